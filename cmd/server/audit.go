@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"skyclust/internal/di"
+	"skyclust/internal/domain"
+	"skyclust/pkg/config"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	auditVerifyFrom string
+	auditVerifyTo   string
+)
+
+// newAuditCommand creates the audit log maintenance commands
+func newAuditCommand() *cobra.Command {
+	auditCmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Audit log maintenance commands",
+	}
+
+	verifyCmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify the audit log hash chain, checkpoints and archives",
+		Long:  "Verifies every audit log segment between --from and --to, prints the report as JSON and exits with status 1 when tampering is detected.",
+		Run:   runAuditVerify,
+	}
+
+	today := time.Now().UTC().Format(domain.AuditSegmentLayout)
+	verifyCmd.Flags().StringVarP(&configFile, "config", "c", "config.yaml", "Configuration file path")
+	verifyCmd.Flags().StringVar(&auditVerifyFrom, "from", today, "First segment to verify (YYYY-MM-DD, UTC)")
+	verifyCmd.Flags().StringVar(&auditVerifyTo, "to", today, "Last segment to verify (YYYY-MM-DD, UTC)")
+
+	auditCmd.AddCommand(verifyCmd)
+	return auditCmd
+}
+
+func runAuditVerify(cmd *cobra.Command, args []string) {
+	if configFile == "config.yaml" {
+		configFile = getConfigFileByEnvironment()
+	}
+
+	cfg, err := config.LoadConfig(configFile)
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	appContainer := &di.Container{}
+	if err := appContainer.Initialize(context.Background(), cfg); err != nil {
+		log.Fatalf("Failed to initialize container: %v", err)
+	}
+	defer appContainer.Close()
+
+	report, err := appContainer.GetAuditLogService().VerifyAuditLogs(auditVerifyFrom, auditVerifyTo)
+	if err != nil {
+		log.Fatalf("Failed to verify audit logs: %v", err)
+	}
+
+	output, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to encode verification report: %v", err)
+	}
+	fmt.Println(string(output))
+
+	if !report.Valid {
+		appContainer.Close()
+		os.Exit(1)
+	}
+}
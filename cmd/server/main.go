@@ -37,6 +37,8 @@ func main() {
 	rootCmd.Flags().StringVarP(&configFile, "config", "c", "config.yaml", "Configuration file path")
 	rootCmd.Flags().StringVarP(&port, "port", "P", "8081", "Server port")
 
+	rootCmd.AddCommand(newAuditCommand())
+
 	if err := rootCmd.Execute(); err != nil {
 		log.Fatal(err)
 	}
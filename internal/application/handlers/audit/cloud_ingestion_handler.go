@@ -0,0 +1,83 @@
+package audit
+
+import (
+	"skyclust/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// IngestCloudAuditLogsRequest: 클라우드 감사 로그 즉시 수집 요청
+type IngestCloudAuditLogsRequest struct {
+	WorkspaceID  string `json:"workspace_id" binding:"required,uuid"`
+	CredentialID string `json:"credential_id" binding:"required,uuid"`
+}
+
+// GetCloudIngestionStatus: 자격증명별 클라우드 감사 로그 수집 상태를 조회합니다
+func (h *Handler) GetCloudIngestionStatus(c *gin.Context) {
+	// Start performance tracking
+	defer h.TrackRequest(c, "get_cloud_ingestion_status", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	var workspaceID *uuid.UUID
+	if value := c.Query("workspace_id"); value != "" {
+		parsed, err := uuid.Parse(value)
+		if err != nil {
+			h.HandleError(c, domain.NewDomainError(domain.ErrCodeBadRequest, "invalid workspace_id", 400), "get_cloud_ingestion_status")
+			return
+		}
+		workspaceID = &parsed
+	}
+
+	cursors, err := h.cloudAuditService.ListIngestionStatus(c.Request.Context(), workspaceID)
+	if err != nil {
+		h.HandleError(c, err, "get_cloud_ingestion_status")
+		return
+	}
+
+	h.OK(c, gin.H{
+		"credentials": cursors,
+		"total":       len(cursors),
+	}, "Cloud audit ingestion status retrieved successfully")
+}
+
+// IngestCloudAuditLogs: 자격증명의 CloudTrail/GCP Admin Activity 로그를 즉시 수집합니다
+func (h *Handler) IngestCloudAuditLogs(c *gin.Context) {
+	// Start performance tracking
+	defer h.TrackRequest(c, "ingest_cloud_audit_logs", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	var req IngestCloudAuditLogsRequest
+	if err := h.ValidateRequest(c, &req); err != nil {
+		h.HandleError(c, err, "ingest_cloud_audit_logs")
+		return
+	}
+	workspaceID, _ := uuid.Parse(req.WorkspaceID)
+	credentialID, _ := uuid.Parse(req.CredentialID)
+
+	h.LogInfo(c, "Ingesting cloud audit logs",
+		zap.String("operation", "ingest_cloud_audit_logs"),
+		zap.String("workspace_id", req.WorkspaceID),
+		zap.String("credential_id", req.CredentialID))
+
+	result, err := h.cloudAuditService.IngestWorkspaceCredential(c.Request.Context(), workspaceID, credentialID)
+	if err != nil {
+		h.HandleError(c, err, "ingest_cloud_audit_logs")
+		return
+	}
+
+	if len(result.OutOfBand) > 0 {
+		h.LogWarn(c, "Detected out-of-band changes to managed resources",
+			zap.String("credential_id", req.CredentialID),
+			zap.Int("count", len(result.OutOfBand)))
+	}
+
+	h.OK(c, result, "Cloud audit logs ingested successfully")
+}
@@ -2,6 +2,7 @@ package audit
 
 import (
 	"fmt"
+	"net/http"
 	"skyclust/internal/domain"
 	"skyclust/internal/shared/handlers"
 	"strconv"
@@ -15,19 +16,21 @@ import (
 // Handler: 감사 로그 관리 작업을 처리하는 핸들러
 type Handler struct {
 	*handlers.BaseHandler
-	auditLogService domain.AuditLogService
+	auditLogService   domain.AuditLogService
+	cloudAuditService domain.CloudAuditService
 }
 
 // NewHandler: 새로운 감사 로그 핸들러를 생성합니다
-func NewHandler(auditLogService domain.AuditLogService) *Handler {
+func NewHandler(auditLogService domain.AuditLogService, cloudAuditService domain.CloudAuditService) *Handler {
 	return &Handler{
-		BaseHandler:     handlers.NewBaseHandler("audit"),
-		auditLogService: auditLogService,
+		BaseHandler:       handlers.NewBaseHandler("audit"),
+		auditLogService:   auditLogService,
+		cloudAuditService: cloudAuditService,
 	}
 }
 
 // GetAuditLogs: 필터링을 포함한 감사 로그를 조회합니다
-// 쿼리 파라미터 지원: aggregate (stats), format (summary), 필터링 파라미터
+// 쿼리 파라미터 지원: aggregate (stats), format (summary), q (검색 쿼리), 필터링 파라미터
 func (h *Handler) GetAuditLogs(c *gin.Context) {
 	// Start performance tracking
 	defer h.TrackRequest(c, "get_audit_logs", 200)
@@ -46,6 +49,12 @@ func (h *Handler) GetAuditLogs(c *gin.Context) {
 		return
 	}
 
+	// Check for search query language
+	if c.Query("q") != "" {
+		h.SearchAuditLogs(c)
+		return
+	}
+
 	// Log operation start
 	h.LogInfo(c, "Getting audit logs",
 		zap.String("operation", "get_audit_logs"))
@@ -415,7 +424,7 @@ func (h *Handler) getContentType(format string) string {
 	}
 }
 
-// CleanupAuditLogs: 오래된 감사 로그를 정리합니다
+// CleanupAuditLogs: 보존 기간이 지난 감사 로그를 아카이브합니다
 func (h *Handler) CleanupAuditLogs(c *gin.Context) {
 	// Start performance tracking
 	defer h.TrackRequest(c, "cleanup_audit_logs", 200)
@@ -438,16 +447,43 @@ func (h *Handler) CleanupAuditLogs(c *gin.Context) {
 		"retention_days": retentionDays,
 	})
 
-	// Cleanup audit logs
-	deletedCount, err := h.auditLogService.CleanupAuditLogs(retentionDays)
+	adminID, err := h.ExtractUserIDFromContext(c)
+	if err != nil {
+		h.HandleError(c, err, "cleanup_audit_logs")
+		return
+	}
+
+	// Archive expired segments (audit logs are never deleted without an archive)
+	result, err := h.auditLogService.CleanupAuditLogs(&adminID, retentionDays)
 	if err != nil {
 		h.HandleError(c, err, "cleanup_audit_logs")
 		return
 	}
 
 	h.OK(c, gin.H{
-		"message":        "Audit logs cleaned up successfully",
-		"retention_days": retentionDays,
-		"deleted_count":  deletedCount,
+		"message":           "Audit logs archived successfully",
+		"retention_days":    retentionDays,
+		"archived_count":    result.ArchivedEntries,
+		"archived_segments": result.ArchivedSegments,
 	}, "Cleanup completed")
 }
+
+// checkAdminPermission: 관리자 권한을 확인합니다
+func (h *Handler) checkAdminPermission(c *gin.Context) bool {
+	userRole, err := h.GetUserRoleFromToken(c)
+	if err != nil {
+		h.HandleError(c, err, "check_admin_permission")
+		return false
+	}
+
+	if userRole != domain.AdminRoleType {
+		h.HandleError(c, domain.NewDomainError(
+			domain.ErrCodeForbidden,
+			"Insufficient permissions - admin role required",
+			http.StatusForbidden,
+		), "check_admin_permission")
+		return false
+	}
+
+	return true
+}
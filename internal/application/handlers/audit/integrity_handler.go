@@ -0,0 +1,154 @@
+package audit
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"skyclust/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// defaultVerifyDays: 기간 미지정 시 검증하는 최근 일수
+const defaultVerifyDays = 7
+
+// VerifyAuditLogs: 감사 로그 해시 체인, 체크포인트, 아카이브의 무결성을 검증합니다
+func (h *Handler) VerifyAuditLogs(c *gin.Context) {
+	// Start performance tracking
+	defer h.TrackRequest(c, "verify_audit_logs", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	from, to := h.parseSegmentRange(c)
+
+	h.LogInfo(c, "Verifying audit log integrity",
+		zap.String("operation", "verify_audit_logs"),
+		zap.String("from", from),
+		zap.String("to", to))
+
+	report, err := h.auditLogService.VerifyAuditLogs(from, to)
+	if err != nil {
+		h.HandleError(c, err, "verify_audit_logs")
+		return
+	}
+
+	if !report.Valid {
+		h.LogWarn(c, "Audit log integrity verification failed",
+			zap.String("from", from),
+			zap.String("to", to),
+			zap.Int("issue_count", report.IssueCount))
+	}
+
+	h.OK(c, report, "Audit log integrity verified")
+}
+
+// GetAuditCheckpoints: 기간 내 서명된 체크포인트를 조회합니다
+func (h *Handler) GetAuditCheckpoints(c *gin.Context) {
+	// Start performance tracking
+	defer h.TrackRequest(c, "get_audit_checkpoints", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	from, to := h.parseSegmentRange(c)
+
+	checkpoints, err := h.auditLogService.ListCheckpoints(from, to)
+	if err != nil {
+		h.HandleError(c, err, "get_audit_checkpoints")
+		return
+	}
+
+	h.OK(c, gin.H{
+		"checkpoints": checkpoints,
+		"total":       len(checkpoints),
+	}, "Audit checkpoints retrieved successfully")
+}
+
+// CreateAuditCheckpoints: 현재 세그먼트에 체크포인트를 즉시 생성합니다
+func (h *Handler) CreateAuditCheckpoints(c *gin.Context) {
+	// Start performance tracking
+	defer h.TrackRequest(c, "create_audit_checkpoints", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	checkpoints, err := h.auditLogService.CreateCheckpoints()
+	if err != nil {
+		h.HandleError(c, err, "create_audit_checkpoints")
+		return
+	}
+
+	h.OK(c, gin.H{
+		"checkpoints": checkpoints,
+		"total":       len(checkpoints),
+	}, "Audit checkpoints created successfully")
+}
+
+// GetAuditArchives: 기간 내 감사 로그 아카이브 목록을 조회합니다
+func (h *Handler) GetAuditArchives(c *gin.Context) {
+	// Start performance tracking
+	defer h.TrackRequest(c, "get_audit_archives", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	from, to := h.parseSegmentRange(c)
+	if c.Query("from") == "" {
+		// 아카이브는 보존 기간 이전 세그먼트이므로 기본 조회 범위를 넓게 잡음
+		from = time.Now().UTC().AddDate(-1, 0, 0).Format(domain.AuditSegmentLayout)
+	}
+
+	archives, err := h.auditLogService.ListArchives(from, to)
+	if err != nil {
+		h.HandleError(c, err, "get_audit_archives")
+		return
+	}
+
+	h.OK(c, gin.H{
+		"archives": archives,
+		"total":    len(archives),
+	}, "Audit archives retrieved successfully")
+}
+
+// DownloadAuditArchive: 세그먼트 아카이브(gzip JSON Lines)를 다운로드합니다
+func (h *Handler) DownloadAuditArchive(c *gin.Context) {
+	// Start performance tracking
+	defer h.TrackRequest(c, "download_audit_archive", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	segment := c.Param("segment")
+	archive, err := h.auditLogService.GetArchive(segment)
+	if err != nil {
+		h.HandleError(c, err, "download_audit_archive")
+		return
+	}
+
+	h.LogAuditEvent(c, "download", "audit_archive", "", archive.ID.String(), map[string]interface{}{
+		"segment": archive.Segment,
+		"digest":  archive.Digest,
+	})
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=audit-logs-%s.jsonl.gz", archive.Segment))
+	c.Header("X-Audit-Archive-Digest", archive.Digest)
+	c.Header("X-Audit-Archive-Signature", archive.Signature)
+	c.Header("X-Audit-Archive-Key-Id", archive.KeyID)
+	c.Data(http.StatusOK, "application/gzip", archive.Data)
+}
+
+// parseSegmentRange: from/to 쿼리 파라미터(YYYY-MM-DD)를 읽고 기본값으로 최근 7일을 사용합니다
+func (h *Handler) parseSegmentRange(c *gin.Context) (string, string) {
+	now := time.Now().UTC()
+	from := c.DefaultQuery("from", now.AddDate(0, 0, -(defaultVerifyDays-1)).Format(domain.AuditSegmentLayout))
+	to := c.DefaultQuery("to", now.Format(domain.AuditSegmentLayout))
+	return from, to
+}
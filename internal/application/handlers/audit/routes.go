@@ -7,13 +7,38 @@ import (
 )
 
 // SetupRoutes sets up audit log management routes
-func SetupRoutes(router *gin.RouterGroup, auditLogService domain.AuditLogService) {
-	auditHandler := NewHandler(auditLogService)
+func SetupRoutes(router *gin.RouterGroup, auditLogService domain.AuditLogService, cloudAuditService domain.CloudAuditService) {
+	auditHandler := NewHandler(auditLogService, cloudAuditService)
 
 	// Audit log management (RESTful)
 	// Base path: /api/v1/admin/audit-logs
-	router.GET("", auditHandler.GetAuditLogs)           // GET /api/v1/admin/audit-logs (with query params: aggregate=stats, format=summary)
+	router.GET("", auditHandler.GetAuditLogs)           // GET /api/v1/admin/audit-logs (with query params: aggregate=stats, format=summary, q=)
 	router.GET("/:id", auditHandler.GetAuditLog)        // GET /api/v1/admin/audit-logs/:id
 	router.GET("/export", auditHandler.ExportAuditLogs) // GET /api/v1/admin/audit-logs/export
-	router.DELETE("", auditHandler.CleanupAuditLogs)    // DELETE /api/v1/admin/audit-logs?retention_days=90
+	router.DELETE("", auditHandler.CleanupAuditLogs)    // DELETE /api/v1/admin/audit-logs?retention_days=90 (archives expired segments)
+
+	// Search query language, saved queries and subscriptions
+	router.GET("/search", auditHandler.SearchAuditLogs)                                  // GET /api/v1/admin/audit-logs/search?q=action:credential.* AND details.provider:aws
+	router.GET("/saved-queries", auditHandler.GetSavedQueries)                           // GET /api/v1/admin/audit-logs/saved-queries
+	router.POST("/saved-queries", auditHandler.CreateSavedQuery)                         // POST /api/v1/admin/audit-logs/saved-queries
+	router.GET("/saved-queries/:id", auditHandler.GetSavedQuery)                         // GET /api/v1/admin/audit-logs/saved-queries/:id
+	router.PUT("/saved-queries/:id", auditHandler.UpdateSavedQuery)                      // PUT /api/v1/admin/audit-logs/saved-queries/:id
+	router.DELETE("/saved-queries/:id", auditHandler.DeleteSavedQuery)                   // DELETE /api/v1/admin/audit-logs/saved-queries/:id
+	router.GET("/saved-queries/:id/results", auditHandler.RunSavedQuery)                 // GET /api/v1/admin/audit-logs/saved-queries/:id/results?start_time=&end_time=
+	router.POST("/saved-queries/:id/subscription", auditHandler.SubscribeSavedQuery)     // POST /api/v1/admin/audit-logs/saved-queries/:id/subscription
+	router.DELETE("/saved-queries/:id/subscription", auditHandler.UnsubscribeSavedQuery) // DELETE /api/v1/admin/audit-logs/saved-queries/:id/subscription
+
+	// Integrity (hash chain, signed checkpoints, archives)
+	router.GET("/verify", auditHandler.VerifyAuditLogs)                 // GET /api/v1/admin/audit-logs/verify?from=YYYY-MM-DD&to=YYYY-MM-DD
+	router.GET("/checkpoints", auditHandler.GetAuditCheckpoints)        // GET /api/v1/admin/audit-logs/checkpoints?from=&to=
+	router.POST("/checkpoints", auditHandler.CreateAuditCheckpoints)    // POST /api/v1/admin/audit-logs/checkpoints
+	router.GET("/archives", auditHandler.GetAuditArchives)              // GET /api/v1/admin/audit-logs/archives?from=&to=
+	router.GET("/archives/:segment", auditHandler.DownloadAuditArchive) // GET /api/v1/admin/audit-logs/archives/:segment
+
+	// SIEM forwarding (syslog, webhook, Splunk HEC)
+	router.GET("/sinks", auditHandler.GetAuditSinks) // GET /api/v1/admin/audit-logs/sinks
+
+	// Cloud provider audit log ingestion (CloudTrail, GCP Admin Activity)
+	router.GET("/cloud-ingestion", auditHandler.GetCloudIngestionStatus) // GET /api/v1/admin/audit-logs/cloud-ingestion?workspace_id=
+	router.POST("/cloud-ingestion", auditHandler.IngestCloudAuditLogs)   // POST /api/v1/admin/audit-logs/cloud-ingestion
 }
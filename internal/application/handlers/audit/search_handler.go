@@ -0,0 +1,297 @@
+package audit
+
+import (
+	"strconv"
+	"time"
+
+	"skyclust/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// SubscribeAuditQueryRequest: 저장된 쿼리 구독 요청
+type SubscribeAuditQueryRequest struct {
+	Priority string `json:"priority" binding:"omitempty,oneof=low medium high urgent"`
+}
+
+// SearchAuditLogs: 쿼리 언어로 감사 로그를 검색합니다
+// 예: q=action:credential.* AND details.provider:aws
+func (h *Handler) SearchAuditLogs(c *gin.Context) {
+	// Start performance tracking
+	defer h.TrackRequest(c, "search_audit_logs", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	req, err := h.parseSearchRequest(c)
+	if err != nil {
+		h.HandleError(c, err, "search_audit_logs")
+		return
+	}
+	req.Query = c.Query("q")
+
+	h.LogInfo(c, "Searching audit logs",
+		zap.String("operation", "search_audit_logs"),
+		zap.String("query", req.Query))
+
+	result, err := h.auditLogService.SearchAuditLogs(req)
+	if err != nil {
+		h.HandleError(c, err, "search_audit_logs")
+		return
+	}
+
+	h.OK(c, result, "Audit logs searched successfully")
+}
+
+// GetSavedQueries: 사용자가 소유했거나 공유된 저장된 쿼리를 조회합니다
+func (h *Handler) GetSavedQueries(c *gin.Context) {
+	// Start performance tracking
+	defer h.TrackRequest(c, "get_saved_audit_queries", 200)
+
+	userID, err := h.GetUserIDFromToken(c)
+	if err != nil {
+		h.HandleError(c, err, "get_saved_audit_queries")
+		return
+	}
+
+	queries, err := h.auditLogService.ListSavedQueries(userID)
+	if err != nil {
+		h.HandleError(c, err, "get_saved_audit_queries")
+		return
+	}
+
+	h.OK(c, gin.H{
+		"queries": queries,
+		"total":   len(queries),
+	}, "Saved audit queries retrieved successfully")
+}
+
+// CreateSavedQuery: 검색 쿼리를 저장합니다
+func (h *Handler) CreateSavedQuery(c *gin.Context) {
+	// Start performance tracking
+	defer h.TrackRequest(c, "create_saved_audit_query", 201)
+
+	userID, err := h.GetUserIDFromToken(c)
+	if err != nil {
+		h.HandleError(c, err, "create_saved_audit_query")
+		return
+	}
+
+	var req domain.SaveAuditQueryRequest
+	if err := h.ValidateRequest(c, &req); err != nil {
+		h.HandleError(c, err, "create_saved_audit_query")
+		return
+	}
+
+	saved, err := h.auditLogService.CreateSavedQuery(userID, req)
+	if err != nil {
+		h.HandleError(c, err, "create_saved_audit_query")
+		return
+	}
+
+	h.LogInfo(c, "Saved audit query created",
+		zap.String("saved_query_id", saved.ID.String()),
+		zap.Bool("shared", saved.Shared))
+
+	h.Created(c, saved, "Saved audit query created successfully")
+}
+
+// GetSavedQuery: 저장된 쿼리를 조회합니다
+func (h *Handler) GetSavedQuery(c *gin.Context) {
+	// Start performance tracking
+	defer h.TrackRequest(c, "get_saved_audit_query", 200)
+
+	userID, id, ok := h.savedQueryParams(c, "get_saved_audit_query")
+	if !ok {
+		return
+	}
+
+	saved, err := h.auditLogService.GetSavedQuery(userID, id)
+	if err != nil {
+		h.HandleError(c, err, "get_saved_audit_query")
+		return
+	}
+
+	h.OK(c, saved, "Saved audit query retrieved successfully")
+}
+
+// UpdateSavedQuery: 저장된 쿼리를 수정합니다
+func (h *Handler) UpdateSavedQuery(c *gin.Context) {
+	// Start performance tracking
+	defer h.TrackRequest(c, "update_saved_audit_query", 200)
+
+	userID, id, ok := h.savedQueryParams(c, "update_saved_audit_query")
+	if !ok {
+		return
+	}
+
+	var req domain.SaveAuditQueryRequest
+	if err := h.ValidateRequest(c, &req); err != nil {
+		h.HandleError(c, err, "update_saved_audit_query")
+		return
+	}
+
+	saved, err := h.auditLogService.UpdateSavedQuery(userID, id, req)
+	if err != nil {
+		h.HandleError(c, err, "update_saved_audit_query")
+		return
+	}
+
+	h.OK(c, saved, "Saved audit query updated successfully")
+}
+
+// DeleteSavedQuery: 저장된 쿼리를 삭제합니다
+func (h *Handler) DeleteSavedQuery(c *gin.Context) {
+	// Start performance tracking
+	defer h.TrackRequest(c, "delete_saved_audit_query", 200)
+
+	userID, id, ok := h.savedQueryParams(c, "delete_saved_audit_query")
+	if !ok {
+		return
+	}
+
+	if err := h.auditLogService.DeleteSavedQuery(userID, id); err != nil {
+		h.HandleError(c, err, "delete_saved_audit_query")
+		return
+	}
+
+	h.OK(c, nil, "Saved audit query deleted successfully")
+}
+
+// RunSavedQuery: 저장된 쿼리로 감사 로그를 검색합니다
+func (h *Handler) RunSavedQuery(c *gin.Context) {
+	// Start performance tracking
+	defer h.TrackRequest(c, "run_saved_audit_query", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	userID, id, ok := h.savedQueryParams(c, "run_saved_audit_query")
+	if !ok {
+		return
+	}
+
+	req, err := h.parseSearchRequest(c)
+	if err != nil {
+		h.HandleError(c, err, "run_saved_audit_query")
+		return
+	}
+
+	result, err := h.auditLogService.RunSavedQuery(userID, id, req)
+	if err != nil {
+		h.HandleError(c, err, "run_saved_audit_query")
+		return
+	}
+
+	h.OK(c, result, "Saved audit query executed successfully")
+}
+
+// SubscribeSavedQuery: 저장된 쿼리를 구독하여 새로 일치하는 감사 로그를 알림으로 받습니다
+func (h *Handler) SubscribeSavedQuery(c *gin.Context) {
+	// Start performance tracking
+	defer h.TrackRequest(c, "subscribe_saved_audit_query", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	userID, id, ok := h.savedQueryParams(c, "subscribe_saved_audit_query")
+	if !ok {
+		return
+	}
+
+	var req SubscribeAuditQueryRequest
+	if c.Request.ContentLength > 0 {
+		if err := h.ValidateRequest(c, &req); err != nil {
+			h.HandleError(c, err, "subscribe_saved_audit_query")
+			return
+		}
+	}
+
+	subscription, err := h.auditLogService.SubscribeSavedQuery(userID, id, req.Priority)
+	if err != nil {
+		h.HandleError(c, err, "subscribe_saved_audit_query")
+		return
+	}
+
+	h.LogInfo(c, "Subscribed to saved audit query",
+		zap.String("saved_query_id", id.String()),
+		zap.String("priority", subscription.Priority))
+
+	h.OK(c, subscription, "Subscribed to saved audit query successfully")
+}
+
+// UnsubscribeSavedQuery: 저장된 쿼리 구독을 해지합니다
+func (h *Handler) UnsubscribeSavedQuery(c *gin.Context) {
+	// Start performance tracking
+	defer h.TrackRequest(c, "unsubscribe_saved_audit_query", 200)
+
+	userID, id, ok := h.savedQueryParams(c, "unsubscribe_saved_audit_query")
+	if !ok {
+		return
+	}
+
+	if err := h.auditLogService.UnsubscribeSavedQuery(userID, id); err != nil {
+		h.HandleError(c, err, "unsubscribe_saved_audit_query")
+		return
+	}
+
+	h.OK(c, nil, "Unsubscribed from saved audit query successfully")
+}
+
+// savedQueryParams: 요청한 사용자 ID와 경로의 저장된 쿼리 ID를 가져옵니다
+func (h *Handler) savedQueryParams(c *gin.Context, operation string) (uuid.UUID, uuid.UUID, bool) {
+	userID, err := h.GetUserIDFromToken(c)
+	if err != nil {
+		h.HandleError(c, err, operation)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		h.HandleError(c, domain.NewDomainError(domain.ErrCodeBadRequest, "invalid saved query ID format", 400), operation)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, id, true
+}
+
+// parseSearchRequest: 검색 기간과 페이지 파라미터를 파싱합니다 (start_time, end_time: RFC3339)
+func (h *Handler) parseSearchRequest(c *gin.Context) (domain.AuditSearchRequest, error) {
+	var req domain.AuditSearchRequest
+
+	if value := c.Query("start_time"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return req, domain.NewDomainError(domain.ErrCodeBadRequest, "invalid start_time format, expected RFC3339", 400)
+		}
+		req.StartTime = &parsed
+	}
+	if value := c.Query("end_time"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return req, domain.NewDomainError(domain.ErrCodeBadRequest, "invalid end_time format, expected RFC3339", 400)
+		}
+		req.EndTime = &parsed
+	}
+	if value := c.Query("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 {
+			return req, domain.NewDomainError(domain.ErrCodeBadRequest, "limit must be a positive integer", 400)
+		}
+		req.Limit = limit
+	}
+	if value := c.Query("offset"); value != "" {
+		offset, err := strconv.Atoi(value)
+		if err != nil || offset < 0 {
+			return req, domain.NewDomainError(domain.ErrCodeBadRequest, "offset must be a non-negative integer", 400)
+		}
+		req.Offset = offset
+	}
+
+	return req, nil
+}
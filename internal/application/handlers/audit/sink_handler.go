@@ -0,0 +1,36 @@
+package audit
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// GetAuditSinks: SIEM 싱크별 전달 상태(대기, 전달, 실패 지표)를 조회합니다
+func (h *Handler) GetAuditSinks(c *gin.Context) {
+	// Start performance tracking
+	defer h.TrackRequest(c, "get_audit_sinks", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	sinks, err := h.auditLogService.GetSinkStatus()
+	if err != nil {
+		h.HandleError(c, err, "get_audit_sinks")
+		return
+	}
+
+	for _, sink := range sinks {
+		if !sink.Healthy {
+			h.LogWarn(c, "Audit sink is failing",
+				zap.String("sink", sink.Name),
+				zap.Int("consecutive_failures", sink.ConsecutiveFailures),
+				zap.Int64("pending", sink.Pending))
+		}
+	}
+
+	h.OK(c, gin.H{
+		"sinks": sinks,
+		"total": len(sinks),
+	}, "Audit sink status retrieved successfully")
+}
@@ -0,0 +1,404 @@
+package automation
+
+import (
+	"skyclust/internal/domain"
+	"skyclust/internal/shared/handlers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler: 워크스페이스 자동화 규칙 관련 HTTP 요청을 처리하는 핸들러
+type Handler struct {
+	*handlers.BaseHandler
+	automationService domain.AutomationService
+}
+
+// NewHandler: 새로운 워크스페이스 자동화 규칙 핸들러를 생성합니다
+func NewHandler(automationService domain.AutomationService) *Handler {
+	return &Handler{
+		BaseHandler:       handlers.NewBaseHandler("automation"),
+		automationService: automationService,
+	}
+}
+
+// ListRules: 워크스페이스 자동화 규칙 목록 조회 요청을 처리합니다
+func (h *Handler) ListRules(c *gin.Context) {
+	handler := h.Compose(
+		h.listRulesHandler(),
+		h.StandardCRUDDecorators("list_automation_rules")...,
+	)
+
+	handler(c)
+}
+
+// listRulesHandler: 워크스페이스 자동화 규칙 목록 조회의 핵심 비즈니스 로직
+func (h *Handler) listRulesHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "list_automation_rules")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceAutomation); err != nil {
+			h.HandleError(c, err, "list_automation_rules")
+			return
+		}
+
+		rules, err := h.automationService.ListRules(c.Request.Context(), workspaceID)
+		if err != nil {
+			h.HandleError(c, err, "list_automation_rules")
+			return
+		}
+
+		h.OK(c, RuleListResponse{Rules: rules, Total: len(rules)}, "Automation rules retrieved successfully")
+	}
+}
+
+// GetRule: 워크스페이스 자동화 규칙 조회 요청을 처리합니다
+func (h *Handler) GetRule(c *gin.Context) {
+	handler := h.Compose(
+		h.getRuleHandler(),
+		h.StandardCRUDDecorators("get_automation_rule")...,
+	)
+
+	handler(c)
+}
+
+// getRuleHandler: 워크스페이스 자동화 규칙 조회의 핵심 비즈니스 로직
+func (h *Handler) getRuleHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "get_automation_rule")
+			return
+		}
+		ruleID, err := h.ExtractPathParam(c, "ruleId")
+		if err != nil {
+			h.HandleError(c, err, "get_automation_rule")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceAutomation); err != nil {
+			h.HandleError(c, err, "get_automation_rule")
+			return
+		}
+
+		rule, err := h.automationService.GetRule(c.Request.Context(), workspaceID, ruleID)
+		if err != nil {
+			h.HandleError(c, err, "get_automation_rule")
+			return
+		}
+
+		h.OK(c, rule, "Automation rule retrieved successfully")
+	}
+}
+
+// CreateRule: 워크스페이스 자동화 규칙 생성 요청을 처리합니다
+// SkyClust 작업 액션이 포함되면 compute:write 권한도 필요합니다
+func (h *Handler) CreateRule(c *gin.Context) {
+	handler := h.Compose(
+		h.createRuleHandler(),
+		h.StandardCRUDDecorators("create_automation_rule")...,
+	)
+
+	handler(c)
+}
+
+// createRuleHandler: 워크스페이스 자동화 규칙 생성의 핵심 비즈니스 로직
+func (h *Handler) createRuleHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "create_automation_rule")
+			return
+		}
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "create_automation_rule")
+			return
+		}
+
+		var req domain.CreateAutomationRuleRequest
+		if err := h.ExtractValidatedRequest(c, &req); err != nil {
+			h.HandleError(c, err, "create_automation_rule")
+			return
+		}
+
+		if err := h.requireRulePermissions(c, workspaceID, req.Actions); err != nil {
+			h.HandleError(c, err, "create_automation_rule")
+			return
+		}
+
+		rule, err := h.automationService.CreateRule(ctx, userID, workspaceID, req)
+		if err != nil {
+			h.HandleError(c, err, "create_automation_rule")
+			return
+		}
+
+		h.LogBusinessEvent(c, "automation_rule_created", userID.String(), workspaceID.String(), map[string]interface{}{
+			"workspace_id": workspaceID.String(),
+			"rule_id":      rule.ID.String(),
+			"event_types":  []string(rule.EventTypes),
+		})
+		h.Created(c, rule, "Automation rule created successfully")
+	}
+}
+
+// UpdateRule: 워크스페이스 자동화 규칙 수정 요청을 처리합니다
+func (h *Handler) UpdateRule(c *gin.Context) {
+	handler := h.Compose(
+		h.updateRuleHandler(),
+		h.StandardCRUDDecorators("update_automation_rule")...,
+	)
+
+	handler(c)
+}
+
+// updateRuleHandler: 워크스페이스 자동화 규칙 수정의 핵심 비즈니스 로직
+func (h *Handler) updateRuleHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "update_automation_rule")
+			return
+		}
+		ruleID, err := h.ExtractPathParam(c, "ruleId")
+		if err != nil {
+			h.HandleError(c, err, "update_automation_rule")
+			return
+		}
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "update_automation_rule")
+			return
+		}
+
+		var req domain.UpdateAutomationRuleRequest
+		if err := h.ExtractValidatedRequest(c, &req); err != nil {
+			h.HandleError(c, err, "update_automation_rule")
+			return
+		}
+
+		if err := h.requireRulePermissions(c, workspaceID, req.Actions); err != nil {
+			h.HandleError(c, err, "update_automation_rule")
+			return
+		}
+
+		rule, err := h.automationService.UpdateRule(ctx, userID, workspaceID, ruleID, req)
+		if err != nil {
+			h.HandleError(c, err, "update_automation_rule")
+			return
+		}
+
+		h.LogBusinessEvent(c, "automation_rule_updated", userID.String(), workspaceID.String(), map[string]interface{}{
+			"workspace_id": workspaceID.String(),
+			"rule_id":      rule.ID.String(),
+			"enabled":      rule.Enabled,
+		})
+		h.OK(c, rule, "Automation rule updated successfully")
+	}
+}
+
+// DeleteRule: 워크스페이스 자동화 규칙 삭제 요청을 처리합니다 (실행 기록 포함)
+func (h *Handler) DeleteRule(c *gin.Context) {
+	handler := h.Compose(
+		h.deleteRuleHandler(),
+		h.StandardCRUDDecorators("delete_automation_rule")...,
+	)
+
+	handler(c)
+}
+
+// deleteRuleHandler: 워크스페이스 자동화 규칙 삭제의 핵심 비즈니스 로직
+func (h *Handler) deleteRuleHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "delete_automation_rule")
+			return
+		}
+		ruleID, err := h.ExtractPathParam(c, "ruleId")
+		if err != nil {
+			h.HandleError(c, err, "delete_automation_rule")
+			return
+		}
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "delete_automation_rule")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceAutomation); err != nil {
+			h.HandleError(c, err, "delete_automation_rule")
+			return
+		}
+
+		if err := h.automationService.DeleteRule(ctx, userID, workspaceID, ruleID); err != nil {
+			h.HandleError(c, err, "delete_automation_rule")
+			return
+		}
+
+		h.LogBusinessEvent(c, "automation_rule_deleted", userID.String(), workspaceID.String(), map[string]interface{}{
+			"workspace_id": workspaceID.String(),
+			"rule_id":      ruleID.String(),
+		})
+		h.OK(c, gin.H{"message": "Automation rule deleted successfully"}, "Automation rule deleted successfully")
+	}
+}
+
+// TestRule: 샘플 이벤트로 자동화 규칙을 시험하는 요청을 처리합니다 (액션은 실행하지 않음)
+func (h *Handler) TestRule(c *gin.Context) {
+	handler := h.Compose(
+		h.testRuleHandler(),
+		h.StandardCRUDDecorators("test_automation_rule")...,
+	)
+
+	handler(c)
+}
+
+// testRuleHandler: 자동화 규칙 시험의 핵심 비즈니스 로직
+func (h *Handler) testRuleHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "test_automation_rule")
+			return
+		}
+		ruleID, err := h.ExtractPathParam(c, "ruleId")
+		if err != nil {
+			h.HandleError(c, err, "test_automation_rule")
+			return
+		}
+
+		var req domain.TestAutomationRuleRequest
+		if err := h.ExtractValidatedRequest(c, &req); err != nil {
+			h.HandleError(c, err, "test_automation_rule")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceAutomation); err != nil {
+			h.HandleError(c, err, "test_automation_rule")
+			return
+		}
+
+		result, err := h.automationService.TestRule(c.Request.Context(), workspaceID, ruleID, req)
+		if err != nil {
+			h.HandleError(c, err, "test_automation_rule")
+			return
+		}
+
+		h.OK(c, result, "Automation rule evaluated successfully")
+	}
+}
+
+// ListExecutions: 자동화 규칙 실행 기록 목록 조회 요청을 처리합니다
+func (h *Handler) ListExecutions(c *gin.Context) {
+	handler := h.Compose(
+		h.listExecutionsHandler(),
+		h.StandardCRUDDecorators("list_automation_executions")...,
+	)
+
+	handler(c)
+}
+
+// listExecutionsHandler: 자동화 규칙 실행 기록 목록 조회의 핵심 비즈니스 로직
+func (h *Handler) listExecutionsHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "list_automation_executions")
+			return
+		}
+		ruleID, err := h.ExtractPathParam(c, "ruleId")
+		if err != nil {
+			h.HandleError(c, err, "list_automation_executions")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceAutomation); err != nil {
+			h.HandleError(c, err, "list_automation_executions")
+			return
+		}
+
+		limit, offset := h.ParsePaginationParams(c)
+		executions, total, err := h.automationService.ListExecutions(c.Request.Context(), workspaceID, ruleID, domain.AutomationExecutionFilter{
+			Status: c.Query("status"),
+			Limit:  limit,
+			Offset: offset,
+		})
+		if err != nil {
+			h.HandleError(c, err, "list_automation_executions")
+			return
+		}
+
+		h.OK(c, ExecutionListResponse{
+			Executions: executions,
+			Total:      total,
+			Limit:      limit,
+			Offset:     offset,
+		}, "Automation executions retrieved successfully")
+	}
+}
+
+// GetExecution: 자동화 규칙 실행 기록 조회 요청을 처리합니다 (이벤트 데이터와 액션 결과 포함)
+func (h *Handler) GetExecution(c *gin.Context) {
+	handler := h.Compose(
+		h.getExecutionHandler(),
+		h.StandardCRUDDecorators("get_automation_execution")...,
+	)
+
+	handler(c)
+}
+
+// getExecutionHandler: 자동화 규칙 실행 기록 조회의 핵심 비즈니스 로직
+func (h *Handler) getExecutionHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "get_automation_execution")
+			return
+		}
+		ruleID, err := h.ExtractPathParam(c, "ruleId")
+		if err != nil {
+			h.HandleError(c, err, "get_automation_execution")
+			return
+		}
+		executionID, err := h.ExtractPathParam(c, "executionId")
+		if err != nil {
+			h.HandleError(c, err, "get_automation_execution")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceAutomation); err != nil {
+			h.HandleError(c, err, "get_automation_execution")
+			return
+		}
+
+		execution, err := h.automationService.GetExecution(c.Request.Context(), workspaceID, ruleID, executionID)
+		if err != nil {
+			h.HandleError(c, err, "get_automation_execution")
+			return
+		}
+
+		h.OK(c, execution, "Automation execution retrieved successfully")
+	}
+}
+
+// requireRulePermissions: 규칙 관리 권한과, SkyClust 작업 액션이 있으면 compute:write 권한을 확인합니다
+// 작업 액션은 규칙 생성자 권한으로 실행되므로 생성자가 직접 실행할 수 없는 작업을 예약하지 못하게 합니다
+func (h *Handler) requireRulePermissions(c *gin.Context, workspaceID uuid.UUID, actions []domain.AutomationAction) error {
+	if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceAutomation); err != nil {
+		return err
+	}
+	for _, action := range actions {
+		if action.Type == domain.AutomationActionOperation {
+			return h.RequireWorkspacePermission(c, workspaceID, domain.ComputeWrite)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package automation
+
+import (
+	"skyclust/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupRoutes sets up workspace automation rule routes
+// Rules are nested under a workspace: /workspaces/:id/automation-rules
+func SetupRoutes(router *gin.RouterGroup, automationService domain.AutomationService) {
+	automationHandler := NewHandler(automationService)
+
+	router.GET("/:id/automation-rules", automationHandler.ListRules)
+	router.POST("/:id/automation-rules", automationHandler.CreateRule)
+	router.GET("/:id/automation-rules/:ruleId", automationHandler.GetRule)
+	router.PUT("/:id/automation-rules/:ruleId", automationHandler.UpdateRule)
+	router.DELETE("/:id/automation-rules/:ruleId", automationHandler.DeleteRule)
+	router.POST("/:id/automation-rules/:ruleId/test", automationHandler.TestRule)
+
+	// Execution history
+	router.GET("/:id/automation-rules/:ruleId/executions", automationHandler.ListExecutions)
+	router.GET("/:id/automation-rules/:ruleId/executions/:executionId", automationHandler.GetExecution)
+}
@@ -0,0 +1,17 @@
+package automation
+
+import "skyclust/internal/domain"
+
+// RuleListResponse represents a list of workspace automation rules
+type RuleListResponse struct {
+	Rules []*domain.AutomationRule `json:"rules"`
+	Total int                      `json:"total"`
+}
+
+// ExecutionListResponse represents a page of automation rule executions
+type ExecutionListResponse struct {
+	Executions []*domain.AutomationExecution `json:"executions"`
+	Total      int64                         `json:"total"`
+	Limit      int                           `json:"limit"`
+	Offset     int                           `json:"offset"`
+}
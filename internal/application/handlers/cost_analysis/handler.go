@@ -42,6 +42,11 @@ func (h *Handler) getCostSummaryHandler() handlers.HandlerFunc {
 			return
 		}
 
+		if err := h.RequireWorkspacePermissionFromString(c, workspaceIDStr, domain.CostRead); err != nil {
+			h.HandleError(c, err, "get_cost_summary")
+			return
+		}
+
 		// Get period from query parameter (default: 30d)
 		period := c.DefaultQuery("period", "30d")
 
@@ -94,6 +99,11 @@ func (h *Handler) getCostPredictionsHandler() handlers.HandlerFunc {
 			return
 		}
 
+		if err := h.RequireWorkspacePermissionFromString(c, workspaceID, domain.CostRead); err != nil {
+			h.HandleError(c, err, "get_cost_predictions")
+			return
+		}
+
 		// Get days from query parameter (default: 30)
 		days := 30
 		if daysStr := c.Query("days"); daysStr != "" {
@@ -149,6 +159,11 @@ func (h *Handler) getBudgetAlertsHandler() handlers.HandlerFunc {
 			return
 		}
 
+		if err := h.RequireWorkspacePermissionFromString(c, workspaceID, domain.CostRead); err != nil {
+			h.HandleError(c, err, "get_budget_alerts")
+			return
+		}
+
 		// Get budget limit from query parameter (optional)
 		budgetLimit := 0.0
 		if budgetLimitStr := c.Query("budget_limit"); budgetLimitStr != "" {
@@ -204,6 +219,11 @@ func (h *Handler) getCostTrendHandler() handlers.HandlerFunc {
 			return
 		}
 
+		if err := h.RequireWorkspacePermissionFromString(c, workspaceID, domain.CostRead); err != nil {
+			h.HandleError(c, err, "get_cost_trend")
+			return
+		}
+
 		// Get period from query parameter (default: 90d)
 		period := c.DefaultQuery("period", "90d")
 
@@ -265,6 +285,11 @@ func (h *Handler) getCostBreakdownHandler() handlers.HandlerFunc {
 			return
 		}
 
+		if err := h.RequireWorkspacePermissionFromString(c, workspaceID, domain.CostRead); err != nil {
+			h.HandleError(c, err, "get_cost_breakdown")
+			return
+		}
+
 		// Get period from query parameter (default: 30d)
 		period := c.DefaultQuery("period", "30d")
 
@@ -315,6 +340,11 @@ func (h *Handler) getCostComparisonHandler() handlers.HandlerFunc {
 			return
 		}
 
+		if err := h.RequireWorkspacePermissionFromString(c, workspaceID, domain.CostRead); err != nil {
+			h.HandleError(c, err, "get_cost_comparison")
+			return
+		}
+
 		// Get periods from query parameters
 		currentPeriod := c.DefaultQuery("current_period", "30d")
 		comparePeriod := c.DefaultQuery("compare_period", "30d")
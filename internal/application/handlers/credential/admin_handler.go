@@ -0,0 +1,140 @@
+package credential
+
+import (
+	"net/http"
+	"strconv"
+
+	"skyclust/internal/domain"
+	"skyclust/internal/shared/handlers"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// GetEncryptionStatus: 마스터 키 버전별 자격증명 수 조회 요청을 처리합니다 (관리자 전용)
+func (h *Handler) GetEncryptionStatus(c *gin.Context) {
+	handler := h.Compose(
+		h.getEncryptionStatusHandler(),
+		h.StandardCRUDDecorators("get_credential_encryption_status")...,
+	)
+
+	handler(c)
+}
+
+// getEncryptionStatusHandler: 암호화 키 현황 조회의 핵심 비즈니스 로직
+func (h *Handler) getEncryptionStatusHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.checkAdminPermission(c) {
+			return
+		}
+
+		status, err := h.credentialService.GetEncryptionStatus(c.Request.Context())
+		if err != nil {
+			h.HandleError(c, err, "get_credential_encryption_status")
+			return
+		}
+
+		h.OK(c, status, "Credential encryption status retrieved successfully")
+	}
+}
+
+// ReencryptCredentials: 최신 마스터 키로의 재암호화 작업 시작 요청을 처리합니다 (관리자 전용)
+func (h *Handler) ReencryptCredentials(c *gin.Context) {
+	handler := h.Compose(
+		h.reencryptCredentialsHandler(),
+		h.StandardCRUDDecorators("reencrypt_credentials")...,
+	)
+
+	handler(c)
+}
+
+// reencryptCredentialsHandler: 재암호화 작업을 백그라운드에서 시작하고 작업 상태를 반환합니다
+func (h *Handler) reencryptCredentialsHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.checkAdminPermission(c) {
+			return
+		}
+
+		adminID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "reencrypt_credentials")
+			return
+		}
+
+		batchSize := 0
+		if value := c.Query("batch_size"); value != "" {
+			batchSize, err = strconv.Atoi(value)
+			if err != nil || batchSize < 1 || batchSize > MaxReencryptBatchSize {
+				h.HandleError(c, domain.NewDomainError(domain.ErrCodeValidationFailed, "batch_size must be between 1 and 1000", 400), "reencrypt_credentials")
+				return
+			}
+		}
+
+		job, err := h.credentialService.StartReencryption(h.EnrichContextWithRequestMetadata(c), &adminID, batchSize)
+		if err != nil {
+			h.HandleError(c, err, "reencrypt_credentials")
+			return
+		}
+
+		h.LogInfo(c, "Credential re-encryption job started",
+			zap.String("operation", "reencrypt_credentials"),
+			zap.String("job_id", job.ID.String()),
+			zap.String("primary_key_id", job.Result.PrimaryKeyID),
+			zap.Int("batch_size", job.BatchSize))
+
+		h.Success(c, http.StatusAccepted, job, "Credential re-encryption job started")
+	}
+}
+
+// GetReencryptionJob: 재암호화 작업 상태 조회 요청을 처리합니다 (관리자 전용)
+func (h *Handler) GetReencryptionJob(c *gin.Context) {
+	handler := h.Compose(
+		h.getReencryptionJobHandler(),
+		h.StandardCRUDDecorators("get_reencryption_job")...,
+	)
+
+	handler(c)
+}
+
+// getReencryptionJobHandler: 재암호화 작업 상태 조회의 핵심 비즈니스 로직
+func (h *Handler) getReencryptionJobHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.checkAdminPermission(c) {
+			return
+		}
+
+		jobID, err := h.ExtractPathParam(c, "jobId")
+		if err != nil {
+			h.HandleError(c, err, "get_reencryption_job")
+			return
+		}
+
+		job, err := h.credentialService.GetReencryptionJob(c.Request.Context(), jobID)
+		if err != nil {
+			h.HandleError(c, err, "get_reencryption_job")
+			return
+		}
+
+		h.OK(c, job, "Credential re-encryption job retrieved successfully")
+	}
+}
+
+// checkAdminPermission: 관리자 권한을 확인합니다
+func (h *Handler) checkAdminPermission(c *gin.Context) bool {
+	userRole, err := h.GetUserRoleFromToken(c)
+	if err != nil {
+		h.HandleError(c, err, "check_admin_permission")
+		return false
+	}
+
+	if userRole != domain.AdminRoleType {
+		h.HandleError(c, domain.NewDomainError(
+			domain.ErrCodeForbidden,
+			"Insufficient permissions - admin role required",
+			http.StatusForbidden,
+		), "check_admin_permission")
+		return false
+	}
+
+	return true
+}
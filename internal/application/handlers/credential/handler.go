@@ -65,6 +65,11 @@ func (h *Handler) createCredentialHandler(req domain.CreateCredentialRequest) ha
 			return
 		}
 
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.CredentialWrite); err != nil {
+			h.HandleError(c, err, "create_credential")
+			return
+		}
+
 		h.logCredentialCreationAttempt(c, userID, req)
 
 		ctx := h.EnrichContextWithRequestMetadata(c)
@@ -111,6 +116,11 @@ func (h *Handler) getCredentialsHandler() handlers.HandlerFunc {
 			return
 		}
 
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.CredentialRead); err != nil {
+			h.HandleError(c, err, "get_credentials")
+			return
+		}
+
 		h.logCredentialsRequest(c, userID)
 
 		credentials, err := h.credentialService.GetCredentials(c.Request.Context(), workspaceID)
@@ -167,6 +177,11 @@ func (h *Handler) getCredentialHandler() handlers.HandlerFunc {
 			return
 		}
 
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.CredentialRead); err != nil {
+			h.HandleError(c, err, "get_credential")
+			return
+		}
+
 		h.logCredentialRequest(c, userID, credentialID)
 
 		credential, err := h.credentialService.GetCredentialByID(c.Request.Context(), workspaceID, credentialID)
@@ -225,6 +240,11 @@ func (h *Handler) updateCredentialHandler(req domain.UpdateCredentialRequest) ha
 			return
 		}
 
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.CredentialWrite); err != nil {
+			h.HandleError(c, err, "update_credential")
+			return
+		}
+
 		h.logCredentialUpdateAttempt(c, userID, credentialID)
 
 		ctx := h.EnrichContextWithRequestMetadata(c)
@@ -277,6 +297,11 @@ func (h *Handler) deleteCredentialHandler() handlers.HandlerFunc {
 			return
 		}
 
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.CredentialDelete); err != nil {
+			h.HandleError(c, err, "delete_credential")
+			return
+		}
+
 		h.logCredentialDeletionAttempt(c, userID, credentialID)
 
 		ctx := h.EnrichContextWithRequestMetadata(c)
@@ -355,6 +380,11 @@ func (h *Handler) createCredentialFromFileHandler() handlers.HandlerFunc {
 			return
 		}
 
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.CredentialWrite); err != nil {
+			h.HandleError(c, err, "create_credential_from_file")
+			return
+		}
+
 		h.logCredentialFromFileCreationAttempt(c, userID, formData)
 
 		credential, err := h.credentialService.CreateCredential(c.Request.Context(), workspaceID, userID, req)
@@ -0,0 +1,44 @@
+package credential
+
+import (
+	"skyclust/internal/domain"
+	"skyclust/internal/shared/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VerifyCredential: 자격증명 상태를 즉시 점검합니다 (데코레이터 패턴 사용)
+func (h *Handler) VerifyCredential(c *gin.Context) {
+	handler := h.Compose(
+		h.verifyCredentialHandler(),
+		h.StandardCRUDDecorators("verify_credential")...,
+	)
+
+	handler(c)
+}
+
+// verifyCredentialHandler: 자격증명 점검의 핵심 비즈니스 로직을 처리합니다
+func (h *Handler) verifyCredentialHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		credentialID, workspaceID, err := h.extractCredentialScope(c)
+		if err != nil {
+			h.HandleError(c, err, "verify_credential")
+			return
+		}
+
+		// 점검은 자격증명으로 프로바이더 API를 호출함
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.CredentialUse); err != nil {
+			h.HandleError(c, err, "verify_credential")
+			return
+		}
+
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		credential, err := h.credentialService.VerifyCredential(ctx, workspaceID, credentialID)
+		if err != nil {
+			h.HandleError(c, err, "verify_credential")
+			return
+		}
+
+		h.OK(c, credential, "Credential verified successfully")
+	}
+}
@@ -0,0 +1,120 @@
+package credential
+
+import (
+	"strconv"
+	"strings"
+
+	"skyclust/internal/domain"
+	"skyclust/internal/shared/handlers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// AnalyzeCredentialPermissions: 자격증명의 기능별 권한을 분석합니다 (데코레이터 패턴 사용)
+func (h *Handler) AnalyzeCredentialPermissions(c *gin.Context) {
+	handler := h.Compose(
+		h.analyzeCredentialPermissionsHandler(),
+		h.StandardCRUDDecorators("analyze_credential_permissions")...,
+	)
+
+	handler(c)
+}
+
+// analyzeCredentialPermissionsHandler: 권한 분석의 핵심 비즈니스 로직을 처리합니다
+func (h *Handler) analyzeCredentialPermissionsHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		credentialID, workspaceID, err := h.extractCredentialScope(c)
+		if err != nil {
+			h.HandleError(c, err, "analyze_credential_permissions")
+			return
+		}
+
+		// 분석은 자격증명으로 프로바이더 API를 호출함
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.CredentialUse); err != nil {
+			h.HandleError(c, err, "analyze_credential_permissions")
+			return
+		}
+
+		report, err := h.credentialService.AnalyzeCredentialPermissions(c.Request.Context(), workspaceID, credentialID)
+		if err != nil {
+			h.HandleError(c, err, "analyze_credential_permissions")
+			return
+		}
+
+		h.OK(c, report, "Credential permissions analyzed successfully")
+	}
+}
+
+// GenerateCredentialPolicy: 최소 권한 정책 문서를 생성합니다 (데코레이터 패턴 사용)
+func (h *Handler) GenerateCredentialPolicy(c *gin.Context) {
+	handler := h.Compose(
+		h.generateCredentialPolicyHandler(),
+		h.StandardCRUDDecorators("generate_credential_policy")...,
+	)
+
+	handler(c)
+}
+
+// generateCredentialPolicyHandler: 정책 생성의 핵심 비즈니스 로직을 처리합니다
+func (h *Handler) generateCredentialPolicyHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		credentialID, workspaceID, err := h.extractCredentialScope(c)
+		if err != nil {
+			h.HandleError(c, err, "generate_credential_policy")
+			return
+		}
+
+		missingOnly := false
+		if value := c.Query("missing_only"); value != "" {
+			missingOnly, err = strconv.ParseBool(value)
+			if err != nil {
+				h.HandleError(c, domain.NewDomainError(domain.ErrCodeBadRequest, "missing_only must be a boolean", 400), "generate_credential_policy")
+				return
+			}
+		}
+
+		// missing_only는 현재 권한을 분석하므로 프로바이더 API 호출 권한이 필요함
+		permission := domain.CredentialRead
+		if missingOnly {
+			permission = domain.CredentialUse
+		}
+		if err := h.RequireWorkspacePermission(c, workspaceID, permission); err != nil {
+			h.HandleError(c, err, "generate_credential_policy")
+			return
+		}
+
+		var features []string
+		if value := c.Query("features"); value != "" {
+			features = strings.Split(value, ",")
+		}
+
+		policy, err := h.credentialService.GenerateCredentialPolicy(c.Request.Context(), workspaceID, credentialID, features, missingOnly)
+		if err != nil {
+			h.HandleError(c, err, "generate_credential_policy")
+			return
+		}
+
+		h.OK(c, policy, "Credential policy generated successfully")
+	}
+}
+
+// extractCredentialScope: 경로의 자격증명 ID와 workspace_id 쿼리 파라미터를 추출합니다
+func (h *Handler) extractCredentialScope(c *gin.Context) (uuid.UUID, uuid.UUID, error) {
+	credentialID, err := h.ExtractPathParam(c, "id")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	workspaceIDStr, err := h.ExtractRequiredQueryParam(c, "workspace_id")
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	workspaceID, err := uuid.Parse(workspaceIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, domain.NewDomainError(domain.ErrCodeBadRequest, "Invalid workspace ID format", 400)
+	}
+
+	return credentialID, workspaceID, nil
+}
@@ -17,6 +17,24 @@ func SetupRoutes(router *gin.RouterGroup, credentialService domain.CredentialSer
 	router.PUT("/:id", credentialHandler.UpdateCredential)    // PUT /api/v1/credentials/:id
 	router.DELETE("/:id", credentialHandler.DeleteCredential) // DELETE /api/v1/credentials/:id
 
+	// Health check route
+	router.POST("/:id/verify", credentialHandler.VerifyCredential) // POST /api/v1/credentials/:id/verify?workspace_id=
+
+	// Least-privilege analysis routes
+	router.GET("/:id/permissions", credentialHandler.AnalyzeCredentialPermissions)    // GET /api/v1/credentials/:id/permissions?workspace_id=
+	router.GET("/:id/permissions/policy", credentialHandler.GenerateCredentialPolicy) // GET /api/v1/credentials/:id/permissions/policy?workspace_id=&features=&missing_only=
+	router.POST("/:id/vend", credentialHandler.VendCredential)                        // POST /api/v1/credentials/:id/vend?workspace_id=
+
 	// File upload route (special case for multipart/form-data)
 	router.POST("/upload", credentialHandler.CreateCredentialFromFile) // POST /api/v1/credentials/upload
 }
+
+// SetupAdminRoutes sets up credential encryption key management routes (admin only)
+// router is scoped to /api/v1/admin/credentials
+func SetupAdminRoutes(router *gin.RouterGroup, credentialService domain.CredentialService) {
+	credentialHandler := NewHandler(credentialService)
+
+	router.GET("/encryption", credentialHandler.GetEncryptionStatus)      // GET /api/v1/admin/credentials/encryption
+	router.POST("/reencrypt", credentialHandler.ReencryptCredentials)     // POST /api/v1/admin/credentials/reencrypt?batch_size=100
+	router.GET("/reencrypt/:jobId", credentialHandler.GetReencryptionJob) // GET /api/v1/admin/credentials/reencrypt/:jobId
+}
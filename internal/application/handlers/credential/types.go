@@ -2,6 +2,9 @@ package credential
 
 import "time"
 
+// MaxReencryptBatchSize is the largest batch size accepted by the re-encryption endpoint
+const MaxReencryptBatchSize = 1000
+
 // CreateCredentialRequest represents a credential creation request
 type CreateCredentialRequest struct {
 	Provider string                 `json:"provider" validate:"required,oneof=aws gcp openstack azure"`
@@ -0,0 +1,163 @@
+package credential
+
+import (
+	"fmt"
+
+	"skyclust/internal/domain"
+	"skyclust/internal/shared/handlers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// vendableFeatures: 기능 미지정 시 발급 대상이 되는 기능 목록
+var vendableFeatures = []string{
+	domain.CredentialFeatureKubernetes,
+	domain.CredentialFeatureNetwork,
+	domain.CredentialFeatureCost,
+}
+
+// VendCredential: 최종 사용자용 단기 자격증명을 발급합니다 (데코레이터 패턴 사용)
+func (h *Handler) VendCredential(c *gin.Context) {
+	handler := h.Compose(
+		h.vendCredentialHandler(),
+		h.StandardCRUDDecorators("vend_credential")...,
+	)
+
+	handler(c)
+}
+
+// vendCredentialHandler: 단기 자격증명 발급의 핵심 비즈니스 로직을 처리합니다
+func (h *Handler) vendCredentialHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		credentialID, workspaceID, err := h.extractCredentialScope(c)
+		if err != nil {
+			h.HandleError(c, err, "vend_credential")
+			return
+		}
+
+		var req domain.CredentialVendRequest
+		if c.Request.ContentLength != 0 {
+			if err := h.ValidateRequest(c, &req); err != nil {
+				h.HandleError(c, err, "vend_credential")
+				return
+			}
+		}
+		if req.Access == "" {
+			req.Access = domain.VendAccessReadOnly
+		}
+
+		requesterID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "vend_credential")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.CredentialUse); err != nil {
+			h.HandleError(c, err, "vend_credential")
+			return
+		}
+
+		// 요청자의 기능 권한 범위를 넘는 자격증명은 발급하지 않음
+		features, err := h.authorizeVendFeatures(c, workspaceID, req.Access, req.Features)
+		if err != nil {
+			h.HandleError(c, err, "vend_credential")
+			return
+		}
+		req.Features = features
+
+		if err := h.authorizeVendAccess(c, workspaceID, credentialID, req.Access); err != nil {
+			h.HandleError(c, err, "vend_credential")
+			return
+		}
+
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		vended, err := h.credentialService.VendCredential(ctx, workspaceID, credentialID, requesterID, req)
+		if err != nil {
+			h.HandleError(c, err, "vend_credential")
+			return
+		}
+
+		c.Header("Cache-Control", "no-store")
+		h.OK(c, vended, "Credential vended successfully")
+	}
+}
+
+// authorizeVendFeatures: 요청자가 가진 워크스페이스 권한으로 발급 가능한 기능을 결정합니다
+// 명시한 기능에 권한이 없으면 거부하고, 미지정 시 권한이 있는 기능만 포함합니다
+func (h *Handler) authorizeVendFeatures(c *gin.Context, workspaceID uuid.UUID, access string, requested []string) ([]string, error) {
+	explicit := len(requested) > 0
+	candidates := requested
+	if !explicit {
+		candidates = vendableFeatures
+	}
+
+	var allowed []string
+	for _, feature := range candidates {
+		if !isVendableFeature(feature) {
+			return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, fmt.Sprintf("unknown feature: %s", feature), 400)
+		}
+		if err := h.RequireWorkspacePermission(c, workspaceID, vendFeaturePermission(feature, access)); err != nil {
+			if domain.GetDomainError(err).StatusCode != 403 {
+				return nil, err
+			}
+			if explicit {
+				return nil, domain.NewDomainError(domain.ErrCodeForbidden,
+					fmt.Sprintf("you are not allowed to vend %s access for feature %s", access, feature), 403).
+					WithDetails("feature", feature)
+			}
+			continue
+		}
+		allowed = append(allowed, feature)
+	}
+
+	if len(allowed) == 0 {
+		return nil, domain.NewDomainError(domain.ErrCodeForbidden, "you are not allowed to vend credentials for any feature", 403)
+	}
+	return allowed, nil
+}
+
+// authorizeVendAccess: 기능 단위로 범위를 줄일 수 없는 자격증명의 full 발급은 자격증명 관리 권한을 요구합니다
+// GCP의 full 토큰은 cloud-platform 범위로 서비스 계정의 모든 권한을 가지므로 기능 권한만으로는 발급하지 않습니다
+func (h *Handler) authorizeVendAccess(c *gin.Context, workspaceID, credentialID uuid.UUID, access string) error {
+	if access != domain.VendAccessFull {
+		return nil
+	}
+
+	credential, err := h.credentialService.GetCredentialByID(c.Request.Context(), workspaceID, credentialID)
+	if err != nil {
+		return err
+	}
+	if credential.Provider != domain.ProviderGCP {
+		return nil
+	}
+
+	if err := h.RequireWorkspacePermission(c, workspaceID, domain.CredentialWrite); err != nil {
+		if domain.GetDomainError(err).StatusCode != 403 {
+			return err
+		}
+		return domain.NewDomainError(domain.ErrCodeForbidden,
+			fmt.Sprintf("full access for gcp credentials carries every permission of the service account and requires %s; request read_only instead", domain.CredentialWrite), 403).
+			WithDetails("required_permission", string(domain.CredentialWrite))
+	}
+	return nil
+}
+
+// vendFeaturePermission: 기능과 권한 수준에 대응하는 워크스페이스 권한을 반환합니다
+// cost는 조회 전용 기능이므로 항상 읽기 권한을 요구합니다
+func vendFeaturePermission(feature, access string) domain.Permission {
+	if feature == domain.CredentialFeatureCost || access == domain.VendAccessReadOnly {
+		return domain.WorkspaceActionPermission(feature, "GET")
+	}
+	return domain.WorkspaceActionPermission(feature, "POST")
+}
+
+// isVendableFeature: 발급 가능한 기능인지 확인합니다
+func isVendableFeature(feature string) bool {
+	for _, vendable := range vendableFeatures {
+		if feature == vendable {
+			return true
+		}
+	}
+	return false
+}
@@ -43,6 +43,12 @@ func (h *Handler) getDashboardSummaryHandler() handlers.HandlerFunc {
 			return
 		}
 
+		// 워크스페이스 조회 권한 확인
+		if err := h.RequireWorkspacePermissionFromString(c, workspaceID, domain.WorkspaceRead); err != nil {
+			h.HandleError(c, err, "get_dashboard_summary")
+			return
+		}
+
 		// 자격 증명 ID 조회 (선택)
 		credentialID := c.Query("credential_id")
 		var credentialIDPtr *string
@@ -0,0 +1,264 @@
+package dead_letter
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"skyclust/internal/domain"
+	"skyclust/internal/infrastructure/messaging"
+	"skyclust/internal/shared/handlers"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// Handler: dead-letter 스트림의 이벤트 조회/재처리/삭제를 처리하는 핸들러 (관리자 전용)
+type Handler struct {
+	*handlers.BaseHandler
+	deadLetterQueue messaging.DeadLetterQueue
+}
+
+// NewHandler: 새로운 dead-letter 핸들러를 생성합니다
+func NewHandler(deadLetterQueue messaging.DeadLetterQueue) *Handler {
+	return &Handler{
+		BaseHandler:     handlers.NewBaseHandler("dead_letter"),
+		deadLetterQueue: deadLetterQueue,
+	}
+}
+
+// ListDeadLetters: dead-letter 이벤트 목록 조회 요청을 처리합니다
+func (h *Handler) ListDeadLetters(c *gin.Context) {
+	handler := h.Compose(
+		h.listDeadLettersHandler(),
+		h.StandardCRUDDecorators("list_dead_letters")...,
+	)
+
+	handler(c)
+}
+
+// listDeadLettersHandler: dead-letter 이벤트 목록 조회의 핵심 비즈니스 로직
+func (h *Handler) listDeadLettersHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.checkAdminPermission(c) {
+			return
+		}
+
+		filter := messaging.DeadLetterFilter{
+			Consumer: c.Query("consumer"),
+			Limit:    DefaultListLimit,
+		}
+		if value := c.Query("after"); value != "" {
+			after, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				h.HandleError(c, domain.NewDomainError(domain.ErrCodeBadRequest, "after must be a sequence number", http.StatusBadRequest), "list_dead_letters")
+				return
+			}
+			filter.AfterSequence = after
+		}
+		if value := c.Query("limit"); value != "" {
+			limit, err := strconv.Atoi(value)
+			if err != nil || limit < 1 {
+				h.HandleError(c, domain.NewDomainError(domain.ErrCodeBadRequest, "limit must be a positive integer", http.StatusBadRequest), "list_dead_letters")
+				return
+			}
+			filter.Limit = limit
+		}
+		if filter.Limit > MaxListLimit {
+			filter.Limit = MaxListLimit
+		}
+
+		deadLetters, err := h.deadLetterQueue.ListDeadLetters(c.Request.Context(), filter)
+		if err != nil {
+			h.HandleError(c, toDomainError(err, "failed to list dead letters"), "list_dead_letters")
+			return
+		}
+
+		// 다음 페이지는 마지막 시퀀스를 after로 전달해 조회
+		var next uint64
+		if len(deadLetters) == filter.Limit {
+			next = deadLetters[len(deadLetters)-1].Sequence
+		}
+
+		h.OK(c, gin.H{
+			"dead_letters": deadLetters,
+			"total":        len(deadLetters),
+			"next_after":   next,
+		}, "Dead letters retrieved successfully")
+	}
+}
+
+// GetDeadLetter: dead-letter 이벤트 조회 요청을 처리합니다
+func (h *Handler) GetDeadLetter(c *gin.Context) {
+	handler := h.Compose(
+		h.getDeadLetterHandler(),
+		h.StandardCRUDDecorators("get_dead_letter")...,
+	)
+
+	handler(c)
+}
+
+// getDeadLetterHandler: dead-letter 이벤트 조회의 핵심 비즈니스 로직
+func (h *Handler) getDeadLetterHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.checkAdminPermission(c) {
+			return
+		}
+
+		sequence, ok := h.parseSequence(c, "get_dead_letter")
+		if !ok {
+			return
+		}
+
+		deadLetter, err := h.deadLetterQueue.GetDeadLetter(c.Request.Context(), sequence)
+		if err != nil {
+			h.HandleError(c, toDomainError(err, "failed to get dead letter"), "get_dead_letter")
+			return
+		}
+		if deadLetter == nil {
+			h.HandleError(c, toDomainError(messaging.ErrDeadLetterNotFound, ""), "get_dead_letter")
+			return
+		}
+
+		h.OK(c, deadLetter, "Dead letter retrieved successfully")
+	}
+}
+
+// ReplayDeadLetter: dead-letter 이벤트를 원래 subject로 다시 발행하는 요청을 처리합니다
+func (h *Handler) ReplayDeadLetter(c *gin.Context) {
+	handler := h.Compose(
+		h.replayDeadLetterHandler(),
+		h.StandardCRUDDecorators("replay_dead_letter")...,
+	)
+
+	handler(c)
+}
+
+// replayDeadLetterHandler: dead-letter 이벤트 재처리의 핵심 비즈니스 로직
+func (h *Handler) replayDeadLetterHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.checkAdminPermission(c) {
+			return
+		}
+
+		sequence, ok := h.parseSequence(c, "replay_dead_letter")
+		if !ok {
+			return
+		}
+
+		if err := h.deadLetterQueue.ReplayDeadLetter(c.Request.Context(), sequence); err != nil {
+			h.HandleError(c, toDomainError(err, "failed to replay dead letter"), "replay_dead_letter")
+			return
+		}
+
+		h.LogInfo(c, "Dead letter replayed",
+			zap.String("operation", "replay_dead_letter"),
+			zap.Uint64("sequence", sequence))
+
+		h.OK(c, gin.H{"sequence": sequence}, "Dead letter replayed successfully")
+	}
+}
+
+// DeleteDeadLetter: dead-letter 이벤트 삭제 요청을 처리합니다
+func (h *Handler) DeleteDeadLetter(c *gin.Context) {
+	handler := h.Compose(
+		h.deleteDeadLetterHandler(),
+		h.StandardCRUDDecorators("delete_dead_letter")...,
+	)
+
+	handler(c)
+}
+
+// deleteDeadLetterHandler: dead-letter 이벤트 삭제의 핵심 비즈니스 로직
+func (h *Handler) deleteDeadLetterHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.checkAdminPermission(c) {
+			return
+		}
+
+		sequence, ok := h.parseSequence(c, "delete_dead_letter")
+		if !ok {
+			return
+		}
+
+		if err := h.deadLetterQueue.DeleteDeadLetter(c.Request.Context(), sequence); err != nil {
+			h.HandleError(c, toDomainError(err, "failed to delete dead letter"), "delete_dead_letter")
+			return
+		}
+
+		h.OK(c, gin.H{"sequence": sequence}, "Dead letter deleted successfully")
+	}
+}
+
+// PurgeDeadLetters: dead-letter 이벤트 전체(또는 consumer별) 삭제 요청을 처리합니다
+func (h *Handler) PurgeDeadLetters(c *gin.Context) {
+	handler := h.Compose(
+		h.purgeDeadLettersHandler(),
+		h.StandardCRUDDecorators("purge_dead_letters")...,
+	)
+
+	handler(c)
+}
+
+// purgeDeadLettersHandler: dead-letter 이벤트 일괄 삭제의 핵심 비즈니스 로직
+func (h *Handler) purgeDeadLettersHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.checkAdminPermission(c) {
+			return
+		}
+
+		consumer := c.Query("consumer")
+		if err := h.deadLetterQueue.PurgeDeadLetters(c.Request.Context(), consumer); err != nil {
+			h.HandleError(c, toDomainError(err, "failed to purge dead letters"), "purge_dead_letters")
+			return
+		}
+
+		h.LogWarn(c, "Dead letters purged",
+			zap.String("operation", "purge_dead_letters"),
+			zap.String("consumer", consumer))
+
+		h.OK(c, gin.H{"consumer": consumer}, "Dead letters purged successfully")
+	}
+}
+
+// parseSequence: 경로의 dead-letter 시퀀스 번호를 파싱합니다
+func (h *Handler) parseSequence(c *gin.Context, operation string) (uint64, bool) {
+	sequence, err := strconv.ParseUint(c.Param("sequence"), 10, 64)
+	if err != nil || sequence == 0 {
+		h.HandleError(c, domain.NewDomainError(domain.ErrCodeBadRequest, "sequence must be a positive integer", http.StatusBadRequest), operation)
+		return 0, false
+	}
+	return sequence, true
+}
+
+// checkAdminPermission: 관리자 권한을 확인합니다
+func (h *Handler) checkAdminPermission(c *gin.Context) bool {
+	userRole, err := h.GetUserRoleFromToken(c)
+	if err != nil {
+		h.HandleError(c, err, "check_admin_permission")
+		return false
+	}
+
+	if userRole != domain.AdminRoleType {
+		h.HandleError(c, domain.NewDomainError(
+			domain.ErrCodeForbidden,
+			"Insufficient permissions - admin role required",
+			http.StatusForbidden,
+		), "check_admin_permission")
+		return false
+	}
+
+	return true
+}
+
+// toDomainError: 메시징 오류를 API 오류로 변환합니다
+func toDomainError(err error, message string) error {
+	switch {
+	case errors.Is(err, messaging.ErrDeadLetterNotFound):
+		return domain.NewDomainError(domain.ErrCodeNotFound, "dead letter not found", http.StatusNotFound)
+	case errors.Is(err, messaging.ErrJetStreamDisabled):
+		return domain.NewDomainError(domain.ErrCodeServiceUnavailable, "JetStream is not enabled", http.StatusServiceUnavailable)
+	default:
+		return domain.NewDomainError(domain.ErrCodeInternalError, message, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,18 @@
+package dead_letter
+
+import (
+	"skyclust/internal/infrastructure/messaging"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupRoutes sets up dead-lettered event management routes (admin)
+func SetupRoutes(router *gin.RouterGroup, deadLetterQueue messaging.DeadLetterQueue) {
+	deadLetterHandler := NewHandler(deadLetterQueue)
+
+	router.GET("", deadLetterHandler.ListDeadLetters)                    // GET /api/v1/admin/messaging/dead-letters?consumer=&after=&limit=
+	router.DELETE("", deadLetterHandler.PurgeDeadLetters)                // DELETE /api/v1/admin/messaging/dead-letters?consumer=
+	router.GET("/:sequence", deadLetterHandler.GetDeadLetter)            // GET /api/v1/admin/messaging/dead-letters/:sequence
+	router.POST("/:sequence/replay", deadLetterHandler.ReplayDeadLetter) // POST /api/v1/admin/messaging/dead-letters/:sequence/replay
+	router.DELETE("/:sequence", deadLetterHandler.DeleteDeadLetter)      // DELETE /api/v1/admin/messaging/dead-letters/:sequence
+}
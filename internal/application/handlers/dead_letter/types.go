@@ -0,0 +1,7 @@
+package dead_letter
+
+// Dead-letter listing limits
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 500
+)
@@ -136,6 +136,14 @@ func (h *Handler) exportDataHandler(req gin.H) handlers.HandlerFunc {
 			return
 		}
 
+		// Workspace-scoped exports require read access to the workspace
+		if exportReq.WorkspaceID != "" {
+			if err := h.RequireWorkspacePermissionFromString(c, exportReq.WorkspaceID, domain.WorkspaceRead); err != nil {
+				h.HandleError(c, err, "export_data")
+				return
+			}
+		}
+
 		h.logExportDataAttempt(c, userID, gin.H{
 			"type":   exportReq.Type,
 			"format": exportReq.Format,
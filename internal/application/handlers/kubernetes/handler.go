@@ -24,7 +24,7 @@ type Handler struct {
 // NewHandler creates a new Kubernetes handler for a specific provider
 func NewHandler(k8sService *kubernetesservice.Service, credentialService domain.CredentialService, provider string) *Handler {
 	return &Handler{
-		BaseHandler:       handlers.NewBaseHandler("kubernetes"),
+		BaseHandler:       handlers.NewBaseHandler("kubernetes").WithWorkspaceResource(domain.PermissionResourceKubernetes),
 		k8sService:        k8sService,
 		credentialService: credentialService,
 		provider:          provider,
@@ -356,7 +356,7 @@ func (h *Handler) createNodeGroupHandler(req kubernetesservice.CreateNodeGroupRe
 			return
 		}
 
-		nodeGroup, err := h.k8sService.CreateEKSNodeGroup(c.Request.Context(), credential, req)
+		nodeGroup, err := h.k8sService.CreateEKSNodeGroup(h.EnrichContextWithRequestMetadata(c), credential, req)
 		if err != nil {
 			h.HandleError(c, err, "create_node_group")
 			return
@@ -494,7 +494,7 @@ func (h *Handler) DeleteNodeGroup(c *gin.Context) {
 		Region:        req.Region,
 	}
 
-	if err := h.k8sService.DeleteNodeGroup(c.Request.Context(), credential, deleteReq); err != nil {
+	if err := h.k8sService.DeleteNodeGroup(h.EnrichContextWithRequestMetadata(c), credential, deleteReq); err != nil {
 		h.HandleError(c, err, "delete_node_group")
 		return
 	}
@@ -26,7 +26,7 @@ func NewBaseHandler(
 	handlerName string,
 ) *BaseHandler {
 	return &BaseHandler{
-		BaseHandler:       handlers.NewBaseHandler(handlerName),
+		BaseHandler:       handlers.NewBaseHandler(handlerName).WithWorkspaceResource(domain.PermissionResourceKubernetes),
 		k8sService:        k8sService,
 		credentialService: credentialService,
 		provider:          provider,
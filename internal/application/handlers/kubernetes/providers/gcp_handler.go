@@ -321,7 +321,7 @@ func (h *GCPHandler) ScaleNodePool(c *gin.Context) {
 		DesiredSize:  req.DesiredSize,
 	}
 
-	_, err = h.k8sService.CreateEKSNodePool(c.Request.Context(), credential, scaleReq)
+	_, err = h.k8sService.CreateEKSNodePool(h.EnrichContextWithRequestMetadata(c), credential, scaleReq)
 	if err != nil {
 		h.HandleError(c, err, "scale_node_pool")
 		return
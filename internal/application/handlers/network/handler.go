@@ -22,7 +22,7 @@ type Handler struct {
 // NewHandler creates a new network handler
 func NewHandler(networkService *networkservice.Service, credentialService domain.CredentialService, provider string) *Handler {
 	return &Handler{
-		BaseHandler:       handlers.NewBaseHandler("network"),
+		BaseHandler:       handlers.NewBaseHandler("network").WithWorkspaceResource(domain.PermissionResourceNetwork),
 		networkService:    networkService,
 		credentialService: credentialService,
 		provider:          provider,
@@ -874,7 +874,7 @@ func (h *Handler) addSecurityGroupRuleHandler(req AddSecurityGroupRuleRequest) h
 		serviceReq := ToServiceAddSecurityGroupRuleRequest(req, credential.ID.String())
 		h.logSecurityGroupRuleAdditionAttempt(c, userID, serviceReq)
 
-		result, err := h.networkService.AddSecurityGroupRule(c.Request.Context(), credential, serviceReq)
+		result, err := h.networkService.AddSecurityGroupRule(h.EnrichContextWithRequestMetadata(c), credential, serviceReq)
 		if err != nil {
 			h.HandleError(c, err, "add_security_group_rule")
 			return
@@ -922,7 +922,7 @@ func (h *Handler) removeSecurityGroupRuleHandler(req RemoveSecurityGroupRuleRequ
 		serviceReq := ToServiceRemoveSecurityGroupRuleRequest(req, credential.ID.String())
 		h.logSecurityGroupRuleRemovalAttempt(c, userID, serviceReq)
 
-		result, err := h.networkService.RemoveSecurityGroupRule(c.Request.Context(), credential, serviceReq)
+		result, err := h.networkService.RemoveSecurityGroupRule(h.EnrichContextWithRequestMetadata(c), credential, serviceReq)
 		if err != nil {
 			h.HandleError(c, err, "remove_security_group_rule")
 			return
@@ -969,7 +969,7 @@ func (h *Handler) updateSecurityGroupRulesHandler(req UpdateSecurityGroupRulesRe
 		serviceReq := ToServiceUpdateSecurityGroupRulesRequest(req, credential.ID.String())
 		h.logSecurityGroupRulesUpdateAttempt(c, userID, serviceReq)
 
-		result, err := h.networkService.UpdateSecurityGroupRules(c.Request.Context(), credential, serviceReq)
+		result, err := h.networkService.UpdateSecurityGroupRules(h.EnrichContextWithRequestMetadata(c), credential, serviceReq)
 		if err != nil {
 			h.HandleError(c, err, "update_security_group_rules")
 			return
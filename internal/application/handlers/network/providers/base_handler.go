@@ -26,7 +26,7 @@ func NewBaseHandler(
 	handlerName string,
 ) *BaseHandler {
 	return &BaseHandler{
-		BaseHandler:       handlers.NewBaseHandler(handlerName),
+		BaseHandler:       handlers.NewBaseHandler(handlerName).WithWorkspaceResource(domain.PermissionResourceNetwork),
 		networkService:    networkService,
 		credentialService: credentialService,
 		provider:          provider,
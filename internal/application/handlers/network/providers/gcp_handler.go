@@ -493,7 +493,7 @@ func (h *GCPHandler) AddSecurityGroupRule(c *gin.Context) {
 
 	req.CredentialID = credential.ID.String()
 
-	result, err := h.networkService.AddSecurityGroupRule(c.Request.Context(), credential, req)
+	result, err := h.networkService.AddSecurityGroupRule(h.EnrichContextWithRequestMetadata(c), credential, req)
 	if err != nil {
 		h.HandleError(c, err, "add_security_group_rule")
 		return
@@ -518,7 +518,7 @@ func (h *GCPHandler) RemoveSecurityGroupRule(c *gin.Context) {
 
 	req.CredentialID = credential.ID.String()
 
-	result, err := h.networkService.RemoveSecurityGroupRule(c.Request.Context(), credential, req)
+	result, err := h.networkService.RemoveSecurityGroupRule(h.EnrichContextWithRequestMetadata(c), credential, req)
 	if err != nil {
 		h.HandleError(c, err, "remove_security_group_rule")
 		return
@@ -543,7 +543,7 @@ func (h *GCPHandler) UpdateSecurityGroupRules(c *gin.Context) {
 
 	req.CredentialID = credential.ID.String()
 
-	result, err := h.networkService.UpdateSecurityGroupRules(c.Request.Context(), credential, req)
+	result, err := h.networkService.UpdateSecurityGroupRules(h.EnrichContextWithRequestMetadata(c), credential, req)
 	if err != nil {
 		h.HandleError(c, err, "update_security_group_rules")
 		return
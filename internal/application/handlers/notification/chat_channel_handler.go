@@ -0,0 +1,453 @@
+package notification
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+	"io"
+	"net/http"
+	"skyclust/internal/domain"
+	"skyclust/internal/shared/handlers"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxSlackInteractionBody: Slack 인터랙션 요청 본문 최대 크기
+const maxSlackInteractionBody = 64 * 1024
+
+// ChatHandler: 워크스페이스 채팅 채널 및 확인 콜백 HTTP 요청을 처리하는 핸들러
+type ChatHandler struct {
+	*handlers.BaseHandler
+	chatService domain.ChatChannelService
+}
+
+// NewChatHandler: 새로운 채팅 채널 핸들러를 생성합니다
+func NewChatHandler(chatService domain.ChatChannelService) *ChatHandler {
+	return &ChatHandler{
+		BaseHandler: handlers.NewBaseHandler("chat_channel"),
+		chatService: chatService,
+	}
+}
+
+// ListChannels: 워크스페이스 채팅 채널 목록 조회 요청을 처리합니다
+func (h *ChatHandler) ListChannels(c *gin.Context) {
+	handler := h.Compose(
+		h.listChannelsHandler(),
+		h.StandardCRUDDecorators("list_chat_channels")...,
+	)
+
+	handler(c)
+}
+
+// listChannelsHandler: 워크스페이스 채팅 채널 목록 조회의 핵심 비즈니스 로직
+func (h *ChatHandler) listChannelsHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "list_chat_channels")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceNotifications); err != nil {
+			h.HandleError(c, err, "list_chat_channels")
+			return
+		}
+
+		channels, err := h.chatService.ListChannels(c.Request.Context(), workspaceID)
+		if err != nil {
+			h.HandleError(c, err, "list_chat_channels")
+			return
+		}
+
+		h.OK(c, ChatChannelListResponse{Channels: channels, Total: len(channels)}, "Chat channels retrieved successfully")
+	}
+}
+
+// GetChannel: 워크스페이스 채팅 채널 조회 요청을 처리합니다
+func (h *ChatHandler) GetChannel(c *gin.Context) {
+	handler := h.Compose(
+		h.getChannelHandler(),
+		h.StandardCRUDDecorators("get_chat_channel")...,
+	)
+
+	handler(c)
+}
+
+// getChannelHandler: 워크스페이스 채팅 채널 조회의 핵심 비즈니스 로직
+func (h *ChatHandler) getChannelHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "get_chat_channel")
+			return
+		}
+		channelID, err := h.ExtractPathParam(c, "channelId")
+		if err != nil {
+			h.HandleError(c, err, "get_chat_channel")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceNotifications); err != nil {
+			h.HandleError(c, err, "get_chat_channel")
+			return
+		}
+
+		channel, err := h.chatService.GetChannel(c.Request.Context(), workspaceID, channelID)
+		if err != nil {
+			h.HandleError(c, err, "get_chat_channel")
+			return
+		}
+
+		h.OK(c, channel, "Chat channel retrieved successfully")
+	}
+}
+
+// CreateChannel: 워크스페이스 채팅 채널 생성 요청을 처리합니다
+func (h *ChatHandler) CreateChannel(c *gin.Context) {
+	handler := h.Compose(
+		h.createChannelHandler(),
+		h.StandardCRUDDecorators("create_chat_channel")...,
+	)
+
+	handler(c)
+}
+
+// createChannelHandler: 워크스페이스 채팅 채널 생성의 핵심 비즈니스 로직
+func (h *ChatHandler) createChannelHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "create_chat_channel")
+			return
+		}
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "create_chat_channel")
+			return
+		}
+
+		var req domain.CreateChatChannelRequest
+		if err := h.ExtractValidatedRequest(c, &req); err != nil {
+			h.HandleError(c, err, "create_chat_channel")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceNotifications); err != nil {
+			h.HandleError(c, err, "create_chat_channel")
+			return
+		}
+
+		channel, err := h.chatService.CreateChannel(ctx, userID, workspaceID, req)
+		if err != nil {
+			h.HandleError(c, err, "create_chat_channel")
+			return
+		}
+
+		h.LogBusinessEvent(c, "chat_channel_created", userID.String(), workspaceID.String(), map[string]interface{}{
+			"channel_id": channel.ID.String(),
+			"type":       channel.Type,
+			"mode":       channel.Mode,
+		})
+
+		h.Created(c, channel, "Chat channel created successfully")
+	}
+}
+
+// UpdateChannel: 워크스페이스 채팅 채널 수정 요청을 처리합니다
+func (h *ChatHandler) UpdateChannel(c *gin.Context) {
+	handler := h.Compose(
+		h.updateChannelHandler(),
+		h.StandardCRUDDecorators("update_chat_channel")...,
+	)
+
+	handler(c)
+}
+
+// updateChannelHandler: 워크스페이스 채팅 채널 수정의 핵심 비즈니스 로직
+func (h *ChatHandler) updateChannelHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "update_chat_channel")
+			return
+		}
+		channelID, err := h.ExtractPathParam(c, "channelId")
+		if err != nil {
+			h.HandleError(c, err, "update_chat_channel")
+			return
+		}
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "update_chat_channel")
+			return
+		}
+
+		var req domain.UpdateChatChannelRequest
+		if err := h.ExtractValidatedRequest(c, &req); err != nil {
+			h.HandleError(c, err, "update_chat_channel")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceNotifications); err != nil {
+			h.HandleError(c, err, "update_chat_channel")
+			return
+		}
+
+		channel, err := h.chatService.UpdateChannel(ctx, userID, workspaceID, channelID, req)
+		if err != nil {
+			h.HandleError(c, err, "update_chat_channel")
+			return
+		}
+
+		h.LogBusinessEvent(c, "chat_channel_updated", userID.String(), workspaceID.String(), map[string]interface{}{
+			"channel_id": channel.ID.String(),
+			"enabled":    channel.Enabled,
+		})
+
+		h.OK(c, channel, "Chat channel updated successfully")
+	}
+}
+
+// DeleteChannel: 워크스페이스 채팅 채널 삭제 요청을 처리합니다
+func (h *ChatHandler) DeleteChannel(c *gin.Context) {
+	handler := h.Compose(
+		h.deleteChannelHandler(),
+		h.StandardCRUDDecorators("delete_chat_channel")...,
+	)
+
+	handler(c)
+}
+
+// deleteChannelHandler: 워크스페이스 채팅 채널 삭제의 핵심 비즈니스 로직
+func (h *ChatHandler) deleteChannelHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "delete_chat_channel")
+			return
+		}
+		channelID, err := h.ExtractPathParam(c, "channelId")
+		if err != nil {
+			h.HandleError(c, err, "delete_chat_channel")
+			return
+		}
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "delete_chat_channel")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceNotifications); err != nil {
+			h.HandleError(c, err, "delete_chat_channel")
+			return
+		}
+
+		if err := h.chatService.DeleteChannel(ctx, userID, workspaceID, channelID); err != nil {
+			h.HandleError(c, err, "delete_chat_channel")
+			return
+		}
+
+		h.LogBusinessEvent(c, "chat_channel_deleted", userID.String(), workspaceID.String(), map[string]interface{}{
+			"channel_id": channelID.String(),
+		})
+
+		h.OK(c, gin.H{"id": channelID.String()}, "Chat channel deleted successfully")
+	}
+}
+
+// TestChannel: 채팅 채널 테스트 메시지 전송 요청을 처리합니다
+func (h *ChatHandler) TestChannel(c *gin.Context) {
+	handler := h.Compose(
+		h.testChannelHandler(),
+		h.StandardCRUDDecorators("test_chat_channel")...,
+	)
+
+	handler(c)
+}
+
+// testChannelHandler: 채팅 채널 테스트 메시지 전송의 핵심 비즈니스 로직
+func (h *ChatHandler) testChannelHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "test_chat_channel")
+			return
+		}
+		channelID, err := h.ExtractPathParam(c, "channelId")
+		if err != nil {
+			h.HandleError(c, err, "test_chat_channel")
+			return
+		}
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "test_chat_channel")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceNotifications); err != nil {
+			h.HandleError(c, err, "test_chat_channel")
+			return
+		}
+
+		if err := h.chatService.TestChannel(c.Request.Context(), userID, workspaceID, channelID); err != nil {
+			h.HandleError(c, err, "test_chat_channel")
+			return
+		}
+
+		h.OK(c, gin.H{"id": channelID.String(), "sent": true}, "Test message sent successfully")
+	}
+}
+
+// ackPageTemplate: 채팅 메시지의 확인 링크가 여는 확인 페이지
+// 링크 미리보기나 프리페치로 확인되지 않도록 GET은 확인 폼만 표시하고 POST에서 처리합니다
+var ackPageTemplate = template.Must(template.New("ack").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>SkyClust - Acknowledge notification</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", sans-serif; background: #f5f6f8; color: #1f2328; margin: 0; }
+main { max-width: 480px; margin: 10vh auto; background: #fff; border-radius: 8px; padding: 32px; box-shadow: 0 1px 3px rgba(0,0,0,.12); }
+h1 { font-size: 20px; margin-top: 0; }
+p.meta { color: #59636e; font-size: 14px; }
+input[type=text] { width: 100%; box-sizing: border-box; padding: 8px; margin: 8px 0 16px; border: 1px solid #d1d9e0; border-radius: 6px; }
+button { background: #1f6feb; color: #fff; border: 0; border-radius: 6px; padding: 10px 16px; font-size: 14px; cursor: pointer; }
+.error { color: #d1242f; }
+</style>
+</head>
+<body>
+<main>
+{{if .Error}}
+<h1>Unable to acknowledge</h1>
+<p class="error">{{.Error}}</p>
+{{else if .Notification}}
+<h1>{{.Notification.Title}}</h1>
+<p>{{.Notification.Message}}</p>
+<p class="meta">Acknowledged by {{.Notification.AcknowledgedBy}}{{if .Notification.AcknowledgedAt}} at {{.Notification.AcknowledgedAt.UTC.Format "2006-01-02 15:04 MST"}}{{end}}.</p>
+{{else}}
+<h1>Acknowledge notification</h1>
+<p>Confirm that you are handling this alert. Everyone who received it will see it as acknowledged.</p>
+<form method="post">
+<input type="hidden" name="token" value="{{.Token}}">
+<label for="name">Your name (optional)</label>
+<input type="text" id="name" name="name" maxlength="100" autocomplete="name">
+<button type="submit">Acknowledge</button>
+</form>
+{{end}}
+</main>
+</body>
+</html>
+`))
+
+// ackPageData: 확인 페이지 렌더링 데이터
+type ackPageData struct {
+	Token        string
+	Notification *domain.Notification
+	Error        string
+}
+
+// GetAcknowledgePage: 채팅 메시지의 확인 링크가 여는 확인 폼을 반환합니다 (공개)
+func (h *ChatHandler) GetAcknowledgePage(c *gin.Context) {
+	defer h.TrackRequest(c, "get_chat_ack_page", 200)
+
+	token := c.Query("token")
+	if token == "" {
+		h.renderAckPage(c, http.StatusBadRequest, ackPageData{Error: "The acknowledge link is missing its token."})
+		return
+	}
+	h.renderAckPage(c, http.StatusOK, ackPageData{Token: token})
+}
+
+// Acknowledge: 확인 폼 제출을 처리하고 알림을 확인 처리합니다 (공개, 서명된 토큰으로 인증)
+func (h *ChatHandler) Acknowledge(c *gin.Context) {
+	defer h.TrackRequest(c, "chat_ack", 200)
+
+	token := c.PostForm("token")
+	if token == "" {
+		token = c.Query("token")
+	}
+	if token == "" {
+		h.renderAckPage(c, http.StatusBadRequest, ackPageData{Error: "The acknowledge link is missing its token."})
+		return
+	}
+
+	acknowledgedBy := strings.TrimSpace(c.PostForm("name"))
+	if len(acknowledgedBy) > 100 {
+		acknowledgedBy = acknowledgedBy[:100]
+	}
+	if acknowledgedBy != "" {
+		acknowledgedBy = "link:" + acknowledgedBy
+	}
+
+	ctx := h.EnrichContextWithRequestMetadata(c)
+	notification, err := h.chatService.AcknowledgeByToken(ctx, token, acknowledgedBy)
+	if err != nil {
+		status := http.StatusInternalServerError
+		message := "The notification could not be acknowledged. Try again later."
+		var domainErr *domain.DomainError
+		if errors.As(err, &domainErr) {
+			status = domainErr.StatusCode
+			if status < http.StatusInternalServerError {
+				message = domainErr.Message
+			}
+		}
+		h.renderAckPage(c, status, ackPageData{Error: message})
+		return
+	}
+
+	h.LogBusinessEvent(c, "notification_acknowledged", "", notification.WorkspaceID, map[string]interface{}{
+		"group_id":        notification.GroupID,
+		"acknowledged_by": notification.AcknowledgedBy,
+		"source":          "chat_link",
+	})
+
+	h.renderAckPage(c, http.StatusOK, ackPageData{Notification: notification})
+}
+
+// renderAckPage: 확인 페이지 HTML을 렌더링합니다
+func (h *ChatHandler) renderAckPage(c *gin.Context, status int, data ackPageData) {
+	var buf bytes.Buffer
+	if err := ackPageTemplate.Execute(&buf, data); err != nil {
+		h.LogError(c, err, "Failed to render acknowledge page")
+		c.String(http.StatusInternalServerError, "failed to render page")
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.Header("Referrer-Policy", "no-referrer")
+	c.Data(status, "text/html; charset=utf-8", buf.Bytes())
+}
+
+// HandleSlackInteraction: Slack 인터랙티브 버튼 콜백을 처리합니다 (공개, Slack 서명으로 인증)
+func (h *ChatHandler) HandleSlackInteraction(c *gin.Context) {
+	defer h.TrackRequest(c, "slack_interaction", 200)
+
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxSlackInteractionBody+1))
+	if err != nil {
+		h.HandleError(c, domain.NewDomainError(domain.ErrCodeBadRequest, "failed to read request body", 400), "slack_interaction")
+		return
+	}
+	if len(body) > maxSlackInteractionBody {
+		h.HandleError(c, domain.NewDomainError(domain.ErrCodeBadRequest, "request body too large", 413), "slack_interaction")
+		return
+	}
+
+	ctx := h.EnrichContextWithRequestMetadata(c)
+	interaction := domain.SlackInteraction{
+		Timestamp: c.GetHeader("X-Slack-Request-Timestamp"),
+		Signature: c.GetHeader("X-Slack-Signature"),
+		Body:      body,
+	}
+	if err := h.chatService.HandleSlackInteraction(ctx, interaction); err != nil {
+		h.HandleError(c, err, "slack_interaction")
+		return
+	}
+
+	// Slack expects an empty 200 within 3 seconds; the message itself is updated via response_url
+	c.Status(http.StatusOK)
+}
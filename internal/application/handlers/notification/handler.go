@@ -196,6 +196,48 @@ func (h *Handler) updateNotificationHandler() handlers.HandlerFunc {
 	}
 }
 
+// AcknowledgeNotification: 알림을 확인 처리합니다 (POST /notifications/:id/acknowledge)
+// 같은 알림을 받은 다른 수신자와 채팅 채널에도 확인 상태가 공유됩니다
+func (h *Handler) AcknowledgeNotification(c *gin.Context) {
+	handler := h.Compose(
+		h.acknowledgeNotificationHandler(),
+		h.StandardCRUDDecorators("acknowledge_notification")...,
+	)
+
+	handler(c)
+}
+
+// acknowledgeNotificationHandler: 알림 확인 처리의 핵심 비즈니스 로직을 처리합니다
+func (h *Handler) acknowledgeNotificationHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "acknowledge_notification")
+			return
+		}
+		notificationID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "acknowledge_notification")
+			return
+		}
+
+		notification, err := h.notificationService.AcknowledgeNotification(ctx, userID.String(), notificationID.String())
+		if err != nil {
+			h.HandleError(c, err, "acknowledge_notification")
+			return
+		}
+
+		h.LogBusinessEvent(c, "notification_acknowledged", userID.String(), notification.WorkspaceID, map[string]interface{}{
+			"notification_id": notificationID.String(),
+			"group_id":        notification.GroupID,
+			"source":          "api",
+		})
+
+		h.OK(c, notification, "Notification acknowledged successfully")
+	}
+}
+
 // UpdateNotifications: 알림을 일괄 업데이트합니다 (RESTful: PATCH /notifications)
 // 요청 본문을 통해 일괄 읽음 상태 업데이트 지원: {"read": true/false, "notification_ids": [...]}
 func (h *Handler) UpdateNotifications(c *gin.Context) {
@@ -494,6 +536,7 @@ func (h *Handler) updateNotificationPreferencesHandler(req domain.UpdateNotifica
 				PushEnabled:           true,
 				BrowserEnabled:        true,
 				InAppEnabled:          true,
+				ChatEnabled:           true,
 				SystemNotifications:   true,
 				VMNotifications:       true,
 				CostNotifications:     true,
@@ -503,6 +546,8 @@ func (h *Handler) updateNotificationPreferencesHandler(req domain.UpdateNotifica
 				HighPriorityEnabled:   true,
 				UrgentPriorityEnabled: true,
 				Timezone:              "UTC",
+				Locale:                domain.DefaultNotificationLocale,
+				DigestFrequency:       domain.NotificationDigestNone,
 			}
 		}
 
@@ -519,6 +564,9 @@ func (h *Handler) updateNotificationPreferencesHandler(req domain.UpdateNotifica
 		if req.InAppEnabled != nil {
 			preferences.InAppEnabled = *req.InAppEnabled
 		}
+		if req.ChatEnabled != nil {
+			preferences.ChatEnabled = *req.ChatEnabled
+		}
 		if req.SystemNotifications != nil {
 			preferences.SystemNotifications = *req.SystemNotifications
 		}
@@ -552,6 +600,18 @@ func (h *Handler) updateNotificationPreferencesHandler(req domain.UpdateNotifica
 		if req.Timezone != "" {
 			preferences.Timezone = req.Timezone
 		}
+		if req.Locale != "" {
+			preferences.Locale = req.Locale
+		}
+		if req.DigestFrequency != "" {
+			preferences.DigestFrequency = req.DigestFrequency
+		}
+		if req.EscalationUserID != nil {
+			preferences.EscalationUserID = *req.EscalationUserID
+		}
+		if req.EscalationDelayMinutes != nil {
+			preferences.EscalationDelayMinutes = *req.EscalationDelayMinutes
+		}
 
 		err = h.notificationService.UpdateNotificationPreferences(
 			c.Request.Context(),
@@ -635,6 +695,8 @@ func (h *Handler) sendTestNotificationHandler() handlers.HandlerFunc {
 			IsRead:    false,
 			CreatedAt: time.Now(),
 		}
+		// Every test is sent, even when repeated within the dedup window
+		testNotification.DedupKey = "test:" + testNotification.ID
 
 		err = h.notificationService.SendNotification(
 			c.Request.Context(),
@@ -19,6 +19,9 @@ func SetupRoutes(router *gin.RouterGroup, notificationService domain.Notificatio
 	// 알림 읽음 처리 (RESTful: PATCH for partial update)
 	router.PATCH("/:id", notificationHandler.UpdateNotification) // PATCH /notifications/:id (read 상태 업데이트)
 
+	// 알림 확인 처리 (같은 그룹의 모든 수신자 및 채팅 채널에 공유)
+	router.POST("/:id/acknowledge", notificationHandler.AcknowledgeNotification)
+
 	// 알림 읽음 처리 (여러 개) (RESTful: PATCH for bulk update)
 	router.PATCH("", notificationHandler.UpdateNotifications) // PATCH /notifications (bulk read 상태 업데이트)
 
@@ -40,3 +43,40 @@ func SetupRoutes(router *gin.RouterGroup, notificationService domain.Notificatio
 	// 알림 테스트 (개발용)
 	router.POST("/test", notificationHandler.SendTestNotification)
 }
+
+// SetupChatChannelRoutes sets up workspace chat channel routes
+// Chat channels are nested under a workspace: /workspaces/:id/chat-channels
+func SetupChatChannelRoutes(router *gin.RouterGroup, chatService domain.ChatChannelService) {
+	chatHandler := NewChatHandler(chatService)
+
+	router.GET("/:id/chat-channels", chatHandler.ListChannels)
+	router.POST("/:id/chat-channels", chatHandler.CreateChannel)
+	router.GET("/:id/chat-channels/:channelId", chatHandler.GetChannel)
+	router.PUT("/:id/chat-channels/:channelId", chatHandler.UpdateChannel)
+	router.DELETE("/:id/chat-channels/:channelId", chatHandler.DeleteChannel)
+	router.POST("/:id/chat-channels/:channelId/test", chatHandler.TestChannel)
+}
+
+// SetupChatCallbackRoutes sets up public chat acknowledgement callback routes
+// router is scoped to /api/v1/integrations; requests are authenticated by signed tokens and Slack signatures
+func SetupChatCallbackRoutes(router *gin.RouterGroup, chatService domain.ChatChannelService) {
+	chatHandler := NewChatHandler(chatService)
+
+	router.GET("/chat/ack", chatHandler.GetAcknowledgePage)                // GET /api/v1/integrations/chat/ack?token= (confirm form)
+	router.POST("/chat/ack", chatHandler.Acknowledge)                      // POST /api/v1/integrations/chat/ack
+	router.POST("/slack/interactions", chatHandler.HandleSlackInteraction) // POST /api/v1/integrations/slack/interactions
+}
+
+// SetupTemplateRoutes sets up notification template management routes (admin)
+// router is scoped to /api/v1/admin/notification-templates
+func SetupTemplateRoutes(router *gin.RouterGroup, notificationService domain.NotificationService) {
+	templateHandler := NewTemplateHandler(notificationService)
+
+	router.GET("", templateHandler.ListTemplates)                                                    // GET /api/v1/admin/notification-templates?key=
+	router.POST("", templateHandler.SaveTemplate)                                                    // POST /api/v1/admin/notification-templates (new version)
+	router.POST("/preview", templateHandler.PreviewTemplate)                                         // POST /api/v1/admin/notification-templates/preview
+	router.GET("/:key/:locale", templateHandler.GetTemplate)                                         // GET /api/v1/admin/notification-templates/:key/:locale?version=
+	router.DELETE("/:key/:locale", templateHandler.DeleteTemplate)                                   // DELETE /api/v1/admin/notification-templates/:key/:locale (all versions)
+	router.GET("/:key/:locale/versions", templateHandler.ListTemplateVersions)                       // GET /api/v1/admin/notification-templates/:key/:locale/versions
+	router.POST("/:key/:locale/versions/:version/activate", templateHandler.ActivateTemplateVersion) // POST /api/v1/admin/notification-templates/:key/:locale/versions/:version/activate
+}
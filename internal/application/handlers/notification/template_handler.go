@@ -0,0 +1,308 @@
+package notification
+
+import (
+	"net/http"
+	"skyclust/internal/domain"
+	"skyclust/internal/shared/handlers"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TemplateHandler: 알림 템플릿 관리 HTTP 요청을 처리하는 핸들러 (관리자 전용)
+type TemplateHandler struct {
+	*handlers.BaseHandler
+	notificationService domain.NotificationService
+}
+
+// NewTemplateHandler: 새로운 알림 템플릿 핸들러를 생성합니다
+func NewTemplateHandler(notificationService domain.NotificationService) *TemplateHandler {
+	return &TemplateHandler{
+		BaseHandler:         handlers.NewBaseHandler("notification_template"),
+		notificationService: notificationService,
+	}
+}
+
+// ListTemplates: 활성 알림 템플릿 목록 조회 요청을 처리합니다 (?key=로 필터)
+func (h *TemplateHandler) ListTemplates(c *gin.Context) {
+	handler := h.Compose(
+		h.listTemplatesHandler(),
+		h.StandardCRUDDecorators("list_notification_templates")...,
+	)
+
+	handler(c)
+}
+
+// listTemplatesHandler: 알림 템플릿 목록 조회의 핵심 비즈니스 로직
+func (h *TemplateHandler) listTemplatesHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.checkAdminPermission(c) {
+			return
+		}
+
+		templates, err := h.notificationService.ListTemplates(c.Request.Context(), c.Query("key"))
+		if err != nil {
+			h.HandleError(c, err, "list_notification_templates")
+			return
+		}
+
+		h.OK(c, NotificationTemplateListResponse{Templates: templates, Total: len(templates)}, "Notification templates retrieved successfully")
+	}
+}
+
+// GetTemplate: 알림 템플릿 조회 요청을 처리합니다 (?version=으로 특정 버전 조회)
+func (h *TemplateHandler) GetTemplate(c *gin.Context) {
+	handler := h.Compose(
+		h.getTemplateHandler(),
+		h.StandardCRUDDecorators("get_notification_template")...,
+	)
+
+	handler(c)
+}
+
+// getTemplateHandler: 알림 템플릿 조회의 핵심 비즈니스 로직
+func (h *TemplateHandler) getTemplateHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.checkAdminPermission(c) {
+			return
+		}
+
+		version := 0
+		if value := c.Query("version"); value != "" {
+			parsed, err := parseTemplateVersion(value)
+			if err != nil {
+				h.HandleError(c, err, "get_notification_template")
+				return
+			}
+			version = parsed
+		}
+
+		template, err := h.notificationService.GetTemplate(c.Request.Context(), c.Param("key"), c.Param("locale"), version)
+		if err != nil {
+			h.HandleError(c, err, "get_notification_template")
+			return
+		}
+
+		h.OK(c, template, "Notification template retrieved successfully")
+	}
+}
+
+// ListTemplateVersions: 알림 템플릿 버전 목록 조회 요청을 처리합니다
+func (h *TemplateHandler) ListTemplateVersions(c *gin.Context) {
+	handler := h.Compose(
+		h.listTemplateVersionsHandler(),
+		h.StandardCRUDDecorators("list_notification_template_versions")...,
+	)
+
+	handler(c)
+}
+
+// listTemplateVersionsHandler: 알림 템플릿 버전 목록 조회의 핵심 비즈니스 로직
+func (h *TemplateHandler) listTemplateVersionsHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.checkAdminPermission(c) {
+			return
+		}
+
+		versions, err := h.notificationService.ListTemplateVersions(c.Request.Context(), c.Param("key"), c.Param("locale"))
+		if err != nil {
+			h.HandleError(c, err, "list_notification_template_versions")
+			return
+		}
+
+		h.OK(c, NotificationTemplateListResponse{Templates: versions, Total: len(versions)}, "Notification template versions retrieved successfully")
+	}
+}
+
+// SaveTemplate: 알림 템플릿 저장 요청을 처리합니다 (저장할 때마다 새 버전 생성)
+func (h *TemplateHandler) SaveTemplate(c *gin.Context) {
+	handler := h.Compose(
+		h.saveTemplateHandler(),
+		h.StandardCRUDDecorators("save_notification_template")...,
+	)
+
+	handler(c)
+}
+
+// saveTemplateHandler: 알림 템플릿 저장의 핵심 비즈니스 로직
+func (h *TemplateHandler) saveTemplateHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.checkAdminPermission(c) {
+			return
+		}
+
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "save_notification_template")
+			return
+		}
+
+		var req domain.SaveNotificationTemplateRequest
+		if err := h.ExtractValidatedRequest(c, &req); err != nil {
+			h.HandleError(c, err, "save_notification_template")
+			return
+		}
+
+		template, err := h.notificationService.SaveTemplate(ctx, userID, req)
+		if err != nil {
+			h.HandleError(c, err, "save_notification_template")
+			return
+		}
+
+		h.LogBusinessEvent(c, "notification_template_saved", userID.String(), "", map[string]interface{}{
+			"key":     template.Key,
+			"locale":  template.Locale,
+			"version": template.Version,
+			"active":  template.Active,
+		})
+
+		h.Created(c, template, "Notification template saved successfully")
+	}
+}
+
+// ActivateTemplateVersion: 알림 템플릿 버전 활성화 요청을 처리합니다
+func (h *TemplateHandler) ActivateTemplateVersion(c *gin.Context) {
+	handler := h.Compose(
+		h.activateTemplateVersionHandler(),
+		h.StandardCRUDDecorators("activate_notification_template")...,
+	)
+
+	handler(c)
+}
+
+// activateTemplateVersionHandler: 알림 템플릿 버전 활성화의 핵심 비즈니스 로직
+func (h *TemplateHandler) activateTemplateVersionHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.checkAdminPermission(c) {
+			return
+		}
+
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "activate_notification_template")
+			return
+		}
+		version, err := parseTemplateVersion(c.Param("version"))
+		if err != nil {
+			h.HandleError(c, err, "activate_notification_template")
+			return
+		}
+
+		template, err := h.notificationService.ActivateTemplateVersion(ctx, userID, c.Param("key"), c.Param("locale"), version)
+		if err != nil {
+			h.HandleError(c, err, "activate_notification_template")
+			return
+		}
+
+		h.LogBusinessEvent(c, "notification_template_activated", userID.String(), "", map[string]interface{}{
+			"key":     template.Key,
+			"locale":  template.Locale,
+			"version": template.Version,
+		})
+
+		h.OK(c, template, "Notification template version activated successfully")
+	}
+}
+
+// DeleteTemplate: 알림 템플릿 삭제 요청을 처리합니다 (모든 버전 삭제)
+func (h *TemplateHandler) DeleteTemplate(c *gin.Context) {
+	handler := h.Compose(
+		h.deleteTemplateHandler(),
+		h.StandardCRUDDecorators("delete_notification_template")...,
+	)
+
+	handler(c)
+}
+
+// deleteTemplateHandler: 알림 템플릿 삭제의 핵심 비즈니스 로직
+func (h *TemplateHandler) deleteTemplateHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.checkAdminPermission(c) {
+			return
+		}
+
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "delete_notification_template")
+			return
+		}
+
+		key, locale := c.Param("key"), c.Param("locale")
+		if err := h.notificationService.DeleteTemplate(ctx, userID, key, locale); err != nil {
+			h.HandleError(c, err, "delete_notification_template")
+			return
+		}
+
+		h.LogBusinessEvent(c, "notification_template_deleted", userID.String(), "", map[string]interface{}{
+			"key":    key,
+			"locale": locale,
+		})
+
+		h.OK(c, gin.H{"key": key, "locale": locale}, "Notification template deleted successfully")
+	}
+}
+
+// PreviewTemplate: 알림 템플릿 미리보기 요청을 처리합니다
+func (h *TemplateHandler) PreviewTemplate(c *gin.Context) {
+	handler := h.Compose(
+		h.previewTemplateHandler(),
+		h.StandardCRUDDecorators("preview_notification_template")...,
+	)
+
+	handler(c)
+}
+
+// previewTemplateHandler: 알림 템플릿 미리보기의 핵심 비즈니스 로직
+func (h *TemplateHandler) previewTemplateHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.checkAdminPermission(c) {
+			return
+		}
+
+		var req domain.PreviewNotificationTemplateRequest
+		if err := h.ExtractValidatedRequest(c, &req); err != nil {
+			h.HandleError(c, err, "preview_notification_template")
+			return
+		}
+
+		preview, err := h.notificationService.PreviewTemplate(c.Request.Context(), req)
+		if err != nil {
+			h.HandleError(c, err, "preview_notification_template")
+			return
+		}
+
+		h.OK(c, preview, "Notification template rendered successfully")
+	}
+}
+
+// checkAdminPermission: 관리자 권한을 확인합니다
+func (h *TemplateHandler) checkAdminPermission(c *gin.Context) bool {
+	userRole, err := h.GetUserRoleFromToken(c)
+	if err != nil {
+		h.HandleError(c, err, "check_admin_permission")
+		return false
+	}
+
+	if userRole != domain.AdminRoleType {
+		h.HandleError(c, domain.NewDomainError(
+			domain.ErrCodeForbidden,
+			"Insufficient permissions - admin role required",
+			http.StatusForbidden,
+		), "check_admin_permission")
+		return false
+	}
+
+	return true
+}
+
+// parseTemplateVersion: 템플릿 버전 파라미터를 파싱합니다
+func parseTemplateVersion(value string) (int, error) {
+	version, err := strconv.Atoi(value)
+	if err != nil || version < 1 {
+		return 0, domain.NewDomainError(domain.ErrCodeBadRequest, "version must be a positive integer", http.StatusBadRequest)
+	}
+	return version, nil
+}
@@ -1,6 +1,9 @@
 package notification
 
-import "time"
+import (
+	"skyclust/internal/domain"
+	"time"
+)
 
 // NotificationResponse represents a notification in API responses
 type NotificationResponse struct {
@@ -57,3 +60,15 @@ type UpdatePreferencesRequest struct {
 	SecurityAlerts  *bool `json:"security_alerts,omitempty"`
 	SystemUpdates   *bool `json:"system_updates,omitempty"`
 }
+
+// ChatChannelListResponse represents a list of workspace chat channels
+type ChatChannelListResponse struct {
+	Channels []*domain.ChatChannel `json:"channels"`
+	Total    int                   `json:"total"`
+}
+
+// NotificationTemplateListResponse represents a list of notification templates or template versions
+type NotificationTemplateListResponse struct {
+	Templates []*domain.NotificationTemplate `json:"templates"`
+	Total     int                            `json:"total"`
+}
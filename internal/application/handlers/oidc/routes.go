@@ -31,6 +31,9 @@ func SetupProviderRoutes(router *gin.RouterGroup, oidcService domain.OIDCService
 	// Public OIDC provider type routes
 	// GET /api/v1/oidc/providers/types - Get available provider types
 	router.GET("/types", oidcHandler.GetProviders)
+
+	// GET /api/v1/oidc/providers/discover?email= - Discover organization SSO provider by email domain
+	router.GET("/discover", oidcHandler.DiscoverSSOProvider)
 }
 
 // SetupUserProviderRoutes sets up user OIDC provider management routes (protected, needs auth)
@@ -49,3 +52,36 @@ func SetupUserProviderRoutes(router *gin.RouterGroup, oidcService domain.OIDCSer
 		providerRoutes.DELETE("/:id", oidcHandler.DeleteProvider) // DELETE /api/v1/oidc/providers/:id
 	}
 }
+
+// SetupSSOProviderRoutes sets up organization SSO provider management routes (admin only)
+// router is scoped to /api/v1/admin/sso-providers
+func SetupSSOProviderRoutes(router *gin.RouterGroup, oidcService domain.OIDCService) {
+	oidcHandler := NewHandler(oidcService)
+
+	router.POST("", oidcHandler.CreateSSOProvider)                     // POST /api/v1/admin/sso-providers
+	router.GET("", oidcHandler.ListSSOProviders)                       // GET /api/v1/admin/sso-providers
+	router.GET("/:id", oidcHandler.GetSSOProvider)                     // GET /api/v1/admin/sso-providers/:id
+	router.PUT("/:id", oidcHandler.UpdateSSOProvider)                  // PUT /api/v1/admin/sso-providers/:id
+	router.DELETE("/:id", oidcHandler.DeleteSSOProvider)               // DELETE /api/v1/admin/sso-providers/:id
+	router.PUT("/:id/group-mappings", oidcHandler.SetSSOGroupMappings) // PUT /api/v1/admin/sso-providers/:id/group-mappings
+}
+
+// SetupSAMLRoutes sets up public SAML 2.0 service provider routes
+// router is scoped to /api/v1/auth/saml
+func SetupSAMLRoutes(router *gin.RouterGroup, oidcService domain.OIDCService) {
+	oidcHandler := NewHandler(oidcService)
+
+	router.GET("/:id/metadata", oidcHandler.GetSAMLMetadata)  // GET /api/v1/auth/saml/:id/metadata
+	router.GET("/:id/login", oidcHandler.GetSAMLAuthURL)      // GET /api/v1/auth/saml/:id/login
+	router.POST("/:id/acs", oidcHandler.ConsumeSAMLAssertion) // POST /api/v1/auth/saml/:id/acs
+	router.GET("/:id/slo", oidcHandler.HandleSAMLLogout)      // GET /api/v1/auth/saml/:id/slo (HTTP-Redirect binding)
+	router.POST("/:id/slo", oidcHandler.HandleSAMLLogout)     // POST /api/v1/auth/saml/:id/slo (HTTP-POST binding)
+}
+
+// SetupSAMLSessionRoutes sets up SAML session routes (protected, needs auth)
+// router is scoped to /api/v1/auth/saml
+func SetupSAMLSessionRoutes(router *gin.RouterGroup, oidcService domain.OIDCService) {
+	oidcHandler := NewHandler(oidcService)
+
+	router.POST("/:id/logout", oidcHandler.InitiateSAMLLogout) // POST /api/v1/auth/saml/:id/logout
+}
@@ -0,0 +1,189 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"skyclust/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// GetSAMLMetadata: SAML SP 메타데이터 XML을 반환합니다
+func (h *Handler) GetSAMLMetadata(c *gin.Context) {
+	defer h.TrackRequest(c, "get_saml_metadata", 200)
+
+	providerID, err := h.ExtractPathParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, "get_saml_metadata")
+		return
+	}
+
+	metadata, err := h.oidcService.GetSAMLMetadata(c.Request.Context(), providerID)
+	if err != nil {
+		h.HandleError(c, err, "get_saml_metadata")
+		return
+	}
+
+	c.Data(http.StatusOK, "application/samlmetadata+xml", metadata)
+}
+
+// GetSAMLAuthURL: 서명된 AuthnRequest를 담은 IdP 로그인 URL을 반환합니다
+func (h *Handler) GetSAMLAuthURL(c *gin.Context) {
+	defer h.TrackRequest(c, "get_saml_auth_url", 200)
+
+	providerID, err := h.ExtractPathParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, "get_saml_auth_url")
+		return
+	}
+
+	h.LogInfo(c, "Getting SAML auth URL",
+		zap.String("operation", "get_saml_auth_url"),
+		zap.String("provider_id", providerID.String()))
+
+	// RelayState doubles as the CSRF state and the lookup key for the AuthnRequest ID
+	stateBytes := make([]byte, 16)
+	if _, err := rand.Read(stateBytes); err != nil {
+		h.LogError(c, err, "Failed to generate relay state")
+		h.HandleError(c, domain.NewDomainError(domain.ErrCodeInternalError, "Failed to generate relay state", 500), "get_saml_auth_url")
+		return
+	}
+	relayState := hex.EncodeToString(stateBytes)
+
+	authURL, err := h.oidcService.GetSAMLAuthURL(c.Request.Context(), providerID, relayState)
+	if err != nil {
+		h.LogError(c, err, "Failed to get SAML auth URL")
+		h.HandleError(c, err, "get_saml_auth_url")
+		return
+	}
+
+	h.LogBusinessEvent(c, "saml_auth_url_requested", "", "", map[string]interface{}{
+		"provider_id": providerID.String(),
+	})
+
+	h.OK(c, gin.H{
+		"auth_url":    authURL,
+		"relay_state": relayState,
+	}, "Auth URL generated successfully")
+}
+
+// ConsumeSAMLAssertion: IdP가 POST 바인딩으로 전달한 SAML 응답을 처리합니다 (ACS)
+func (h *Handler) ConsumeSAMLAssertion(c *gin.Context) {
+	defer h.TrackRequest(c, "saml_acs", 200)
+
+	providerID, err := h.ExtractPathParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, "saml_acs")
+		return
+	}
+
+	samlResponse := c.PostForm("SAMLResponse")
+	relayState := c.PostForm("RelayState")
+	if samlResponse == "" {
+		h.HandleError(c, domain.NewDomainError(domain.ErrCodeBadRequest, "SAMLResponse is required", 400), "saml_acs")
+		return
+	}
+
+	h.LogInfo(c, "Processing SAML assertion",
+		zap.String("operation", "saml_acs"),
+		zap.String("provider_id", providerID.String()))
+
+	ctx := h.EnrichContextWithRequestMetadata(c)
+	user, token, err := h.oidcService.ConsumeSAMLResponse(ctx, providerID, samlResponse, relayState)
+	if err != nil {
+		h.LogError(c, err, "Failed to process SAML assertion")
+		h.HandleError(c, err, "saml_acs")
+		return
+	}
+
+	h.LogBusinessEvent(c, "saml_authentication_successful", user.ID.String(), "", map[string]interface{}{
+		"provider_id": providerID.String(),
+		"user_id":     user.ID.String(),
+	})
+
+	h.OK(c, gin.H{
+		"user":  user,
+		"token": token,
+	}, "Authentication successful")
+}
+
+// InitiateSAMLLogout: 현재 세션을 종료하고 IdP 로그아웃 URL을 반환합니다 (SP 시작 SLO)
+func (h *Handler) InitiateSAMLLogout(c *gin.Context) {
+	defer h.TrackRequest(c, "saml_logout", 200)
+
+	providerID, err := h.ExtractPathParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, "saml_logout")
+		return
+	}
+
+	userID, err := h.ExtractUserIDFromContext(c)
+	if err != nil {
+		h.HandleError(c, err, "saml_logout")
+		return
+	}
+
+	token, err := h.GetBearerTokenFromHeader(c)
+	if err != nil {
+		h.HandleError(c, err, "saml_logout")
+		return
+	}
+
+	ctx := h.EnrichContextWithRequestMetadata(c)
+	logoutURL, err := h.oidcService.InitiateSAMLLogout(ctx, providerID, userID, token, c.Query("relay_state"))
+	if err != nil {
+		h.LogError(c, err, "Failed to initiate SAML logout")
+		h.HandleError(c, err, "saml_logout")
+		return
+	}
+
+	h.LogBusinessEvent(c, "saml_logout_initiated", userID.String(), "", map[string]interface{}{
+		"provider_id": providerID.String(),
+	})
+
+	h.OK(c, gin.H{
+		"logout_url": logoutURL,
+	}, "Session terminated successfully")
+}
+
+// HandleSAMLLogout: IdP가 보낸 LogoutRequest 또는 LogoutResponse를 처리합니다 (SLO)
+func (h *Handler) HandleSAMLLogout(c *gin.Context) {
+	defer h.TrackRequest(c, "saml_slo", 200)
+
+	providerID, err := h.ExtractPathParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, "saml_slo")
+		return
+	}
+
+	redirectBinding := c.Request.Method == http.MethodGet
+	var samlRequest, samlResponse, relayState string
+	if redirectBinding {
+		samlRequest = c.Query("SAMLRequest")
+		samlResponse = c.Query("SAMLResponse")
+		relayState = c.Query("RelayState")
+	} else {
+		samlRequest = c.PostForm("SAMLRequest")
+		samlResponse = c.PostForm("SAMLResponse")
+		relayState = c.PostForm("RelayState")
+	}
+
+	ctx := h.EnrichContextWithRequestMetadata(c)
+	redirectURL, err := h.oidcService.HandleSAMLLogout(ctx, providerID, samlRequest, samlResponse, relayState, redirectBinding)
+	if err != nil {
+		h.LogError(c, err, "Failed to process SAML logout")
+		h.HandleError(c, err, "saml_slo")
+		return
+	}
+
+	if redirectURL != "" {
+		c.Redirect(http.StatusFound, redirectURL)
+		return
+	}
+
+	h.OK(c, gin.H{
+		"message": "Logout processed successfully",
+	}, "Logout processed successfully")
+}
@@ -0,0 +1,308 @@
+package oidc
+
+import (
+	"net/http"
+	"skyclust/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// DiscoverSSOProvider: 이메일 도메인에 해당하는 조직 SSO 프로바이더를 찾습니다
+func (h *Handler) DiscoverSSOProvider(c *gin.Context) {
+	defer h.TrackRequest(c, "discover_sso_provider", 200)
+
+	email := c.Query("email")
+	if email == "" {
+		h.HandleError(c, domain.NewDomainError(domain.ErrCodeBadRequest, "email parameter required", 400), "discover_sso_provider")
+		return
+	}
+
+	provider, err := h.oidcService.DiscoverSSOProvider(c.Request.Context(), email)
+	if err != nil {
+		h.HandleError(c, err, "discover_sso_provider")
+		return
+	}
+
+	h.OK(c, SSODiscoveryResponse{
+		ProviderID:   provider.ID.String(),
+		Name:         provider.Name,
+		Protocol:     provider.Protocol,
+		ProviderType: provider.ProviderType,
+	}, "SSO provider discovered successfully")
+}
+
+// CreateSSOProvider: 조직 SSO 프로바이더를 생성합니다 (관리자 전용)
+func (h *Handler) CreateSSOProvider(c *gin.Context) {
+	defer h.TrackRequest(c, "create_sso_provider", 201)
+
+	h.LogInfo(c, "Creating SSO provider",
+		zap.String("operation", "create_sso_provider"))
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	adminID, err := h.ExtractUserIDFromContext(c)
+	if err != nil {
+		h.HandleError(c, err, "create_sso_provider")
+		return
+	}
+
+	var req CreateSSOProviderRequest
+	if err := h.ValidateRequest(c, &req); err != nil {
+		h.HandleError(c, err, "create_sso_provider")
+		return
+	}
+
+	mappings, err := h.parseGroupMappings(req.GroupMappings)
+	if err != nil {
+		h.HandleError(c, err, "create_sso_provider")
+		return
+	}
+
+	provider := &domain.SSOProvider{
+		Name:              req.Name,
+		Protocol:          req.Protocol,
+		ProviderType:      req.ProviderType,
+		ClientID:          req.ClientID,
+		ClientSecret:      req.ClientSecret, // Will be encrypted in repository
+		RedirectURL:       req.RedirectURL,
+		AuthURL:           req.AuthURL,
+		TokenURL:          req.TokenURL,
+		UserInfoURL:       req.UserInfoURL,
+		Scopes:            req.Scopes,
+		SAMLIdPMetadata:   req.SAMLIdPMetadata,
+		SAMLBaseURL:       req.SAMLBaseURL,
+		EmailAttribute:    req.EmailAttribute,
+		UsernameAttribute: req.UsernameAttribute,
+		Domains:           req.Domains,
+		GroupsClaim:       req.GroupsClaim,
+		AutoProvision:     true,
+		DefaultRole:       domain.Role(req.DefaultRole),
+		Enabled:           true,
+		GroupMappings:     mappings,
+	}
+	if req.AutoProvision != nil {
+		provider.AutoProvision = *req.AutoProvision
+	}
+	if req.Enabled != nil {
+		provider.Enabled = *req.Enabled
+	}
+
+	provider, err = h.oidcService.CreateSSOProvider(c.Request.Context(), adminID, provider)
+	if err != nil {
+		h.HandleError(c, err, "create_sso_provider")
+		return
+	}
+
+	h.LogBusinessEvent(c, "sso_provider_created", adminID.String(), provider.ID.String(), map[string]interface{}{
+		"provider_id":   provider.ID.String(),
+		"provider_name": provider.Name,
+		"provider_type": provider.ProviderType,
+	})
+
+	h.Created(c, provider, "SSO provider created successfully")
+}
+
+// ListSSOProviders: 모든 조직 SSO 프로바이더를 조회합니다 (관리자 전용)
+func (h *Handler) ListSSOProviders(c *gin.Context) {
+	defer h.TrackRequest(c, "list_sso_providers", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	providers, err := h.oidcService.ListSSOProviders(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err, "list_sso_providers")
+		return
+	}
+
+	h.OK(c, gin.H{
+		"providers": providers,
+		"total":     len(providers),
+	}, "SSO providers retrieved successfully")
+}
+
+// GetSSOProvider: 조직 SSO 프로바이더를 조회합니다 (관리자 전용)
+func (h *Handler) GetSSOProvider(c *gin.Context) {
+	defer h.TrackRequest(c, "get_sso_provider", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	providerID, err := h.ExtractPathParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, "get_sso_provider")
+		return
+	}
+
+	provider, err := h.oidcService.GetSSOProvider(c.Request.Context(), providerID)
+	if err != nil {
+		h.HandleError(c, err, "get_sso_provider")
+		return
+	}
+
+	h.OK(c, provider, "SSO provider retrieved successfully")
+}
+
+// UpdateSSOProvider: 조직 SSO 프로바이더를 업데이트합니다 (관리자 전용)
+func (h *Handler) UpdateSSOProvider(c *gin.Context) {
+	defer h.TrackRequest(c, "update_sso_provider", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	adminID, err := h.ExtractUserIDFromContext(c)
+	if err != nil {
+		h.HandleError(c, err, "update_sso_provider")
+		return
+	}
+
+	providerID, err := h.ExtractPathParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, "update_sso_provider")
+		return
+	}
+
+	var req domain.UpdateSSOProviderRequest
+	if err := h.ValidateRequest(c, &req); err != nil {
+		h.HandleError(c, err, "update_sso_provider")
+		return
+	}
+
+	provider, err := h.oidcService.UpdateSSOProvider(c.Request.Context(), adminID, providerID, req)
+	if err != nil {
+		h.HandleError(c, err, "update_sso_provider")
+		return
+	}
+
+	h.LogBusinessEvent(c, "sso_provider_updated", adminID.String(), provider.ID.String(), map[string]interface{}{
+		"provider_id": provider.ID.String(),
+	})
+
+	h.OK(c, provider, "SSO provider updated successfully")
+}
+
+// DeleteSSOProvider: 조직 SSO 프로바이더를 삭제합니다 (관리자 전용)
+func (h *Handler) DeleteSSOProvider(c *gin.Context) {
+	defer h.TrackRequest(c, "delete_sso_provider", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	adminID, err := h.ExtractUserIDFromContext(c)
+	if err != nil {
+		h.HandleError(c, err, "delete_sso_provider")
+		return
+	}
+
+	providerID, err := h.ExtractPathParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, "delete_sso_provider")
+		return
+	}
+
+	if err := h.oidcService.DeleteSSOProvider(c.Request.Context(), adminID, providerID); err != nil {
+		h.HandleError(c, err, "delete_sso_provider")
+		return
+	}
+
+	h.LogBusinessEvent(c, "sso_provider_deleted", adminID.String(), providerID.String(), map[string]interface{}{
+		"provider_id": providerID.String(),
+	})
+
+	h.OK(c, gin.H{"message": "SSO provider deleted successfully"}, "SSO provider deleted successfully")
+}
+
+// SetSSOGroupMappings: SSO 프로바이더의 그룹 매핑을 교체합니다 (관리자 전용)
+func (h *Handler) SetSSOGroupMappings(c *gin.Context) {
+	defer h.TrackRequest(c, "set_sso_group_mappings", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	adminID, err := h.ExtractUserIDFromContext(c)
+	if err != nil {
+		h.HandleError(c, err, "set_sso_group_mappings")
+		return
+	}
+
+	providerID, err := h.ExtractPathParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, "set_sso_group_mappings")
+		return
+	}
+
+	var req SetSSOGroupMappingsRequest
+	if err := h.ValidateRequest(c, &req); err != nil {
+		h.HandleError(c, err, "set_sso_group_mappings")
+		return
+	}
+
+	mappings, err := h.parseGroupMappings(req.GroupMappings)
+	if err != nil {
+		h.HandleError(c, err, "set_sso_group_mappings")
+		return
+	}
+
+	provider, err := h.oidcService.SetSSOGroupMappings(c.Request.Context(), adminID, providerID, mappings)
+	if err != nil {
+		h.HandleError(c, err, "set_sso_group_mappings")
+		return
+	}
+
+	h.LogBusinessEvent(c, "sso_group_mappings_updated", adminID.String(), providerID.String(), map[string]interface{}{
+		"provider_id": providerID.String(),
+		"mappings":    len(mappings),
+	})
+
+	h.OK(c, provider, "SSO group mappings updated successfully")
+}
+
+// parseGroupMappings: 요청의 그룹 매핑을 도메인 객체로 변환합니다
+func (h *Handler) parseGroupMappings(reqs []SSOGroupMappingRequest) ([]domain.SSOGroupMapping, error) {
+	mappings := make([]domain.SSOGroupMapping, 0, len(reqs))
+	for _, req := range reqs {
+		mapping := domain.SSOGroupMapping{
+			Group:         req.Group,
+			Role:          domain.Role(req.Role),
+			WorkspaceRole: req.WorkspaceRole,
+		}
+		if req.WorkspaceID != "" {
+			workspaceID, err := uuid.Parse(req.WorkspaceID)
+			if err != nil {
+				return nil, domain.NewDomainError(domain.ErrCodeBadRequest, "Invalid workspace ID format", 400)
+			}
+			mapping.WorkspaceID = &workspaceID
+		}
+		mappings = append(mappings, mapping)
+	}
+	return mappings, nil
+}
+
+// checkAdminPermission: 현재 사용자가 관리자 권한을 가지고 있는지 확인합니다
+func (h *Handler) checkAdminPermission(c *gin.Context) bool {
+	userRole, err := h.GetUserRoleFromToken(c)
+	if err != nil {
+		h.HandleError(c, err, "check_admin_permission")
+		return false
+	}
+
+	if userRole != domain.AdminRoleType {
+		h.HandleError(c, domain.NewDomainError(
+			domain.ErrCodeForbidden,
+			"Insufficient permissions - admin role required",
+			http.StatusForbidden,
+		), "check_admin_permission")
+		return false
+	}
+
+	return true
+}
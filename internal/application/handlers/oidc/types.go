@@ -107,3 +107,48 @@ type ProviderDetailResponse struct {
 	CreatedAt    string `json:"created_at"`
 	UpdatedAt    string `json:"updated_at"`
 }
+
+// SSOGroupMappingRequest represents an IdP group mapping in a request
+type SSOGroupMappingRequest struct {
+	Group         string `json:"group" validate:"required,min=1,max=255"`
+	Role          string `json:"role,omitempty" validate:"omitempty,oneof=admin user viewer"`
+	WorkspaceID   string `json:"workspace_id,omitempty" validate:"omitempty,uuid"`
+	WorkspaceRole string `json:"workspace_role,omitempty" validate:"omitempty,oneof=admin member"`
+}
+
+// CreateSSOProviderRequest represents a request to create an organization SSO provider
+type CreateSSOProviderRequest struct {
+	Name              string                   `json:"name" validate:"required,min=1,max=100"`
+	Protocol          string                   `json:"protocol,omitempty" validate:"omitempty,oneof=oidc saml"`
+	ProviderType      string                   `json:"provider_type,omitempty" validate:"required_unless=Protocol saml,omitempty,oneof=google github azure microsoft custom"`
+	ClientID          string                   `json:"client_id,omitempty" validate:"required_unless=Protocol saml"`
+	ClientSecret      string                   `json:"client_secret,omitempty" validate:"required_unless=Protocol saml"`
+	RedirectURL       string                   `json:"redirect_url,omitempty" validate:"required_unless=Protocol saml,omitempty,url"`
+	AuthURL           string                   `json:"auth_url,omitempty" validate:"omitempty,url"`
+	TokenURL          string                   `json:"token_url,omitempty" validate:"omitempty,url"`
+	UserInfoURL       string                   `json:"user_info_url,omitempty" validate:"omitempty,url"`
+	Scopes            string                   `json:"scopes,omitempty"`
+	SAMLIdPMetadata   string                   `json:"saml_idp_metadata,omitempty" validate:"required_if=Protocol saml"`
+	SAMLBaseURL       string                   `json:"saml_base_url,omitempty" validate:"required_if=Protocol saml,omitempty,url"`
+	EmailAttribute    string                   `json:"email_attribute,omitempty"`
+	UsernameAttribute string                   `json:"username_attribute,omitempty"`
+	Domains           string                   `json:"domains,omitempty"`
+	GroupsClaim       string                   `json:"groups_claim,omitempty"`
+	AutoProvision     *bool                    `json:"auto_provision,omitempty"`
+	DefaultRole       string                   `json:"default_role,omitempty" validate:"omitempty,oneof=admin user viewer"`
+	Enabled           *bool                    `json:"enabled,omitempty"`
+	GroupMappings     []SSOGroupMappingRequest `json:"group_mappings,omitempty" validate:"omitempty,dive"`
+}
+
+// SetSSOGroupMappingsRequest represents a request to replace SSO group mappings
+type SetSSOGroupMappingsRequest struct {
+	GroupMappings []SSOGroupMappingRequest `json:"group_mappings" validate:"dive"`
+}
+
+// SSODiscoveryResponse represents the SSO provider discovered for an email domain
+type SSODiscoveryResponse struct {
+	ProviderID   string `json:"provider_id"`
+	Name         string `json:"name"`
+	Protocol     string `json:"protocol"`
+	ProviderType string `json:"provider_type"`
+}
@@ -0,0 +1,147 @@
+package operation
+
+import (
+	"skyclust/internal/domain"
+	"skyclust/internal/shared/handlers"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler: 장시간 클라우드 작업 진행 상황 조회 HTTP 요청을 처리하는 핸들러
+type Handler struct {
+	*handlers.BaseHandler
+	operationService domain.OperationService
+}
+
+// NewHandler: 새로운 작업 핸들러를 생성합니다
+func NewHandler(operationService domain.OperationService) *Handler {
+	return &Handler{
+		BaseHandler:      handlers.NewBaseHandler("operation"),
+		operationService: operationService,
+	}
+}
+
+// ListOperations: 워크스페이스 작업 목록 조회 요청을 처리합니다
+// 조회 권한(kubernetes:read, network:read, compute:read)이 있는 종류의 작업만 반환합니다
+func (h *Handler) ListOperations(c *gin.Context) {
+	handler := h.Compose(
+		h.listOperationsHandler(),
+		h.StandardCRUDDecorators("list_operations")...,
+	)
+
+	handler(c)
+}
+
+// listOperationsHandler: 워크스페이스 작업 목록 조회의 핵심 비즈니스 로직
+func (h *Handler) listOperationsHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "list_operations")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceRead); err != nil {
+			h.HandleError(c, err, "list_operations")
+			return
+		}
+
+		filter := domain.OperationFilter{
+			Status:     c.Query("status"),
+			Type:       c.Query("type"),
+			TargetType: c.Query("target_type"),
+			TargetID:   c.Query("target_id"),
+		}
+		if filter.Type != "" {
+			if err := h.RequireWorkspacePermission(c, workspaceID, domain.OperationReadPermission(filter.Type)); err != nil {
+				h.HandleError(c, err, "list_operations")
+				return
+			}
+		} else {
+			kinds, err := h.readableKinds(c, workspaceID)
+			if err != nil {
+				h.HandleError(c, err, "list_operations")
+				return
+			}
+			filter.Kinds = kinds
+		}
+
+		filter.Limit, filter.Offset = h.ParsePaginationParams(c)
+		operations, total, err := h.operationService.ListOperations(c.Request.Context(), workspaceID, filter)
+		if err != nil {
+			h.HandleError(c, err, "list_operations")
+			return
+		}
+
+		h.OK(c, OperationListResponse{
+			Operations: operations,
+			Total:      total,
+			Limit:      filter.Limit,
+			Offset:     filter.Offset,
+		}, "Operations retrieved successfully")
+	}
+}
+
+// GetOperation: 워크스페이스 작업 조회 요청을 처리합니다 (단계별 진행 상황 포함)
+func (h *Handler) GetOperation(c *gin.Context) {
+	handler := h.Compose(
+		h.getOperationHandler(),
+		h.StandardCRUDDecorators("get_operation")...,
+	)
+
+	handler(c)
+}
+
+// getOperationHandler: 워크스페이스 작업 조회의 핵심 비즈니스 로직
+func (h *Handler) getOperationHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "get_operation")
+			return
+		}
+		operationID, err := h.ExtractPathParam(c, "operationId")
+		if err != nil {
+			h.HandleError(c, err, "get_operation")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceRead); err != nil {
+			h.HandleError(c, err, "get_operation")
+			return
+		}
+
+		operation, err := h.operationService.GetOperation(c.Request.Context(), workspaceID, operationID)
+		if err != nil {
+			h.HandleError(c, err, "get_operation")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.OperationReadPermission(operation.Type)); err != nil {
+			h.HandleError(c, err, "get_operation")
+			return
+		}
+
+		h.OK(c, operation, "Operation retrieved successfully")
+	}
+}
+
+// readableKinds: 사용자가 조회 권한을 가진 작업 종류를 반환합니다 (하나도 없으면 권한 오류)
+func (h *Handler) readableKinds(c *gin.Context, workspaceID uuid.UUID) ([]string, error) {
+	kinds := make([]string, 0, len(domain.OperationKinds))
+	for _, kind := range domain.OperationKinds {
+		err := h.RequireWorkspacePermission(c, workspaceID, domain.OperationReadPermission(kind))
+		if err == nil {
+			kinds = append(kinds, kind)
+			continue
+		}
+		if domain.GetDomainError(err).Code != domain.ErrCodeForbidden {
+			return nil, err
+		}
+	}
+	if len(kinds) == 0 {
+		return nil, domain.NewDomainError(domain.ErrCodeForbidden, "kubernetes:read, network:read or compute:read is required in this workspace", 403)
+	}
+	return kinds, nil
+}
@@ -0,0 +1,16 @@
+package operation
+
+import (
+	"skyclust/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupRoutes sets up long-running cloud operation routes
+// Operations are nested under a workspace: /workspaces/:id/operations
+func SetupRoutes(router *gin.RouterGroup, operationService domain.OperationService) {
+	operationHandler := NewHandler(operationService)
+
+	router.GET("/:id/operations", operationHandler.ListOperations)
+	router.GET("/:id/operations/:operationId", operationHandler.GetOperation)
+}
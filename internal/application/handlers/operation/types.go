@@ -0,0 +1,11 @@
+package operation
+
+import "skyclust/internal/domain"
+
+// OperationListResponse represents a page of long-running cloud operations
+type OperationListResponse struct {
+	Operations []*domain.Operation `json:"operations"`
+	Total      int64               `json:"total"`
+	Limit      int                 `json:"limit"`
+	Offset     int                 `json:"offset"`
+}
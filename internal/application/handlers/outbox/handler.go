@@ -0,0 +1,157 @@
+package outbox
+
+import (
+	"net/http"
+	"strconv"
+
+	"skyclust/internal/domain"
+	"skyclust/internal/shared/handlers"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// Handler: outbox 발행 상태 조회와 실패 이벤트 재처리를 처리하는 핸들러 (관리자 전용)
+type Handler struct {
+	*handlers.BaseHandler
+	outboxRepo domain.OutboxRepository
+}
+
+// NewHandler: 새로운 outbox 핸들러를 생성합니다
+func NewHandler(outboxRepo domain.OutboxRepository) *Handler {
+	return &Handler{
+		BaseHandler: handlers.NewBaseHandler("outbox"),
+		outboxRepo:  outboxRepo,
+	}
+}
+
+// GetStats: 발행 대기/처리 중/실패 이벤트 수와 지연 조회 요청을 처리합니다
+func (h *Handler) GetStats(c *gin.Context) {
+	handler := h.Compose(
+		h.getStatsHandler(),
+		h.StandardCRUDDecorators("get_outbox_stats")...,
+	)
+
+	handler(c)
+}
+
+// getStatsHandler: outbox 현황 조회의 핵심 비즈니스 로직
+func (h *Handler) getStatsHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.checkAdminPermission(c) {
+			return
+		}
+
+		stats, err := h.outboxRepo.GetStats(c.Request.Context())
+		if err != nil {
+			h.HandleError(c, domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to get outbox stats", http.StatusInternalServerError), "get_outbox_stats")
+			return
+		}
+
+		h.OK(c, stats, "Outbox stats retrieved successfully")
+	}
+}
+
+// GetFailedEvents: 발행에 실패한 이벤트 목록 조회 요청을 처리합니다
+func (h *Handler) GetFailedEvents(c *gin.Context) {
+	handler := h.Compose(
+		h.getFailedEventsHandler(),
+		h.StandardCRUDDecorators("get_failed_outbox_events")...,
+	)
+
+	handler(c)
+}
+
+// getFailedEventsHandler: 실패한 이벤트 목록 조회의 핵심 비즈니스 로직
+func (h *Handler) getFailedEventsHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.checkAdminPermission(c) {
+			return
+		}
+
+		limit := DefaultFailedLimit
+		if value := c.Query("limit"); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil || parsed < 1 {
+				h.HandleError(c, domain.NewDomainError(domain.ErrCodeBadRequest, "limit must be a positive integer", http.StatusBadRequest), "get_failed_outbox_events")
+				return
+			}
+			limit = parsed
+		}
+		if limit > MaxFailedLimit {
+			limit = MaxFailedLimit
+		}
+
+		events, err := h.outboxRepo.GetFailedEvents(c.Request.Context(), limit)
+		if err != nil {
+			h.HandleError(c, domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to get failed outbox events", http.StatusInternalServerError), "get_failed_outbox_events")
+			return
+		}
+
+		h.OK(c, gin.H{
+			"events": events,
+			"total":  len(events),
+		}, "Failed outbox events retrieved successfully")
+	}
+}
+
+// RequeueFailedEvents: 실패한 이벤트를 다시 발행 대기열에 넣는 요청을 처리합니다
+func (h *Handler) RequeueFailedEvents(c *gin.Context) {
+	var req RequeueFailedEventsRequest
+	handler := h.Compose(
+		h.requeueFailedEventsHandler(req),
+		h.StandardCRUDDecorators("requeue_failed_outbox_events")...,
+	)
+
+	handler(c)
+}
+
+// requeueFailedEventsHandler: 실패한 이벤트 재처리의 핵심 비즈니스 로직
+func (h *Handler) requeueFailedEventsHandler(req RequeueFailedEventsRequest) handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		if !h.checkAdminPermission(c) {
+			return
+		}
+
+		// 본문이 없으면 실패한 이벤트 전체를 재처리
+		if c.Request.ContentLength > 0 {
+			if err := h.ValidateRequest(c, &req); err != nil {
+				h.HandleError(c, err, "requeue_failed_outbox_events")
+				return
+			}
+		}
+
+		requeued, err := h.outboxRepo.RequeueFailedEvents(c.Request.Context(), req.IDs)
+		if err != nil {
+			h.HandleError(c, domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to requeue outbox events", http.StatusInternalServerError), "requeue_failed_outbox_events")
+			return
+		}
+
+		h.LogInfo(c, "Failed outbox events requeued",
+			zap.String("operation", "requeue_failed_outbox_events"),
+			zap.Int("requested", len(req.IDs)),
+			zap.Int64("requeued", requeued))
+
+		h.OK(c, gin.H{"requeued": requeued}, "Failed outbox events requeued successfully")
+	}
+}
+
+// checkAdminPermission: 관리자 권한을 확인합니다
+func (h *Handler) checkAdminPermission(c *gin.Context) bool {
+	userRole, err := h.GetUserRoleFromToken(c)
+	if err != nil {
+		h.HandleError(c, err, "check_admin_permission")
+		return false
+	}
+
+	if userRole != domain.AdminRoleType {
+		h.HandleError(c, domain.NewDomainError(
+			domain.ErrCodeForbidden,
+			"Insufficient permissions - admin role required",
+			http.StatusForbidden,
+		), "check_admin_permission")
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,16 @@
+package outbox
+
+import (
+	"skyclust/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupRoutes sets up outbox monitoring and requeue routes (admin)
+func SetupRoutes(router *gin.RouterGroup, outboxRepo domain.OutboxRepository) {
+	outboxHandler := NewHandler(outboxRepo)
+
+	router.GET("/stats", outboxHandler.GetStats)                      // GET /api/v1/admin/messaging/outbox/stats
+	router.GET("/failed", outboxHandler.GetFailedEvents)              // GET /api/v1/admin/messaging/outbox/failed?limit=
+	router.POST("/failed/requeue", outboxHandler.RequeueFailedEvents) // POST /api/v1/admin/messaging/outbox/failed/requeue
+}
@@ -0,0 +1,33 @@
+package outbox
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Failed event listing limits
+const (
+	DefaultFailedLimit = 50
+	MaxFailedLimit     = 500
+	MaxRequeueIDs      = 1000
+)
+
+// RequeueFailedEventsRequest represents a request to requeue failed outbox events
+type RequeueFailedEventsRequest struct {
+	// IDs of failed events to requeue; all failed events are requeued when empty
+	IDs []string `json:"ids" validate:"omitempty,max=1000,dive,uuid"`
+}
+
+// Validate checks that every ID is a UUID
+func (r *RequeueFailedEventsRequest) Validate() error {
+	if len(r.IDs) > MaxRequeueIDs {
+		return fmt.Errorf("at most %d ids can be requeued at once", MaxRequeueIDs)
+	}
+	for _, id := range r.IDs {
+		if _, err := uuid.Parse(id); err != nil {
+			return fmt.Errorf("invalid event id: %s", id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,307 @@
+package policy
+
+import (
+	"skyclust/internal/domain"
+	"skyclust/internal/shared/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler: 워크스페이스 정책 관련 HTTP 요청을 처리하는 핸들러
+type Handler struct {
+	*handlers.BaseHandler
+	policyService domain.PolicyService
+}
+
+// NewHandler: 새로운 워크스페이스 정책 핸들러를 생성합니다
+func NewHandler(policyService domain.PolicyService) *Handler {
+	return &Handler{
+		BaseHandler:   handlers.NewBaseHandler("policy"),
+		policyService: policyService,
+	}
+}
+
+// ListPolicies: 워크스페이스 정책 목록 조회 요청을 처리합니다
+func (h *Handler) ListPolicies(c *gin.Context) {
+	handler := h.Compose(
+		h.listPoliciesHandler(),
+		h.StandardCRUDDecorators("list_workspace_policies")...,
+	)
+
+	handler(c)
+}
+
+// listPoliciesHandler: 워크스페이스 정책 목록 조회의 핵심 비즈니스 로직
+func (h *Handler) listPoliciesHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "list_workspace_policies")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceRead); err != nil {
+			h.HandleError(c, err, "list_workspace_policies")
+			return
+		}
+
+		policies, err := h.policyService.ListPolicies(c.Request.Context(), workspaceID)
+		if err != nil {
+			h.HandleError(c, err, "list_workspace_policies")
+			return
+		}
+
+		h.OK(c, PolicyListResponse{Policies: policies, Total: len(policies)}, "Workspace policies retrieved successfully")
+	}
+}
+
+// GetPolicy: 워크스페이스 정책 조회 요청을 처리합니다
+func (h *Handler) GetPolicy(c *gin.Context) {
+	handler := h.Compose(
+		h.getPolicyHandler(),
+		h.StandardCRUDDecorators("get_workspace_policy")...,
+	)
+
+	handler(c)
+}
+
+// getPolicyHandler: 워크스페이스 정책 조회의 핵심 비즈니스 로직
+func (h *Handler) getPolicyHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "get_workspace_policy")
+			return
+		}
+		policyID, err := h.ExtractPathParam(c, "policyId")
+		if err != nil {
+			h.HandleError(c, err, "get_workspace_policy")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceRead); err != nil {
+			h.HandleError(c, err, "get_workspace_policy")
+			return
+		}
+
+		policy, err := h.policyService.GetPolicy(c.Request.Context(), workspaceID, policyID)
+		if err != nil {
+			h.HandleError(c, err, "get_workspace_policy")
+			return
+		}
+
+		h.OK(c, policy, "Workspace policy retrieved successfully")
+	}
+}
+
+// CreatePolicy: 워크스페이스 정책 생성 요청을 처리합니다
+func (h *Handler) CreatePolicy(c *gin.Context) {
+	handler := h.Compose(
+		h.createPolicyHandler(),
+		h.StandardCRUDDecorators("create_workspace_policy")...,
+	)
+
+	handler(c)
+}
+
+// createPolicyHandler: 워크스페이스 정책 생성의 핵심 비즈니스 로직
+func (h *Handler) createPolicyHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "create_workspace_policy")
+			return
+		}
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "create_workspace_policy")
+			return
+		}
+
+		var req domain.CreateWorkspacePolicyRequest
+		if err := h.ExtractValidatedRequest(c, &req); err != nil {
+			h.HandleError(c, err, "create_workspace_policy")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspacePolicies); err != nil {
+			h.HandleError(c, err, "create_workspace_policy")
+			return
+		}
+
+		policy, err := h.policyService.CreatePolicy(ctx, userID, workspaceID, req)
+		if err != nil {
+			h.HandleError(c, err, "create_workspace_policy")
+			return
+		}
+
+		h.LogBusinessEvent(c, "workspace_policy_created", userID.String(), workspaceID.String(), map[string]interface{}{
+			"workspace_id": workspaceID.String(),
+			"policy_id":    policy.ID.String(),
+			"effect":       string(policy.Effect),
+		})
+		h.Created(c, policy, "Workspace policy created successfully")
+	}
+}
+
+// UpdatePolicy: 워크스페이스 정책 수정 요청을 처리합니다
+func (h *Handler) UpdatePolicy(c *gin.Context) {
+	handler := h.Compose(
+		h.updatePolicyHandler(),
+		h.StandardCRUDDecorators("update_workspace_policy")...,
+	)
+
+	handler(c)
+}
+
+// updatePolicyHandler: 워크스페이스 정책 수정의 핵심 비즈니스 로직
+func (h *Handler) updatePolicyHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "update_workspace_policy")
+			return
+		}
+		policyID, err := h.ExtractPathParam(c, "policyId")
+		if err != nil {
+			h.HandleError(c, err, "update_workspace_policy")
+			return
+		}
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "update_workspace_policy")
+			return
+		}
+
+		var req domain.UpdateWorkspacePolicyRequest
+		if err := h.ExtractValidatedRequest(c, &req); err != nil {
+			h.HandleError(c, err, "update_workspace_policy")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspacePolicies); err != nil {
+			h.HandleError(c, err, "update_workspace_policy")
+			return
+		}
+
+		policy, err := h.policyService.UpdatePolicy(ctx, userID, workspaceID, policyID, req)
+		if err != nil {
+			h.HandleError(c, err, "update_workspace_policy")
+			return
+		}
+
+		h.LogBusinessEvent(c, "workspace_policy_updated", userID.String(), workspaceID.String(), map[string]interface{}{
+			"workspace_id": workspaceID.String(),
+			"policy_id":    policy.ID.String(),
+		})
+		h.OK(c, policy, "Workspace policy updated successfully")
+	}
+}
+
+// DeletePolicy: 워크스페이스 정책 삭제 요청을 처리합니다
+func (h *Handler) DeletePolicy(c *gin.Context) {
+	handler := h.Compose(
+		h.deletePolicyHandler(),
+		h.StandardCRUDDecorators("delete_workspace_policy")...,
+	)
+
+	handler(c)
+}
+
+// deletePolicyHandler: 워크스페이스 정책 삭제의 핵심 비즈니스 로직
+func (h *Handler) deletePolicyHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "delete_workspace_policy")
+			return
+		}
+		policyID, err := h.ExtractPathParam(c, "policyId")
+		if err != nil {
+			h.HandleError(c, err, "delete_workspace_policy")
+			return
+		}
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "delete_workspace_policy")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspacePolicies); err != nil {
+			h.HandleError(c, err, "delete_workspace_policy")
+			return
+		}
+
+		if err := h.policyService.DeletePolicy(ctx, userID, workspaceID, policyID); err != nil {
+			h.HandleError(c, err, "delete_workspace_policy")
+			return
+		}
+
+		h.LogBusinessEvent(c, "workspace_policy_deleted", userID.String(), workspaceID.String(), map[string]interface{}{
+			"workspace_id": workspaceID.String(),
+			"policy_id":    policyID.String(),
+		})
+		h.OK(c, gin.H{"message": "Workspace policy deleted successfully"}, "Workspace policy deleted successfully")
+	}
+}
+
+// ExplainPolicy: 정책 드라이런 평가 요청을 처리합니다 (거부 사유 설명)
+func (h *Handler) ExplainPolicy(c *gin.Context) {
+	handler := h.Compose(
+		h.explainPolicyHandler(),
+		h.StandardCRUDDecorators("explain_workspace_policy")...,
+	)
+
+	handler(c)
+}
+
+// explainPolicyHandler: 정책 드라이런 평가의 핵심 비즈니스 로직
+func (h *Handler) explainPolicyHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "explain_workspace_policy")
+			return
+		}
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "explain_workspace_policy")
+			return
+		}
+
+		var req ExplainPolicyRequest
+		if err := h.ExtractValidatedRequest(c, &req); err != nil {
+			h.HandleError(c, err, "explain_workspace_policy")
+			return
+		}
+
+		// 다른 사용자 기준의 평가는 정책 관리 권한이 필요
+		required := domain.WorkspaceRead
+		if req.SubjectID != nil && *req.SubjectID != userID {
+			required = domain.WorkspacePolicies
+		}
+		if err := h.RequireWorkspacePermission(c, workspaceID, required); err != nil {
+			h.HandleError(c, err, "explain_workspace_policy")
+			return
+		}
+
+		decision, err := h.policyService.Evaluate(ctx, domain.PolicyRequest{
+			WorkspaceID: workspaceID.String(),
+			SubjectID:   req.SubjectID,
+			Action:      domain.Permission(req.Action),
+			Resource:    req.Resource,
+			Environment: req.Environment,
+			Time:        req.Time,
+		})
+		if err != nil {
+			h.HandleError(c, err, "explain_workspace_policy")
+			return
+		}
+
+		h.OK(c, decision, "Policy evaluated successfully")
+	}
+}
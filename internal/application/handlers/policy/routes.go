@@ -0,0 +1,20 @@
+package policy
+
+import (
+	"skyclust/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupRoutes sets up workspace policy routes
+// Policies are nested under a workspace: /workspaces/:id/policies
+func SetupRoutes(router *gin.RouterGroup, policyService domain.PolicyService) {
+	policyHandler := NewHandler(policyService)
+
+	router.GET("/:id/policies", policyHandler.ListPolicies)
+	router.POST("/:id/policies", policyHandler.CreatePolicy)
+	router.POST("/:id/policies/explain", policyHandler.ExplainPolicy)
+	router.GET("/:id/policies/:policyId", policyHandler.GetPolicy)
+	router.PUT("/:id/policies/:policyId", policyHandler.UpdatePolicy)
+	router.DELETE("/:id/policies/:policyId", policyHandler.DeletePolicy)
+}
@@ -0,0 +1,23 @@
+package policy
+
+import (
+	"skyclust/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExplainPolicyRequest represents a dry-run policy evaluation request
+type ExplainPolicyRequest struct {
+	Action      string                `json:"action" validate:"required"`
+	Resource    domain.PolicyResource `json:"resource"`
+	SubjectID   *uuid.UUID            `json:"subject_id,omitempty"`
+	Environment map[string]string     `json:"environment,omitempty"`
+	Time        *time.Time            `json:"time,omitempty"`
+}
+
+// PolicyListResponse represents a list of workspace policies
+type PolicyListResponse struct {
+	Policies []*domain.WorkspacePolicy `json:"policies"`
+	Total    int                       `json:"total"`
+}
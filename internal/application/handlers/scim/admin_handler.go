@@ -0,0 +1,181 @@
+package scim
+
+import (
+	"net/http"
+	"skyclust/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// CreateToken: SCIM 토큰을 발급합니다 (관리자 전용)
+func (h *Handler) CreateToken(c *gin.Context) {
+	defer h.TrackRequest(c, "create_scim_token", 201)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	adminID, err := h.ExtractUserIDFromContext(c)
+	if err != nil {
+		h.HandleError(c, err, "create_scim_token")
+		return
+	}
+
+	var req CreateTokenRequest
+	if err := h.ValidateRequest(c, &req); err != nil {
+		h.HandleError(c, err, "create_scim_token")
+		return
+	}
+
+	token, plainToken, err := h.scimService.CreateToken(c.Request.Context(), adminID, req.Name, req.ExpiresAt)
+	if err != nil {
+		h.HandleError(c, err, "create_scim_token")
+		return
+	}
+
+	h.LogInfo(c, "SCIM token created",
+		zap.String("operation", "create_scim_token"),
+		zap.String("token_id", token.ID.String()))
+
+	h.Created(c, CreateTokenResponse{
+		SCIMToken: token,
+		Token:     plainToken,
+	}, "SCIM token created successfully")
+}
+
+// ListTokens: SCIM 토큰 목록을 조회합니다 (관리자 전용)
+func (h *Handler) ListTokens(c *gin.Context) {
+	defer h.TrackRequest(c, "list_scim_tokens", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	tokens, err := h.scimService.ListTokens(c.Request.Context())
+	if err != nil {
+		h.HandleError(c, err, "list_scim_tokens")
+		return
+	}
+
+	h.OK(c, gin.H{
+		"tokens": tokens,
+		"total":  len(tokens),
+	}, "SCIM tokens retrieved successfully")
+}
+
+// RevokeToken: SCIM 토큰을 폐기합니다 (관리자 전용)
+func (h *Handler) RevokeToken(c *gin.Context) {
+	defer h.TrackRequest(c, "revoke_scim_token", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	adminID, err := h.ExtractUserIDFromContext(c)
+	if err != nil {
+		h.HandleError(c, err, "revoke_scim_token")
+		return
+	}
+
+	tokenID, err := h.ExtractPathParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, "revoke_scim_token")
+		return
+	}
+
+	if err := h.scimService.RevokeToken(c.Request.Context(), adminID, tokenID); err != nil {
+		h.HandleError(c, err, "revoke_scim_token")
+		return
+	}
+
+	h.OK(c, nil, "SCIM token revoked successfully")
+}
+
+// ListSyncedGroups: SCIM으로 동기화된 그룹과 워크스페이스 연결 정보를 조회합니다 (관리자 전용)
+func (h *Handler) ListSyncedGroups(c *gin.Context) {
+	defer h.TrackRequest(c, "list_scim_groups", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	startIndex, count := h.parseListParams(c)
+	groups, total, err := h.scimService.ListGroups(c.Request.Context(), c.Query("filter"), startIndex, count)
+	if err != nil {
+		h.HandleError(c, err, "list_scim_groups")
+		return
+	}
+
+	h.OK(c, gin.H{
+		"groups": groups,
+		"total":  total,
+	}, "SCIM groups retrieved successfully")
+}
+
+// BindGroupWorkspace: SCIM 그룹을 워크스페이스 역할에 연결합니다 (관리자 전용)
+// workspace_id를 비우면 연결이 해제되고 그룹이 부여한 멤버십이 제거됩니다
+func (h *Handler) BindGroupWorkspace(c *gin.Context) {
+	defer h.TrackRequest(c, "bind_scim_group_workspace", 200)
+
+	if !h.checkAdminPermission(c) {
+		return
+	}
+
+	adminID, err := h.ExtractUserIDFromContext(c)
+	if err != nil {
+		h.HandleError(c, err, "bind_scim_group_workspace")
+		return
+	}
+
+	groupID, err := h.ExtractPathParam(c, "id")
+	if err != nil {
+		h.HandleError(c, err, "bind_scim_group_workspace")
+		return
+	}
+
+	var req BindWorkspaceRequest
+	if err := h.ValidateRequest(c, &req); err != nil {
+		h.HandleError(c, err, "bind_scim_group_workspace")
+		return
+	}
+
+	var workspaceID *uuid.UUID
+	if req.WorkspaceID != nil && *req.WorkspaceID != "" {
+		parsed, err := uuid.Parse(*req.WorkspaceID)
+		if err != nil {
+			h.HandleError(c, domain.NewDomainError(domain.ErrCodeBadRequest, "invalid workspace_id", 400), "bind_scim_group_workspace")
+			return
+		}
+		workspaceID = &parsed
+	}
+
+	group, err := h.scimService.BindGroupWorkspace(c.Request.Context(), adminID, groupID, workspaceID, req.WorkspaceRole)
+	if err != nil {
+		h.HandleError(c, err, "bind_scim_group_workspace")
+		return
+	}
+
+	h.OK(c, group, "SCIM group workspace binding updated successfully")
+}
+
+// checkAdminPermission: 관리자 권한을 확인합니다
+func (h *Handler) checkAdminPermission(c *gin.Context) bool {
+	userRole, err := h.GetUserRoleFromToken(c)
+	if err != nil {
+		h.HandleError(c, err, "check_admin_permission")
+		return false
+	}
+
+	if userRole != domain.AdminRoleType {
+		h.HandleError(c, domain.NewDomainError(
+			domain.ErrCodeForbidden,
+			"Insufficient permissions - admin role required",
+			http.StatusForbidden,
+		), "check_admin_permission")
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,496 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"skyclust/internal/domain"
+	"skyclust/internal/shared/handlers"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// Handler: SCIM 2.0 프로비저닝 요청을 처리하는 핸들러
+type Handler struct {
+	*handlers.BaseHandler
+	scimService domain.SCIMService
+}
+
+// NewHandler: 새로운 SCIM 핸들러를 생성합니다
+func NewHandler(scimService domain.SCIMService) *Handler {
+	return &Handler{
+		BaseHandler: handlers.NewBaseHandler("scim"),
+		scimService: scimService,
+	}
+}
+
+// AuthMiddleware: SCIM 전용 Bearer 토큰을 검증하는 미들웨어를 반환합니다
+func (h *Handler) AuthMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		if !strings.HasPrefix(header, "Bearer ") {
+			h.respondError(c, domain.NewDomainError(domain.ErrCodeUnauthorized, "bearer token required", 401), "scim_auth")
+			c.Abort()
+			return
+		}
+
+		token, err := h.scimService.AuthenticateToken(c.Request.Context(), strings.TrimSpace(strings.TrimPrefix(header, "Bearer ")))
+		if err != nil {
+			h.respondError(c, err, "scim_auth")
+			c.Abort()
+			return
+		}
+
+		c.Set(TokenContextKey, token)
+		c.Next()
+	}
+}
+
+// GetServiceProviderConfig: SCIM 서비스 제공자 설정을 반환합니다
+func (h *Handler) GetServiceProviderConfig(c *gin.Context) {
+	c.Header("Content-Type", ContentType)
+	c.JSON(http.StatusOK, gin.H{
+		"schemas":          []string{domain.SCIMSchemaServiceConfig},
+		"documentationUri": "",
+		"patch":            gin.H{"supported": true},
+		"bulk":             gin.H{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":           gin.H{"supported": true, "maxResults": 200},
+		"changePassword":   gin.H{"supported": true},
+		"sort":             gin.H{"supported": false},
+		"etag":             gin.H{"supported": false},
+		"authenticationSchemes": []gin.H{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication using a SCIM token issued by a SkyClust administrator",
+			"primary":     true,
+		}},
+		"meta": gin.H{
+			"resourceType": "ServiceProviderConfig",
+			"location":     h.baseURL(c) + "/ServiceProviderConfig",
+		},
+	})
+}
+
+// GetResourceTypes: 지원하는 SCIM 리소스 타입을 반환합니다
+func (h *Handler) GetResourceTypes(c *gin.Context) {
+	baseURL := h.baseURL(c)
+	resourceTypes := []gin.H{
+		{
+			"schemas":  []string{domain.SCIMSchemaResourceType},
+			"id":       "User",
+			"name":     "User",
+			"endpoint": "/Users",
+			"schema":   domain.SCIMSchemaUser,
+			"meta":     gin.H{"resourceType": "ResourceType", "location": baseURL + "/ResourceTypes/User"},
+		},
+		{
+			"schemas":  []string{domain.SCIMSchemaResourceType},
+			"id":       "Group",
+			"name":     "Group",
+			"endpoint": "/Groups",
+			"schema":   domain.SCIMSchemaGroup,
+			"meta":     gin.H{"resourceType": "ResourceType", "location": baseURL + "/ResourceTypes/Group"},
+		},
+	}
+
+	h.respond(c, http.StatusOK, ListResponse{
+		Schemas:      []string{domain.SCIMSchemaListResponse},
+		TotalResults: int64(len(resourceTypes)),
+		StartIndex:   1,
+		ItemsPerPage: len(resourceTypes),
+		Resources:    resourceTypes,
+	})
+}
+
+// ListUsers: SCIM 필터로 사용자 목록을 조회합니다
+func (h *Handler) ListUsers(c *gin.Context) {
+	defer h.TrackRequest(c, "scim_list_users", 200)
+
+	startIndex, count := h.parseListParams(c)
+	users, total, err := h.scimService.ListUsers(c.Request.Context(), c.Query("filter"), startIndex, count)
+	if err != nil {
+		h.respondError(c, err, "scim_list_users")
+		return
+	}
+
+	baseURL := h.baseURL(c)
+	resources := make([]UserResource, 0, len(users))
+	for _, user := range users {
+		resources = append(resources, toUserResource(baseURL, user, nil))
+	}
+
+	h.respond(c, http.StatusOK, newListResponse(resources, len(resources), total, startIndex))
+}
+
+// GetUser: SCIM 사용자를 조회합니다
+func (h *Handler) GetUser(c *gin.Context) {
+	defer h.TrackRequest(c, "scim_get_user", 200)
+
+	user, err := h.scimService.GetUser(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondError(c, err, "scim_get_user")
+		return
+	}
+
+	h.respondUser(c, http.StatusOK, user, "scim_get_user")
+}
+
+// CreateUser: SCIM 사용자를 생성합니다
+func (h *Handler) CreateUser(c *gin.Context) {
+	defer h.TrackRequest(c, "scim_create_user", 201)
+
+	var req UserRequest
+	if !h.bindJSON(c, &req, "scim_create_user") {
+		return
+	}
+
+	user, err := h.scimService.CreateUser(c.Request.Context(), h.token(c), req.ToAttributes())
+	if err != nil {
+		h.respondError(c, err, "scim_create_user")
+		return
+	}
+
+	h.LogInfo(c, "SCIM user provisioned",
+		zap.String("operation", "scim_create_user"),
+		zap.String("user_id", user.ID.String()))
+
+	h.respondUser(c, http.StatusCreated, user, "scim_create_user")
+}
+
+// ReplaceUser: SCIM 사용자 속성을 교체합니다 (PUT)
+func (h *Handler) ReplaceUser(c *gin.Context) {
+	defer h.TrackRequest(c, "scim_replace_user", 200)
+
+	var req UserRequest
+	if !h.bindJSON(c, &req, "scim_replace_user") {
+		return
+	}
+
+	user, err := h.scimService.ReplaceUser(c.Request.Context(), h.token(c), c.Param("id"), req.ToAttributes())
+	if err != nil {
+		h.respondError(c, err, "scim_replace_user")
+		return
+	}
+
+	h.respondUser(c, http.StatusOK, user, "scim_replace_user")
+}
+
+// PatchUser: SCIM PATCH 연산을 사용자에 적용합니다
+func (h *Handler) PatchUser(c *gin.Context) {
+	defer h.TrackRequest(c, "scim_patch_user", 200)
+
+	operations, ok := h.bindPatch(c, "scim_patch_user")
+	if !ok {
+		return
+	}
+
+	user, err := h.scimService.PatchUser(c.Request.Context(), h.token(c), c.Param("id"), operations)
+	if err != nil {
+		h.respondError(c, err, "scim_patch_user")
+		return
+	}
+
+	h.respondUser(c, http.StatusOK, user, "scim_patch_user")
+}
+
+// DeleteUser: SCIM 사용자를 삭제합니다
+func (h *Handler) DeleteUser(c *gin.Context) {
+	defer h.TrackRequest(c, "scim_delete_user", 204)
+
+	if err := h.scimService.DeleteUser(c.Request.Context(), h.token(c), c.Param("id")); err != nil {
+		h.respondError(c, err, "scim_delete_user")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListGroups: SCIM 필터로 그룹 목록을 조회합니다
+func (h *Handler) ListGroups(c *gin.Context) {
+	defer h.TrackRequest(c, "scim_list_groups", 200)
+
+	startIndex, count := h.parseListParams(c)
+	groups, total, err := h.scimService.ListGroups(c.Request.Context(), c.Query("filter"), startIndex, count)
+	if err != nil {
+		h.respondError(c, err, "scim_list_groups")
+		return
+	}
+
+	// Okta and Entra ID request excludedAttributes=members when they only need group identity
+	excludeMembers := strings.Contains(strings.ToLower(c.Query("excludedAttributes")), "members")
+
+	baseURL := h.baseURL(c)
+	resources := make([]GroupResource, 0, len(groups))
+	for _, group := range groups {
+		resource := toGroupResource(baseURL, group)
+		if excludeMembers {
+			resource.Members = nil
+		}
+		resources = append(resources, resource)
+	}
+
+	h.respond(c, http.StatusOK, newListResponse(resources, len(resources), total, startIndex))
+}
+
+// GetGroup: SCIM 그룹을 조회합니다
+func (h *Handler) GetGroup(c *gin.Context) {
+	defer h.TrackRequest(c, "scim_get_group", 200)
+
+	group, err := h.scimService.GetGroup(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondError(c, err, "scim_get_group")
+		return
+	}
+
+	h.respond(c, http.StatusOK, toGroupResource(h.baseURL(c), group))
+}
+
+// CreateGroup: SCIM 그룹을 생성합니다
+func (h *Handler) CreateGroup(c *gin.Context) {
+	defer h.TrackRequest(c, "scim_create_group", 201)
+
+	var req GroupRequest
+	if !h.bindJSON(c, &req, "scim_create_group") {
+		return
+	}
+
+	group, err := h.scimService.CreateGroup(c.Request.Context(), h.token(c), req.ToAttributes())
+	if err != nil {
+		h.respondError(c, err, "scim_create_group")
+		return
+	}
+
+	h.respond(c, http.StatusCreated, toGroupResource(h.baseURL(c), group))
+}
+
+// ReplaceGroup: SCIM 그룹 속성과 멤버를 교체합니다 (PUT)
+func (h *Handler) ReplaceGroup(c *gin.Context) {
+	defer h.TrackRequest(c, "scim_replace_group", 200)
+
+	var req GroupRequest
+	if !h.bindJSON(c, &req, "scim_replace_group") {
+		return
+	}
+
+	group, err := h.scimService.ReplaceGroup(c.Request.Context(), h.token(c), c.Param("id"), req.ToAttributes())
+	if err != nil {
+		h.respondError(c, err, "scim_replace_group")
+		return
+	}
+
+	h.respond(c, http.StatusOK, toGroupResource(h.baseURL(c), group))
+}
+
+// PatchGroup: SCIM PATCH 연산을 그룹에 적용합니다
+func (h *Handler) PatchGroup(c *gin.Context) {
+	defer h.TrackRequest(c, "scim_patch_group", 200)
+
+	operations, ok := h.bindPatch(c, "scim_patch_group")
+	if !ok {
+		return
+	}
+
+	group, err := h.scimService.PatchGroup(c.Request.Context(), h.token(c), c.Param("id"), operations)
+	if err != nil {
+		h.respondError(c, err, "scim_patch_group")
+		return
+	}
+
+	h.respond(c, http.StatusOK, toGroupResource(h.baseURL(c), group))
+}
+
+// DeleteGroup: SCIM 그룹을 삭제합니다
+func (h *Handler) DeleteGroup(c *gin.Context) {
+	defer h.TrackRequest(c, "scim_delete_group", 204)
+
+	if err := h.scimService.DeleteGroup(c.Request.Context(), h.token(c), c.Param("id")); err != nil {
+		h.respondError(c, err, "scim_delete_group")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// respondUser: 사용자 리소스를 그룹 정보와 함께 응답합니다
+func (h *Handler) respondUser(c *gin.Context, status int, user *domain.User, operation string) {
+	groups, err := h.scimService.GetUserGroups(c.Request.Context(), user.ID)
+	if err != nil {
+		h.respondError(c, err, operation)
+		return
+	}
+
+	baseURL := h.baseURL(c)
+	resource := toUserResource(baseURL, user, groups)
+	c.Header("Location", resource.Meta.Location)
+	h.respond(c, status, resource)
+}
+
+// respond: SCIM 콘텐츠 타입으로 JSON 응답을 작성합니다
+func (h *Handler) respond(c *gin.Context, status int, body interface{}) {
+	c.Header("Content-Type", ContentType)
+	c.JSON(status, body)
+}
+
+// respondError: 오류를 SCIM 오류 메시지 형식으로 응답합니다 (RFC 7644 3.12)
+func (h *Handler) respondError(c *gin.Context, err error, operation string) {
+	h.LogError(c, err, "SCIM request failed", zap.String("operation", operation))
+
+	status := http.StatusInternalServerError
+	detail := "internal server error"
+	scimType := ""
+
+	var domainErr *domain.DomainError
+	if errors.As(err, &domainErr) {
+		status = domainErr.StatusCode
+		detail = domainErr.Message
+		if value, ok := domainErr.Details["scim_type"].(string); ok {
+			scimType = value
+		}
+	}
+
+	h.respond(c, status, ErrorResponse{
+		Schemas:  []string{domain.SCIMSchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
+
+// bindJSON: 요청 본문을 파싱하고 실패 시 invalidSyntax 오류를 응답합니다
+func (h *Handler) bindJSON(c *gin.Context, req interface{}, operation string) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		h.respondError(c, domain.NewSCIMError(domain.SCIMErrorInvalidSyntax, "request body is not a valid SCIM resource", 400), operation)
+		return false
+	}
+	return true
+}
+
+// bindPatch: PatchOp 요청을 파싱하여 서비스 연산 목록으로 변환합니다
+func (h *Handler) bindPatch(c *gin.Context, operation string) ([]domain.SCIMPatchOperation, bool) {
+	var req PatchRequest
+	if !h.bindJSON(c, &req, operation) {
+		return nil, false
+	}
+	if len(req.Operations) == 0 {
+		h.respondError(c, domain.NewSCIMError(domain.SCIMErrorInvalidValue, "at least one operation is required", 400), operation)
+		return nil, false
+	}
+
+	operations := make([]domain.SCIMPatchOperation, 0, len(req.Operations))
+	for _, op := range req.Operations {
+		var value interface{}
+		if len(op.Value) > 0 {
+			if err := json.Unmarshal(op.Value, &value); err != nil {
+				h.respondError(c, domain.NewSCIMError(domain.SCIMErrorInvalidSyntax, "invalid operation value", 400), operation)
+				return nil, false
+			}
+		}
+		operations = append(operations, domain.SCIMPatchOperation{
+			Op:    op.Op,
+			Path:  op.Path,
+			Value: value,
+		})
+	}
+	return operations, true
+}
+
+// token: 미들웨어가 검증한 SCIM 토큰을 반환합니다
+func (h *Handler) token(c *gin.Context) *domain.SCIMToken {
+	value, _ := c.Get(TokenContextKey)
+	token, _ := value.(*domain.SCIMToken)
+	return token
+}
+
+// parseListParams: startIndex와 count 쿼리 파라미터를 파싱합니다 (count가 없으면 -1)
+func (h *Handler) parseListParams(c *gin.Context) (int, int) {
+	startIndex, err := strconv.Atoi(c.Query("startIndex"))
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+	count, err := strconv.Atoi(c.Query("count"))
+	if err != nil || count < 0 {
+		count = -1
+	}
+	return startIndex, count
+}
+
+// baseURL: 요청 호스트 기준 SCIM 기본 URL을 반환합니다
+func (h *Handler) baseURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if forwarded := c.GetHeader("X-Forwarded-Proto"); forwarded != "" {
+		scheme = forwarded
+	}
+	return scheme + "://" + c.Request.Host + BasePath
+}
+
+// newListResponse: SCIM ListResponse 메시지를 생성합니다
+func newListResponse(resources interface{}, itemsPerPage int, total int64, startIndex int) ListResponse {
+	return ListResponse{
+		Schemas:      []string{domain.SCIMSchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: itemsPerPage,
+		Resources:    resources,
+	}
+}
+
+// toUserResource: 도메인 사용자를 SCIM User 리소스로 변환합니다
+func toUserResource(baseURL string, user *domain.User, groups []*domain.SCIMGroup) UserResource {
+	resource := UserResource{
+		Schemas:     []string{domain.SCIMSchemaUser},
+		ID:          user.ID.String(),
+		ExternalID:  user.ExternalID,
+		UserName:    user.Username,
+		DisplayName: user.Username,
+		Emails:      []EmailItem{{Value: user.Email, Type: "work", Primary: true}},
+		Active:      user.Active,
+		Meta: ResourceMeta{
+			ResourceType: "User",
+			Created:      user.CreatedAt,
+			LastModified: user.UpdatedAt,
+			Location:     baseURL + "/Users/" + user.ID.String(),
+		},
+	}
+	for _, group := range groups {
+		resource.Groups = append(resource.Groups, MemberRef{
+			Value:   group.ID.String(),
+			Display: group.DisplayName,
+			Ref:     baseURL + "/Groups/" + group.ID.String(),
+		})
+	}
+	return resource
+}
+
+// toGroupResource: 도메인 그룹을 SCIM Group 리소스로 변환합니다
+func toGroupResource(baseURL string, group *domain.SCIMGroup) GroupResource {
+	resource := GroupResource{
+		Schemas:     []string{domain.SCIMSchemaGroup},
+		ID:          group.ID.String(),
+		ExternalID:  group.ExternalID,
+		DisplayName: group.DisplayName,
+		Members:     make([]MemberRef, 0, len(group.Members)),
+		Meta: ResourceMeta{
+			ResourceType: "Group",
+			Created:      group.CreatedAt,
+			LastModified: group.UpdatedAt,
+			Location:     baseURL + "/Groups/" + group.ID.String(),
+		},
+	}
+	for _, member := range group.Members {
+		ref := MemberRef{
+			Value: member.UserID.String(),
+			Ref:   baseURL + "/Users/" + member.UserID.String(),
+		}
+		if member.User != nil {
+			ref.Display = member.User.Username
+		}
+		resource.Members = append(resource.Members, ref)
+	}
+	return resource
+}
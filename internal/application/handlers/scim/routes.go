@@ -0,0 +1,57 @@
+package scim
+
+import (
+	"skyclust/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupRoutes sets up SCIM 2.0 provisioning routes
+// router is scoped to /scim/v2 and authenticated with a dedicated SCIM bearer token
+func SetupRoutes(router *gin.RouterGroup, scimService domain.SCIMService) {
+	scimHandler := NewHandler(scimService)
+
+	router.Use(scimHandler.AuthMiddleware())
+
+	router.GET("/ServiceProviderConfig", scimHandler.GetServiceProviderConfig) // GET /scim/v2/ServiceProviderConfig
+	router.GET("/ResourceTypes", scimHandler.GetResourceTypes)                 // GET /scim/v2/ResourceTypes
+
+	usersGroup := router.Group("/Users")
+	{
+		usersGroup.GET("", scimHandler.ListUsers)         // GET /scim/v2/Users?filter=
+		usersGroup.POST("", scimHandler.CreateUser)       // POST /scim/v2/Users
+		usersGroup.GET("/:id", scimHandler.GetUser)       // GET /scim/v2/Users/:id
+		usersGroup.PUT("/:id", scimHandler.ReplaceUser)   // PUT /scim/v2/Users/:id
+		usersGroup.PATCH("/:id", scimHandler.PatchUser)   // PATCH /scim/v2/Users/:id
+		usersGroup.DELETE("/:id", scimHandler.DeleteUser) // DELETE /scim/v2/Users/:id
+	}
+
+	groupsGroup := router.Group("/Groups")
+	{
+		groupsGroup.GET("", scimHandler.ListGroups)         // GET /scim/v2/Groups?filter=
+		groupsGroup.POST("", scimHandler.CreateGroup)       // POST /scim/v2/Groups
+		groupsGroup.GET("/:id", scimHandler.GetGroup)       // GET /scim/v2/Groups/:id
+		groupsGroup.PUT("/:id", scimHandler.ReplaceGroup)   // PUT /scim/v2/Groups/:id
+		groupsGroup.PATCH("/:id", scimHandler.PatchGroup)   // PATCH /scim/v2/Groups/:id
+		groupsGroup.DELETE("/:id", scimHandler.DeleteGroup) // DELETE /scim/v2/Groups/:id
+	}
+}
+
+// SetupAdminRoutes sets up SCIM token and group binding management routes (admin only)
+// router is scoped to /api/v1/admin/scim
+func SetupAdminRoutes(router *gin.RouterGroup, scimService domain.SCIMService) {
+	scimHandler := NewHandler(scimService)
+
+	tokensGroup := router.Group("/tokens")
+	{
+		tokensGroup.POST("", scimHandler.CreateToken)       // POST /api/v1/admin/scim/tokens
+		tokensGroup.GET("", scimHandler.ListTokens)         // GET /api/v1/admin/scim/tokens
+		tokensGroup.DELETE("/:id", scimHandler.RevokeToken) // DELETE /api/v1/admin/scim/tokens/:id
+	}
+
+	groupsGroup := router.Group("/groups")
+	{
+		groupsGroup.GET("", scimHandler.ListSyncedGroups)                 // GET /api/v1/admin/scim/groups
+		groupsGroup.PUT("/:id/workspace", scimHandler.BindGroupWorkspace) // PUT /api/v1/admin/scim/groups/:id/workspace
+	}
+}
@@ -0,0 +1,165 @@
+package scim
+
+import (
+	"encoding/json"
+	"skyclust/internal/domain"
+	"time"
+)
+
+// SCIM 프로토콜 상수
+const (
+	ContentType     = "application/scim+json"
+	BasePath        = "/scim/v2"
+	TokenContextKey = "scim_token"
+)
+
+// UserRequest represents a SCIM User resource sent by the identity provider
+type UserRequest struct {
+	Schemas     []string    `json:"schemas"`
+	UserName    string      `json:"userName"`
+	ExternalID  string      `json:"externalId"`
+	DisplayName string      `json:"displayName"`
+	Emails      []EmailItem `json:"emails"`
+	Active      *bool       `json:"active"`
+	Password    string      `json:"password"`
+}
+
+// ToAttributes converts the request into the attributes understood by the SCIM service
+func (r UserRequest) ToAttributes() domain.SCIMUserAttributes {
+	attrs := domain.SCIMUserAttributes{
+		UserName:   r.UserName,
+		ExternalID: r.ExternalID,
+		Password:   r.Password,
+		Active:     r.Active,
+	}
+	for _, email := range r.Emails {
+		if email.Primary {
+			attrs.Email = email.Value
+			break
+		}
+		if attrs.Email == "" {
+			attrs.Email = email.Value
+		}
+	}
+	return attrs
+}
+
+// EmailItem represents a SCIM multi-valued email attribute
+type EmailItem struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// GroupRequest represents a SCIM Group resource sent by the identity provider
+type GroupRequest struct {
+	Schemas     []string        `json:"schemas"`
+	DisplayName string          `json:"displayName"`
+	ExternalID  string          `json:"externalId"`
+	Members     []MemberRequest `json:"members"`
+}
+
+// ToAttributes converts the request into the attributes understood by the SCIM service
+func (r GroupRequest) ToAttributes() domain.SCIMGroupAttributes {
+	members := make([]string, 0, len(r.Members))
+	for _, member := range r.Members {
+		members = append(members, member.Value)
+	}
+	return domain.SCIMGroupAttributes{
+		DisplayName: r.DisplayName,
+		ExternalID:  r.ExternalID,
+		Members:     members,
+	}
+}
+
+// MemberRequest represents a member reference in a SCIM Group request
+type MemberRequest struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+// PatchRequest represents a SCIM PatchOp message
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// PatchOperation represents a single SCIM PATCH operation
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// UserResource represents a SCIM User resource response
+type UserResource struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	UserName    string       `json:"userName"`
+	DisplayName string       `json:"displayName"`
+	Emails      []EmailItem  `json:"emails"`
+	Active      bool         `json:"active"`
+	Groups      []MemberRef  `json:"groups,omitempty"`
+	Meta        ResourceMeta `json:"meta"`
+}
+
+// GroupResource represents a SCIM Group resource response
+type GroupResource struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	DisplayName string       `json:"displayName"`
+	Members     []MemberRef  `json:"members"`
+	Meta        ResourceMeta `json:"meta"`
+}
+
+// MemberRef represents a reference to another SCIM resource
+type MemberRef struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// ResourceMeta represents the SCIM meta attribute
+type ResourceMeta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location"`
+}
+
+// ListResponse represents a SCIM ListResponse message
+type ListResponse struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults int64       `json:"totalResults"`
+	StartIndex   int         `json:"startIndex"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Resources    interface{} `json:"Resources"`
+}
+
+// ErrorResponse represents a SCIM error message
+type ErrorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+// CreateTokenRequest represents a SCIM token creation request
+type CreateTokenRequest struct {
+	Name      string     `json:"name" validate:"required,min=1,max=100"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// CreateTokenResponse represents a SCIM token creation response
+type CreateTokenResponse struct {
+	*domain.SCIMToken
+	Token string `json:"token"` // Plaintext token, returned only once
+}
+
+// BindWorkspaceRequest represents a SCIM group workspace binding request
+type BindWorkspaceRequest struct {
+	WorkspaceID   *string `json:"workspace_id"`
+	WorkspaceRole string  `json:"workspace_role" validate:"omitempty,oneof=admin member"`
+}
@@ -0,0 +1,310 @@
+package sse
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"skyclust/internal/domain"
+	"skyclust/internal/infrastructure/messaging"
+)
+
+// eventDecision: 클라이언트에게 이벤트를 전달하는 방식
+type eventDecision int
+
+const (
+	eventDrop     eventDecision = iota // 전달하지 않음 (워크스페이스 멤버가 아님)
+	eventRedacted                      // 리소스 내용을 제거하고 식별자만 전달 (읽기 권한 없음)
+	eventFull                          // 그대로 전달
+)
+
+// membershipActions: 연결별 권한 캐시를 무효화하는 워크스페이스 이벤트 액션
+var membershipActions = map[string]bool{
+	"member_added":        true,
+	"member_removed":      true,
+	"member_role_updated": true,
+	"deleted":             true,
+}
+
+// credentialAccess: 연결별로 캐시되는 자격증명 접근 정보
+type credentialAccess struct {
+	workspaceID string
+	access      *domain.WorkspaceAccess // nil이면 워크스페이스 멤버가 아니거나 자격증명이 없음
+	expiresAt   time.Time
+}
+
+// EventAuthorizer: 이벤트의 자격증명을 워크스페이스로 해석하고 연결 사용자의 워크스페이스 권한을 확인합니다
+type EventAuthorizer struct {
+	credentialRepo  domain.CredentialRepository
+	workspaceRBAC   domain.WorkspaceRBACService
+	credentialCache sync.Map // credential ID -> workspace ID (자격증명의 워크스페이스는 바뀌지 않음)
+}
+
+// NewEventAuthorizer: 새로운 이벤트 권한 확인기를 생성합니다
+func NewEventAuthorizer(credentialRepo domain.CredentialRepository, workspaceRBAC domain.WorkspaceRBACService) *EventAuthorizer {
+	return &EventAuthorizer{
+		credentialRepo: credentialRepo,
+		workspaceRBAC:  workspaceRBAC,
+	}
+}
+
+// authorizeEvent: 클라이언트에게 이벤트를 어떻게 전달할지 결정합니다
+// 자격증명을 알 수 없는 리소스 이벤트는 워크스페이스를 확인할 수 없으므로 전달하지 않습니다
+// 작업 이벤트는 자격증명이 없을 수 있으므로(VM 작업) 이벤트의 워크스페이스로 확인합니다
+func (h *SSEHandler) authorizeEvent(client *SSEClient, subject, eventType string, data interface{}) eventDecision {
+	if h.isSystemEvent(eventType) {
+		return eventFull
+	}
+	if h.authorizer == nil {
+		// Fail closed: 권한 확인기 없이 워크스페이스 리소스 이벤트를 전달하지 않음
+		return eventDrop
+	}
+
+	var entry *credentialAccess
+	permission := eventReadPermission(eventType)
+	if eventType == EventTypeOperationUpdated {
+		workspaceID := eventField(data, "workspace_id")
+		if workspaceID == "" {
+			return eventDrop
+		}
+		entry = h.workspaceAccessEntry(client, workspaceID)
+		permission = domain.OperationReadPermission(eventField(data, "operation_type"))
+	} else {
+		credentialID := eventCredentialID(subject, data)
+		if credentialID == "" {
+			return eventDrop
+		}
+		entry = h.credentialAccess(client, credentialID)
+	}
+
+	if entry.access == nil {
+		return eventDrop
+	}
+	// 특정 워크스페이스로 연결한 클라이언트는 해당 워크스페이스의 이벤트만 받음
+	if client.WorkspaceID != "" && client.WorkspaceID != entry.workspaceID {
+		return eventDrop
+	}
+	if !entry.access.Has(permission) {
+		return eventRedacted
+	}
+	return eventFull
+}
+
+// credentialAccess: 연결의 캐시에서 자격증명 접근 정보를 조회하고, 없거나 만료되었으면 다시 확인합니다
+func (h *SSEHandler) credentialAccess(client *SSEClient, credentialID string) *credentialAccess {
+	client.accessMu.Lock()
+	entry, ok := client.credentialAccess[credentialID]
+	client.accessMu.Unlock()
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry
+	}
+
+	entry = &credentialAccess{expiresAt: time.Now().Add(AuthorizationCacheTTL)}
+	workspaceID, err := h.authorizer.credentialWorkspace(credentialID)
+	if err != nil {
+		h.logger.Warn("Failed to resolve credential workspace for SSE event",
+			zap.String("credential_id", credentialID),
+			zap.Error(err))
+		// 일시적인 오류는 캐시하지 않음
+		return entry
+	}
+
+	if workspaceID != "" {
+		entry.workspaceID = workspaceID
+		if !h.resolveWorkspaceAccess(client, entry) {
+			return entry
+		}
+	}
+
+	client.accessMu.Lock()
+	client.credentialAccess[credentialID] = entry
+	client.accessMu.Unlock()
+	return entry
+}
+
+// workspaceAccessEntry: 워크스페이스 ID로 접근 정보를 조회합니다 (자격증명 캐시와 같은 맵에 workspace: 접두사로 저장)
+func (h *SSEHandler) workspaceAccessEntry(client *SSEClient, workspaceID string) *credentialAccess {
+	key := "workspace:" + workspaceID
+	client.accessMu.Lock()
+	entry, ok := client.credentialAccess[key]
+	client.accessMu.Unlock()
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry
+	}
+
+	entry = &credentialAccess{workspaceID: workspaceID, expiresAt: time.Now().Add(AuthorizationCacheTTL)}
+	if !h.resolveWorkspaceAccess(client, entry) {
+		return entry
+	}
+
+	client.accessMu.Lock()
+	client.credentialAccess[key] = entry
+	client.accessMu.Unlock()
+	return entry
+}
+
+// resolveWorkspaceAccess: 연결 사용자의 워크스페이스 권한을 채웁니다 (일시적인 오류면 false를 반환하고 캐시하지 않음)
+func (h *SSEHandler) resolveWorkspaceAccess(client *SSEClient, entry *credentialAccess) bool {
+	access, err := h.authorizer.workspaceAccess(client.UserUUID, entry.workspaceID)
+	if err != nil {
+		h.logger.Warn("Failed to resolve workspace access for SSE client",
+			zap.String("client_id", client.ID),
+			zap.String("workspace_id", entry.workspaceID),
+			zap.Error(err))
+		return false
+	}
+	entry.access = access
+	return true
+}
+
+// invalidateWorkspaceAccess: 워크스페이스 멤버십이 바뀌면 모든 연결에서 해당 워크스페이스의 캐시를 제거합니다
+func (h *SSEHandler) invalidateWorkspaceAccess(workspaceID string) {
+	h.clientsMux.RLock()
+	defer h.clientsMux.RUnlock()
+
+	for _, client := range h.clients {
+		client.accessMu.Lock()
+		for credentialID, entry := range client.credentialAccess {
+			// 워크스페이스를 알 수 없는 항목(멤버가 아니었던 경우 포함)도 다시 확인
+			if entry.workspaceID == workspaceID || entry.workspaceID == "" {
+				delete(client.credentialAccess, credentialID)
+			}
+		}
+		client.accessMu.Unlock()
+	}
+}
+
+// handleWorkspaceEvent: 워크스페이스 이벤트 subject(workspace.{workspace_id}.{action})를 보고 캐시를 무효화합니다
+func (h *SSEHandler) handleWorkspaceEvent(subject string) {
+	tokens := strings.Split(subject, ".")
+	if len(tokens) != 3 || tokens[0] != messaging.ResourceWorkspace || !membershipActions[tokens[2]] {
+		return
+	}
+	h.invalidateWorkspaceAccess(tokens[1])
+}
+
+// credentialWorkspace: 자격증명이 속한 워크스페이스 ID를 반환합니다 (자격증명이 없으면 빈 문자열)
+func (a *EventAuthorizer) credentialWorkspace(credentialID string) (string, error) {
+	if workspaceID, ok := a.credentialCache.Load(credentialID); ok {
+		return workspaceID.(string), nil
+	}
+
+	id, err := uuid.Parse(credentialID)
+	if err != nil {
+		return "", nil
+	}
+	credential, err := a.credentialRepo.GetByID(id)
+	if err != nil {
+		return "", err
+	}
+	if credential == nil {
+		return "", nil
+	}
+
+	workspaceID := credential.WorkspaceID.String()
+	a.credentialCache.Store(credentialID, workspaceID)
+	return workspaceID, nil
+}
+
+// workspaceAccess: 사용자의 워크스페이스 권한을 조회합니다 (멤버가 아니면 nil)
+func (a *EventAuthorizer) workspaceAccess(userID uuid.UUID, workspaceID string) (*domain.WorkspaceAccess, error) {
+	id, err := uuid.Parse(workspaceID)
+	if err != nil {
+		return nil, nil
+	}
+	access, err := a.workspaceRBAC.GetWorkspaceAccess(context.Background(), id, userID)
+	if err != nil {
+		if domainErr, ok := err.(*domain.DomainError); ok && domainErr.StatusCode == 404 {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if access.Role == "" {
+		return nil, nil
+	}
+	return access, nil
+}
+
+// eventCredentialID: subject 또는 메시지 데이터에서 자격증명 ID를 찾습니다
+// subject 형식: kubernetes|network|vm.{provider}.{credential_id}...
+func eventCredentialID(subject string, data interface{}) string {
+	tokens := strings.Split(subject, ".")
+	if len(tokens) >= 4 {
+		switch tokens[0] {
+		case messaging.ResourceKubernetes, messaging.ResourceNetwork, messaging.ResourceVM:
+			if _, err := uuid.Parse(tokens[2]); err == nil {
+				return tokens[2]
+			}
+		}
+	}
+
+	dataMap, ok := data.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	for _, key := range []string{"credential_id", FieldCredentialID} {
+		if value, ok := dataMap[key].(string); ok && value != "" {
+			return value
+		}
+	}
+	// messaging.Event 형식이면 data 필드 안에서 찾음
+	if nested, ok := dataMap["data"].(map[string]interface{}); ok {
+		for _, key := range []string{"credential_id", FieldCredentialID} {
+			if value, ok := nested[key].(string); ok && value != "" {
+				return value
+			}
+		}
+	}
+	return ""
+}
+
+// eventField: 메시지 데이터(또는 messaging.Event의 data 필드)에서 문자열 값을 찾습니다
+func eventField(data interface{}, key string) string {
+	dataMap, ok := data.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	if value, ok := dataMap[key].(string); ok && value != "" {
+		return value
+	}
+	if nested, ok := dataMap["data"].(map[string]interface{}); ok {
+		if value, ok := nested[key].(string); ok {
+			return value
+		}
+	}
+	return ""
+}
+
+// eventReadPermission: 이벤트 내용을 보려면 필요한 워크스페이스 권한을 반환합니다
+func eventReadPermission(eventType string) domain.Permission {
+	switch {
+	case strings.HasPrefix(eventType, "kubernetes-"):
+		return domain.KubernetesRead
+	case strings.HasPrefix(eventType, "network-"):
+		return domain.NetworkRead
+	default:
+		return domain.ComputeRead
+	}
+}
+
+// redactEventData: 리소스 내용을 제거하고 UI가 갱신 여부를 판단할 식별자만 남깁니다
+func redactEventData(data interface{}) interface{} {
+	redacted := map[string]interface{}{"redacted": true}
+
+	dataMap, ok := data.(map[string]interface{})
+	if !ok {
+		return redacted
+	}
+	if nested, ok := dataMap["data"].(map[string]interface{}); ok {
+		dataMap = nested
+	}
+	for _, key := range []string{"provider", "credential_id", FieldCredentialID, "region", "action", "timestamp", "operation_id"} {
+		if value, exists := dataMap[key]; exists {
+			redacted[key] = value
+		}
+	}
+	return redacted
+}
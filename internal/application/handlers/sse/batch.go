@@ -17,6 +17,7 @@ type BatchBuffer struct {
 
 // BatchEvent represents an event in the batch buffer
 type BatchEvent struct {
+	Subject   string // NATS subject (권한 확인에 사용)
 	EventType string
 	Data      interface{}
 	Timestamp time.Time
@@ -37,6 +37,9 @@ const (
 	EventTypeNetworkSecurityGroupUpdated = "network-security-group-updated"
 	EventTypeNetworkSecurityGroupDeleted = "network-security-group-deleted"
 	EventTypeNetworkSecurityGroupList    = "network-security-group-list"
+
+	// EventTypeOperationUpdated carries progress of a long-running cloud operation (cluster create, VM start, ...)
+	EventTypeOperationUpdated = "operation-updated"
 )
 
 // SSE timing constants
@@ -46,6 +49,28 @@ const (
 	HeartbeatInterval  = 30 * time.Second
 	BatchFlushInterval = 100 * time.Millisecond
 	BatchMaxSize       = 10
+
+	// AuthorizationCacheTTL bounds how long a connection trusts a cached credential permission check
+	// Membership changes invalidate the cache immediately; the TTL covers custom role permission edits
+	AuthorizationCacheTTL = 5 * time.Minute
+)
+
+// SSE event history (Postgres ring buffer) constants
+const (
+	// EventStoreQueueGroup makes exactly one server instance persist each resource event
+	EventStoreQueueGroup = "sse-event-store"
+	// StoredEventSubject fans persisted events (with their IDs) out to every server instance
+	StoredEventSubject = "sse.events.stored"
+
+	EventHistoryMaxEvents    = 10000
+	EventHistoryMaxAge       = 24 * time.Hour
+	EventHistoryTrimInterval = 5 * time.Minute
+	ReplayMaxEvents          = 1000
+	HistoryDefaultLimit      = 50
+	HistoryMaxLimit          = 500
+
+	// EventTypeStreamReset tells a reconnecting client that missed events are no longer available and it must refetch
+	EventTypeStreamReset = "stream-reset"
 )
 
 // SSE data field names
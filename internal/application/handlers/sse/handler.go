@@ -6,10 +6,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/nats-io/nats.go"
 	"go.uber.org/zap"
 	"skyclust/internal/domain"
@@ -32,17 +34,22 @@ type SSEHandler struct {
 	clients     map[string]*SSEClient
 	clientsMux  sync.RWMutex
 	batchBuffer *BatchBuffer
+	authorizer  *EventAuthorizer
+	eventStore  domain.StreamEventRepository // nil이면 이벤트를 기록하지 않음 (재전송 불가)
 }
 
 // SSEClient: SSE 클라이언트를 나타내는 구조체
 type SSEClient struct {
 	ID       string
 	UserID   string
-	Writer   http.ResponseWriter
-	Flusher  http.Flusher
-	Context  context.Context
-	Cancel   context.CancelFunc
-	LastSeen time.Time
+	UserUUID uuid.UUID
+	// 연결 시 지정한 워크스페이스 (비어 있으면 사용자가 속한 모든 워크스페이스)
+	WorkspaceID string
+	Writer      http.ResponseWriter
+	Flusher     http.Flusher
+	Context     context.Context
+	Cancel      context.CancelFunc
+	LastSeen    time.Time
 	// 구독 중인 이벤트 타입들
 	SubscribedEvents map[string]bool
 	// 구독 중인 VM/Provider ID들
@@ -50,6 +57,12 @@ type SSEClient struct {
 	SubscribedProviders map[string]bool
 	// 구독 필터 (provider, credential_id, region 기반)
 	Filters SSEClientFilters
+	// 권한이 확인된 자격증명 캐시 (멤버십 변경 시 무효화)
+	credentialAccess map[string]*credentialAccess
+	accessMu         sync.Mutex
+	// Last-Event-ID 재전송으로 이미 보낸 마지막 이벤트 ID (실시간 이벤트 중복 방지)
+	replayedUntil int64
+	replayMu      sync.Mutex
 }
 
 // SSEClientFilters: SSE 클라이언트 구독을 위한 필터를 정의하는 구조체
@@ -61,7 +74,7 @@ type SSEClientFilters struct {
 }
 
 // NewSSEHandler: 새로운 SSE 핸들러를 생성합니다
-func NewSSEHandler(logger *zap.Logger, natsConn *nats.Conn, eventBus interface{}) *SSEHandler {
+func NewSSEHandler(logger *zap.Logger, natsConn *nats.Conn, eventBus interface{}, authorizer *EventAuthorizer, eventStore domain.StreamEventRepository) *SSEHandler {
 	// realtime.Service 생성 (eventBus를 messaging.Bus로 변환)
 	var messagingBus messaging.Bus
 	if bus, ok := eventBus.(messaging.Bus); ok {
@@ -79,6 +92,8 @@ func NewSSEHandler(logger *zap.Logger, natsConn *nats.Conn, eventBus interface{}
 		natsConn:    natsConn,
 		realtimeSvc: realtimeSvc,
 		clients:     make(map[string]*SSEClient),
+		authorizer:  authorizer,
+		eventStore:  eventStore,
 	}
 
 	// 배치 버퍼 초기화
@@ -91,6 +106,11 @@ func NewSSEHandler(logger *zap.Logger, natsConn *nats.Conn, eventBus interface{}
 	ctx := context.Background()
 	go realtimeSvc.StartCleanupRoutine(ctx)
 
+	// 이벤트 기록 링 버퍼 정리
+	if eventStore != nil {
+		go handler.trimEventHistory(ctx)
+	}
+
 	return handler
 }
 
@@ -114,121 +134,94 @@ func (h *SSEHandler) flushBatch(events []BatchEvent) {
 		default:
 			// Send batch of events
 			for _, event := range events {
-				if h.shouldSendToClient(client, event.EventType, event.Data) {
+				if !h.shouldSendToClient(client, event.EventType, event.Data) {
+					continue
+				}
+				switch h.authorizeEvent(client, event.Subject, event.EventType, event.Data) {
+				case eventFull:
 					h.sendToClient(client, event.EventType, event.Data)
+				case eventRedacted:
+					h.sendToClient(client, event.EventType, redactEventData(event.Data))
 				}
 			}
 		}
 	}
 }
 
-func (h *SSEHandler) setupNATSSubscriptions() {
-	// VM 상태 업데이트 구독
-	_, _ = h.natsConn.Subscribe("vm.status.update", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeVMStatus, m.Data)
-	})
-
-	// VM 리소스 업데이트 구독
-	_, _ = h.natsConn.Subscribe("vm.resource.update", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeVMResource, m.Data)
-	})
-
-	// Provider 상태 업데이트 구독
-	_, _ = h.natsConn.Subscribe("provider.status.update", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeProviderStatus, m.Data)
-	})
-
-	// Provider 인스턴스 업데이트 구독
-	_, _ = h.natsConn.Subscribe("provider.instance.update", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeProviderInstance, m.Data)
-	})
+// resourceSubscriptions: SSE로 전달하는 리소스 이벤트 subject(와일드카드 패턴)와 이벤트 타입
+var resourceSubscriptions = []struct {
+	subject   string
+	eventType string
+}{
+	// VM/Provider 상태 업데이트
+	{"vm.status.update", EventTypeVMStatus},
+	{"vm.resource.update", EventTypeVMResource},
+	{"provider.status.update", EventTypeProviderStatus},
+	{"provider.instance.update", EventTypeProviderInstance},
+
+	// Kubernetes 클러스터/Node Pool/Node 이벤트
+	{"kubernetes.*.*.*.clusters.created", EventTypeKubernetesClusterCreated},
+	{"kubernetes.*.*.*.clusters.updated", EventTypeKubernetesClusterUpdated},
+	{"kubernetes.*.*.*.clusters.deleted", EventTypeKubernetesClusterDeleted},
+	{"kubernetes.*.*.*.clusters.list", EventTypeKubernetesClusterList},
+	{"kubernetes.*.*.clusters.*.nodepools.created", EventTypeKubernetesNodePoolCreated},
+	{"kubernetes.*.*.clusters.*.nodepools.updated", EventTypeKubernetesNodePoolUpdated},
+	{"kubernetes.*.*.clusters.*.nodepools.deleted", EventTypeKubernetesNodePoolDeleted},
+	{"kubernetes.*.*.clusters.*.nodes.created", EventTypeKubernetesNodeCreated},
+	{"kubernetes.*.*.clusters.*.nodes.updated", EventTypeKubernetesNodeUpdated},
+	{"kubernetes.*.*.clusters.*.nodes.deleted", EventTypeKubernetesNodeDeleted},
+
+	// Network VPC/Subnet/Security Group 이벤트
+	{"network.*.*.*.vpcs.created", EventTypeNetworkVPCCreated},
+	{"network.*.*.*.vpcs.updated", EventTypeNetworkVPCUpdated},
+	{"network.*.*.*.vpcs.deleted", EventTypeNetworkVPCDeleted},
+	{"network.*.*.*.vpcs.list", EventTypeNetworkVPCList},
+	{"network.*.*.vpcs.*.subnets.created", EventTypeNetworkSubnetCreated},
+	{"network.*.*.vpcs.*.subnets.updated", EventTypeNetworkSubnetUpdated},
+	{"network.*.*.vpcs.*.subnets.deleted", EventTypeNetworkSubnetDeleted},
+	{"network.*.*.vpcs.*.subnets.list", EventTypeNetworkSubnetList},
+	{"network.*.*.*.security-groups.created", EventTypeNetworkSecurityGroupCreated},
+	{"network.*.*.*.security-groups.updated", EventTypeNetworkSecurityGroupUpdated},
+	{"network.*.*.*.security-groups.deleted", EventTypeNetworkSecurityGroupDeleted},
+	{"network.*.*.*.security-groups.list", EventTypeNetworkSecurityGroupList},
+
+	// 장시간 클라우드 작업 진행 상황
+	{"operation.updated", EventTypeOperationUpdated},
+}
 
-	// 시스템 알림 구독
+func (h *SSEHandler) setupNATSSubscriptions() {
+	// 시스템 알림 구독 (기록하지 않고 바로 전달)
 	_, _ = h.natsConn.Subscribe("system.notification", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeSystemNotification, m.Data)
+		h.broadcastToClients(m.Subject, EventTypeSystemNotification, m.Data)
 	})
-
-	// 시스템 알림 구독
 	_, _ = h.natsConn.Subscribe("system.alert", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeSystemAlert, m.Data)
-	})
-
-	// Kubernetes 클러스터 이벤트 구독 (와일드카드 패턴)
-	_, _ = h.natsConn.Subscribe("kubernetes.*.*.*.clusters.created", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeKubernetesClusterCreated, m.Data)
-	})
-	_, _ = h.natsConn.Subscribe("kubernetes.*.*.*.clusters.updated", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeKubernetesClusterUpdated, m.Data)
-	})
-	_, _ = h.natsConn.Subscribe("kubernetes.*.*.*.clusters.deleted", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeKubernetesClusterDeleted, m.Data)
-	})
-	_, _ = h.natsConn.Subscribe("kubernetes.*.*.*.clusters.list", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeKubernetesClusterList, m.Data)
+		h.broadcastToClients(m.Subject, EventTypeSystemAlert, m.Data)
 	})
 
-	// Kubernetes Node Pool 이벤트 구독
-	_, _ = h.natsConn.Subscribe("kubernetes.*.*.clusters.*.nodepools.created", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeKubernetesNodePoolCreated, m.Data)
-	})
-	_, _ = h.natsConn.Subscribe("kubernetes.*.*.clusters.*.nodepools.updated", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeKubernetesNodePoolUpdated, m.Data)
-	})
-	_, _ = h.natsConn.Subscribe("kubernetes.*.*.clusters.*.nodepools.deleted", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeKubernetesNodePoolDeleted, m.Data)
-	})
-
-	// Kubernetes Node 이벤트 구독
-	_, _ = h.natsConn.Subscribe("kubernetes.*.*.clusters.*.nodes.created", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeKubernetesNodeCreated, m.Data)
-	})
-	_, _ = h.natsConn.Subscribe("kubernetes.*.*.clusters.*.nodes.updated", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeKubernetesNodeUpdated, m.Data)
-	})
-	_, _ = h.natsConn.Subscribe("kubernetes.*.*.clusters.*.nodes.deleted", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeKubernetesNodeDeleted, m.Data)
-	})
+	for _, subscription := range resourceSubscriptions {
+		eventType := subscription.eventType
+		if h.eventStore == nil {
+			_, _ = h.natsConn.Subscribe(subscription.subject, func(m *nats.Msg) {
+				h.broadcastToClients(m.Subject, eventType, m.Data)
+			})
+			continue
+		}
 
-	// Network VPC 이벤트 구독
-	_, _ = h.natsConn.Subscribe("network.*.*.*.vpcs.created", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeNetworkVPCCreated, m.Data)
-	})
-	_, _ = h.natsConn.Subscribe("network.*.*.*.vpcs.updated", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeNetworkVPCUpdated, m.Data)
-	})
-	_, _ = h.natsConn.Subscribe("network.*.*.*.vpcs.deleted", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeNetworkVPCDeleted, m.Data)
-	})
-	_, _ = h.natsConn.Subscribe("network.*.*.*.vpcs.list", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeNetworkVPCList, m.Data)
-	})
+		// 한 인스턴스만 기록하도록 큐 그룹으로 구독하고, 기록된 이벤트는 StoredEventSubject로 모든 인스턴스에 전달
+		_, _ = h.natsConn.QueueSubscribe(subscription.subject, EventStoreQueueGroup, func(m *nats.Msg) {
+			h.storeEvent(m.Subject, eventType, m.Data)
+		})
+	}
 
-	// Network Subnet 이벤트 구독
-	_, _ = h.natsConn.Subscribe("network.*.*.vpcs.*.subnets.created", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeNetworkSubnetCreated, m.Data)
-	})
-	_, _ = h.natsConn.Subscribe("network.*.*.vpcs.*.subnets.updated", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeNetworkSubnetUpdated, m.Data)
-	})
-	_, _ = h.natsConn.Subscribe("network.*.*.vpcs.*.subnets.deleted", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeNetworkSubnetDeleted, m.Data)
-	})
-	_, _ = h.natsConn.Subscribe("network.*.*.vpcs.*.subnets.list", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeNetworkSubnetList, m.Data)
-	})
+	if h.eventStore != nil {
+		_, _ = h.natsConn.Subscribe(StoredEventSubject, func(m *nats.Msg) {
+			h.broadcastStoredEvent(m.Data)
+		})
+	}
 
-	// Network Security Group 이벤트 구독
-	_, _ = h.natsConn.Subscribe("network.*.*.*.security-groups.created", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeNetworkSecurityGroupCreated, m.Data)
-	})
-	_, _ = h.natsConn.Subscribe("network.*.*.*.security-groups.updated", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeNetworkSecurityGroupUpdated, m.Data)
-	})
-	_, _ = h.natsConn.Subscribe("network.*.*.*.security-groups.deleted", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeNetworkSecurityGroupDeleted, m.Data)
-	})
-	_, _ = h.natsConn.Subscribe("network.*.*.*.security-groups.list", func(m *nats.Msg) {
-		h.broadcastToClients(EventTypeNetworkSecurityGroupList, m.Data)
+	// 워크스페이스 멤버십 변경 시 권한 캐시 무효화
+	_, _ = h.natsConn.Subscribe("workspace.*.*", func(m *nats.Msg) {
+		h.handleWorkspaceEvent(m.Subject)
 	})
 }
 
@@ -246,16 +239,22 @@ func (h *SSEHandler) HandleSSE(c *gin.Context) {
 func (h *SSEHandler) handleSSECore() handlers.HandlerFunc {
 	return func(c *gin.Context) {
 		// 사용자 ID 추출 (JWT에서)
-		userID, exists := c.Get("user_id")
-		if !exists {
+		userID, err := h.GetUserIDFromToken(c)
+		if err != nil {
 			h.HandleError(c, domain.NewDomainError(domain.ErrCodeUnauthorized, "User not authenticated", 401), "handle_sse")
 			return
 		}
 
 		// Workspace ID 추출 (선택적)
-		workspaceID := ""
+		workspaceID := c.Query("workspace_id")
 		if wsID, exists := c.Get("workspace_id"); exists {
-			workspaceID = wsID.(string)
+			workspaceID, _ = wsID.(string)
+		}
+		if workspaceID != "" {
+			if _, err := uuid.Parse(workspaceID); err != nil {
+				h.HandleError(c, domain.NewDomainError(domain.ErrCodeBadRequest, "invalid workspace_id", 400), "handle_sse")
+				return
+			}
 		}
 
 		// SSE 헤더 설정
@@ -266,7 +265,7 @@ func (h *SSEHandler) handleSSECore() handlers.HandlerFunc {
 		c.Header("Access-Control-Allow-Headers", "Cache-Control")
 
 		// realtime.Service를 사용하여 SSE 연결 생성
-		conn, err := h.realtimeSvc.CreateSSEConnection(c.Writer, c.Request, userID.(string), workspaceID)
+		conn, err := h.realtimeSvc.CreateSSEConnection(c.Writer, c.Request, userID.String(), workspaceID)
 		if err != nil {
 			h.HandleError(c, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("Failed to create SSE connection: %v", err), 500), "handle_sse")
 			return
@@ -276,6 +275,8 @@ func (h *SSEHandler) handleSSECore() handlers.HandlerFunc {
 		client := &SSEClient{
 			ID:                  conn.ID,
 			UserID:              conn.UserID,
+			UserUUID:            userID,
+			WorkspaceID:         workspaceID,
 			Writer:              conn.Writer,
 			Flusher:             conn.Flusher,
 			Context:             conn.Context,
@@ -290,13 +291,19 @@ func (h *SSEHandler) handleSSECore() handlers.HandlerFunc {
 				Regions:       make(map[string]bool),
 				ResourceTypes: make(map[string]bool),
 			},
+			credentialAccess: make(map[string]*credentialAccess),
 		}
 
 		h.clientsMux.Lock()
 		h.clients[conn.ID] = client
 		h.clientsMux.Unlock()
 
-		h.LogInfo(c, "SSE client connected", zap.String("client_id", conn.ID), zap.String("user_id", userID.(string)))
+		h.LogInfo(c, "SSE client connected", zap.String("client_id", conn.ID), zap.String("user_id", userID.String()))
+
+		// 재연결이면 놓친 이벤트를 먼저 재전송
+		if lastEventID := lastEventIDFromRequest(c); lastEventID > 0 {
+			h.replayEvents(client, lastEventID)
+		}
 
 		// realtime.Service를 사용하여 SSE 연결 처리
 		// 이는 연결 확인 메시지 전송 및 heartbeat 관리를 포함
@@ -350,14 +357,19 @@ func (h *SSEHandler) sendToClient(client *SSEClient, eventType string, data inte
 	client.LastSeen = time.Now()
 }
 
-// broadcastToClients: 모든 구독 클라이언트에게 이벤트를 브로드캐스트합니다
-func (h *SSEHandler) broadcastToClients(eventType string, data []byte) {
+// broadcastToClients: 이벤트를 볼 권한이 있는 구독 클라이언트에게 브로드캐스트합니다
+func (h *SSEHandler) broadcastToClients(subject, eventType string, data []byte) {
 	var messageData interface{}
 	if err := json.Unmarshal(data, &messageData); err != nil {
 		h.logger.Error("Failed to unmarshal NATS message", zap.Error(err))
 		return
 	}
 
+	h.deliverToClients(subject, eventType, 0, messageData)
+}
+
+// deliverToClients: 이벤트를 클라이언트별 구독과 권한에 따라 전달합니다 (eventID가 0이면 id 없이 전송)
+func (h *SSEHandler) deliverToClients(subject, eventType string, eventID int64, messageData interface{}) {
 	// realtime.Service를 사용하여 모든 연결에 브로드캐스팅
 	// 필터링은 각 클라이언트별로 처리
 	h.clientsMux.RLock()
@@ -373,24 +385,59 @@ func (h *SSEHandler) broadcastToClients(eventType string, data []byte) {
 			// 클라이언트가 연결 해제됨
 			continue
 		default:
-			// 이벤트 타입 구독 확인
-			if len(client.SubscribedEvents) > 0 && !client.SubscribedEvents[eventType] {
+			if eventID > 0 {
+				h.deliverStoredToClient(client, subject, eventType, eventID, messageData)
 				continue
 			}
+			h.deliverToClient(client, subject, eventType, "", messageData)
+		}
+	}
+}
 
-			// VM/Provider 특정 구독 확인
-			if !h.shouldSendToClient(client, eventType, messageData) {
-				continue
-			}
+// deliverStoredToClient: 기록된 이벤트를 전달합니다 (재전송 중이면 끝날 때까지 대기하고, 이미 재전송한 이벤트는 건너뜀)
+func (h *SSEHandler) deliverStoredToClient(client *SSEClient, subject, eventType string, eventID int64, messageData interface{}) {
+	client.replayMu.Lock()
+	defer client.replayMu.Unlock()
 
-			// realtime.Service를 통해 이벤트 전송
-			if err := h.realtimeSvc.BroadcastToConnection(client.ID, eventType, messageData); err != nil {
-				h.logger.Warn("Failed to broadcast to connection",
-					zap.String("connection_id", client.ID),
-					zap.String("event_type", eventType),
-					zap.Error(err))
-			}
-		}
+	if eventID <= client.replayedUntil {
+		return
+	}
+	h.deliverToClient(client, subject, eventType, strconv.FormatInt(eventID, 10), messageData)
+}
+
+// deliverToClient: 구독과 권한을 확인하고 한 클라이언트에게 이벤트를 전송합니다
+func (h *SSEHandler) deliverToClient(client *SSEClient, subject, eventType, eventID string, messageData interface{}) {
+	// 이벤트 타입 구독 확인
+	if len(client.SubscribedEvents) > 0 && !client.SubscribedEvents[eventType] {
+		return
+	}
+
+	// VM/Provider 특정 구독 확인
+	if !h.shouldSendToClient(client, eventType, messageData) {
+		return
+	}
+
+	// 워크스페이스 멤버십과 권한 확인
+	payload := messageData
+	switch h.authorizeEvent(client, subject, eventType, messageData) {
+	case eventDrop:
+		return
+	case eventRedacted:
+		payload = redactEventData(messageData)
+	}
+
+	// realtime.Service를 통해 이벤트 전송
+	var err error
+	if eventID != "" {
+		err = h.realtimeSvc.SendEventWithID(client.ID, eventID, eventType, payload)
+	} else {
+		err = h.realtimeSvc.BroadcastToConnection(client.ID, eventType, payload)
+	}
+	if err != nil {
+		h.logger.Warn("Failed to broadcast to connection",
+			zap.String("connection_id", client.ID),
+			zap.String("event_type", eventType),
+			zap.Error(err))
 	}
 }
 
@@ -0,0 +1,253 @@
+package sse
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"skyclust/internal/domain"
+)
+
+// resourceIDFields: 리소스 타입별로 이벤트 데이터에서 리소스 ID를 찾을 필드 (앞에서부터 우선)
+var resourceIDFields = map[string][]string{
+	"clusters":        {"cluster_id", FieldClusterID, "name", "cluster_name"},
+	"nodepools":       {"node_pool_id", "nodepool_id", "name", "node_pool_name"},
+	"nodes":           {"node_id", "name"},
+	"vpcs":            {"vpc_id", FieldVPCID, "name"},
+	"subnets":         {"subnet_id", FieldSubnetID, "name"},
+	"security-groups": {"security_group_id", "name"},
+	"operation":       {"operation_id"},
+}
+
+// storeEvent: 리소스 이벤트를 기록해 ID를 할당하고 모든 인스턴스에 전달합니다
+// 기록에 실패하면 ID 없이 이 인스턴스의 클라이언트에게만 전달합니다
+func (h *SSEHandler) storeEvent(subject, eventType string, data []byte) {
+	var messageData map[string]interface{}
+	if err := json.Unmarshal(data, &messageData); err != nil {
+		h.logger.Error("Failed to unmarshal NATS message", zap.Error(err))
+		return
+	}
+
+	resourceType, resourceID := eventResource(subject, messageData)
+	event := &domain.StreamEvent{
+		Subject:      subject,
+		EventType:    eventType,
+		CredentialID: eventCredentialID(subject, messageData),
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Data:         domain.JSONBMap(messageData),
+	}
+	if err := h.eventStore.Append(event); err != nil {
+		h.logger.Warn("Failed to store SSE event, delivering without replay support",
+			zap.String("subject", subject),
+			zap.Error(err))
+		h.deliverToClients(subject, eventType, 0, messageData)
+		return
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		h.logger.Error("Failed to marshal stored SSE event", zap.Error(err))
+		return
+	}
+	if err := h.natsConn.Publish(StoredEventSubject, payload); err != nil {
+		h.logger.Warn("Failed to fan out stored SSE event, delivering locally",
+			zap.Int64("event_id", event.ID),
+			zap.Error(err))
+		h.deliverToClients(subject, eventType, event.ID, map[string]interface{}(event.Data))
+	}
+}
+
+// broadcastStoredEvent: 기록된 이벤트를 ID와 함께 이 인스턴스의 클라이언트에게 전달합니다
+func (h *SSEHandler) broadcastStoredEvent(data []byte) {
+	var event domain.StreamEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		h.logger.Error("Failed to unmarshal stored SSE event", zap.Error(err))
+		return
+	}
+	h.deliverToClients(event.Subject, event.EventType, event.ID, map[string]interface{}(event.Data))
+}
+
+// replayEvents: Last-Event-ID 이후 기록된 이벤트를 재전송합니다
+// 재전송하는 동안 실시간 이벤트 전달을 멈추고, 재전송한 이벤트는 실시간으로 다시 보내지 않습니다
+// 기록이 이미 정리되었거나 놓친 이벤트가 너무 많으면 stream-reset 이벤트로 다시 조회하도록 알립니다
+func (h *SSEHandler) replayEvents(client *SSEClient, lastEventID int64) {
+	if h.eventStore == nil {
+		return
+	}
+
+	client.replayMu.Lock()
+	defer client.replayMu.Unlock()
+
+	oldestID, err := h.eventStore.GetOldestID()
+	if err != nil {
+		h.logger.Warn("Failed to read SSE event history for replay", zap.String("client_id", client.ID), zap.Error(err))
+		h.sendStreamReset(client, lastEventID, "history_unavailable")
+		return
+	}
+	if oldestID > lastEventID+1 {
+		h.sendStreamReset(client, lastEventID, "history_expired")
+		return
+	}
+
+	events, err := h.eventStore.ListAfter(lastEventID, ReplayMaxEvents+1)
+	if err != nil {
+		h.logger.Warn("Failed to read SSE event history for replay", zap.String("client_id", client.ID), zap.Error(err))
+		h.sendStreamReset(client, lastEventID, "history_unavailable")
+		return
+	}
+	if len(events) > ReplayMaxEvents {
+		h.sendStreamReset(client, lastEventID, "too_many_events")
+		return
+	}
+
+	for _, event := range events {
+		h.deliverToClient(client, event.Subject, event.EventType, strconv.FormatInt(event.ID, 10), map[string]interface{}(event.Data))
+		client.replayedUntil = event.ID
+	}
+
+	h.logger.Debug("Replayed SSE events",
+		zap.String("client_id", client.ID),
+		zap.Int64("last_event_id", lastEventID),
+		zap.Int("count", len(events)))
+}
+
+// sendStreamReset: 놓친 이벤트를 재전송할 수 없음을 클라이언트에게 알립니다
+func (h *SSEHandler) sendStreamReset(client *SSEClient, lastEventID int64, reason string) {
+	if err := h.realtimeSvc.BroadcastToConnection(client.ID, EventTypeStreamReset, map[string]interface{}{
+		"last_event_id": lastEventID,
+		"reason":        reason,
+	}); err != nil {
+		h.logger.Warn("Failed to send stream reset", zap.String("client_id", client.ID), zap.Error(err))
+	}
+}
+
+// trimEventHistory: 이벤트 기록을 최대 개수와 보존 기간으로 주기적으로 정리합니다
+func (h *SSEHandler) trimEventHistory(ctx context.Context) {
+	ticker := time.NewTicker(EventHistoryTrimInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := h.eventStore.Trim(EventHistoryMaxEvents, time.Now().Add(-EventHistoryMaxAge)); err != nil {
+				h.logger.Warn("Failed to trim SSE event history", zap.Error(err))
+			}
+		}
+	}
+}
+
+// GetEventHistory: 리소스의 최근 이벤트를 조회합니다 (UI 초기 데이터 채우기용)
+// 쿼리 파라미터: credential_id (필수), resource_type, resource_id, limit
+func (h *SSEHandler) GetEventHistory(c *gin.Context) {
+	// Start performance tracking
+	defer h.TrackRequest(c, "get_sse_event_history", 200)
+
+	userID, err := h.GetUserIDFromToken(c)
+	if err != nil {
+		h.HandleError(c, err, "get_sse_event_history")
+		return
+	}
+
+	credentialID := c.Query("credential_id")
+	if _, err := uuid.Parse(credentialID); err != nil {
+		h.HandleError(c, domain.NewDomainError(domain.ErrCodeBadRequest, "credential_id is required and must be a UUID", 400), "get_sse_event_history")
+		return
+	}
+
+	limit := HistoryDefaultLimit
+	if value := c.Query("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			h.HandleError(c, domain.NewDomainError(domain.ErrCodeBadRequest, "limit must be a positive integer", 400), "get_sse_event_history")
+			return
+		}
+		limit = parsed
+	}
+	if limit > HistoryMaxLimit {
+		limit = HistoryMaxLimit
+	}
+
+	// 기록 조회도 실시간 전달과 같은 규칙으로 권한을 확인 (요청 동안만 캐시)
+	requester := &SSEClient{
+		ID:               "history-" + userID.String(),
+		UserUUID:         userID,
+		credentialAccess: make(map[string]*credentialAccess),
+	}
+	if h.authorizer == nil || h.credentialAccess(requester, credentialID).access == nil {
+		h.HandleError(c, domain.NewDomainError(domain.ErrCodeNotFound, "credential not found", 404), "get_sse_event_history")
+		return
+	}
+
+	events, err := h.eventStore.ListHistory(domain.StreamEventHistoryFilter{
+		CredentialID: credentialID,
+		ResourceType: c.Query("resource_type"),
+		ResourceID:   c.Query("resource_id"),
+		Limit:        limit,
+	})
+	if err != nil {
+		h.HandleError(c, domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to get event history", 500), "get_sse_event_history")
+		return
+	}
+
+	history := make([]*domain.StreamEvent, 0, len(events))
+	for _, event := range events {
+		switch h.authorizeEvent(requester, event.Subject, event.EventType, map[string]interface{}(event.Data)) {
+		case eventFull:
+			history = append(history, event)
+		case eventRedacted:
+			redacted := *event
+			redacted.Data = domain.JSONBMap(redactEventData(map[string]interface{}(event.Data)).(map[string]interface{}))
+			history = append(history, &redacted)
+		}
+	}
+
+	h.OK(c, gin.H{
+		"events": history,
+		"total":  len(history),
+	}, "Event history retrieved successfully")
+}
+
+// lastEventIDFromRequest: Last-Event-ID 헤더(EventSource 재연결) 또는 last_event_id 쿼리 파라미터를 읽습니다
+func lastEventIDFromRequest(c *gin.Context) int64 {
+	value := c.GetHeader("Last-Event-ID")
+	if value == "" {
+		value = c.Query("last_event_id")
+	}
+	id, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil || id < 0 {
+		return 0
+	}
+	return id
+}
+
+// eventResource: subject와 이벤트 데이터에서 리소스 타입과 ID를 찾습니다
+// subject의 마지막 토큰은 액션, 그 앞 토큰은 리소스 타입입니다 (예: network.aws.{cred}.vpcs.{vpc}.subnets.created)
+func eventResource(subject string, data map[string]interface{}) (string, string) {
+	tokens := strings.Split(subject, ".")
+	if len(tokens) < 2 {
+		return "", ""
+	}
+	resourceType := tokens[len(tokens)-2]
+
+	fields, ok := resourceIDFields[resourceType]
+	if !ok {
+		return resourceType, ""
+	}
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		data = nested
+	}
+	for _, field := range fields {
+		if value, ok := data[field].(string); ok && value != "" {
+			return resourceType, value
+		}
+	}
+	return resourceType, ""
+}
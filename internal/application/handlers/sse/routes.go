@@ -6,12 +6,24 @@ import (
 )
 
 // SetupRoutes sets up SSE routes
-func SetupRoutes(router *gin.RouterGroup) {
-	// SSE endpoint (인증 필요)
-	router.GET("/events", func(c *gin.Context) {
-		responses.NewResponseBuilder(c).
-			WithError("not_implemented", "SSE endpoint is not implemented").
-			WithMessage("Not Implemented").
-			Send(501)
-	})
+// sseHandler is nil when NATS is not available
+func SetupRoutes(router *gin.RouterGroup, sseHandler *SSEHandler) {
+	if sseHandler == nil {
+		// SSE endpoint (인증 필요)
+		router.GET("/events", func(c *gin.Context) {
+			responses.NewResponseBuilder(c).
+				WithError("not_implemented", "SSE endpoint is not available without NATS").
+				WithMessage("Not Implemented").
+				Send(501)
+		})
+		return
+	}
+
+	// SSE endpoint (인증 필요, 워크스페이스 멤버십과 권한에 따라 이벤트 전달)
+	router.GET("/events", sseHandler.HandleSSE) // GET /api/v1/sse/events?workspace_id= (Last-Event-ID 헤더로 재연결 시 놓친 이벤트 재전송)
+
+	// 리소스 이벤트 기록 (이벤트 기록 저장소가 있을 때만)
+	if sseHandler.eventStore != nil {
+		router.GET("/events/history", sseHandler.GetEventHistory) // GET /api/v1/sse/events/history?credential_id=&resource_type=&resource_id=&limit=
+	}
 }
@@ -0,0 +1,400 @@
+package webhook
+
+import (
+	"skyclust/internal/domain"
+	"skyclust/internal/shared/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler: 워크스페이스 웹훅 관련 HTTP 요청을 처리하는 핸들러
+type Handler struct {
+	*handlers.BaseHandler
+	webhookService domain.WebhookService
+}
+
+// NewHandler: 새로운 워크스페이스 웹훅 핸들러를 생성합니다
+func NewHandler(webhookService domain.WebhookService) *Handler {
+	return &Handler{
+		BaseHandler:    handlers.NewBaseHandler("webhook"),
+		webhookService: webhookService,
+	}
+}
+
+// ListWebhooks: 워크스페이스 웹훅 목록 조회 요청을 처리합니다
+func (h *Handler) ListWebhooks(c *gin.Context) {
+	handler := h.Compose(
+		h.listWebhooksHandler(),
+		h.StandardCRUDDecorators("list_workspace_webhooks")...,
+	)
+
+	handler(c)
+}
+
+// listWebhooksHandler: 워크스페이스 웹훅 목록 조회의 핵심 비즈니스 로직
+func (h *Handler) listWebhooksHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "list_workspace_webhooks")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceWebhooks); err != nil {
+			h.HandleError(c, err, "list_workspace_webhooks")
+			return
+		}
+
+		webhooks, err := h.webhookService.ListWebhooks(c.Request.Context(), workspaceID)
+		if err != nil {
+			h.HandleError(c, err, "list_workspace_webhooks")
+			return
+		}
+
+		h.OK(c, WebhookListResponse{Webhooks: webhooks, Total: len(webhooks)}, "Workspace webhooks retrieved successfully")
+	}
+}
+
+// GetWebhook: 워크스페이스 웹훅 조회 요청을 처리합니다
+func (h *Handler) GetWebhook(c *gin.Context) {
+	handler := h.Compose(
+		h.getWebhookHandler(),
+		h.StandardCRUDDecorators("get_workspace_webhook")...,
+	)
+
+	handler(c)
+}
+
+// getWebhookHandler: 워크스페이스 웹훅 조회의 핵심 비즈니스 로직
+func (h *Handler) getWebhookHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "get_workspace_webhook")
+			return
+		}
+		webhookID, err := h.ExtractPathParam(c, "webhookId")
+		if err != nil {
+			h.HandleError(c, err, "get_workspace_webhook")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceWebhooks); err != nil {
+			h.HandleError(c, err, "get_workspace_webhook")
+			return
+		}
+
+		webhook, err := h.webhookService.GetWebhook(c.Request.Context(), workspaceID, webhookID)
+		if err != nil {
+			h.HandleError(c, err, "get_workspace_webhook")
+			return
+		}
+
+		h.OK(c, webhook, "Workspace webhook retrieved successfully")
+	}
+}
+
+// CreateWebhook: 워크스페이스 웹훅 생성 요청을 처리합니다
+// 서명 시크릿은 생성 응답에서만 반환됩니다
+func (h *Handler) CreateWebhook(c *gin.Context) {
+	handler := h.Compose(
+		h.createWebhookHandler(),
+		h.StandardCRUDDecorators("create_workspace_webhook")...,
+	)
+
+	handler(c)
+}
+
+// createWebhookHandler: 워크스페이스 웹훅 생성의 핵심 비즈니스 로직
+func (h *Handler) createWebhookHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "create_workspace_webhook")
+			return
+		}
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "create_workspace_webhook")
+			return
+		}
+
+		var req domain.CreateWebhookRequest
+		if err := h.ExtractValidatedRequest(c, &req); err != nil {
+			h.HandleError(c, err, "create_workspace_webhook")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceWebhooks); err != nil {
+			h.HandleError(c, err, "create_workspace_webhook")
+			return
+		}
+
+		webhook, err := h.webhookService.CreateWebhook(ctx, userID, workspaceID, req)
+		if err != nil {
+			h.HandleError(c, err, "create_workspace_webhook")
+			return
+		}
+
+		h.LogBusinessEvent(c, "workspace_webhook_created", userID.String(), workspaceID.String(), map[string]interface{}{
+			"workspace_id": workspaceID.String(),
+			"webhook_id":   webhook.ID.String(),
+			"event_types":  []string(webhook.EventTypes),
+		})
+		h.Created(c, webhook, "Workspace webhook created successfully")
+	}
+}
+
+// UpdateWebhook: 워크스페이스 웹훅 수정 요청을 처리합니다
+func (h *Handler) UpdateWebhook(c *gin.Context) {
+	handler := h.Compose(
+		h.updateWebhookHandler(),
+		h.StandardCRUDDecorators("update_workspace_webhook")...,
+	)
+
+	handler(c)
+}
+
+// updateWebhookHandler: 워크스페이스 웹훅 수정의 핵심 비즈니스 로직
+func (h *Handler) updateWebhookHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "update_workspace_webhook")
+			return
+		}
+		webhookID, err := h.ExtractPathParam(c, "webhookId")
+		if err != nil {
+			h.HandleError(c, err, "update_workspace_webhook")
+			return
+		}
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "update_workspace_webhook")
+			return
+		}
+
+		var req domain.UpdateWebhookRequest
+		if err := h.ExtractValidatedRequest(c, &req); err != nil {
+			h.HandleError(c, err, "update_workspace_webhook")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceWebhooks); err != nil {
+			h.HandleError(c, err, "update_workspace_webhook")
+			return
+		}
+
+		webhook, err := h.webhookService.UpdateWebhook(ctx, userID, workspaceID, webhookID, req)
+		if err != nil {
+			h.HandleError(c, err, "update_workspace_webhook")
+			return
+		}
+
+		h.LogBusinessEvent(c, "workspace_webhook_updated", userID.String(), workspaceID.String(), map[string]interface{}{
+			"workspace_id": workspaceID.String(),
+			"webhook_id":   webhook.ID.String(),
+			"enabled":      webhook.Enabled,
+		})
+		h.OK(c, webhook, "Workspace webhook updated successfully")
+	}
+}
+
+// DeleteWebhook: 워크스페이스 웹훅 삭제 요청을 처리합니다
+func (h *Handler) DeleteWebhook(c *gin.Context) {
+	handler := h.Compose(
+		h.deleteWebhookHandler(),
+		h.StandardCRUDDecorators("delete_workspace_webhook")...,
+	)
+
+	handler(c)
+}
+
+// deleteWebhookHandler: 워크스페이스 웹훅 삭제의 핵심 비즈니스 로직
+func (h *Handler) deleteWebhookHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "delete_workspace_webhook")
+			return
+		}
+		webhookID, err := h.ExtractPathParam(c, "webhookId")
+		if err != nil {
+			h.HandleError(c, err, "delete_workspace_webhook")
+			return
+		}
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "delete_workspace_webhook")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceWebhooks); err != nil {
+			h.HandleError(c, err, "delete_workspace_webhook")
+			return
+		}
+
+		if err := h.webhookService.DeleteWebhook(ctx, userID, workspaceID, webhookID); err != nil {
+			h.HandleError(c, err, "delete_workspace_webhook")
+			return
+		}
+
+		h.LogBusinessEvent(c, "workspace_webhook_deleted", userID.String(), workspaceID.String(), map[string]interface{}{
+			"workspace_id": workspaceID.String(),
+			"webhook_id":   webhookID.String(),
+		})
+		h.OK(c, gin.H{"message": "Workspace webhook deleted successfully"}, "Workspace webhook deleted successfully")
+	}
+}
+
+// ListDeliveries: 웹훅 전송 기록 목록 조회 요청을 처리합니다
+func (h *Handler) ListDeliveries(c *gin.Context) {
+	handler := h.Compose(
+		h.listDeliveriesHandler(),
+		h.StandardCRUDDecorators("list_webhook_deliveries")...,
+	)
+
+	handler(c)
+}
+
+// listDeliveriesHandler: 웹훅 전송 기록 목록 조회의 핵심 비즈니스 로직
+func (h *Handler) listDeliveriesHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "list_webhook_deliveries")
+			return
+		}
+		webhookID, err := h.ExtractPathParam(c, "webhookId")
+		if err != nil {
+			h.HandleError(c, err, "list_webhook_deliveries")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceWebhooks); err != nil {
+			h.HandleError(c, err, "list_webhook_deliveries")
+			return
+		}
+
+		limit, offset := h.ParsePaginationParams(c)
+		deliveries, total, err := h.webhookService.ListDeliveries(c.Request.Context(), workspaceID, webhookID, domain.WebhookDeliveryFilter{
+			Status: c.Query("status"),
+			Limit:  limit,
+			Offset: offset,
+		})
+		if err != nil {
+			h.HandleError(c, err, "list_webhook_deliveries")
+			return
+		}
+
+		h.OK(c, DeliveryListResponse{
+			Deliveries: deliveries,
+			Total:      total,
+			Limit:      limit,
+			Offset:     offset,
+		}, "Webhook deliveries retrieved successfully")
+	}
+}
+
+// GetDelivery: 웹훅 전송 기록 조회 요청을 처리합니다 (페이로드와 응답 포함)
+func (h *Handler) GetDelivery(c *gin.Context) {
+	handler := h.Compose(
+		h.getDeliveryHandler(),
+		h.StandardCRUDDecorators("get_webhook_delivery")...,
+	)
+
+	handler(c)
+}
+
+// getDeliveryHandler: 웹훅 전송 기록 조회의 핵심 비즈니스 로직
+func (h *Handler) getDeliveryHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "get_webhook_delivery")
+			return
+		}
+		webhookID, err := h.ExtractPathParam(c, "webhookId")
+		if err != nil {
+			h.HandleError(c, err, "get_webhook_delivery")
+			return
+		}
+		deliveryID, err := h.ExtractPathParam(c, "deliveryId")
+		if err != nil {
+			h.HandleError(c, err, "get_webhook_delivery")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceWebhooks); err != nil {
+			h.HandleError(c, err, "get_webhook_delivery")
+			return
+		}
+
+		delivery, err := h.webhookService.GetDelivery(c.Request.Context(), workspaceID, webhookID, deliveryID)
+		if err != nil {
+			h.HandleError(c, err, "get_webhook_delivery")
+			return
+		}
+
+		h.OK(c, delivery, "Webhook delivery retrieved successfully")
+	}
+}
+
+// Redeliver: 웹훅 전송 재시도 요청을 처리합니다
+func (h *Handler) Redeliver(c *gin.Context) {
+	handler := h.Compose(
+		h.redeliverHandler(),
+		h.StandardCRUDDecorators("redeliver_webhook")...,
+	)
+
+	handler(c)
+}
+
+// redeliverHandler: 웹훅 전송 재시도의 핵심 비즈니스 로직
+func (h *Handler) redeliverHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "redeliver_webhook")
+			return
+		}
+		webhookID, err := h.ExtractPathParam(c, "webhookId")
+		if err != nil {
+			h.HandleError(c, err, "redeliver_webhook")
+			return
+		}
+		deliveryID, err := h.ExtractPathParam(c, "deliveryId")
+		if err != nil {
+			h.HandleError(c, err, "redeliver_webhook")
+			return
+		}
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "redeliver_webhook")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceWebhooks); err != nil {
+			h.HandleError(c, err, "redeliver_webhook")
+			return
+		}
+
+		delivery, err := h.webhookService.Redeliver(ctx, userID, workspaceID, webhookID, deliveryID)
+		if err != nil {
+			h.HandleError(c, err, "redeliver_webhook")
+			return
+		}
+
+		h.LogBusinessEvent(c, "workspace_webhook_redelivered", userID.String(), workspaceID.String(), map[string]interface{}{
+			"workspace_id":     workspaceID.String(),
+			"webhook_id":       webhookID.String(),
+			"delivery_id":      delivery.ID.String(),
+			"redelivery_of_id": deliveryID.String(),
+		})
+		h.Created(c, delivery, "Webhook redelivery queued successfully")
+	}
+}
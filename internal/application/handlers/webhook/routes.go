@@ -0,0 +1,24 @@
+package webhook
+
+import (
+	"skyclust/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetupRoutes sets up workspace webhook routes
+// Webhooks are nested under a workspace: /workspaces/:id/webhooks
+func SetupRoutes(router *gin.RouterGroup, webhookService domain.WebhookService) {
+	webhookHandler := NewHandler(webhookService)
+
+	router.GET("/:id/webhooks", webhookHandler.ListWebhooks)
+	router.POST("/:id/webhooks", webhookHandler.CreateWebhook)
+	router.GET("/:id/webhooks/:webhookId", webhookHandler.GetWebhook)
+	router.PUT("/:id/webhooks/:webhookId", webhookHandler.UpdateWebhook)
+	router.DELETE("/:id/webhooks/:webhookId", webhookHandler.DeleteWebhook)
+
+	// Delivery log
+	router.GET("/:id/webhooks/:webhookId/deliveries", webhookHandler.ListDeliveries)
+	router.GET("/:id/webhooks/:webhookId/deliveries/:deliveryId", webhookHandler.GetDelivery)
+	router.POST("/:id/webhooks/:webhookId/deliveries/:deliveryId/redeliver", webhookHandler.Redeliver)
+}
@@ -0,0 +1,17 @@
+package webhook
+
+import "skyclust/internal/domain"
+
+// WebhookListResponse represents a list of workspace webhooks
+type WebhookListResponse struct {
+	Webhooks []*domain.WebhookSubscription `json:"webhooks"`
+	Total    int                           `json:"total"`
+}
+
+// DeliveryListResponse represents a page of webhook deliveries
+type DeliveryListResponse struct {
+	Deliveries []*domain.WebhookDelivery `json:"deliveries"`
+	Total      int64                     `json:"total"`
+	Limit      int                       `json:"limit"`
+	Offset     int                       `json:"offset"`
+}
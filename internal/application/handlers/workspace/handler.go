@@ -15,15 +15,17 @@ type Handler struct {
 	*handlers.BaseHandler
 	workspaceService  domain.WorkspaceService
 	userService       domain.UserService
+	rbacService       domain.WorkspaceRBACService
 	readabilityHelper *readability.ReadabilityHelper
 }
 
 // NewHandler: 새로운 워크스페이스 핸들러를 생성합니다
-func NewHandler(workspaceService domain.WorkspaceService, userService domain.UserService) *Handler {
+func NewHandler(workspaceService domain.WorkspaceService, userService domain.UserService, rbacService domain.WorkspaceRBACService) *Handler {
 	return &Handler{
 		BaseHandler:       handlers.NewBaseHandler("workspace"),
 		workspaceService:  workspaceService,
 		userService:       userService,
+		rbacService:       rbacService,
 		readabilityHelper: readability.NewReadabilityHelper(),
 	}
 }
@@ -152,6 +154,12 @@ func (h *Handler) getWorkspaceHandler() handlers.HandlerFunc {
 			return
 		}
 
+		// 워크스페이스 권한 확인
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceRead); err != nil {
+			h.HandleError(c, err, "get_workspace")
+			return
+		}
+
 		h.logWorkspaceRequest(c, userID, workspaceID)
 
 		workspace, err := h.workspaceService.GetWorkspace(ctx, workspaceID.String())
@@ -198,32 +206,12 @@ func (h *Handler) updateWorkspaceHandler(req domain.UpdateWorkspaceRequest) hand
 			return
 		}
 
-		// 권한 확인을 위해 워크스페이스 조회
-		workspace, err := h.workspaceService.GetWorkspace(ctx, workspaceID.String())
-		if err != nil {
+		// 워크스페이스 권한 확인
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceUpdate); err != nil {
 			h.HandleError(c, err, "update_workspace")
 			return
 		}
 
-		// 업데이트 권한 확인 (소유자 또는 관리자)
-		userRole, err := h.GetUserRoleFromToken(c)
-		if err != nil {
-			h.HandleError(c, err, "update_workspace")
-			return
-		}
-
-		ownerID, err := uuid.Parse(workspace.OwnerID)
-		if err != nil {
-			h.HandleError(c, domain.NewDomainError(domain.ErrCodeInternalError, "Invalid owner ID format", 500), "update_workspace")
-			return
-		}
-
-		// 소유자 또는 관리자만 업데이트 가능
-		if userID != ownerID && userRole != domain.AdminRoleType {
-			h.Forbidden(c, "Insufficient permissions to update this workspace")
-			return
-		}
-
 		h.logWorkspaceUpdateAttempt(c, userID, workspaceID)
 
 		// 워크스페이스 업데이트 (owner_id는 변경되지 않음)
@@ -265,6 +253,12 @@ func (h *Handler) deleteWorkspaceHandler() handlers.HandlerFunc {
 			return
 		}
 
+		// 워크스페이스 권한 확인
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceDelete); err != nil {
+			h.HandleError(c, err, "delete_workspace")
+			return
+		}
+
 		h.logWorkspaceDeletionAttempt(c, userID, workspaceID)
 
 		err = h.workspaceService.DeleteWorkspace(ctx, workspaceID.String())
@@ -379,42 +373,19 @@ func (h *Handler) getMembersHandler() handlers.HandlerFunc {
 
 		h.logWorkspaceMembersRequest(c, userID, workspaceID)
 
-		// 권한 확인을 위해 워크스페이스 조회
+		// 워크스페이스 조회
 		workspace, err := h.workspaceService.GetWorkspace(ctx, workspaceID.String())
 		if err != nil {
 			h.HandleError(c, err, "get_workspace_members")
 			return
 		}
 
-		// 멤버 조회 권한 확인 (소유자, 관리자 또는 멤버)
-		userRole, err := h.GetUserRoleFromToken(c)
-		if err != nil {
+		// 워크스페이스 권한 확인
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceRead); err != nil {
 			h.HandleError(c, err, "get_workspace_members")
 			return
 		}
 
-		ownerID, err := uuid.Parse(workspace.OwnerID)
-		if err != nil {
-			h.HandleError(c, domain.NewDomainError(domain.ErrCodeInternalError, "Invalid owner ID format", 500), "get_workspace_members")
-			return
-		}
-
-		// 소유자 또는 관리자가 아니면 멤버 여부 확인
-		if userID != ownerID && userRole != domain.AdminRoleType {
-			members, _ := h.workspaceService.GetWorkspaceMembers(ctx, workspaceID.String())
-			isMember := false
-			for _, member := range members {
-				if member.ID == userID {
-					isMember = true
-					break
-				}
-			}
-			if !isMember {
-				h.Forbidden(c, "Insufficient permissions to view workspace members")
-				return
-			}
-		}
-
 		// workspace_users 테이블에서 역할 정보를 포함한 멤버 조회
 		workspaceMembers, err := h.workspaceService.GetWorkspaceMembersWithRoles(ctx, workspaceID.String())
 		if err != nil {
@@ -532,29 +503,22 @@ func (h *Handler) addMemberHandler() handlers.HandlerFunc {
 			return
 		}
 
-		// 권한 확인을 위해 워크스페이스 조회
+		// 워크스페이스 조회
 		workspace, err := h.workspaceService.GetWorkspace(ctx, workspaceID.String())
 		if err != nil {
 			h.HandleError(c, err, "add_workspace_member")
 			return
 		}
 
-		// 멤버 추가 권한 확인 (소유자 또는 관리자)
-		userRole, err := h.GetUserRoleFromToken(c)
-		if err != nil {
+		// 워크스페이스 권한 확인
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceMembersManage); err != nil {
 			h.HandleError(c, err, "add_workspace_member")
 			return
 		}
 
-		ownerID, err := uuid.Parse(workspace.OwnerID)
-		if err != nil {
-			h.HandleError(c, domain.NewDomainError(domain.ErrCodeInternalError, "Invalid owner ID format", 500), "add_workspace_member")
-			return
-		}
-
-		// 소유자 또는 관리자만 멤버 추가 가능
-		if userID != ownerID && userRole != domain.AdminRoleType {
-			h.Forbidden(c, "Insufficient permissions to add members to this workspace")
+		// 요청자가 가진 권한 범위 내의 역할만 할당 가능
+		if err := h.rbacService.AuthorizeRoleAssignment(ctx, userID, workspaceID, req.Role); err != nil {
+			h.HandleError(c, err, "add_workspace_member")
 			return
 		}
 
@@ -603,32 +567,19 @@ func (h *Handler) removeMemberHandler() handlers.HandlerFunc {
 			return
 		}
 
-		// 권한 확인을 위해 워크스페이스 조회
+		// 워크스페이스 조회
 		workspace, err := h.workspaceService.GetWorkspace(ctx, workspaceID.String())
 		if err != nil {
 			h.HandleError(c, err, "remove_workspace_member")
 			return
 		}
 
-		// 멤버 제거 권한 확인 (소유자 또는 관리자)
-		userRole, err := h.GetUserRoleFromToken(c)
-		if err != nil {
+		// 워크스페이스 권한 확인
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceMembersManage); err != nil {
 			h.HandleError(c, err, "remove_workspace_member")
 			return
 		}
 
-		ownerID, err := uuid.Parse(workspace.OwnerID)
-		if err != nil {
-			h.HandleError(c, domain.NewDomainError(domain.ErrCodeInternalError, "Invalid owner ID format", 500), "remove_workspace_member")
-			return
-		}
-
-		// 소유자 또는 관리자만 멤버 제거 가능
-		if userID != ownerID && userRole != domain.AdminRoleType {
-			h.Forbidden(c, "Insufficient permissions to remove members from this workspace")
-			return
-		}
-
 		// 소유자는 제거할 수 없음
 		if memberID.String() == workspace.OwnerID {
 			h.HandleError(c, domain.NewDomainError(domain.ErrCodeBadRequest, "Cannot remove workspace owner", 400), "remove_workspace_member")
@@ -686,29 +637,22 @@ func (h *Handler) updateMemberRoleHandler() handlers.HandlerFunc {
 			return
 		}
 
-		// 권한 확인을 위해 워크스페이스 조회
+		// 워크스페이스 조회
 		workspace, err := h.workspaceService.GetWorkspace(ctx, workspaceID.String())
 		if err != nil {
 			h.HandleError(c, err, "update_workspace_member_role")
 			return
 		}
 
-		// 멤버 역할 업데이트 권한 확인 (소유자 또는 관리자)
-		userRole, err := h.GetUserRoleFromToken(c)
-		if err != nil {
+		// 워크스페이스 권한 확인
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceMembersManage); err != nil {
 			h.HandleError(c, err, "update_workspace_member_role")
 			return
 		}
 
-		ownerID, err := uuid.Parse(workspace.OwnerID)
-		if err != nil {
-			h.HandleError(c, domain.NewDomainError(domain.ErrCodeInternalError, "Invalid owner ID format", 500), "update_workspace_member_role")
-			return
-		}
-
-		// 소유자 또는 관리자만 멤버 역할 업데이트 가능
-		if userID != ownerID && userRole != domain.AdminRoleType {
-			h.Forbidden(c, "Insufficient permissions to update member roles")
+		// 요청자가 가진 권한 범위 내의 역할만 할당 가능
+		if err := h.rbacService.AuthorizeRoleAssignment(ctx, userID, workspaceID, req.Role); err != nil {
+			h.HandleError(c, err, "update_workspace_member_role")
 			return
 		}
 
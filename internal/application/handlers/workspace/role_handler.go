@@ -0,0 +1,238 @@
+package workspace
+
+import (
+	"skyclust/internal/domain"
+	"skyclust/internal/shared/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetPermissions: 권한 카탈로그와 요청자의 워크스페이스 권한 조회 요청을 처리합니다
+func (h *Handler) GetPermissions(c *gin.Context) {
+	handler := h.Compose(
+		h.getPermissionsHandler(),
+		h.StandardCRUDDecorators("get_workspace_permissions")...,
+	)
+
+	handler(c)
+}
+
+// getPermissionsHandler: 권한 카탈로그 조회의 핵심 비즈니스 로직
+func (h *Handler) getPermissionsHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "get_workspace_permissions")
+			return
+		}
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "get_workspace_permissions")
+			return
+		}
+
+		access, err := h.rbacService.GetWorkspaceAccess(c.Request.Context(), workspaceID, userID)
+		if err != nil {
+			h.HandleError(c, err, "get_workspace_permissions")
+			return
+		}
+
+		// 멤버가 아닌 사용자에게는 워크스페이스 정보를 노출하지 않음
+		if access.Role == "" {
+			h.Forbidden(c, "You are not a member of this workspace")
+			return
+		}
+
+		h.OK(c, WorkspacePermissionsResponse{
+			Catalog: h.rbacService.GetPermissionCatalog(),
+			Access:  access,
+		}, "Workspace permissions retrieved successfully")
+	}
+}
+
+// GetRoles: 워크스페이스 역할 목록 조회 요청을 처리합니다
+func (h *Handler) GetRoles(c *gin.Context) {
+	handler := h.Compose(
+		h.getRolesHandler(),
+		h.StandardCRUDDecorators("get_workspace_roles")...,
+	)
+
+	handler(c)
+}
+
+// getRolesHandler: 워크스페이스 역할 목록 조회의 핵심 비즈니스 로직
+func (h *Handler) getRolesHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "get_workspace_roles")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceRead); err != nil {
+			h.HandleError(c, err, "get_workspace_roles")
+			return
+		}
+
+		roles, err := h.rbacService.ListWorkspaceRoles(c.Request.Context(), workspaceID)
+		if err != nil {
+			h.HandleError(c, err, "get_workspace_roles")
+			return
+		}
+
+		h.OK(c, gin.H{"roles": roles}, "Workspace roles retrieved successfully")
+	}
+}
+
+// CreateRole: 사용자 정의 워크스페이스 역할 생성 요청을 처리합니다
+func (h *Handler) CreateRole(c *gin.Context) {
+	handler := h.Compose(
+		h.createRoleHandler(),
+		h.StandardCRUDDecorators("create_workspace_role")...,
+	)
+
+	handler(c)
+}
+
+// createRoleHandler: 사용자 정의 워크스페이스 역할 생성의 핵심 비즈니스 로직
+func (h *Handler) createRoleHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "create_workspace_role")
+			return
+		}
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "create_workspace_role")
+			return
+		}
+
+		var req domain.CreateWorkspaceRoleRequest
+		if err := h.ExtractValidatedRequest(c, &req); err != nil {
+			h.HandleError(c, err, "create_workspace_role")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceRolesManage); err != nil {
+			h.HandleError(c, err, "create_workspace_role")
+			return
+		}
+
+		role, err := h.rbacService.CreateWorkspaceRole(ctx, userID, workspaceID, req)
+		if err != nil {
+			h.HandleError(c, err, "create_workspace_role")
+			return
+		}
+
+		h.LogBusinessEvent(c, "workspace_role_created", userID.String(), workspaceID.String(), map[string]interface{}{
+			"workspace_id": workspaceID.String(),
+			"role":         role.Name,
+		})
+		h.Created(c, role, "Workspace role created successfully")
+	}
+}
+
+// UpdateRole: 사용자 정의 워크스페이스 역할 수정 요청을 처리합니다
+func (h *Handler) UpdateRole(c *gin.Context) {
+	handler := h.Compose(
+		h.updateRoleHandler(),
+		h.StandardCRUDDecorators("update_workspace_role")...,
+	)
+
+	handler(c)
+}
+
+// updateRoleHandler: 사용자 정의 워크스페이스 역할 수정의 핵심 비즈니스 로직
+func (h *Handler) updateRoleHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "update_workspace_role")
+			return
+		}
+		roleID, err := h.ExtractPathParam(c, "roleId")
+		if err != nil {
+			h.HandleError(c, err, "update_workspace_role")
+			return
+		}
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "update_workspace_role")
+			return
+		}
+
+		var req domain.UpdateWorkspaceRoleRequest
+		if err := h.ExtractValidatedRequest(c, &req); err != nil {
+			h.HandleError(c, err, "update_workspace_role")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceRolesManage); err != nil {
+			h.HandleError(c, err, "update_workspace_role")
+			return
+		}
+
+		role, err := h.rbacService.UpdateWorkspaceRole(ctx, userID, workspaceID, roleID, req)
+		if err != nil {
+			h.HandleError(c, err, "update_workspace_role")
+			return
+		}
+
+		h.LogBusinessEvent(c, "workspace_role_updated", userID.String(), workspaceID.String(), map[string]interface{}{
+			"workspace_id": workspaceID.String(),
+			"role":         role.Name,
+		})
+		h.OK(c, role, "Workspace role updated successfully")
+	}
+}
+
+// DeleteRole: 사용자 정의 워크스페이스 역할 삭제 요청을 처리합니다
+func (h *Handler) DeleteRole(c *gin.Context) {
+	handler := h.Compose(
+		h.deleteRoleHandler(),
+		h.StandardCRUDDecorators("delete_workspace_role")...,
+	)
+
+	handler(c)
+}
+
+// deleteRoleHandler: 사용자 정의 워크스페이스 역할 삭제의 핵심 비즈니스 로직
+func (h *Handler) deleteRoleHandler() handlers.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := h.EnrichContextWithRequestMetadata(c)
+		workspaceID, err := h.ExtractPathParam(c, "id")
+		if err != nil {
+			h.HandleError(c, err, "delete_workspace_role")
+			return
+		}
+		roleID, err := h.ExtractPathParam(c, "roleId")
+		if err != nil {
+			h.HandleError(c, err, "delete_workspace_role")
+			return
+		}
+		userID, err := h.ExtractUserIDFromContext(c)
+		if err != nil {
+			h.HandleError(c, err, "delete_workspace_role")
+			return
+		}
+
+		if err := h.RequireWorkspacePermission(c, workspaceID, domain.WorkspaceRolesManage); err != nil {
+			h.HandleError(c, err, "delete_workspace_role")
+			return
+		}
+
+		if err := h.rbacService.DeleteWorkspaceRole(ctx, userID, workspaceID, roleID); err != nil {
+			h.HandleError(c, err, "delete_workspace_role")
+			return
+		}
+
+		h.LogBusinessEvent(c, "workspace_role_deleted", userID.String(), workspaceID.String(), map[string]interface{}{
+			"workspace_id": workspaceID.String(),
+			"role_id":      roleID.String(),
+		})
+		h.OK(c, gin.H{"message": "Workspace role deleted successfully"}, "Workspace role deleted successfully")
+	}
+}
@@ -7,8 +7,9 @@ import (
 )
 
 // SetupRoutes sets up workspace management routes
-func SetupRoutes(router *gin.RouterGroup, workspaceService domain.WorkspaceService, userService domain.UserService) {
-	workspaceHandler := NewHandler(workspaceService, userService)
+// Every handler authorizes against the workspace-scoped RBAC service (see WorkspaceRBACMiddleware)
+func SetupRoutes(router *gin.RouterGroup, workspaceService domain.WorkspaceService, userService domain.UserService, rbacService domain.WorkspaceRBACService) {
+	workspaceHandler := NewHandler(workspaceService, userService, rbacService)
 
 	router.POST("", workspaceHandler.CreateWorkspace)
 	router.GET("", workspaceHandler.GetWorkspaces)
@@ -21,4 +22,11 @@ func SetupRoutes(router *gin.RouterGroup, workspaceService domain.WorkspaceServi
 	router.POST("/:id/members", workspaceHandler.AddMember)
 	router.DELETE("/:id/members/:memberId", workspaceHandler.RemoveMember)
 	router.PUT("/:id/members/:memberId", workspaceHandler.UpdateMemberRole)
+
+	// Workspace role and permission routes
+	router.GET("/:id/permissions", workspaceHandler.GetPermissions)
+	router.GET("/:id/roles", workspaceHandler.GetRoles)
+	router.POST("/:id/roles", workspaceHandler.CreateRole)
+	router.PUT("/:id/roles/:roleId", workspaceHandler.UpdateRole)
+	router.DELETE("/:id/roles/:roleId", workspaceHandler.DeleteRole)
 }
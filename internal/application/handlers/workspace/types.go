@@ -1,6 +1,9 @@
 package workspace
 
-import "time"
+import (
+	"skyclust/internal/domain"
+	"time"
+)
 
 // CreateWorkspaceRequest represents a workspace creation request
 type CreateWorkspaceRequest struct {
@@ -33,12 +36,12 @@ type WorkspaceListResponse struct {
 // AddMemberRequest represents a request to add a member to a workspace
 type AddMemberRequest struct {
 	Email string `json:"email" validate:"required,email"`
-	Role  string `json:"role" validate:"required,oneof=admin member"`
+	Role  string `json:"role" validate:"required,min=2,max=50"` // admin, member, viewer 또는 사용자 정의 역할
 }
 
 // UpdateMemberRoleRequest represents a request to update a member's role
 type UpdateMemberRoleRequest struct {
-	Role string `json:"role" validate:"required,oneof=admin member"`
+	Role string `json:"role" validate:"required,min=2,max=50"` // admin, member, viewer 또는 사용자 정의 역할
 }
 
 // WorkspaceMemberResponse represents a workspace member in API responses
@@ -53,3 +56,9 @@ type WorkspaceMemberResponse struct {
 		Email    string `json:"email"`
 	} `json:"user"`
 }
+
+// WorkspacePermissionsResponse represents the permission catalog and the caller's access in a workspace
+type WorkspacePermissionsResponse struct {
+	Catalog []domain.PermissionDefinition `json:"catalog"`
+	Access  *domain.WorkspaceAccess       `json:"access"`
+}
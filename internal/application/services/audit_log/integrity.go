@@ -0,0 +1,620 @@
+package audit_log
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"skyclust/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// verifyBatchSize: 검증/아카이브 시 한 번에 읽는 항목 수
+	verifyBatchSize = 1000
+	// maxIssuesPerSegment: 세그먼트별로 보고하는 최대 이슈 수
+	maxIssuesPerSegment = 100
+	// maxVerifyDays: 한 번에 검증할 수 있는 최대 기간
+	maxVerifyDays = 366
+)
+
+// CreateCheckpoints: 어제와 오늘 세그먼트의 헤드에 서명된 체크포인트를 생성합니다
+// 마지막 체크포인트 이후 새 항목이 없는 세그먼트는 건너뜁니다
+func (s *Service) CreateCheckpoints() ([]*domain.AuditCheckpoint, error) {
+	now := time.Now()
+	segments := []string{
+		domain.AuditSegmentFor(now.AddDate(0, 0, -1)),
+		domain.AuditSegmentFor(now),
+	}
+
+	var created []*domain.AuditCheckpoint
+	for _, segment := range segments {
+		checkpoint, isNew, err := s.checkpointSegment(segment)
+		if err != nil {
+			return created, err
+		}
+		if isNew {
+			created = append(created, checkpoint)
+		}
+	}
+	return created, nil
+}
+
+// checkpointSegment: 세그먼트 헤드에 대한 체크포인트를 반환합니다
+// 최신 체크포인트가 이미 헤드를 가리키면 새로 만들지 않으며, 항목이 없으면 nil을 반환합니다
+func (s *Service) checkpointSegment(segment string) (*domain.AuditCheckpoint, bool, error) {
+	head, err := s.auditLogRepo.GetSegmentHead(segment)
+	if err != nil {
+		return nil, false, domain.NewDomainError(domain.ErrCodeDatabaseError, fmt.Sprintf("failed to read audit segment head: %v", err), 500)
+	}
+	if head == nil {
+		return nil, false, nil
+	}
+
+	latest, err := s.auditLogRepo.GetLatestCheckpoint(segment)
+	if err != nil {
+		return nil, false, domain.NewDomainError(domain.ErrCodeDatabaseError, fmt.Sprintf("failed to read audit checkpoint: %v", err), 500)
+	}
+	if latest != nil && latest.Sequence == head.Sequence {
+		return latest, false, nil
+	}
+
+	checkpoint := &domain.AuditCheckpoint{
+		ID:        uuid.New(),
+		Segment:   segment,
+		Sequence:  head.Sequence,
+		EntryHash: head.Hash,
+		KeyID:     s.signer.KeyID(),
+	}
+	err = s.auditLogRepo.AppendCheckpoint(checkpoint, func(previous *domain.AuditCheckpoint) error {
+		checkpoint.Number = 1
+		checkpoint.PrevCheckpointHash = ""
+		if previous != nil {
+			checkpoint.Number = previous.Number + 1
+			checkpoint.PrevCheckpointHash = previous.Hash
+		}
+		checkpoint.CreatedAt = time.Now().UTC().Truncate(time.Microsecond)
+		checkpoint.Hash = checkpoint.ComputeHash()
+		checkpoint.Signature = s.signer.Sign(checkpoint.SigningPayload())
+		return nil
+	})
+	if err != nil {
+		return nil, false, domain.NewDomainError(domain.ErrCodeDatabaseError, fmt.Sprintf("failed to create audit checkpoint: %v", err), 500)
+	}
+	return checkpoint, true, nil
+}
+
+// VerifyAuditLogs: 기간 내 세그먼트의 해시 체인, 체크포인트, 아카이브를 검증합니다
+func (s *Service) VerifyAuditLogs(from, to string) (*domain.AuditVerificationReport, error) {
+	if err := validateSegmentRange(from, to); err != nil {
+		return nil, err
+	}
+
+	liveSegments, err := s.auditLogRepo.ListSegments(from, to)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeDatabaseError, fmt.Sprintf("failed to list audit segments: %v", err), 500)
+	}
+	checkpoints, err := s.auditLogRepo.ListCheckpoints(from, to)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeDatabaseError, fmt.Sprintf("failed to list audit checkpoints: %v", err), 500)
+	}
+	archives, err := s.auditLogRepo.ListArchives(from, to)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeDatabaseError, fmt.Sprintf("failed to list audit archives: %v", err), 500)
+	}
+
+	checkpointsBySegment := make(map[string][]*domain.AuditCheckpoint)
+	segmentSet := make(map[string]bool)
+	for _, segment := range liveSegments {
+		segmentSet[segment] = true
+	}
+	for _, checkpoint := range checkpoints {
+		checkpointsBySegment[checkpoint.Segment] = append(checkpointsBySegment[checkpoint.Segment], checkpoint)
+		segmentSet[checkpoint.Segment] = true
+	}
+	archived := make(map[string]bool)
+	for _, archive := range archives {
+		archived[archive.Segment] = true
+		segmentSet[archive.Segment] = true
+	}
+
+	segments := make([]string, 0, len(segmentSet))
+	for segment := range segmentSet {
+		segments = append(segments, segment)
+	}
+	sort.Strings(segments)
+
+	live := make(map[string]bool, len(liveSegments))
+	for _, segment := range liveSegments {
+		live[segment] = true
+	}
+
+	results := make(map[string]*domain.AuditSegmentVerification, len(segments))
+	for _, segment := range segments {
+		var result *domain.AuditSegmentVerification
+		if archived[segment] {
+			result, err = s.verifyArchivedSegment(segment, checkpointsBySegment[segment])
+			if err == nil && live[segment] {
+				addIssue(result, domain.AuditIntegrityIssue{
+					Type:    domain.AuditIssueUnexpectedEntries,
+					Message: "segment is archived but audit log entries still exist for it",
+				})
+			}
+		} else {
+			result, err = s.verifyLiveSegment(segment, checkpointsBySegment[segment])
+		}
+		if err != nil {
+			return nil, err
+		}
+		results[segment] = result
+	}
+
+	if err := s.verifyCheckpointChain(checkpoints, results); err != nil {
+		return nil, err
+	}
+
+	unchained, err := s.auditLogRepo.CountUnchainedLogs()
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeDatabaseError, fmt.Sprintf("failed to count unchained audit logs: %v", err), 500)
+	}
+
+	report := &domain.AuditVerificationReport{
+		Valid:         true,
+		From:          from,
+		To:            to,
+		Segments:      make([]domain.AuditSegmentVerification, 0, len(segments)),
+		UnchainedLogs: unchained,
+		KeyID:         s.signer.KeyID(),
+		PublicKey:     s.signer.PublicKey(),
+		VerifiedAt:    time.Now(),
+	}
+	for _, segment := range segments {
+		result := results[segment]
+		result.Status = domain.AuditSegmentValid
+		if len(result.Issues) > 0 {
+			result.Status = domain.AuditSegmentInvalid
+			report.Valid = false
+			report.IssueCount += len(result.Issues)
+		}
+		report.Segments = append(report.Segments, *result)
+	}
+	return report, nil
+}
+
+// verifyLiveSegment: 감사 로그 테이블의 세그먼트를 검증합니다
+func (s *Service) verifyLiveSegment(segment string, checkpoints []*domain.AuditCheckpoint) (*domain.AuditSegmentVerification, error) {
+	verifier := newChainVerifier(segment, checkpoints)
+
+	var after int64
+	for {
+		entries, err := s.auditLogRepo.ListSegmentEntries(segment, after, verifyBatchSize)
+		if err != nil {
+			return nil, domain.NewDomainError(domain.ErrCodeDatabaseError, fmt.Sprintf("failed to read audit segment: %v", err), 500)
+		}
+		for _, entry := range entries {
+			verifier.add(entry)
+			after = entry.Sequence
+		}
+		if len(entries) < verifyBatchSize {
+			break
+		}
+	}
+
+	verifier.checkCheckpoints(s)
+	return verifier.result, nil
+}
+
+// verifyArchivedSegment: 아카이브의 다이제스트, 서명, 보관된 해시 체인을 검증합니다
+func (s *Service) verifyArchivedSegment(segment string, checkpoints []*domain.AuditCheckpoint) (*domain.AuditSegmentVerification, error) {
+	archive, err := s.auditLogRepo.GetArchive(segment)
+	if err != nil {
+		return nil, err
+	}
+
+	verifier := newChainVerifier(segment, checkpoints)
+	verifier.result.Archived = true
+
+	digest := sha256.Sum256(archive.Data)
+	if hex.EncodeToString(digest[:]) != archive.Digest {
+		addIssue(verifier.result, domain.AuditIntegrityIssue{
+			Type:    domain.AuditIssueArchiveInvalid,
+			Message: "archive data does not match its digest",
+		})
+		return verifier.result, nil
+	}
+	if archive.KeyID != s.signer.KeyID() || !s.signer.Verify(archive.SigningPayload(), archive.Signature) {
+		addIssue(verifier.result, domain.AuditIntegrityIssue{
+			Type:    domain.AuditIssueArchiveInvalid,
+			Message: fmt.Sprintf("archive signature is not valid for key %s", s.signer.KeyID()),
+		})
+	}
+
+	entries, err := readArchiveEntries(archive.Data)
+	if err != nil {
+		addIssue(verifier.result, domain.AuditIntegrityIssue{
+			Type:    domain.AuditIssueArchiveInvalid,
+			Message: fmt.Sprintf("archive data cannot be read: %v", err),
+		})
+		return verifier.result, nil
+	}
+	for _, entry := range entries {
+		if entry.Segment == "" {
+			continue
+		}
+		verifier.add(entry)
+	}
+
+	if verifier.result.HeadSequence != archive.LastSequence || verifier.result.HeadHash != archive.HeadHash {
+		addIssue(verifier.result, domain.AuditIntegrityIssue{
+			Type:    domain.AuditIssueArchiveInvalid,
+			Message: fmt.Sprintf("archive head (sequence %d) does not match its recorded head (sequence %d)", verifier.result.HeadSequence, archive.LastSequence),
+		})
+	}
+
+	verifier.checkCheckpoints(s)
+	return verifier.result, nil
+}
+
+// verifyCheckpointChain: 체크포인트 번호와 이전 해시 연결을 검증합니다
+// 체크포인트 체인이 끊기면 세그먼트 전체가 삭제되었거나 체크포인트가 변조된 것입니다
+func (s *Service) verifyCheckpointChain(checkpoints []*domain.AuditCheckpoint, results map[string]*domain.AuditSegmentVerification) error {
+	var previous *domain.AuditCheckpoint
+	if len(checkpoints) > 0 && checkpoints[0].Number > 1 {
+		var err error
+		previous, err = s.auditLogRepo.GetCheckpointByNumber(checkpoints[0].Number - 1)
+		if err != nil {
+			return domain.NewDomainError(domain.ErrCodeDatabaseError, fmt.Sprintf("failed to read audit checkpoint: %v", err), 500)
+		}
+	}
+
+	for _, checkpoint := range checkpoints {
+		result := results[checkpoint.Segment]
+
+		if checkpoint.Number == 1 {
+			if checkpoint.PrevCheckpointHash != "" {
+				addIssue(result, domain.AuditIntegrityIssue{
+					Type:    domain.AuditIssueCheckpointInvalid,
+					Message: "first checkpoint must not reference a previous checkpoint",
+				})
+			}
+			previous = checkpoint
+			continue
+		}
+
+		// 범위 밖 세그먼트의 체크포인트가 사이에 있으면 번호 순서대로 따라감
+		expected := checkpoint.Number - 1
+		if previous == nil || previous.Number < expected {
+			start := expected
+			if previous != nil {
+				start = previous.Number + 1
+			}
+			for number := start; number <= expected; number++ {
+				intermediate, err := s.auditLogRepo.GetCheckpointByNumber(number)
+				if err != nil {
+					return domain.NewDomainError(domain.ErrCodeDatabaseError, fmt.Sprintf("failed to read audit checkpoint: %v", err), 500)
+				}
+				if intermediate == nil {
+					addIssue(result, domain.AuditIntegrityIssue{
+						Type:    domain.AuditIssueCheckpointMissing,
+						Message: fmt.Sprintf("checkpoint #%d is missing", number),
+					})
+					previous = nil
+					continue
+				}
+				if previous != nil && intermediate.PrevCheckpointHash != previous.Hash {
+					addIssue(result, domain.AuditIntegrityIssue{
+						Type:    domain.AuditIssueCheckpointInvalid,
+						Message: fmt.Sprintf("checkpoint #%d is not linked to checkpoint #%d", intermediate.Number, previous.Number),
+					})
+				}
+				previous = intermediate
+			}
+		}
+
+		if previous != nil && checkpoint.PrevCheckpointHash != previous.Hash {
+			addIssue(result, domain.AuditIntegrityIssue{
+				Type:    domain.AuditIssueCheckpointInvalid,
+				Message: fmt.Sprintf("checkpoint #%d is not linked to checkpoint #%d", checkpoint.Number, previous.Number),
+			})
+		}
+		previous = checkpoint
+	}
+	return nil
+}
+
+// CleanupAuditLogs: 보존 기간이 지난 세그먼트를 최종 체크포인트와 함께 아카이브합니다 (관리자 메서드)
+// 감사 로그는 삭제되지 않고 서명된 아카이브로 이동합니다
+func (s *Service) CleanupAuditLogs(actorID *uuid.UUID, retentionDays int) (*domain.AuditCleanupResult, error) {
+	if retentionDays < 0 {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "retention days must be non-negative", 400)
+	}
+
+	if retentionDays == 0 {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "retention days must be greater than 0 to prevent accidental archiving of all logs", 400)
+	}
+
+	cutoff := domain.AuditSegmentFor(time.Now().AddDate(0, 0, -retentionDays))
+	segments, err := s.auditLogRepo.ListArchivableSegments(cutoff)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to list audit segments: %v", err), 500)
+	}
+
+	result := &domain.AuditCleanupResult{
+		ArchivedSegments: make([]string, 0, len(segments)),
+		RetentionDays:    retentionDays,
+	}
+	for _, segment := range segments {
+		archive, err := s.archiveSegment(segment, retentionDays)
+		if err != nil {
+			return result, err
+		}
+		result.ArchivedSegments = append(result.ArchivedSegments, segment)
+		result.ArchivedEntries += archive.EntryCount + archive.LegacyCount
+	}
+
+	if actorID != nil && len(result.ArchivedSegments) > 0 {
+		_ = s.auditLogRepo.Create(&domain.AuditLog{
+			UserID:   *actorID,
+			Action:   domain.ActionAuditLogArchive,
+			Resource: "DELETE /api/v1/admin/audit-logs",
+			Details: domain.JSONBMap{
+				"retention_days":    retentionDays,
+				"archived_segments": result.ArchivedSegments,
+				"archived_entries":  result.ArchivedEntries,
+			},
+		})
+	}
+
+	return result, nil
+}
+
+// archiveSegment: 세그먼트에 최종 체크포인트를 만들고 항목을 서명된 gzip JSON Lines 아카이브로 이동합니다
+func (s *Service) archiveSegment(segment string, retentionDays int) (*domain.AuditArchive, error) {
+	checkpoint, _, err := s.checkpointSegment(segment)
+	if err != nil {
+		return nil, err
+	}
+
+	archive := &domain.AuditArchive{
+		ID:            uuid.New(),
+		Segment:       segment,
+		RetentionDays: retentionDays,
+		KeyID:         s.signer.KeyID(),
+	}
+	if checkpoint != nil {
+		archive.CheckpointID = &checkpoint.ID
+	}
+
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+	encoder := json.NewEncoder(writer)
+	for offset := 0; ; offset += verifyBatchSize {
+		entries, err := s.auditLogRepo.ListSegmentArchiveEntries(segment, offset, verifyBatchSize)
+		if err != nil {
+			return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to read audit segment %s: %v", segment, err), 500)
+		}
+		for _, entry := range entries {
+			if err := encoder.Encode(entry); err != nil {
+				return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to encode audit entry: %v", err), 500)
+			}
+			if entry.Segment == "" {
+				archive.LegacyCount++
+				continue
+			}
+			archive.EntryCount++
+			archive.LastSequence = entry.Sequence
+			archive.HeadHash = entry.Hash
+		}
+		if len(entries) < verifyBatchSize {
+			break
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to compress audit segment: %v", err), 500)
+	}
+
+	// 체크포인트 이후 기록된 항목이 있으면 아카이브 헤드와 맞지 않으므로 중단
+	if checkpoint != nil && checkpoint.Sequence != archive.LastSequence {
+		return nil, domain.NewDomainError(domain.ErrCodeConflict,
+			fmt.Sprintf("audit segment %s changed while archiving; retry the cleanup", segment), 409)
+	}
+
+	digest := sha256.Sum256(buffer.Bytes())
+	archive.Data = buffer.Bytes()
+	archive.CompressedSize = int64(buffer.Len())
+	archive.Digest = hex.EncodeToString(digest[:])
+	archive.ArchivedAt = time.Now()
+	archive.Signature = s.signer.Sign(archive.SigningPayload())
+
+	if err := s.auditLogRepo.ArchiveSegment(archive); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to archive audit segment %s: %v", segment, err), 500)
+	}
+	return archive, nil
+}
+
+// ListCheckpoints: 기간 내 체크포인트를 조회합니다
+func (s *Service) ListCheckpoints(from, to string) ([]*domain.AuditCheckpoint, error) {
+	if err := validateSegmentRange(from, to); err != nil {
+		return nil, err
+	}
+	return s.auditLogRepo.ListCheckpoints(from, to)
+}
+
+// ListArchives: 기간 내 아카이브 목록을 조회합니다
+func (s *Service) ListArchives(from, to string) ([]*domain.AuditArchive, error) {
+	if err := validateSegmentRange(from, to); err != nil {
+		return nil, err
+	}
+	return s.auditLogRepo.ListArchives(from, to)
+}
+
+// GetArchive: 세그먼트 아카이브를 데이터와 함께 조회합니다
+func (s *Service) GetArchive(segment string) (*domain.AuditArchive, error) {
+	if _, err := time.Parse(domain.AuditSegmentLayout, segment); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "segment must be formatted as YYYY-MM-DD", 400)
+	}
+	return s.auditLogRepo.GetArchive(segment)
+}
+
+// chainVerifier: 시퀀스 순서로 전달되는 세그먼트 항목의 해시 체인을 검증합니다
+type chainVerifier struct {
+	result      *domain.AuditSegmentVerification
+	checkpoints []*domain.AuditCheckpoint
+	expected    int64
+	prevHash    string
+	hashes      map[int64]string // 체크포인트가 가리키는 시퀀스의 항목 해시
+}
+
+func newChainVerifier(segment string, checkpoints []*domain.AuditCheckpoint) *chainVerifier {
+	hashes := make(map[int64]string, len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		hashes[checkpoint.Sequence] = ""
+	}
+	return &chainVerifier{
+		result: &domain.AuditSegmentVerification{
+			Segment:     segment,
+			Checkpoints: len(checkpoints),
+		},
+		checkpoints: checkpoints,
+		expected:    1,
+		prevHash:    domain.AuditSegmentGenesisHash(segment),
+		hashes:      hashes,
+	}
+}
+
+// add: 다음 항목을 검증합니다
+func (v *chainVerifier) add(entry *domain.AuditLog) {
+	v.result.Entries++
+
+	switch {
+	case entry.Sequence > v.expected:
+		addIssue(v.result, domain.AuditIntegrityIssue{
+			Type:     domain.AuditIssueGap,
+			Sequence: v.expected,
+			Message:  fmt.Sprintf("entries %d to %d are missing", v.expected, entry.Sequence-1),
+		})
+	case entry.Sequence < v.expected:
+		addIssue(v.result, domain.AuditIntegrityIssue{
+			Type:     domain.AuditIssueChainBroken,
+			Sequence: entry.Sequence,
+			EntryID:  entry.ID.String(),
+			Message:  fmt.Sprintf("entry has sequence %d but %d was expected", entry.Sequence, v.expected),
+		})
+	case entry.PrevHash != v.prevHash:
+		addIssue(v.result, domain.AuditIntegrityIssue{
+			Type:     domain.AuditIssueChainBroken,
+			Sequence: entry.Sequence,
+			EntryID:  entry.ID.String(),
+			Message:  "entry does not reference the hash of the previous entry",
+		})
+	}
+
+	hash, err := entry.ComputeHash()
+	if err != nil || hash != entry.Hash {
+		addIssue(v.result, domain.AuditIntegrityIssue{
+			Type:     domain.AuditIssueHashMismatch,
+			Sequence: entry.Sequence,
+			EntryID:  entry.ID.String(),
+			Message:  "entry content does not match its hash",
+		})
+	}
+
+	// 저장된 해시로 체인을 이어가 변조 한 건이 연쇄 이슈로 번지지 않게 함
+	v.prevHash = entry.Hash
+	v.expected = entry.Sequence + 1
+	if _, ok := v.hashes[entry.Sequence]; ok {
+		v.hashes[entry.Sequence] = entry.Hash
+	}
+	v.result.HeadSequence = entry.Sequence
+	v.result.HeadHash = entry.Hash
+}
+
+// checkCheckpoints: 체크포인트 서명과 체크포인트가 가리키는 항목을 검증합니다
+func (v *chainVerifier) checkCheckpoints(s *Service) {
+	for _, checkpoint := range v.checkpoints {
+		if checkpoint.Hash != checkpoint.ComputeHash() ||
+			checkpoint.KeyID != s.signer.KeyID() ||
+			!s.signer.Verify(checkpoint.SigningPayload(), checkpoint.Signature) {
+			addIssue(v.result, domain.AuditIntegrityIssue{
+				Type:     domain.AuditIssueCheckpointInvalid,
+				Sequence: checkpoint.Sequence,
+				Message:  fmt.Sprintf("checkpoint #%d hash or signature is not valid for key %s", checkpoint.Number, s.signer.KeyID()),
+			})
+			continue
+		}
+
+		if checkpoint.Sequence > v.result.HeadSequence {
+			addIssue(v.result, domain.AuditIntegrityIssue{
+				Type:     domain.AuditIssueTruncated,
+				Sequence: checkpoint.Sequence,
+				Message:  fmt.Sprintf("checkpoint #%d covers sequence %d but the segment ends at %d", checkpoint.Number, checkpoint.Sequence, v.result.HeadSequence),
+			})
+			continue
+		}
+
+		// 항목이 누락된 경우는 gap 이슈로 이미 보고됨
+		if hash := v.hashes[checkpoint.Sequence]; hash != "" && hash != checkpoint.EntryHash {
+			addIssue(v.result, domain.AuditIntegrityIssue{
+				Type:     domain.AuditIssueCheckpointMismatch,
+				Sequence: checkpoint.Sequence,
+				Message:  fmt.Sprintf("entry %d does not match the hash signed by checkpoint #%d", checkpoint.Sequence, checkpoint.Number),
+			})
+		}
+	}
+}
+
+// addIssue: 세그먼트 결과에 이슈를 추가합니다 (세그먼트별 최대 개수 제한)
+func addIssue(result *domain.AuditSegmentVerification, issue domain.AuditIntegrityIssue) {
+	if result == nil || len(result.Issues) >= maxIssuesPerSegment {
+		return
+	}
+	issue.Segment = result.Segment
+	result.Issues = append(result.Issues, issue)
+}
+
+// readArchiveEntries: gzip JSON Lines 아카이브를 항목 목록으로 읽습니다
+func readArchiveEntries(data []byte) ([]*domain.AuditLog, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	var entries []*domain.AuditLog
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var entry domain.AuditLog
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &entry)
+	}
+	return entries, scanner.Err()
+}
+
+// validateSegmentRange: 검증/조회 기간을 확인합니다
+func validateSegmentRange(from, to string) error {
+	fromDate, err := time.Parse(domain.AuditSegmentLayout, from)
+	if err != nil {
+		return domain.NewDomainError(domain.ErrCodeValidationFailed, "from must be formatted as YYYY-MM-DD", 400)
+	}
+	toDate, err := time.Parse(domain.AuditSegmentLayout, to)
+	if err != nil {
+		return domain.NewDomainError(domain.ErrCodeValidationFailed, "to must be formatted as YYYY-MM-DD", 400)
+	}
+	if toDate.Before(fromDate) {
+		return domain.NewDomainError(domain.ErrCodeValidationFailed, "to must not be before from", 400)
+	}
+	if toDate.Sub(fromDate) > maxVerifyDays*24*time.Hour {
+		return domain.NewDomainError(domain.ErrCodeValidationFailed, fmt.Sprintf("range must not exceed %d days", maxVerifyDays), 400)
+	}
+	return nil
+}
@@ -0,0 +1,298 @@
+package audit_log
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultSearchLimit = 50
+	maxSearchLimit     = 1000
+
+	// subscriptionLag: 기록 중인 트랜잭션이 커밋될 시간을 두고 그 이전 항목까지만 확인합니다
+	subscriptionLag = 30 * time.Second
+	// subscriptionSampleSize: 구독 알림에 포함하는 최근 일치 항목 수
+	subscriptionSampleSize = 5
+)
+
+// subscriptionPriorities: 구독 알림에 허용하는 우선순위
+var subscriptionPriorities = map[string]bool{"low": true, "medium": true, "high": true, "urgent": true}
+
+// SearchAuditLogs: 쿼리 언어로 감사 로그를 검색합니다
+func (s *Service) SearchAuditLogs(req domain.AuditSearchRequest) (*domain.AuditSearchResult, error) {
+	query, err := domain.ParseAuditQuery(req.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.StartTime != nil && req.EndTime != nil && req.StartTime.After(*req.EndTime) {
+		return nil, domain.NewDomainError(domain.ErrCodeBadRequest, "start_time must be before end_time", 400)
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	filters := domain.AuditSearchFilters{
+		Query:  query,
+		Before: req.EndTime,
+		Limit:  limit,
+		Offset: offset,
+	}
+	if req.StartTime != nil {
+		// 시작 시각을 포함하도록 직전 시각부터 조회
+		after := req.StartTime.Add(-time.Nanosecond)
+		filters.After = &after
+	}
+
+	logs, total, err := s.auditLogRepo.Search(filters)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeDatabaseError, fmt.Sprintf("failed to search audit logs: %v", err), 500)
+	}
+
+	return &domain.AuditSearchResult{
+		Logs:   logs,
+		Total:  total,
+		Query:  query.String(),
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
+// CreateSavedQuery: 검색 쿼리를 저장합니다
+func (s *Service) CreateSavedQuery(ownerID uuid.UUID, req domain.SaveAuditQueryRequest) (*domain.AuditSavedQuery, error) {
+	if err := validateSavedQuery(&req); err != nil {
+		return nil, err
+	}
+
+	saved := &domain.AuditSavedQuery{
+		ID:          uuid.New(),
+		Name:        req.Name,
+		Description: req.Description,
+		Query:       req.Query,
+		OwnerID:     ownerID,
+		Shared:      req.Shared,
+	}
+	if err := s.queryRepo.CreateSavedQuery(saved); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to save audit query", 500)
+	}
+	return saved, nil
+}
+
+// ListSavedQueries: 사용자가 소유했거나 공유된 쿼리를 구독 여부와 함께 조회합니다
+func (s *Service) ListSavedQueries(userID uuid.UUID) ([]*domain.AuditSavedQuery, error) {
+	queries, err := s.queryRepo.ListSavedQueries(userID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to list saved audit queries", 500)
+	}
+	subscribed, err := s.queryRepo.ListSubscribedQueryIDs(userID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to list audit query subscriptions", 500)
+	}
+	for _, query := range queries {
+		query.Subscribed = subscribed[query.ID]
+	}
+	return queries, nil
+}
+
+// GetSavedQuery: 사용자가 볼 수 있는 저장된 쿼리를 조회합니다
+func (s *Service) GetSavedQuery(userID, id uuid.UUID) (*domain.AuditSavedQuery, error) {
+	saved, err := s.visibleSavedQuery(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	subscription, err := s.queryRepo.GetSubscription(id, userID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to get audit query subscription", 500)
+	}
+	saved.Subscribed = subscription != nil
+	return saved, nil
+}
+
+// UpdateSavedQuery: 저장된 쿼리를 수정합니다 (소유자만)
+func (s *Service) UpdateSavedQuery(userID, id uuid.UUID, req domain.SaveAuditQueryRequest) (*domain.AuditSavedQuery, error) {
+	saved, err := s.ownedSavedQuery(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateSavedQuery(&req); err != nil {
+		return nil, err
+	}
+
+	saved.Name = req.Name
+	saved.Description = req.Description
+	saved.Query = req.Query
+	saved.Shared = req.Shared
+	saved.UpdatedAt = time.Now()
+	if err := s.queryRepo.UpdateSavedQuery(saved); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to update saved audit query", 500)
+	}
+	return saved, nil
+}
+
+// DeleteSavedQuery: 저장된 쿼리와 모든 구독을 삭제합니다 (소유자만)
+func (s *Service) DeleteSavedQuery(userID, id uuid.UUID) error {
+	if _, err := s.ownedSavedQuery(userID, id); err != nil {
+		return err
+	}
+	if err := s.queryRepo.DeleteSavedQuery(id); err != nil {
+		return domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to delete saved audit query", 500)
+	}
+	return nil
+}
+
+// RunSavedQuery: 저장된 쿼리로 감사 로그를 검색합니다
+func (s *Service) RunSavedQuery(userID, id uuid.UUID, req domain.AuditSearchRequest) (*domain.AuditSearchResult, error) {
+	saved, err := s.visibleSavedQuery(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	req.Query = saved.Query
+	return s.SearchAuditLogs(req)
+}
+
+// SubscribeSavedQuery: 저장된 쿼리를 구독합니다 (구독 이후 기록된 항목부터 알림)
+func (s *Service) SubscribeSavedQuery(userID, id uuid.UUID, priority string) (*domain.AuditQuerySubscription, error) {
+	if _, err := s.visibleSavedQuery(userID, id); err != nil {
+		return nil, err
+	}
+	if priority == "" {
+		priority = "medium"
+	}
+	if !subscriptionPriorities[priority] {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "priority must be one of low, medium, high, urgent", 400)
+	}
+
+	subscription, err := s.queryRepo.GetSubscription(id, userID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to get audit query subscription", 500)
+	}
+	if subscription == nil {
+		subscription = &domain.AuditQuerySubscription{
+			SavedQueryID:  id,
+			UserID:        userID,
+			LastCheckedAt: time.Now(),
+		}
+	}
+	subscription.Priority = priority
+	if err := s.queryRepo.SaveSubscription(subscription); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to subscribe to saved audit query", 500)
+	}
+	return subscription, nil
+}
+
+// UnsubscribeSavedQuery: 저장된 쿼리 구독을 해지합니다
+func (s *Service) UnsubscribeSavedQuery(userID, id uuid.UUID) error {
+	if err := s.queryRepo.DeleteSubscription(id, userID); err != nil {
+		return domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to unsubscribe from saved audit query", 500)
+	}
+	return nil
+}
+
+// EvaluateSubscriptions: 구독별로 마지막 확인 이후 새로 일치한 감사 로그를 찾습니다
+// 일치 여부와 관계없이 확인 시각을 갱신하므로 같은 항목으로 두 번 알리지 않습니다
+func (s *Service) EvaluateSubscriptions() ([]*domain.AuditSubscriptionMatch, error) {
+	subscriptions, err := s.queryRepo.ListSubscriptions()
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to list audit query subscriptions", 500)
+	}
+
+	until := time.Now().Add(-subscriptionLag)
+	var matches []*domain.AuditSubscriptionMatch
+	for _, subscription := range subscriptions {
+		saved := subscription.SavedQuery
+		if saved == nil || !until.After(subscription.LastCheckedAt) {
+			continue
+		}
+		// 공유가 해제된 쿼리는 소유자만 계속 알림을 받음
+		if !saved.Shared && saved.OwnerID != subscription.UserID {
+			continue
+		}
+
+		query, err := domain.ParseAuditQuery(saved.Query)
+		if err != nil {
+			logger.Warnf("Skipping audit query subscription %s with invalid query: %v", subscription.ID, err)
+			continue
+		}
+
+		from := subscription.LastCheckedAt
+		logs, total, err := s.auditLogRepo.Search(domain.AuditSearchFilters{
+			Query:  query,
+			After:  &from,
+			Before: &until,
+			Limit:  subscriptionSampleSize,
+		})
+		if err != nil {
+			logger.Warnf("Failed to evaluate audit query subscription %s: %v", subscription.ID, err)
+			continue
+		}
+		if err := s.queryRepo.UpdateSubscriptionProgress(subscription.ID, until, total); err != nil {
+			logger.Warnf("Failed to update audit query subscription %s: %v", subscription.ID, err)
+			continue
+		}
+
+		if total > 0 {
+			matches = append(matches, &domain.AuditSubscriptionMatch{
+				Subscription: subscription,
+				Logs:         logs,
+				Total:        total,
+				From:         from,
+				To:           until,
+			})
+		}
+	}
+	return matches, nil
+}
+
+// visibleSavedQuery: 사용자가 볼 수 있는(소유 또는 공유) 저장된 쿼리를 조회합니다
+func (s *Service) visibleSavedQuery(userID, id uuid.UUID) (*domain.AuditSavedQuery, error) {
+	saved, err := s.queryRepo.GetSavedQuery(id)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to get saved audit query", 500)
+	}
+	if saved == nil || (!saved.Shared && saved.OwnerID != userID) {
+		return nil, domain.NewDomainError(domain.ErrCodeNotFound, "saved audit query not found", 404)
+	}
+	return saved, nil
+}
+
+// ownedSavedQuery: 사용자가 소유한 저장된 쿼리를 조회합니다
+func (s *Service) ownedSavedQuery(userID, id uuid.UUID) (*domain.AuditSavedQuery, error) {
+	saved, err := s.visibleSavedQuery(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if saved.OwnerID != userID {
+		return nil, domain.NewDomainError(domain.ErrCodeForbidden, "only the owner can modify a saved audit query", 403)
+	}
+	return saved, nil
+}
+
+// validateSavedQuery: 저장할 쿼리의 이름과 구문을 검증하고 정리합니다
+func validateSavedQuery(req *domain.SaveAuditQueryRequest) error {
+	req.Name = strings.TrimSpace(req.Name)
+	req.Description = strings.TrimSpace(req.Description)
+	req.Query = strings.TrimSpace(req.Query)
+
+	if req.Name == "" || len(req.Name) > 100 {
+		return domain.NewDomainError(domain.ErrCodeValidationFailed, "name must be 1-100 characters", 400)
+	}
+	if len(req.Description) > 500 {
+		return domain.NewDomainError(domain.ErrCodeValidationFailed, "description cannot exceed 500 characters", 400)
+	}
+	_, err := domain.ParseAuditQuery(req.Query)
+	return err
+}
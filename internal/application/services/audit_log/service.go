@@ -1,11 +1,14 @@
 package audit_log
 
 import (
+	"context"
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"skyclust/internal/application/services/audit_log/sink"
 	exportservice "skyclust/internal/application/services/export"
 	"skyclust/internal/domain"
+	"skyclust/pkg/security"
 	"strings"
 	"time"
 
@@ -15,15 +18,30 @@ import (
 // Service implements the audit log business logic
 type Service struct {
 	auditLogRepo domain.AuditLogRepository
+	queryRepo    domain.AuditQueryRepository // saved search queries and subscriptions
+	signer       *security.Ed25519Signer     // signs checkpoints and archives
+	sinks        *sink.Dispatcher            // forwards entries to SIEM sinks through the outbox
 }
 
 // NewService creates a new audit log service
-func NewService(auditLogRepo domain.AuditLogRepository) domain.AuditLogService {
+func NewService(auditLogRepo domain.AuditLogRepository, queryRepo domain.AuditQueryRepository, signer *security.Ed25519Signer, sinks *sink.Dispatcher) domain.AuditLogService {
 	return &Service{
 		auditLogRepo: auditLogRepo,
+		queryRepo:    queryRepo,
+		signer:       signer,
+		sinks:        sinks,
 	}
 }
 
+// GetSinkStatus: 감사 로그 싱크별 전달 상태를 조회합니다
+func (s *Service) GetSinkStatus() ([]*domain.AuditSinkStatus, error) {
+	statuses, err := s.sinks.Status(context.Background())
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeDatabaseError, fmt.Sprintf("failed to get audit sink status: %v", err), 500)
+	}
+	return statuses, nil
+}
+
 // LogAction: 사용자 액션을 로깅합니다
 func (s *Service) LogAction(userID uuid.UUID, action, resource string, details map[string]interface{}) error {
 	log := &domain.AuditLog{
@@ -50,10 +68,9 @@ func (s *Service) GetLogsByDateRange(start, end time.Time, limit, offset int) ([
 	return s.auditLogRepo.GetByDateRange(start, end, limit, offset)
 }
 
-// CleanupOldLogs: 오래된 감사 로그 항목을 제거합니다
+// CleanupOldLogs: 보존 기간이 지난 감사 로그 세그먼트를 아카이브합니다
 func (s *Service) CleanupOldLogs(retentionDays int) error {
-	cutoffDate := time.Now().AddDate(0, 0, -retentionDays)
-	_, err := s.auditLogRepo.DeleteOldLogs(cutoffDate)
+	_, err := s.CleanupAuditLogs(nil, retentionDays)
 	return err
 }
 
@@ -287,27 +304,6 @@ func (s *Service) exportAuditLogsToJSON(logs []*domain.AuditLog) ([]byte, error)
 	return data, nil
 }
 
-// CleanupAuditLogs: 보존 정책에 따라 오래된 감사 로그를 제거합니다 (관리자 메서드)
-func (s *Service) CleanupAuditLogs(retentionDays int) (int64, error) {
-	if retentionDays < 0 {
-		return 0, domain.NewDomainError(domain.ErrCodeValidationFailed, "retention days must be non-negative", 400)
-	}
-
-	if retentionDays == 0 {
-		return 0, domain.NewDomainError(domain.ErrCodeValidationFailed, "retention days must be greater than 0 to prevent accidental deletion of all logs", 400)
-	}
-
-	cutoffDate := time.Now().AddDate(0, 0, -retentionDays)
-
-	// Delete old logs and get actual count of deleted logs
-	deletedCount, err := s.auditLogRepo.DeleteOldLogs(cutoffDate)
-	if err != nil {
-		return 0, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to cleanup audit logs: %v", err), 500)
-	}
-
-	return deletedCount, nil
-}
-
 // GetAuditLogSummary: 감사 로그 요약을 조회합니다 (관리자 메서드)
 func (s *Service) GetAuditLogSummary(startTime, endTime time.Time) (*domain.AuditLogSummary, error) {
 	filters := domain.AuditStatsFilters{
@@ -0,0 +1,232 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+)
+
+const (
+	// DefaultBatchSize: 싱크별 한 번에 전송하는 최대 항목 수
+	DefaultBatchSize = 100
+
+	minRetryBackoff = 5 * time.Second
+	maxRetryBackoff = 5 * time.Minute
+
+	// claimLease: 가져간 배치가 이 인스턴스에 잠겨 있는 시간 (전송 중 중단되면 만료 후 다른 인스턴스가 다시 전송)
+	claimLease = 2 * time.Minute
+)
+
+// Dispatcher: outbox에 쌓인 싱크별 감사 로그를 순서대로 전달하고 전달 지표를 기록합니다
+// 전송이 실패하면 항목은 pending으로 남고 싱크별 지수 백오프 후 같은 순서로 재시도됩니다
+// 배치는 싱크 토픽 단위로 임대하므로 모든 인스턴스에서 실행해도 같은 항목을 중복 전송하지 않습니다
+type Dispatcher struct {
+	outboxRepo domain.OutboxRepository
+	sinks      []Sink
+	batchSize  int
+	workerID   string
+
+	mu      sync.Mutex
+	metrics map[string]*metrics
+}
+
+// metrics: 싱크별 전달 지표
+type metrics struct {
+	delivered           int64
+	failed              int64
+	consecutiveFailures int
+	lastAttemptAt       *time.Time
+	lastDeliveredAt     *time.Time
+	lastFailureAt       *time.Time
+	lastError           string
+	retryAt             time.Time
+}
+
+// NewDispatcher: 새로운 싱크 디스패처를 생성합니다
+func NewDispatcher(outboxRepo domain.OutboxRepository, batchSize int, sinks ...Sink) *Dispatcher {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	hostname, _ := os.Hostname()
+	d := &Dispatcher{
+		outboxRepo: outboxRepo,
+		sinks:      sinks,
+		batchSize:  batchSize,
+		workerID:   fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		metrics:    make(map[string]*metrics, len(sinks)),
+	}
+	for _, s := range sinks {
+		d.metrics[s.Name()] = &metrics{}
+	}
+	return d
+}
+
+// Enabled: 설정된 싱크가 있는지 확인합니다
+func (d *Dispatcher) Enabled() bool {
+	return d != nil && len(d.sinks) > 0
+}
+
+// Dispatch: 각 싱크의 대기 항목을 한 배치씩 전달하고, 대기 항목이 더 남았는지 반환합니다
+func (d *Dispatcher) Dispatch(ctx context.Context) bool {
+	more := false
+	for _, s := range d.sinks {
+		if !d.ready(s.Name()) {
+			continue
+		}
+		delivered, err := d.dispatchSink(ctx, s)
+		if err != nil {
+			logger.Warnf("Audit sink %s delivery failed: %v", s.Name(), err)
+			continue
+		}
+		if delivered == d.batchSize {
+			more = true
+		}
+	}
+	return more
+}
+
+// dispatchSink: 싱크 하나의 대기 항목 배치를 임대하여 전달합니다
+// 다른 인스턴스가 같은 싱크의 배치를 전송 중이거나 재시도 대기 중이면 아무것도 가져오지 않습니다
+func (d *Dispatcher) dispatchSink(ctx context.Context, s Sink) (int, error) {
+	events, err := d.outboxRepo.ClaimPendingEventsByTopic(ctx, domain.AuditSinkTopic(s.Name()), d.workerID, d.batchSize, claimLease)
+	if err != nil {
+		return 0, err
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	entries := make([]*domain.AuditLog, 0, len(events))
+	valid := make([]*domain.OutboxEvent, 0, len(events))
+	for _, event := range events {
+		entry, err := domain.AuditLogFromSinkEvent(event)
+		if err != nil {
+			// 복원할 수 없는 이벤트는 재시도해도 성공하지 않으므로 실패로 표시하고 건너뜀
+			msg := err.Error()
+			if updateErr := d.outboxRepo.UpdateStatus(ctx, event.ID.String(), domain.OutboxStatusFailed, &msg); updateErr != nil {
+				return 0, updateErr
+			}
+			logger.Errorf("Dropped undecodable audit sink event %s: %v", event.ID, err)
+			continue
+		}
+		entries = append(entries, entry)
+		valid = append(valid, event)
+	}
+	if len(entries) == 0 {
+		return 0, nil
+	}
+
+	sendErr := s.Send(ctx, entries)
+	retryAt := d.record(s.Name(), len(entries), sendErr)
+	if sendErr != nil {
+		// 배치의 첫 항목에 재시도 횟수, 오류, 다음 시도 시각을 남기고 나머지는 임대를 풀어 순서대로 대기
+		// 첫 항목이 재시도 대기 중인 동안에는 어느 인스턴스도 이 싱크의 배치를 가져가지 않음
+		if err := d.outboxRepo.ScheduleRetry(ctx, valid[0].ID.String(), retryAt, sendErr.Error()); err != nil {
+			logger.Errorf("Failed to schedule audit sink retry: %v", err)
+		}
+		rest := make([]string, 0, len(valid)-1)
+		for _, event := range valid[1:] {
+			rest = append(rest, event.ID.String())
+		}
+		if err := d.outboxRepo.ReleaseClaimedEvents(ctx, rest); err != nil {
+			logger.Errorf("Failed to release audit sink events: %v", err)
+		}
+		return 0, sendErr
+	}
+
+	for _, event := range valid {
+		if err := d.outboxRepo.MarkAsPublished(ctx, event.ID.String()); err != nil {
+			return 0, fmt.Errorf("failed to mark audit sink event %s as delivered: %w", event.ID, err)
+		}
+	}
+	return len(events), nil
+}
+
+// ready: 싱크가 재시도 대기 중이 아닌지 확인합니다
+func (d *Dispatcher) ready(name string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return !time.Now().Before(d.metrics[name].retryAt)
+}
+
+// record: 전송 결과를 지표에 반영하고 실패했으면 다음 시도 시각을 반환합니다
+func (d *Dispatcher) record(name string, count int, err error) time.Time {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	m := d.metrics[name]
+	m.lastAttemptAt = &now
+	if err == nil {
+		m.delivered += int64(count)
+		m.consecutiveFailures = 0
+		m.lastDeliveredAt = &now
+		m.retryAt = time.Time{}
+		return time.Time{}
+	}
+
+	m.failed++
+	m.consecutiveFailures++
+	m.lastFailureAt = &now
+	m.lastError = err.Error()
+
+	backoff := minRetryBackoff << min(m.consecutiveFailures-1, 6)
+	if backoff > maxRetryBackoff {
+		backoff = maxRetryBackoff
+	}
+	m.retryAt = now.Add(backoff)
+	return m.retryAt
+}
+
+// Status: 싱크별 전달 상태와 대기 항목 수를 반환합니다
+func (d *Dispatcher) Status(ctx context.Context) ([]*domain.AuditSinkStatus, error) {
+	if !d.Enabled() {
+		return []*domain.AuditSinkStatus{}, nil
+	}
+
+	statuses := make([]*domain.AuditSinkStatus, 0, len(d.sinks))
+	for _, s := range d.sinks {
+		pending, err := d.outboxRepo.CountPendingByTopic(ctx, domain.AuditSinkTopic(s.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		d.mu.Lock()
+		m := d.metrics[s.Name()]
+		statuses = append(statuses, &domain.AuditSinkStatus{
+			Name:                s.Name(),
+			Type:                s.Type(),
+			Format:              s.Format(),
+			Target:              s.Target(),
+			Filter:              s.Filter(),
+			Healthy:             m.consecutiveFailures == 0,
+			Pending:             pending,
+			Delivered:           m.delivered,
+			Failed:              m.failed,
+			ConsecutiveFailures: m.consecutiveFailures,
+			LastAttemptAt:       m.lastAttemptAt,
+			LastDeliveredAt:     m.lastDeliveredAt,
+			LastFailureAt:       m.lastFailureAt,
+			LastError:           m.lastError,
+		})
+		d.mu.Unlock()
+	}
+	return statuses, nil
+}
+
+// Close: 모든 싱크의 연결을 닫습니다
+func (d *Dispatcher) Close() {
+	if d == nil {
+		return
+	}
+	for _, s := range d.sinks {
+		if err := s.Close(); err != nil {
+			logger.Warnf("Failed to close audit sink %s: %v", s.Name(), err)
+		}
+	}
+}
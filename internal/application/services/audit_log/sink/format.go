@@ -0,0 +1,145 @@
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"skyclust/internal/domain"
+)
+
+const (
+	vendorName    = "SkyClust"
+	productName   = "SkyClust"
+	productVer    = "1.0"
+	defaultSource = "skyclust"
+)
+
+// record: JSON 형식으로 전달하는 감사 로그 표현
+type record struct {
+	ID        string                 `json:"id"`
+	Timestamp string                 `json:"timestamp"`
+	UserID    string                 `json:"user_id"`
+	Action    string                 `json:"action"`
+	Resource  string                 `json:"resource,omitempty"`
+	IPAddress string                 `json:"ip_address,omitempty"`
+	UserAgent string                 `json:"user_agent,omitempty"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+	Segment   string                 `json:"segment,omitempty"`
+	Sequence  int64                  `json:"sequence,omitempty"`
+	Hash      string                 `json:"hash,omitempty"`
+	Source    string                 `json:"source"`
+}
+
+// newRecord: 감사 로그를 JSON 레코드로 변환합니다
+func newRecord(log *domain.AuditLog) record {
+	return record{
+		ID:        log.ID.String(),
+		Timestamp: log.CreatedAt.UTC().Format(time.RFC3339Nano),
+		UserID:    log.UserID.String(),
+		Action:    log.Action,
+		Resource:  log.Resource,
+		IPAddress: log.IPAddress,
+		UserAgent: log.UserAgent,
+		Details:   log.Details,
+		Segment:   log.Segment,
+		Sequence:  log.Sequence,
+		Hash:      log.Hash,
+		Source:    defaultSource,
+	}
+}
+
+// formatMessage: 싱크 형식에 맞는 메시지 본문을 생성합니다
+func formatMessage(format string, log *domain.AuditLog) (string, error) {
+	if format == domain.AuditSinkFormatCEF {
+		return formatCEF(log), nil
+	}
+	data, err := json.Marshal(newRecord(log))
+	if err != nil {
+		return "", fmt.Errorf("failed to encode audit log %s: %w", log.ID, err)
+	}
+	return string(data), nil
+}
+
+// formatCEF: ArcSight Common Event Format 메시지를 생성합니다
+func formatCEF(log *domain.AuditLog) string {
+	method, path := splitResource(log.Resource)
+
+	ext := []string{
+		"rt=" + strconv.FormatInt(log.CreatedAt.UnixMilli(), 10),
+		"externalId=" + cefExtension(log.ID.String()),
+		"suser=" + cefExtension(log.UserID.String()),
+		"act=" + cefExtension(log.Action),
+	}
+	if log.IPAddress != "" {
+		ext = append(ext, "src="+cefExtension(log.IPAddress))
+	}
+	if method != "" {
+		ext = append(ext, "requestMethod="+cefExtension(method))
+	}
+	if path != "" {
+		ext = append(ext, "request="+cefExtension(path))
+	}
+	if log.UserAgent != "" {
+		ext = append(ext, "requestClientApplication="+cefExtension(log.UserAgent))
+	}
+	if log.Segment != "" {
+		ext = append(ext,
+			"cs1Label=segment", "cs1="+cefExtension(log.Segment),
+			"cn1Label=sequence", "cn1="+strconv.FormatInt(log.Sequence, 10),
+			"cs2Label=hash", "cs2="+cefExtension(log.Hash))
+	}
+	if len(log.Details) > 0 {
+		if details, err := json.Marshal(log.Details); err == nil {
+			ext = append(ext, "msg="+cefExtension(string(details)))
+		}
+	}
+
+	return fmt.Sprintf("CEF:0|%s|%s|%s|%s|%s|%d|%s",
+		cefHeader(vendorName),
+		cefHeader(productName),
+		cefHeader(productVer),
+		cefHeader(log.Action),
+		cefHeader(strings.ReplaceAll(log.Action, "_", " ")),
+		cefSeverity(log),
+		strings.Join(ext, " "))
+}
+
+// cefSeverity: 액션과 결과로 CEF 심각도(0-10)를 결정합니다
+func cefSeverity(log *domain.AuditLog) int {
+	if status, ok := log.Details["status"].(string); ok {
+		switch strings.ToLower(status) {
+		case "failed", "failure", "denied", "error":
+			return 7
+		}
+	}
+	for _, keyword := range []string{"delete", "revoke", "archive", "rotate", "vend"} {
+		if strings.Contains(log.Action, keyword) {
+			return 5
+		}
+	}
+	return 3
+}
+
+// splitResource: "METHOD /path" 형식의 리소스를 분리합니다
+func splitResource(resource string) (string, string) {
+	method, path, ok := strings.Cut(resource, " ")
+	if !ok || method == "" || strings.ToUpper(method) != method {
+		return "", resource
+	}
+	return method, path
+}
+
+// cefHeader: CEF 헤더 필드의 특수 문자를 이스케이프합니다
+func cefHeader(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, "|", `\|`)
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(value)
+}
+
+// cefExtension: CEF 확장 필드 값의 특수 문자를 이스케이프합니다
+func cefExtension(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "=", `\=`, "\r", `\r`, "\n", `\n`).Replace(value)
+}
@@ -0,0 +1,151 @@
+package sink
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"skyclust/internal/domain"
+)
+
+const defaultSinkTimeout = 10 * time.Second
+
+// sinkNamePattern: outbox 토픽에 사용하는 싱크 이름 형식
+var sinkNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// Sink: 감사 로그를 외부 SIEM으로 전달하는 대상
+type Sink interface {
+	Name() string
+	Type() string
+	Format() string
+	Target() string // 자격 증명을 제외한 전송 대상
+	Filter() domain.AuditSinkFilter
+	Send(ctx context.Context, entries []*domain.AuditLog) error
+	Close() error
+}
+
+// Config: 감사 로그 싱크 설정
+type Config struct {
+	Name   string
+	Type   string
+	Format string // json (기본) 또는 cef
+	Filter domain.AuditSinkFilter
+
+	// syslog
+	Address  string // host:port
+	TLS      bool
+	AppName  string
+	Hostname string
+
+	// webhook, splunk_hec
+	URL        string
+	Secret     string // 웹훅 HMAC-SHA256 서명 키
+	Token      string // Splunk HEC 토큰
+	Index      string
+	Source     string
+	SourceType string
+
+	// 공통 TLS/타임아웃
+	CAFile             string
+	InsecureSkipVerify bool
+	Timeout            time.Duration
+}
+
+// New: 설정에 맞는 싱크를 생성합니다
+func New(cfg Config) (Sink, error) {
+	if !sinkNamePattern.MatchString(cfg.Name) {
+		return nil, fmt.Errorf("invalid audit sink name %q: use lowercase letters, digits, '-' or '_'", cfg.Name)
+	}
+
+	cfg.Format = strings.ToLower(cfg.Format)
+	switch cfg.Format {
+	case "":
+		cfg.Format = domain.AuditSinkFormatJSON
+	case domain.AuditSinkFormatJSON, domain.AuditSinkFormatCEF:
+	default:
+		return nil, fmt.Errorf("audit sink %s: unsupported format %q", cfg.Name, cfg.Format)
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultSinkTimeout
+	}
+
+	switch strings.ToLower(cfg.Type) {
+	case domain.AuditSinkTypeSyslog:
+		return newSyslogSink(cfg)
+	case domain.AuditSinkTypeWebhook:
+		return newWebhookSink(cfg)
+	case domain.AuditSinkTypeSplunkHEC:
+		return newSplunkSink(cfg)
+	default:
+		return nil, fmt.Errorf("audit sink %s: unsupported type %q", cfg.Name, cfg.Type)
+	}
+}
+
+// Routes: 감사 로그 기록 시 outbox 이벤트를 생성할 싱크 목록을 반환합니다
+func Routes(sinks []Sink) []domain.AuditSinkRoute {
+	routes := make([]domain.AuditSinkRoute, 0, len(sinks))
+	for _, s := range sinks {
+		routes = append(routes, domain.AuditSinkRoute{Name: s.Name(), Filter: s.Filter()})
+	}
+	return routes
+}
+
+// base: 싱크 공통 속성
+type base struct {
+	name   string
+	format string
+	filter domain.AuditSinkFilter
+}
+
+// Name: 싱크 이름을 반환합니다
+func (b *base) Name() string {
+	return b.name
+}
+
+// Format: 메시지 형식을 반환합니다
+func (b *base) Format() string {
+	return b.format
+}
+
+// Filter: 전달 조건을 반환합니다
+func (b *base) Filter() domain.AuditSinkFilter {
+	return b.filter
+}
+
+// newTLSConfig: 사용자 지정 CA와 검증 생략 옵션을 반영한 TLS 설정을 생성합니다
+func newTLSConfig(cfg Config, serverName string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         serverName,
+		InsecureSkipVerify: cfg.InsecureSkipVerify, // 자체 서명 인증서를 쓰는 테스트 환경용
+	}
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("audit sink %s: failed to read CA file: %w", cfg.Name, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("audit sink %s: no certificates found in CA file", cfg.Name)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
+
+// newHTTPClient: HTTP 기반 싱크용 클라이언트를 생성합니다
+func newHTTPClient(cfg Config) (*http.Client, error) {
+	tlsConfig, err := newTLSConfig(cfg, "")
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Timeout: cfg.Timeout, Transport: transport}, nil
+}
@@ -0,0 +1,153 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"skyclust/internal/domain"
+)
+
+const (
+	splunkEventPath         = "/services/collector/event"
+	defaultSplunkSourceType = "skyclust:audit"
+)
+
+// splunkSink: Splunk HTTP Event Collector로 배치를 전송하는 싱크
+type splunkSink struct {
+	base
+	url        string
+	token      string
+	index      string
+	source     string
+	sourceType string
+	host       string
+	client     *http.Client
+}
+
+// splunkEvent: HEC 이벤트 엔벨로프
+type splunkEvent struct {
+	Time       float64     `json:"time"`
+	Host       string      `json:"host,omitempty"`
+	Source     string      `json:"source,omitempty"`
+	SourceType string      `json:"sourcetype,omitempty"`
+	Index      string      `json:"index,omitempty"`
+	Event      interface{} `json:"event"`
+}
+
+// splunkResponse: HEC 응답
+type splunkResponse struct {
+	Text string `json:"text"`
+	Code int    `json:"code"`
+}
+
+// newSplunkSink: 새로운 Splunk HEC 싱크를 생성합니다
+func newSplunkSink(cfg Config) (*splunkSink, error) {
+	parsed, err := url.Parse(cfg.URL)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+		return nil, fmt.Errorf("audit sink %s: invalid splunk hec url", cfg.Name)
+	}
+	if parsed.Path == "" || parsed.Path == "/" {
+		parsed.Path = splunkEventPath
+	}
+	if cfg.Token == "" {
+		return nil, fmt.Errorf("audit sink %s: splunk hec token is required", cfg.Name)
+	}
+
+	client, err := newHTTPClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &splunkSink{
+		base:       base{name: cfg.Name, format: cfg.Format, filter: cfg.Filter},
+		url:        parsed.String(),
+		token:      cfg.Token,
+		index:      cfg.Index,
+		source:     cfg.Source,
+		sourceType: cfg.SourceType,
+		host:       cfg.Hostname,
+		client:     client,
+	}
+	if s.source == "" {
+		s.source = defaultSource
+	}
+	if s.sourceType == "" {
+		s.sourceType = defaultSplunkSourceType
+		if s.format == domain.AuditSinkFormatCEF {
+			s.sourceType = "cef"
+		}
+	}
+	if s.host == "" {
+		s.host, _ = os.Hostname()
+	}
+	return s, nil
+}
+
+// Type: 싱크 유형을 반환합니다
+func (s *splunkSink) Type() string {
+	return domain.AuditSinkTypeSplunkHEC
+}
+
+// Target: 전송 대상을 반환합니다
+func (s *splunkSink) Target() string {
+	return redactURL(s.url)
+}
+
+// Send: 감사 로그 배치를 HEC 이벤트로 전송합니다
+func (s *splunkSink) Send(ctx context.Context, entries []*domain.AuditLog) error {
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, entry := range entries {
+		event := splunkEvent{
+			Time:       float64(entry.CreatedAt.UnixMicro()) / 1e6,
+			Host:       s.host,
+			Source:     s.source,
+			SourceType: s.sourceType,
+			Index:      s.index,
+		}
+		if s.format == domain.AuditSinkFormatCEF {
+			event.Event = formatCEF(entry)
+		} else {
+			event.Event = newRecord(entry)
+		}
+		if err := encoder.Encode(event); err != nil {
+			return fmt.Errorf("failed to encode splunk event: %w", err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, &body)
+	if err != nil {
+		return fmt.Errorf("failed to create splunk request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Splunk "+s.token)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to deliver events to splunk %s: %w", s.Target(), err)
+	}
+	defer resp.Body.Close()
+
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, maxSinkResponse))
+	if resp.StatusCode != http.StatusOK {
+		var hecErr splunkResponse
+		if json.Unmarshal(data, &hecErr) == nil && hecErr.Text != "" {
+			return fmt.Errorf("splunk %s returned status %d: %s (code %d)", s.Target(), resp.StatusCode, hecErr.Text, hecErr.Code)
+		}
+		return fmt.Errorf("splunk %s returned status %d: %s", s.Target(), resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return nil
+}
+
+// Close: 유휴 연결을 정리합니다
+func (s *splunkSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
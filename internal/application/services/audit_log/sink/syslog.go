@@ -0,0 +1,208 @@
+package sink
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"skyclust/internal/domain"
+)
+
+const (
+	syslogFacilityAudit = 13 // log audit
+	syslogSeverityInfo  = 6
+	syslogSeverityWarn  = 4
+	syslogMaxMsgID      = 32
+	syslogSDID          = "audit@32473"
+	defaultSyslogApp    = "skyclust"
+)
+
+// syslogSink: RFC 5424 메시지를 TCP 또는 TLS(RFC 5425 옥텟 카운팅 프레이밍)로 전송하는 싱크
+type syslogSink struct {
+	base
+	address   string
+	appName   string
+	hostname  string
+	timeout   time.Duration
+	tlsConfig *tls.Config
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// newSyslogSink: 새로운 syslog 싱크를 생성합니다
+func newSyslogSink(cfg Config) (*syslogSink, error) {
+	host, _, err := net.SplitHostPort(cfg.Address)
+	if err != nil {
+		return nil, fmt.Errorf("audit sink %s: invalid syslog address %q: %w", cfg.Name, cfg.Address, err)
+	}
+
+	s := &syslogSink{
+		base:     base{name: cfg.Name, format: cfg.Format, filter: cfg.Filter},
+		address:  cfg.Address,
+		appName:  syslogToken(cfg.AppName, 48),
+		hostname: syslogToken(cfg.Hostname, 255),
+		timeout:  cfg.Timeout,
+	}
+	if s.appName == "-" {
+		s.appName = defaultSyslogApp
+	}
+	if s.hostname == "-" {
+		if hostname, err := os.Hostname(); err == nil {
+			s.hostname = syslogToken(hostname, 255)
+		}
+	}
+	if cfg.TLS {
+		if s.tlsConfig, err = newTLSConfig(cfg, host); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Type: 싱크 유형을 반환합니다
+func (s *syslogSink) Type() string {
+	return domain.AuditSinkTypeSyslog
+}
+
+// Target: 전송 대상을 반환합니다
+func (s *syslogSink) Target() string {
+	if s.tlsConfig != nil {
+		return "tls://" + s.address
+	}
+	return "tcp://" + s.address
+}
+
+// Send: 감사 로그를 순서대로 전송합니다 (쓰기 실패 시 연결을 닫고 다음 시도에서 재연결)
+func (s *syslogSink) Send(ctx context.Context, entries []*domain.AuditLog) error {
+	var frames strings.Builder
+	for _, entry := range entries {
+		msg, err := s.formatRFC5424(entry)
+		if err != nil {
+			return err
+		}
+		frames.WriteString(strconv.Itoa(len(msg)))
+		frames.WriteByte(' ')
+		frames.WriteString(msg)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	conn, err := s.connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(s.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	_ = conn.SetWriteDeadline(deadline)
+
+	if _, err := conn.Write([]byte(frames.String())); err != nil {
+		s.closeLocked()
+		return fmt.Errorf("failed to write to syslog %s: %w", s.address, err)
+	}
+	return nil
+}
+
+// Close: 연결을 닫습니다
+func (s *syslogSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closeLocked()
+	return nil
+}
+
+// connect: 열린 연결을 재사용하거나 새로 연결합니다
+func (s *syslogSink) connect(ctx context.Context) (net.Conn, error) {
+	if s.conn != nil {
+		return s.conn, nil
+	}
+
+	dialer := &net.Dialer{Timeout: s.timeout, KeepAlive: 30 * time.Second}
+	var (
+		conn net.Conn
+		err  error
+	)
+	if s.tlsConfig != nil {
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: s.tlsConfig}
+		conn, err = tlsDialer.DialContext(ctx, "tcp", s.address)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", s.address)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to syslog %s: %w", s.address, err)
+	}
+	s.conn = conn
+	return conn, nil
+}
+
+// closeLocked: 잠금을 보유한 상태에서 연결을 닫습니다
+func (s *syslogSink) closeLocked() {
+	if s.conn != nil {
+		_ = s.conn.Close()
+		s.conn = nil
+	}
+}
+
+// formatRFC5424: RFC 5424 syslog 메시지를 생성합니다
+func (s *syslogSink) formatRFC5424(log *domain.AuditLog) (string, error) {
+	body, err := formatMessage(s.format, log)
+	if err != nil {
+		return "", err
+	}
+
+	severity := syslogSeverityInfo
+	if cefSeverity(log) >= 7 {
+		severity = syslogSeverityWarn
+	}
+
+	sd := fmt.Sprintf(`[%s id="%s" user="%s"`, syslogSDID, log.ID, log.UserID)
+	if log.Segment != "" {
+		sd += fmt.Sprintf(` segment="%s" seq="%d"`, log.Segment, log.Sequence)
+	}
+	if log.IPAddress != "" {
+		sd += fmt.Sprintf(` src="%s"`, syslogParam(log.IPAddress))
+	}
+	sd += "]"
+
+	return fmt.Sprintf("<%d>1 %s %s %s %d %s %s \ufeff%s",
+		syslogFacilityAudit*8+severity,
+		log.CreatedAt.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+		s.hostname,
+		s.appName,
+		os.Getpid(),
+		syslogToken(log.Action, syslogMaxMsgID),
+		sd,
+		body), nil
+}
+
+// syslogToken: 헤더 필드를 출력 가능한 ASCII로 제한하고 비어 있으면 NILVALUE를 반환합니다
+func syslogToken(value string, maxLen int) string {
+	var b strings.Builder
+	for _, r := range value {
+		if r > 32 && r < 127 {
+			b.WriteRune(r)
+		}
+		if b.Len() >= maxLen {
+			break
+		}
+	}
+	if b.Len() == 0 {
+		return "-"
+	}
+	return b.String()
+}
+
+// syslogParam: 구조화 데이터 파라미터 값을 이스케이프합니다
+func syslogParam(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "]", `\]`).Replace(value)
+}
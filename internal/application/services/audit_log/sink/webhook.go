@@ -0,0 +1,146 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"skyclust/internal/domain"
+)
+
+// 웹훅 서명 헤더
+const (
+	webhookSignatureHeader = "X-SkyClust-Signature"
+	webhookTimestampHeader = "X-SkyClust-Timestamp"
+	webhookSinkHeader      = "X-SkyClust-Audit-Sink"
+	maxSinkResponse        = 64 << 10
+)
+
+// webhookSink: HMAC-SHA256으로 서명한 배치를 HTTPS로 전송하는 싱크
+// 서명은 "<timestamp>.<body>"에 대해 계산되며 "sha256=<hex>" 형식으로 전달됩니다
+type webhookSink struct {
+	base
+	url    string
+	secret []byte
+	client *http.Client
+}
+
+// webhookPayload: 웹훅 요청 본문
+type webhookPayload struct {
+	Sink   string        `json:"sink"`
+	Count  int           `json:"count"`
+	Events []interface{} `json:"events"`
+}
+
+// newWebhookSink: 새로운 웹훅 싱크를 생성합니다
+func newWebhookSink(cfg Config) (*webhookSink, error) {
+	parsed, err := url.Parse(cfg.URL)
+	if err != nil || parsed.Host == "" {
+		return nil, fmt.Errorf("audit sink %s: invalid webhook url", cfg.Name)
+	}
+	if parsed.Scheme != "https" {
+		return nil, fmt.Errorf("audit sink %s: webhook url must use https", cfg.Name)
+	}
+	if cfg.Secret == "" {
+		return nil, fmt.Errorf("audit sink %s: webhook secret is required", cfg.Name)
+	}
+
+	client, err := newHTTPClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhookSink{
+		base:   base{name: cfg.Name, format: cfg.Format, filter: cfg.Filter},
+		url:    cfg.URL,
+		secret: []byte(cfg.Secret),
+		client: client,
+	}, nil
+}
+
+// Type: 싱크 유형을 반환합니다
+func (s *webhookSink) Type() string {
+	return domain.AuditSinkTypeWebhook
+}
+
+// Target: 쿼리 문자열을 제외한 전송 대상을 반환합니다
+func (s *webhookSink) Target() string {
+	return redactURL(s.url)
+}
+
+// Send: 감사 로그 배치를 서명하여 전송합니다
+func (s *webhookSink) Send(ctx context.Context, entries []*domain.AuditLog) error {
+	payload := webhookPayload{Sink: s.name, Count: len(entries), Events: make([]interface{}, 0, len(entries))}
+	for _, entry := range entries {
+		if s.format == domain.AuditSinkFormatCEF {
+			payload.Events = append(payload.Events, formatCEF(entry))
+		} else {
+			payload.Events = append(payload.Events, newRecord(entry))
+		}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookTimestampHeader, timestamp)
+	req.Header.Set(webhookSignatureHeader, "sha256="+s.sign(timestamp, body))
+	req.Header.Set(webhookSinkHeader, s.name)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to deliver webhook to %s: %w", s.Target(), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, maxSinkResponse))
+		return fmt.Errorf("webhook %s returned status %d: %s", s.Target(), resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxSinkResponse))
+	return nil
+}
+
+// Close: 유휴 연결을 정리합니다
+func (s *webhookSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+// sign: 타임스탬프와 본문에 대한 HMAC-SHA256 서명을 계산합니다
+func (s *webhookSink) sign(timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// redactURL: 자격 증명이 포함될 수 있는 사용자 정보와 쿼리를 제거합니다
+func redactURL(raw string) string {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	parsed.User = nil
+	parsed.RawQuery = ""
+	parsed.Fragment = ""
+	return parsed.String()
+}
@@ -103,15 +103,8 @@ func (s *Service) Register(req domain.CreateUserRequest) (*domain.User, string,
 		return nil, "", domain.NewDomainError(domain.ErrCodeInternalError, "failed to get user roles", 500)
 	}
 
-	// Generate JWT token with primary role (first role)
-	var primaryRole domain.Role
-	if len(userRoles) > 0 {
-		primaryRole = userRoles[0]
-	} else {
-		primaryRole = domain.UserRoleType
-	}
-
-	token, err := s.generateJWT(user.ID, user.Username, primaryRole)
+	// Generate JWT token with the highest-privilege role so roles granted by SSO group mappings take effect
+	token, err := s.generateJWT(user.ID, user.Username, domain.HighestRole(userRoles))
 	if err != nil {
 		return nil, "", domain.NewDomainError(domain.ErrCodeInternalError, "failed to generate token", 500)
 	}
@@ -150,28 +143,35 @@ func (s *Service) Login(email, password string) (*domain.User, string, error) {
 		return nil, "", domain.ErrInvalidCredentials
 	}
 
-	// Get user roles for JWT token
-	userRoles, err := s.rbacService.GetUserRoles(user.ID)
+	token, err := s.IssueToken(user)
 	if err != nil {
-		return nil, "", domain.NewDomainError(domain.ErrCodeInternalError, "failed to get user roles", 500)
+		return nil, "", err
 	}
 
-	// Generate JWT token with primary role (first role)
-	var primaryRole domain.Role
-	if len(userRoles) > 0 {
-		primaryRole = userRoles[0]
-	} else {
-		primaryRole = domain.UserRoleType
+	// Note: Audit log is now created in LoginWithContext method
+
+	return user, token, nil
+}
+
+// IssueToken: 이미 인증된 사용자에게 JWT 토큰을 발급합니다 (OIDC/SSO 로그인에서 공유)
+func (s *Service) IssueToken(user *domain.User) (string, error) {
+	if !user.IsActive() {
+		return "", domain.NewDomainError(domain.ErrCodeUnauthorized, "account is deactivated", 401)
 	}
 
-	token, err := s.generateJWT(user.ID, user.Username, primaryRole)
+	// Get user roles for JWT token
+	userRoles, err := s.rbacService.GetUserRoles(user.ID)
 	if err != nil {
-		return nil, "", domain.NewDomainError(domain.ErrCodeInternalError, "failed to generate token", 500)
+		return "", domain.NewDomainError(domain.ErrCodeInternalError, "failed to get user roles", 500)
 	}
 
-	// Note: Audit log is now created in LoginWithContext method
+	// Generate JWT token with the highest-privilege role so roles granted by SSO group mappings take effect
+	token, err := s.generateJWT(user.ID, user.Username, domain.HighestRole(userRoles))
+	if err != nil {
+		return "", domain.NewDomainError(domain.ErrCodeInternalError, "failed to generate token", 500)
+	}
 
-	return user, token, nil
+	return token, nil
 }
 
 // LoginWithContext: 클라이언트 컨텍스트 정보를 포함하여 로그인을 수행합니다
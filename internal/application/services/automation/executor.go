@@ -0,0 +1,399 @@
+package automation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"skyclust/internal/application/services/common"
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// compiledRule: 조건식과 액션 템플릿을 컴파일한 규칙
+type compiledRule struct {
+	updatedAt time.Time
+	condition *Expression // nil이면 항상 참
+	actions   []*compiledAction
+}
+
+// compiledAction: 템플릿과 대상 식을 컴파일한 액션
+type compiledAction struct {
+	domain.AutomationAction
+	title   *Template
+	message *Template
+	target  *Expression
+}
+
+// compileRule: 규칙의 조건식, 알림 템플릿, 작업 대상 식을 컴파일합니다
+func compileRule(rule *domain.AutomationRule) (*compiledRule, error) {
+	compiled := &compiledRule{updatedAt: rule.UpdatedAt}
+	if rule.Condition != "" {
+		condition, err := CompileExpression(rule.Condition)
+		if err != nil {
+			return nil, fmt.Errorf("invalid condition: %w", err)
+		}
+		compiled.condition = condition
+	}
+
+	for i, action := range rule.Actions {
+		compiledAction := &compiledAction{AutomationAction: action}
+		var err error
+		switch action.Type {
+		case domain.AutomationActionNotify:
+			if compiledAction.title, err = CompileTemplate(action.Title); err != nil {
+				return nil, fmt.Errorf("actions[%d]: invalid title: %w", i, err)
+			}
+			if compiledAction.message, err = CompileTemplate(action.Message); err != nil {
+				return nil, fmt.Errorf("actions[%d]: invalid message: %w", i, err)
+			}
+		case domain.AutomationActionOperation:
+			if compiledAction.target, err = CompileExpression(action.Target); err != nil {
+				return nil, fmt.Errorf("actions[%d]: invalid target: %w", i, err)
+			}
+		}
+		compiled.actions = append(compiled.actions, compiledAction)
+	}
+	return compiled, nil
+}
+
+// evaluate: 조건식을 평가합니다
+func (r *compiledRule) evaluate(env map[string]interface{}) (bool, error) {
+	if r.condition == nil {
+		return true, nil
+	}
+	return r.condition.EvalBool(env)
+}
+
+// preview: 액션을 실행하지 않고 템플릿과 대상 식만 적용한 결과를 반환합니다
+func (a *compiledAction) preview(env map[string]interface{}) domain.AutomationActionResult {
+	result := domain.AutomationActionResult{Type: a.Type}
+	switch a.Type {
+	case domain.AutomationActionNotify:
+		title, message, err := a.render(env)
+		if err != nil {
+			result.Error = err.Error()
+			break
+		}
+		result.Detail = fmt.Sprintf("%s: %s", title, message)
+	case domain.AutomationActionWebhook:
+		result.Detail = fmt.Sprintf("webhook %s", a.WebhookID)
+	case domain.AutomationActionOperation:
+		target, err := a.resolveTarget(env)
+		if err != nil {
+			result.Error = err.Error()
+			break
+		}
+		result.Detail = fmt.Sprintf("%s %s", a.Operation, target)
+	}
+	return result
+}
+
+// render: 알림 제목과 본문 템플릿을 채웁니다
+func (a *compiledAction) render(env map[string]interface{}) (string, string, error) {
+	title, err := a.title.Render(env)
+	if err != nil {
+		return "", "", fmt.Errorf("title: %w", err)
+	}
+	message, err := a.message.Render(env)
+	if err != nil {
+		return "", "", fmt.Errorf("message: %w", err)
+	}
+	return title, message, nil
+}
+
+// resolveTarget: 작업 대상 ID 식을 평가합니다
+func (a *compiledAction) resolveTarget(env map[string]interface{}) (string, error) {
+	value, err := a.target.Eval(env)
+	if err != nil {
+		return "", fmt.Errorf("target: %w", err)
+	}
+	target, ok := value.(string)
+	if !ok || target == "" {
+		return "", fmt.Errorf("target must evaluate to a non-empty string, got %s", typeName(value))
+	}
+	return target, nil
+}
+
+// execute: 예약된 실행의 액션을 순서대로 실행하고 결과를 기록합니다
+// 이벤트를 처리한 요청이나 구독과 무관하게 실행되며, 규칙 생성자를 정책 평가 주체로 사용합니다
+func (s *Service) execute(rule *domain.AutomationRule, compiled *compiledRule, execution *domain.AutomationExecution, event domain.AutomationEvent, env map[string]interface{}) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.ActionTimeout)
+	defer cancel()
+	if rule.CreatedBy != nil {
+		ctx = domain.ContextWithSubject(ctx, *rule.CreatedBy)
+	}
+
+	results := make([]domain.AutomationActionResult, 0, len(compiled.actions))
+	if err := s.authorizeCreator(ctx, rule, compiled); err != nil {
+		for _, action := range compiled.actions {
+			results = append(results, domain.AutomationActionResult{Type: action.Type, Error: truncate("skipped: "+err.Error(), 1000)})
+		}
+	} else {
+		for _, action := range compiled.actions {
+			result := domain.AutomationActionResult{Type: action.Type}
+			detail, err := s.runAction(ctx, rule, execution, action, event, env)
+			result.Detail = detail
+			if err != nil {
+				result.Error = truncate(err.Error(), 1000)
+			}
+			results = append(results, result)
+		}
+	}
+
+	execution.Finish(results, time.Now())
+	if err := s.automationRepo.UpdateExecution(context.Background(), execution); err != nil {
+		logger.Warn(fmt.Sprintf("Failed to record automation execution %s: %v", execution.ID, err))
+	}
+	if execution.Status != domain.AutomationExecutionSucceeded {
+		logger.Warn(fmt.Sprintf("Automation rule %s (%s) execution %s %s: %s", rule.ID, rule.Name, execution.ID, execution.Status, execution.Error))
+	}
+}
+
+// authorizeCreator: 작업/웹훅 액션을 실행하기 전에 규칙 생성자가 아직 워크스페이스 멤버이고 규칙 저장 시 요구한 권한을 가졌는지 확인합니다
+// 멤버에서 제외되었거나 권한을 잃었으면 규칙을 비활성화하고, 권한 조회 자체가 실패하면 이번 실행만 건너뜁니다
+func (s *Service) authorizeCreator(ctx context.Context, rule *domain.AutomationRule, compiled *compiledRule) error {
+	permissions := creatorPermissions(compiled.actions)
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	var denied error
+	if rule.CreatedBy == nil || s.workspaceAuthorizer == nil {
+		denied = fmt.Errorf("rule has no creator to run operation or webhook actions as")
+	} else {
+		workspaceID, err := uuid.Parse(rule.WorkspaceID)
+		if err != nil {
+			return err
+		}
+		for _, permission := range permissions {
+			err := s.workspaceAuthorizer.CheckWorkspacePermission(ctx, workspaceID, *rule.CreatedBy, permission)
+			if err == nil {
+				continue
+			}
+			if domain.GetDomainError(err).Code != domain.ErrCodeForbidden {
+				return fmt.Errorf("failed to check rule creator permissions: %w", err)
+			}
+			denied = fmt.Errorf("rule creator %s is no longer allowed to run these actions: %s", *rule.CreatedBy, domain.GetDomainError(err).Message)
+			break
+		}
+	}
+	if denied == nil {
+		return nil
+	}
+
+	reason := truncate("disabled: "+denied.Error(), 1000)
+	if err := s.automationRepo.DisableRule(context.Background(), rule.ID, reason, time.Now()); err != nil {
+		logger.Warn(fmt.Sprintf("Failed to disable automation rule %s: %v", rule.ID, err))
+	}
+	s.compiled.Delete(rule.ID)
+	logger.Warn(fmt.Sprintf("Automation rule %s (%s) disabled: %v", rule.ID, rule.Name, denied))
+	return denied
+}
+
+// creatorPermissions: 액션 실행에 필요한 규칙 생성자의 워크스페이스 권한을 반환합니다 (알림만 있으면 없음)
+func creatorPermissions(actions []*compiledAction) []domain.Permission {
+	var permissions []domain.Permission
+	needsAutomation, needsCompute := false, false
+	for _, action := range actions {
+		switch action.Type {
+		case domain.AutomationActionWebhook:
+			needsAutomation = true
+		case domain.AutomationActionOperation:
+			needsAutomation, needsCompute = true, true
+		}
+	}
+	if needsAutomation {
+		permissions = append(permissions, domain.WorkspaceAutomation)
+	}
+	if needsCompute {
+		permissions = append(permissions, domain.ComputeWrite)
+	}
+	return permissions
+}
+
+// runAction: 액션 하나를 실행하고 결과 설명을 반환합니다
+func (s *Service) runAction(ctx context.Context, rule *domain.AutomationRule, execution *domain.AutomationExecution, action *compiledAction, event domain.AutomationEvent, env map[string]interface{}) (string, error) {
+	switch action.Type {
+	case domain.AutomationActionNotify:
+		return s.notify(ctx, rule, execution, action, env)
+	case domain.AutomationActionWebhook:
+		return s.triggerWebhook(ctx, rule, execution, action, event)
+	case domain.AutomationActionOperation:
+		return s.runOperation(ctx, rule, execution, action, env)
+	}
+	return "", fmt.Errorf("unsupported action type: %s", action.Type)
+}
+
+// notify: 알림을 워크스페이스 멤버와 채팅 채널로 전송합니다
+func (s *Service) notify(ctx context.Context, rule *domain.AutomationRule, execution *domain.AutomationExecution, action *compiledAction, env map[string]interface{}) (string, error) {
+	if s.notificationService == nil {
+		return "", fmt.Errorf("notifications are not available")
+	}
+	title, message, err := action.render(env)
+	if err != nil {
+		return "", err
+	}
+
+	// 지정한 사용자는 현재 워크스페이스 멤버인 경우에만 받습니다
+	members, err := common.WorkspaceMemberIDs(ctx, s.workspaceRepo, rule.WorkspaceID)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve recipients: %w", err)
+	}
+	var recipients []string
+	switch action.Recipients {
+	case domain.AutomationRecipientsMembers:
+		recipients = members
+	case domain.AutomationRecipientsUsers:
+	default:
+		if recipients, err = common.WorkspaceAdminIDs(ctx, s.workspaceRepo, rule.WorkspaceID); err != nil {
+			return "", fmt.Errorf("failed to resolve recipients: %w", err)
+		}
+	}
+	recipients = appendMembers(recipients, action.UserIDs, members)
+	if len(recipients) == 0 {
+		return "", fmt.Errorf("no recipients")
+	}
+
+	priority := action.Priority
+	if priority == "" {
+		priority = domain.NotificationPriorityMedium
+	}
+	category := action.Category
+	if category == "" {
+		category = "system"
+	}
+	notificationType := "info"
+	if priority == domain.NotificationPriorityHigh || priority == domain.NotificationPriorityUrgent {
+		notificationType = "warning"
+	}
+	data, _ := json.Marshal(map[string]interface{}{
+		"automation_rule_id": rule.ID,
+		"execution_id":       execution.ID,
+		"event_type":         execution.EventType,
+	})
+
+	notification := &domain.Notification{
+		ID:          uuid.New().String(),
+		WorkspaceID: rule.WorkspaceID,
+		Type:        notificationType,
+		Title:       truncate(title, 255),
+		Message:     message,
+		Category:    category,
+		Priority:    priority,
+		Data:        string(data),
+		CreatedAt:   time.Now(),
+	}
+	if err := s.notificationService.SendBulkNotification(ctx, recipients, notification); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("notified %d users: %s", len(recipients), notification.Title), nil
+}
+
+// triggerWebhook: 워크스페이스 웹훅으로 이벤트를 전송하도록 등록합니다
+func (s *Service) triggerWebhook(ctx context.Context, rule *domain.AutomationRule, execution *domain.AutomationExecution, action *compiledAction, event domain.AutomationEvent) (string, error) {
+	if s.webhookService == nil {
+		return "", fmt.Errorf("webhooks are not available")
+	}
+	workspaceID, err := uuid.Parse(rule.WorkspaceID)
+	if err != nil {
+		return "", err
+	}
+	webhookID, err := uuid.Parse(action.WebhookID)
+	if err != nil {
+		return "", fmt.Errorf("invalid webhook id: %s", action.WebhookID)
+	}
+
+	data := make(map[string]interface{}, len(event.Data)+1)
+	for key, value := range event.Data {
+		data[key] = value
+	}
+	data["automation"] = map[string]interface{}{
+		"rule_id":      rule.ID.String(),
+		"rule_name":    rule.Name,
+		"execution_id": execution.ID.String(),
+	}
+
+	delivery, err := s.webhookService.Trigger(ctx, workspaceID, webhookID, domain.WebhookEvent{
+		Type:        event.Type,
+		WorkspaceID: rule.WorkspaceID,
+		Data:        data,
+		Timestamp:   event.Timestamp,
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("queued webhook delivery %s", delivery.ID), nil
+}
+
+// runOperation: 대상 리소스에 SkyClust 작업을 실행합니다 (대상은 규칙의 워크스페이스에 속해야 함)
+func (s *Service) runOperation(ctx context.Context, rule *domain.AutomationRule, execution *domain.AutomationExecution, action *compiledAction, env map[string]interface{}) (string, error) {
+	target, err := action.resolveTarget(env)
+	if err != nil {
+		return "", err
+	}
+	if s.vmService == nil {
+		return "", fmt.Errorf("VM operations are not available")
+	}
+
+	vm, err := s.vmService.GetVM(ctx, target)
+	if err != nil {
+		return "", err
+	}
+	if vm.WorkspaceID != rule.WorkspaceID {
+		return "", domain.ErrVMNotFound
+	}
+
+	switch action.Operation {
+	case domain.AutomationOperationVMStart:
+		err = s.vmService.StartVM(ctx, vm.ID)
+	case domain.AutomationOperationVMStop:
+		err = s.vmService.StopVM(ctx, vm.ID)
+	case domain.AutomationOperationVMRestart:
+		err = s.vmService.RestartVM(ctx, vm.ID)
+	default:
+		return "", fmt.Errorf("unsupported operation: %s", action.Operation)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if rule.CreatedBy != nil {
+		common.LogAction(ctx, s.auditLogRepo, rule.CreatedBy, domain.ActionAutomationOperation,
+			fmt.Sprintf("AUTOMATION %s", rule.ID),
+			map[string]interface{}{
+				"workspace_id": rule.WorkspaceID,
+				"rule_id":      rule.ID.String(),
+				"rule_name":    rule.Name,
+				"execution_id": execution.ID.String(),
+				"operation":    action.Operation,
+				"vm_id":        vm.ID,
+				"vm_name":      vm.Name,
+				"event_type":   execution.EventType,
+			},
+		)
+	}
+	return fmt.Sprintf("%s %s (%s)", action.Operation, vm.ID, vm.Name), nil
+}
+
+// appendMembers: 목록에 없는 사용자 중 워크스페이스 멤버만 추가합니다
+func appendMembers(ids, extra, members []string) []string {
+	isMember := make(map[string]bool, len(members))
+	for _, id := range members {
+		isMember[id] = true
+	}
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		seen[id] = true
+	}
+	for _, id := range extra {
+		if isMember[id] && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,1218 @@
+package automation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// 조건식 언어 (CEL 부분 집합)
+//
+//	논리:     a && b, a || b, !a, cond ? a : b
+//	비교:     == != < <= > >=, x in list, "key" in map, "sub" in string
+//	산술:     + - * / % (문자열과 리스트는 +로 연결)
+//	접근:     data.cluster.status, data["security-groups"], data.rules[0]
+//	함수:     has(data.field), size(x), string(x), int(x), double(x)
+//	메서드:   s.startsWith(p), s.endsWith(p), s.contains(p), s.matches("re"), s.lower(), s.upper(), x.size()
+//	매크로:   list.exists(r, pred), list.all(r, pred), list.exists_one(r, pred), list.filter(r, pred), list.map(r, expr)
+//
+// 없는 필드와 null에 대한 필드 접근은 null이 되며, null과 숫자의 크기 비교처럼 타입이 맞지 않는 연산은 오류입니다
+// matches()의 정규식은 문자열 리터럴만 허용하며 조건식을 컴파일할 때 함께 컴파일합니다
+
+// maxExpressionDepth: 중첩 깊이 제한 (재귀 하강 파서의 스택 보호)
+const maxExpressionDepth = 64
+
+// expressionVariables: 조건식에서 사용할 수 있는 최상위 변수
+var expressionVariables = []string{"event", "data"}
+
+// Expression: 컴파일된 조건식
+type Expression struct {
+	source string
+	root   exprNode
+}
+
+// CompileExpression: 조건식을 컴파일합니다
+func CompileExpression(source string) (*Expression, error) {
+	tokens, err := tokenizeExpression(source)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &exprParser{tokens: tokens, scope: map[string]int{}}
+	for _, name := range expressionVariables {
+		parser.scope[name]++
+	}
+	root, err := parser.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if token := parser.peek(); token.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %s at position %d", token, token.pos)
+	}
+	return &Expression{source: source, root: root}, nil
+}
+
+// Eval: 환경 변수로 조건식을 평가합니다
+func (e *Expression) Eval(env map[string]interface{}) (interface{}, error) {
+	return e.root.eval(&evalScope{vars: env})
+}
+
+// EvalBool: 조건식을 평가하고 결과가 불리언인지 확인합니다
+func (e *Expression) EvalBool(env map[string]interface{}) (bool, error) {
+	value, err := e.Eval(env)
+	if err != nil {
+		return false, err
+	}
+	result, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("condition must evaluate to a boolean, got %s", typeName(value))
+	}
+	return result, nil
+}
+
+// String: 원본 조건식을 반환합니다
+func (e *Expression) String() string {
+	return e.source
+}
+
+// ----------------------------------------------------------------------------
+// 토크나이저
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenString
+	tokenIdent
+	tokenOperator
+)
+
+// exprToken: 조건식 토큰
+type exprToken struct {
+	kind  tokenKind
+	text  string
+	value interface{} // 숫자, 문자열 리터럴 값
+	pos   int
+}
+
+// String: 오류 메시지용 토큰 표현
+func (t exprToken) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of expression"
+	case tokenString:
+		return strconv.Quote(t.value.(string))
+	default:
+		return fmt.Sprintf("%q", t.text)
+	}
+}
+
+// exprOperators: 긴 연산자부터 확인합니다
+var exprOperators = []string{
+	"&&", "||", "==", "!=", "<=", ">=",
+	"<", ">", "!", "+", "-", "*", "/", "%", "(", ")", "[", "]", ",", ".", "?", ":",
+}
+
+// tokenizeExpression: 조건식을 토큰으로 분리합니다
+func tokenizeExpression(source string) ([]exprToken, error) {
+	var tokens []exprToken
+	runes := []rune(source)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '"' || r == '\'':
+			value, next, err := readStringLiteral(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, exprToken{kind: tokenString, text: string(runes[i:next]), value: value, pos: i})
+			i = next
+		case unicode.IsDigit(r):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.' || runes[i] == 'e' || runes[i] == 'E' ||
+				((runes[i] == '+' || runes[i] == '-') && (runes[i-1] == 'e' || runes[i-1] == 'E'))) {
+				i++
+			}
+			text := string(runes[start:i])
+			number, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q at position %d", text, start)
+			}
+			tokens = append(tokens, exprToken{kind: tokenNumber, text: text, value: number, pos: start})
+		case r == '_' || unicode.IsLetter(r):
+			start := i
+			for i < len(runes) && (runes[i] == '_' || unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i])) {
+				i++
+			}
+			tokens = append(tokens, exprToken{kind: tokenIdent, text: string(runes[start:i]), pos: start})
+		default:
+			matched := ""
+			for _, op := range exprOperators {
+				if strings.HasPrefix(string(runes[i:min(i+2, len(runes))]), op) {
+					matched = op
+					break
+				}
+			}
+			if matched == "" {
+				return nil, fmt.Errorf("unexpected character %q at position %d", r, i)
+			}
+			tokens = append(tokens, exprToken{kind: tokenOperator, text: matched, pos: i})
+			i += len(matched)
+		}
+	}
+	return append(tokens, exprToken{kind: tokenEOF, pos: len(runes)}), nil
+}
+
+// readStringLiteral: 따옴표로 감싼 문자열 리터럴을 읽습니다
+func readStringLiteral(runes []rune, start int) (string, int, error) {
+	quote := runes[start]
+	var builder strings.Builder
+	for i := start + 1; i < len(runes); i++ {
+		switch r := runes[i]; r {
+		case quote:
+			return builder.String(), i + 1, nil
+		case '\\':
+			i++
+			if i >= len(runes) {
+				return "", 0, fmt.Errorf("unterminated string starting at position %d", start)
+			}
+			switch runes[i] {
+			case 'n':
+				builder.WriteRune('\n')
+			case 't':
+				builder.WriteRune('\t')
+			case 'r':
+				builder.WriteRune('\r')
+			case '\\', '"', '\'':
+				builder.WriteRune(runes[i])
+			default:
+				return "", 0, fmt.Errorf("invalid escape sequence \\%c at position %d", runes[i], i-1)
+			}
+		default:
+			builder.WriteRune(r)
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string starting at position %d", start)
+}
+
+// ----------------------------------------------------------------------------
+// 파서
+
+// exprParser: 조건식 재귀 하강 파서
+type exprParser struct {
+	tokens []exprToken
+	pos    int
+	depth  int
+	scope  map[string]int // 사용 가능한 변수 (매크로 변수 포함)
+}
+
+func (p *exprParser) peek() exprToken {
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) next() exprToken {
+	token := p.tokens[p.pos]
+	if token.kind != tokenEOF {
+		p.pos++
+	}
+	return token
+}
+
+// accept: 다음 토큰이 연산자 op이면 소비합니다
+func (p *exprParser) accept(op string) bool {
+	if token := p.peek(); token.kind == tokenOperator && token.text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// expect: 다음 토큰이 연산자 op인지 확인하고 소비합니다
+func (p *exprParser) expect(op string) error {
+	if !p.accept(op) {
+		token := p.peek()
+		return fmt.Errorf("expected %q but found %s at position %d", op, token, token.pos)
+	}
+	return nil
+}
+
+// parseExpression: cond ? a : b
+func (p *exprParser) parseExpression() (exprNode, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxExpressionDepth {
+		return nil, fmt.Errorf("expression is nested too deeply")
+	}
+
+	condition, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.accept("?") {
+		return condition, nil
+	}
+	then, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	otherwise, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	return &conditionalNode{condition: condition, then: then, otherwise: otherwise}, nil
+}
+
+// parseOr: a || b
+func (p *exprParser) parseOr() (exprNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalNode{and: false, left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseAnd: a && b
+func (p *exprParser) parseAnd() (exprNode, error) {
+	left, err := p.parseRelation()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("&&") {
+		right, err := p.parseRelation()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalNode{and: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseRelation: == != < <= > >= in
+func (p *exprParser) parseRelation() (exprNode, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		token := p.peek()
+		isRelation := token.kind == tokenOperator && (token.text == "==" || token.text == "!=" ||
+			token.text == "<" || token.text == "<=" || token.text == ">" || token.text == ">=")
+		if !isRelation && !(token.kind == tokenIdent && token.text == "in") {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: token.text, left: left, right: right}
+	}
+}
+
+// parseAdditive: + -
+func (p *exprParser) parseAdditive() (exprNode, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		token := p.peek()
+		if token.kind != tokenOperator || (token.text != "+" && token.text != "-") {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: token.text, left: left, right: right}
+	}
+}
+
+// parseMultiplicative: * / %
+func (p *exprParser) parseMultiplicative() (exprNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		token := p.peek()
+		if token.kind != tokenOperator || (token.text != "*" && token.text != "/" && token.text != "%") {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: token.text, left: left, right: right}
+	}
+}
+
+// parseUnary: !x, -x
+func (p *exprParser) parseUnary() (exprNode, error) {
+	token := p.peek()
+	if token.kind == tokenOperator && (token.text == "!" || token.text == "-") {
+		p.next()
+		p.depth++
+		defer func() { p.depth-- }()
+		if p.depth > maxExpressionDepth {
+			return nil, fmt.Errorf("expression is nested too deeply")
+		}
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{op: token.text, operand: operand}, nil
+	}
+	return p.parseMember()
+}
+
+// parseMember: x.field, x[index], x.method(args), x.macro(var, expr)
+func (p *exprParser) parseMember() (exprNode, error) {
+	node, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.accept("."):
+			name := p.next()
+			if name.kind != tokenIdent {
+				return nil, fmt.Errorf("expected field name but found %s at position %d", name, name.pos)
+			}
+			if !p.accept("(") {
+				node = &selectNode{operand: node, field: name.text}
+				continue
+			}
+			if isMacro(name.text) {
+				if node, err = p.parseMacro(node, name); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			args, err := p.parseArguments()
+			if err != nil {
+				return nil, err
+			}
+			if node, err = newMethodNode(node, name, args); err != nil {
+				return nil, err
+			}
+		case p.accept("["):
+			index, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			node = &indexNode{operand: node, index: index}
+		default:
+			return node, nil
+		}
+	}
+}
+
+// parsePrimary: 리터럴, 변수, 함수 호출, 괄호, 리스트
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	token := p.next()
+	switch token.kind {
+	case tokenNumber, tokenString:
+		return &literalNode{value: token.value}, nil
+	case tokenIdent:
+		switch token.text {
+		case "true":
+			return &literalNode{value: true}, nil
+		case "false":
+			return &literalNode{value: false}, nil
+		case "null":
+			return &literalNode{value: nil}, nil
+		case "in":
+			return nil, fmt.Errorf("unexpected \"in\" at position %d", token.pos)
+		}
+		if p.accept("(") {
+			args, err := p.parseArguments()
+			if err != nil {
+				return nil, err
+			}
+			return newFunctionNode(token, args)
+		}
+		if p.scope[token.text] == 0 {
+			return nil, fmt.Errorf("undeclared reference %q at position %d (available: %s)", token.text, token.pos, strings.Join(expressionVariables, ", "))
+		}
+		return &identNode{name: token.text}, nil
+	case tokenOperator:
+		switch token.text {
+		case "(":
+			node, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return node, nil
+		case "[":
+			var elements []exprNode
+			if p.accept("]") {
+				return &listNode{}, nil
+			}
+			for {
+				element, err := p.parseExpression()
+				if err != nil {
+					return nil, err
+				}
+				elements = append(elements, element)
+				if p.accept("]") {
+					return &listNode{elements: elements}, nil
+				}
+				if err := p.expect(","); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return nil, fmt.Errorf("unexpected %s at position %d", token, token.pos)
+}
+
+// parseArguments: 여는 괄호 다음부터 닫는 괄호까지의 인자 목록
+func (p *exprParser) parseArguments() ([]exprNode, error) {
+	var args []exprNode
+	if p.accept(")") {
+		return args, nil
+	}
+	for {
+		arg, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if p.accept(")") {
+			return args, nil
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parseMacro: list.exists(x, pred) 형태의 매크로 (x는 본문에서만 사용 가능)
+func (p *exprParser) parseMacro(target exprNode, name exprToken) (exprNode, error) {
+	variable := p.next()
+	if variable.kind != tokenIdent {
+		return nil, fmt.Errorf("%s() expects a variable name as its first argument at position %d", name.text, variable.pos)
+	}
+	if err := p.expect(","); err != nil {
+		return nil, err
+	}
+
+	p.scope[variable.text]++
+	body, err := p.parseExpression()
+	p.scope[variable.text]--
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	return &macroNode{kind: name.text, target: target, variable: variable.text, body: body}, nil
+}
+
+// isMacro: 리스트 매크로 이름인지 확인합니다
+func isMacro(name string) bool {
+	switch name {
+	case "exists", "all", "exists_one", "filter", "map":
+		return true
+	}
+	return false
+}
+
+// newFunctionNode: 전역 함수 호출 노드를 생성합니다
+func newFunctionNode(name exprToken, args []exprNode) (exprNode, error) {
+	switch name.text {
+	case "has":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("has() takes exactly one argument")
+		}
+		selection, ok := args[0].(*selectNode)
+		if !ok {
+			return nil, fmt.Errorf("has() argument must be a field selection such as has(data.field)")
+		}
+		return &hasNode{selection: selection}, nil
+	case "size", "string", "int", "double":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%s() takes exactly one argument", name.text)
+		}
+		return &callNode{function: name.text, args: args}, nil
+	}
+	return nil, fmt.Errorf("unknown function %q at position %d", name.text, name.pos)
+}
+
+// newMethodNode: 메서드 호출 노드를 생성합니다
+func newMethodNode(target exprNode, name exprToken, args []exprNode) (exprNode, error) {
+	switch name.text {
+	case "startsWith", "endsWith", "contains":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%s() takes exactly one argument", name.text)
+		}
+	case "matches":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%s() takes exactly one argument", name.text)
+		}
+		var source string
+		literal, ok := args[0].(*literalNode)
+		if ok {
+			source, ok = literal.value.(string)
+		}
+		if !ok {
+			return nil, fmt.Errorf("matches() pattern must be a string literal at position %d", name.pos)
+		}
+		pattern, err := regexp.Compile(source)
+		if err != nil {
+			return nil, fmt.Errorf("matches(): invalid regular expression %q: %v", source, err)
+		}
+		return &callNode{function: name.text, target: target, args: args, pattern: pattern}, nil
+	case "lower", "upper", "size":
+		if len(args) != 0 {
+			return nil, fmt.Errorf("%s() takes no arguments", name.text)
+		}
+	default:
+		return nil, fmt.Errorf("unknown method %q at position %d", name.text, name.pos)
+	}
+	return &callNode{function: name.text, target: target, args: args}, nil
+}
+
+// ----------------------------------------------------------------------------
+// 평가
+
+// evalScope: 평가 중 변수 바인딩 (매크로 변수는 부모 스코프를 가립니다)
+type evalScope struct {
+	vars   map[string]interface{}
+	name   string
+	value  interface{}
+	parent *evalScope
+}
+
+// lookup: 변수 값을 조회합니다
+func (s *evalScope) lookup(name string) interface{} {
+	for scope := s; scope != nil; scope = scope.parent {
+		if scope.vars != nil {
+			return scope.vars[name]
+		}
+		if scope.name == name {
+			return scope.value
+		}
+	}
+	return nil
+}
+
+// exprNode: 조건식 구문 트리 노드
+type exprNode interface {
+	eval(scope *evalScope) (interface{}, error)
+}
+
+type literalNode struct {
+	value interface{}
+}
+
+func (n *literalNode) eval(*evalScope) (interface{}, error) {
+	return n.value, nil
+}
+
+type identNode struct {
+	name string
+}
+
+func (n *identNode) eval(scope *evalScope) (interface{}, error) {
+	return scope.lookup(n.name), nil
+}
+
+type listNode struct {
+	elements []exprNode
+}
+
+func (n *listNode) eval(scope *evalScope) (interface{}, error) {
+	values := make([]interface{}, 0, len(n.elements))
+	for _, element := range n.elements {
+		value, err := element.eval(scope)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+type selectNode struct {
+	operand exprNode
+	field   string
+}
+
+func (n *selectNode) eval(scope *evalScope) (interface{}, error) {
+	operand, err := n.operand.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+	switch value := operand.(type) {
+	case nil:
+		return nil, nil
+	case map[string]interface{}:
+		return value[n.field], nil
+	default:
+		return nil, fmt.Errorf("cannot select field %q from %s", n.field, typeName(operand))
+	}
+}
+
+type indexNode struct {
+	operand exprNode
+	index   exprNode
+}
+
+func (n *indexNode) eval(scope *evalScope) (interface{}, error) {
+	operand, err := n.operand.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+	index, err := n.index.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+	switch value := operand.(type) {
+	case nil:
+		return nil, nil
+	case map[string]interface{}:
+		key, ok := index.(string)
+		if !ok {
+			return nil, fmt.Errorf("map index must be a string, got %s", typeName(index))
+		}
+		return value[key], nil
+	case []interface{}:
+		position, ok := index.(float64)
+		if !ok || position != math.Trunc(position) {
+			return nil, fmt.Errorf("list index must be an integer, got %s", typeName(index))
+		}
+		if position < 0 || int(position) >= len(value) {
+			return nil, nil
+		}
+		return value[int(position)], nil
+	default:
+		return nil, fmt.Errorf("cannot index %s", typeName(operand))
+	}
+}
+
+type hasNode struct {
+	selection *selectNode
+}
+
+func (n *hasNode) eval(scope *evalScope) (interface{}, error) {
+	operand, err := n.selection.operand.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+	object, ok := operand.(map[string]interface{})
+	if !ok {
+		return false, nil
+	}
+	_, present := object[n.selection.field]
+	return present, nil
+}
+
+type unaryNode struct {
+	op      string
+	operand exprNode
+}
+
+func (n *unaryNode) eval(scope *evalScope) (interface{}, error) {
+	operand, err := n.operand.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+	if n.op == "!" {
+		value, ok := operand.(bool)
+		if !ok {
+			return nil, fmt.Errorf("operator ! requires a boolean, got %s", typeName(operand))
+		}
+		return !value, nil
+	}
+	value, ok := operand.(float64)
+	if !ok {
+		return nil, fmt.Errorf("operator - requires a number, got %s", typeName(operand))
+	}
+	return -value, nil
+}
+
+type logicalNode struct {
+	and         bool
+	left, right exprNode
+}
+
+func (n *logicalNode) eval(scope *evalScope) (interface{}, error) {
+	op := "||"
+	if n.and {
+		op = "&&"
+	}
+	left, err := n.left.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+	leftValue, ok := left.(bool)
+	if !ok {
+		return nil, fmt.Errorf("operator %s requires booleans, got %s", op, typeName(left))
+	}
+	if leftValue != n.and {
+		// false && x, true || x
+		return leftValue, nil
+	}
+	right, err := n.right.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+	rightValue, ok := right.(bool)
+	if !ok {
+		return nil, fmt.Errorf("operator %s requires booleans, got %s", op, typeName(right))
+	}
+	return rightValue, nil
+}
+
+type conditionalNode struct {
+	condition, then, otherwise exprNode
+}
+
+func (n *conditionalNode) eval(scope *evalScope) (interface{}, error) {
+	condition, err := n.condition.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := condition.(bool)
+	if !ok {
+		return nil, fmt.Errorf("operator ?: requires a boolean condition, got %s", typeName(condition))
+	}
+	if value {
+		return n.then.eval(scope)
+	}
+	return n.otherwise.eval(scope)
+}
+
+type binaryNode struct {
+	op          string
+	left, right exprNode
+}
+
+func (n *binaryNode) eval(scope *evalScope) (interface{}, error) {
+	left, err := n.left.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+	right, err := n.right.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "==":
+		return valuesEqual(left, right), nil
+	case "!=":
+		return !valuesEqual(left, right), nil
+	case "in":
+		return contains(right, left)
+	case "<", "<=", ">", ">=":
+		order, err := compareValues(left, right, n.op)
+		if err != nil {
+			return nil, err
+		}
+		switch n.op {
+		case "<":
+			return order < 0, nil
+		case "<=":
+			return order <= 0, nil
+		case ">":
+			return order > 0, nil
+		default:
+			return order >= 0, nil
+		}
+	case "+":
+		switch l := left.(type) {
+		case string:
+			if r, ok := right.(string); ok {
+				return l + r, nil
+			}
+		case []interface{}:
+			if r, ok := right.([]interface{}); ok {
+				return append(append([]interface{}{}, l...), r...), nil
+			}
+		}
+	}
+
+	l, lok := left.(float64)
+	r, rok := right.(float64)
+	if !lok || !rok {
+		return nil, fmt.Errorf("operator %s is not defined for %s and %s", n.op, typeName(left), typeName(right))
+	}
+	switch n.op {
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	case "/":
+		if r == 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		return l / r, nil
+	default:
+		if r == 0 {
+			return nil, fmt.Errorf("modulus by zero")
+		}
+		return math.Mod(l, r), nil
+	}
+}
+
+type macroNode struct {
+	kind     string
+	target   exprNode
+	variable string
+	body     exprNode
+}
+
+func (n *macroNode) eval(scope *evalScope) (interface{}, error) {
+	target, err := n.target.eval(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []interface{}
+	switch value := target.(type) {
+	case nil:
+	case []interface{}:
+		items = value
+	case map[string]interface{}:
+		// 맵은 정렬된 키에 대해 반복합니다
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			items = append(items, key)
+		}
+	default:
+		return nil, fmt.Errorf("%s() requires a list or map, got %s", n.kind, typeName(target))
+	}
+
+	matches := 0
+	var results []interface{}
+	for _, item := range items {
+		result, err := n.body.eval(&evalScope{name: n.variable, value: item, parent: scope})
+		if err != nil {
+			return nil, err
+		}
+		if n.kind == "map" {
+			results = append(results, result)
+			continue
+		}
+		matched, ok := result.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%s() predicate must evaluate to a boolean, got %s", n.kind, typeName(result))
+		}
+		switch {
+		case n.kind == "exists" && matched:
+			return true, nil
+		case n.kind == "all" && !matched:
+			return false, nil
+		case matched:
+			matches++
+			results = append(results, item)
+		}
+	}
+
+	switch n.kind {
+	case "exists":
+		return false, nil
+	case "all":
+		return true, nil
+	case "exists_one":
+		return matches == 1, nil
+	default:
+		if results == nil {
+			results = []interface{}{}
+		}
+		return results, nil
+	}
+}
+
+type callNode struct {
+	function string
+	target   exprNode // 메서드 호출의 대상 (전역 함수는 nil)
+	args     []exprNode
+	pattern  *regexp.Regexp // matches()의 컴파일된 정규식
+}
+
+func (n *callNode) eval(scope *evalScope) (interface{}, error) {
+	var values []interface{}
+	if n.target != nil {
+		target, err := n.target.eval(scope)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, target)
+	}
+	for _, arg := range n.args {
+		value, err := arg.eval(scope)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	switch n.function {
+	case "size":
+		switch value := values[0].(type) {
+		case string:
+			return float64(len([]rune(value))), nil
+		case []interface{}:
+			return float64(len(value)), nil
+		case map[string]interface{}:
+			return float64(len(value)), nil
+		case nil:
+			return float64(0), nil
+		}
+		return nil, fmt.Errorf("size() is not defined for %s", typeName(values[0]))
+	case "string":
+		return stringify(values[0]), nil
+	case "int", "double":
+		var number float64
+		switch value := values[0].(type) {
+		case float64:
+			number = value
+		case string:
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s(): cannot convert %q to a number", n.function, value)
+			}
+			number = parsed
+		case bool:
+			if value {
+				number = 1
+			}
+		default:
+			return nil, fmt.Errorf("%s() is not defined for %s", n.function, typeName(values[0]))
+		}
+		if n.function == "int" {
+			number = math.Trunc(number)
+		}
+		return number, nil
+	}
+
+	// 문자열 메서드
+	text, ok := values[0].(string)
+	if !ok {
+		if values[0] == nil {
+			// null.startsWith(...) 등은 false (lower/upper는 null)
+			if n.function == "lower" || n.function == "upper" {
+				return nil, nil
+			}
+			return false, nil
+		}
+		return nil, fmt.Errorf("%s() requires a string, got %s", n.function, typeName(values[0]))
+	}
+	switch n.function {
+	case "lower":
+		return strings.ToLower(text), nil
+	case "upper":
+		return strings.ToUpper(text), nil
+	}
+
+	arg, ok := values[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("%s() argument must be a string, got %s", n.function, typeName(values[1]))
+	}
+	switch n.function {
+	case "startsWith":
+		return strings.HasPrefix(text, arg), nil
+	case "endsWith":
+		return strings.HasSuffix(text, arg), nil
+	case "contains":
+		return strings.Contains(text, arg), nil
+	default:
+		return n.pattern.MatchString(text), nil
+	}
+}
+
+// valuesEqual: 두 값이 같은지 비교합니다 (타입이 다르면 false)
+func valuesEqual(left, right interface{}) bool {
+	return reflect.DeepEqual(left, right)
+}
+
+// contains: in 연산자 (리스트 원소, 맵 키, 부분 문자열)
+func contains(container, item interface{}) (interface{}, error) {
+	switch value := container.(type) {
+	case nil:
+		return false, nil
+	case []interface{}:
+		for _, element := range value {
+			if valuesEqual(element, item) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case map[string]interface{}:
+		key, ok := item.(string)
+		if !ok {
+			return false, nil
+		}
+		_, present := value[key]
+		return present, nil
+	case string:
+		text, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("operator in with a string requires a string, got %s", typeName(item))
+		}
+		return strings.Contains(value, text), nil
+	default:
+		return nil, fmt.Errorf("operator in is not defined for %s", typeName(container))
+	}
+}
+
+// compareValues: 숫자 또는 문자열의 크기를 비교합니다
+func compareValues(left, right interface{}, op string) (int, error) {
+	switch l := left.(type) {
+	case float64:
+		if r, ok := right.(float64); ok {
+			switch {
+			case l < r:
+				return -1, nil
+			case l > r:
+				return 1, nil
+			}
+			return 0, nil
+		}
+	case string:
+		if r, ok := right.(string); ok {
+			return strings.Compare(l, r), nil
+		}
+	}
+	return 0, fmt.Errorf("operator %s is not defined for %s and %s", op, typeName(left), typeName(right))
+}
+
+// typeName: 오류 메시지용 값 타입 이름
+func typeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "bool"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "list"
+	case map[string]interface{}:
+		return "map"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// stringify: 값을 문자열로 변환합니다 (정수는 소수점 없이, 리스트와 맵은 JSON)
+func stringify(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(encoded)
+	}
+}
+
+// normalizeValue: 이벤트 데이터를 JSON 값(숫자는 float64)으로 정규화합니다
+func normalizeValue(value interface{}) interface{} {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(encoded, &normalized); err != nil {
+		return nil
+	}
+	return normalized
+}
+
+// ----------------------------------------------------------------------------
+// 템플릿
+
+// Template: {{ 식 }} 자리에 식의 값을 넣는 문자열 템플릿
+type Template struct {
+	literals    []string // len(literals) == len(expressions) + 1
+	expressions []*Expression
+}
+
+// CompileTemplate: 템플릿의 모든 식을 컴파일합니다
+func CompileTemplate(source string) (*Template, error) {
+	template := &Template{}
+	rest := source
+	for {
+		start := strings.Index(rest, "{{")
+		if start < 0 {
+			template.literals = append(template.literals, rest)
+			return template, nil
+		}
+		end := strings.Index(rest[start:], "}}")
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated {{ in template")
+		}
+		expression, err := CompileExpression(strings.TrimSpace(rest[start+2 : start+end]))
+		if err != nil {
+			return nil, fmt.Errorf("template expression %q: %w", strings.TrimSpace(rest[start+2:start+end]), err)
+		}
+		template.literals = append(template.literals, rest[:start])
+		template.expressions = append(template.expressions, expression)
+		rest = rest[start+end+2:]
+	}
+}
+
+// Render: 환경 변수로 템플릿을 채웁니다
+func (t *Template) Render(env map[string]interface{}) (string, error) {
+	var builder strings.Builder
+	for i, literal := range t.literals {
+		builder.WriteString(literal)
+		if i == len(t.expressions) {
+			break
+		}
+		value, err := t.expressions[i].Eval(env)
+		if err != nil {
+			return "", err
+		}
+		builder.WriteString(stringify(value))
+	}
+	return builder.String(), nil
+}
@@ -0,0 +1,297 @@
+package automation
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testEnv: 조건식 테스트용 이벤트 환경
+func testEnv() map[string]interface{} {
+	data := normalizeValue(map[string]interface{}{
+		"name":    "prod-cluster",
+		"status":  "FAILED",
+		"count":   3,
+		"ratio":   0.5,
+		"enabled": true,
+		"tags":    map[string]interface{}{"env": "prod", "team": "platform"},
+		"rules": []interface{}{
+			map[string]interface{}{"cidr": "0.0.0.0/0", "port": 22},
+			map[string]interface{}{"cidr": "10.0.0.0/8", "port": 443},
+		},
+		"ports":           []interface{}{22, 80, 443},
+		"security-groups": []interface{}{"sg-1"},
+		"nothing":         nil,
+	})
+	return map[string]interface{}{
+		"event": map[string]interface{}{"type": "kubernetes.cluster.failed", "workspace_id": "ws-1"},
+		"data":  data,
+	}
+}
+
+func TestExpressionEval(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want interface{}
+	}{
+		// 우선순위
+		{"multiplication before addition", "1 + 2 * 3", float64(7)},
+		{"parentheses", "(1 + 2) * 3", float64(9)},
+		{"left associative subtraction", "10 - 4 - 3", float64(3)},
+		{"left associative division", "16 / 4 / 2", float64(2)},
+		{"unary minus binds tighter than multiplication", "-2 * 3", float64(-6)},
+		{"and before or", "true || false && false", true},
+		{"relation before and", "1 < 2 && 3 > 4", false},
+		{"arithmetic before relation", "1 + 1 == 2", true},
+		{"not before and", "!false && false", false},
+		{"conditional is lowest", "true ? 1 : 2 + 3", float64(1)},
+		{"nested conditional is right associative", "false ? 1 : true ? 2 : 3", float64(2)},
+		{"in after arithmetic", "20 + 2 in data.ports", true},
+
+		// 필드 접근과 연산자
+		{"nested select", "data.tags.env == 'prod'", true},
+		{"index by key", `data["security-groups"][0]`, "sg-1"},
+		{"list index", "data.rules[1].port", float64(443)},
+		{"out of range index is null", "data.rules[5]", nil},
+		{"string concatenation", `data.name + "-" + data.status.lower()`, "prod-cluster-failed"},
+		{"list concatenation", "size(data.ports + [8080])", float64(4)},
+		{"modulus", "7 % 3", float64(1)},
+		{"string comparison", `"abc" < "abd"`, true},
+		{"in list", "443 in data.ports", true},
+		{"in map keys", `"env" in data.tags`, true},
+		{"in string", `"prod" in data.name`, true},
+		{"equality across types is false", `data.count == "3"`, false},
+		{"list equality", "[1, 2] == [1, 2]", true},
+		{"has present field", "has(data.tags.env)", true},
+		{"has missing field", "has(data.tags.owner)", false},
+		{"has on missing parent", "has(data.missing.field)", false},
+
+		// 함수와 메서드
+		{"size of string counts runes", `size("한글")`, float64(2)},
+		{"size method", "data.tags.size()", float64(2)},
+		{"int truncates", "int(data.ratio * 5)", float64(2)},
+		{"int parses strings", `int(" 42 ")`, float64(42)},
+		{"double of bool", "double(true)", float64(1)},
+		{"string of integer", "string(data.count)", "3"},
+		{"string of list", "string(data.ports)", "[22,80,443]"},
+		{"startsWith", `data.name.startsWith("prod")`, true},
+		{"endsWith", `data.name.endsWith("cluster")`, true},
+		{"contains", `data.name.contains("d-c")`, true},
+		{"matches", `data.name.matches("^prod-[a-z]+$")`, true},
+		{"upper", "data.tags.team.upper()", "PLATFORM"},
+
+		// null 전파
+		{"missing field is null", "data.missing", nil},
+		{"select through null is null", "data.missing.deeper.field", nil},
+		{"null field value", "data.nothing == null", true},
+		{"missing equals null", "data.missing == null", true},
+		{"index into null is null", "data.missing[0]", nil},
+		{"in null is false", `"a" in data.missing`, false},
+		{"size of null is zero", "size(data.missing)", float64(0)},
+		{"string of null is empty", "string(data.missing)", ""},
+		{"string method on null is false", `data.missing.startsWith("a")`, false},
+		{"lower of null is null", "data.missing.lower()", nil},
+		{"matches on null is false", `data.missing.matches("a")`, false},
+		{"macro over null is empty", "data.missing.exists(x, true)", false},
+
+		// 단락 평가
+		{"and short-circuits errors", "false && data.count / 0 > 1", false},
+		{"or short-circuits errors", "true || data.missing > 1", true},
+		{"conditional skips other branch", "true ? 1 : 1 / 0", float64(1)},
+
+		// 매크로
+		{"exists", `data.rules.exists(r, r.cidr == "0.0.0.0/0" && r.port == 22)`, true},
+		{"exists without match", "data.rules.exists(r, r.port == 8080)", false},
+		{"all", "data.ports.all(p, p > 0)", true},
+		{"all with a miss", "data.ports.all(p, p > 22)", false},
+		{"exists_one", "data.ports.exists_one(p, p == 80)", true},
+		{"exists_one with two matches", "data.ports.exists_one(p, p > 22)", false},
+		{"filter", "data.ports.filter(p, p > 22)", []interface{}{float64(80), float64(443)}},
+		{"filter with no match is empty", "data.ports.filter(p, p > 1000)", []interface{}{}},
+		{"map", "data.rules.map(r, r.port)", []interface{}{float64(22), float64(443)}},
+		{"macro over map iterates sorted keys", "data.tags.map(k, k)", []interface{}{"env", "team"}},
+		{"nested macros", "data.rules.exists(r, data.ports.exists(p, p == r.port))", true},
+		{"macro variable shadows data", "[1].map(data, data + 1)", []interface{}{float64(2)}},
+		{"empty list exists", "[].exists(x, true)", false},
+		{"empty list all", "[].all(x, false)", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expression, err := CompileExpression(tt.expr)
+			if err != nil {
+				t.Fatalf("CompileExpression(%q) error = %v", tt.expr, err)
+			}
+			got, err := expression.Eval(testEnv())
+			if err != nil {
+				t.Fatalf("Eval(%q) error = %v", tt.expr, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Eval(%q) = %#v, want %#v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpressionEvalErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		message string
+	}{
+		{"division by zero", "data.count / 0", "division by zero"},
+		{"modulus by zero", "data.count % (data.count - 3)", "modulus by zero"},
+		{"null in arithmetic", "data.missing + 1", "operator + is not defined for null and number"},
+		{"null in comparison", "data.missing > 1", "operator > is not defined for null and number"},
+		{"mixed comparison", `data.count < "4"`, "operator < is not defined for number and string"},
+		{"and requires booleans", "data.count && true", "operator && requires booleans, got number"},
+		{"not requires a boolean", "!data.name", "operator ! requires a boolean, got string"},
+		{"negation requires a number", "-data.name", "operator - requires a number, got string"},
+		{"conditional requires a boolean", "data.count ? 1 : 2", "requires a boolean condition"},
+		{"select on a string", "data.name.field", `cannot select field "field" from string`},
+		{"fractional list index", "data.ports[0.5]", "list index must be an integer"},
+		{"map index must be a string", "data.tags[1]", "map index must be a string"},
+		{"macro predicate must be boolean", "data.ports.exists(p, p)", "predicate must evaluate to a boolean"},
+		{"macro requires a list", "data.count.all(x, true)", "all() requires a list or map, got number"},
+		{"int of invalid string", `int("abc")`, `cannot convert "abc" to a number`},
+		{"string method on a number", `data.count.startsWith("3")`, "startsWith() requires a string, got number"},
+		{"in with a string and a number", "3 in data.name", "operator in with a string requires a string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expression, err := CompileExpression(tt.expr)
+			if err != nil {
+				t.Fatalf("CompileExpression(%q) error = %v", tt.expr, err)
+			}
+			_, err = expression.Eval(testEnv())
+			if err == nil || !strings.Contains(err.Error(), tt.message) {
+				t.Fatalf("Eval(%q) error = %v, want error containing %q", tt.expr, err, tt.message)
+			}
+		})
+	}
+}
+
+func TestCompileExpressionErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		message string
+	}{
+		{"empty", "", "unexpected end of expression"},
+		{"undeclared variable", "user.name", `undeclared reference "user"`},
+		{"macro variable out of scope", "data.ports.exists(p, true) && p > 1", `undeclared reference "p"`},
+		{"unknown function", "now()", `unknown function "now"`},
+		{"unknown method", "data.name.trim()", `unknown method "trim"`},
+		{"has needs a selection", "has(data)", "has() argument must be a field selection"},
+		{"size arity", "size(1, 2)", "size() takes exactly one argument"},
+		{"method arity", "data.name.lower(1)", "lower() takes no arguments"},
+		{"macro needs a variable", "data.ports.exists(1, true)", "expects a variable name"},
+		{"unterminated string", `"abc`, "unterminated string"},
+		{"invalid escape", `"\q"`, "invalid escape sequence"},
+		{"invalid number", "1.2.3", `invalid number "1.2.3"`},
+		{"unexpected character", "data.count @ 1", "unexpected character '@'"},
+		{"trailing tokens", "1 2", `unexpected "2"`},
+		{"missing colon", "true ? 1", `expected ":"`},
+		{"unclosed parenthesis", "(1 + 2", `expected ")"`},
+		{"unclosed list", "[1, 2", `expected ","`},
+		{"matches requires a literal", "data.name.matches(data.status)", "matches() pattern must be a string literal"},
+		{"matches rejects an invalid pattern", `data.name.matches("(")`, "invalid regular expression"},
+		{"nested parentheses", strings.Repeat("(", maxExpressionDepth+1) + "1" + strings.Repeat(")", maxExpressionDepth+1), "nested too deeply"},
+		{"nested negation", strings.Repeat("!", maxExpressionDepth+1) + "true", "nested too deeply"},
+		{"nested lists", strings.Repeat("[", maxExpressionDepth+1) + strings.Repeat("]", maxExpressionDepth+1), "nested too deeply"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := CompileExpression(tt.expr)
+			if err == nil || !strings.Contains(err.Error(), tt.message) {
+				t.Fatalf("CompileExpression(%q) error = %v, want error containing %q", tt.expr, err, tt.message)
+			}
+		})
+	}
+}
+
+func TestCompileExpressionDepthLimit(t *testing.T) {
+	// 최상위 식이 깊이 1을 차지하므로 괄호는 maxExpressionDepth-1단계까지 허용
+	source := strings.Repeat("(", maxExpressionDepth-1) + "1" + strings.Repeat(")", maxExpressionDepth-1)
+	expression, err := CompileExpression(source)
+	if err != nil {
+		t.Fatalf("CompileExpression() at the depth limit error = %v", err)
+	}
+	if got, err := expression.Eval(testEnv()); err != nil || got != float64(1) {
+		t.Fatalf("Eval() = %v, %v, want 1", got, err)
+	}
+}
+
+func TestExpressionEvalBool(t *testing.T) {
+	expression, err := CompileExpression(`data.status == "FAILED"`)
+	if err != nil {
+		t.Fatalf("CompileExpression() error = %v", err)
+	}
+	if matched, err := expression.EvalBool(testEnv()); err != nil || !matched {
+		t.Fatalf("EvalBool() = %v, %v, want true", matched, err)
+	}
+
+	expression, err = CompileExpression("data.count")
+	if err != nil {
+		t.Fatalf("CompileExpression() error = %v", err)
+	}
+	if _, err := expression.EvalBool(testEnv()); err == nil || !strings.Contains(err.Error(), "must evaluate to a boolean, got number") {
+		t.Fatalf("EvalBool() error = %v, want non-boolean error", err)
+	}
+}
+
+func TestTemplateRender(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{"no expressions", "plain text", "plain text"},
+		{"single expression", "Cluster {{ data.name }} failed", "Cluster prod-cluster failed"},
+		{"multiple expressions", "{{data.tags.env}}/{{ data.tags.team }}", "prod/platform"},
+		{"integer without decimals", "count={{ data.count }}", "count=3"},
+		{"fraction", "ratio={{ data.ratio }}", "ratio=0.5"},
+		{"null renders empty", "owner=[{{ data.missing }}]", "owner=[]"},
+		{"list renders as json", "ports={{ data.ports.filter(p, p > 22) }}", "ports=[80,443]"},
+		{"map renders as json", "{{ data.tags }}", `{"env":"prod","team":"platform"}`},
+		{"conditional", `{{ data.count > 2 ? "many" : "few" }}`, "many"},
+		{"adjacent expressions", "{{ data.count }}{{ data.count }}", "33"},
+		{"expression at both ends", "{{ event.type }} in {{ event.workspace_id }}", "kubernetes.cluster.failed in ws-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template, err := CompileTemplate(tt.template)
+			if err != nil {
+				t.Fatalf("CompileTemplate(%q) error = %v", tt.template, err)
+			}
+			got, err := template.Render(testEnv())
+			if err != nil {
+				t.Fatalf("Render(%q) error = %v", tt.template, err)
+			}
+			if got != tt.want {
+				t.Fatalf("Render(%q) = %q, want %q", tt.template, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemplateErrors(t *testing.T) {
+	if _, err := CompileTemplate("Cluster {{ data.name"); err == nil || !strings.Contains(err.Error(), "unterminated {{") {
+		t.Fatalf("CompileTemplate() error = %v, want unterminated error", err)
+	}
+	if _, err := CompileTemplate("{{ user.name }}"); err == nil || !strings.Contains(err.Error(), `template expression "user.name"`) {
+		t.Fatalf("CompileTemplate() error = %v, want expression error", err)
+	}
+
+	template, err := CompileTemplate("{{ data.count / 0 }}")
+	if err != nil {
+		t.Fatalf("CompileTemplate() error = %v", err)
+	}
+	if _, err := template.Render(testEnv()); err == nil || !strings.Contains(err.Error(), "division by zero") {
+		t.Fatalf("Render() error = %v, want division by zero", err)
+	}
+}
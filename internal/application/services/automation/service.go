@@ -0,0 +1,502 @@
+package automation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"skyclust/internal/application/services/common"
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// ignoredEventPrefixes: 자동화 규칙을 평가하지 않는 이벤트 (알림 액션이 자기 자신을 다시 실행하지 않도록)
+var ignoredEventPrefixes = []string{"notification."}
+
+// Config: 자동화 서비스 설정
+type Config struct {
+	ActionTimeout time.Duration // 실행 하나의 모든 액션에 허용되는 시간
+}
+
+// Service: domain.AutomationService 인터페이스 구현체
+// 워크스페이스 이벤트마다 일치하는 규칙의 조건식을 평가하고, 실행 한도 안에서 액션을 비동기로 실행합니다
+type Service struct {
+	automationRepo      domain.AutomationRepository
+	workspaceRepo       domain.WorkspaceRepository
+	auditLogRepo        domain.AuditLogRepository
+	notificationService domain.NotificationService
+	webhookService      domain.WebhookService
+	vmService           domain.VMService
+	workspaceAuthorizer domain.WorkspaceAuthorizer
+	workspaceResolver   *common.EventWorkspaceResolver
+	config              Config
+
+	// compiled: 규칙 ID → 컴파일된 조건식과 액션 (규칙 수정 시각이 바뀌면 다시 컴파일)
+	compiled sync.Map
+}
+
+// NewService: 새로운 자동화 서비스를 생성합니다
+func NewService(
+	automationRepo domain.AutomationRepository,
+	workspaceRepo domain.WorkspaceRepository,
+	credentialRepo domain.CredentialRepository,
+	auditLogRepo domain.AuditLogRepository,
+	notificationService domain.NotificationService,
+	webhookService domain.WebhookService,
+	vmService domain.VMService,
+	workspaceAuthorizer domain.WorkspaceAuthorizer,
+	config Config,
+) *Service {
+	if config.ActionTimeout == 0 {
+		config.ActionTimeout = 2 * time.Minute
+	}
+	return &Service{
+		automationRepo:      automationRepo,
+		workspaceRepo:       workspaceRepo,
+		auditLogRepo:        auditLogRepo,
+		notificationService: notificationService,
+		webhookService:      webhookService,
+		vmService:           vmService,
+		workspaceAuthorizer: workspaceAuthorizer,
+		workspaceResolver:   common.NewEventWorkspaceResolver(credentialRepo),
+		config:              config,
+	}
+}
+
+// ListRules: 워크스페이스의 자동화 규칙 목록을 조회합니다
+func (s *Service) ListRules(ctx context.Context, workspaceID uuid.UUID) ([]*domain.AutomationRule, error) {
+	workspace, err := s.getWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := s.automationRepo.ListRules(ctx, workspace.ID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to list automation rules: %v", err), 500)
+	}
+	return rules, nil
+}
+
+// GetRule: 워크스페이스의 자동화 규칙을 조회합니다
+func (s *Service) GetRule(ctx context.Context, workspaceID, ruleID uuid.UUID) (*domain.AutomationRule, error) {
+	rule, err := s.automationRepo.GetRule(ctx, ruleID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get automation rule: %v", err), 500)
+	}
+	if rule == nil || rule.WorkspaceID != workspaceID.String() {
+		return nil, domain.NewDomainError(domain.ErrCodeNotFound, "automation rule not found", 404)
+	}
+	return rule, nil
+}
+
+// CreateRule: 자동화 규칙을 생성합니다 (액션은 생성자의 권한과 워크스페이스 정책으로 실행)
+func (s *Service) CreateRule(ctx context.Context, actorID, workspaceID uuid.UUID, req domain.CreateAutomationRuleRequest) (*domain.AutomationRule, error) {
+	workspace, err := s.getWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &domain.AutomationRule{
+		ID:                uuid.New(),
+		WorkspaceID:       workspace.ID,
+		Name:              strings.TrimSpace(req.Name),
+		Description:       strings.TrimSpace(req.Description),
+		Enabled:           true,
+		EventTypes:        domain.StringList(normalizeEventTypes(req.EventTypes)),
+		Condition:         strings.TrimSpace(req.Condition),
+		Actions:           domain.AutomationActions(req.Actions),
+		RateLimit:         domain.DefaultAutomationRateLimit,
+		RateWindowSeconds: domain.DefaultAutomationRateWindow,
+		CreatedBy:         &actorID,
+	}
+	if req.RateLimit != nil {
+		rule.RateLimit = *req.RateLimit
+	}
+	if req.RateWindowSeconds != nil {
+		rule.RateWindowSeconds = *req.RateWindowSeconds
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	if err := s.validateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.automationRepo.CreateRule(ctx, rule); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to create automation rule: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionAutomationRuleCreate,
+		fmt.Sprintf("POST /api/v1/workspaces/%s/automation-rules", workspace.ID),
+		ruleAuditDetails(rule),
+	)
+
+	return rule, nil
+}
+
+// UpdateRule: 자동화 규칙을 수정합니다
+func (s *Service) UpdateRule(ctx context.Context, actorID, workspaceID, ruleID uuid.UUID, req domain.UpdateAutomationRuleRequest) (*domain.AutomationRule, error) {
+	rule, err := s.GetRule(ctx, workspaceID, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		rule.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		rule.Description = strings.TrimSpace(*req.Description)
+	}
+	if req.EventTypes != nil {
+		rule.EventTypes = domain.StringList(normalizeEventTypes(req.EventTypes))
+	}
+	if req.Condition != nil {
+		rule.Condition = strings.TrimSpace(*req.Condition)
+		// 이전 조건식의 평가 오류는 더 이상 의미가 없음
+		rule.LastError = ""
+		rule.LastErrorAt = nil
+	}
+	if req.Actions != nil {
+		rule.Actions = domain.AutomationActions(req.Actions)
+	}
+	if req.RateLimit != nil {
+		rule.RateLimit = *req.RateLimit
+	}
+	if req.RateWindowSeconds != nil {
+		rule.RateWindowSeconds = *req.RateWindowSeconds
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	if err := s.validateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.automationRepo.UpdateRule(ctx, rule); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to update automation rule: %v", err), 500)
+	}
+	s.compiled.Delete(rule.ID)
+
+	common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionAutomationRuleUpdate,
+		fmt.Sprintf("PUT /api/v1/workspaces/%s/automation-rules/%s", rule.WorkspaceID, rule.ID),
+		ruleAuditDetails(rule),
+	)
+
+	return rule, nil
+}
+
+// DeleteRule: 자동화 규칙과 실행 기록을 삭제합니다
+func (s *Service) DeleteRule(ctx context.Context, actorID, workspaceID, ruleID uuid.UUID) error {
+	rule, err := s.GetRule(ctx, workspaceID, ruleID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.automationRepo.DeleteRule(ctx, rule.ID); err != nil {
+		return domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to delete automation rule: %v", err), 500)
+	}
+	s.compiled.Delete(rule.ID)
+
+	common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionAutomationRuleDelete,
+		fmt.Sprintf("DELETE /api/v1/workspaces/%s/automation-rules/%s", rule.WorkspaceID, rule.ID),
+		ruleAuditDetails(rule),
+	)
+
+	return nil
+}
+
+// TestRule: 예시 이벤트로 규칙을 평가하고 실행될 액션을 보여줍니다 (액션은 실행하지 않음)
+func (s *Service) TestRule(ctx context.Context, workspaceID, ruleID uuid.UUID, req domain.TestAutomationRuleRequest) (*domain.AutomationTestResult, error) {
+	rule, err := s.GetRule(ctx, workspaceID, ruleID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.EventType) == "" {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "event_type is required", 400)
+	}
+
+	compiled, err := compileRule(rule)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, err.Error(), 400)
+	}
+
+	event := domain.AutomationEvent{
+		Type:        req.EventType,
+		WorkspaceID: rule.WorkspaceID,
+		Data:        req.Data,
+		Timestamp:   time.Now().Unix(),
+	}
+	env := eventEnvironment(event, rule.WorkspaceID)
+
+	result := &domain.AutomationTestResult{Matched: rule.Matches(event.Type)}
+	matched, err := compiled.evaluate(env)
+	if err != nil {
+		result.Error = err.Error()
+		return result, nil
+	}
+	result.Condition = matched
+	if !matched {
+		return result, nil
+	}
+	for _, action := range compiled.actions {
+		result.Actions = append(result.Actions, action.preview(env))
+	}
+	return result, nil
+}
+
+// ListExecutions: 규칙의 실행 기록을 조회합니다
+func (s *Service) ListExecutions(ctx context.Context, workspaceID, ruleID uuid.UUID, filter domain.AutomationExecutionFilter) ([]*domain.AutomationExecution, int64, error) {
+	rule, err := s.GetRule(ctx, workspaceID, ruleID)
+	if err != nil {
+		return nil, 0, err
+	}
+	switch filter.Status {
+	case "", domain.AutomationExecutionRunning, domain.AutomationExecutionSucceeded, domain.AutomationExecutionPartial,
+		domain.AutomationExecutionFailed, domain.AutomationExecutionRateLimited:
+	default:
+		return nil, 0, domain.NewDomainError(domain.ErrCodeValidationFailed, "status must be running, succeeded, partial, failed or rate_limited", 400)
+	}
+
+	executions, total, err := s.automationRepo.ListExecutions(ctx, rule.ID, filter)
+	if err != nil {
+		return nil, 0, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to list automation executions: %v", err), 500)
+	}
+	return executions, total, nil
+}
+
+// GetExecution: 규칙의 실행 기록을 조회합니다
+func (s *Service) GetExecution(ctx context.Context, workspaceID, ruleID, executionID uuid.UUID) (*domain.AutomationExecution, error) {
+	rule, err := s.GetRule(ctx, workspaceID, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	execution, err := s.automationRepo.GetExecution(ctx, executionID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get automation execution: %v", err), 500)
+	}
+	if execution == nil || execution.RuleID != rule.ID {
+		return nil, domain.NewDomainError(domain.ErrCodeNotFound, "automation execution not found", 404)
+	}
+	return execution, nil
+}
+
+// HandleEvent: 이벤트의 워크스페이스에서 일치하는 활성 규칙을 평가하고 조건을 만족한 규칙의 액션을 시작합니다
+// 조건식 평가 오류는 규칙의 last_error에 기록하며, 시작한 실행 수를 반환합니다
+func (s *Service) HandleEvent(ctx context.Context, event domain.AutomationEvent) (int, error) {
+	for _, prefix := range ignoredEventPrefixes {
+		if strings.HasPrefix(event.Type, prefix) {
+			return 0, nil
+		}
+	}
+
+	workspaceID := s.workspaceResolver.Resolve(ctx, event.Type, event.WorkspaceID, event.Data)
+	if workspaceID == "" {
+		return 0, nil
+	}
+
+	rules, err := s.automationRepo.ListEnabledRules(ctx, workspaceID)
+	if err != nil {
+		return 0, err
+	}
+
+	var env map[string]interface{}
+	started := 0
+	for _, rule := range rules {
+		if !rule.Matches(event.Type) {
+			continue
+		}
+		if env == nil {
+			env = eventEnvironment(event, workspaceID)
+		}
+
+		compiled, err := s.compiledRule(rule)
+		if err == nil {
+			var matched bool
+			matched, err = compiled.evaluate(env)
+			if err == nil && !matched {
+				continue
+			}
+		}
+		if err != nil {
+			s.recordRuleError(ctx, rule, err)
+			continue
+		}
+		if rule.LastError != "" {
+			s.recordRuleError(ctx, rule, nil)
+		}
+
+		execution := &domain.AutomationExecution{
+			ID:          uuid.New(),
+			RuleID:      rule.ID,
+			WorkspaceID: workspaceID,
+			EventType:   event.Type,
+			EventData:   domain.JSONBMap(event.Data),
+		}
+		reserved, err := s.automationRepo.ReserveExecution(ctx, execution, rule.RateLimit, rule.RateWindow())
+		if err != nil {
+			return started, err
+		}
+		if !reserved {
+			logger.Debug(fmt.Sprintf("Automation rule %s (%s) skipped: %s", rule.ID, rule.Name, execution.Error))
+			continue
+		}
+
+		started++
+		go s.execute(rule, compiled, execution, event, env)
+	}
+	return started, nil
+}
+
+// PurgeExecutions: 보존 기간이 지난 실행 기록을 삭제합니다
+func (s *Service) PurgeExecutions(ctx context.Context, retention time.Duration) (int64, error) {
+	return s.automationRepo.DeleteExecutionsBefore(ctx, time.Now().Add(-retention))
+}
+
+// validateRule: 규칙 정의, 조건식과 템플릿 문법, 액션 대상이 워크스페이스에 속하는지 검증합니다
+func (s *Service) validateRule(ctx context.Context, rule *domain.AutomationRule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+	if _, err := compileRule(rule); err != nil {
+		return domain.NewDomainError(domain.ErrCodeValidationFailed, err.Error(), 400)
+	}
+
+	var members map[string]bool
+	for i, action := range rule.Actions {
+		switch action.Type {
+		case domain.AutomationActionWebhook:
+			webhookID, _ := uuid.Parse(action.WebhookID)
+			if s.webhookService == nil {
+				return domain.NewDomainError(domain.ErrCodeValidationFailed, fmt.Sprintf("actions[%d]: webhooks are not available", i), 400)
+			}
+			workspaceID, _ := uuid.Parse(rule.WorkspaceID)
+			if _, err := s.webhookService.GetWebhook(ctx, workspaceID, webhookID); err != nil {
+				return domain.NewDomainError(domain.ErrCodeValidationFailed, fmt.Sprintf("actions[%d]: webhook %s not found in this workspace", i, action.WebhookID), 400)
+			}
+		case domain.AutomationActionNotify:
+			if len(action.UserIDs) == 0 {
+				continue
+			}
+			if members == nil {
+				memberIDs, err := common.WorkspaceMemberIDs(ctx, s.workspaceRepo, rule.WorkspaceID)
+				if err != nil {
+					return domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get workspace members: %v", err), 500)
+				}
+				members = make(map[string]bool, len(memberIDs))
+				for _, memberID := range memberIDs {
+					members[memberID] = true
+				}
+			}
+			for _, userID := range action.UserIDs {
+				if !members[userID] {
+					return domain.NewDomainError(domain.ErrCodeValidationFailed, fmt.Sprintf("actions[%d]: user %s is not a member of this workspace", i, userID), 400)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// compiledRule: 캐시된 컴파일 결과를 반환합니다 (규칙이 수정되었으면 다시 컴파일)
+func (s *Service) compiledRule(rule *domain.AutomationRule) (*compiledRule, error) {
+	if cached, ok := s.compiled.Load(rule.ID); ok {
+		if compiled := cached.(*compiledRule); compiled.updatedAt.Equal(rule.UpdatedAt) {
+			return compiled, nil
+		}
+	}
+	compiled, err := compileRule(rule)
+	if err != nil {
+		return nil, err
+	}
+	s.compiled.Store(rule.ID, compiled)
+	return compiled, nil
+}
+
+// recordRuleError: 조건식 평가 오류를 규칙에 기록합니다 (err가 nil이면 지웁니다)
+func (s *Service) recordRuleError(ctx context.Context, rule *domain.AutomationRule, err error) {
+	message := ""
+	if err != nil {
+		message = truncate(err.Error(), 1000)
+		if message == rule.LastError {
+			return
+		}
+		logger.Warn(fmt.Sprintf("Automation rule %s (%s) failed to evaluate: %v", rule.ID, rule.Name, err))
+	}
+	if recordErr := s.automationRepo.RecordRuleError(ctx, rule.ID, message, time.Now()); recordErr != nil {
+		logger.Warn(fmt.Sprintf("Failed to record automation rule error for %s: %v", rule.ID, recordErr))
+	}
+}
+
+// getWorkspace: 워크스페이스를 조회합니다
+func (s *Service) getWorkspace(ctx context.Context, workspaceID uuid.UUID) (*domain.Workspace, error) {
+	workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID.String())
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get workspace: %v", err), 500)
+	}
+	if workspace == nil {
+		return nil, domain.ErrWorkspaceNotFound
+	}
+	return workspace, nil
+}
+
+// eventEnvironment: 조건식과 템플릿의 평가 환경을 만듭니다 (event, data)
+func eventEnvironment(event domain.AutomationEvent, workspaceID string) map[string]interface{} {
+	data, _ := normalizeValue(event.Data).(map[string]interface{})
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+	return map[string]interface{}{
+		"event": map[string]interface{}{
+			"type":         event.Type,
+			"workspace_id": workspaceID,
+			"user_id":      event.UserID,
+			"timestamp":    float64(event.Timestamp),
+			"data":         data,
+		},
+		"data": data,
+	}
+}
+
+// normalizeEventTypes: 이벤트 패턴의 공백과 중복을 제거합니다
+func normalizeEventTypes(eventTypes []string) []string {
+	seen := make(map[string]bool, len(eventTypes))
+	normalized := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		eventType = strings.TrimSpace(eventType)
+		if seen[eventType] {
+			continue
+		}
+		seen[eventType] = true
+		normalized = append(normalized, eventType)
+	}
+	return normalized
+}
+
+// truncate: 문자열을 최대 길이로 자릅니다
+func truncate(value string, limit int) string {
+	if len(value) <= limit {
+		return value
+	}
+	return value[:limit]
+}
+
+// ruleAuditDetails: 감사 로그에 기록할 규칙 정보
+func ruleAuditDetails(rule *domain.AutomationRule) map[string]interface{} {
+	actionTypes := make([]string, 0, len(rule.Actions))
+	for _, action := range rule.Actions {
+		actionTypes = append(actionTypes, action.Type)
+	}
+	return map[string]interface{}{
+		"workspace_id": rule.WorkspaceID,
+		"rule_id":      rule.ID.String(),
+		"name":         rule.Name,
+		"event_types":  []string(rule.EventTypes),
+		"condition":    rule.Condition,
+		"actions":      actionTypes,
+		"enabled":      rule.Enabled,
+	}
+}
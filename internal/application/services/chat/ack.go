@@ -0,0 +1,245 @@
+package chat
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// slackSignatureTolerance: Slack 요청 타임스탬프 허용 오차 (재전송 공격 방지)
+const slackSignatureTolerance = 5 * time.Minute
+
+// ackClaims: 확인 토큰에 서명된 값
+type ackClaims struct {
+	GroupID   string
+	ChannelID uuid.UUID
+	ExpiresAt time.Time
+}
+
+// signAckToken: 알림 그룹과 채널을 묶은 서명된 확인 토큰을 생성합니다
+// 형식: base64url(groupID|channelID|expiry) + "." + base64url(HMAC-SHA256)
+func (s *Service) signAckToken(groupID string, channelID uuid.UUID, expiresAt time.Time) string {
+	payload := strings.Join([]string{groupID, channelID.String(), strconv.FormatInt(expiresAt.Unix(), 10)}, "|")
+	encoded := base64.RawURLEncoding.EncodeToString([]byte(payload))
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.ackMAC(encoded))
+}
+
+// verifyAckToken: 확인 토큰의 서명과 만료 시간을 검증합니다
+func (s *Service) verifyAckToken(token string) (*ackClaims, error) {
+	invalid := domain.NewDomainError(domain.ErrCodeInvalidToken, "invalid acknowledge token", 400)
+	if len(s.ackKey) == 0 {
+		return nil, domain.NewDomainError(domain.ErrCodeServiceUnavailable, "chat acknowledgements are not configured", 503)
+	}
+
+	encoded, signature, ok := strings.Cut(strings.TrimSpace(token), ".")
+	if !ok || encoded == "" || signature == "" {
+		return nil, invalid
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, s.ackMAC(encoded)) {
+		return nil, invalid
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, invalid
+	}
+
+	parts := strings.Split(string(payload), "|")
+	if len(parts) != 3 || parts[0] == "" {
+		return nil, invalid
+	}
+	channelID, err := uuid.Parse(parts[1])
+	if err != nil {
+		return nil, invalid
+	}
+	expiry, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return nil, invalid
+	}
+	expiresAt := time.Unix(expiry, 0)
+	if time.Now().After(expiresAt) {
+		return nil, domain.NewDomainError(domain.ErrCodeTokenExpired, "acknowledge link has expired", 400)
+	}
+
+	return &ackClaims{GroupID: parts[0], ChannelID: channelID, ExpiresAt: expiresAt}, nil
+}
+
+// ackMAC: 인코딩된 토큰 본문의 HMAC-SHA256 값을 계산합니다
+func (s *Service) ackMAC(encoded string) []byte {
+	mac := hmac.New(sha256.New, s.ackKey)
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
+
+// ackURL: 공개 확인 페이지 링크를 생성합니다
+func (s *Service) ackURL(token string) string {
+	return s.publicURL + "/api/v1/integrations/chat/ack?token=" + url.QueryEscape(token)
+}
+
+// AcknowledgeByToken: 채팅 메시지의 확인 링크로 알림을 확인 처리합니다
+// 이미 확인된 알림은 기존 확인 정보를 그대로 반환합니다
+func (s *Service) AcknowledgeByToken(ctx context.Context, token, acknowledgedBy string) (*domain.Notification, error) {
+	claims, err := s.verifyAckToken(token)
+	if err != nil {
+		return nil, err
+	}
+	return s.acknowledge(ctx, claims, acknowledgedBy)
+}
+
+// acknowledge: 알림 그룹 전체(모든 수신자 사본)를 확인 처리합니다
+func (s *Service) acknowledge(ctx context.Context, claims *ackClaims, acknowledgedBy string) (*domain.Notification, error) {
+	if acknowledgedBy == "" {
+		acknowledgedBy = "chat"
+	}
+
+	updated, err := s.notificationRepo.Acknowledge(ctx, claims.GroupID, truncate(acknowledgedBy, 255), time.Now())
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to acknowledge notification: %v", err), 500)
+	}
+
+	notification, err := s.notificationRepo.GetByGroupID(ctx, claims.GroupID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get notification: %v", err), 500)
+	}
+	if notification == nil {
+		return nil, domain.NewDomainError(domain.ErrCodeNotFound, "notification not found", 404)
+	}
+
+	if updated > 0 {
+		logger.Info(fmt.Sprintf("Notification group %s acknowledged by %s via chat channel %s", claims.GroupID, notification.AcknowledgedBy, claims.ChannelID))
+	}
+	return notification, nil
+}
+
+// slackInteractionPayload: Slack block_actions 콜백 페이로드 중 사용하는 필드
+type slackInteractionPayload struct {
+	Type string `json:"type"`
+	User struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+		Name     string `json:"name"`
+	} `json:"user"`
+	Actions []struct {
+		ActionID string `json:"action_id"`
+		Value    string `json:"value"`
+	} `json:"actions"`
+	ResponseURL string `json:"response_url"`
+}
+
+// HandleSlackInteraction: Slack 확인 버튼 콜백을 검증하고 알림을 확인 처리합니다
+// 버튼 값의 토큰으로 채널을 찾은 뒤 해당 채널의 서명 비밀키로 요청 서명을 검증합니다
+func (s *Service) HandleSlackInteraction(ctx context.Context, interaction domain.SlackInteraction) error {
+	form, err := url.ParseQuery(string(interaction.Body))
+	if err != nil {
+		return domain.NewDomainError(domain.ErrCodeBadRequest, "invalid interaction body", 400)
+	}
+	var payload slackInteractionPayload
+	if err := json.Unmarshal([]byte(form.Get("payload")), &payload); err != nil {
+		return domain.NewDomainError(domain.ErrCodeBadRequest, "invalid interaction payload", 400)
+	}
+
+	var token string
+	for _, action := range payload.Actions {
+		if action.ActionID == domain.SlackAckActionID && action.Value != "" {
+			token = action.Value
+			break
+		}
+	}
+	if token == "" {
+		// 확인 버튼 이외의 상호작용은 무시
+		return nil
+	}
+
+	claims, err := s.verifyAckToken(token)
+	if err != nil {
+		return err
+	}
+	channel, err := s.chatRepo.GetByID(ctx, claims.ChannelID)
+	if err != nil {
+		return domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get chat channel: %v", err), 500)
+	}
+	if channel == nil || channel.Type != domain.ChatChannelSlack || channel.SigningSecret == "" {
+		return domain.NewDomainError(domain.ErrCodeUnauthorized, "slack interactivity is not configured for this channel", 401)
+	}
+	if err := verifySlackSignature(channel.SigningSecret, interaction); err != nil {
+		return err
+	}
+
+	by := payload.User.Username
+	if by == "" {
+		by = payload.User.Name
+	}
+	if by == "" {
+		by = payload.User.ID
+	}
+	notification, err := s.acknowledge(ctx, claims, "slack:"+by)
+	if err != nil {
+		return err
+	}
+
+	if payload.ResponseURL != "" {
+		workspaceName := ""
+		if workspace, err := s.workspaceRepo.GetByID(ctx, channel.WorkspaceID); err == nil && workspace != nil {
+			workspaceName = workspace.Name
+		}
+		message := &domain.ChatMessage{
+			NotificationID: claims.GroupID,
+			WorkspaceName:  workspaceName,
+			Type:           notification.Type,
+			Title:          notification.Title,
+			Message:        notification.Message,
+			Category:       notification.Category,
+			Priority:       notification.Priority,
+			CreatedAt:      notification.CreatedAt,
+			AcknowledgedBy: notification.AcknowledgedBy,
+		}
+		if err := s.sender.RespondSlack(ctx, payload.ResponseURL, message); err != nil {
+			logger.Warn(fmt.Sprintf("Failed to update Slack message for notification %s: %v", claims.GroupID, err))
+		}
+	}
+	return nil
+}
+
+// verifySlackSignature: Slack 요청 서명(v0=HMAC-SHA256("v0:ts:body"))을 검증합니다
+func verifySlackSignature(signingSecret string, interaction domain.SlackInteraction) error {
+	unauthorized := domain.NewDomainError(domain.ErrCodeUnauthorized, "invalid slack signature", 401)
+
+	ts, err := strconv.ParseInt(interaction.Timestamp, 10, 64)
+	if err != nil {
+		return unauthorized
+	}
+	age := time.Since(time.Unix(ts, 0))
+	if age > slackSignatureTolerance || age < -slackSignatureTolerance {
+		return unauthorized
+	}
+
+	signature, ok := strings.CutPrefix(interaction.Signature, "v0=")
+	if !ok {
+		return unauthorized
+	}
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return unauthorized
+	}
+
+	mac := hmac.New(sha256.New, []byte(signingSecret))
+	mac.Write([]byte("v0:" + interaction.Timestamp + ":"))
+	mac.Write(interaction.Body)
+	if !hmac.Equal(expected, mac.Sum(nil)) {
+		return unauthorized
+	}
+	return nil
+}
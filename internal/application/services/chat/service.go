@@ -0,0 +1,405 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"skyclust/internal/application/services/common"
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// Sender: 채팅 채널 전송 구현 (Slack 인터랙션 응답 포함)
+type Sender interface {
+	domain.ChatSender
+	// RespondSlack: Slack 인터랙션의 response_url로 원본 메시지를 교체합니다
+	RespondSlack(ctx context.Context, responseURL string, message *domain.ChatMessage) error
+}
+
+// Config: 채팅 채널 서비스 설정
+type Config struct {
+	PublicURL string        // 확인 링크의 기준 URL (비어 있으면 링크 생략)
+	AckKey    []byte        // 확인 토큰 서명 키
+	AckTTL    time.Duration // 확인 링크 유효 기간
+}
+
+// defaultAckTTL: 확인 링크 기본 유효 기간
+const defaultAckTTL = 7 * 24 * time.Hour
+
+// Service: domain.ChatChannelService 인터페이스 구현체
+// 워크스페이스 알림을 Slack, Microsoft Teams, Discord 채널로 전달하고 확인 콜백을 처리합니다
+type Service struct {
+	chatRepo         domain.ChatChannelRepository
+	notificationRepo domain.NotificationRepository
+	preferencesRepo  domain.NotificationPreferencesRepository
+	workspaceRepo    domain.WorkspaceRepository
+	auditLogRepo     domain.AuditLogRepository
+	sender           Sender
+	publicURL        string
+	ackKey           []byte
+	ackTTL           time.Duration
+}
+
+// NewService: 새로운 채팅 채널 서비스를 생성합니다
+func NewService(
+	chatRepo domain.ChatChannelRepository,
+	notificationRepo domain.NotificationRepository,
+	preferencesRepo domain.NotificationPreferencesRepository,
+	workspaceRepo domain.WorkspaceRepository,
+	auditLogRepo domain.AuditLogRepository,
+	sender Sender,
+	config Config,
+) *Service {
+	if config.AckTTL == 0 {
+		config.AckTTL = defaultAckTTL
+	}
+	return &Service{
+		chatRepo:         chatRepo,
+		notificationRepo: notificationRepo,
+		preferencesRepo:  preferencesRepo,
+		workspaceRepo:    workspaceRepo,
+		auditLogRepo:     auditLogRepo,
+		sender:           sender,
+		publicURL:        strings.TrimRight(config.PublicURL, "/"),
+		ackKey:           config.AckKey,
+		ackTTL:           config.AckTTL,
+	}
+}
+
+// ListChannels: 워크스페이스의 채팅 채널 목록을 조회합니다
+func (s *Service) ListChannels(ctx context.Context, workspaceID uuid.UUID) ([]*domain.ChatChannel, error) {
+	if _, err := s.getWorkspace(ctx, workspaceID); err != nil {
+		return nil, err
+	}
+	channels, err := s.chatRepo.ListByWorkspace(ctx, workspaceID.String())
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to list chat channels: %v", err), 500)
+	}
+	for _, channel := range channels {
+		s.setInteractive(channel)
+	}
+	return channels, nil
+}
+
+// GetChannel: 워크스페이스의 채팅 채널을 조회합니다
+func (s *Service) GetChannel(ctx context.Context, workspaceID, channelID uuid.UUID) (*domain.ChatChannel, error) {
+	channel, err := s.chatRepo.GetByID(ctx, channelID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get chat channel: %v", err), 500)
+	}
+	if channel == nil || channel.WorkspaceID != workspaceID.String() {
+		return nil, domain.NewDomainError(domain.ErrCodeNotFound, "chat channel not found", 404)
+	}
+	s.setInteractive(channel)
+	return channel, nil
+}
+
+// CreateChannel: 채팅 채널을 생성합니다
+func (s *Service) CreateChannel(ctx context.Context, actorID, workspaceID uuid.UUID, req domain.CreateChatChannelRequest) (*domain.ChatChannel, error) {
+	workspace, err := s.getWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.chatRepo.ListByWorkspace(ctx, workspace.ID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to list chat channels: %v", err), 500)
+	}
+	if len(existing) >= domain.MaxChatChannelsPerWorkspace {
+		return nil, domain.NewDomainError(domain.ErrCodeResourceExhausted, fmt.Sprintf("a workspace can have at most %d chat channels", domain.MaxChatChannelsPerWorkspace), 400)
+	}
+
+	channel := &domain.ChatChannel{
+		WorkspaceID:   workspace.ID,
+		Name:          strings.TrimSpace(req.Name),
+		Type:          req.Type,
+		WebhookURL:    strings.TrimSpace(req.WebhookURL),
+		BotToken:      strings.TrimSpace(req.BotToken),
+		SigningSecret: strings.TrimSpace(req.SigningSecret),
+		SlackChannel:  strings.TrimSpace(req.SlackChannel),
+		Categories:    domain.StringList(normalizeCategories(req.Categories)),
+		MinPriority:   req.MinPriority,
+		Enabled:       true,
+		CreatedBy:     &actorID,
+	}
+	if req.Enabled != nil {
+		channel.Enabled = *req.Enabled
+	}
+	if err := channel.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.chatRepo.Create(ctx, channel); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to create chat channel: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionChatChannelCreate,
+		fmt.Sprintf("POST /api/v1/workspaces/%s/chat-channels", workspace.ID),
+		channelAuditDetails(channel),
+	)
+
+	s.setInteractive(channel)
+	return channel, nil
+}
+
+// UpdateChannel: 채팅 채널을 수정합니다 (비밀 값은 요청에 포함된 경우에만 교체)
+func (s *Service) UpdateChannel(ctx context.Context, actorID, workspaceID, channelID uuid.UUID, req domain.UpdateChatChannelRequest) (*domain.ChatChannel, error) {
+	channel, err := s.GetChannel(ctx, workspaceID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		channel.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.WebhookURL != nil {
+		channel.WebhookURL = strings.TrimSpace(*req.WebhookURL)
+	}
+	if req.BotToken != nil {
+		channel.BotToken = strings.TrimSpace(*req.BotToken)
+	}
+	if req.SigningSecret != nil {
+		channel.SigningSecret = strings.TrimSpace(*req.SigningSecret)
+	}
+	if req.SlackChannel != nil {
+		channel.SlackChannel = strings.TrimSpace(*req.SlackChannel)
+	}
+	if req.Categories != nil {
+		channel.Categories = domain.StringList(normalizeCategories(req.Categories))
+	}
+	if req.MinPriority != nil {
+		channel.MinPriority = *req.MinPriority
+	}
+	if req.Enabled != nil {
+		channel.Enabled = *req.Enabled
+	}
+	if err := channel.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.chatRepo.Update(ctx, channel); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to update chat channel: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionChatChannelUpdate,
+		fmt.Sprintf("PUT /api/v1/workspaces/%s/chat-channels/%s", channel.WorkspaceID, channel.ID),
+		channelAuditDetails(channel),
+	)
+
+	s.setInteractive(channel)
+	return channel, nil
+}
+
+// DeleteChannel: 채팅 채널을 삭제합니다
+func (s *Service) DeleteChannel(ctx context.Context, actorID, workspaceID, channelID uuid.UUID) error {
+	channel, err := s.GetChannel(ctx, workspaceID, channelID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.chatRepo.Delete(ctx, channel.ID); err != nil {
+		return err
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionChatChannelDelete,
+		fmt.Sprintf("DELETE /api/v1/workspaces/%s/chat-channels/%s", channel.WorkspaceID, channel.ID),
+		channelAuditDetails(channel),
+	)
+	return nil
+}
+
+// TestChannel: 채팅 채널로 테스트 메시지를 전송합니다
+func (s *Service) TestChannel(ctx context.Context, actorID, workspaceID, channelID uuid.UUID) error {
+	channel, err := s.GetChannel(ctx, workspaceID, channelID)
+	if err != nil {
+		return err
+	}
+
+	workspaceName := ""
+	if workspace, err := s.workspaceRepo.GetByID(ctx, channel.WorkspaceID); err == nil && workspace != nil {
+		workspaceName = workspace.Name
+	}
+
+	message := &domain.ChatMessage{
+		WorkspaceName: workspaceName,
+		Type:          "info",
+		Title:         "SkyClust test message",
+		Message:       fmt.Sprintf("Notifications for this workspace will be posted to %q.", channel.Name),
+		Category:      "system",
+		Priority:      domain.NotificationPriorityLow,
+		CreatedAt:     time.Now(),
+	}
+
+	sendErr := s.sender.Send(ctx, channel, message)
+	s.recordResult(ctx, channel, sendErr)
+	if sendErr != nil {
+		return domain.NewDomainError(domain.ErrCodeNetworkError, fmt.Sprintf("failed to send test message: %v", sendErr), 502)
+	}
+	return nil
+}
+
+// Dispatch: 워크스페이스 알림을 라우팅이 일치하는 활성 채널로 전송합니다
+func (s *Service) Dispatch(ctx context.Context, notification *domain.Notification, recipientIDs []string) error {
+	if notification.WorkspaceID == "" {
+		return nil
+	}
+
+	channels, err := s.chatRepo.ListEnabledByWorkspace(ctx, notification.WorkspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to list chat channels: %w", err)
+	}
+
+	var matched []*domain.ChatChannel
+	for _, channel := range channels {
+		if channel.Accepts(notification.Category, notification.Priority) {
+			matched = append(matched, channel)
+		}
+	}
+	if len(matched) == 0 || !s.recipientsAllowChat(ctx, notification, recipientIDs) {
+		return nil
+	}
+
+	workspaceName := ""
+	if workspace, err := s.workspaceRepo.GetByID(ctx, notification.WorkspaceID); err == nil && workspace != nil {
+		workspaceName = workspace.Name
+	}
+
+	groupID := notification.GroupID
+	if groupID == "" {
+		groupID = notification.ID
+	}
+
+	var failed int
+	for _, channel := range matched {
+		message := &domain.ChatMessage{
+			NotificationID: groupID,
+			WorkspaceName:  workspaceName,
+			Type:           notification.Type,
+			Title:          notification.Title,
+			Message:        notification.Message,
+			Category:       notification.Category,
+			Priority:       notification.Priority,
+			CreatedAt:      notification.CreatedAt,
+		}
+		s.attachAck(message, channel)
+
+		sendErr := s.sender.Send(ctx, channel, message)
+		s.recordResult(ctx, channel, sendErr)
+		if sendErr != nil {
+			failed++
+			logger.Warn(fmt.Sprintf("Failed to post notification %s to %s chat channel %s: %v", groupID, channel.Type, channel.ID, sendErr))
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to post notification to %d of %d chat channels", failed, len(matched))
+	}
+	return nil
+}
+
+// recipientsAllowChat: 수신자 중 한 명이라도 알림 설정에서 채팅 전송을 허용하는지 확인합니다
+// 수신자가 지정되지 않은 워크스페이스 알림은 채널 라우팅만 적용합니다
+func (s *Service) recipientsAllowChat(ctx context.Context, notification *domain.Notification, recipientIDs []string) bool {
+	if len(recipientIDs) == 0 {
+		return true
+	}
+	for _, userID := range recipientIDs {
+		preferences, err := s.preferencesRepo.GetByUserID(ctx, userID)
+		if err != nil || preferences == nil {
+			// 설정을 읽지 못하면 기본값(허용)으로 처리
+			return true
+		}
+		if preferences.AllowsChat(notification.Category, notification.Priority) {
+			return true
+		}
+	}
+	return false
+}
+
+// attachAck: 채널이 지원하는 방식으로 확인 버튼 정보를 메시지에 추가합니다
+func (s *Service) attachAck(message *domain.ChatMessage, channel *domain.ChatChannel) {
+	if len(s.ackKey) == 0 {
+		return
+	}
+	token := s.signAckToken(message.NotificationID, channel.ID, time.Now().Add(s.ackTTL))
+	if channel.Type == domain.ChatChannelSlack && channel.SigningSecret != "" {
+		message.AckValue = token
+	}
+	if s.publicURL != "" {
+		message.AckURL = s.ackURL(token)
+	}
+}
+
+// recordResult: 채널의 마지막 전송 결과를 기록합니다
+func (s *Service) recordResult(ctx context.Context, channel *domain.ChatChannel, sendErr error) {
+	errMessage := ""
+	if sendErr != nil {
+		errMessage = truncate(sendErr.Error(), 1000)
+	}
+	if err := s.chatRepo.RecordResult(ctx, channel.ID, time.Now(), errMessage); err != nil {
+		logger.Warn(fmt.Sprintf("Failed to record chat channel result for %s: %v", channel.ID, err))
+	}
+}
+
+// setInteractive: 확인 버튼 콜백 지원 여부를 계산합니다
+func (s *Service) setInteractive(channel *domain.ChatChannel) {
+	if len(s.ackKey) == 0 {
+		channel.Interactive = false
+		return
+	}
+	channel.Interactive = s.publicURL != "" || (channel.Type == domain.ChatChannelSlack && channel.SigningSecret != "")
+}
+
+// getWorkspace: 워크스페이스를 조회합니다
+func (s *Service) getWorkspace(ctx context.Context, workspaceID uuid.UUID) (*domain.Workspace, error) {
+	workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID.String())
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get workspace: %v", err), 500)
+	}
+	if workspace == nil {
+		return nil, domain.ErrWorkspaceNotFound
+	}
+	return workspace, nil
+}
+
+// normalizeCategories: 카테고리 목록의 공백과 중복을 제거합니다
+func normalizeCategories(categories []string) []string {
+	seen := make(map[string]bool, len(categories))
+	normalized := make([]string, 0, len(categories))
+	for _, category := range categories {
+		category = strings.ToLower(strings.TrimSpace(category))
+		if category == "" || seen[category] {
+			continue
+		}
+		seen[category] = true
+		normalized = append(normalized, category)
+	}
+	return normalized
+}
+
+// channelAuditDetails: 감사 로그에 기록할 채널 정보를 반환합니다 (비밀 값 제외)
+func channelAuditDetails(channel *domain.ChatChannel) map[string]interface{} {
+	return map[string]interface{}{
+		"workspace_id":  channel.WorkspaceID,
+		"channel_id":    channel.ID.String(),
+		"name":          channel.Name,
+		"type":          channel.Type,
+		"mode":          channel.Mode,
+		"categories":    []string(channel.Categories),
+		"min_priority":  channel.MinPriority,
+		"enabled":       channel.Enabled,
+		"slack_channel": channel.SlackChannel,
+	}
+}
+
+// truncate: 최대 길이로 자릅니다
+func truncate(value string, limit int) string {
+	if len(value) <= limit {
+		return value
+	}
+	return value[:limit]
+}
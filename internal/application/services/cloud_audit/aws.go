@@ -0,0 +1,190 @@
+package cloud_audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"skyclust/internal/application/services/common"
+	"skyclust/internal/domain"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	cloudtrailtypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+)
+
+const (
+	// awsGlobalEventRegion: IAM, STS 등 글로벌 서비스 이벤트가 기록되는 리전
+	awsGlobalEventRegion = "us-east-1"
+	// awsLookupSlice: LookupEvents는 최신 이벤트부터 반환하므로 구간을 나눠 오래된 구간부터 완전히 조회합니다
+	awsLookupSlice = time.Hour
+	// awsLookupPageSize: LookupEvents의 최대 페이지 크기
+	awsLookupPageSize = 50
+	// awsLookupInterval: LookupEvents 호출 간격 (리전·계정당 초당 2회 제한)
+	awsLookupInterval = 500 * time.Millisecond
+)
+
+// cloudTrailRecord: CloudTrailEvent JSON에서 사용하는 필드
+type cloudTrailRecord struct {
+	AWSRegion       string `json:"awsRegion"`
+	SourceIPAddress string `json:"sourceIPAddress"`
+	UserAgent       string `json:"userAgent"`
+	ErrorCode       string `json:"errorCode"`
+	ErrorMessage    string `json:"errorMessage"`
+	UserIdentity    struct {
+		Type        string `json:"type"`
+		ARN         string `json:"arn"`
+		UserName    string `json:"userName"`
+		AccessKeyID string `json:"accessKeyId"`
+		InvokedBy   string `json:"invokedBy"`
+	} `json:"userIdentity"`
+}
+
+// fetchAWSEvents: CloudTrail LookupEvents로 쓰기 이벤트(ReadOnly=false)를 조회합니다
+// 자격증명 리전과 글로벌 서비스 리전(us-east-1)을 함께 조회합니다
+func fetchAWSEvents(ctx context.Context, data map[string]interface{}, from, to time.Time) (*fetchResult, error) {
+	source, err := common.ParseAWSCredentialData(data, awsGlobalEventRegion)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := common.NewAWSConfig(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	regions := []string{source.Region}
+	if source.Region != awsGlobalEventRegion {
+		regions = append(regions, awsGlobalEventRegion)
+	}
+
+	result := &fetchResult{initiatedBySkyClust: awsInitiatedBy(source)}
+	seen := make(map[string]bool)
+
+	for start := from; start.Before(to); start = start.Add(awsLookupSlice) {
+		end := start.Add(awsLookupSlice)
+		if end.After(to) {
+			end = to
+		}
+		for _, region := range regions {
+			client := cloudtrail.NewFromConfig(cfg, func(o *cloudtrail.Options) {
+				o.Region = region
+			})
+			if err := lookupAWSEvents(ctx, client, region, start, end, seen, result); err != nil {
+				return nil, err
+			}
+		}
+		// 구간 단위로 끝까지 조회한 뒤에만 중단해야 수집 위치가 정확하게 유지됨
+		if len(result.events) >= maxEventsPerRun && end.Before(to) {
+			result.truncated = true
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// lookupAWSEvents: 한 리전의 구간 내 이벤트를 모든 페이지에 걸쳐 조회합니다
+func lookupAWSEvents(ctx context.Context, client *cloudtrail.Client, region string, start, end time.Time, seen map[string]bool, result *fetchResult) error {
+	paginator := cloudtrail.NewLookupEventsPaginator(client, &cloudtrail.LookupEventsInput{
+		StartTime: aws.Time(start),
+		EndTime:   aws.Time(end),
+		LookupAttributes: []cloudtrailtypes.LookupAttribute{{
+			AttributeKey:   cloudtrailtypes.LookupAttributeKeyReadOnly,
+			AttributeValue: aws.String("false"),
+		}},
+		MaxResults: aws.Int32(awsLookupPageSize),
+	})
+
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return domain.NewDomainError(domain.ErrCodeProviderError,
+				fmt.Sprintf("failed to look up CloudTrail events in %s: %v", region, err), 502)
+		}
+		for i := range output.Events {
+			event := normalizeAWSEvent(&output.Events[i], region)
+			if event.EventID == "" || seen[event.EventID] {
+				continue
+			}
+			seen[event.EventID] = true
+			result.events = append(result.events, event)
+		}
+
+		if !paginator.HasMorePages() {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(awsLookupInterval):
+		}
+	}
+	return nil
+}
+
+// normalizeAWSEvent: CloudTrail 이벤트를 정규화합니다
+func normalizeAWSEvent(event *cloudtrailtypes.Event, region string) domain.CloudActivityEvent {
+	normalized := domain.CloudActivityEvent{
+		EventID:     aws.ToString(event.EventId),
+		EventTime:   aws.ToTime(event.EventTime).UTC(),
+		EventName:   aws.ToString(event.EventName),
+		EventSource: aws.ToString(event.EventSource),
+		Region:      region,
+		Principal:   aws.ToString(event.Username),
+		AccessKeyID: aws.ToString(event.AccessKeyId),
+	}
+	for _, resource := range event.Resources {
+		normalized.Resources = append(normalized.Resources, domain.CloudActivityResource{
+			Type: aws.ToString(resource.ResourceType),
+			Name: aws.ToString(resource.ResourceName),
+		})
+	}
+
+	var record cloudTrailRecord
+	if event.CloudTrailEvent == nil || json.Unmarshal([]byte(*event.CloudTrailEvent), &record) != nil {
+		return normalized
+	}
+	if record.AWSRegion != "" {
+		normalized.Region = record.AWSRegion
+	}
+	normalized.SourceIP = record.SourceIPAddress
+	normalized.UserAgent = record.UserAgent
+	normalized.ErrorCode = record.ErrorCode
+	normalized.ErrorMessage = record.ErrorMessage
+	normalized.PrincipalType = record.UserIdentity.Type
+	if record.UserIdentity.AccessKeyID != "" {
+		normalized.AccessKeyID = record.UserIdentity.AccessKeyID
+	}
+	switch {
+	case record.UserIdentity.ARN != "":
+		normalized.Principal = record.UserIdentity.ARN
+	case record.UserIdentity.InvokedBy != "":
+		normalized.Principal = record.UserIdentity.InvokedBy
+	case record.UserIdentity.UserName != "":
+		normalized.Principal = record.UserIdentity.UserName
+	}
+	return normalized
+}
+
+// awsInitiatedBy: 이벤트가 자격증명 자신의 호출인지 판별하는 함수를 반환합니다
+// 액세스 키 자격증명은 액세스 키 ID로, AssumeRole 자격증명은 assumed-role/<역할 이름>/<세션 이름> 주체로 판별합니다
+func awsInitiatedBy(source *common.AWSCredentialSource) func(event *domain.CloudActivityEvent) bool {
+	var assumedRoleSuffix string
+	if source.UsesAssumeRole() {
+		target := source.Roles[len(source.Roles)-1]
+		sessionName := target.SessionName
+		if sessionName == "" {
+			sessionName = common.AWSDefaultSessionName
+		}
+		assumedRoleSuffix = ":assumed-role/" + lastSegment(target.RoleARN) + "/" + sessionName
+	}
+
+	return func(event *domain.CloudActivityEvent) bool {
+		if assumedRoleSuffix != "" {
+			return strings.HasSuffix(event.Principal, assumedRoleSuffix)
+		}
+		return event.AccessKeyID != "" && event.AccessKeyID == source.AccessKey
+	}
+}
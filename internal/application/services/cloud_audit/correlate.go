@@ -0,0 +1,66 @@
+package cloud_audit
+
+import (
+	"sort"
+	"strings"
+
+	"skyclust/internal/domain"
+)
+
+// managedIndex: SkyClust로 생성·변경한 리소스 식별자 인덱스
+// 프로바이더마다 리소스 이름 형식이 달라(ID, ARN, 전체 경로) 전체 값과 마지막 경로 구성 요소로 모두 조회합니다
+type managedIndex map[string]string
+
+// newManagedIndex: 감사 로그에 기록된 리소스 식별자로 인덱스를 생성합니다
+func newManagedIndex(ids []string) managedIndex {
+	index := make(managedIndex, len(ids)*2)
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		index[strings.ToLower(id)] = id
+		if short := lastSegment(id); short != id {
+			if _, exists := index[strings.ToLower(short)]; !exists {
+				index[strings.ToLower(short)] = id
+			}
+		}
+	}
+	return index
+}
+
+// match: 이벤트 대상 리소스 중 관리 대상에 해당하는 식별자를 반환합니다
+func (m managedIndex) match(resources []domain.CloudActivityResource) []string {
+	if len(m) == 0 || len(resources) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for _, resource := range resources {
+		for _, key := range []string{resource.Name, lastSegment(resource.Name)} {
+			if key == "" {
+				continue
+			}
+			if id, ok := m[strings.ToLower(key)]; ok {
+				seen[id] = true
+				break
+			}
+		}
+	}
+
+	matched := make([]string, 0, len(seen))
+	for id := range seen {
+		matched = append(matched, id)
+	}
+	sort.Strings(matched)
+	return matched
+}
+
+// lastSegment: ARN이나 리소스 경로의 마지막 구성 요소를 반환합니다
+// 예: arn:aws:eks:ap-northeast-2:123456789012:cluster/prod → prod, projects/p/zones/z/instances/vm-1 → vm-1
+func lastSegment(name string) string {
+	if i := strings.LastIndexAny(name, "/:"); i >= 0 && i < len(name)-1 {
+		return name[i+1:]
+	}
+	return name
+}
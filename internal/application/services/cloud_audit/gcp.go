@@ -0,0 +1,158 @@
+package cloud_audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"skyclust/internal/domain"
+
+	logging "google.golang.org/api/logging/v2"
+	"google.golang.org/api/option"
+)
+
+const (
+	// gcpActivityLogID: Admin Activity 감사 로그 ID (URL 인코딩)
+	gcpActivityLogID = "cloudaudit.googleapis.com%2Factivity"
+	// gcpPageSize: entries.list 페이지 크기
+	gcpPageSize = 500
+)
+
+// gcpAuditPayload: protoPayload(google.cloud.audit.AuditLog)에서 사용하는 필드
+type gcpAuditPayload struct {
+	ServiceName        string `json:"serviceName"`
+	MethodName         string `json:"methodName"`
+	ResourceName       string `json:"resourceName"`
+	AuthenticationInfo struct {
+		PrincipalEmail string `json:"principalEmail"`
+	} `json:"authenticationInfo"`
+	RequestMetadata struct {
+		CallerIP                string `json:"callerIp"`
+		CallerSuppliedUserAgent string `json:"callerSuppliedUserAgent"`
+	} `json:"requestMetadata"`
+	Status *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"status"`
+}
+
+// fetchGCPEvents: Cloud Logging에서 프로젝트의 Admin Activity 로그를 오래된 순으로 조회합니다
+func fetchGCPEvents(ctx context.Context, data map[string]interface{}, from, to time.Time) (*fetchResult, error) {
+	projectID, _ := data["project_id"].(string)
+	if projectID == "" {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "project_id not found in credential", 400)
+	}
+	clientEmail, _ := data["client_email"].(string)
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to marshal credential data: %v", err), 500)
+	}
+
+	loggingService, err := logging.NewService(ctx,
+		option.WithCredentialsJSON(jsonData),
+		option.WithScopes(logging.LoggingReadScope),
+	)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeProviderError, fmt.Sprintf("failed to create GCP logging service: %v", err), 502)
+	}
+
+	request := &logging.ListLogEntriesRequest{
+		ResourceNames: []string{"projects/" + projectID},
+		Filter: fmt.Sprintf(`logName="projects/%s/logs/%s" AND timestamp>="%s" AND timestamp<"%s"`,
+			projectID, gcpActivityLogID, from.UTC().Format(time.RFC3339Nano), to.UTC().Format(time.RFC3339Nano)),
+		OrderBy:  "timestamp asc",
+		PageSize: gcpPageSize,
+	}
+
+	result := &fetchResult{
+		initiatedBySkyClust: func(event *domain.CloudActivityEvent) bool {
+			return clientEmail != "" && event.Principal == clientEmail
+		},
+	}
+
+	for {
+		response, err := loggingService.Entries.List(request).Context(ctx).Do()
+		if err != nil {
+			return nil, domain.NewDomainError(domain.ErrCodeProviderError, fmt.Sprintf("failed to list GCP admin activity logs: %v", err), 502)
+		}
+		for _, entry := range response.Entries {
+			if event, ok := normalizeGCPEntry(entry); ok {
+				result.events = append(result.events, event)
+			}
+		}
+
+		if response.NextPageToken == "" {
+			break
+		}
+		// 오래된 순으로 조회하므로 여기서 멈춰도 수집한 구간은 연속됨
+		if len(result.events) >= maxEventsPerRun {
+			result.truncated = true
+			break
+		}
+		request.PageToken = response.NextPageToken
+	}
+
+	return result, nil
+}
+
+// normalizeGCPEntry: Admin Activity 로그 항목을 정규화합니다
+func normalizeGCPEntry(entry *logging.LogEntry) (domain.CloudActivityEvent, bool) {
+	eventTime, err := time.Parse(time.RFC3339Nano, entry.Timestamp)
+	if err != nil || entry.InsertId == "" {
+		return domain.CloudActivityEvent{}, false
+	}
+
+	var payload gcpAuditPayload
+	if len(entry.ProtoPayload) > 0 {
+		if err := json.Unmarshal(entry.ProtoPayload, &payload); err != nil {
+			return domain.CloudActivityEvent{}, false
+		}
+	}
+
+	event := domain.CloudActivityEvent{
+		// insertId는 같은 타임스탬프 안에서만 고유하므로 시각과 함께 사용
+		EventID:     eventTime.UTC().Format(time.RFC3339Nano) + "/" + entry.InsertId,
+		EventTime:   eventTime.UTC(),
+		EventName:   payload.MethodName,
+		EventSource: payload.ServiceName,
+		Principal:   payload.AuthenticationInfo.PrincipalEmail,
+		SourceIP:    payload.RequestMetadata.CallerIP,
+		UserAgent:   payload.RequestMetadata.CallerSuppliedUserAgent,
+	}
+	if payload.Status != nil && payload.Status.Code != 0 {
+		event.ErrorCode = strconv.Itoa(payload.Status.Code)
+		event.ErrorMessage = payload.Status.Message
+	}
+
+	resourceType := ""
+	if entry.Resource != nil {
+		resourceType = entry.Resource.Type
+		for _, key := range []string{"location", "zone", "region"} {
+			if value := entry.Resource.Labels[key]; value != "" {
+				event.Region = value
+				break
+			}
+		}
+		// 모니터링 리소스 라벨에는 instance_id, cluster_name 등 리소스 식별자가 포함됨
+		keys := make([]string, 0, len(entry.Resource.Labels))
+		for key := range entry.Resource.Labels {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			value := entry.Resource.Labels[key]
+			if key != "project_id" && key != "location" && key != "zone" && key != "region" && value != "" {
+				event.Resources = append(event.Resources, domain.CloudActivityResource{Type: key, Name: value})
+			}
+		}
+	}
+	if payload.ResourceName != "" {
+		event.Resources = append(event.Resources, domain.CloudActivityResource{Type: resourceType, Name: payload.ResourceName})
+	}
+
+	return event, true
+}
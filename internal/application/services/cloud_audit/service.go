@@ -0,0 +1,337 @@
+package cloud_audit
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultLookback: 처음 수집하는 자격증명의 조회 시작 시점
+	defaultLookback = 24 * time.Hour
+	// maxCatchUp: 오래 수집하지 못한 경우 되돌아가 조회하는 최대 기간
+	maxCatchUp = 7 * 24 * time.Hour
+	// deliveryOverlap: 클라우드 감사 로그의 전달 지연(최대 약 15분)을 고려해 이전 구간을 다시 조회하는 시간
+	deliveryOverlap = 15 * time.Minute
+	// maxEventsPerRun: 한 번의 수집에서 처리하는 자격증명별 최대 이벤트 수
+	maxEventsPerRun = 2000
+	// ingestTimeout: 자격증명 하나를 수집하는 최대 시간
+	ingestTimeout = 5 * time.Minute
+
+	maxResourceLength  = 100
+	maxUserAgentLength = 512
+)
+
+// SupportedProviders: 클라우드 감사 로그 수집을 지원하는 프로바이더
+var SupportedProviders = []string{domain.ProviderAWS, domain.ProviderGCP}
+
+// Service: CloudTrail, GCP Admin Activity 로그를 감사 로그로 수집하는 서비스
+type Service struct {
+	credentialService domain.CredentialService
+	auditLogRepo      domain.AuditLogRepository
+	cursorRepo        domain.CloudAuditRepository
+}
+
+// NewService: 새로운 클라우드 감사 로그 수집 서비스를 생성합니다
+func NewService(
+	credentialService domain.CredentialService,
+	auditLogRepo domain.AuditLogRepository,
+	cursorRepo domain.CloudAuditRepository,
+) domain.CloudAuditService {
+	return &Service{
+		credentialService: credentialService,
+		auditLogRepo:      auditLogRepo,
+		cursorRepo:        cursorRepo,
+	}
+}
+
+// fetchResult: 프로바이더 조회 결과
+type fetchResult struct {
+	events    []domain.CloudActivityEvent
+	truncated bool
+	// initiatedBySkyClust: 이벤트 주체가 자격증명 자신(SkyClust API 호출)인지 판별합니다
+	initiatedBySkyClust func(event *domain.CloudActivityEvent) bool
+}
+
+// IngestWorkspaceCredential: 워크스페이스 자격증명의 클라우드 감사 로그를 즉시 수집합니다
+func (s *Service) IngestWorkspaceCredential(ctx context.Context, workspaceID, credentialID uuid.UUID) (*domain.CloudAuditIngestResult, error) {
+	credential, err := s.credentialService.GetCredentialByID(ctx, workspaceID, credentialID)
+	if err != nil {
+		return nil, err
+	}
+	return s.IngestCredential(ctx, credential)
+}
+
+// IngestCredential: 마지막 수집 이후의 클라우드 변경 이벤트를 감사 로그로 기록합니다
+// 이미 기록된 이벤트는 외부 ID로 건너뛰고, SkyClust가 관리하는 리소스를 SkyClust 밖에서 변경한 이벤트는 out_of_band로 표시합니다
+func (s *Service) IngestCredential(ctx context.Context, credential *domain.Credential) (*domain.CloudAuditIngestResult, error) {
+	if !isSupportedProvider(credential.Provider) {
+		return nil, domain.NewDomainError(domain.ErrCodeNotSupported,
+			fmt.Sprintf("cloud audit ingestion is not supported for provider %s", credential.Provider), 400)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ingestTimeout)
+	defer cancel()
+
+	cursor, err := s.cursorRepo.GetCursor(credential.ID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to load cloud audit cursor", 500)
+	}
+	if cursor == nil {
+		cursor = &domain.CloudAuditCursor{CredentialID: credential.ID}
+	}
+	cursor.WorkspaceID = credential.WorkspaceID
+	cursor.Provider = credential.Provider
+
+	now := time.Now().UTC()
+	result := &domain.CloudAuditIngestResult{
+		CredentialID: credential.ID,
+		WorkspaceID:  credential.WorkspaceID,
+		Provider:     credential.Provider,
+		From:         ingestStart(cursor, now),
+		To:           now,
+		OutOfBand:    []domain.CloudOutOfBandChange{},
+	}
+
+	fetched, err := s.fetch(ctx, credential, result.From, result.To)
+	if err != nil {
+		s.saveFailure(cursor, now, err)
+		return nil, err
+	}
+	result.Fetched = len(fetched.events)
+	result.Truncated = fetched.truncated
+
+	if err := s.record(credential, fetched, result); err != nil {
+		s.saveFailure(cursor, now, err)
+		return nil, err
+	}
+
+	cursor.LastRunAt = &now
+	cursor.LastError = ""
+	cursor.IngestedCount += int64(result.Ingested)
+	cursor.OutOfBandCount += int64(len(result.OutOfBand))
+	// 최대 이벤트 수에 도달하면 마지막으로 수집한 이벤트까지만 완료로 기록하고 나머지는 다음 수집에서 처리
+	collectedUntil := result.To
+	if fetched.truncated {
+		collectedUntil = result.From
+		if n := len(fetched.events); n > 0 {
+			collectedUntil = fetched.events[n-1].EventTime
+		}
+	}
+	if cursor.CollectedUntil == nil || collectedUntil.After(*cursor.CollectedUntil) {
+		cursor.CollectedUntil = &collectedUntil
+	}
+	if err := s.cursorRepo.SaveCursor(cursor); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to save cloud audit cursor", 500)
+	}
+
+	if result.Truncated {
+		logger.DefaultLogger.GetLogger().Warn("Cloud audit ingestion hit the per-run event limit",
+			zap.String("credential_id", credential.ID.String()),
+			zap.Int("limit", maxEventsPerRun))
+	}
+
+	return result, nil
+}
+
+// ListIngestionStatus: 자격증명별 수집 상태를 조회합니다
+func (s *Service) ListIngestionStatus(ctx context.Context, workspaceID *uuid.UUID) ([]*domain.CloudAuditCursor, error) {
+	cursors, err := s.cursorRepo.ListCursors(workspaceID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to list cloud audit ingestion status", 500)
+	}
+	return cursors, nil
+}
+
+// fetch: 프로바이더 API에서 기간 내 변경 이벤트를 시간 순으로 조회합니다
+func (s *Service) fetch(ctx context.Context, credential *domain.Credential, from, to time.Time) (*fetchResult, error) {
+	data, err := s.credentialService.ResolveCredentialData(ctx, credential)
+	if err != nil {
+		return nil, err
+	}
+
+	var fetched *fetchResult
+	switch credential.Provider {
+	case domain.ProviderAWS:
+		fetched, err = fetchAWSEvents(ctx, data, from, to)
+	case domain.ProviderGCP:
+		fetched, err = fetchGCPEvents(ctx, data, from, to)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(fetched.events, func(i, j int) bool {
+		return fetched.events[i].EventTime.Before(fetched.events[j].EventTime)
+	})
+	return fetched, nil
+}
+
+// record: 새 이벤트를 감사 로그로 기록합니다
+func (s *Service) record(credential *domain.Credential, fetched *fetchResult, result *domain.CloudAuditIngestResult) error {
+	if len(fetched.events) == 0 {
+		return nil
+	}
+
+	externalIDs := make([]string, 0, len(fetched.events))
+	for i := range fetched.events {
+		externalIDs = append(externalIDs, externalID(credential.Provider, fetched.events[i].EventID))
+	}
+	existing, err := s.auditLogRepo.ListExistingExternalIDs(domain.AuditSourceCloud, externalIDs)
+	if err != nil {
+		return domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to check ingested cloud events", 500)
+	}
+
+	managedIDs, err := s.auditLogRepo.ListManagedResourceIDs(credential.ID.String(), domain.CloudAuditManagedKeys)
+	if err != nil {
+		return domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to load managed resources", 500)
+	}
+	managed := newManagedIndex(managedIDs)
+
+	for i := range fetched.events {
+		event := &fetched.events[i]
+		id := externalIDs[i]
+		if existing[id] {
+			result.Duplicates++
+			continue
+		}
+		existing[id] = true
+
+		initiated := fetched.initiatedBySkyClust(event)
+		matched := managed.match(event.Resources)
+		outOfBand := !initiated && len(matched) > 0 && event.ErrorCode == ""
+
+		log := newCloudAuditLog(credential, event, id, initiated, matched, outOfBand)
+		if err := s.auditLogRepo.Create(log); err != nil {
+			return domain.NewDomainError(domain.ErrCodeDatabaseError, "failed to record cloud audit event", 500)
+		}
+		result.Ingested++
+
+		if outOfBand {
+			result.OutOfBand = append(result.OutOfBand, domain.CloudOutOfBandChange{
+				AuditLogID: log.ID,
+				EventName:  event.EventName,
+				Principal:  event.Principal,
+				Resources:  matched,
+				EventTime:  event.EventTime,
+			})
+		}
+	}
+	return nil
+}
+
+// saveFailure: 수집 실패를 커서에 기록합니다 (수집 위치는 유지)
+func (s *Service) saveFailure(cursor *domain.CloudAuditCursor, now time.Time, cause error) {
+	cursor.LastRunAt = &now
+	cursor.LastError = truncate(cause.Error(), 1000)
+	if err := s.cursorRepo.SaveCursor(cursor); err != nil {
+		logger.DefaultLogger.GetLogger().Warn("Failed to save cloud audit cursor",
+			zap.String("credential_id", cursor.CredentialID.String()),
+			zap.Error(err))
+	}
+}
+
+// newCloudAuditLog: 클라우드 이벤트를 감사 로그 항목으로 변환합니다
+// 클라우드 주체는 SkyClust 사용자가 아니므로 자격증명 생성자를 user_id로 사용하고 실제 주체는 details.principal에 기록합니다
+func newCloudAuditLog(credential *domain.Credential, event *domain.CloudActivityEvent, externalID string, initiated bool, managed []string, outOfBand bool) *domain.AuditLog {
+	resources := make([]map[string]interface{}, 0, len(event.Resources))
+	for _, resource := range event.Resources {
+		resources = append(resources, map[string]interface{}{
+			"type": resource.Type,
+			"name": resource.Name,
+		})
+	}
+
+	details := map[string]interface{}{
+		"provider":           credential.Provider,
+		"credential_id":      credential.ID.String(),
+		"workspace_id":       credential.WorkspaceID.String(),
+		"event_id":           event.EventID,
+		"event_time":         event.EventTime.UTC().Format(time.RFC3339Nano),
+		"event_name":         event.EventName,
+		"event_source":       event.EventSource,
+		"principal":          event.Principal,
+		"resources":          resources,
+		"skyclust_initiated": initiated,
+		"out_of_band":        outOfBand,
+	}
+	if event.Region != "" {
+		details["region"] = event.Region
+	}
+	if event.PrincipalType != "" {
+		details["principal_type"] = event.PrincipalType
+	}
+	if event.AccessKeyID != "" {
+		details["access_key_id"] = domain.MaskString(event.AccessKeyID, 4, 4)
+	}
+	if len(managed) > 0 {
+		details["managed_resources"] = managed
+	}
+	if event.ErrorCode != "" {
+		details["status"] = "failed"
+		details["error_code"] = event.ErrorCode
+		details["error_message"] = truncate(event.ErrorMessage, 500)
+	}
+
+	ipAddress := ""
+	if ip := net.ParseIP(event.SourceIP); ip != nil {
+		ipAddress = ip.String()
+	} else if event.SourceIP != "" {
+		// AWS 서비스가 대신 호출한 경우 (예: autoscaling.amazonaws.com)
+		details["source_ip"] = event.SourceIP
+	}
+
+	return &domain.AuditLog{
+		UserID:     credential.CreatedBy,
+		Action:     domain.ActionCloudActivity,
+		Resource:   truncate(fmt.Sprintf("%s:%s/%s", credential.Provider, event.EventSource, event.EventName), maxResourceLength),
+		IPAddress:  ipAddress,
+		UserAgent:  truncate(event.UserAgent, maxUserAgentLength),
+		Details:    domain.JSONBMap(details),
+		Source:     domain.AuditSourceCloud,
+		ExternalID: externalID,
+	}
+}
+
+// ingestStart: 조회 시작 시각을 계산합니다
+func ingestStart(cursor *domain.CloudAuditCursor, now time.Time) time.Time {
+	if cursor.CollectedUntil == nil {
+		return now.Add(-defaultLookback)
+	}
+	start := cursor.CollectedUntil.Add(-deliveryOverlap)
+	if earliest := now.Add(-maxCatchUp); start.Before(earliest) {
+		return earliest
+	}
+	return start
+}
+
+// externalID: 프로바이더 간 충돌하지 않는 외부 이벤트 ID를 생성합니다
+func externalID(provider, eventID string) string {
+	return truncate(provider+":"+eventID, 255)
+}
+
+// isSupportedProvider: 수집 지원 프로바이더인지 확인합니다
+func isSupportedProvider(provider string) bool {
+	for _, supported := range SupportedProviders {
+		if provider == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// truncate: 문자열을 최대 길이로 자릅니다
+func truncate(value string, maxLen int) string {
+	if len(value) <= maxLen {
+		return value
+	}
+	return value[:maxLen]
+}
@@ -0,0 +1,357 @@
+package common
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"skyclust/internal/domain"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+const (
+	// AWSDefaultSessionName is the role session name used when a credential does not define one
+	AWSDefaultSessionName = "skyclust"
+	// AWSMinSessionDuration / AWSMaxSessionDuration are the STS AssumeRole duration limits (seconds)
+	AWSMinSessionDuration = 900
+	AWSMaxSessionDuration = 43200
+	// AWSChainedSessionDuration is the maximum duration AWS allows for role-chained sessions (seconds)
+	AWSChainedSessionDuration = 3600
+	// AWSMaxRoleChainLength limits the number of roles assumed for one credential
+	AWSMaxRoleChainLength = 5
+
+	// awsSessionExpiryWindow: 만료 이 시간 전에 STS 세션을 미리 갱신합니다
+	awsSessionExpiryWindow = 5 * time.Minute
+	// awsSessionIdleTTL: 이 시간 동안 사용되지 않은 세션 캐시 항목은 제거합니다
+	awsSessionIdleTTL = 12 * time.Hour
+)
+
+var (
+	awsRoleARNPattern     = regexp.MustCompile(`^arn:aws(-cn|-us-gov)?:iam::\d{12}:role/[\w+=,.@/-]{1,512}$`)
+	awsSessionNamePattern = regexp.MustCompile(`^[\w+=,.@-]{2,64}$`)
+	awsExternalIDPattern  = regexp.MustCompile(`^[\w+=,.@:/-]+$`)
+)
+
+// AWSCredentialSource: 복호화된 AWS 자격증명 데이터에서 추출한 인증 정보
+// Roles가 비어 있으면 정적 액세스 키를 그대로 사용하고, 있으면 순서대로 AssumeRole 합니다
+type AWSCredentialSource struct {
+	AccessKey       string
+	SecretKey       string
+	SessionToken    string
+	Region          string
+	Roles           []domain.AWSAssumeRole
+	DurationSeconds int32
+}
+
+// UsesAssumeRole: AssumeRole을 사용하는 자격증명인지 확인합니다
+func (s *AWSCredentialSource) UsesAssumeRole() bool {
+	return len(s.Roles) > 0
+}
+
+// TargetRoleARN: 최종적으로 assume 되는 역할 ARN을 반환합니다
+func (s *AWSCredentialSource) TargetRoleARN() string {
+	if len(s.Roles) == 0 {
+		return ""
+	}
+	return s.Roles[len(s.Roles)-1].RoleARN
+}
+
+// ParseAWSCredentialData: 복호화된 자격증명 데이터를 파싱하고 검증합니다
+// region 값이 자격증명에 있으면 defaultRegion보다 우선합니다
+func ParseAWSCredentialData(data map[string]interface{}, defaultRegion string) (*AWSCredentialSource, error) {
+	accessKey, ok := data["access_key"].(string)
+	if !ok || accessKey == "" {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "access_key not found in credential", 400)
+	}
+	secretKey, ok := data["secret_key"].(string)
+	if !ok || secretKey == "" {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "secret_key not found in credential", 400)
+	}
+
+	source := &AWSCredentialSource{
+		AccessKey:    accessKey,
+		SecretKey:    secretKey,
+		SessionToken: stringValue(data, "session_token"),
+		Region:       defaultRegion,
+	}
+	if region := stringValue(data, "region"); region != "" {
+		source.Region = region
+	}
+
+	authType := stringValue(data, "auth_type")
+	roleARN := stringValue(data, "role_arn")
+	switch authType {
+	case "", domain.AWSAuthTypeAccessKey:
+		if roleARN == "" {
+			return source, nil
+		}
+	case domain.AWSAuthTypeAssumeRole:
+		if roleARN == "" {
+			return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "role_arn is required for assume_role credentials", 400)
+		}
+	default:
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, fmt.Sprintf("unsupported AWS auth_type: %s", authType), 400)
+	}
+
+	chain, err := parseAWSRoleChain(data["role_chain"])
+	if err != nil {
+		return nil, err
+	}
+	source.Roles = append(chain, domain.AWSAssumeRole{
+		RoleARN:     roleARN,
+		ExternalID:  stringValue(data, "external_id"),
+		SessionName: stringValue(data, "session_name"),
+	})
+	if len(source.Roles) > AWSMaxRoleChainLength {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, fmt.Sprintf("role chain cannot exceed %d roles", AWSMaxRoleChainLength), 400)
+	}
+
+	for i := range source.Roles {
+		if err := validateAWSAssumeRole(&source.Roles[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	duration, err := int32Value(data, "duration_seconds")
+	if err != nil {
+		return nil, err
+	}
+	if duration != 0 && (duration < AWSMinSessionDuration || duration > AWSMaxSessionDuration) {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed,
+			fmt.Sprintf("duration_seconds must be between %d and %d", AWSMinSessionDuration, AWSMaxSessionDuration), 400)
+	}
+	source.DurationSeconds = duration
+
+	return source, nil
+}
+
+// NewAWSConfig: 자격증명으로부터 AWS 설정을 생성합니다
+// AssumeRole 자격증명은 캐시된 STS 세션을 사용하며 만료 전에 자동으로 갱신됩니다
+func NewAWSConfig(ctx context.Context, source *AWSCredentialSource) (aws.Config, error) {
+	var provider aws.CredentialsProvider = credentials.NewStaticCredentialsProvider(
+		source.AccessKey,
+		source.SecretKey,
+		source.SessionToken,
+	)
+
+	if source.UsesAssumeRole() {
+		cached, err := awsSessions.provider(ctx, source)
+		if err != nil {
+			return aws.Config{}, err
+		}
+		// 첫 호출에서 AssumeRole 실패를 명확한 오류로 반환 (이후 호출은 캐시 사용)
+		if _, err := cached.Retrieve(ctx); err != nil {
+			return aws.Config{}, domain.NewDomainError(domain.ErrCodeProviderError,
+				fmt.Sprintf("failed to assume role %s: %v", source.TargetRoleARN(), err), 502).
+				WithDetails("role_arn", source.TargetRoleARN())
+		}
+		provider = cached
+	}
+
+	cfg, err := awsconfig.LoadDefaultConfig(ctx,
+		awsconfig.WithRegion(source.Region),
+		awsconfig.WithCredentialsProvider(provider),
+	)
+	if err != nil {
+		return aws.Config{}, domain.NewDomainError(domain.ErrCodeProviderError, fmt.Sprintf("failed to load AWS config: %v", err), 502)
+	}
+
+	return cfg, nil
+}
+
+// awsSessionCache: AssumeRole 체인별 STS 세션 캐시
+// 동일한 기본 키와 역할 체인을 사용하는 모든 AWS 클라이언트가 세션을 공유합니다
+type awsSessionCache struct {
+	mu      sync.Mutex
+	entries map[string]*awsSessionEntry
+}
+
+// awsSessionEntry: 캐시된 자격증명 공급자와 마지막 사용 시각
+type awsSessionEntry struct {
+	provider *aws.CredentialsCache
+	lastUsed time.Time
+}
+
+var awsSessions = &awsSessionCache{entries: make(map[string]*awsSessionEntry)}
+
+// provider: 역할 체인에 대한 캐시된 자격증명 공급자를 반환합니다 (없으면 생성)
+func (c *awsSessionCache) provider(ctx context.Context, source *AWSCredentialSource) (*aws.CredentialsCache, error) {
+	key := awsSessionKey(source)
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k, entry := range c.entries {
+		if now.Sub(entry.lastUsed) > awsSessionIdleTTL {
+			delete(c.entries, k)
+		}
+	}
+
+	if entry, ok := c.entries[key]; ok {
+		entry.lastUsed = now
+		return entry.provider, nil
+	}
+
+	cached, err := buildAWSRoleChain(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	c.entries[key] = &awsSessionEntry{provider: cached, lastUsed: now}
+	return cached, nil
+}
+
+// buildAWSRoleChain: 기본 키에서 시작해 역할을 순서대로 assume 하는 공급자 체인을 생성합니다
+func buildAWSRoleChain(ctx context.Context, source *AWSCredentialSource) (*aws.CredentialsCache, error) {
+	var provider aws.CredentialsProvider = credentials.NewStaticCredentialsProvider(
+		source.AccessKey,
+		source.SecretKey,
+		source.SessionToken,
+	)
+
+	var cached *aws.CredentialsCache
+	for i, role := range source.Roles {
+		cfg, err := awsconfig.LoadDefaultConfig(ctx,
+			awsconfig.WithRegion(source.Region),
+			awsconfig.WithCredentialsProvider(provider),
+		)
+		if err != nil {
+			return nil, domain.NewDomainError(domain.ErrCodeProviderError, fmt.Sprintf("failed to load AWS config: %v", err), 502)
+		}
+
+		duration := awsRoleSessionDuration(source, i)
+		sessionName := role.SessionName
+		if sessionName == "" {
+			sessionName = AWSDefaultSessionName
+		}
+		externalID := role.ExternalID
+
+		assumeRole := stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), role.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			o.RoleSessionName = sessionName
+			o.Duration = duration
+			if externalID != "" {
+				o.ExternalID = aws.String(externalID)
+			}
+		})
+		cached = aws.NewCredentialsCache(assumeRole, func(o *aws.CredentialsCacheOptions) {
+			o.ExpiryWindow = awsSessionExpiryWindow
+			o.ExpiryWindowJitterFrac = 0.2
+		})
+		provider = cached
+	}
+
+	return cached, nil
+}
+
+// awsRoleSessionDuration: 체인 단계별 세션 유지 시간을 계산합니다
+// 역할 체이닝으로 얻은 세션은 AWS 제약에 따라 최대 1시간입니다
+func awsRoleSessionDuration(source *AWSCredentialSource, index int) time.Duration {
+	last := index == len(source.Roles)-1
+	seconds := int32(AWSChainedSessionDuration)
+	if last && source.DurationSeconds > 0 {
+		seconds = source.DurationSeconds
+	}
+	if index > 0 && seconds > AWSChainedSessionDuration {
+		seconds = AWSChainedSessionDuration
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// awsSessionKey: 기본 키와 역할 체인으로 캐시 키를 생성합니다 (비밀 값은 해시로만 보관)
+func awsSessionKey(source *AWSCredentialSource) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%s\x00%d", source.AccessKey, source.SecretKey, source.SessionToken, source.DurationSeconds)
+	for _, role := range source.Roles {
+		fmt.Fprintf(h, "\x00%s\x00%s\x00%s", role.RoleARN, role.ExternalID, role.SessionName)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// validateAWSAssumeRole: 역할 ARN, External ID, 세션 이름 형식을 검증합니다
+func validateAWSAssumeRole(role *domain.AWSAssumeRole) error {
+	role.RoleARN = strings.TrimSpace(role.RoleARN)
+	if !awsRoleARNPattern.MatchString(role.RoleARN) {
+		return domain.NewDomainError(domain.ErrCodeValidationFailed, fmt.Sprintf("invalid IAM role ARN: %s", role.RoleARN), 400)
+	}
+	if role.ExternalID != "" && (len(role.ExternalID) < 2 || len(role.ExternalID) > 1224 || !awsExternalIDPattern.MatchString(role.ExternalID)) {
+		return domain.NewDomainError(domain.ErrCodeValidationFailed, "external_id must be 2-1224 characters of [A-Za-z0-9+=,.@:/-]", 400)
+	}
+	if role.SessionName != "" && !awsSessionNamePattern.MatchString(role.SessionName) {
+		return domain.NewDomainError(domain.ErrCodeValidationFailed, "session_name must be 2-64 characters of [A-Za-z0-9+=,.@-]", 400)
+	}
+	return nil
+}
+
+// parseAWSRoleChain: role_chain 값을 역할 목록으로 변환합니다
+func parseAWSRoleChain(value interface{}) ([]domain.AWSAssumeRole, error) {
+	if value == nil {
+		return nil, nil
+	}
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "role_chain must be a list of roles", 400)
+	}
+
+	chain := make([]domain.AWSAssumeRole, 0, len(items))
+	for i, item := range items {
+		switch v := item.(type) {
+		case string:
+			chain = append(chain, domain.AWSAssumeRole{RoleARN: v})
+		case map[string]interface{}:
+			chain = append(chain, domain.AWSAssumeRole{
+				RoleARN:     stringValue(v, "role_arn"),
+				ExternalID:  stringValue(v, "external_id"),
+				SessionName: stringValue(v, "session_name"),
+			})
+		default:
+			return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, fmt.Sprintf("role_chain[%d] must be a role ARN or an object", i), 400)
+		}
+	}
+	return chain, nil
+}
+
+// stringValue: 맵에서 문자열 값을 조회합니다
+func stringValue(data map[string]interface{}, key string) string {
+	if v, ok := data[key].(string); ok {
+		return strings.TrimSpace(v)
+	}
+	return ""
+}
+
+// int32Value: 맵에서 정수 값을 조회합니다 (JSON 숫자 또는 문자열)
+func int32Value(data map[string]interface{}, key string) (int32, error) {
+	switch v := data[key].(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return int32(v), nil
+	case int:
+		return int32(v), nil
+	case int32:
+		return v, nil
+	case int64:
+		return int32(v), nil
+	case string:
+		if v == "" {
+			return 0, nil
+		}
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return 0, domain.NewDomainError(domain.ErrCodeValidationFailed, fmt.Sprintf("%s must be a number", key), 400)
+		}
+		return int32(n), nil
+	default:
+		return 0, domain.NewDomainError(domain.ErrCodeValidationFailed, fmt.Sprintf("%s must be a number", key), 400)
+	}
+}
@@ -30,6 +30,7 @@ func (v *CredentialValidator) ValidateCredentialData(provider string, data map[s
 }
 
 // validateAWSCredentials: AWS 자격증명 데이터를 검증합니다
+// assume_role 자격증명은 role_arn, external_id, session_name, duration_seconds, role_chain 형식도 검증합니다
 func (v *CredentialValidator) validateAWSCredentials(data map[string]interface{}) error {
 	if _, ok := data["access_key"]; !ok {
 		return domain.NewDomainError(domain.ErrCodeValidationFailed, "access_key is required for AWS", 400)
@@ -37,7 +38,8 @@ func (v *CredentialValidator) validateAWSCredentials(data map[string]interface{}
 	if _, ok := data["secret_key"]; !ok {
 		return domain.NewDomainError(domain.ErrCodeValidationFailed, "secret_key is required for AWS", 400)
 	}
-	return nil
+	_, err := ParseAWSCredentialData(data, "")
+	return err
 }
 
 // validateGCPCredentials: GCP 자격증명 데이터를 검증합니다
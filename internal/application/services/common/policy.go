@@ -0,0 +1,32 @@
+package common
+
+import (
+	"context"
+
+	"skyclust/internal/domain"
+)
+
+// EnforcePolicy evaluates workspace policies before a mutating cloud operation performed with a credential
+// The credential supplies the workspace, provider and credential ID attributes; a nil enforcer allows everything
+func EnforcePolicy(
+	ctx context.Context,
+	enforcer domain.PolicyEnforcer,
+	credential *domain.Credential,
+	action domain.Permission,
+	resource domain.PolicyResource,
+) error {
+	if enforcer == nil || credential == nil {
+		return nil
+	}
+
+	if resource.Provider == "" {
+		resource.Provider = credential.Provider
+	}
+	resource.CredentialID = credential.ID.String()
+
+	return enforcer.Enforce(ctx, domain.PolicyRequest{
+		WorkspaceID: credential.WorkspaceID.String(),
+		Action:      action,
+		Resource:    resource,
+	})
+}
@@ -0,0 +1,125 @@
+package common
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"skyclust/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// EventWorkspaceResolver finds the workspace a platform event belongs to
+// Credential lookups are cached because credentials never move between workspaces
+type EventWorkspaceResolver struct {
+	credentialRepo domain.CredentialRepository
+
+	// credentialWorkspaces: 자격증명 ID → 워크스페이스 ID
+	credentialWorkspaces sync.Map
+}
+
+// NewEventWorkspaceResolver creates a resolver that looks up credential workspaces in credentialRepo
+func NewEventWorkspaceResolver(credentialRepo domain.CredentialRepository) *EventWorkspaceResolver {
+	return &EventWorkspaceResolver{credentialRepo: credentialRepo}
+}
+
+// Resolve returns the workspace ID of an event, or an empty string when it cannot be determined
+// It checks the event's workspace ID, the workspace_id/credential_id data fields and the IDs in the subject, in that order
+func (r *EventWorkspaceResolver) Resolve(ctx context.Context, eventType, workspaceID string, data map[string]interface{}) string {
+	if workspaceID != "" {
+		return workspaceID
+	}
+	if workspaceID, ok := data["workspace_id"].(string); ok && workspaceID != "" {
+		return workspaceID
+	}
+
+	tokens := strings.Split(eventType, ".")
+	credentialID, _ := data["credential_id"].(string)
+	if len(tokens) >= 3 {
+		switch tokens[0] {
+		case "kubernetes", "network":
+			// {resource}.{provider}.{credential_id}...
+			if credentialID == "" {
+				credentialID = tokens[2]
+			}
+		case "vm":
+			// vm.{provider}.{workspace_id}...
+			if _, err := uuid.Parse(tokens[2]); err == nil {
+				return tokens[2]
+			}
+		case "credential":
+			// credential.{workspace_id}.{provider}.{action}
+			return tokens[1]
+		case "workspace":
+			// workspace.{workspace_id}.{action}
+			return tokens[1]
+		}
+	}
+	if credentialID == "" {
+		return ""
+	}
+	return r.credentialWorkspaceID(credentialID)
+}
+
+// credentialWorkspaceID returns the workspace ID of a credential
+func (r *EventWorkspaceResolver) credentialWorkspaceID(credentialID string) string {
+	if workspaceID, ok := r.credentialWorkspaces.Load(credentialID); ok {
+		return workspaceID.(string)
+	}
+	if r.credentialRepo == nil {
+		return ""
+	}
+
+	id, err := uuid.Parse(credentialID)
+	if err != nil {
+		return ""
+	}
+	credential, err := r.credentialRepo.GetByID(id)
+	if err != nil || credential == nil {
+		return ""
+	}
+	workspaceID := credential.WorkspaceID.String()
+	r.credentialWorkspaces.Store(credentialID, workspaceID)
+	return workspaceID
+}
+
+// WorkspaceAdminIDs returns the IDs of the workspace owner and its admin members
+func WorkspaceAdminIDs(ctx context.Context, workspaceRepo domain.WorkspaceRepository, workspaceID string) ([]string, error) {
+	return workspaceMemberIDs(ctx, workspaceRepo, workspaceID, true)
+}
+
+// WorkspaceMemberIDs returns the IDs of the workspace owner and all of its members
+func WorkspaceMemberIDs(ctx context.Context, workspaceRepo domain.WorkspaceRepository, workspaceID string) ([]string, error) {
+	return workspaceMemberIDs(ctx, workspaceRepo, workspaceID, false)
+}
+
+// workspaceMemberIDs returns the owner followed by the members, optionally only admins
+func workspaceMemberIDs(ctx context.Context, workspaceRepo domain.WorkspaceRepository, workspaceID string, adminsOnly bool) ([]string, error) {
+	workspace, err := workspaceRepo.GetByID(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var userIDs []string
+	if workspace != nil && workspace.OwnerID != "" {
+		seen[workspace.OwnerID] = true
+		userIDs = append(userIDs, workspace.OwnerID)
+	}
+
+	members, err := workspaceRepo.GetWorkspaceMembersWithRoles(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if adminsOnly && member.Role != domain.WorkspaceRoleAdmin {
+			continue
+		}
+		if !seen[member.UserID] {
+			seen[member.UserID] = true
+			userIDs = append(userIDs, member.UserID)
+		}
+	}
+	return userIDs, nil
+}
@@ -20,4 +20,7 @@ const (
 	// Error rate thresholds
 	HighErrorRateThreshold = 5.0   // 5% error rate threshold
 	MemoryWarningThreshold = 100.0 // 100MB memory warning threshold
+
+	// Outbox thresholds
+	OutboxLagWarningSeconds = 300.0 // Oldest unpublished outbox event older than 5 minutes
 )
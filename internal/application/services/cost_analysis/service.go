@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math"
 	serviceconstants "skyclust/internal/application/services"
+	"skyclust/internal/application/services/common"
 	kubernetesservice "skyclust/internal/application/services/kubernetes"
 	"skyclust/internal/domain"
 	"skyclust/pkg/cache"
@@ -17,8 +18,6 @@ import (
 	billingv1 "cloud.google.com/go/billing/apiv1"
 	billingpb "cloud.google.com/go/billing/apiv1/billingpb"
 	"github.com/aws/aws-sdk-go-v2/aws"
-	awsconfig "github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
 	"github.com/google/uuid"
@@ -1034,43 +1033,26 @@ func (s *Service) getAWSCosts(ctx context.Context, credential *domain.Credential
 
 // getAWSCostExplorerClient: 자격증명으로부터 AWS Cost Explorer 클라이언트를 생성합니다
 func (s *Service) getAWSCostExplorerClient(ctx context.Context, credential *domain.Credential, defaultRegion string) (*costexplorer.Client, string, error) {
-	credData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	credData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return nil, "", domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
 
-	accessKey, ok := credData["access_key"].(string)
-	if !ok {
-		return nil, "", domain.NewDomainError(domain.ErrCodeValidationFailed, "access_key not found in credential", 400)
-	}
-
-	secretKey, ok := credData["secret_key"].(string)
-	if !ok {
-		return nil, "", domain.NewDomainError(domain.ErrCodeValidationFailed, "secret_key not found in credential", 400)
-	}
-
-	region := defaultRegion
-	if r, ok := credData["region"].(string); ok && r != "" {
-		region = r
+	creds, err := common.ParseAWSCredentialData(credData, defaultRegion)
+	if err != nil {
+		return nil, "", err
 	}
-	if region == "" {
-		region = AWSDefaultRegion
+	if creds.Region == "" {
+		creds.Region = AWSDefaultRegion
 	}
 
-	cfg, err := awsconfig.LoadDefaultConfig(ctx,
-		awsconfig.WithRegion(region),
-		awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
-			accessKey,
-			secretKey,
-			"",
-		)),
-	)
+	cfg, err := common.NewAWSConfig(ctx, creds)
 	if err != nil {
-		return nil, "", domain.NewDomainError(domain.ErrCodeProviderError, fmt.Sprintf("failed to load AWS config: %v", err), 502)
+		return nil, "", err
 	}
 
 	ceClient := costexplorer.NewFromConfig(cfg)
-	return ceClient, region, nil
+	return ceClient, creds.Region, nil
 }
 
 // queryAWSCostExplorer: 주어진 파라미터로 AWS Cost Explorer API를 조회합니다
@@ -1166,7 +1148,7 @@ func (s *Service) parseAWSCostExplorerResults(result *costexplorer.GetCostAndUsa
 // getGCPCosts: GCP Cloud Billing API에서 실제 비용을 조회합니다
 func (s *Service) getGCPCosts(ctx context.Context, credential *domain.Credential, vm *domain.VM, startDate, endDate time.Time) ([]CostData, error) {
 	// Decrypt credential data
-	credData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	credData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
@@ -1443,7 +1425,7 @@ func (s *Service) getAWSKubernetesCosts(ctx context.Context, credential *domain.
 // 반환값: 비용, 경고, 에러
 func (s *Service) getGCPKubernetesCosts(ctx context.Context, credential *domain.Credential, workspaceID string, startDate, endDate time.Time, includeNodeGroups bool) ([]CostData, []CostWarning, error) {
 	// Decrypt credential data
-	credData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	credData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return nil, nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
@@ -0,0 +1,461 @@
+package credential
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"skyclust/internal/application/services/common"
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/iam/v1"
+	"google.golang.org/api/option"
+)
+
+const (
+	// healthCheckTimeout: 자격증명 하나를 점검하는 최대 시간
+	healthCheckTimeout = 30 * time.Second
+	// maxHealthMessageLength: 저장하는 점검 메시지 최대 길이 (Credential.HealthMessage 컬럼 크기)
+	maxHealthMessageLength = 1000
+
+	awsHealthCheckRegion  = "us-east-1"
+	gcpCloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+	azureManagementScope  = "https://management.azure.com/.default"
+	azureManagementAPI    = "https://management.azure.com"
+)
+
+// azureAuthorityHost: Azure AD 토큰 엔드포인트 호스트 (소버린 클라우드는 변경 필요)
+var azureAuthorityHost = "https://login.microsoftonline.com"
+
+// gcpRequiredPermissions: SkyClust 기능에 필요한 GCP 프로젝트 권한
+var gcpRequiredPermissions = []string{
+	"resourcemanager.projects.get",
+	"compute.instances.list",
+	"compute.networks.list",
+	"compute.subnetworks.list",
+	"compute.firewalls.list",
+	"container.clusters.list",
+	"container.clusters.get",
+	"iam.serviceAccounts.list",
+}
+
+// VerifyCredential: 자격증명을 즉시 점검하고 결과가 반영된 자격증명을 반환합니다
+func (s *Service) VerifyCredential(ctx context.Context, workspaceID, credentialID uuid.UUID) (*domain.Credential, error) {
+	credential, err := s.GetCredentialByID(ctx, workspaceID, credentialID)
+	if err != nil {
+		return nil, err
+	}
+
+	health, err := s.CheckCredentialHealth(ctx, credential)
+	if err != nil {
+		return nil, err
+	}
+
+	if userID, ok := domain.SubjectFromContext(ctx); ok {
+		common.LogAction(ctx, s.auditLogRepo, &userID, domain.ActionCredentialVerify,
+			"POST /api/v1/credentials/"+credentialID.String()+"/verify",
+			map[string]interface{}{
+				"credential_id": credential.ID,
+				"workspace_id":  workspaceID,
+				"provider":      credential.Provider,
+				"health_status": health.Status,
+			},
+		)
+	}
+
+	applyHealth(credential, health)
+	credential.SetKeyAge(time.Now())
+	return credential, nil
+}
+
+// CheckCredentialHealth: 프로바이더 API로 자격증명을 점검하고 결과를 저장합니다
+// 인증 실패는 오류가 아닌 unhealthy 상태로 기록되며, 저장 실패만 오류로 반환합니다
+func (s *Service) CheckCredentialHealth(ctx context.Context, credential *domain.Credential) (*domain.CredentialHealth, error) {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	health := &domain.CredentialHealth{
+		PermissionGaps:      []string{},
+		PreviousStatus:      credential.HealthStatus,
+		PreviousRotationDue: credential.RotationDue,
+	}
+
+	data, err := s.ResolveCredentialData(ctx, credential)
+	if err != nil {
+		health.Status = domain.CredentialHealthUnhealthy
+		health.Message = "failed to load credential data: " + err.Error()
+	} else {
+		var checkErr error
+		switch credential.Provider {
+		case domain.ProviderAWS:
+			checkErr = s.checkAWSCredentialHealth(ctx, data, health)
+		case domain.ProviderGCP:
+			checkErr = s.checkGCPCredentialHealth(ctx, data, health)
+		case domain.ProviderAzure:
+			checkErr = s.checkAzureCredentialHealth(ctx, data, health)
+		default:
+			health.Status = domain.CredentialHealthUnknown
+			health.Message = fmt.Sprintf("health checks are not supported for provider %s", credential.Provider)
+		}
+		if checkErr != nil {
+			health.Status = domain.CredentialHealthUnhealthy
+			health.Message = checkErr.Error()
+		}
+	}
+
+	health.VerifiedAt = time.Now()
+	if health.KeyCreatedAt != nil {
+		health.RotationDue = health.VerifiedAt.Sub(*health.KeyCreatedAt) > domain.CredentialKeyRotationAge
+	}
+	if health.Status == "" {
+		health.Status = domain.CredentialHealthHealthy
+		if len(health.PermissionGaps) > 0 || health.RotationDue {
+			health.Status = domain.CredentialHealthDegraded
+		}
+	}
+	health.Message = truncateHealthMessage(health.Message)
+
+	if err := s.credentialRepo.UpdateHealth(credential.ID, health); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, "failed to save credential health", 500)
+	}
+
+	logger.DefaultLogger.GetLogger().Debug("Credential health checked",
+		zap.String("credential_id", credential.ID.String()),
+		zap.String("provider", credential.Provider),
+		zap.String("status", health.Status),
+		zap.Strings("permission_gaps", health.PermissionGaps))
+
+	return health, nil
+}
+
+// checkAWSCredentialHealth: STS GetCallerIdentity로 인증을 확인하고 주요 API 권한과 액세스 키 생성 시각을 점검합니다
+func (s *Service) checkAWSCredentialHealth(ctx context.Context, data map[string]interface{}, health *domain.CredentialHealth) error {
+	source, err := common.ParseAWSCredentialData(data, awsHealthCheckRegion)
+	if err != nil {
+		return err
+	}
+
+	// 기본 키를 먼저 확인 (AssumeRole 세션 캐시가 폐기된 키를 가리지 않도록)
+	baseSource := &common.AWSCredentialSource{
+		AccessKey:    source.AccessKey,
+		SecretKey:    source.SecretKey,
+		SessionToken: source.SessionToken,
+		Region:       source.Region,
+	}
+	baseCfg, err := common.NewAWSConfig(ctx, baseSource)
+	if err != nil {
+		return err
+	}
+	identity, err := sts.NewFromConfig(baseCfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return fmt.Errorf("AWS authentication failed: %s", awsErrorMessage(err))
+	}
+	health.Identity = aws.ToString(identity.Arn)
+
+	// 장기 액세스 키만 생성 시각이 있음 (임시 자격증명 제외)
+	if source.SessionToken == "" {
+		s.checkAWSAccessKeyAge(ctx, baseCfg, source.AccessKey, health)
+	}
+
+	cfg := baseCfg
+	if source.UsesAssumeRole() {
+		cfg, err = common.NewAWSConfig(ctx, source)
+		if err != nil {
+			return err
+		}
+		assumed, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+		if err != nil {
+			return fmt.Errorf("AWS assumed role authentication failed: %s", awsErrorMessage(err))
+		}
+		health.Identity = aws.ToString(assumed.Arn)
+	}
+
+	probes := []struct {
+		action string
+		call   func() error
+	}{
+		{"ec2:DescribeVpcs", func() error {
+			_, err := ec2.NewFromConfig(cfg).DescribeVpcs(ctx, &ec2.DescribeVpcsInput{MaxResults: aws.Int32(5)})
+			return err
+		}},
+		{"ec2:DescribeInstances", func() error {
+			_, err := ec2.NewFromConfig(cfg).DescribeInstances(ctx, &ec2.DescribeInstancesInput{MaxResults: aws.Int32(5)})
+			return err
+		}},
+		{"eks:ListClusters", func() error {
+			_, err := eks.NewFromConfig(cfg).ListClusters(ctx, &eks.ListClustersInput{MaxResults: aws.Int32(1)})
+			return err
+		}},
+	}
+	for _, probe := range probes {
+		if err := probe.call(); err != nil {
+			if isAWSAccessDenied(err) {
+				health.PermissionGaps = append(health.PermissionGaps, probe.action)
+				continue
+			}
+			logger.DefaultLogger.GetLogger().Debug("AWS permission probe failed",
+				zap.String("action", probe.action),
+				zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// checkAWSAccessKeyAge: IAM ListAccessKeys로 액세스 키 생성 시각을 조회합니다
+func (s *Service) checkAWSAccessKeyAge(ctx context.Context, cfg aws.Config, accessKey string, health *domain.CredentialHealth) {
+	output, err := awsiam.NewFromConfig(cfg).ListAccessKeys(ctx, &awsiam.ListAccessKeysInput{})
+	if err != nil {
+		if isAWSAccessDenied(err) {
+			health.PermissionGaps = append(health.PermissionGaps, "iam:ListAccessKeys")
+		}
+		return
+	}
+	for _, key := range output.AccessKeyMetadata {
+		if aws.ToString(key.AccessKeyId) == accessKey && key.CreateDate != nil {
+			createdAt := key.CreateDate.UTC()
+			health.KeyCreatedAt = &createdAt
+			return
+		}
+	}
+}
+
+// checkGCPCredentialHealth: 서비스 계정 토큰 발급으로 인증을 확인하고 프로젝트 권한과 키 생성 시각을 점검합니다
+func (s *Service) checkGCPCredentialHealth(ctx context.Context, data map[string]interface{}, health *domain.CredentialHealth) error {
+	tokenSource, projectID, clientEmail, err := gcpServiceAccountTokenSource(ctx, data)
+	if err != nil {
+		return err
+	}
+	health.Identity = clientEmail
+
+	granted, err := testGCPProjectPermissions(ctx, tokenSource, projectID, gcpRequiredPermissions)
+	if err != nil {
+		if !isGoogleAPIStatus(err, http.StatusForbidden) {
+			return fmt.Errorf("GCP project %s is not accessible: %v", projectID, err)
+		}
+		// testIamPermissions 자체가 거부되면 모든 권한이 없는 것으로 간주
+		health.PermissionGaps = append(health.PermissionGaps, gcpRequiredPermissions...)
+	} else {
+		health.PermissionGaps = append(health.PermissionGaps, missingPermissions(gcpRequiredPermissions, granted)...)
+	}
+
+	if privateKeyID, _ := data["private_key_id"].(string); privateKeyID != "" {
+		s.checkGCPServiceAccountKeyAge(ctx, tokenSource, projectID, clientEmail, privateKeyID, health)
+	}
+
+	return nil
+}
+
+// gcpServiceAccountTokenSource: 서비스 계정 키로 토큰을 발급해 인증을 확인합니다
+func gcpServiceAccountTokenSource(ctx context.Context, data map[string]interface{}) (oauth2.TokenSource, string, string, error) {
+	projectID, _ := data["project_id"].(string)
+	clientEmail, _ := data["client_email"].(string)
+	if projectID == "" || clientEmail == "" {
+		return nil, "", "", fmt.Errorf("project_id and client_email are required for GCP service account")
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("failed to marshal service account data: %v", err)
+	}
+	jwtConfig, err := google.JWTConfigFromJSON(jsonData, gcpCloudPlatformScope)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("invalid service account key: %v", err)
+	}
+	tokenSource := jwtConfig.TokenSource(ctx)
+	if _, err := tokenSource.Token(); err != nil {
+		return nil, "", "", fmt.Errorf("GCP token fetch failed: %s", oauthErrorMessage(err))
+	}
+	return tokenSource, projectID, clientEmail, nil
+}
+
+// testGCPProjectPermissions: 프로젝트에 대해 보유한 권한을 반환합니다 (요청당 최대 100개씩 나누어 조회)
+func testGCPProjectPermissions(ctx context.Context, tokenSource oauth2.TokenSource, projectID string, permissions []string) ([]string, error) {
+	resourceManager, err := cloudresourcemanager.NewService(ctx, option.WithTokenSource(tokenSource))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCP resource manager client: %v", err)
+	}
+
+	const maxPermissionsPerRequest = 100
+	var granted []string
+	for start := 0; start < len(permissions); start += maxPermissionsPerRequest {
+		end := min(start+maxPermissionsPerRequest, len(permissions))
+		response, err := resourceManager.Projects.TestIamPermissions(projectID, &cloudresourcemanager.TestIamPermissionsRequest{
+			Permissions: permissions[start:end],
+		}).Context(ctx).Do()
+		if err != nil {
+			return nil, err
+		}
+		granted = append(granted, response.Permissions...)
+	}
+	return granted, nil
+}
+
+// checkGCPServiceAccountKeyAge: 서비스 계정 키의 생성 시각(validAfterTime)을 조회합니다
+func (s *Service) checkGCPServiceAccountKeyAge(ctx context.Context, tokenSource oauth2.TokenSource, projectID, clientEmail, privateKeyID string, health *domain.CredentialHealth) {
+	iamService, err := iam.NewService(ctx, option.WithTokenSource(tokenSource))
+	if err != nil {
+		return
+	}
+	name := fmt.Sprintf("projects/%s/serviceAccounts/%s/keys/%s", projectID, clientEmail, privateKeyID)
+	key, err := iamService.Projects.ServiceAccounts.Keys.Get(name).Context(ctx).Do()
+	if err != nil {
+		if isGoogleAPIStatus(err, http.StatusForbidden) {
+			health.PermissionGaps = append(health.PermissionGaps, "iam.serviceAccountKeys.get")
+		}
+		return
+	}
+	if createdAt, err := time.Parse(time.RFC3339, key.ValidAfterTime); err == nil {
+		createdAt = createdAt.UTC()
+		health.KeyCreatedAt = &createdAt
+	}
+}
+
+// checkAzureCredentialHealth: 클라이언트 자격증명 토큰 발급으로 인증을 확인하고 구독 읽기 권한을 점검합니다
+// 클라이언트 시크릿 생성 시각은 Microsoft Graph 권한이 필요하므로 조회하지 않습니다
+func (s *Service) checkAzureCredentialHealth(ctx context.Context, data map[string]interface{}, health *domain.CredentialHealth) error {
+	tenantID, _ := data["tenant_id"].(string)
+	clientID, _ := data["client_id"].(string)
+	clientSecret, _ := data["client_secret"].(string)
+	subscriptionID, _ := data["subscription_id"].(string)
+	if tenantID == "" || clientID == "" || clientSecret == "" {
+		return fmt.Errorf("tenant_id, client_id and client_secret are required for Azure")
+	}
+	health.Identity = clientID + "@" + tenantID
+
+	tokenConfig := &clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     fmt.Sprintf("%s/%s/oauth2/v2.0/token", azureAuthorityHost, url.PathEscape(tenantID)),
+		Scopes:       []string{azureManagementScope},
+	}
+	token, err := tokenConfig.Token(ctx)
+	if err != nil {
+		return fmt.Errorf("Azure token fetch failed: %s", oauthErrorMessage(err))
+	}
+
+	if subscriptionID == "" {
+		return nil
+	}
+	endpoint := fmt.Sprintf("%s/subscriptions/%s?api-version=2022-12-01", azureManagementAPI, url.PathEscape(subscriptionID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil
+	}
+	token.SetAuthHeader(req)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		logger.DefaultLogger.GetLogger().Debug("Azure subscription probe failed", zap.Error(err))
+		return nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusForbidden, http.StatusNotFound:
+		// 역할 할당이 없는 구독은 404로 응답하기도 함
+		health.PermissionGaps = append(health.PermissionGaps, "Microsoft.Resources/subscriptions/read")
+	default:
+		logger.DefaultLogger.GetLogger().Debug("Azure subscription probe returned unexpected status",
+			zap.Int("status", resp.StatusCode))
+	}
+	return nil
+}
+
+// applyHealth: 점검 결과를 자격증명 응답에 반영합니다
+func applyHealth(credential *domain.Credential, health *domain.CredentialHealth) {
+	verifiedAt := health.VerifiedAt
+	credential.HealthStatus = health.Status
+	credential.HealthMessage = health.Message
+	credential.VerifiedIdentity = health.Identity
+	credential.LastVerifiedAt = &verifiedAt
+	credential.PermissionGaps = health.PermissionGaps
+	credential.KeyCreatedAt = health.KeyCreatedAt
+	credential.RotationDue = health.RotationDue
+}
+
+// missingPermissions: required 중 granted에 없는 권한을 반환합니다
+func missingPermissions(required, granted []string) []string {
+	grantedSet := make(map[string]bool, len(granted))
+	for _, permission := range granted {
+		grantedSet[permission] = true
+	}
+	var missing []string
+	for _, permission := range required {
+		if !grantedSet[permission] {
+			missing = append(missing, permission)
+		}
+	}
+	return missing
+}
+
+// isAWSAccessDenied: AWS 권한 거부 오류인지 확인합니다
+func isAWSAccessDenied(err error) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.ErrorCode() {
+	case "AccessDenied", "AccessDeniedException", "UnauthorizedOperation", "UnauthorizedAccess":
+		return true
+	}
+	return false
+}
+
+// awsErrorMessage: AWS 오류에서 코드와 메시지만 추출합니다 (요청 ID 등 제외)
+func awsErrorMessage(err error) string {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() + ": " + apiErr.ErrorMessage()
+	}
+	return err.Error()
+}
+
+// oauthErrorMessage: OAuth 토큰 오류에서 응답 본문 대신 오류 코드와 설명을 추출합니다
+func oauthErrorMessage(err error) string {
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) {
+		if retrieveErr.ErrorCode != "" {
+			return strings.TrimSpace(retrieveErr.ErrorCode + ": " + retrieveErr.ErrorDescription)
+		}
+		if retrieveErr.Response != nil {
+			return fmt.Sprintf("token endpoint returned status %d", retrieveErr.Response.StatusCode)
+		}
+	}
+	return err.Error()
+}
+
+// isGoogleAPIStatus: Google API 오류의 HTTP 상태 코드를 확인합니다
+func isGoogleAPIStatus(err error, status int) bool {
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == status
+}
+
+// truncateHealthMessage: 점검 메시지를 컬럼 크기에 맞게 자릅니다
+func truncateHealthMessage(message string) string {
+	if len(message) <= maxHealthMessageLength {
+		return message
+	}
+	return strings.ToValidUTF8(message[:maxHealthMessageLength-3], "") + "..."
+}
@@ -0,0 +1,482 @@
+package credential
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"skyclust/internal/application/services/common"
+	"skyclust/internal/domain"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/uuid"
+)
+
+// featureDefinition: 기능과 기능에 필요한 프로바이더 권한
+type featureDefinition struct {
+	feature     string
+	description string
+	actions     []domain.FeatureAction
+}
+
+// awsFeatureCatalog: SkyClust가 AWS에서 호출하는 API 기준 기능별 IAM 액션
+var awsFeatureCatalog = []featureDefinition{
+	{
+		feature:     domain.CredentialFeatureKubernetes,
+		description: "EKS clusters and node groups",
+		actions: []domain.FeatureAction{
+			{Action: "eks:ListClusters", ReadOnly: true},
+			{Action: "eks:DescribeCluster", ReadOnly: true},
+			{Action: "eks:DescribeClusterVersions", ReadOnly: true},
+			{Action: "eks:ListNodegroups", ReadOnly: true},
+			{Action: "eks:DescribeNodegroup", ReadOnly: true},
+			{Action: "ec2:DescribeRegions", ReadOnly: true},
+			{Action: "ec2:DescribeAvailabilityZones", ReadOnly: true},
+			{Action: "eks:CreateCluster"},
+			{Action: "eks:DeleteCluster"},
+			{Action: "eks:CreateNodegroup"},
+			{Action: "eks:DeleteNodegroup"},
+			{Action: "iam:PassRole"},
+		},
+	},
+	{
+		feature:     domain.CredentialFeatureNetwork,
+		description: "VPCs, subnets and security groups",
+		actions: []domain.FeatureAction{
+			{Action: "ec2:DescribeVpcs", ReadOnly: true},
+			{Action: "ec2:DescribeSubnets", ReadOnly: true},
+			{Action: "ec2:DescribeSecurityGroups", ReadOnly: true},
+			{Action: "ec2:DescribeInternetGateways", ReadOnly: true},
+			{Action: "ec2:DescribeRouteTables", ReadOnly: true},
+			{Action: "ec2:CreateVpc"},
+			{Action: "ec2:DeleteVpc"},
+			{Action: "ec2:CreateSubnet"},
+			{Action: "ec2:DeleteSubnet"},
+			{Action: "ec2:CreateSecurityGroup"},
+			{Action: "ec2:DeleteSecurityGroup"},
+			{Action: "ec2:AuthorizeSecurityGroupIngress"},
+			{Action: "ec2:AuthorizeSecurityGroupEgress"},
+			{Action: "ec2:RevokeSecurityGroupIngress"},
+			{Action: "ec2:RevokeSecurityGroupEgress"},
+			{Action: "ec2:CreateTags"},
+		},
+	},
+	{
+		feature:     domain.CredentialFeatureCost,
+		description: "Cost Explorer cost and usage",
+		actions: []domain.FeatureAction{
+			{Action: "ce:GetCostAndUsage", ReadOnly: true},
+		},
+	},
+}
+
+// gcpFeatureCatalog: SkyClust가 GCP에서 호출하는 API 기준 기능별 IAM 권한
+var gcpFeatureCatalog = []featureDefinition{
+	{
+		feature:     domain.CredentialFeatureKubernetes,
+		description: "GKE clusters and node pools",
+		actions: []domain.FeatureAction{
+			{Action: "container.clusters.list", ReadOnly: true},
+			{Action: "container.clusters.get", ReadOnly: true},
+			{Action: "container.operations.get", ReadOnly: true},
+			{Action: "container.nodes.list", ReadOnly: true},
+			{Action: "container.clusters.create"},
+			{Action: "container.clusters.delete"},
+			{Action: "container.clusters.update"},
+			{Action: "iam.serviceAccounts.actAs"},
+		},
+	},
+	{
+		feature:     domain.CredentialFeatureNetwork,
+		description: "VPC networks, subnetworks and firewall rules",
+		actions: []domain.FeatureAction{
+			{Action: "compute.networks.list", ReadOnly: true},
+			{Action: "compute.networks.get", ReadOnly: true},
+			{Action: "compute.subnetworks.list", ReadOnly: true},
+			{Action: "compute.subnetworks.get", ReadOnly: true},
+			{Action: "compute.firewalls.list", ReadOnly: true},
+			{Action: "compute.firewalls.get", ReadOnly: true},
+			{Action: "compute.networks.create"},
+			{Action: "compute.networks.delete"},
+			{Action: "compute.networks.updatePolicy"},
+			{Action: "compute.subnetworks.create"},
+			{Action: "compute.subnetworks.delete"},
+			{Action: "compute.subnetworks.update"},
+			{Action: "compute.firewalls.create"},
+			{Action: "compute.firewalls.update"},
+			{Action: "compute.firewalls.delete"},
+		},
+	},
+	{
+		feature:     domain.CredentialFeatureCost,
+		description: "Cloud Billing project billing info",
+		actions: []domain.FeatureAction{
+			{Action: "resourcemanager.projects.get", ReadOnly: true},
+			{Action: "billing.resourcebilling.get", ReadOnly: true},
+		},
+	},
+}
+
+// featureCatalog: 프로바이더의 기능 카탈로그를 반환합니다
+func featureCatalog(provider string) ([]featureDefinition, bool) {
+	switch provider {
+	case domain.ProviderAWS:
+		return awsFeatureCatalog, true
+	case domain.ProviderGCP:
+		return gcpFeatureCatalog, true
+	default:
+		return nil, false
+	}
+}
+
+// AnalyzeCredentialPermissions: 기능별 필요 권한을 프로바이더 정책 평가로 확인합니다 (실제 리소스는 호출하지 않음)
+func (s *Service) AnalyzeCredentialPermissions(ctx context.Context, workspaceID, credentialID uuid.UUID) (*domain.CredentialPermissionReport, error) {
+	credential, err := s.GetCredentialByID(ctx, workspaceID, credentialID)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, ok := featureCatalog(credential.Provider)
+	if !ok {
+		return nil, domain.NewDomainError(domain.ErrCodeNotSupported,
+			fmt.Sprintf("permission analysis is not supported for provider %s", credential.Provider), 400)
+	}
+
+	return s.analyzePermissions(ctx, credential, catalog)
+}
+
+// GenerateCredentialPolicy: 선택한 기능에 필요한 최소 권한 정책(AWS IAM 정책 / GCP 사용자 정의 역할)을 생성합니다
+// missingOnly가 true이면 현재 보유하지 않은 권한만 포함합니다
+func (s *Service) GenerateCredentialPolicy(ctx context.Context, workspaceID, credentialID uuid.UUID, features []string, missingOnly bool) (*domain.CredentialPolicyDocument, error) {
+	credential, err := s.GetCredentialByID(ctx, workspaceID, credentialID)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, ok := featureCatalog(credential.Provider)
+	if !ok {
+		return nil, domain.NewDomainError(domain.ErrCodeNotSupported,
+			fmt.Sprintf("policy generation is not supported for provider %s", credential.Provider), 400)
+	}
+
+	selected, err := selectFeatures(catalog, features)
+	if err != nil {
+		return nil, err
+	}
+
+	// 기능별로 부여할 액션 (missingOnly이면 분석 결과의 누락 액션만)
+	actionsByFeature := make(map[string][]string, len(selected))
+	if missingOnly {
+		report, err := s.analyzePermissions(ctx, credential, selected)
+		if err != nil {
+			return nil, err
+		}
+		for _, status := range report.Features {
+			actionsByFeature[status.Feature] = status.Missing
+		}
+	} else {
+		for _, definition := range selected {
+			for _, action := range definition.actions {
+				actionsByFeature[definition.feature] = append(actionsByFeature[definition.feature], action.Action)
+			}
+		}
+	}
+
+	policy := &domain.CredentialPolicyDocument{
+		CredentialID: credential.ID,
+		Provider:     credential.Provider,
+		MissingOnly:  missingOnly,
+		Actions:      []string{},
+	}
+	for _, definition := range selected {
+		policy.Features = append(policy.Features, definition.feature)
+	}
+
+	var document interface{}
+	switch credential.Provider {
+	case domain.ProviderAWS:
+		policy.Format = domain.PolicyFormatAWSIAMPolicy
+		document, policy.Actions = buildAWSPolicyDocument(selected, actionsByFeature)
+	case domain.ProviderGCP:
+		policy.Format = domain.PolicyFormatGCPCustomRole
+		document, policy.Actions = buildGCPCustomRole(selected, actionsByFeature)
+	}
+
+	policy.Document, err = json.Marshal(document)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, "failed to encode policy document", 500)
+	}
+	return policy, nil
+}
+
+// analyzePermissions: 카탈로그의 모든 액션을 평가하고 기능별 결과를 만듭니다
+func (s *Service) analyzePermissions(ctx context.Context, credential *domain.Credential, catalog []featureDefinition) (*domain.CredentialPermissionReport, error) {
+	data, err := s.ResolveCredentialData(ctx, credential)
+	if err != nil {
+		return nil, err
+	}
+
+	actions := catalogActions(catalog)
+	report := &domain.CredentialPermissionReport{
+		CredentialID:   credential.ID,
+		Provider:       credential.Provider,
+		MissingActions: []string{},
+		AnalyzedAt:     time.Now(),
+	}
+
+	var granted map[string]bool
+	switch credential.Provider {
+	case domain.ProviderAWS:
+		report.Method = domain.PermissionAnalysisAWSSimulate
+		report.Principal, granted, err = s.simulateAWSPermissions(ctx, data, actions)
+	case domain.ProviderGCP:
+		report.Method = domain.PermissionAnalysisGCPTest
+		report.Principal, granted, err = s.testGCPPermissions(ctx, data, actions)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	missingSet := make(map[string]bool)
+	for _, definition := range catalog {
+		status := domain.CredentialFeatureStatus{
+			Feature:     definition.feature,
+			Description: definition.description,
+			Granted:     []string{},
+			Missing:     []string{},
+		}
+		readOnlyGranted := true
+		for _, action := range definition.actions {
+			if granted[action.Action] {
+				status.Granted = append(status.Granted, action.Action)
+				continue
+			}
+			status.Missing = append(status.Missing, action.Action)
+			if action.ReadOnly {
+				readOnlyGranted = false
+			}
+			if !missingSet[action.Action] {
+				missingSet[action.Action] = true
+				report.MissingActions = append(report.MissingActions, action.Action)
+			}
+		}
+
+		switch {
+		case len(status.Missing) == 0:
+			status.Enabled = true
+			status.Access = domain.FeatureAccessFull
+		case readOnlyGranted:
+			status.Access = domain.FeatureAccessReadOnly
+		default:
+			status.Access = domain.FeatureAccessNone
+		}
+		report.Features = append(report.Features, status)
+	}
+
+	return report, nil
+}
+
+// simulateAWSPermissions: iam:SimulatePrincipalPolicy로 호출 주체의 액션 허용 여부를 평가합니다
+func (s *Service) simulateAWSPermissions(ctx context.Context, data map[string]interface{}, actions []string) (string, map[string]bool, error) {
+	source, err := common.ParseAWSCredentialData(data, awsHealthCheckRegion)
+	if err != nil {
+		return "", nil, err
+	}
+	cfg, err := common.NewAWSConfig(ctx, source)
+	if err != nil {
+		return "", nil, err
+	}
+
+	identity, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", nil, domain.NewDomainError(domain.ErrCodeProviderAuth, "AWS authentication failed: "+awsErrorMessage(err), 502)
+	}
+	callerARN := aws.ToString(identity.Arn)
+
+	granted := make(map[string]bool, len(actions))
+	// 루트 사용자는 시뮬레이션 대상이 아니며 모든 권한을 가짐
+	if strings.HasSuffix(callerARN, ":root") {
+		for _, action := range actions {
+			granted[action] = true
+		}
+		return callerARN, granted, nil
+	}
+
+	iamClient := awsiam.NewFromConfig(cfg)
+	principalARN := awsPolicySourceARN(ctx, iamClient, callerARN)
+
+	paginator := awsiam.NewSimulatePrincipalPolicyPaginator(iamClient, &awsiam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(principalARN),
+		ActionNames:     actions,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			if isAWSAccessDenied(err) {
+				return "", nil, domain.NewDomainError(domain.ErrCodeForbidden,
+					"credential is not allowed to call iam:SimulatePrincipalPolicy on itself", 403).
+					WithDetails("principal", principalARN).
+					WithDetails("required_action", "iam:SimulatePrincipalPolicy")
+			}
+			return "", nil, domain.NewDomainError(domain.ErrCodeProviderError, "failed to simulate IAM policy: "+awsErrorMessage(err), 502)
+		}
+		for _, result := range page.EvaluationResults {
+			if result.EvalDecision == awsiamtypes.PolicyEvaluationDecisionTypeAllowed {
+				granted[aws.ToString(result.EvalActionName)] = true
+			}
+		}
+	}
+
+	return principalARN, granted, nil
+}
+
+// awsPolicySourceARN: 호출 주체 ARN을 시뮬레이션 가능한 IAM 사용자/역할 ARN으로 변환합니다
+// assumed-role 세션은 역할 ARN으로 바꾸며, 경로가 있는 역할은 iam:GetRole로 정확한 ARN을 조회합니다
+func awsPolicySourceARN(ctx context.Context, client *awsiam.Client, callerARN string) string {
+	parsed, err := arn.Parse(callerARN)
+	if err != nil || parsed.Service != "sts" || !strings.HasPrefix(parsed.Resource, "assumed-role/") {
+		return callerARN
+	}
+
+	roleName := strings.SplitN(strings.TrimPrefix(parsed.Resource, "assumed-role/"), "/", 2)[0]
+	if role, err := client.GetRole(ctx, &awsiam.GetRoleInput{RoleName: aws.String(roleName)}); err == nil && role.Role != nil {
+		return aws.ToString(role.Role.Arn)
+	}
+	return arn.ARN{
+		Partition: parsed.Partition,
+		Service:   "iam",
+		AccountID: parsed.AccountID,
+		Resource:  "role/" + roleName,
+	}.String()
+}
+
+// testGCPPermissions: projects.testIamPermissions로 서비스 계정의 프로젝트 권한을 확인합니다
+func (s *Service) testGCPPermissions(ctx context.Context, data map[string]interface{}, permissions []string) (string, map[string]bool, error) {
+	tokenSource, projectID, clientEmail, err := gcpServiceAccountTokenSource(ctx, data)
+	if err != nil {
+		return "", nil, domain.NewDomainError(domain.ErrCodeProviderAuth, err.Error(), 502)
+	}
+
+	grantedList, err := testGCPProjectPermissions(ctx, tokenSource, projectID, permissions)
+	if err != nil {
+		if isGoogleAPIStatus(err, http.StatusForbidden) {
+			// 프로젝트에 대한 권한이 전혀 없으면 testIamPermissions도 거부됨
+			return clientEmail, map[string]bool{}, nil
+		}
+		return "", nil, domain.NewDomainError(domain.ErrCodeProviderError, fmt.Sprintf("failed to test GCP permissions: %v", err), 502)
+	}
+
+	granted := make(map[string]bool, len(grantedList))
+	for _, permission := range grantedList {
+		granted[permission] = true
+	}
+	return clientEmail, granted, nil
+}
+
+// buildAWSPolicyDocument: 기능별 Statement로 구성된 IAM 정책 문서를 만듭니다
+func buildAWSPolicyDocument(selected []featureDefinition, actionsByFeature map[string][]string) (map[string]interface{}, []string) {
+	statements := []map[string]interface{}{}
+	var all []string
+	for _, definition := range selected {
+		actions := actionsByFeature[definition.feature]
+		if len(actions) == 0 {
+			continue
+		}
+		sorted := append([]string(nil), actions...)
+		sort.Strings(sorted)
+		statements = append(statements, map[string]interface{}{
+			"Sid":      "SkyClust" + featureStatementSuffix(definition.feature),
+			"Effect":   "Allow",
+			"Action":   sorted,
+			"Resource": "*",
+		})
+		all = append(all, sorted...)
+	}
+
+	return map[string]interface{}{
+		"Version":   "2012-10-17",
+		"Statement": statements,
+	}, uniqueStrings(all)
+}
+
+// buildGCPCustomRole: gcloud iam roles create --file 형식의 사용자 정의 역할을 만듭니다
+func buildGCPCustomRole(selected []featureDefinition, actionsByFeature map[string][]string) (map[string]interface{}, []string) {
+	var features, permissions []string
+	for _, definition := range selected {
+		features = append(features, definition.feature)
+		permissions = append(permissions, actionsByFeature[definition.feature]...)
+	}
+	permissions = uniqueStrings(permissions)
+
+	return map[string]interface{}{
+		"title":               "SkyClust Operator",
+		"description":         "Minimal permissions for SkyClust features: " + strings.Join(features, ", "),
+		"stage":               "GA",
+		"includedPermissions": permissions,
+	}, permissions
+}
+
+// selectFeatures: 요청한 기능만 카탈로그에서 고릅니다 (비어 있으면 전체)
+func selectFeatures(catalog []featureDefinition, features []string) ([]featureDefinition, error) {
+	if len(features) == 0 {
+		return catalog, nil
+	}
+
+	requested := make(map[string]bool, len(features))
+	for _, feature := range features {
+		requested[strings.TrimSpace(feature)] = true
+	}
+
+	var selected []featureDefinition
+	for _, definition := range catalog {
+		if requested[definition.feature] {
+			selected = append(selected, definition)
+			delete(requested, definition.feature)
+		}
+	}
+	for feature := range requested {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, fmt.Sprintf("unknown feature: %s", feature), 400)
+	}
+	return selected, nil
+}
+
+// catalogActions: 카탈로그의 모든 액션을 중복 없이 반환합니다
+func catalogActions(catalog []featureDefinition) []string {
+	var actions []string
+	for _, definition := range catalog {
+		for _, action := range definition.actions {
+			actions = append(actions, action.Action)
+		}
+	}
+	return uniqueStrings(actions)
+}
+
+// featureStatementSuffix: IAM Sid에 사용할 기능 이름 (영숫자만 허용)
+func featureStatementSuffix(feature string) string {
+	if feature == "" {
+		return ""
+	}
+	return strings.ToUpper(feature[:1]) + feature[1:]
+}
+
+// uniqueStrings: 중복을 제거하고 정렬된 목록을 반환합니다
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	unique := []string{}
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
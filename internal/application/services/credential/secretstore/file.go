@@ -0,0 +1,73 @@
+package secretstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+
+	"skyclust/internal/domain"
+)
+
+const maxSecretFileSize = 1 << 20
+
+// FileStore: 디렉터리 아래 JSON 파일로 비밀을 보관하는 저장소 (개발/온프레미스용)
+type FileStore struct {
+	root string
+}
+
+// NewFileStore: 새로운 파일 비밀 저장소를 생성합니다
+func NewFileStore(root string) (*FileStore, error) {
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, fmt.Errorf("secret store directory: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("secret store path %q is not a directory", root)
+	}
+	return &FileStore{root: root}, nil
+}
+
+// Name: 저장소 이름을 반환합니다
+func (f *FileStore) Name() string {
+	return domain.SecretStoreFile
+}
+
+// GetSecret: 루트 디렉터리 기준 경로의 JSON 파일을 읽습니다 (.json 확장자는 생략 가능)
+func (f *FileStore) GetSecret(ctx context.Context, path string) (map[string]interface{}, error) {
+	secretPath, err := cleanSecretPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(secretPath, ".json") {
+		secretPath += ".json"
+	}
+
+	// OpenInRoot는 심볼릭 링크를 포함해 루트 밖으로 벗어나는 경로를 거부함
+	file, err := os.OpenInRoot(f.root, secretPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrSecretNotFound
+		}
+		return nil, fmt.Errorf("failed to open secret file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	content, err := io.ReadAll(io.LimitReader(file, maxSecretFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secret file: %w", err)
+	}
+	if len(content) > maxSecretFileSize {
+		return nil, fmt.Errorf("secret file exceeds %d bytes", maxSecretFileSize)
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal(content, &data); err != nil {
+		return nil, fmt.Errorf("secret file is not a JSON object: %w", err)
+	}
+	return data, nil
+}
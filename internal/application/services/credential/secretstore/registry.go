@@ -0,0 +1,171 @@
+package secretstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"skyclust/internal/domain"
+)
+
+// DefaultCacheTTL: 조회한 비밀의 기본 메모리 캐시 유지 시간
+const DefaultCacheTTL = 30 * time.Second
+
+// DefaultWorkspacePathPrefix: 워크스페이스가 참조할 수 있는 기본 비밀 경로 접두사
+const DefaultWorkspacePathPrefix = "workspaces/" + WorkspaceIDPlaceholder
+
+// WorkspaceIDPlaceholder: 경로 접두사에서 워크스페이스 ID로 치환되는 자리표시자
+const WorkspaceIDPlaceholder = "{workspace_id}"
+
+// ErrSecretNotFound: 비밀 경로가 존재하지 않음
+var ErrSecretNotFound = errors.New("secret not found")
+
+// ErrSecretPathOutsideWorkspace: 비밀 경로가 워크스페이스 접두사 밖에 있음
+var ErrSecretPathOutsideWorkspace = errors.New("secret path is outside the workspace prefix")
+
+// Registry: 이름별 비밀 저장소와 짧은 수명의 조회 캐시
+type Registry struct {
+	stores          map[string]domain.SecretStore
+	ttl             time.Duration
+	workspacePrefix string
+
+	mu    sync.Mutex
+	cache map[string]cachedSecret
+}
+
+// cachedSecret: 캐시된 비밀 값과 만료 시각
+type cachedSecret struct {
+	data      map[string]interface{}
+	expiresAt time.Time
+}
+
+// NewRegistry: 새로운 비밀 저장소 레지스트리를 생성합니다 (ttl이 0 이하이면 캐시하지 않음)
+// workspacePrefix는 워크스페이스가 참조할 수 있는 경로 접두사이며 비어 있으면 DefaultWorkspacePathPrefix를 사용합니다
+func NewRegistry(ttl time.Duration, workspacePrefix string, stores ...domain.SecretStore) *Registry {
+	if strings.TrimSpace(workspacePrefix) == "" {
+		workspacePrefix = DefaultWorkspacePathPrefix
+	}
+	r := &Registry{
+		stores:          make(map[string]domain.SecretStore, len(stores)),
+		ttl:             ttl,
+		workspacePrefix: workspacePrefix,
+		cache:           make(map[string]cachedSecret),
+	}
+	for _, store := range stores {
+		if store != nil {
+			r.stores[store.Name()] = store
+		}
+	}
+	return r
+}
+
+// Has: 저장소가 등록되어 있는지 확인합니다
+func (r *Registry) Has(name string) bool {
+	if r == nil {
+		return false
+	}
+	_, ok := r.stores[name]
+	return ok
+}
+
+// WorkspacePrefix: 워크스페이스가 참조할 수 있는 비밀 경로 접두사를 반환합니다
+func (r *Registry) WorkspacePrefix(workspaceID string) string {
+	return strings.Trim(strings.ReplaceAll(r.workspacePrefix, WorkspaceIDPlaceholder, workspaceID), "/")
+}
+
+// ScopePath: 비밀 경로를 정규화하고 워크스페이스 접두사 아래에 있는지 확인합니다
+// 다른 워크스페이스나 플랫폼 비밀을 참조하지 못하도록 접두사 밖의 경로는 거부합니다
+func (r *Registry) ScopePath(workspaceID, secretPath string) (string, error) {
+	cleaned, err := cleanSecretPath(secretPath)
+	if err != nil {
+		return "", err
+	}
+	prefix, err := cleanSecretPath(r.WorkspacePrefix(workspaceID))
+	if err != nil {
+		return "", fmt.Errorf("invalid workspace secret path prefix: %w", err)
+	}
+	if !strings.HasPrefix(cleaned, prefix+"/") {
+		return "", ErrSecretPathOutsideWorkspace
+	}
+	return cleaned, nil
+}
+
+// Resolve: 저장소에서 비밀을 조회합니다 (캐시 적중 시 저장소를 호출하지 않음)
+func (r *Registry) Resolve(ctx context.Context, storeName, secretPath string) (map[string]interface{}, error) {
+	if !r.Has(storeName) {
+		return nil, fmt.Errorf("secret store %q is not configured", storeName)
+	}
+
+	key := storeName + ":" + secretPath
+	now := time.Now()
+
+	r.mu.Lock()
+	if entry, ok := r.cache[key]; ok {
+		if now.Before(entry.expiresAt) {
+			r.mu.Unlock()
+			return copySecret(entry.data), nil
+		}
+		delete(r.cache, key)
+	}
+	r.mu.Unlock()
+
+	data, err := r.stores[storeName].GetSecret(ctx, secretPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.ttl > 0 {
+		r.mu.Lock()
+		r.evictExpired(now)
+		r.cache[key] = cachedSecret{data: copySecret(data), expiresAt: now.Add(r.ttl)}
+		r.mu.Unlock()
+	}
+
+	return copySecret(data), nil
+}
+
+// Invalidate: 캐시된 비밀을 제거합니다
+func (r *Registry) Invalidate(storeName, secretPath string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	delete(r.cache, storeName+":"+secretPath)
+	r.mu.Unlock()
+}
+
+// evictExpired: 만료된 캐시 항목을 정리합니다 (mu 보유 상태에서 호출)
+func (r *Registry) evictExpired(now time.Time) {
+	for key, entry := range r.cache {
+		if !now.Before(entry.expiresAt) {
+			delete(r.cache, key)
+		}
+	}
+}
+
+// copySecret: 호출자가 캐시 값을 수정하지 못하도록 최상위 맵을 복사합니다
+func copySecret(data map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		copied[k] = v
+	}
+	return copied
+}
+
+// cleanSecretPath: 비밀 경로를 정규화하고 상위 디렉터리 참조를 거부합니다
+func cleanSecretPath(secretPath string) (string, error) {
+	trimmed := strings.Trim(strings.TrimSpace(secretPath), "/")
+	if trimmed == "" {
+		return "", fmt.Errorf("secret path is required")
+	}
+	for _, segment := range strings.Split(trimmed, "/") {
+		if segment == ".." || segment == "." || segment == "" {
+			return "", fmt.Errorf("invalid secret path %q", secretPath)
+		}
+	}
+	return path.Clean(trimmed), nil
+}
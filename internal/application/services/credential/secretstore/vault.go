@@ -0,0 +1,139 @@
+package secretstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"skyclust/internal/domain"
+)
+
+const (
+	defaultVaultMount   = "secret"
+	defaultVaultTimeout = 10 * time.Second
+	maxVaultResponse    = 1 << 20
+)
+
+// VaultConfig: Vault KV v2 저장소 설정
+type VaultConfig struct {
+	Address   string
+	Token     string
+	Mount     string
+	Namespace string
+	Timeout   time.Duration
+}
+
+// VaultStore: HashiCorp Vault KV v2 비밀 저장소
+type VaultStore struct {
+	address   string
+	token     string
+	mount     string
+	namespace string
+	client    *http.Client
+}
+
+// vaultKVResponse: KV v2 읽기 응답
+type vaultKVResponse struct {
+	Data struct {
+		Data map[string]interface{} `json:"data"`
+	} `json:"data"`
+	Errors []string `json:"errors"`
+}
+
+// NewVaultStore: 새로운 Vault KV v2 저장소를 생성합니다
+func NewVaultStore(cfg VaultConfig) (*VaultStore, error) {
+	address := strings.TrimRight(cfg.Address, "/")
+	parsed, err := url.Parse(address)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid vault address %q", cfg.Address)
+	}
+	if cfg.Token == "" {
+		return nil, fmt.Errorf("vault token is required")
+	}
+
+	mount := strings.Trim(cfg.Mount, "/")
+	if mount == "" {
+		mount = defaultVaultMount
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultVaultTimeout
+	}
+
+	return &VaultStore{
+		address:   address,
+		token:     cfg.Token,
+		mount:     mount,
+		namespace: cfg.Namespace,
+		client:    &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// Name: 저장소 이름을 반환합니다
+func (v *VaultStore) Name() string {
+	return domain.SecretStoreVault
+}
+
+// GetSecret: KV v2 경로의 최신 버전 비밀을 조회합니다
+func (v *VaultStore) GetSecret(ctx context.Context, path string) (map[string]interface{}, error) {
+	secretPath, err := cleanSecretPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s/v1/%s/data/%s", v.address, v.mount, escapePath(secretPath))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build vault request: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+	req.Header.Set("Accept", "application/json")
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("vault request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxVaultResponse))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vault response: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, ErrSecretNotFound
+	case resp.StatusCode == http.StatusForbidden:
+		return nil, fmt.Errorf("vault denied access to %q", secretPath)
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("vault returned status %d", resp.StatusCode)
+	}
+
+	var kv vaultKVResponse
+	if err := json.Unmarshal(body, &kv); err != nil {
+		return nil, fmt.Errorf("invalid vault response: %w", err)
+	}
+	// 삭제된 최신 버전은 data가 null로 반환됨
+	if kv.Data.Data == nil {
+		return nil, ErrSecretNotFound
+	}
+
+	return kv.Data.Data, nil
+}
+
+// escapePath: 경로의 각 구간을 URL 인코딩합니다
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -3,12 +3,17 @@ package credential
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"skyclust/internal/application/services/common"
+	"skyclust/internal/application/services/credential/secretstore"
 	"skyclust/internal/domain"
 	"skyclust/internal/infrastructure/messaging"
 	"skyclust/pkg/logger"
 	"skyclust/pkg/security"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -17,12 +22,23 @@ import (
 	"google.golang.org/api/option"
 )
 
+// defaultReencryptBatchSize: 재암호화 작업의 기본 배치 크기
+const defaultReencryptBatchSize = 100
+
+// maxReencryptJobs: 상태를 보관하는 재암호화 작업의 최대 개수
+const maxReencryptJobs = 20
+
 // Service: 자격증명 비즈니스 로직 구현체
 type Service struct {
 	credentialRepo domain.CredentialRepository
 	auditLogRepo   domain.AuditLogRepository
 	encryptor      security.Encryptor
 	eventPublisher *messaging.Publisher
+	secretStores   *secretstore.Registry
+
+	// 백그라운드 재암호화 작업 상태
+	reencryptMu   sync.Mutex
+	reencryptJobs map[uuid.UUID]*domain.CredentialReencryptionJob
 }
 
 // NewService: 새로운 자격증명 서비스를 생성합니다
@@ -31,12 +47,15 @@ func NewService(
 	auditLogRepo domain.AuditLogRepository,
 	encryptor security.Encryptor,
 	eventPublisher *messaging.Publisher,
+	secretStores *secretstore.Registry,
 ) domain.CredentialService {
 	return &Service{
 		credentialRepo: credentialRepo,
 		auditLogRepo:   auditLogRepo,
 		encryptor:      encryptor,
 		eventPublisher: eventPublisher,
+		secretStores:   secretStores,
+		reencryptJobs:  make(map[uuid.UUID]*domain.CredentialReencryptionJob),
 	}
 }
 
@@ -48,32 +67,51 @@ func (s *Service) CreateCredential(ctx context.Context, workspaceID, createdBy u
 		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "unsupported provider", 400)
 	}
 
+	// 외부 비밀 저장소 참조는 저장소에서 조회한 값으로 검증
+	data := req.Data
+	if req.SecretRef != nil {
+		secretRef, secretData, err := s.resolveSecretReference(ctx, workspaceID, req.SecretRef)
+		if err != nil {
+			return nil, err
+		}
+		req.SecretRef = secretRef
+		data = secretData
+	} else if data == nil {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "either data or secret_ref is required", 400)
+	}
+
 	// Validate credential data based on provider
-	if err := validator.ValidateCredentialData(req.Provider, req.Data); err != nil {
+	if err := validator.ValidateCredentialData(req.Provider, data); err != nil {
 		return nil, err
 	}
 
 	// Additional validation for GCP credentials
 	if req.Provider == "gcp" {
-		if err := s.validateGCPCredentialAccess(ctx, req.Data); err != nil {
+		if err := s.validateGCPCredentialAccess(ctx, data); err != nil {
 			return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "GCP credential validation failed: "+err.Error(), 400)
 		}
 	}
 
-	// Encrypt credential data
-	encryptedData, err := s.EncryptCredentialData(ctx, req.Data)
-	if err != nil {
-		return nil, domain.NewDomainError(domain.ErrCodeInternalError, "failed to encrypt credential data", 500)
-	}
-
 	// Create credential
 	credential := &domain.Credential{
-		WorkspaceID:   workspaceID,
-		CreatedBy:     createdBy,
-		Provider:      req.Provider,
-		Name:          req.Name,
-		EncryptedData: encryptedData,
-		IsActive:      true,
+		WorkspaceID: workspaceID,
+		CreatedBy:   createdBy,
+		Provider:    req.Provider,
+		Name:        req.Name,
+		IsActive:    true,
+	}
+
+	if req.SecretRef != nil {
+		// 비밀 값은 저장하지 않고 참조만 보관
+		s.setSecretReference(credential, req.SecretRef)
+	} else {
+		// Encrypt credential data
+		encryptedData, err := s.EncryptCredentialData(ctx, data)
+		if err != nil {
+			return nil, domain.NewDomainError(domain.ErrCodeInternalError, "failed to encrypt credential data", 500)
+		}
+		credential.EncryptedData = encryptedData
+		credential.KeyVersion = s.keyVersion(encryptedData)
 	}
 
 	if err := s.credentialRepo.Create(credential); err != nil {
@@ -88,6 +126,7 @@ func (s *Service) CreateCredential(ctx context.Context, workspaceID, createdBy u
 			"workspace_id":  workspaceID,
 			"provider":      credential.Provider,
 			"name":          credential.Name,
+			"secret_store":  credential.SecretStore,
 		},
 	)
 
@@ -114,11 +153,14 @@ func (s *Service) GetCredentials(ctx context.Context, workspaceID uuid.UUID) ([]
 	}
 
 	// Decrypt and mask credential data for each credential
+	now := time.Now()
 	for _, credential := range credentials {
-		decryptedData, err := s.DecryptCredentialData(ctx, credential.EncryptedData)
+		credential.SetKeyAge(now)
+
+		decryptedData, err := s.ResolveCredentialData(ctx, credential)
 		if err != nil {
 			// Log error but don't fail the request, just skip masking
-			logger.DefaultLogger.GetLogger().Warn("Failed to decrypt credential for masking",
+			logger.DefaultLogger.GetLogger().Warn("Failed to resolve credential for masking",
 				zap.String("credential_id", credential.ID.String()),
 				zap.Error(err))
 			continue
@@ -146,6 +188,7 @@ func (s *Service) GetCredentialByID(ctx context.Context, workspaceID, credential
 		return nil, domain.NewDomainError(domain.ErrCodeForbidden, "access denied", 403)
 	}
 
+	credential.SetKeyAge(time.Now())
 	return credential, nil
 }
 
@@ -174,6 +217,25 @@ func (s *Service) UpdateCredential(ctx context.Context, workspaceID, credentialI
 		credential.Name = *req.Name
 	}
 
+	if req.Data != nil && req.SecretRef != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "data and secret_ref are mutually exclusive", 400)
+	}
+
+	if req.SecretRef != nil {
+		secretRef, secretData, err := s.resolveSecretReference(ctx, workspaceID, req.SecretRef)
+		if err != nil {
+			return nil, err
+		}
+
+		validator := common.NewCredentialValidator()
+		if err := validator.ValidateCredentialData(credential.Provider, secretData); err != nil {
+			return nil, err
+		}
+
+		s.invalidateSecretCache(credential)
+		s.setSecretReference(credential, secretRef)
+	}
+
 	if req.Data != nil {
 		// Validate credential data
 		validator := common.NewCredentialValidator()
@@ -186,7 +248,13 @@ func (s *Service) UpdateCredential(ctx context.Context, workspaceID, credentialI
 		if err != nil {
 			return nil, domain.NewDomainError(domain.ErrCodeInternalError, "failed to encrypt credential data", 500)
 		}
+
+		// 외부 참조에서 내장 데이터로 전환
+		s.invalidateSecretCache(credential)
+		credential.SecretStore = ""
+		credential.SecretPath = ""
 		credential.EncryptedData = encryptedData
+		credential.KeyVersion = s.keyVersion(encryptedData)
 	}
 
 	// Save updated credential
@@ -201,6 +269,7 @@ func (s *Service) UpdateCredential(ctx context.Context, workspaceID, credentialI
 			"credential_id": credential.ID,
 			"workspace_id":  workspaceID,
 			"provider":      credential.Provider,
+			"secret_store":  credential.SecretStore,
 		},
 	)
 
@@ -231,6 +300,7 @@ func (s *Service) DeleteCredential(ctx context.Context, workspaceID, credentialI
 	if err := s.credentialRepo.Delete(credentialID); err != nil {
 		return domain.NewDomainError(domain.ErrCodeInternalError, "failed to delete credential", 500)
 	}
+	s.invalidateSecretCache(credential)
 
 	// Log credential deletion
 	common.LogAction(ctx, s.auditLogRepo, &credential.CreatedBy, domain.ActionCredentialDelete,
@@ -301,6 +371,340 @@ func (s *Service) DecryptCredentialData(ctx context.Context, encryptedData []byt
 	return data, nil
 }
 
+// ResolveCredentialData: 자격증명 데이터를 반환합니다 (외부 비밀 저장소 참조는 조회, 그 외는 복호화)
+func (s *Service) ResolveCredentialData(ctx context.Context, credential *domain.Credential) (map[string]interface{}, error) {
+	if credential == nil {
+		return nil, domain.NewDomainError(domain.ErrCodeBadRequest, "credential is required", 400)
+	}
+	if !credential.HasExternalSecret() {
+		return s.DecryptCredentialData(ctx, credential.EncryptedData)
+	}
+
+	if !s.secretStores.Has(credential.SecretStore) {
+		return nil, domain.NewDomainError(domain.ErrCodeServiceUnavailable, "secret store is not configured", 503).
+			WithDetails("secret_store", credential.SecretStore)
+	}
+
+	// 접두사 설정 이전에 등록된 참조도 사용 시점에 워크스페이스 범위를 확인
+	if _, err := s.secretStores.ScopePath(credential.WorkspaceID.String(), credential.SecretPath); err != nil {
+		logger.DefaultLogger.GetLogger().Warn("Credential secret reference is outside the workspace prefix",
+			zap.String("credential_id", credential.ID.String()),
+			zap.String("secret_store", credential.SecretStore),
+			zap.Error(err))
+		return nil, domain.NewDomainError(domain.ErrCodeForbidden, "credential secret reference is outside the workspace prefix", 403).
+			WithDetails("secret_store", credential.SecretStore)
+	}
+
+	data, err := s.secretStores.Resolve(ctx, credential.SecretStore, credential.SecretPath)
+	if err != nil {
+		logger.DefaultLogger.GetLogger().Warn("Failed to resolve credential from secret store",
+			zap.String("credential_id", credential.ID.String()),
+			zap.String("secret_store", credential.SecretStore),
+			zap.Error(err))
+		if errors.Is(err, secretstore.ErrSecretNotFound) {
+			return nil, domain.NewDomainError(domain.ErrCodeNotFound, "credential secret not found in secret store", 404).
+				WithDetails("secret_store", credential.SecretStore)
+		}
+		return nil, domain.NewDomainError(domain.ErrCodeServiceUnavailable, "failed to resolve credential from secret store", 503).
+			WithDetails("secret_store", credential.SecretStore)
+	}
+	return data, nil
+}
+
+// resolveSecretReference: 생성/수정 요청의 비밀 참조를 조회합니다
+// 경로는 워크스페이스 접두사 아래로 제한되며, 정규화된 경로의 참조를 함께 반환합니다
+func (s *Service) resolveSecretReference(ctx context.Context, workspaceID uuid.UUID, ref *domain.SecretReference) (*domain.SecretReference, map[string]interface{}, error) {
+	if !s.secretStores.Has(ref.Store) {
+		return nil, nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "secret store is not configured", 400).
+			WithDetails("secret_store", ref.Store)
+	}
+
+	secretPath, err := s.secretStores.ScopePath(workspaceID.String(), ref.Path)
+	if err != nil {
+		return nil, nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "secret path must be under the workspace prefix", 400).
+			WithDetails("secret_store", ref.Store).
+			WithDetails("allowed_prefix", s.secretStores.WorkspacePrefix(workspaceID.String())+"/")
+	}
+
+	data, err := s.secretStores.Resolve(ctx, ref.Store, secretPath)
+	if err != nil {
+		logger.DefaultLogger.GetLogger().Warn("Failed to read secret reference from secret store",
+			zap.String("workspace_id", workspaceID.String()),
+			zap.String("secret_store", ref.Store),
+			zap.String("secret_path", secretPath),
+			zap.Error(err))
+		if errors.Is(err, secretstore.ErrSecretNotFound) {
+			return nil, nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "secret not found in secret store", 400).
+				WithDetails("secret_store", ref.Store)
+		}
+		return nil, nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "failed to read secret from secret store", 400).
+			WithDetails("secret_store", ref.Store)
+	}
+	return &domain.SecretReference{Store: ref.Store, Path: secretPath}, data, nil
+}
+
+// setSecretReference: 자격증명을 외부 비밀 저장소 참조로 설정합니다 (resolveSecretReference로 검증된 참조만 전달)
+func (s *Service) setSecretReference(credential *domain.Credential, ref *domain.SecretReference) {
+	credential.SecretStore = ref.Store
+	credential.SecretPath = ref.Path
+	credential.EncryptedData = []byte{}
+	credential.KeyVersion = ""
+}
+
+// invalidateSecretCache: 자격증명이 참조하던 비밀의 캐시를 제거합니다
+func (s *Service) invalidateSecretCache(credential *domain.Credential) {
+	if credential.HasExternalSecret() {
+		s.secretStores.Invalidate(credential.SecretStore, credential.SecretPath)
+	}
+}
+
+// GetEncryptionStatus: 마스터 키 버전별 자격증명 수를 조회합니다
+func (s *Service) GetEncryptionStatus(ctx context.Context) (*domain.CredentialEncryptionStatus, error) {
+	counts, err := s.credentialRepo.CountByKeyVersion()
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, "failed to count credentials by key version", 500)
+	}
+
+	primary, configured := s.keyRing()
+	configuredSet := make(map[string]bool, len(configured))
+	for _, id := range configured {
+		configuredSet[id] = true
+	}
+
+	status := &domain.CredentialEncryptionStatus{
+		PrimaryKeyID:   primary,
+		ConfiguredKeys: configured,
+		Versions:       make([]domain.CredentialKeyVersionCount, 0, len(counts)),
+	}
+	for version, count := range counts {
+		status.Versions = append(status.Versions, domain.CredentialKeyVersionCount{
+			KeyVersion: version,
+			Count:      count,
+			Primary:    version == primary,
+			Configured: configuredSet[version] || version == security.LegacyKeyVersion,
+		})
+		status.Total += count
+		if version != primary {
+			status.PendingReencryption += count
+		}
+	}
+	sort.Slice(status.Versions, func(i, j int) bool {
+		return status.Versions[i].KeyVersion < status.Versions[j].KeyVersion
+	})
+
+	return status, nil
+}
+
+// StartReencryption: 최신(primary) 마스터 키로의 재암호화 작업을 백그라운드에서 시작하고 작업 상태를 반환합니다
+// 한 번에 하나의 작업만 실행할 수 있습니다
+func (s *Service) StartReencryption(ctx context.Context, actorID *uuid.UUID, batchSize int) (*domain.CredentialReencryptionJob, error) {
+	primary, _ := s.keyRing()
+	if primary == security.LegacyKeyVersion {
+		return nil, domain.NewDomainError(domain.ErrCodeBadRequest, "envelope encryption is not configured", 400)
+	}
+	if batchSize <= 0 {
+		batchSize = defaultReencryptBatchSize
+	}
+
+	s.reencryptMu.Lock()
+	defer s.reencryptMu.Unlock()
+
+	for _, job := range s.reencryptJobs {
+		if job.Status == domain.CredentialReencryptionRunning {
+			return nil, domain.NewDomainError(domain.ErrCodeConflict, "a re-encryption job is already running", 409).
+				WithDetails("job_id", job.ID.String())
+		}
+	}
+
+	now := time.Now()
+	job := &domain.CredentialReencryptionJob{
+		ID:          uuid.New(),
+		Status:      domain.CredentialReencryptionRunning,
+		BatchSize:   batchSize,
+		RequestedBy: actorID,
+		Result: domain.CredentialReencryptionResult{
+			PrimaryKeyID: primary,
+			StartedAt:    now,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	s.reencryptJobs[job.ID] = job
+	s.pruneReencryptJobs()
+
+	// 감사 로그용 요청 메타데이터는 유지하되 HTTP 요청이 끝나도 취소되지 않도록 분리
+	go s.runReencryption(context.WithoutCancel(ctx), job)
+
+	return snapshotReencryptJob(job), nil
+}
+
+// GetReencryptionJob: 재암호화 작업의 상태와 진행 상황을 조회합니다
+func (s *Service) GetReencryptionJob(ctx context.Context, jobID uuid.UUID) (*domain.CredentialReencryptionJob, error) {
+	s.reencryptMu.Lock()
+	defer s.reencryptMu.Unlock()
+
+	job, ok := s.reencryptJobs[jobID]
+	if !ok {
+		return nil, domain.NewDomainError(domain.ErrCodeNotFound, "re-encryption job not found", 404)
+	}
+	return snapshotReencryptJob(job), nil
+}
+
+// runReencryption: 재암호화 작업을 실행하고 최종 상태를 기록합니다
+func (s *Service) runReencryption(ctx context.Context, job *domain.CredentialReencryptionJob) {
+	err := s.reencryptCredentials(job)
+
+	s.reencryptMu.Lock()
+	job.Result.CompletedAt = time.Now()
+	job.UpdatedAt = job.Result.CompletedAt
+	job.Status = domain.CredentialReencryptionCompleted
+	if err != nil {
+		job.Status = domain.CredentialReencryptionFailed
+		job.Error = err.Error()
+	}
+	final := snapshotReencryptJob(job)
+	s.reencryptMu.Unlock()
+
+	if err != nil {
+		logger.DefaultLogger.GetLogger().Error("Credential re-encryption job failed",
+			zap.String("job_id", final.ID.String()),
+			zap.Error(err))
+	} else {
+		logger.DefaultLogger.GetLogger().Info("Credential re-encryption job completed",
+			zap.String("job_id", final.ID.String()),
+			zap.String("primary_key_id", final.Result.PrimaryKeyID),
+			zap.Int("reencrypted", final.Result.Reencrypted),
+			zap.Int("failed", final.Result.Failed))
+	}
+
+	if final.RequestedBy != nil {
+		common.LogAction(ctx, s.auditLogRepo, final.RequestedBy, domain.ActionCredentialReencrypt,
+			"POST /api/v1/admin/credentials/reencrypt",
+			map[string]interface{}{
+				"job_id":         final.ID.String(),
+				"status":         final.Status,
+				"primary_key_id": final.Result.PrimaryKeyID,
+				"scanned":        final.Result.Scanned,
+				"reencrypted":    final.Result.Reencrypted,
+				"skipped":        final.Result.Skipped,
+				"failed":         final.Result.Failed,
+			},
+		)
+	}
+}
+
+// reencryptCredentials: primary 키가 아닌 자격증명을 배치 단위로 재암호화하며 작업 진행 상황을 갱신합니다
+// 실패한 자격증명은 결과에 기록하고 계속 진행합니다
+func (s *Service) reencryptCredentials(job *domain.CredentialReencryptionJob) error {
+	primary := job.Result.PrimaryKeyID
+	afterID := uuid.Nil
+	for {
+		credentials, err := s.credentialRepo.ListNotOnKeyVersion(primary, afterID, job.BatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list credentials for re-encryption: %w", err)
+		}
+		if len(credentials) == 0 {
+			return nil
+		}
+
+		for _, credential := range credentials {
+			afterID = credential.ID
+			updated, err := s.reencryptCredential(credential, primary)
+
+			s.reencryptMu.Lock()
+			job.Result.Scanned++
+			switch {
+			case err != nil:
+				job.Result.Failed++
+				job.Result.Failures = append(job.Result.Failures, domain.CredentialReencryptionFailure{
+					CredentialID: credential.ID,
+					KeyVersion:   s.keyVersion(credential.EncryptedData),
+					Error:        err.Error(),
+				})
+			case updated:
+				job.Result.Reencrypted++
+			default:
+				job.Result.Skipped++
+			}
+			job.UpdatedAt = time.Now()
+			s.reencryptMu.Unlock()
+
+			if err != nil {
+				logger.DefaultLogger.GetLogger().Warn("Failed to re-encrypt credential",
+					zap.String("credential_id", credential.ID.String()),
+					zap.Error(err))
+			}
+		}
+
+		if len(credentials) < job.BatchSize {
+			return nil
+		}
+	}
+}
+
+// reencryptCredential: 단일 자격증명을 복호화한 뒤 primary 키로 다시 암호화합니다
+// 작업 중 수정/삭제되어 갱신하지 않은 경우 false를 반환합니다
+func (s *Service) reencryptCredential(credential *domain.Credential, primary string) (bool, error) {
+	plaintext, err := s.encryptor.Decrypt(credential.EncryptedData)
+	if err != nil {
+		return false, fmt.Errorf("decryption failed: %w", err)
+	}
+
+	encrypted, err := s.encryptor.Encrypt(plaintext)
+	if err != nil {
+		return false, fmt.Errorf("encryption failed: %w", err)
+	}
+
+	updated, err := s.credentialRepo.UpdateEncryptedData(credential.ID, credential.EncryptedData, encrypted, primary)
+	if err != nil {
+		return false, fmt.Errorf("update failed: %w", err)
+	}
+	// 갱신되지 않았다면 이미 최신 키로 저장되었거나 존재하지 않음
+	return updated, nil
+}
+
+// pruneReencryptJobs: 보관 개수를 넘으면 가장 오래된 완료 작업부터 제거합니다 (reencryptMu를 잡은 상태에서 호출)
+func (s *Service) pruneReencryptJobs() {
+	for len(s.reencryptJobs) > maxReencryptJobs {
+		var oldest *domain.CredentialReencryptionJob
+		for _, job := range s.reencryptJobs {
+			if job.Status == domain.CredentialReencryptionRunning {
+				continue
+			}
+			if oldest == nil || job.CreatedAt.Before(oldest.CreatedAt) {
+				oldest = job
+			}
+		}
+		if oldest == nil {
+			return
+		}
+		delete(s.reencryptJobs, oldest.ID)
+	}
+}
+
+// snapshotReencryptJob: 실행 중인 작업과 공유하지 않는 작업 상태의 복사본을 반환합니다
+func snapshotReencryptJob(job *domain.CredentialReencryptionJob) *domain.CredentialReencryptionJob {
+	snapshot := *job
+	snapshot.Result.Failures = append([]domain.CredentialReencryptionFailure(nil), job.Result.Failures...)
+	return &snapshot
+}
+
+// keyVersion: 암호문의 마스터 키 버전을 반환합니다
+func (s *Service) keyVersion(encryptedData []byte) string {
+	if versioner, ok := s.encryptor.(security.KeyVersioner); ok {
+		return versioner.KeyVersion(encryptedData)
+	}
+	return security.LegacyKeyVersion
+}
+
+// keyRing: primary 키와 설정된 키 목록을 반환합니다
+func (s *Service) keyRing() (string, []string) {
+	if versioner, ok := s.encryptor.(security.KeyVersioner); ok {
+		return versioner.PrimaryKeyID(), versioner.KeyIDs()
+	}
+	return security.LegacyKeyVersion, []string{}
+}
+
 // min: 두 정수 중 작은 값을 반환합니다
 func min(a, b int) int {
 	if a < b {
@@ -0,0 +1,333 @@
+package credential
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"skyclust/internal/application/services/common"
+	"skyclust/internal/domain"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/google/uuid"
+	"google.golang.org/api/iamcredentials/v1"
+	"google.golang.org/api/option"
+)
+
+const (
+	// awsMaxChainedSessionSeconds: 역할 체이닝으로 얻은 세션의 최대 유효 시간 (AWS 제약)
+	awsMaxChainedSessionSeconds = 3600
+
+	gcpReadOnlyScope = "https://www.googleapis.com/auth/cloud-platform.read-only"
+)
+
+// profileNamePattern: CLI 프로필 이름에 허용되지 않는 문자
+var profileNamePattern = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// VendCredential: 워크스페이스 자격증명으로 최종 사용자용 단기 자격증명을 발급합니다
+// AWS는 세션 정책으로 범위를 줄인 STS 세션, GCP는 서비스 계정 가장 액세스 토큰을 발급하며 모든 발급은 감사 로그에 기록됩니다
+func (s *Service) VendCredential(ctx context.Context, workspaceID, credentialID, requesterID uuid.UUID, req domain.CredentialVendRequest) (*domain.VendedCredential, error) {
+	credential, err := s.GetCredentialByID(ctx, workspaceID, credentialID)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, ok := featureCatalog(credential.Provider)
+	if !ok {
+		return nil, domain.NewDomainError(domain.ErrCodeNotSupported,
+			fmt.Sprintf("credential vending is not supported for provider %s", credential.Provider), 400)
+	}
+
+	access := req.Access
+	if access == "" {
+		access = domain.VendAccessReadOnly
+	}
+	if access != domain.VendAccessReadOnly && access != domain.VendAccessFull {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "access must be read_only or full", 400)
+	}
+
+	selected, err := selectFeatures(catalog, req.Features)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := req.DurationSeconds
+	if duration == 0 {
+		duration = domain.DefaultVendDurationSeconds
+	}
+	if duration < domain.MinVendDurationSeconds || duration > domain.MaxVendDurationSeconds {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed,
+			fmt.Sprintf("duration_seconds must be between %d and %d", domain.MinVendDurationSeconds, domain.MaxVendDurationSeconds), 400)
+	}
+
+	vended := &domain.VendedCredential{
+		CredentialID: credential.ID.String(),
+		Provider:     credential.Provider,
+		Access:       access,
+		Formats:      make(map[string]string),
+	}
+	for _, definition := range selected {
+		vended.Features = append(vended.Features, definition.feature)
+	}
+
+	data, err := s.ResolveCredentialData(ctx, credential)
+	if err == nil {
+		switch credential.Provider {
+		case domain.ProviderAWS:
+			err = s.vendAWSCredentials(ctx, data, selected, duration, requesterID, credential.Name, vended)
+		case domain.ProviderGCP:
+			err = s.vendGCPToken(ctx, data, duration, vended)
+		}
+	}
+
+	s.logCredentialVend(ctx, requesterID, credential, vended, duration, err)
+	if err != nil {
+		return nil, err
+	}
+	return vended, nil
+}
+
+// vendAWSCredentials: 세션 정책을 적용한 STS 세션을 발급합니다
+// AssumeRole 자격증명은 최종 역할을 다시 assume 하고, 액세스 키 자격증명은 GetFederationToken을 사용합니다
+func (s *Service) vendAWSCredentials(ctx context.Context, data map[string]interface{}, selected []featureDefinition, duration int32, requesterID uuid.UUID, credentialName string, vended *domain.VendedCredential) error {
+	source, err := common.ParseAWSCredentialData(data, awsHealthCheckRegion)
+	if err != nil {
+		return err
+	}
+
+	policyDocument, actions := buildAWSPolicyDocument(selected, vendActions(selected, vended.Access))
+	if len(actions) == 0 {
+		return domain.NewDomainError(domain.ErrCodeValidationFailed, "no actions are available for the requested features and access", 400)
+	}
+	policy, err := json.Marshal(policyDocument)
+	if err != nil {
+		return domain.NewDomainError(domain.ErrCodeInternalError, "failed to encode session policy", 500)
+	}
+
+	var stsCredentials *ststypes.Credentials
+	if source.UsesAssumeRole() {
+		// 최종 역할 직전까지의 체인으로 세션 정책을 붙여 최종 역할을 assume
+		parent := *source
+		parent.Roles = source.Roles[:len(source.Roles)-1]
+		if len(parent.Roles) > 0 && duration > awsMaxChainedSessionSeconds {
+			duration = awsMaxChainedSessionSeconds
+		}
+		cfg, err := common.NewAWSConfig(ctx, &parent)
+		if err != nil {
+			return err
+		}
+
+		target := source.Roles[len(source.Roles)-1]
+		input := &sts.AssumeRoleInput{
+			RoleArn:         aws.String(target.RoleARN),
+			RoleSessionName: aws.String(vendSessionName(requesterID, 64)),
+			Policy:          aws.String(string(policy)),
+			DurationSeconds: aws.Int32(duration),
+		}
+		if target.ExternalID != "" {
+			input.ExternalId = aws.String(target.ExternalID)
+		}
+		output, err := sts.NewFromConfig(cfg).AssumeRole(ctx, input)
+		if err != nil {
+			return vendAWSError("sts:AssumeRole", err)
+		}
+		stsCredentials = output.Credentials
+		if output.AssumedRoleUser != nil {
+			vended.Principal = aws.ToString(output.AssumedRoleUser.Arn)
+		}
+	} else {
+		if source.SessionToken != "" {
+			return domain.NewDomainError(domain.ErrCodeValidationFailed, "credentials with a session token cannot vend federated sessions", 400)
+		}
+		cfg, err := common.NewAWSConfig(ctx, source)
+		if err != nil {
+			return err
+		}
+		output, err := sts.NewFromConfig(cfg).GetFederationToken(ctx, &sts.GetFederationTokenInput{
+			Name:            aws.String(vendSessionName(requesterID, 32)),
+			Policy:          aws.String(string(policy)),
+			DurationSeconds: aws.Int32(duration),
+		})
+		if err != nil {
+			return vendAWSError("sts:GetFederationToken", err)
+		}
+		stsCredentials = output.Credentials
+		if output.FederatedUser != nil {
+			vended.Principal = aws.ToString(output.FederatedUser.Arn)
+		}
+	}
+
+	if stsCredentials == nil {
+		return domain.NewDomainError(domain.ErrCodeProviderError, "STS returned no credentials", 502)
+	}
+
+	vended.DurationSeconds = duration
+	vended.ExpiresAt = aws.ToTime(stsCredentials.Expiration)
+	vended.AWS = &domain.VendedAWSCredentials{
+		AccessKeyID:     aws.ToString(stsCredentials.AccessKeyId),
+		SecretAccessKey: aws.ToString(stsCredentials.SecretAccessKey),
+		SessionToken:    aws.ToString(stsCredentials.SessionToken),
+		Region:          source.Region,
+	}
+
+	profile := vendProfileName(credentialName)
+	vended.Formats[domain.VendFormatEnv] = strings.Join([]string{
+		"export AWS_ACCESS_KEY_ID=" + shellQuote(vended.AWS.AccessKeyID),
+		"export AWS_SECRET_ACCESS_KEY=" + shellQuote(vended.AWS.SecretAccessKey),
+		"export AWS_SESSION_TOKEN=" + shellQuote(vended.AWS.SessionToken),
+		"export AWS_REGION=" + shellQuote(vended.AWS.Region),
+	}, "\n")
+	vended.Formats[domain.VendFormatAWSConfigure] = strings.Join([]string{
+		fmt.Sprintf("aws configure set aws_access_key_id %s --profile %s", shellQuote(vended.AWS.AccessKeyID), profile),
+		fmt.Sprintf("aws configure set aws_secret_access_key %s --profile %s", shellQuote(vended.AWS.SecretAccessKey), profile),
+		fmt.Sprintf("aws configure set aws_session_token %s --profile %s", shellQuote(vended.AWS.SessionToken), profile),
+		fmt.Sprintf("aws configure set region %s --profile %s", shellQuote(vended.AWS.Region), profile),
+	}, "\n")
+	return nil
+}
+
+// vendGCPToken: IAM Credentials API로 서비스 계정 가장 액세스 토큰을 발급합니다
+// GCP 토큰은 기능 단위로 범위를 줄일 수 없어 read_only는 읽기 전용 OAuth 범위로 제한합니다
+func (s *Service) vendGCPToken(ctx context.Context, data map[string]interface{}, duration int32, vended *domain.VendedCredential) error {
+	tokenSource, projectID, clientEmail, err := gcpServiceAccountTokenSource(ctx, data)
+	if err != nil {
+		return domain.NewDomainError(domain.ErrCodeProviderAuth, err.Error(), 502)
+	}
+
+	// impersonate_service_account가 있으면 해당 서비스 계정을 가장
+	target := clientEmail
+	if impersonate, _ := data["impersonate_service_account"].(string); impersonate != "" {
+		target = impersonate
+	}
+
+	scope := gcpCloudPlatformScope
+	if vended.Access == domain.VendAccessReadOnly {
+		scope = gcpReadOnlyScope
+	}
+	if duration > domain.MaxGCPVendDurationSeconds {
+		duration = domain.MaxGCPVendDurationSeconds
+	}
+
+	credentialsService, err := iamcredentials.NewService(ctx, option.WithTokenSource(tokenSource))
+	if err != nil {
+		return domain.NewDomainError(domain.ErrCodeProviderError, fmt.Sprintf("failed to create GCP IAM credentials client: %v", err), 502)
+	}
+	response, err := credentialsService.Projects.ServiceAccounts.GenerateAccessToken(
+		"projects/-/serviceAccounts/"+target,
+		&iamcredentials.GenerateAccessTokenRequest{
+			Scope:    []string{scope},
+			Lifetime: fmt.Sprintf("%ds", duration),
+		},
+	).Context(ctx).Do()
+	if err != nil {
+		if isGoogleAPIStatus(err, http.StatusForbidden) {
+			return domain.NewDomainError(domain.ErrCodeForbidden,
+				fmt.Sprintf("service account %s cannot create access tokens for %s", clientEmail, target), 403).
+				WithDetails("required_permission", "iam.serviceAccounts.getAccessToken")
+		}
+		return domain.NewDomainError(domain.ErrCodeProviderError, fmt.Sprintf("failed to generate GCP access token: %v", err), 502)
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, response.ExpireTime)
+	if err != nil {
+		expiresAt = time.Now().Add(time.Duration(duration) * time.Second)
+	}
+
+	vended.Principal = target
+	vended.DurationSeconds = duration
+	vended.ExpiresAt = expiresAt
+	vended.GCP = &domain.VendedGCPToken{
+		AccessToken:    response.AccessToken,
+		ProjectID:      projectID,
+		ServiceAccount: target,
+		Scopes:         []string{scope},
+	}
+	vended.Formats[domain.VendFormatEnv] = strings.Join([]string{
+		"export CLOUDSDK_AUTH_ACCESS_TOKEN=" + shellQuote(response.AccessToken),
+		"export GOOGLE_OAUTH_ACCESS_TOKEN=" + shellQuote(response.AccessToken),
+		"export CLOUDSDK_CORE_PROJECT=" + shellQuote(projectID),
+	}, "\n")
+	vended.Formats[domain.VendFormatGcloud] = strings.Join([]string{
+		"gcloud config set project " + shellQuote(projectID),
+		"export CLOUDSDK_AUTH_ACCESS_TOKEN=" + shellQuote(response.AccessToken),
+	}, "\n")
+	return nil
+}
+
+// logCredentialVend: 발급 요청자, 기간, 결과를 감사 로그에 기록합니다 (비밀 값은 기록하지 않음)
+func (s *Service) logCredentialVend(ctx context.Context, requesterID uuid.UUID, credential *domain.Credential, vended *domain.VendedCredential, duration int32, vendErr error) {
+	details := map[string]interface{}{
+		"credential_id":    credential.ID,
+		"workspace_id":     credential.WorkspaceID,
+		"provider":         credential.Provider,
+		"requester_id":     requesterID,
+		"access":           vended.Access,
+		"features":         vended.Features,
+		"duration_seconds": duration,
+	}
+	if vendErr != nil {
+		details["status"] = "failed"
+		details["error"] = vendErr.Error()
+	} else {
+		details["status"] = "issued"
+		details["duration_seconds"] = vended.DurationSeconds
+		details["principal"] = vended.Principal
+		details["expires_at"] = vended.ExpiresAt
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &requesterID, domain.ActionCredentialVend,
+		"POST /api/v1/credentials/"+credential.ID.String()+"/vend", details)
+}
+
+// vendActions: 권한 수준에 맞는 기능별 액션을 고릅니다
+func vendActions(selected []featureDefinition, access string) map[string][]string {
+	actionsByFeature := make(map[string][]string, len(selected))
+	for _, definition := range selected {
+		for _, action := range definition.actions {
+			if access == domain.VendAccessReadOnly && !action.ReadOnly {
+				continue
+			}
+			actionsByFeature[definition.feature] = append(actionsByFeature[definition.feature], action.Action)
+		}
+	}
+	return actionsByFeature
+}
+
+// vendAWSError: STS 발급 오류를 도메인 오류로 변환합니다
+func vendAWSError(action string, err error) error {
+	if isAWSAccessDenied(err) {
+		return domain.NewDomainError(domain.ErrCodeForbidden,
+			fmt.Sprintf("credential is not allowed to call %s", action), 403).
+			WithDetails("required_action", action)
+	}
+	return domain.NewDomainError(domain.ErrCodeProviderError, "failed to issue AWS session: "+awsErrorMessage(err), 502)
+}
+
+// vendSessionName: CloudTrail에서 요청자를 식별할 수 있는 세션 이름을 만듭니다
+func vendSessionName(requesterID uuid.UUID, maxLength int) string {
+	name := "skyclust-" + strings.ReplaceAll(requesterID.String(), "-", "")
+	if len(name) > maxLength {
+		name = name[:maxLength]
+	}
+	return name
+}
+
+// vendProfileName: 자격증명 이름으로 CLI 프로필 이름을 만듭니다
+func vendProfileName(credentialName string) string {
+	name := strings.Trim(profileNamePattern.ReplaceAllString(strings.ToLower(credentialName), "-"), "-")
+	if name == "" {
+		return "skyclust"
+	}
+	return "skyclust-" + name
+}
+
+// shellQuote: 셸 명령에 안전하게 넣을 수 있도록 작은따옴표로 감쌉니다
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -38,6 +38,8 @@ type CreateClusterResponse struct {
 	ProjectID string            `json:"project_id,omitempty"` // GCP project ID
 	Tags      map[string]string `json:"tags,omitempty"`
 	CreatedAt string            `json:"created_at"`
+	// OperationID: 생성 진행 상황을 추적하는 작업 ID (GET /workspaces/:id/operations/:operationId)
+	OperationID string `json:"operation_id,omitempty"`
 }
 
 // ListClustersRequest represents a request to list clusters
@@ -168,6 +170,7 @@ type CreateNodeGroupResponse struct {
 	ScalingConfig NodeGroupScalingConfig `json:"scaling_config"`
 	Tags          map[string]string      `json:"tags,omitempty"`
 	CreatedAt     string                 `json:"created_at"`
+	OperationID   string                 `json:"operation_id,omitempty"` // 생성 진행 상황을 추적하는 작업 ID
 }
 
 // ListNodeGroupsRequest represents a request to list node groups
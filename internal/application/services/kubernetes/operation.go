@@ -0,0 +1,228 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"skyclust/internal/domain"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/api/container/v1"
+)
+
+// startOperation: 클라우드에서 비동기로 진행되는 작업을 등록하고 작업 ID를 반환합니다
+// 클라우드 요청은 이미 수락되었으므로 등록에 실패해도 요청을 실패로 처리하지 않고 빈 ID를 반환합니다
+func (s *Service) startOperation(ctx context.Context, credential *domain.Credential, operation *domain.Operation) string {
+	if s.operationService == nil {
+		return ""
+	}
+	operation.WorkspaceID = credential.WorkspaceID.String()
+	operation.CredentialID = credential.ID.String()
+	operation.Provider = credential.Provider
+
+	started, err := s.operationService.Start(ctx, operation)
+	if err != nil {
+		s.logger.Warn("Failed to record Kubernetes operation",
+			zap.String("type", operation.Type),
+			zap.String("target_id", operation.TargetID),
+			zap.Error(err))
+		return ""
+	}
+	return started.ID.String()
+}
+
+// TrackOperation: EKS 클러스터/노드 그룹 상태 또는 GKE 작업 상태를 조회합니다 (domain.OperationTracker 구현)
+func (s *Service) TrackOperation(ctx context.Context, operation *domain.Operation) (*domain.OperationProgress, error) {
+	credentialID, err := uuid.Parse(operation.CredentialID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credential id: %s", operation.CredentialID)
+	}
+	credential, err := s.credentialService.GetCredentialByIDDirect(ctx, credentialID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch credential.Provider {
+	case "aws":
+		switch operation.TargetType {
+		case domain.OperationTargetCluster:
+			return s.trackEKSCluster(ctx, credential, operation)
+		case domain.OperationTargetNodeGroup:
+			return s.trackEKSNodeGroup(ctx, credential, operation)
+		}
+	case "gcp":
+		return s.trackGKEOperation(ctx, credential, operation)
+	}
+	return nil, fmt.Errorf("unsupported %s operation target: %s", credential.Provider, operation.TargetType)
+}
+
+// trackEKSCluster: EKS 클러스터 상태로 생성/삭제 진행 상황을 판단합니다
+func (s *Service) trackEKSCluster(ctx context.Context, credential *domain.Credential, operation *domain.Operation) (*domain.OperationProgress, error) {
+	cfg, err := s.createAWSConfigForRegion(ctx, credential, operation.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := eks.NewFromConfig(cfg).DescribeCluster(ctx, &eks.DescribeClusterInput{
+		Name: aws.String(operationDetail(operation, "cluster_name")),
+	})
+	if err != nil {
+		var notFound *types.ResourceNotFoundException
+		if errors.As(err, &notFound) && operation.Type == domain.OperationClusterDelete {
+			return &domain.OperationProgress{Status: domain.OperationSucceeded, ProviderStatus: "DELETED"}, nil
+		}
+		return nil, err
+	}
+
+	var issues []string
+	if output.Cluster.Health != nil {
+		for _, issue := range output.Cluster.Health.Issues {
+			issues = append(issues, fmt.Sprintf("%s: %s", issue.Code, aws.ToString(issue.Message)))
+		}
+	}
+
+	status := output.Cluster.Status
+	return eksProgress(operation, string(status),
+		status == types.ClusterStatusActive,
+		status == types.ClusterStatusFailed,
+		issues,
+	), nil
+}
+
+// trackEKSNodeGroup: EKS 노드 그룹 상태로 생성/삭제 진행 상황을 판단합니다
+func (s *Service) trackEKSNodeGroup(ctx context.Context, credential *domain.Credential, operation *domain.Operation) (*domain.OperationProgress, error) {
+	cfg, err := s.createAWSConfigForRegion(ctx, credential, operation.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := eks.NewFromConfig(cfg).DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(operationDetail(operation, "cluster_name")),
+		NodegroupName: aws.String(operationDetail(operation, "node_group_name")),
+	})
+	if err != nil {
+		var notFound *types.ResourceNotFoundException
+		if errors.As(err, &notFound) && operation.Type == domain.OperationNodeGroupDelete {
+			return &domain.OperationProgress{Status: domain.OperationSucceeded, ProviderStatus: "DELETED"}, nil
+		}
+		return nil, err
+	}
+
+	var issues []string
+	if output.Nodegroup.Health != nil {
+		for _, issue := range output.Nodegroup.Health.Issues {
+			issues = append(issues, fmt.Sprintf("%s: %s", issue.Code, aws.ToString(issue.Message)))
+		}
+	}
+
+	status := output.Nodegroup.Status
+	return eksProgress(operation, string(status),
+		status == types.NodegroupStatusActive,
+		status == types.NodegroupStatusCreateFailed || status == types.NodegroupStatusDeleteFailed || status == types.NodegroupStatusDegraded,
+		issues,
+	), nil
+}
+
+// eksProgress: EKS 리소스 상태를 작업 진행 상황으로 변환합니다 (EKS는 진행률을 보고하지 않으므로 경과 시간으로 추정)
+func eksProgress(operation *domain.Operation, providerStatus string, ready, failed bool, issues []string) *domain.OperationProgress {
+	progress := &domain.OperationProgress{
+		Status:         domain.OperationRunning,
+		ProviderStatus: providerStatus,
+		Percent:        operation.EstimatedPercent(time.Now()),
+	}
+	switch {
+	case failed:
+		progress.Status = domain.OperationFailed
+		progress.Error = fmt.Sprintf("resource is %s", providerStatus)
+		if len(issues) > 0 {
+			progress.Error += ": " + strings.Join(issues, "; ")
+		}
+	case ready && !strings.HasSuffix(operation.Type, ".delete"):
+		progress.Status = domain.OperationSucceeded
+	}
+	return progress
+}
+
+// trackGKEOperation: GKE 작업(Projects.Locations.Operations) 상태를 조회합니다
+func (s *Service) trackGKEOperation(ctx context.Context, credential *domain.Credential, operation *domain.Operation) (*domain.OperationProgress, error) {
+	if operation.ProviderOperationID == "" {
+		return nil, fmt.Errorf("GKE operation name is missing")
+	}
+	containerService, _, err := s.getGCPContainerServiceAndProjectID(ctx, credential)
+	if err != nil {
+		return nil, err
+	}
+
+	gkeOperation, err := containerService.Projects.Locations.Operations.Get(operation.ProviderOperationID).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &domain.OperationProgress{
+		Status:         domain.OperationRunning,
+		ProviderStatus: gkeOperation.Status,
+		Message:        gkeOperation.Detail,
+	}
+	if gkeOperation.Status == "DONE" {
+		if gkeOperation.Error != nil && gkeOperation.Error.Message != "" {
+			progress.Status = domain.OperationFailed
+			progress.Error = gkeOperation.Error.Message
+		} else {
+			progress.Status = domain.OperationSucceeded
+		}
+		return progress, nil
+	}
+
+	progress.Percent = gkeProgressPercent(gkeOperation.Progress)
+	if progress.Percent == 0 {
+		progress.Percent = operation.EstimatedPercent(time.Now())
+	}
+	if gkeOperation.Progress != nil && progress.Message == "" {
+		for _, stage := range gkeOperation.Progress.Stages {
+			if stage.Status == "RUNNING" && stage.Name != "" {
+				progress.Message = stage.Name
+				break
+			}
+		}
+	}
+	return progress, nil
+}
+
+// gkeProgressPercent: GKE 작업의 진행률 지표(progress/progress scale 또는 nodes done/nodes total)를 백분율로 변환합니다 (없으면 0)
+func gkeProgressPercent(progress *container.OperationProgress) int {
+	if progress == nil {
+		return 0
+	}
+	metrics := make(map[string]float64, len(progress.Metrics))
+	for _, metric := range progress.Metrics {
+		if metric == nil {
+			continue
+		}
+		value := metric.DoubleValue
+		if value == 0 {
+			value = float64(metric.IntValue)
+		}
+		metrics[strings.ToLower(metric.Name)] = value
+	}
+
+	if scale := metrics["progress scale"]; scale > 0 {
+		return int(100 * metrics["progress"] / scale)
+	}
+	if total := metrics["nodes total"]; total > 0 {
+		return int(100 * metrics["nodes done"] / total)
+	}
+	return 0
+}
+
+// operationDetail: 작업 상태 조회에 필요한 값을 반환합니다
+func operationDetail(operation *domain.Operation, key string) string {
+	value, _ := operation.Details[key].(string)
+	return value
+}
@@ -14,8 +14,6 @@ import (
 	"skyclust/pkg/cache"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-	awsconfig "github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/smithy-go"
@@ -76,11 +74,13 @@ type Service struct {
 	invalidator       *cache.Invalidator
 	eventPublisher    *messaging.Publisher
 	auditLogRepo      domain.AuditLogRepository
+	policyEnforcer    domain.PolicyEnforcer
+	operationService  domain.OperationService // nil이면 비동기 작업을 추적하지 않음
 	logger            *zap.Logger
 }
 
 // NewService: 새로운 Kubernetes 서비스를 생성합니다
-func NewService(credentialService domain.CredentialService, cacheService cache.Cache, eventBus messaging.Bus, auditLogRepo domain.AuditLogRepository, logger *zap.Logger) *Service {
+func NewService(credentialService domain.CredentialService, cacheService cache.Cache, eventBus messaging.Bus, auditLogRepo domain.AuditLogRepository, policyEnforcer domain.PolicyEnforcer, operationService domain.OperationService, logger *zap.Logger) *Service {
 	eventPublisher := messaging.NewPublisher(eventBus, logger)
 	return &Service{
 		credentialService: credentialService,
@@ -89,24 +89,36 @@ func NewService(credentialService domain.CredentialService, cacheService cache.C
 		invalidator:       cache.NewInvalidatorWithEvents(cacheService, eventPublisher),
 		eventPublisher:    eventPublisher,
 		auditLogRepo:      auditLogRepo,
+		policyEnforcer:    policyEnforcer,
+		operationService:  operationService,
 		logger:            logger,
 	}
 }
 
 // CreateEKSCluster: AWS EKS 클러스터를 생성합니다 (하위 호환성을 위해)
 func (s *Service) CreateEKSCluster(ctx context.Context, credential *domain.Credential, req CreateClusterRequest) (*CreateClusterResponse, error) {
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.KubernetesWrite, domain.PolicyResource{Type: "cluster", Name: req.Name, Region: req.Region}); err != nil {
+		return nil, err
+	}
+
 	return s.createAWSEKSCluster(ctx, credential, req)
 }
 
 // CreateGCPGKECluster: 새로운 섹션 구조로 GCP GKE 클러스터를 생성합니다
 func (s *Service) CreateGCPGKECluster(ctx context.Context, credential *domain.Credential, req CreateGKEClusterRequest) (*CreateClusterResponse, error) {
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.KubernetesWrite, domain.PolicyResource{Type: "cluster", Name: req.Name, Region: req.Region}); err != nil {
+		return nil, err
+	}
+
 	return s.createGCPGKEClusterWithAdvanced(ctx, credential, req)
 }
 
 // createGCPGKEClusterWithAdvanced: 고급 설정으로 GCP GKE 클러스터를 생성합니다
 func (s *Service) createGCPGKEClusterWithAdvanced(ctx context.Context, credential *domain.Credential, req CreateGKEClusterRequest) (*CreateClusterResponse, error) {
 	// Decrypt credential data
-	credData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	credData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
@@ -214,7 +226,7 @@ func (s *Service) createGCPGKEClusterWithAdvanced(ctx context.Context, credentia
 	}
 
 	// Create cluster
-	_, err = containerService.Projects.Locations.Clusters.Create(
+	gkeOperation, err := containerService.Projects.Locations.Clusters.Create(
 		fmt.Sprintf("projects/%s/locations/%s", req.ProjectID, location),
 		createRequest,
 	).Context(ctx).Do()
@@ -241,6 +253,20 @@ func (s *Service) createGCPGKEClusterWithAdvanced(ctx context.Context, credentia
 		Tags:      req.Tags,
 		CreatedAt: time.Now().Format(time.RFC3339),
 	}
+	response.OperationID = s.startOperation(ctx, credential, &domain.Operation{
+		Region:              req.Region,
+		Type:                domain.OperationClusterCreate,
+		TargetType:          domain.OperationTargetCluster,
+		TargetID:            response.ClusterID,
+		TargetName:          req.Name,
+		ProviderOperationID: fmt.Sprintf("projects/%s/locations/%s/operations/%s", req.ProjectID, location, gkeOperation.Name),
+		ProviderStatus:      gkeOperation.Status,
+		Details: domain.JSONBMap{
+			"cluster_name": req.Name,
+			"project_id":   req.ProjectID,
+			"location":     location,
+		},
+	})
 
 	// 캐시 무효화: 클러스터 목록 캐시 삭제
 	credentialID := credential.ID.String()
@@ -475,6 +501,16 @@ func (s *Service) createAWSEKSCluster(ctx context.Context, credential *domain.Cr
 		zap.String("region", req.Region),
 		zap.String("version", req.Version))
 
+	response.OperationID = s.startOperation(ctx, credential, &domain.Operation{
+		Region:         req.Region,
+		Type:           domain.OperationClusterCreate,
+		TargetType:     domain.OperationTargetCluster,
+		TargetID:       req.Name,
+		TargetName:     req.Name,
+		ProviderStatus: response.Status,
+		Details:        domain.JSONBMap{"cluster_name": req.Name},
+	})
+
 	// 캐시 무효화: 클러스터 목록 캐시 삭제
 	credentialID := credential.ID.String()
 	if err := s.invalidator.InvalidateKubernetesClusterList(ctx, credential.Provider, credentialID, req.Region); err != nil {
@@ -556,7 +592,8 @@ func (s *Service) listAWSEKSClusters(ctx context.Context, credential *domain.Cre
 		zap.String("access_key_prefix", creds.AccessKey[:min(10, len(creds.AccessKey))]),
 		zap.Int("access_key_length", len(creds.AccessKey)),
 		zap.Int("secret_key_length", len(creds.SecretKey)),
-		zap.String("region", creds.Region))
+		zap.String("region", creds.Region),
+		zap.String("role_arn", creds.TargetRoleARN()))
 
 	cfg, err := s.createAWSConfig(ctx, creds)
 	if err != nil {
@@ -718,6 +755,11 @@ func (s *Service) getAWSEKSCluster(ctx context.Context, credential *domain.Crede
 
 // DeleteEKSCluster: Kubernetes 클러스터를 삭제합니다
 func (s *Service) DeleteEKSCluster(ctx context.Context, credential *domain.Credential, clusterName, region string) error {
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.KubernetesDelete, domain.PolicyResource{Type: "cluster", Name: clusterName, Region: region}); err != nil {
+		return err
+	}
+
 	// Route to provider-specific implementation
 	switch credential.Provider {
 	case "aws":
@@ -762,6 +804,15 @@ func (s *Service) deleteAWSEKSCluster(ctx context.Context, credential *domain.Cr
 		zap.String("cluster_name", clusterName),
 		zap.String("region", region))
 
+	s.startOperation(ctx, credential, &domain.Operation{
+		Region:     region,
+		Type:       domain.OperationClusterDelete,
+		TargetType: domain.OperationTargetCluster,
+		TargetID:   clusterName,
+		TargetName: clusterName,
+		Details:    domain.JSONBMap{"cluster_name": clusterName},
+	})
+
 	// 캐시 무효화: 클러스터 목록 및 개별 클러스터 캐시 삭제
 	credentialID := credential.ID.String()
 	if err := s.invalidator.InvalidateKubernetesClusterList(ctx, credential.Provider, credentialID, region); err != nil {
@@ -848,6 +899,20 @@ func (s *Service) getAWSEKSKubeconfig(ctx context.Context, credential *domain.Cr
 		return "", domain.NewDomainError(domain.ErrCodeProviderError, fmt.Sprintf("failed to describe EKS cluster: %v", err), 502)
 	}
 
+	// AssumeRole 자격 증명은 대상 계정의 임시 세션 키를 사용 (세션 만료 후 kubeconfig 재발급 필요)
+	accessKey, secretKey, sessionToken := creds.AccessKey, creds.SecretKey, creds.SessionToken
+	if creds.UsesAssumeRole() {
+		session, err := cfg.Credentials.Retrieve(ctx)
+		if err != nil {
+			return "", domain.NewDomainError(domain.ErrCodeProviderError, fmt.Sprintf("failed to retrieve assumed role session: %v", err), 502)
+		}
+		accessKey, secretKey, sessionToken = session.AccessKeyID, session.SecretAccessKey, session.SessionToken
+	}
+	sessionTokenEnv := ""
+	if sessionToken != "" {
+		sessionTokenEnv = fmt.Sprintf("        - name: AWS_SESSION_TOKEN\n          value: %s\n", sessionToken)
+	}
+
 	// Generate kubeconfig
 	kubeconfig := fmt.Sprintf(`apiVersion: v1
 kind: Config
@@ -880,7 +945,7 @@ users:
           value: %s
         - name: AWS_SECRET_ACCESS_KEY
           value: %s
-`,
+%s`,
 		aws.ToString(output.Cluster.CertificateAuthority.Data),
 		aws.ToString(output.Cluster.Endpoint),
 		clusterName,
@@ -891,8 +956,9 @@ users:
 		clusterName,
 		clusterName,
 		creds.Region,
-		creds.AccessKey,
-		creds.SecretKey,
+		accessKey,
+		secretKey,
+		sessionTokenEnv,
 	)
 
 	return kubeconfig, nil
@@ -900,34 +966,15 @@ users:
 
 // CreateEKSNodePool: EKS 클러스터의 노드 풀을 생성합니다
 func (s *Service) CreateEKSNodePool(ctx context.Context, credential *domain.Credential, req CreateNodePoolRequest) (map[string]interface{}, error) {
-	// Decrypt credential data
-	credData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
-	if err != nil {
-		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
-	}
-
-	// Extract AWS credentials
-	accessKey, ok := credData["access_key"].(string)
-	if !ok {
-		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "access_key not found in credential", 400)
-	}
-
-	secretKey, ok := credData["secret_key"].(string)
-	if !ok {
-		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "secret_key not found in credential", 400)
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.KubernetesWrite, domain.PolicyResource{Type: "node_pool", Name: req.NodePoolName, Region: req.Region, Attributes: map[string]string{"cluster": req.ClusterName}}); err != nil {
+		return nil, err
 	}
 
 	// Create AWS config
-	cfg, err := awsconfig.LoadDefaultConfig(ctx,
-		awsconfig.WithRegion(req.Region),
-		awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
-			accessKey,
-			secretKey,
-			"",
-		)),
-	)
+	cfg, err := s.createAWSConfigForRegion(ctx, credential, req.Region)
 	if err != nil {
-		return nil, domain.NewDomainError(domain.ErrCodeProviderError, fmt.Sprintf("failed to load AWS config: %v", err), 502)
+		return nil, err
 	}
 
 	// Create EKS client
@@ -979,6 +1026,18 @@ func (s *Service) CreateEKSNodePool(ctx context.Context, credential *domain.Cred
 		zap.String("nodegroup_name", req.NodePoolName),
 		zap.String("region", req.Region))
 
+	if operationID := s.startOperation(ctx, credential, &domain.Operation{
+		Region:         req.Region,
+		Type:           domain.OperationNodeGroupCreate,
+		TargetType:     domain.OperationTargetNodeGroup,
+		TargetID:       req.ClusterName + "/" + req.NodePoolName,
+		TargetName:     req.NodePoolName,
+		ProviderStatus: string(output.Nodegroup.Status),
+		Details:        domain.JSONBMap{"cluster_name": req.ClusterName, "node_group_name": req.NodePoolName},
+	}); operationID != "" {
+		result["operation_id"] = operationID
+	}
+
 	// 감사로그 기록
 	credentialID := credential.ID.String()
 	common.LogAction(ctx, s.auditLogRepo, nil, domain.ActionKubernetesNodePoolCreate,
@@ -1009,6 +1068,11 @@ func (s *Service) CreateEKSNodePool(ctx context.Context, credential *domain.Cred
 
 // CreateEKSNodeGroup: EKS 노드 그룹을 생성합니다
 func (s *Service) CreateEKSNodeGroup(ctx context.Context, credential *domain.Credential, req CreateNodeGroupRequest) (*CreateNodeGroupResponse, error) {
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.KubernetesWrite, domain.PolicyResource{Type: "node_group", Name: req.NodeGroupName, Region: req.Region, Attributes: map[string]string{"cluster": req.ClusterName}}); err != nil {
+		return nil, err
+	}
+
 	creds, err := s.extractAWSCredentials(ctx, credential, req.Region)
 	if err != nil {
 		return nil, err
@@ -1082,6 +1146,16 @@ func (s *Service) CreateEKSNodeGroup(ctx context.Context, credential *domain.Cre
 		zap.String("nodegroup_name", req.NodeGroupName),
 		zap.String("region", req.Region))
 
+	response.OperationID = s.startOperation(ctx, credential, &domain.Operation{
+		Region:         req.Region,
+		Type:           domain.OperationNodeGroupCreate,
+		TargetType:     domain.OperationTargetNodeGroup,
+		TargetID:       req.ClusterName + "/" + req.NodeGroupName,
+		TargetName:     req.NodeGroupName,
+		ProviderStatus: response.Status,
+		Details:        domain.JSONBMap{"cluster_name": req.ClusterName, "node_group_name": req.NodeGroupName},
+	})
+
 	return response, nil
 }
 
@@ -1108,34 +1182,10 @@ func (s *Service) ListNodeGroups(ctx context.Context, credential *domain.Credent
 
 // listAWSEKSNodeGroups: 클러스터의 AWS EKS 노드 그룹 목록을 조회합니다
 func (s *Service) listAWSEKSNodeGroups(ctx context.Context, credential *domain.Credential, req ListNodeGroupsRequest) (*ListNodeGroupsResponse, error) {
-	// Decrypt credential data
-	credData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
-	if err != nil {
-		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
-	}
-
-	// Extract AWS credentials
-	accessKey, ok := credData["access_key"].(string)
-	if !ok {
-		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "access_key not found in credential", 400)
-	}
-
-	secretKey, ok := credData["secret_key"].(string)
-	if !ok {
-		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "secret_key not found in credential", 400)
-	}
-
 	// Create AWS config
-	cfg, err := awsconfig.LoadDefaultConfig(ctx,
-		awsconfig.WithRegion(req.Region),
-		awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
-			accessKey,
-			secretKey,
-			"",
-		)),
-	)
+	cfg, err := s.createAWSConfigForRegion(ctx, credential, req.Region)
 	if err != nil {
-		return nil, domain.NewDomainError(domain.ErrCodeProviderError, fmt.Sprintf("failed to load AWS config: %v", err), 502)
+		return nil, err
 	}
 
 	// Create EKS client
@@ -1302,34 +1352,10 @@ func (s *Service) GetNodeGroup(ctx context.Context, credential *domain.Credentia
 
 // getAWSEKSNodeGroup: AWS EKS 노드 그룹 상세 정보를 조회합니다
 func (s *Service) getAWSEKSNodeGroup(ctx context.Context, credential *domain.Credential, req GetNodeGroupRequest) (*NodeGroupInfo, error) {
-	// Decrypt credential data
-	credData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
-	if err != nil {
-		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
-	}
-
-	// Extract AWS credentials
-	accessKey, ok := credData["access_key"].(string)
-	if !ok {
-		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "access_key not found in credential", 400)
-	}
-
-	secretKey, ok := credData["secret_key"].(string)
-	if !ok {
-		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "secret_key not found in credential", 400)
-	}
-
 	// Create AWS config
-	cfg, err := awsconfig.LoadDefaultConfig(ctx,
-		awsconfig.WithRegion(req.Region),
-		awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
-			accessKey,
-			secretKey,
-			"",
-		)),
-	)
+	cfg, err := s.createAWSConfigForRegion(ctx, credential, req.Region)
 	if err != nil {
-		return nil, domain.NewDomainError(domain.ErrCodeProviderError, fmt.Sprintf("failed to load AWS config: %v", err), 502)
+		return nil, err
 	}
 
 	// Create EKS client
@@ -1452,34 +1478,15 @@ func (s *Service) getGCPGKENodePool(ctx context.Context, credential *domain.Cred
 
 // DeleteNodeGroup: 노드 그룹을 삭제합니다
 func (s *Service) DeleteNodeGroup(ctx context.Context, credential *domain.Credential, req DeleteNodeGroupRequest) error {
-	// Decrypt credential data
-	credData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
-	if err != nil {
-		return domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
-	}
-
-	// Extract AWS credentials
-	accessKey, ok := credData["access_key"].(string)
-	if !ok {
-		return domain.NewDomainError(domain.ErrCodeValidationFailed, "access_key not found in credential", 400)
-	}
-
-	secretKey, ok := credData["secret_key"].(string)
-	if !ok {
-		return domain.NewDomainError(domain.ErrCodeValidationFailed, "secret_key not found in credential", 400)
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.KubernetesDelete, domain.PolicyResource{Type: "node_group", Name: req.NodeGroupName, Region: req.Region, Attributes: map[string]string{"cluster": req.ClusterName}}); err != nil {
+		return err
 	}
 
 	// Create AWS config
-	cfg, err := awsconfig.LoadDefaultConfig(ctx,
-		awsconfig.WithRegion(req.Region),
-		awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
-			accessKey,
-			secretKey,
-			"",
-		)),
-	)
+	cfg, err := s.createAWSConfigForRegion(ctx, credential, req.Region)
 	if err != nil {
-		return domain.NewDomainError(domain.ErrCodeProviderError, fmt.Sprintf("failed to load AWS config: %v", err), 502)
+		return err
 	}
 
 	// Create EKS client
@@ -1499,6 +1506,15 @@ func (s *Service) DeleteNodeGroup(ctx context.Context, credential *domain.Creden
 		zap.String("nodegroup_name", req.NodeGroupName),
 		zap.String("region", req.Region))
 
+	s.startOperation(ctx, credential, &domain.Operation{
+		Region:     req.Region,
+		Type:       domain.OperationNodeGroupDelete,
+		TargetType: domain.OperationTargetNodeGroup,
+		TargetID:   req.ClusterName + "/" + req.NodeGroupName,
+		TargetName: req.NodeGroupName,
+		Details:    domain.JSONBMap{"cluster_name": req.ClusterName, "node_group_name": req.NodeGroupName},
+	})
+
 	// 감사로그 기록
 	credentialID := credential.ID.String()
 	common.LogAction(ctx, s.auditLogRepo, nil, domain.ActionKubernetesNodeGroupDelete,
@@ -1767,7 +1783,7 @@ func (s *Service) getGCPLocations(region string) []string {
 
 // getGCPContainerServiceAndProjectID: 자격 증명으로부터 GCP Container 서비스 클라이언트와 프로젝트 ID를 조회합니다
 func (s *Service) getGCPContainerServiceAndProjectID(ctx context.Context, credential *domain.Credential) (*container.Service, string, error) {
-	credData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	credData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return nil, "", domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
@@ -2041,6 +2057,21 @@ func (s *Service) deleteGCPGKECluster(ctx context.Context, credential *domain.Cr
 		zap.String("location", clusterLocation),
 		zap.String("operation_name", operation.Name))
 
+	s.startOperation(ctx, credential, &domain.Operation{
+		Region:              region,
+		Type:                domain.OperationClusterDelete,
+		TargetType:          domain.OperationTargetCluster,
+		TargetID:            clusterPath,
+		TargetName:          clusterName,
+		ProviderOperationID: fmt.Sprintf("projects/%s/locations/%s/operations/%s", projectID, clusterLocation, operation.Name),
+		ProviderStatus:      operation.Status,
+		Details: domain.JSONBMap{
+			"cluster_name": clusterName,
+			"project_id":   projectID,
+			"location":     clusterLocation,
+		},
+	})
+
 	// 캐시 무효화: 클러스터 목록 및 개별 클러스터 캐시 삭제
 	credentialID := credential.ID.String()
 	if err := s.invalidator.InvalidateKubernetesClusterList(ctx, credential.Provider, credentialID, region); err != nil {
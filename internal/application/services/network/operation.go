@@ -0,0 +1,103 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"skyclust/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// startOperation: 클라우드에서 비동기로 진행되는 작업을 등록합니다
+// 클라우드 요청은 이미 수락되었으므로 등록에 실패해도 요청을 실패로 처리하지 않습니다
+func (s *Service) startOperation(ctx context.Context, credential *domain.Credential, operation *domain.Operation) {
+	if s.operationService == nil {
+		return
+	}
+	s.prepareOperation(credential, operation)
+	if _, err := s.operationService.Start(ctx, operation); err != nil {
+		s.logger.Warn("Failed to record network operation",
+			zap.String("type", operation.Type),
+			zap.String("target_id", operation.TargetID),
+			zap.Error(err))
+	}
+}
+
+// recordOperation: 동기로 끝난 작업을 완료 상태로 등록합니다 (AWS VPC 삭제 등)
+func (s *Service) recordOperation(ctx context.Context, credential *domain.Credential, operation *domain.Operation) {
+	if s.operationService == nil {
+		return
+	}
+	s.prepareOperation(credential, operation)
+	if _, err := s.operationService.Record(ctx, operation); err != nil {
+		s.logger.Warn("Failed to record network operation",
+			zap.String("type", operation.Type),
+			zap.String("target_id", operation.TargetID),
+			zap.Error(err))
+	}
+}
+
+// prepareOperation: 자격증명의 워크스페이스와 프로바이더를 작업에 기록합니다
+func (s *Service) prepareOperation(credential *domain.Credential, operation *domain.Operation) {
+	operation.WorkspaceID = credential.WorkspaceID.String()
+	operation.CredentialID = credential.ID.String()
+	operation.Provider = credential.Provider
+}
+
+// TrackOperation: GCP Compute 전역 작업 상태를 조회합니다 (domain.OperationTracker 구현)
+func (s *Service) TrackOperation(ctx context.Context, operation *domain.Operation) (*domain.OperationProgress, error) {
+	if operation.ProviderOperationID == "" {
+		return nil, fmt.Errorf("%s operations are not tracked for %s", operation.Type, operation.Provider)
+	}
+	credentialID, err := uuid.Parse(operation.CredentialID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credential id: %s", operation.CredentialID)
+	}
+	credential, err := s.credentialService.GetCredentialByIDDirect(ctx, credentialID)
+	if err != nil {
+		return nil, err
+	}
+	if credential.Provider != domain.ProviderGCP {
+		return nil, fmt.Errorf("unsupported %s operation provider: %s", operation.Type, credential.Provider)
+	}
+
+	computeService, projectID, err := s.setupGCPComputeService(ctx, credential)
+	if err != nil {
+		return nil, err
+	}
+	if project, ok := operation.Details["project_id"].(string); ok && project != "" {
+		projectID = project
+	}
+
+	gcpOperation, err := computeService.GlobalOperations.Get(projectID, operation.ProviderOperationID).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &domain.OperationProgress{
+		Status:         domain.OperationRunning,
+		ProviderStatus: gcpOperation.Status,
+		Message:        gcpOperation.StatusMessage,
+	}
+	if strings.EqualFold(gcpOperation.Status, OperationStatusDone) {
+		if gcpOperation.Error != nil && len(gcpOperation.Error.Errors) > 0 {
+			messages := make([]string, 0, len(gcpOperation.Error.Errors))
+			for _, operationError := range gcpOperation.Error.Errors {
+				messages = append(messages, fmt.Sprintf("%s: %s", operationError.Code, operationError.Message))
+			}
+			progress.Status = domain.OperationFailed
+			progress.Error = strings.Join(messages, "; ")
+		} else {
+			progress.Status = domain.OperationSucceeded
+		}
+		return progress, nil
+	}
+
+	// Compute 작업은 0~100 진행률을 보고하지만 대부분 완료 시에만 갱신되므로 경과 시간 추정치와 비교해 큰 값을 사용
+	progress.Percent = max(int(gcpOperation.Progress), operation.EstimatedPercent(time.Now()))
+	return progress, nil
+}
@@ -14,8 +14,6 @@ import (
 	"skyclust/pkg/cache"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-	awsconfig "github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"go.uber.org/zap"
@@ -32,11 +30,13 @@ type Service struct {
 	invalidator       *cache.Invalidator
 	eventPublisher    *messaging.Publisher
 	auditLogRepo      domain.AuditLogRepository
+	policyEnforcer    domain.PolicyEnforcer
+	operationService  domain.OperationService // nil이면 비동기 작업을 추적하지 않음
 	logger            *zap.Logger
 }
 
 // NewService: 새로운 네트워크 서비스를 생성합니다
-func NewService(credentialService domain.CredentialService, cacheService cache.Cache, eventBus messaging.Bus, auditLogRepo domain.AuditLogRepository, logger *zap.Logger) *Service {
+func NewService(credentialService domain.CredentialService, cacheService cache.Cache, eventBus messaging.Bus, auditLogRepo domain.AuditLogRepository, policyEnforcer domain.PolicyEnforcer, operationService domain.OperationService, logger *zap.Logger) *Service {
 	eventPublisher := messaging.NewPublisher(eventBus, logger)
 	return &Service{
 		credentialService: credentialService,
@@ -45,6 +45,8 @@ func NewService(credentialService domain.CredentialService, cacheService cache.C
 		invalidator:       cache.NewInvalidatorWithEvents(cacheService, eventPublisher),
 		eventPublisher:    eventPublisher,
 		auditLogRepo:      auditLogRepo,
+		policyEnforcer:    policyEnforcer,
+		operationService:  operationService,
 		logger:            logger,
 	}
 }
@@ -238,6 +240,11 @@ func (s *Service) getAWSVPC(ctx context.Context, credential *domain.Credential,
 
 // CreateVPC: 새로운 VPC를 생성합니다
 func (s *Service) CreateVPC(ctx context.Context, credential *domain.Credential, req CreateVPCRequest) (*VPCInfo, error) {
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.NetworkWrite, domain.PolicyResource{Type: "vpc", Name: req.Name, Region: req.Region}); err != nil {
+		return nil, err
+	}
+
 	// Route to provider-specific implementation
 	var vpc *VPCInfo
 	var err error
@@ -306,7 +313,7 @@ func (s *Service) CreateVPC(ctx context.Context, credential *domain.Credential,
 // createGCPVPC: GCP VPC를 생성합니다
 func (s *Service) createGCPVPC(ctx context.Context, credential *domain.Credential, req CreateVPCRequest) (*VPCInfo, error) {
 	// Decrypt credential data
-	credData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	credData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
@@ -445,7 +452,7 @@ func (s *Service) buildVPCInfoFromRequest(req CreateGCPVPCRequest) *VPCInfo {
 // listGCPVPCs: GCP VPC 목록을 조회합니다
 func (s *Service) listGCPVPCs(ctx context.Context, credential *domain.Credential, req ListVPCsRequest) (*ListVPCsResponse, error) {
 	// Decrypt credential data
-	credData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	credData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
@@ -548,7 +555,7 @@ func (s *Service) listGCPVPCs(ctx context.Context, credential *domain.Credential
 // getGCPVPC: 특정 GCP VPC를 조회합니다
 func (s *Service) getGCPVPC(ctx context.Context, credential *domain.Credential, req GetVPCRequest) (*VPCInfo, error) {
 	// Decrypt credential data
-	credData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	credData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
@@ -668,7 +675,7 @@ func (s *Service) extractNetworkNameFromVPCID(vpcID string) string {
 // deleteGCPVPC: GCP VPC를 삭제합니다
 func (s *Service) deleteGCPVPC(ctx context.Context, credential *domain.Credential, req DeleteVPCRequest) error {
 	// Decrypt credential data
-	credData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	credData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
@@ -743,6 +750,17 @@ func (s *Service) deleteGCPVPC(ctx context.Context, credential *domain.Credentia
 		zap.String("operation_id", operation.Name),
 		zap.String("operation_status", operation.Status))
 
+	s.startOperation(ctx, credential, &domain.Operation{
+		Region:              req.Region,
+		Type:                domain.OperationVPCDelete,
+		TargetType:          domain.OperationTargetVPC,
+		TargetID:            req.VPCID,
+		TargetName:          networkName,
+		ProviderOperationID: operation.Name,
+		ProviderStatus:      operation.Status,
+		Details:             domain.JSONBMap{"project_id": projectID, "network_name": networkName},
+	})
+
 	return nil
 }
 
@@ -1012,6 +1030,11 @@ func (s *Service) createAWSVPC(ctx context.Context, credential *domain.Credentia
 
 // UpdateVPC updates a VPC
 func (s *Service) UpdateVPC(ctx context.Context, credential *domain.Credential, req UpdateVPCRequest, vpcID, region string) (*VPCInfo, error) {
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.NetworkWrite, domain.PolicyResource{Type: "vpc", Name: vpcID, Region: region}); err != nil {
+		return nil, err
+	}
+
 	// Route to provider-specific implementation
 	switch credential.Provider {
 	case "aws":
@@ -1126,6 +1149,11 @@ func (s *Service) updateAWSVPC(ctx context.Context, credential *domain.Credentia
 
 // DeleteVPC: VPC를 삭제합니다
 func (s *Service) DeleteVPC(ctx context.Context, credential *domain.Credential, req DeleteVPCRequest) error {
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.NetworkDelete, domain.PolicyResource{Type: "vpc", Name: req.VPCID, Region: req.Region}); err != nil {
+		return err
+	}
+
 	// Route to provider-specific implementation
 	var err error
 
@@ -1210,6 +1238,15 @@ func (s *Service) deleteAWSVPC(ctx context.Context, credential *domain.Credentia
 		return domain.NewDomainError(domain.ErrCodeProviderError, fmt.Sprintf("failed to delete VPC: %v", err), 502)
 	}
 
+	// EC2 DeleteVpc는 동기로 끝나므로 완료된 작업으로 기록
+	s.recordOperation(ctx, credential, &domain.Operation{
+		Region:     req.Region,
+		Type:       domain.OperationVPCDelete,
+		TargetType: domain.OperationTargetVPC,
+		TargetID:   req.VPCID,
+		TargetName: req.VPCID,
+	})
+
 	return nil
 }
 
@@ -1259,6 +1296,11 @@ func (s *Service) GetSubnet(ctx context.Context, credential *domain.Credential,
 
 // CreateSubnet: 새로운 서브넷을 생성합니다
 func (s *Service) CreateSubnet(ctx context.Context, credential *domain.Credential, req CreateSubnetRequest) (*SubnetInfo, error) {
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.NetworkWrite, domain.PolicyResource{Type: "subnet", Name: req.Name, Region: req.Region}); err != nil {
+		return nil, err
+	}
+
 	switch credential.Provider {
 	case "aws":
 		return s.createAWSSubnet(ctx, credential, req)
@@ -1279,6 +1321,11 @@ func (s *Service) CreateSubnet(ctx context.Context, credential *domain.Credentia
 
 // UpdateSubnet: 서브넷을 업데이트합니다
 func (s *Service) UpdateSubnet(ctx context.Context, credential *domain.Credential, req UpdateSubnetRequest, subnetID, region string) (*SubnetInfo, error) {
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.NetworkWrite, domain.PolicyResource{Type: "subnet", Name: subnetID, Region: region}); err != nil {
+		return nil, err
+	}
+
 	switch credential.Provider {
 	case "aws":
 		return s.updateAWSSubnet(ctx, credential, req, subnetID, region)
@@ -1299,6 +1346,11 @@ func (s *Service) UpdateSubnet(ctx context.Context, credential *domain.Credentia
 
 // DeleteSubnet: 서브넷을 삭제합니다
 func (s *Service) DeleteSubnet(ctx context.Context, credential *domain.Credential, req DeleteSubnetRequest) error {
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.NetworkDelete, domain.PolicyResource{Type: "subnet", Name: req.SubnetID, Region: req.Region}); err != nil {
+		return err
+	}
+
 	switch credential.Provider {
 	case "aws":
 		return s.deleteAWSSubnet(ctx, credential, req)
@@ -1390,7 +1442,7 @@ func (s *Service) listAWSSecurityGroups(ctx context.Context, credential *domain.
 // listGCPSecurityGroups: GCP 보안 그룹 목록을 조회합니다
 func (s *Service) listGCPSecurityGroups(ctx context.Context, credential *domain.Credential, req ListSecurityGroupsRequest) (*ListSecurityGroupsResponse, error) {
 	// Decrypt credential data
-	credData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	credData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
@@ -1596,7 +1648,7 @@ func (s *Service) getAWSSecurityGroup(ctx context.Context, credential *domain.Cr
 // getGCPSecurityGroup: 특정 GCP 보안 그룹을 조회합니다
 func (s *Service) getGCPSecurityGroup(ctx context.Context, credential *domain.Credential, req GetSecurityGroupRequest) (*SecurityGroupInfo, error) {
 	// Decrypt credential data
-	credData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	credData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
@@ -1653,6 +1705,11 @@ func (s *Service) getGCPSecurityGroup(ctx context.Context, credential *domain.Cr
 
 // CreateSecurityGroup: 새로운 보안 그룹을 생성합니다
 func (s *Service) CreateSecurityGroup(ctx context.Context, credential *domain.Credential, req CreateSecurityGroupRequest) (*SecurityGroupInfo, error) {
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.NetworkWrite, domain.PolicyResource{Type: "security_group", Name: req.Name, Region: req.Region}); err != nil {
+		return nil, err
+	}
+
 	switch credential.Provider {
 	case "aws":
 		return s.createAWSSecurityGroup(ctx, credential, req)
@@ -1830,6 +1887,11 @@ func (s *Service) convertGCPFirewallRulesFromRequest(req CreateSecurityGroupRequ
 
 // UpdateSecurityGroup: 보안 그룹을 업데이트합니다
 func (s *Service) UpdateSecurityGroup(ctx context.Context, credential *domain.Credential, req UpdateSecurityGroupRequest, securityGroupID, region string) (*SecurityGroupInfo, error) {
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.NetworkWrite, domain.PolicyResource{Type: "security_group", Name: securityGroupID, Region: region}); err != nil {
+		return nil, err
+	}
+
 	switch credential.Provider {
 	case "aws":
 		return s.updateAWSSecurityGroup(ctx, credential, req, securityGroupID, region)
@@ -1934,7 +1996,7 @@ func (s *Service) updateAWSSecurityGroup(ctx context.Context, credential *domain
 // updateGCPSecurityGroup: GCP 보안 그룹을 업데이트합니다
 func (s *Service) updateGCPSecurityGroup(ctx context.Context, credential *domain.Credential, req UpdateSecurityGroupRequest, firewallName, region string) (*SecurityGroupInfo, error) {
 	// Decrypt credential data
-	credData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	credData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
@@ -2038,6 +2100,11 @@ func (s *Service) updateGCPSecurityGroup(ctx context.Context, credential *domain
 
 // DeleteSecurityGroup: 보안 그룹을 삭제합니다
 func (s *Service) DeleteSecurityGroup(ctx context.Context, credential *domain.Credential, req DeleteSecurityGroupRequest) error {
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.NetworkDelete, domain.PolicyResource{Type: "security_group", Name: req.SecurityGroupID, Region: req.Region}); err != nil {
+		return err
+	}
+
 	switch credential.Provider {
 	case "aws":
 		return s.deleteAWSSecurityGroup(ctx, credential, req)
@@ -2109,7 +2176,7 @@ func (s *Service) deleteAWSSecurityGroup(ctx context.Context, credential *domain
 // deleteGCPSecurityGroup: GCP 보안 그룹을 삭제합니다
 func (s *Service) deleteGCPSecurityGroup(ctx context.Context, credential *domain.Credential, req DeleteSecurityGroupRequest) error {
 	// Decrypt credential data
-	credData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	credData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
@@ -2179,6 +2246,11 @@ func (s *Service) deleteGCPSecurityGroup(ctx context.Context, credential *domain
 
 // RemoveFirewallRule removes a specific firewall rule from a GCP firewall (security group)
 func (s *Service) RemoveFirewallRule(ctx context.Context, credential *domain.Credential, req RemoveFirewallRuleRequest) (*SecurityGroupInfo, error) {
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.NetworkWrite, domain.PolicyResource{Type: "security_group", Name: req.SecurityGroupID, Region: req.Region}); err != nil {
+		return nil, err
+	}
+
 	switch credential.Provider {
 	case "aws":
 		return nil, domain.NewDomainError(domain.ErrCodeNotSupported, "AWS does not support individual rule removal - use RemoveSecurityGroupRule instead", 400)
@@ -2240,6 +2312,11 @@ func (s *Service) removeGCPFirewallRule(ctx context.Context, credential *domain.
 
 // AddFirewallRule adds a specific firewall rule to a GCP firewall (security group)
 func (s *Service) AddFirewallRule(ctx context.Context, credential *domain.Credential, req AddFirewallRuleRequest) (*SecurityGroupInfo, error) {
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.NetworkWrite, domain.PolicyResource{Type: "security_group", Name: req.SecurityGroupID, Region: req.Region}); err != nil {
+		return nil, err
+	}
+
 	switch credential.Provider {
 	case "aws":
 		return nil, domain.NewDomainError(domain.ErrCodeNotSupported, "AWS does not support individual rule addition - use AddSecurityGroupRule instead", 400)
@@ -2303,7 +2380,7 @@ func (s *Service) addGCPFirewallRule(ctx context.Context, credential *domain.Cre
 
 // setupGCPComputeService: GCP Compute 서비스를 설정합니다
 func (s *Service) setupGCPComputeService(ctx context.Context, credential *domain.Credential) (*compute.Service, string, error) {
-	credData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	credData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return nil, "", domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
@@ -2665,7 +2742,7 @@ func (s *Service) waitForGCPOperation(ctx context.Context, computeService *compu
 // createGCPComputeClient: GCP Compute 클라이언트를 생성합니다
 func (s *Service) createGCPComputeClient(ctx context.Context, credential *domain.Credential) (*compute.Service, error) {
 	// Decrypt credential
-	decryptedData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	decryptedData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
@@ -2709,38 +2786,29 @@ func (s *Service) createGCPComputeClient(ctx context.Context, credential *domain
 // createEC2Client: AWS EC2 클라이언트를 생성합니다
 func (s *Service) createEC2Client(ctx context.Context, credential *domain.Credential, region string) (*ec2.Client, error) {
 	// Decrypt credential
-	decryptedData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	decryptedData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
 
-	// Extract AWS credentials (same as kubernetes service)
-	accessKey, ok := decryptedData["access_key"].(string)
-	if !ok {
-		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "access_key not found in credential", 400)
+	// Extract AWS credentials (static keys or AssumeRole chain, same as kubernetes service)
+	creds, err := common.ParseAWSCredentialData(decryptedData, region)
+	if err != nil {
+		return nil, err
 	}
-
-	secretKey, ok := decryptedData["secret_key"].(string)
-	if !ok {
-		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "secret_key not found in credential", 400)
+	if region != "" {
+		creds.Region = region
 	}
 
-	// Debug: Log the extracted credentials
-	s.logger.Info("Extracted AWS credentials",
-		zap.String("access_key", accessKey),
-		zap.String("secret_key", secretKey[:10]+"..."))
+	s.logger.Debug("Extracted AWS credentials",
+		zap.String("credential_id", credential.ID.String()),
+		zap.String("region", creds.Region),
+		zap.String("role_arn", creds.TargetRoleARN()))
 
 	// Create AWS config
-	cfg, err := awsconfig.LoadDefaultConfig(ctx,
-		awsconfig.WithRegion(region),
-		awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
-			accessKey,
-			secretKey,
-			"",
-		)),
-	)
+	cfg, err := common.NewAWSConfig(ctx, creds)
 	if err != nil {
-		return nil, domain.NewDomainError(domain.ErrCodeProviderError, fmt.Sprintf("failed to load AWS config: %v", err), 502)
+		return nil, err
 	}
 
 	return ec2.NewFromConfig(cfg), nil
@@ -2864,6 +2932,11 @@ func (s *Service) convertSecurityGroupRules(ingress, egress []ec2Types.IpPermiss
 
 // AddSecurityGroupRule adds a rule to a security group
 func (s *Service) AddSecurityGroupRule(ctx context.Context, credential *domain.Credential, req AddSecurityGroupRuleRequest) (*SecurityGroupInfo, error) {
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.NetworkWrite, domain.PolicyResource{Type: "security_group", Name: req.SecurityGroupID, Region: req.Region}); err != nil {
+		return nil, err
+	}
+
 	// Create AWS EC2 client
 	ec2Client, err := s.createEC2Client(ctx, credential, req.Region)
 	if err != nil {
@@ -2925,6 +2998,11 @@ func (s *Service) AddSecurityGroupRule(ctx context.Context, credential *domain.C
 
 // RemoveSecurityGroupRule removes a rule from a security group
 func (s *Service) RemoveSecurityGroupRule(ctx context.Context, credential *domain.Credential, req RemoveSecurityGroupRuleRequest) (*SecurityGroupInfo, error) {
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.NetworkWrite, domain.PolicyResource{Type: "security_group", Name: req.SecurityGroupID, Region: req.Region}); err != nil {
+		return nil, err
+	}
+
 	// Create AWS EC2 client
 	ec2Client, err := s.createEC2Client(ctx, credential, req.Region)
 	if err != nil {
@@ -2984,6 +3062,10 @@ func (s *Service) RemoveSecurityGroupRule(ctx context.Context, credential *domai
 
 // UpdateSecurityGroupRules updates all rules for a security group
 func (s *Service) UpdateSecurityGroupRules(ctx context.Context, credential *domain.Credential, req UpdateSecurityGroupRulesRequest) (*SecurityGroupInfo, error) {
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := common.EnforcePolicy(ctx, s.policyEnforcer, credential, domain.NetworkWrite, domain.PolicyResource{Type: "security_group", Name: req.SecurityGroupID, Region: req.Region}); err != nil {
+		return nil, err
+	}
 
 	// Get current security group
 	getReq := GetSecurityGroupRequest{
@@ -3068,7 +3150,7 @@ func (s *Service) listGCPSubnets(ctx context.Context, credential *domain.Credent
 	}
 
 	// Decrypt credential to get project ID
-	decryptedData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	decryptedData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
@@ -3138,7 +3220,7 @@ func (s *Service) getGCPSubnet(ctx context.Context, credential *domain.Credentia
 	}
 
 	// Decrypt credential to get project ID
-	decryptedData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	decryptedData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
@@ -3209,7 +3291,7 @@ func (s *Service) createGCPSubnet(ctx context.Context, credential *domain.Creden
 	}
 
 	// Decrypt credential to get project ID
-	decryptedData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	decryptedData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
@@ -3307,7 +3389,7 @@ func (s *Service) updateGCPSubnet(ctx context.Context, credential *domain.Creden
 	}
 
 	// Decrypt credential to get project ID
-	decryptedData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	decryptedData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
@@ -3419,7 +3501,7 @@ func (s *Service) deleteGCPSubnet(ctx context.Context, credential *domain.Creden
 	}
 
 	// Decrypt credential to get project ID
-	decryptedData, err := s.credentialService.DecryptCredentialData(ctx, credential.EncryptedData)
+	decryptedData, err := s.credentialService.ResolveCredentialData(ctx, credential)
 	if err != nil {
 		return domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to decrypt credential: %v", err), 500)
 	}
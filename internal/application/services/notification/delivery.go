@@ -0,0 +1,322 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"skyclust/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Mailer: 다이제스트와 에스컬레이션 이메일 전송 인터페이스
+type Mailer interface {
+	SendNotification(to string, notification *domain.Notification) error
+	SendDigest(to string, notifications []*domain.Notification) error
+}
+
+// Config: 알림 전달 파이프라인 설정
+type Config struct {
+	DedupWindow     time.Duration // 같은 알림을 중복으로 억제하는 기간 (0이면 기본값, 음수면 중복 억제 안 함)
+	DigestBatchSize int           // 다이제스트 이메일 한 통에 담는 최대 알림 수
+}
+
+const (
+	// defaultDedupWindow: 중복 억제 기간 기본값
+	defaultDedupWindow = 10 * time.Minute
+	// defaultDigestBatchSize: 다이제스트 이메일 한 통에 담는 최대 알림 수 기본값
+	defaultDigestBatchSize = 100
+)
+
+// deliver: 수신자의 알림 설정에 따라 알림을 저장하고 즉시 전달 대상이면 이벤트를 발행합니다
+// 수신 거부되었거나 중복으로 억제된 알림은 저장하지 않고 false를 반환합니다
+func (s *Service) deliver(ctx context.Context, notification *domain.Notification) (bool, error) {
+	if notification.CreatedAt.IsZero() {
+		notification.CreatedAt = time.Now()
+	}
+	if !s.routeNotification(ctx, notification, notification.CreatedAt) {
+		return false, nil
+	}
+
+	if err := s.notificationRepo.Create(ctx, notification); err != nil {
+		return false, err
+	}
+
+	// 방해 금지 시간으로 보류되거나 다이제스트로 모이는 알림은 실시간 이벤트를 발행하지 않음
+	if notification.DeliveryStatus == domain.NotificationDeliveryDelivered && s.eventService != nil {
+		_ = s.eventService.Publish(ctx, "notification.created", notification)
+	}
+	return true, nil
+}
+
+// routeNotification: 수신자의 알림 설정으로 전달 방식을 결정합니다
+// 1. 카테고리와 우선순위 수신 설정 2. 중복 억제 3. 긴급 알림 에스컬레이션 예약
+// 4. 낮은 우선순위 다이제스트 5. 방해 금지 시간 보류 (긴급 알림은 4, 5를 적용하지 않음)
+func (s *Service) routeNotification(ctx context.Context, notification *domain.Notification, now time.Time) bool {
+	preferences := s.preferencesFor(ctx, notification.UserID)
+	if preferences != nil && !preferences.Allows(notification.Category, notification.Priority) {
+		s.logger.Debug("Notification suppressed by preferences",
+			zap.String("user_id", notification.UserID),
+			zap.String("category", notification.Category),
+			zap.String("priority", notification.Priority))
+		return false
+	}
+
+	notification.DedupKey = domain.NotificationDedupKey(notification)
+	if s.isDuplicate(ctx, notification, now) {
+		return false
+	}
+
+	notification.DeliveryStatus = domain.NotificationDeliveryDelivered
+	notification.DeliverAfter = nil
+	if preferences == nil {
+		return true
+	}
+
+	if notification.Priority == domain.NotificationPriorityUrgent {
+		if preferences.EscalationUserID != "" && preferences.EscalationUserID != notification.UserID {
+			escalateAt := now.Add(preferences.EscalationDelay())
+			notification.EscalateTo = preferences.EscalationUserID
+			notification.EscalateAt = &escalateAt
+		}
+		return true
+	}
+
+	if notification.Priority == domain.NotificationPriorityLow &&
+		preferences.DigestInterval() > 0 && preferences.EmailEnabled && s.mailer != nil {
+		notification.DeliveryStatus = domain.NotificationDeliveryDigest
+		return true
+	}
+
+	if until, quiet := preferences.QuietHoursUntil(now); quiet {
+		notification.DeliveryStatus = domain.NotificationDeliveryDeferred
+		notification.DeliverAfter = &until
+	}
+	return true
+}
+
+// isDuplicate: 중복 억제 기간 내에 같은 알림이 있으면 반복 횟수를 기록하고 true를 반환합니다
+func (s *Service) isDuplicate(ctx context.Context, notification *domain.Notification, now time.Time) bool {
+	if s.dedupWindow <= 0 {
+		return false
+	}
+
+	duplicate, err := s.notificationRepo.FindRecentDuplicate(ctx, notification.UserID, notification.DedupKey, now.Add(-s.dedupWindow))
+	if err != nil {
+		// 중복 확인에 실패하면 알림을 놓치지 않도록 전달
+		s.logger.Warn("Failed to check duplicate notification",
+			zap.String("user_id", notification.UserID),
+			zap.Error(err))
+		return false
+	}
+	if duplicate == nil {
+		return false
+	}
+
+	if err := s.notificationRepo.IncrementDuplicate(ctx, duplicate.ID); err != nil {
+		s.logger.Warn("Failed to count duplicate notification",
+			zap.String("notification_id", duplicate.ID),
+			zap.Error(err))
+	}
+	s.logger.Debug("Duplicate notification suppressed",
+		zap.String("user_id", notification.UserID),
+		zap.String("duplicate_of", duplicate.ID))
+	return true
+}
+
+// ReleaseDeferredNotifications: 방해 금지 시간이 끝난 알림을 전달하고 실시간 이벤트를 발행합니다
+func (s *Service) ReleaseDeferredNotifications(ctx context.Context, limit int) (int, error) {
+	notifications, err := s.notificationRepo.ReleaseDeferred(ctx, time.Now(), limit)
+	if err != nil {
+		return 0, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to release deferred notifications: %v", err), 500)
+	}
+
+	if s.eventService != nil {
+		for _, notification := range notifications {
+			_ = s.eventService.Publish(ctx, "notification.created", notification)
+		}
+	}
+	return len(notifications), nil
+}
+
+// EscalateUnacknowledged: 제한 시간 내에 확인되지 않은 긴급 알림을 보조 수신자에게 전달합니다
+// 에스컬레이션 알림은 원본과 같은 그룹이므로 어느 쪽에서 확인해도 함께 확인 처리됩니다
+func (s *Service) EscalateUnacknowledged(ctx context.Context, limit int) (int, error) {
+	now := time.Now()
+	notifications, err := s.notificationRepo.ClaimEscalations(ctx, now, limit)
+	if err != nil {
+		return 0, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to claim notification escalations: %v", err), 500)
+	}
+
+	for _, original := range notifications {
+		s.escalate(ctx, original, now)
+	}
+	return len(notifications), nil
+}
+
+// escalate: 원본 알림을 보조 수신자에게 긴급 알림으로 전달합니다 (이메일 설정 시 이메일도 전송)
+func (s *Service) escalate(ctx context.Context, original *domain.Notification, now time.Time) {
+	groupID := original.GroupID
+	if groupID == "" {
+		groupID = original.ID
+	}
+
+	recipientName := original.UserID
+	if user := s.lookupUser(original.UserID); user != nil {
+		recipientName = user.GetDisplayName()
+	}
+	waited := now.Sub(original.CreatedAt).Round(time.Minute)
+
+	escalation := &domain.Notification{
+		ID:             uuid.New().String(),
+		UserID:         original.EscalateTo,
+		WorkspaceID:    original.WorkspaceID,
+		GroupID:        groupID,
+		Type:           original.Type,
+		Title:          fmt.Sprintf("[Escalated] %s", original.Title),
+		Message:        fmt.Sprintf("Not acknowledged by %s for %s. %s", recipientName, waited, original.Message),
+		Category:       original.Category,
+		Priority:       domain.NotificationPriorityUrgent,
+		Data:           original.Data,
+		DedupKey:       "escalation:" + groupID,
+		DeliveryStatus: domain.NotificationDeliveryDelivered,
+		CreatedAt:      now,
+	}
+
+	// 같은 그룹의 여러 수신자가 같은 보조 수신자를 지정한 경우 한 번만 전달
+	if s.isDuplicate(ctx, escalation, now) {
+		return
+	}
+	if err := s.notificationRepo.Create(ctx, escalation); err != nil {
+		s.logger.Warn("Failed to escalate notification",
+			zap.String("notification_id", original.ID),
+			zap.String("escalate_to", original.EscalateTo),
+			zap.Error(err))
+		return
+	}
+	if s.eventService != nil {
+		_ = s.eventService.Publish(ctx, "notification.created", escalation)
+	}
+
+	s.emailEscalation(ctx, escalation)
+
+	s.logger.Info("Escalated unacknowledged notification",
+		zap.String("notification_id", original.ID),
+		zap.String("group_id", groupID),
+		zap.String("user_id", original.UserID),
+		zap.String("escalate_to", original.EscalateTo))
+}
+
+// emailEscalation: 보조 수신자가 이메일 알림을 허용하면 에스컬레이션을 이메일로도 전송합니다
+func (s *Service) emailEscalation(ctx context.Context, escalation *domain.Notification) {
+	if s.mailer == nil {
+		return
+	}
+	if preferences := s.preferencesFor(ctx, escalation.UserID); preferences != nil && !preferences.EmailEnabled {
+		return
+	}
+	user := s.lookupUser(escalation.UserID)
+	if user == nil || user.Email == "" {
+		return
+	}
+	if err := s.mailer.SendNotification(user.Email, escalation); err != nil {
+		s.logger.Warn("Failed to email escalated notification",
+			zap.String("notification_id", escalation.ID),
+			zap.String("user_id", escalation.UserID),
+			zap.Error(err))
+	}
+}
+
+// SendDigests: 다이제스트 주기가 된 사용자에게 대기 중인 낮은 우선순위 알림을 이메일로 묶어 전송합니다
+// 다이제스트를 더 이상 받을 수 없는 사용자(설정 변경, 이메일 미설정)의 대기 알림은 이메일 없이 정리합니다
+func (s *Service) SendDigests(ctx context.Context) (int, error) {
+	userIDs, err := s.notificationRepo.ListDigestUserIDs(ctx)
+	if err != nil {
+		return 0, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to list digest recipients: %v", err), 500)
+	}
+
+	now := time.Now()
+	sent := 0
+	for _, userID := range userIDs {
+		preferences := s.preferencesFor(ctx, userID)
+		emailable := s.mailer != nil && preferences != nil && preferences.EmailEnabled && preferences.DigestInterval() > 0
+		var user *domain.User
+		if emailable {
+			if user = s.lookupUser(userID); user == nil || user.Email == "" {
+				emailable = false
+			}
+		}
+		if emailable {
+			if !preferences.DigestDue(now) {
+				continue
+			}
+			if _, quiet := preferences.QuietHoursUntil(now); quiet {
+				continue
+			}
+		}
+
+		notifications, err := s.notificationRepo.ClaimDigest(ctx, userID, s.digestBatchSize)
+		if err != nil {
+			s.logger.Warn("Failed to claim digest notifications",
+				zap.String("user_id", userID),
+				zap.Error(err))
+			continue
+		}
+		if len(notifications) == 0 || !emailable {
+			continue
+		}
+
+		if err := s.mailer.SendDigest(user.Email, notifications); err != nil {
+			s.logger.Warn("Failed to send notification digest",
+				zap.String("user_id", userID),
+				zap.Int("count", len(notifications)),
+				zap.Error(err))
+			ids := make([]string, 0, len(notifications))
+			for _, notification := range notifications {
+				ids = append(ids, notification.ID)
+			}
+			if err := s.notificationRepo.RequeueDigest(ctx, ids); err != nil {
+				s.logger.Warn("Failed to requeue digest notifications",
+					zap.String("user_id", userID),
+					zap.Error(err))
+			}
+			continue
+		}
+
+		if err := s.preferencesRepo.SetLastDigestAt(ctx, userID, now); err != nil {
+			s.logger.Warn("Failed to record last digest time",
+				zap.String("user_id", userID),
+				zap.Error(err))
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+// preferencesFor: 사용자의 알림 설정을 조회합니다 (조회 실패 시 nil이며 설정 없이 즉시 전달)
+func (s *Service) preferencesFor(ctx context.Context, userID string) *domain.NotificationPreferences {
+	preferences, err := s.preferencesRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Warn("Failed to get notification preferences",
+			zap.String("user_id", userID),
+			zap.Error(err))
+		return nil
+	}
+	return preferences
+}
+
+// lookupUser: 사용자를 조회합니다 (없거나 실패하면 nil)
+func (s *Service) lookupUser(userID string) *domain.User {
+	id, err := uuid.Parse(userID)
+	if err != nil || s.userRepo == nil {
+		return nil
+	}
+	user, err := s.userRepo.GetByID(id)
+	if err != nil {
+		return nil
+	}
+	return user
+}
@@ -19,20 +19,6 @@ type Notification struct {
 	ReadAt      *time.Time             `json:"read_at,omitempty"`
 }
 
-// NotificationTemplate represents a notification template
-type NotificationTemplate struct {
-	ID        string                `json:"id"`
-	Name      string                `json:"name"`
-	Type      NotificationType      `json:"type"`
-	Priority  NotificationPriority  `json:"priority"`
-	Title     string                `json:"title"`
-	Message   string                `json:"message"`
-	Channels  []NotificationChannel `json:"channels"`
-	Variables []string              `json:"variables"`
-	CreatedAt time.Time             `json:"created_at"`
-	UpdatedAt time.Time             `json:"updated_at"`
-}
-
 // NotificationPreferences represents user notification preferences (service-level DTO)
 // Note: This is different from domain.NotificationPreferences which is the persistence model
 type NotificationPreferences struct {
@@ -3,9 +3,11 @@ package notification
 import (
 	"context"
 	"fmt"
+	"skyclust/internal/application/services/common"
 	"skyclust/internal/domain"
 	"time"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
@@ -14,30 +16,54 @@ type Service struct {
 	logger           *zap.Logger
 	notificationRepo domain.NotificationRepository
 	preferencesRepo  domain.NotificationPreferencesRepository
+	templateRepo     domain.NotificationTemplateRepository
 	auditLogRepo     domain.AuditLogRepository
 	userRepo         domain.UserRepository
 	workspaceRepo    domain.WorkspaceRepository
 	eventService     domain.EventService
+	chatService      domain.ChatChannelService
+	mailer           Mailer
+	dedupWindow      time.Duration
+	digestBatchSize  int
 }
 
+// chatDispatchTimeout: 채팅 채널 전송 제한 시간
+const chatDispatchTimeout = 30 * time.Second
+
 // NewService: 새로운 알림 서비스를 생성합니다
 func NewService(
 	logger *zap.Logger,
 	notificationRepo domain.NotificationRepository,
 	preferencesRepo domain.NotificationPreferencesRepository,
+	templateRepo domain.NotificationTemplateRepository,
 	auditLogRepo domain.AuditLogRepository,
 	userRepo domain.UserRepository,
 	workspaceRepo domain.WorkspaceRepository,
 	eventService domain.EventService,
+	chatService domain.ChatChannelService,
+	mailer Mailer,
+	config Config,
 ) domain.NotificationService {
+	if config.DedupWindow == 0 {
+		config.DedupWindow = defaultDedupWindow
+	}
+	if config.DigestBatchSize <= 0 {
+		config.DigestBatchSize = defaultDigestBatchSize
+	}
+
 	return &Service{
 		logger:           logger,
 		notificationRepo: notificationRepo,
 		preferencesRepo:  preferencesRepo,
+		templateRepo:     templateRepo,
 		auditLogRepo:     auditLogRepo,
 		userRepo:         userRepo,
 		workspaceRepo:    workspaceRepo,
 		eventService:     eventService,
+		chatService:      chatService,
+		mailer:           mailer,
+		dedupWindow:      config.DedupWindow,
+		digestBatchSize:  config.DigestBatchSize,
 	}
 }
 
@@ -59,47 +85,6 @@ func (s *Service) GetUserNotifications(ctx context.Context, userID string, limit
 	return s.getMockNotifications(userID, limit, offset), nil
 }
 
-// CreateTemplate: 알림 템플릿을 생성합니다
-func (s *Service) CreateTemplate(ctx context.Context, template *NotificationTemplate) error {
-	// This would typically save to a templates table
-	// For now, we'll just log the action
-	s.logger.Info("Notification template created", zap.String("template_id", template.ID))
-	return nil
-}
-
-// SendTemplateNotification: 템플릿을 사용하여 알림을 전송합니다
-func (s *Service) SendTemplateNotification(ctx context.Context, templateID string, userID string, variables map[string]interface{}) error {
-	// This would typically load the template and substitute variables
-	// For now, we'll create a basic notification
-	notification := &Notification{
-		ID:        fmt.Sprintf("template-%s-%d", templateID, time.Now().Unix()),
-		UserID:    userID,
-		Type:      NotificationTypeInfo,
-		Priority:  PriorityMedium,
-		Title:     "Template Notification",
-		Message:   "This is a template-based notification",
-		Channels:  []NotificationChannel{ChannelInApp},
-		Data:      variables,
-		CreatedAt: time.Now(),
-	}
-
-	// Convert to domain.Notification
-	domainNotification := &domain.Notification{
-		ID:        notification.ID,
-		UserID:    notification.UserID,
-		Type:      string(notification.Type),
-		Title:     notification.Title,
-		Message:   notification.Message,
-		Category:  "system", // Default category
-		Priority:  string(notification.Priority),
-		IsRead:    notification.Read,
-		Data:      fmt.Sprintf("%v", notification.Data),
-		CreatedAt: notification.CreatedAt,
-	}
-
-	return s.SendNotification(ctx, notification.UserID, domainNotification)
-}
-
 // Helper methods
 
 func (s *Service) getMockNotifications(userID string, limit, offset int) []*Notification {
@@ -238,6 +223,12 @@ func (s *Service) GetNotificationPreferences(ctx context.Context, userID string)
 func (s *Service) UpdateNotificationPreferences(ctx context.Context, userID string, preferences *domain.NotificationPreferences) error {
 	// Ensure userID matches
 	preferences.UserID = userID
+	if err := preferences.ValidateDelivery(); err != nil {
+		return err
+	}
+	if preferences.EscalationUserID != "" && s.lookupUser(preferences.EscalationUserID) == nil {
+		return domain.NewDomainError(domain.ErrCodeValidationFailed, fmt.Sprintf("escalation user not found: %s", preferences.EscalationUserID), 400)
+	}
 
 	// Use Upsert to create or update
 	if err := s.preferencesRepo.Upsert(ctx, preferences); err != nil {
@@ -256,54 +247,141 @@ func (s *Service) GetNotificationStats(ctx context.Context, userID string) (*dom
 }
 
 // SendNotification: 사용자에게 알림을 전송합니다
+// 수신자의 알림 설정(수신 여부, 중복 억제, 방해 금지 시간, 다이제스트, 에스컬레이션)에 따라 전달됩니다
 func (s *Service) SendNotification(ctx context.Context, userID string, notification *domain.Notification) error {
 	// Ensure userID matches
 	notification.UserID = userID
+	if notification.GroupID == "" {
+		notification.GroupID = notification.ID
+	}
 
-	// Create notification in database
-	if err := s.notificationRepo.Create(ctx, notification); err != nil {
+	stored, err := s.deliver(ctx, notification)
+	if err != nil {
 		return domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to send notification: %v", err), 500)
 	}
-
-	// Publish event if event service is available
-	if s.eventService != nil {
-		_ = s.eventService.Publish(ctx, "notification.created", notification)
+	if !stored {
+		return nil
 	}
 
+	// Workspace chat channels are shared, so quiet hours and digests of the recipient do not apply
+	s.dispatchToChat(ctx, notification, []string{userID})
+
 	return nil
 }
 
 // SendBulkNotification: 여러 사용자에게 알림을 전송합니다
 func (s *Service) SendBulkNotification(ctx context.Context, userIDs []string, notification *domain.Notification) error {
+	// All per-user copies share one group so chat channels post (and acknowledge) once
+	groupID := notification.GroupID
+	if groupID == "" {
+		groupID = notification.ID
+	}
+
 	// Create notification for each user
+	var delivered []string
 	for _, userID := range userIDs {
 		bulkNotification := *notification // Copy
 		bulkNotification.UserID = userID
+		bulkNotification.GroupID = groupID
 		// Ensure unique ID per user (append userID to notification ID)
 		bulkNotification.ID = fmt.Sprintf("%s-%s", notification.ID, userID)
 
-		if err := s.notificationRepo.Create(ctx, &bulkNotification); err != nil {
+		stored, err := s.deliver(ctx, &bulkNotification)
+		if err != nil {
 			s.logger.Warn("Failed to send notification to user",
 				zap.String("user_id", userID),
 				zap.Error(err))
 			// Continue with other users instead of failing completely
 			continue
 		}
-
-		// Publish event if event service is available
-		if s.eventService != nil {
-			_ = s.eventService.Publish(ctx, "notification.created", &bulkNotification)
+		if stored {
+			delivered = append(delivered, userID)
 		}
 	}
 
+	if len(delivered) > 0 {
+		groupNotification := *notification
+		groupNotification.GroupID = groupID
+		s.dispatchToChat(ctx, &groupNotification, delivered)
+	}
+
 	s.logger.Info("Bulk notification sent",
 		zap.Strings("user_ids", userIDs),
 		zap.String("title", notification.Title),
-		zap.Int("count", len(userIDs)))
+		zap.Int("count", len(userIDs)),
+		zap.Int("delivered", len(delivered)))
 
 	return nil
 }
 
+// AcknowledgeNotification: 알림을 확인 처리합니다
+// 같은 그룹(대량 전송된 사본)의 모든 알림이 함께 확인 처리됩니다
+func (s *Service) AcknowledgeNotification(ctx context.Context, userID, notificationID string) (*domain.Notification, error) {
+	notification, err := s.notificationRepo.GetByID(ctx, userID, notificationID)
+	if err != nil || notification == nil {
+		return nil, domain.NewDomainError(domain.ErrCodeNotFound, fmt.Sprintf("notification not found: %s", notificationID), 404)
+	}
+	if notification.AcknowledgedAt != nil {
+		return notification, nil
+	}
+
+	now := time.Now()
+	if notification.GroupID != "" {
+		if _, err := s.notificationRepo.Acknowledge(ctx, notification.GroupID, userID, now); err != nil {
+			return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to acknowledge notification: %v", err), 500)
+		}
+		notification, err = s.notificationRepo.GetByID(ctx, userID, notificationID)
+		if err != nil {
+			return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get notification: %v", err), 500)
+		}
+	} else {
+		// Legacy rows created before grouping
+		notification.AcknowledgedAt = &now
+		notification.AcknowledgedBy = userID
+		notification.IsRead = true
+		if notification.ReadAt == nil {
+			notification.ReadAt = &now
+		}
+		if err := s.notificationRepo.Update(ctx, notification); err != nil {
+			return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to acknowledge notification: %v", err), 500)
+		}
+	}
+
+	actorID, err := uuid.Parse(userID)
+	if err == nil {
+		common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionNotificationAcknowledge,
+			fmt.Sprintf("POST /api/v1/notifications/%s/acknowledge", notificationID),
+			map[string]interface{}{
+				"notification_id": notificationID,
+				"group_id":        notification.GroupID,
+				"workspace_id":    notification.WorkspaceID,
+			},
+		)
+	}
+
+	return notification, nil
+}
+
+// dispatchToChat: 워크스페이스 알림을 채팅 채널로 비동기 전송합니다
+// 요청 컨텍스트가 응답 후 취소되어도 전송이 끝나도록 취소 신호를 분리합니다
+func (s *Service) dispatchToChat(ctx context.Context, notification *domain.Notification, recipientIDs []string) {
+	if s.chatService == nil || notification.WorkspaceID == "" {
+		return
+	}
+
+	copied := *notification
+	go func(ctx context.Context) {
+		ctx, cancel := context.WithTimeout(ctx, chatDispatchTimeout)
+		defer cancel()
+		if err := s.chatService.Dispatch(ctx, &copied, recipientIDs); err != nil {
+			s.logger.Warn("Failed to dispatch notification to chat channels",
+				zap.String("notification_id", copied.ID),
+				zap.String("workspace_id", copied.WorkspaceID),
+				zap.Error(err))
+		}
+	}(context.WithoutCancel(ctx))
+}
+
 // CleanupOldNotifications: 오래된 알림을 제거합니다
 func (s *Service) CleanupOldNotifications(ctx context.Context, olderThan time.Duration) error {
 	// Call repository cleanup method
@@ -0,0 +1,327 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"skyclust/internal/application/services/common"
+	"skyclust/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ListTemplates: 활성 알림 템플릿 목록을 조회합니다 (key가 있으면 해당 키의 로케일별 템플릿)
+func (s *Service) ListTemplates(ctx context.Context, key string) ([]*domain.NotificationTemplate, error) {
+	templates, err := s.templateRepo.ListActive(ctx, strings.TrimSpace(key))
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to list notification templates: %v", err), 500)
+	}
+	return templates, nil
+}
+
+// GetTemplate: 알림 템플릿을 조회합니다 (version이 0이면 활성 버전)
+func (s *Service) GetTemplate(ctx context.Context, key, locale string, version int) (*domain.NotificationTemplate, error) {
+	var (
+		template *domain.NotificationTemplate
+		err      error
+	)
+	if version > 0 {
+		template, err = s.templateRepo.GetVersion(ctx, key, locale, version)
+	} else {
+		template, err = s.templateRepo.GetActive(ctx, key, locale)
+	}
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get notification template: %v", err), 500)
+	}
+	if template == nil {
+		return nil, domain.NewDomainError(domain.ErrCodeNotFound, fmt.Sprintf("notification template not found: %s (%s)", key, locale), 404)
+	}
+	return template, nil
+}
+
+// ListTemplateVersions: 알림 템플릿의 버전 목록을 최신순으로 조회합니다
+func (s *Service) ListTemplateVersions(ctx context.Context, key, locale string) ([]*domain.NotificationTemplate, error) {
+	versions, err := s.templateRepo.ListVersions(ctx, key, locale)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to list notification template versions: %v", err), 500)
+	}
+	if len(versions) == 0 {
+		return nil, domain.NewDomainError(domain.ErrCodeNotFound, fmt.Sprintf("notification template not found: %s (%s)", key, locale), 404)
+	}
+	return versions, nil
+}
+
+// SaveTemplate: 알림 템플릿을 검증한 뒤 새 버전으로 저장합니다
+// 기존 버전은 그대로 남으므로 ActivateTemplateVersion으로 되돌릴 수 있습니다
+func (s *Service) SaveTemplate(ctx context.Context, actorID uuid.UUID, req domain.SaveNotificationTemplateRequest) (*domain.NotificationTemplate, error) {
+	template := templateFromRequest(req)
+	template.CreatedBy = &actorID
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+
+	activate := req.Activate == nil || *req.Activate
+	if err := s.templateRepo.CreateVersion(ctx, template, activate); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to save notification template: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionNotificationTemplateSave,
+		"POST /api/v1/admin/notification-templates",
+		map[string]interface{}{
+			"key":     template.Key,
+			"locale":  template.Locale,
+			"version": template.Version,
+			"active":  template.Active,
+		},
+	)
+
+	return template, nil
+}
+
+// ActivateTemplateVersion: 알림 템플릿의 특정 버전을 활성화합니다 (이전 버전으로 되돌리기)
+func (s *Service) ActivateTemplateVersion(ctx context.Context, actorID uuid.UUID, key, locale string, version int) (*domain.NotificationTemplate, error) {
+	if err := s.templateRepo.Activate(ctx, key, locale, version); err != nil {
+		if _, ok := err.(*domain.DomainError); ok {
+			return nil, err
+		}
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to activate notification template: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionNotificationTemplateActivate,
+		fmt.Sprintf("POST /api/v1/admin/notification-templates/%s/%s/versions/%d/activate", key, locale, version),
+		map[string]interface{}{
+			"key":     key,
+			"locale":  locale,
+			"version": version,
+		},
+	)
+
+	return s.GetTemplate(ctx, key, locale, version)
+}
+
+// DeleteTemplate: 알림 템플릿의 모든 버전을 삭제합니다
+func (s *Service) DeleteTemplate(ctx context.Context, actorID uuid.UUID, key, locale string) error {
+	deleted, err := s.templateRepo.Delete(ctx, key, locale)
+	if err != nil {
+		return domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to delete notification template: %v", err), 500)
+	}
+	if deleted == 0 {
+		return domain.NewDomainError(domain.ErrCodeNotFound, fmt.Sprintf("notification template not found: %s (%s)", key, locale), 404)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionNotificationTemplateDelete,
+		fmt.Sprintf("DELETE /api/v1/admin/notification-templates/%s/%s", key, locale),
+		map[string]interface{}{
+			"key":      key,
+			"locale":   locale,
+			"versions": deleted,
+		},
+	)
+	return nil
+}
+
+// PreviewTemplate: 저장된 템플릿 또는 초안을 예시 데이터로 렌더링합니다
+func (s *Service) PreviewTemplate(ctx context.Context, req domain.PreviewNotificationTemplateRequest) (*domain.NotificationTemplatePreview, error) {
+	var template *domain.NotificationTemplate
+	if req.Template != nil {
+		template = templateFromRequest(*req.Template)
+		// 미리보기는 전달된 데이터로 렌더링하므로 예시 데이터 검증은 생략
+		sampleData := template.SampleData
+		template.SampleData = nil
+		if err := template.Validate(); err != nil {
+			return nil, err
+		}
+		template.SampleData = sampleData
+	} else {
+		if req.Key == "" || req.Locale == "" {
+			return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "either template or key and locale are required", 400)
+		}
+		stored, err := s.GetTemplate(ctx, req.Key, req.Locale, req.Version)
+		if err != nil {
+			return nil, err
+		}
+		template = stored
+	}
+
+	data := req.Data
+	if data == nil {
+		data = template.SampleData
+	}
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+
+	rendered, err := template.Render(data)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.NotificationTemplatePreview{
+		Key:      template.Key,
+		Locale:   template.Locale,
+		Version:  template.Version,
+		Rendered: rendered,
+	}, nil
+}
+
+// SendTemplateNotification: 템플릿으로 알림을 렌더링하여 전송합니다
+// 수신자마다 알림 설정의 로케일 템플릿을 사용하고, 없으면 기본 로케일(en) 템플릿을 사용합니다
+func (s *Service) SendTemplateNotification(ctx context.Context, req domain.TemplateNotificationRequest) error {
+	if len(req.UserIDs) == 0 {
+		return nil
+	}
+	variables := req.Variables
+	if variables == nil {
+		variables = map[string]interface{}{}
+	}
+
+	// 로케일별 렌더링 결과 캐시
+	rendered := make(map[string]*renderedTemplate)
+	render := func(locale string) (*renderedTemplate, error) {
+		if cached, ok := rendered[locale]; ok {
+			return cached, nil
+		}
+		template, err := s.resolveTemplate(ctx, req.TemplateKey, locale)
+		if err != nil {
+			return nil, err
+		}
+		output, err := template.Render(variables)
+		if err != nil {
+			return nil, err
+		}
+		result := &renderedTemplate{template: template, output: output}
+		rendered[locale] = result
+		return result, nil
+	}
+
+	groupID := uuid.New().String()
+	createdAt := time.Now()
+	var delivered []string
+	for _, userID := range req.UserIDs {
+		result, err := render(s.userLocale(ctx, userID))
+		if err != nil {
+			return err
+		}
+
+		notification := &domain.Notification{
+			ID:          uuid.New().String(),
+			UserID:      userID,
+			WorkspaceID: req.WorkspaceID,
+			GroupID:     groupID,
+			Type:        result.template.Type,
+			Title:       result.output.Title,
+			Message:     result.output.Text,
+			Category:    result.template.Category,
+			Priority:    result.template.Priority,
+			Data:        req.Data,
+			CreatedAt:   createdAt,
+		}
+		stored, err := s.deliver(ctx, notification)
+		if err != nil {
+			s.logger.Warn("Failed to send template notification to user",
+				zap.String("template_key", req.TemplateKey),
+				zap.String("user_id", userID),
+				zap.Error(err))
+			continue
+		}
+		if stored {
+			delivered = append(delivered, userID)
+		}
+	}
+
+	// 채팅 채널은 워크스페이스 공용이므로 기본 로케일의 채팅 본문으로 한 번만 게시
+	if len(delivered) > 0 && req.WorkspaceID != "" {
+		result, err := render(domain.DefaultNotificationLocale)
+		if err != nil {
+			s.logger.Warn("Failed to render chat body of template notification",
+				zap.String("template_key", req.TemplateKey),
+				zap.Error(err))
+			return nil
+		}
+		s.dispatchToChat(ctx, &domain.Notification{
+			ID:          groupID,
+			WorkspaceID: req.WorkspaceID,
+			GroupID:     groupID,
+			Type:        result.template.Type,
+			Title:       result.output.Title,
+			Message:     result.output.Chat,
+			Category:    result.template.Category,
+			Priority:    result.template.Priority,
+			Data:        req.Data,
+			CreatedAt:   createdAt,
+		}, delivered)
+	}
+
+	s.logger.Info("Template notification sent",
+		zap.String("template_key", req.TemplateKey),
+		zap.Int("count", len(delivered)))
+	return nil
+}
+
+// renderedTemplate: 로케일별로 선택된 템플릿과 렌더링 결과
+type renderedTemplate struct {
+	template *domain.NotificationTemplate
+	output   *domain.RenderedNotification
+}
+
+// resolveTemplate: 로케일의 활성 템플릿을 찾고, 없으면 기본 로케일 템플릿을 사용합니다
+func (s *Service) resolveTemplate(ctx context.Context, key, locale string) (*domain.NotificationTemplate, error) {
+	template, err := s.templateRepo.GetActive(ctx, key, locale)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get notification template: %v", err), 500)
+	}
+	if template == nil && locale != domain.DefaultNotificationLocale {
+		template, err = s.templateRepo.GetActive(ctx, key, domain.DefaultNotificationLocale)
+		if err != nil {
+			return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get notification template: %v", err), 500)
+		}
+	}
+	if template == nil {
+		return nil, domain.NewDomainError(domain.ErrCodeNotFound, fmt.Sprintf("notification template not found: %s", key), 404)
+	}
+	return template, nil
+}
+
+// userLocale: 사용자 알림 설정의 로케일을 반환합니다 (없으면 기본 로케일)
+func (s *Service) userLocale(ctx context.Context, userID string) string {
+	preferences, err := s.preferencesRepo.GetByUserID(ctx, userID)
+	if err != nil || preferences == nil {
+		return domain.DefaultNotificationLocale
+	}
+	if locale := domain.NormalizeNotificationLocale(preferences.Locale); locale != "" {
+		return locale
+	}
+	return domain.DefaultNotificationLocale
+}
+
+// templateFromRequest: 저장 요청을 템플릿 엔티티로 변환합니다
+func templateFromRequest(req domain.SaveNotificationTemplateRequest) *domain.NotificationTemplate {
+	variables := make([]string, 0, len(req.Variables))
+	seen := make(map[string]bool, len(req.Variables))
+	for _, variable := range req.Variables {
+		variable = strings.TrimSpace(variable)
+		if variable == "" || seen[variable] {
+			continue
+		}
+		seen[variable] = true
+		variables = append(variables, variable)
+	}
+
+	return &domain.NotificationTemplate{
+		Key:         strings.TrimSpace(req.Key),
+		Locale:      strings.TrimSpace(req.Locale),
+		Name:        strings.TrimSpace(req.Name),
+		Description: req.Description,
+		Type:        req.Type,
+		Category:    strings.TrimSpace(req.Category),
+		Priority:    req.Priority,
+		Title:       req.Title,
+		TextBody:    req.TextBody,
+		HTMLBody:    req.HTMLBody,
+		ChatBody:    req.ChatBody,
+		Variables:   domain.StringList(variables),
+		SampleData:  domain.JSONBMap(req.SampleData),
+	}
+}
@@ -19,3 +19,12 @@ const (
 	// StateMaxAge is the maximum age for OIDC state before expiration
 	StateMaxAge = 10 * time.Minute
 )
+
+// SAML constants
+const (
+	// SAMLSessionTTL is the time-to-live for SAML session entries used by single logout
+	SAMLSessionTTL = 24 * time.Hour
+
+	// SAMLCertificateValidity is the validity period of generated SP signing certificates
+	SAMLCertificateValidity = 10 * 365 * 24 * time.Hour
+)
@@ -30,3 +30,26 @@ type OIDCUserInfo struct {
 	Name     string `json:"name"`
 	Avatar   string `json:"avatar_url"`
 }
+
+// SSOIdentity represents the identity asserted by an organization SSO provider
+type SSOIdentity struct {
+	Subject  string
+	Email    string
+	Username string
+	Groups   []string
+}
+
+// SAMLRequestState represents a pending SAML AuthnRequest keyed by RelayState
+type SAMLRequestState struct {
+	ProviderID string    `json:"provider_id"`
+	RequestID  string    `json:"request_id"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// SAMLSession represents a session established through a SAML assertion
+type SAMLSession struct {
+	UserID       string `json:"user_id"`
+	NameID       string `json:"name_id"`
+	SessionIndex string `json:"session_index,omitempty"`
+	Token        string `json:"token"`
+}
@@ -0,0 +1,590 @@
+package oidc
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"skyclust/internal/application/services/common"
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+	"skyclust/pkg/security"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/crewjam/saml"
+	"github.com/google/uuid"
+	xrv "github.com/mattermost/xml-roundtrip-validator"
+	dsig "github.com/russellhaering/goxmldsig"
+)
+
+// Default assertion attribute names used when the provider does not configure one
+var (
+	defaultSAMLEmailAttributes = []string{
+		"email",
+		"mail",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress",
+		"urn:oid:0.9.2342.19200300.100.1.3",
+	}
+	defaultSAMLUsernameAttributes = []string{
+		"username",
+		"uid",
+		"displayName",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/name",
+		"urn:oid:0.9.2342.19200300.100.1.1",
+	}
+)
+
+var whitespace = regexp.MustCompile(`\s+`)
+
+// GetSAMLMetadata: SAML SP 메타데이터 XML을 반환합니다
+func (s *Service) GetSAMLMetadata(ctx context.Context, providerID uuid.UUID) ([]byte, error) {
+	provider, err := s.getSAMLProvider(providerID)
+	if err != nil {
+		return nil, err
+	}
+
+	sp, err := s.samlServiceProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := xml.MarshalIndent(sp.Metadata(), "", "  ")
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, "failed to marshal SAML metadata", 500)
+	}
+
+	return append([]byte(xml.Header), metadata...), nil
+}
+
+// GetSAMLAuthURL: 서명된 AuthnRequest를 포함한 IdP 리다이렉트 URL을 반환합니다
+func (s *Service) GetSAMLAuthURL(ctx context.Context, providerID uuid.UUID, relayState string) (string, error) {
+	provider, err := s.getSAMLProvider(providerID)
+	if err != nil {
+		return "", err
+	}
+	if !provider.IsEnabled() {
+		return "", domain.NewDomainError(domain.ErrCodeValidationFailed, "SSO provider is disabled", 400)
+	}
+
+	sp, err := s.samlServiceProvider(provider)
+	if err != nil {
+		return "", err
+	}
+
+	ssoURL := sp.GetSSOBindingLocation(saml.HTTPRedirectBinding)
+	if ssoURL == "" {
+		return "", domain.NewDomainError(domain.ErrCodeValidationFailed, "IdP metadata has no HTTP-Redirect SSO endpoint", 400)
+	}
+
+	authnRequest, err := sp.MakeAuthenticationRequest(ssoURL, saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+	if err != nil {
+		return "", domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to create AuthnRequest: %v", err), 500)
+	}
+
+	redirectURL, err := authnRequest.Redirect(url.QueryEscape(relayState), sp)
+	if err != nil {
+		return "", domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to sign AuthnRequest: %v", err), 500)
+	}
+
+	// Store the request ID so the ACS endpoint only accepts responses to our own requests
+	requestState := SAMLRequestState{
+		ProviderID: provider.ID.String(),
+		RequestID:  authnRequest.ID,
+		Timestamp:  time.Now(),
+	}
+	if err := s.cacheService.Set(ctx, samlRequestKey(relayState), requestState, StateCacheTTL); err != nil {
+		return "", domain.NewDomainError(domain.ErrCodeInternalError, "failed to store state", 500)
+	}
+
+	return redirectURL.String(), nil
+}
+
+// ConsumeSAMLResponse: ACS로 전달된 서명된 SAML 응답을 검증하고 세션을 발급합니다
+func (s *Service) ConsumeSAMLResponse(ctx context.Context, providerID uuid.UUID, samlResponse, relayState string) (*domain.User, string, error) {
+	provider, err := s.getSAMLProvider(providerID)
+	if err != nil {
+		return nil, "", err
+	}
+	if !provider.IsEnabled() {
+		return nil, "", domain.NewDomainError(domain.ErrCodeValidationFailed, "SSO provider is disabled", 400)
+	}
+
+	requestState, err := s.loadSAMLRequestState(ctx, relayState)
+	if err != nil {
+		return nil, "", err
+	}
+	if requestState.ProviderID != provider.ID.String() {
+		return nil, "", domain.NewDomainError(domain.ErrCodeUnauthorized, "state provider mismatch", 401)
+	}
+
+	sp, err := s.samlServiceProvider(provider)
+	if err != nil {
+		return nil, "", err
+	}
+
+	rawResponse, err := base64.StdEncoding.DecodeString(samlResponse)
+	if err != nil {
+		return nil, "", domain.NewDomainError(domain.ErrCodeBadRequest, "invalid SAMLResponse encoding", 400)
+	}
+
+	assertion, err := sp.ParseXMLResponse(rawResponse, []string{requestState.RequestID}, sp.AcsURL)
+	if err != nil {
+		if invalidErr, ok := err.(*saml.InvalidResponseError); ok {
+			logger.Warnf("Rejected SAML response for provider %s: %v", provider.ID, invalidErr.PrivateErr)
+		}
+		return nil, "", domain.NewDomainError(domain.ErrCodeUnauthorized, "invalid SAML response", 401)
+	}
+
+	// Prevent replay of the same response
+	_ = s.cacheService.Delete(ctx, samlRequestKey(relayState))
+
+	identity, err := s.samlIdentity(provider, assertion)
+	if err != nil {
+		return nil, "", err
+	}
+
+	user, err := s.provisionSSOUser(ctx, provider, identity)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := s.syncSSOGroupMappings(ctx, provider, user, identity.Groups); err != nil {
+		return nil, "", err
+	}
+
+	jwtToken, err := s.issueSession(ctx, user, map[string]interface{}{
+		"provider":      "saml",
+		"provider_id":   provider.ID.String(),
+		"provider_name": provider.Name,
+		"groups":        len(identity.Groups),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Remember the session so IdP-initiated logout can revoke the issued token
+	session := SAMLSession{
+		UserID: user.ID.String(),
+		NameID: identity.Subject,
+		Token:  jwtToken,
+	}
+	for _, statement := range assertion.AuthnStatements {
+		if statement.SessionIndex != "" {
+			session.SessionIndex = statement.SessionIndex
+			break
+		}
+	}
+	_ = s.cacheService.Set(ctx, samlSessionKey(provider.ID, user.ID), session, SAMLSessionTTL)
+
+	return user, jwtToken, nil
+}
+
+// InitiateSAMLLogout: 현재 토큰을 폐기하고 IdP로 보낼 서명된 LogoutRequest URL을 반환합니다
+// IdP가 SLO를 지원하지 않으면 로컬 로그아웃만 수행하고 빈 문자열을 반환합니다
+func (s *Service) InitiateSAMLLogout(ctx context.Context, providerID, userID uuid.UUID, token, relayState string) (string, error) {
+	provider, err := s.getSAMLProvider(providerID)
+	if err != nil {
+		return "", err
+	}
+
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return "", domain.NewDomainError(domain.ErrCodeInternalError, "failed to get user", 500)
+	}
+	if user == nil || user.OIDCProvider != provider.SubjectNamespace() {
+		return "", domain.NewDomainError(domain.ErrCodeValidationFailed, "user is not signed in with this SAML provider", 400)
+	}
+
+	sp, err := s.samlServiceProvider(provider)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.authService.Logout(userID, token); err != nil {
+		return "", err
+	}
+	_ = s.cacheService.Delete(ctx, samlSessionKey(provider.ID, userID))
+
+	common.LogAction(ctx, s.auditLogRepo, &userID, domain.ActionOIDCLogout,
+		fmt.Sprintf("POST /api/v1/auth/saml/%s/logout", providerID),
+		map[string]interface{}{
+			"provider":    "saml",
+			"provider_id": provider.ID.String(),
+			"initiator":   "sp",
+		},
+	)
+
+	if sp.GetSLOBindingLocation(saml.HTTPRedirectBinding) == "" {
+		return "", nil
+	}
+
+	logoutURL, err := sp.MakeRedirectLogoutRequest(user.OIDCSubject, relayState)
+	if err != nil {
+		return "", domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to create LogoutRequest: %v", err), 500)
+	}
+
+	return logoutURL.String(), nil
+}
+
+// HandleSAMLLogout: SLO 엔드포인트로 전달된 LogoutRequest(IdP 시작) 또는 LogoutResponse(SP 시작)를 처리합니다
+// LogoutRequest를 처리한 경우 IdP로 돌려보낼 LogoutResponse URL을 반환합니다
+func (s *Service) HandleSAMLLogout(ctx context.Context, providerID uuid.UUID, samlRequest, samlResponse, relayState string, redirectBinding bool) (string, error) {
+	provider, err := s.getSAMLProvider(providerID)
+	if err != nil {
+		return "", err
+	}
+
+	sp, err := s.samlServiceProvider(provider)
+	if err != nil {
+		return "", err
+	}
+
+	if samlResponse != "" {
+		if redirectBinding {
+			err = sp.ValidateLogoutResponseRedirect(samlResponse)
+		} else {
+			err = sp.ValidateLogoutResponseForm(samlResponse)
+		}
+		if err != nil {
+			logger.Warnf("Rejected SAML logout response for provider %s: %v", provider.ID, err)
+			return "", domain.NewDomainError(domain.ErrCodeUnauthorized, "invalid SAML logout response", 401)
+		}
+		return "", nil
+	}
+
+	if samlRequest == "" {
+		return "", domain.NewDomainError(domain.ErrCodeBadRequest, "SAMLRequest or SAMLResponse is required", 400)
+	}
+
+	logoutRequest, err := s.parseSAMLLogoutRequest(sp, samlRequest, redirectBinding)
+	if err != nil {
+		logger.Warnf("Rejected SAML logout request for provider %s: %v", provider.ID, err)
+		return "", domain.NewDomainError(domain.ErrCodeUnauthorized, "invalid SAML logout request", 401)
+	}
+
+	if logoutRequest.NameID != nil {
+		user, err := s.userRepo.GetByOIDC(provider.SubjectNamespace(), logoutRequest.NameID.Value)
+		if err != nil {
+			return "", domain.NewDomainError(domain.ErrCodeInternalError, "failed to get user", 500)
+		}
+		if user != nil {
+			if err := s.revokeSAMLSession(ctx, provider, user.ID); err != nil {
+				return "", err
+			}
+			common.LogAction(ctx, s.auditLogRepo, &user.ID, domain.ActionOIDCLogout,
+				fmt.Sprintf("POST /api/v1/auth/saml/%s/slo", providerID),
+				map[string]interface{}{
+					"provider":    "saml",
+					"provider_id": provider.ID.String(),
+					"initiator":   "idp",
+				},
+			)
+		}
+	}
+
+	if sp.GetSLOBindingLocation(saml.HTTPRedirectBinding) == "" {
+		return "", nil
+	}
+
+	responseURL, err := sp.MakeRedirectLogoutResponse(logoutRequest.ID, relayState)
+	if err != nil {
+		return "", domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to create LogoutResponse: %v", err), 500)
+	}
+
+	return responseURL.String(), nil
+}
+
+// revokeSAMLSession: SAML 로그인으로 발급한 토큰을 폐기합니다
+func (s *Service) revokeSAMLSession(ctx context.Context, provider *domain.SSOProvider, userID uuid.UUID) error {
+	key := samlSessionKey(provider.ID, userID)
+	value, err := s.cacheService.Get(ctx, key)
+	if err != nil || value == nil {
+		return nil
+	}
+
+	var session SAMLSession
+	if err := decodeCachedValue(value, &session); err != nil || session.Token == "" {
+		_ = s.cacheService.Delete(ctx, key)
+		return nil
+	}
+
+	if err := s.authService.Logout(userID, session.Token); err != nil {
+		return err
+	}
+	_ = s.cacheService.Delete(ctx, key)
+	return nil
+}
+
+// parseSAMLLogoutRequest: IdP가 보낸 LogoutRequest를 디코딩하고 XML 서명과 발급자를 검증합니다
+func (s *Service) parseSAMLLogoutRequest(sp *saml.ServiceProvider, samlRequest string, redirectBinding bool) (*saml.LogoutRequest, error) {
+	raw, err := base64.StdEncoding.DecodeString(samlRequest)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode base64: %w", err)
+	}
+	if redirectBinding {
+		raw, err = io.ReadAll(io.LimitReader(flate.NewReader(bytes.NewReader(raw)), 1<<20))
+		if err != nil {
+			return nil, fmt.Errorf("unable to inflate request: %w", err)
+		}
+	}
+
+	if err := xrv.Validate(bytes.NewReader(raw)); err != nil {
+		return nil, fmt.Errorf("request contains invalid XML: %w", err)
+	}
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(raw); err != nil {
+		return nil, err
+	}
+	if doc.Root() == nil {
+		return nil, fmt.Errorf("empty logout request")
+	}
+
+	certs, err := samlIdPSigningCertificates(sp.IDPMetadata)
+	if err != nil {
+		return nil, err
+	}
+	validationContext := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{Roots: certs})
+	validationContext.IdAttribute = "ID"
+	verified, err := validationContext.Validate(doc.Root())
+	if err != nil {
+		return nil, fmt.Errorf("cannot validate signature: %w", err)
+	}
+	if verified.Tag != "LogoutRequest" {
+		return nil, fmt.Errorf("expected LogoutRequest, got %s", verified.Tag)
+	}
+
+	// Only read fields from the element covered by the signature, never from the raw bytes
+	verifiedDoc := etree.NewDocument()
+	verifiedDoc.SetRoot(verified.Copy())
+	verifiedXML, err := verifiedDoc.WriteToBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var logoutRequest saml.LogoutRequest
+	if err := xml.Unmarshal(verifiedXML, &logoutRequest); err != nil {
+		return nil, err
+	}
+
+	if logoutRequest.Issuer == nil || logoutRequest.Issuer.Value != sp.IDPMetadata.EntityID {
+		return nil, fmt.Errorf("issuer does not match the IdP metadata")
+	}
+	if logoutRequest.Destination != "" && logoutRequest.Destination != sp.SloURL.String() {
+		return nil, fmt.Errorf("destination does not match the SLO URL")
+	}
+	if time.Since(logoutRequest.IssueInstant) > saml.MaxIssueDelay {
+		return nil, fmt.Errorf("logout request expired")
+	}
+
+	return &logoutRequest, nil
+}
+
+// samlIdentity: 검증된 어설션의 NameID와 속성을 SSO 사용자 정보로 매핑합니다
+func (s *Service) samlIdentity(provider *domain.SSOProvider, assertion *saml.Assertion) (*SSOIdentity, error) {
+	if assertion.Subject == nil || assertion.Subject.NameID == nil || assertion.Subject.NameID.Value == "" {
+		return nil, domain.NewDomainError(domain.ErrCodeUnauthorized, "SAML assertion has no NameID", 401)
+	}
+
+	attributes := make(map[string][]string)
+	for _, statement := range assertion.AttributeStatements {
+		for _, attribute := range statement.Attributes {
+			var values []string
+			for _, value := range attribute.Values {
+				if value.Value != "" {
+					values = append(values, value.Value)
+				}
+			}
+			for _, name := range []string{attribute.Name, attribute.FriendlyName} {
+				if name != "" {
+					attributes[name] = append(attributes[name], values...)
+				}
+			}
+		}
+	}
+
+	firstAttribute := func(configured string, defaults []string) string {
+		names := defaults
+		if configured != "" {
+			names = []string{configured}
+		}
+		for _, name := range names {
+			if values := attributes[name]; len(values) > 0 {
+				return values[0]
+			}
+		}
+		return ""
+	}
+
+	nameID := assertion.Subject.NameID.Value
+	identity := &SSOIdentity{
+		Subject:  nameID,
+		Email:    strings.ToLower(firstAttribute(provider.EmailAttribute, defaultSAMLEmailAttributes)),
+		Username: firstAttribute(provider.UsernameAttribute, defaultSAMLUsernameAttributes),
+		Groups:   attributes[provider.GetGroupsClaim()],
+	}
+
+	if identity.Email == "" && strings.Contains(nameID, "@") {
+		identity.Email = strings.ToLower(nameID)
+	}
+	if identity.Email == "" {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "email attribute is required for SSO login", 400)
+	}
+	if identity.Username == "" {
+		identity.Username = strings.Split(identity.Email, "@")[0]
+	}
+
+	return identity, nil
+}
+
+// getSAMLProvider: SAML 프로토콜 SSO 프로바이더를 조회합니다
+func (s *Service) getSAMLProvider(providerID uuid.UUID) (*domain.SSOProvider, error) {
+	provider, err := s.ssoProviderRepo.GetByID(providerID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get provider: %v", err), 500)
+	}
+	if provider == nil || !provider.IsSAML() {
+		return nil, domain.NewDomainError(domain.ErrCodeNotFound, "SAML provider not found", 404)
+	}
+	return provider, nil
+}
+
+// samlServiceProvider: SSO 프로바이더 설정으로 SAML SP를 구성합니다
+func (s *Service) samlServiceProvider(provider *domain.SSOProvider) (*saml.ServiceProvider, error) {
+	cert, key, err := security.ParseRSAKeyPair([]byte(provider.SAMLSPCertificate), []byte(provider.SAMLSPPrivateKey))
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("invalid SAML SP key pair: %v", err), 500)
+	}
+
+	idpMetadata, err := parseSAMLIdPMetadata(provider.SAMLIdPMetadata)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, fmt.Sprintf("invalid IdP metadata: %v", err), 400)
+	}
+
+	metadataURL, err := url.Parse(provider.SAMLMetadataURL())
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "invalid SAML base URL", 400)
+	}
+	acsURL, _ := url.Parse(provider.SAMLACSURL())
+	sloURL, _ := url.Parse(provider.SAMLSLOURL())
+
+	return &saml.ServiceProvider{
+		EntityID:        provider.SAMLMetadataURL(),
+		Key:             key,
+		Certificate:     cert,
+		HTTPClient:      s.httpClient,
+		MetadataURL:     *metadataURL,
+		AcsURL:          *acsURL,
+		SloURL:          *sloURL,
+		IDPMetadata:     idpMetadata,
+		SignatureMethod: dsig.RSASHA256SignatureMethod,
+		LogoutBindings:  []string{saml.HTTPRedirectBinding, saml.HTTPPostBinding},
+	}, nil
+}
+
+// loadSAMLRequestState: RelayState로 저장된 AuthnRequest 정보를 조회합니다
+func (s *Service) loadSAMLRequestState(ctx context.Context, relayState string) (*SAMLRequestState, error) {
+	if relayState == "" {
+		return nil, domain.NewDomainError(domain.ErrCodeUnauthorized, "IdP-initiated SAML login is not supported", 401)
+	}
+
+	value, err := s.cacheService.Get(ctx, samlRequestKey(relayState))
+	if err != nil || value == nil {
+		return nil, domain.NewDomainError(domain.ErrCodeUnauthorized, "invalid or expired state parameter", 401)
+	}
+
+	var requestState SAMLRequestState
+	if err := decodeCachedValue(value, &requestState); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeUnauthorized, "invalid state data format", 401)
+	}
+	if time.Since(requestState.Timestamp) > StateMaxAge {
+		_ = s.cacheService.Delete(ctx, samlRequestKey(relayState))
+		return nil, domain.NewDomainError(domain.ErrCodeUnauthorized, "state parameter expired", 401)
+	}
+
+	return &requestState, nil
+}
+
+// parseSAMLIdPMetadata: IdP 메타데이터 XML을 파싱합니다 (EntitiesDescriptor 지원)
+func parseSAMLIdPMetadata(data string) (*saml.EntityDescriptor, error) {
+	if strings.TrimSpace(data) == "" {
+		return nil, fmt.Errorf("metadata is empty")
+	}
+	if err := xrv.Validate(strings.NewReader(data)); err != nil {
+		return nil, err
+	}
+
+	entity := &saml.EntityDescriptor{}
+	if err := xml.Unmarshal([]byte(data), entity); err != nil {
+		entities := &saml.EntitiesDescriptor{}
+		if err := xml.Unmarshal([]byte(data), entities); err != nil {
+			return nil, err
+		}
+		for i := range entities.EntityDescriptors {
+			if len(entities.EntityDescriptors[i].IDPSSODescriptors) > 0 {
+				return &entities.EntityDescriptors[i], nil
+			}
+		}
+		return nil, fmt.Errorf("no IdP entity descriptor found")
+	}
+
+	if len(entity.IDPSSODescriptors) == 0 {
+		return nil, fmt.Errorf("no IDPSSODescriptor found")
+	}
+	return entity, nil
+}
+
+// samlIdPSigningCertificates: IdP 메타데이터에서 서명 인증서를 추출합니다
+func samlIdPSigningCertificates(metadata *saml.EntityDescriptor) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for _, descriptor := range metadata.IDPSSODescriptors {
+		for _, keyDescriptor := range descriptor.KeyDescriptors {
+			if keyDescriptor.Use != "" && keyDescriptor.Use != "signing" {
+				continue
+			}
+			for _, x509Cert := range keyDescriptor.KeyInfo.X509Data.X509Certificates {
+				der, err := base64.StdEncoding.DecodeString(whitespace.ReplaceAllString(x509Cert.Data, ""))
+				if err != nil {
+					return nil, fmt.Errorf("invalid IdP certificate encoding: %w", err)
+				}
+				cert, err := x509.ParseCertificate(der)
+				if err != nil {
+					return nil, fmt.Errorf("invalid IdP certificate: %w", err)
+				}
+				certs = append(certs, cert)
+			}
+		}
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("IdP metadata has no signing certificate")
+	}
+	return certs, nil
+}
+
+// decodeCachedValue: 캐시 백엔드에 따라 구조체 또는 맵으로 반환되는 값을 대상 타입으로 변환합니다
+func decodeCachedValue(value interface{}, dest interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dest)
+}
+
+// samlRequestKey: AuthnRequest 상태 캐시 키를 반환합니다
+func samlRequestKey(relayState string) string {
+	return fmt.Sprintf("saml:request:%s", relayState)
+}
+
+// samlSessionKey: SAML 세션 캐시 키를 반환합니다
+func samlSessionKey(providerID, userID uuid.UUID) string {
+	return fmt.Sprintf("saml:session:%s:%s", providerID, userID)
+}
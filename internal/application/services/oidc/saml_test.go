@@ -0,0 +1,531 @@
+package oidc
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"skyclust/internal/domain"
+
+	"github.com/beevik/etree"
+	"github.com/google/uuid"
+	dsig "github.com/russellhaering/goxmldsig"
+)
+
+const (
+	testIdPEntityID = "https://idp.example.com/metadata"
+	testRequestID   = "id-test-authn-request"
+	testRelayState  = "relay-state"
+	testNameID      = "alice@example.com"
+	samlTimeFormat  = "2006-01-02T15:04:05Z"
+)
+
+type testKeyPair struct {
+	key     *rsa.PrivateKey
+	cert    *x509.Certificate
+	certPEM string
+	keyPEM  string
+}
+
+func newTestKeyPair(t *testing.T, commonName string) *testKeyPair {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+	return &testKeyPair{
+		key:     key,
+		cert:    cert,
+		certPEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		keyPEM:  string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+	}
+}
+
+func (k *testKeyPair) signingContext(t *testing.T) *dsig.SigningContext {
+	t.Helper()
+	ctx := dsig.NewDefaultSigningContext(dsig.TLSCertKeyStore(tls.Certificate{
+		Certificate: [][]byte{k.cert.Raw},
+		PrivateKey:  k.key,
+	}))
+	ctx.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+	if err := ctx.SetSignatureMethod(dsig.RSASHA256SignatureMethod); err != nil {
+		t.Fatalf("set signature method: %v", err)
+	}
+	return ctx
+}
+
+func testIdPMetadata(idp *testKeyPair) string {
+	return fmt.Sprintf(`<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="%s">
+  <IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <KeyDescriptor use="signing">
+      <KeyInfo xmlns="http://www.w3.org/2000/09/xmldsig#">
+        <X509Data><X509Certificate>%s</X509Certificate></X509Data>
+      </KeyInfo>
+    </KeyDescriptor>
+    <SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/slo"/>
+    <SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/sso"/>
+  </IDPSSODescriptor>
+</EntityDescriptor>`, testIdPEntityID, base64.StdEncoding.EncodeToString(idp.cert.Raw))
+}
+
+// Fakes: only the methods exercised by the SAML flows are implemented
+
+type fakeSSOProviderRepo struct {
+	domain.SSOProviderRepository
+	provider *domain.SSOProvider
+}
+
+func (r *fakeSSOProviderRepo) GetByID(id uuid.UUID) (*domain.SSOProvider, error) {
+	if r.provider != nil && r.provider.ID == id {
+		return r.provider, nil
+	}
+	return nil, nil
+}
+
+type fakeUserRepo struct {
+	domain.UserRepository
+	users []*domain.User
+}
+
+func (r *fakeUserRepo) GetByOIDC(provider, subject string) (*domain.User, error) {
+	for _, user := range r.users {
+		if user.OIDCProvider == provider && user.OIDCSubject == subject {
+			return user, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeUserRepo) GetByEmail(email string) (*domain.User, error) {
+	for _, user := range r.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeUserRepo) Create(user *domain.User) error {
+	user.ID = uuid.New()
+	r.users = append(r.users, user)
+	return nil
+}
+
+type fakeRBACService struct {
+	domain.RBACService
+}
+
+func (s *fakeRBACService) AssignRole(userID uuid.UUID, role domain.Role) error {
+	return nil
+}
+
+type fakeAuthService struct {
+	domain.AuthService
+	loggedOut []string
+}
+
+func (s *fakeAuthService) IssueToken(user *domain.User) (string, error) {
+	return "token-" + user.ID.String(), nil
+}
+
+func (s *fakeAuthService) Logout(userID uuid.UUID, token string) error {
+	s.loggedOut = append(s.loggedOut, token)
+	return nil
+}
+
+type fakeAuditLogRepo struct {
+	domain.AuditLogRepository
+}
+
+func (r *fakeAuditLogRepo) Create(log *domain.AuditLog) error {
+	return nil
+}
+
+type fakeCache struct {
+	domain.CacheService
+	values map[string]interface{}
+}
+
+func (c *fakeCache) Get(ctx context.Context, key string) (interface{}, error) {
+	value, ok := c.values[key]
+	if !ok {
+		return nil, fmt.Errorf("cache miss")
+	}
+	return value, nil
+}
+
+func (c *fakeCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	c.values[key] = value
+	return nil
+}
+
+func (c *fakeCache) Delete(ctx context.Context, key string) error {
+	delete(c.values, key)
+	return nil
+}
+
+type samlTestEnv struct {
+	service  *Service
+	provider *domain.SSOProvider
+	idp      *testKeyPair
+	users    *fakeUserRepo
+	auth     *fakeAuthService
+	cache    *fakeCache
+}
+
+func newSAMLTestEnv(t *testing.T) *samlTestEnv {
+	t.Helper()
+	idp := newTestKeyPair(t, "idp.example.com")
+	sp := newTestKeyPair(t, "sp.example.com")
+
+	provider := &domain.SSOProvider{
+		ID:                uuid.New(),
+		Name:              "test-saml",
+		Protocol:          domain.SSOProtocolSAML,
+		ProviderType:      "saml",
+		AutoProvision:     true,
+		Enabled:           true,
+		SAMLIdPMetadata:   testIdPMetadata(idp),
+		SAMLBaseURL:       "https://skyclust.example.com",
+		SAMLSPCertificate: sp.certPEM,
+		SAMLSPPrivateKey:  sp.keyPEM,
+	}
+
+	env := &samlTestEnv{
+		provider: provider,
+		idp:      idp,
+		users:    &fakeUserRepo{},
+		auth:     &fakeAuthService{},
+		cache:    &fakeCache{values: make(map[string]interface{})},
+	}
+	env.service = &Service{
+		userRepo:        env.users,
+		auditLogRepo:    &fakeAuditLogRepo{},
+		authService:     env.auth,
+		cacheService:    env.cache,
+		ssoProviderRepo: &fakeSSOProviderRepo{provider: provider},
+		rbacService:     &fakeRBACService{},
+		configs:         make(map[string]*OIDCConfig),
+		httpClient:      &http.Client{Timeout: DefaultHTTPClientTimeout},
+	}
+	env.cache.values[samlRequestKey(testRelayState)] = SAMLRequestState{
+		ProviderID: provider.ID.String(),
+		RequestID:  testRequestID,
+		Timestamp:  time.Now(),
+	}
+	return env
+}
+
+type assertionOptions struct {
+	audience string
+	issuedAt time.Time
+	sign     bool
+}
+
+// samlResponse: IdP가 발급하는 형태의 응답 XML을 만들고 필요하면 어설션에 서명합니다
+func (e *samlTestEnv) samlResponse(t *testing.T, opts assertionOptions) string {
+	t.Helper()
+	issued := opts.issuedAt.UTC().Format(samlTimeFormat)
+	notBefore := opts.issuedAt.Add(-time.Minute).UTC().Format(samlTimeFormat)
+	notOnOrAfter := opts.issuedAt.Add(5 * time.Minute).UTC().Format(samlTimeFormat)
+	acsURL := e.provider.SAMLACSURL()
+
+	response := fmt.Sprintf(`<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_response-1" Version="2.0" IssueInstant="%[1]s" Destination="%[2]s" InResponseTo="%[3]s">
+  <saml:Issuer>%[4]s</saml:Issuer>
+  <samlp:Status><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success"/></samlp:Status>
+  <saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_assertion-1" Version="2.0" IssueInstant="%[1]s">
+    <saml:Issuer>%[4]s</saml:Issuer>
+    <saml:Subject>
+      <saml:NameID Format="urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress">%[5]s</saml:NameID>
+      <saml:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer">
+        <saml:SubjectConfirmationData InResponseTo="%[3]s" NotOnOrAfter="%[7]s" Recipient="%[2]s"/>
+      </saml:SubjectConfirmation>
+    </saml:Subject>
+    <saml:Conditions NotBefore="%[6]s" NotOnOrAfter="%[7]s">
+      <saml:AudienceRestriction><saml:Audience>%[8]s</saml:Audience></saml:AudienceRestriction>
+    </saml:Conditions>
+    <saml:AuthnStatement AuthnInstant="%[1]s" SessionIndex="_session-1">
+      <saml:AuthnContext><saml:AuthnContextClassRef>urn:oasis:names:tc:SAML:2.0:ac:classes:Password</saml:AuthnContextClassRef></saml:AuthnContext>
+    </saml:AuthnStatement>
+    <saml:AttributeStatement>
+      <saml:Attribute Name="email"><saml:AttributeValue>%[5]s</saml:AttributeValue></saml:Attribute>
+      <saml:Attribute Name="username"><saml:AttributeValue>alice</saml:AttributeValue></saml:Attribute>
+    </saml:AttributeStatement>
+  </saml:Assertion>
+</samlp:Response>`, issued, acsURL, testRequestID, testIdPEntityID, testNameID, notBefore, notOnOrAfter, opts.audience)
+
+	if !opts.sign {
+		return base64.StdEncoding.EncodeToString([]byte(response))
+	}
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromString(response); err != nil {
+		t.Fatalf("parse response: %v", err)
+	}
+	assertion := doc.Root().FindElement("./Assertion")
+	signed, err := e.idp.signingContext(t).SignEnveloped(assertion)
+	if err != nil {
+		t.Fatalf("sign assertion: %v", err)
+	}
+	doc.Root().RemoveChild(assertion)
+	doc.Root().AddChild(signed)
+
+	out, err := doc.WriteToBytes()
+	if err != nil {
+		t.Fatalf("serialize response: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(out)
+}
+
+// logoutRequest: IdP가 시작하는 서명된 LogoutRequest XML을 만듭니다
+func (e *samlTestEnv) logoutRequest(t *testing.T, nameID string, sign bool) *etree.Document {
+	t.Helper()
+	request := fmt.Sprintf(`<samlp:LogoutRequest xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_logout-1" Version="2.0" IssueInstant="%s" Destination="%s">
+  <saml:Issuer>%s</saml:Issuer>
+  <saml:NameID Format="urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress">%s</saml:NameID>
+  <samlp:SessionIndex>_session-1</samlp:SessionIndex>
+</samlp:LogoutRequest>`, time.Now().UTC().Format(samlTimeFormat), e.provider.SAMLSLOURL(), testIdPEntityID, nameID)
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromString(request); err != nil {
+		t.Fatalf("parse logout request: %v", err)
+	}
+	if sign {
+		signed, err := e.idp.signingContext(t).SignEnveloped(doc.Root())
+		if err != nil {
+			t.Fatalf("sign logout request: %v", err)
+		}
+		doc.SetRoot(signed)
+	}
+	return doc
+}
+
+func encodeDocument(t *testing.T, doc *etree.Document) string {
+	t.Helper()
+	out, err := doc.WriteToBytes()
+	if err != nil {
+		t.Fatalf("serialize document: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(out)
+}
+
+func TestConsumeSAMLResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     func(env *samlTestEnv) assertionOptions
+		wantUser bool
+	}{
+		{
+			name: "signed assertion",
+			opts: func(env *samlTestEnv) assertionOptions {
+				return assertionOptions{audience: env.provider.SAMLMetadataURL(), issuedAt: time.Now(), sign: true}
+			},
+			wantUser: true,
+		},
+		{
+			name: "unsigned assertion",
+			opts: func(env *samlTestEnv) assertionOptions {
+				return assertionOptions{audience: env.provider.SAMLMetadataURL(), issuedAt: time.Now(), sign: false}
+			},
+		},
+		{
+			name: "wrong audience",
+			opts: func(env *samlTestEnv) assertionOptions {
+				return assertionOptions{audience: "https://other-sp.example.com/metadata", issuedAt: time.Now(), sign: true}
+			},
+		},
+		{
+			name: "expired assertion",
+			opts: func(env *samlTestEnv) assertionOptions {
+				return assertionOptions{audience: env.provider.SAMLMetadataURL(), issuedAt: time.Now().Add(-time.Hour), sign: true}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newSAMLTestEnv(t)
+			response := env.samlResponse(t, tt.opts(env))
+
+			user, token, err := env.service.ConsumeSAMLResponse(context.Background(), env.provider.ID, response, testRelayState)
+			if !tt.wantUser {
+				if err == nil {
+					t.Fatalf("expected the response to be rejected, got user %v", user)
+				}
+				if code := domain.GetDomainError(err).Code; code != domain.ErrCodeUnauthorized {
+					t.Fatalf("expected %s, got %s (%v)", domain.ErrCodeUnauthorized, code, err)
+				}
+				if len(env.users.users) != 0 {
+					t.Fatalf("no user should be provisioned for a rejected response")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if user.Email != testNameID || user.OIDCSubject != testNameID {
+				t.Fatalf("unexpected user: email=%s subject=%s", user.Email, user.OIDCSubject)
+			}
+			if token == "" {
+				t.Fatalf("expected a session token")
+			}
+			if _, ok := env.cache.values[samlRequestKey(testRelayState)]; ok {
+				t.Fatalf("request state should be consumed to prevent replay")
+			}
+			if _, ok := env.cache.values[samlSessionKey(env.provider.ID, user.ID)]; !ok {
+				t.Fatalf("SAML session should be stored for single logout")
+			}
+		})
+	}
+}
+
+func TestConsumeSAMLResponseRejectsReplay(t *testing.T) {
+	env := newSAMLTestEnv(t)
+	response := env.samlResponse(t, assertionOptions{audience: env.provider.SAMLMetadataURL(), issuedAt: time.Now(), sign: true})
+
+	if _, _, err := env.service.ConsumeSAMLResponse(context.Background(), env.provider.ID, response, testRelayState); err != nil {
+		t.Fatalf("first response should be accepted: %v", err)
+	}
+	if _, _, err := env.service.ConsumeSAMLResponse(context.Background(), env.provider.ID, response, testRelayState); err == nil {
+		t.Fatalf("replayed response should be rejected")
+	}
+}
+
+func TestParseSAMLLogoutRequest(t *testing.T) {
+	env := newSAMLTestEnv(t)
+	provider, err := env.service.getSAMLProvider(env.provider.ID)
+	if err != nil {
+		t.Fatalf("get provider: %v", err)
+	}
+	sp, err := env.service.samlServiceProvider(provider)
+	if err != nil {
+		t.Fatalf("service provider: %v", err)
+	}
+
+	t.Run("signed request", func(t *testing.T) {
+		request := encodeDocument(t, env.logoutRequest(t, testNameID, true))
+		logoutRequest, err := env.service.parseSAMLLogoutRequest(sp, request, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if logoutRequest.NameID == nil || logoutRequest.NameID.Value != testNameID {
+			t.Fatalf("unexpected NameID: %+v", logoutRequest.NameID)
+		}
+		if logoutRequest.ID != "_logout-1" {
+			t.Fatalf("unexpected request ID: %s", logoutRequest.ID)
+		}
+	})
+
+	t.Run("signed request over redirect binding", func(t *testing.T) {
+		raw, err := env.logoutRequest(t, testNameID, true).WriteToBytes()
+		if err != nil {
+			t.Fatalf("serialize: %v", err)
+		}
+		var deflated bytes.Buffer
+		writer, _ := flate.NewWriter(&deflated, flate.BestCompression)
+		_, _ = writer.Write(raw)
+		_ = writer.Close()
+
+		logoutRequest, err := env.service.parseSAMLLogoutRequest(sp, base64.StdEncoding.EncodeToString(deflated.Bytes()), true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if logoutRequest.NameID == nil || logoutRequest.NameID.Value != testNameID {
+			t.Fatalf("unexpected NameID: %+v", logoutRequest.NameID)
+		}
+	})
+
+	t.Run("unsigned request", func(t *testing.T) {
+		request := encodeDocument(t, env.logoutRequest(t, testNameID, false))
+		if _, err := env.service.parseSAMLLogoutRequest(sp, request, false); err == nil {
+			t.Fatalf("unsigned request should be rejected")
+		}
+	})
+
+	t.Run("tampered NameID", func(t *testing.T) {
+		doc := env.logoutRequest(t, testNameID, true)
+		doc.Root().FindElement("./NameID").SetText("admin@example.com")
+		if _, err := env.service.parseSAMLLogoutRequest(sp, encodeDocument(t, doc), false); err == nil {
+			t.Fatalf("tampered request should be rejected")
+		}
+	})
+
+	t.Run("signed by another key", func(t *testing.T) {
+		other := newSAMLTestEnv(t)
+		request := encodeDocument(t, other.logoutRequest(t, testNameID, true))
+		if _, err := env.service.parseSAMLLogoutRequest(sp, request, false); err == nil {
+			t.Fatalf("request signed by an unknown key should be rejected")
+		}
+	})
+
+	t.Run("comment injected into NameID", func(t *testing.T) {
+		// Exclusive C14N drops comments, so the signature still verifies; the NameID must be the full signed value
+		signedNameID := "alice@example.com.evil.example"
+		doc := env.logoutRequest(t, signedNameID, true)
+		raw, err := doc.WriteToString()
+		if err != nil {
+			t.Fatalf("serialize: %v", err)
+		}
+		raw = strings.Replace(raw, signedNameID, testNameID+"<!---->.evil.example", 1)
+		logoutRequest, err := env.service.parseSAMLLogoutRequest(sp, base64.StdEncoding.EncodeToString([]byte(raw)), false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if logoutRequest.NameID == nil || logoutRequest.NameID.Value != signedNameID {
+			t.Fatalf("expected NameID %s, got %+v", signedNameID, logoutRequest.NameID)
+		}
+	})
+}
+
+func TestHandleSAMLLogoutRevokesSession(t *testing.T) {
+	env := newSAMLTestEnv(t)
+	response := env.samlResponse(t, assertionOptions{audience: env.provider.SAMLMetadataURL(), issuedAt: time.Now(), sign: true})
+	user, token, err := env.service.ConsumeSAMLResponse(context.Background(), env.provider.ID, response, testRelayState)
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+
+	request := encodeDocument(t, env.logoutRequest(t, testNameID, true))
+	responseURL, err := env.service.HandleSAMLLogout(context.Background(), env.provider.ID, request, "", "", false)
+	if err != nil {
+		t.Fatalf("logout: %v", err)
+	}
+	if !strings.HasPrefix(responseURL, "https://idp.example.com/slo") {
+		t.Fatalf("unexpected LogoutResponse URL: %s", responseURL)
+	}
+	if len(env.auth.loggedOut) != 1 || env.auth.loggedOut[0] != token {
+		t.Fatalf("expected the SAML session token to be revoked, got %v", env.auth.loggedOut)
+	}
+	if _, ok := env.cache.values[samlSessionKey(env.provider.ID, user.ID)]; ok {
+		t.Fatalf("SAML session should be removed after logout")
+	}
+}
@@ -25,6 +25,9 @@ type Service struct {
 	authService      domain.AuthService
 	cacheService     domain.CacheService
 	oidcProviderRepo domain.OIDCProviderRepository
+	ssoProviderRepo  domain.SSOProviderRepository
+	rbacService      domain.RBACService
+	workspaceRepo    domain.WorkspaceRepository
 	configs          map[string]*OIDCConfig
 	httpClient       *http.Client
 }
@@ -36,6 +39,9 @@ func NewService(
 	authService domain.AuthService,
 	cacheService domain.CacheService,
 	oidcProviderRepo domain.OIDCProviderRepository,
+	ssoProviderRepo domain.SSOProviderRepository,
+	rbacService domain.RBACService,
+	workspaceRepo domain.WorkspaceRepository,
 ) domain.OIDCService {
 	service := &Service{
 		userRepo:         userRepo,
@@ -43,6 +49,9 @@ func NewService(
 		authService:      authService,
 		cacheService:     cacheService,
 		oidcProviderRepo: oidcProviderRepo,
+		ssoProviderRepo:  ssoProviderRepo,
+		rbacService:      rbacService,
+		workspaceRepo:    workspaceRepo,
 		configs:          make(map[string]*OIDCConfig),
 		httpClient:       &http.Client{Timeout: DefaultHTTPClientTimeout},
 	}
@@ -65,6 +74,10 @@ func (s *Service) GetAuthURL(ctx context.Context, provider string, state string)
 			return "", domain.NewDomainError(domain.ErrCodeInternalError, "failed to get user provider", 500)
 		}
 		if userProvider == nil {
+			// Organization SSO provider
+			if ssoProvider, err := s.ssoProviderRepo.GetByID(providerID); err == nil && ssoProvider != nil {
+				return s.getSSOAuthURL(ctx, ssoProvider, state)
+			}
 			return "", domain.NewDomainError(domain.ErrCodeNotFound, "OIDC provider not found", 404)
 		}
 		if !userProvider.Enabled {
@@ -115,6 +128,10 @@ func (s *Service) ExchangeCode(ctx context.Context, provider, code, state string
 			return nil, "", domain.NewDomainError(domain.ErrCodeInternalError, "failed to get user provider", 500)
 		}
 		if userProvider == nil {
+			// Organization SSO provider
+			if ssoProvider, err := s.ssoProviderRepo.GetByID(providerID); err == nil && ssoProvider != nil {
+				return s.exchangeSSOCode(ctx, ssoProvider, code, state)
+			}
 			return nil, "", domain.NewDomainError(domain.ErrCodeNotFound, "OIDC provider not found", 404)
 		}
 		if !userProvider.Enabled {
@@ -187,22 +204,15 @@ func (s *Service) ExchangeCode(ctx context.Context, provider, code, state string
 		)
 	}
 
-	// Generate JWT token using auth service
-	_, jwtToken, err := s.authService.Login(user.Username, "OIDC_USER") // Use dummy password for OIDC
+	jwtToken, err := s.issueSession(ctx, user, map[string]interface{}{
+		"provider":      providerType,
+		"provider_id":   provider,
+		"user_provider": userProvider != nil,
+	})
 	if err != nil {
-		return nil, "", domain.NewDomainError(domain.ErrCodeInternalError, "failed to generate JWT token", 500)
+		return nil, "", err
 	}
 
-	// Log OIDC login
-	common.LogAction(ctx, s.auditLogRepo, &user.ID, domain.ActionOIDCLogin,
-		"POST /api/v1/auth/oidc/login",
-		map[string]interface{}{
-			"provider":      providerType,
-			"provider_id":   provider,
-			"user_provider": userProvider != nil,
-		},
-	)
-
 	// Delete state after successful exchange (prevent reuse)
 	stateKey := fmt.Sprintf("oidc:state:%s", state)
 	_ = s.cacheService.Delete(ctx, stateKey)
@@ -210,6 +220,21 @@ func (s *Service) ExchangeCode(ctx context.Context, provider, code, state string
 	return user, jwtToken, nil
 }
 
+// issueSession: 외부 IdP로 인증된 사용자에게 JWT를 발급하고 로그인 감사 로그를 남깁니다
+func (s *Service) issueSession(ctx context.Context, user *domain.User, details map[string]interface{}) (string, error) {
+	jwtToken, err := s.authService.IssueToken(user)
+	if err != nil {
+		return "", err
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &user.ID, domain.ActionOIDCLogin,
+		"POST /api/v1/auth/oidc/login",
+		details,
+	)
+
+	return jwtToken, nil
+}
+
 // validateState: OIDC state 파라미터를 검증합니다
 func (s *Service) validateState(ctx context.Context, state, provider string) error {
 	stateKey := fmt.Sprintf("oidc:state:%s", state)
@@ -273,15 +298,10 @@ func (s *Service) getUserInfoFromProvider(providerType string, token *oauth2.Tok
 		}
 		client = config.Config.Client(context.Background(), token)
 
-		switch providerType {
-		case "google":
-			apiURL = "https://www.googleapis.com/oauth2/v2/userinfo"
-		case "github":
-			apiURL = "https://api.github.com/user"
-		case "azure", "microsoft":
-			apiURL = "https://graph.microsoft.com/v1.0/me"
-		default:
-			return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, fmt.Sprintf("unsupported provider: %s", providerType), 400)
+		var err error
+		apiURL, err = userInfoEndpoint(providerType)
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -304,6 +324,20 @@ func (s *Service) getUserInfoFromProvider(providerType string, token *oauth2.Tok
 	return &userInfo, nil
 }
 
+// userInfoEndpoint: 사전 정의된 프로바이더의 사용자 정보 엔드포인트를 반환합니다
+func userInfoEndpoint(providerType string) (string, error) {
+	switch providerType {
+	case "google":
+		return "https://www.googleapis.com/oauth2/v2/userinfo", nil
+	case "github":
+		return "https://api.github.com/user", nil
+	case "azure", "microsoft":
+		return "https://graph.microsoft.com/v1.0/me", nil
+	default:
+		return "", domain.NewDomainError(domain.ErrCodeValidationFailed, fmt.Sprintf("unsupported provider: %s", providerType), 400)
+	}
+}
+
 // createConfigFromProvider: 사용자 등록 OIDC 프로바이더로부터 OAuth2 설정을 생성합니다
 func (s *Service) createConfigFromProvider(provider *domain.OIDCProvider) (*OIDCConfig, error) {
 	// Parse scopes
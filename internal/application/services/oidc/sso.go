@@ -0,0 +1,698 @@
+package oidc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"skyclust/internal/application/services/common"
+	"skyclust/internal/domain"
+	"skyclust/pkg/security"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/oauth2"
+)
+
+// getSSOAuthURL: 조직 SSO 프로바이더의 인증 URL을 반환합니다
+func (s *Service) getSSOAuthURL(ctx context.Context, provider *domain.SSOProvider, state string) (string, error) {
+	if !provider.IsEnabled() {
+		return "", domain.NewDomainError(domain.ErrCodeValidationFailed, "SSO provider is disabled", 400)
+	}
+	if provider.IsSAML() {
+		return "", domain.NewDomainError(domain.ErrCodeValidationFailed, "SAML providers must use the SAML login endpoint", 400)
+	}
+
+	config, err := s.createConfigFromProvider(provider.ToOIDCProvider())
+	if err != nil {
+		return "", err
+	}
+
+	stateData := OIDCState{
+		Provider:  provider.SubjectNamespace(),
+		Timestamp: time.Now(),
+	}
+	stateKey := fmt.Sprintf("oidc:state:%s", state)
+	if err := s.cacheService.Set(ctx, stateKey, stateData, StateCacheTTL); err != nil {
+		return "", domain.NewDomainError(domain.ErrCodeInternalError, "failed to store state", 500)
+	}
+
+	return config.Config.AuthCodeURL(state, oauth2.AccessTypeOnline), nil
+}
+
+// exchangeSSOCode: 조직 SSO 프로바이더의 인증 코드를 교환하고 사용자를 프로비저닝합니다
+func (s *Service) exchangeSSOCode(ctx context.Context, provider *domain.SSOProvider, code, state string) (*domain.User, string, error) {
+	if !provider.IsEnabled() {
+		return nil, "", domain.NewDomainError(domain.ErrCodeValidationFailed, "SSO provider is disabled", 400)
+	}
+	if provider.IsSAML() {
+		return nil, "", domain.NewDomainError(domain.ErrCodeValidationFailed, "SAML providers must use the SAML ACS endpoint", 400)
+	}
+
+	config, err := s.createConfigFromProvider(provider.ToOIDCProvider())
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := s.validateState(ctx, state, provider.SubjectNamespace()); err != nil {
+		return nil, "", err
+	}
+
+	token, err := config.Config.Exchange(context.Background(), code)
+	if err != nil {
+		return nil, "", domain.NewDomainError(domain.ErrCodeInternalError, "failed to exchange code for token", 500)
+	}
+
+	identity, err := s.getSSOIdentity(provider, config, token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	user, err := s.provisionSSOUser(ctx, provider, identity)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := s.syncSSOGroupMappings(ctx, provider, user, identity.Groups); err != nil {
+		return nil, "", err
+	}
+
+	jwtToken, err := s.issueSession(ctx, user, map[string]interface{}{
+		"provider":      "sso",
+		"provider_id":   provider.ID.String(),
+		"provider_name": provider.Name,
+		"groups":        len(identity.Groups),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	stateKey := fmt.Sprintf("oidc:state:%s", state)
+	_ = s.cacheService.Delete(ctx, stateKey)
+
+	return user, jwtToken, nil
+}
+
+// getSSOIdentity: 사용자 정보 엔드포인트와 ID 토큰에서 SSO 사용자 정보를 추출합니다
+func (s *Service) getSSOIdentity(provider *domain.SSOProvider, config *OIDCConfig, token *oauth2.Token) (*SSOIdentity, error) {
+	apiURL := provider.UserInfoURL
+	if apiURL == "" {
+		var err error
+		apiURL, err = userInfoEndpoint(provider.ProviderType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := config.Config.Client(context.Background(), token).Get(apiURL)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeProviderError, fmt.Sprintf("failed to get user info: %v", err), 502)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, domain.NewDomainError(domain.ErrCodeProviderError, fmt.Sprintf("failed to get user info: status %d", resp.StatusCode), 502)
+	}
+
+	claims := make(map[string]interface{})
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&claims); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeProviderError, "failed to decode user info", 502)
+	}
+
+	// Group claims are frequently only present in the ID token. The token was received
+	// directly from the token endpoint over TLS, so its payload can be trusted here.
+	groupsClaim := provider.GetGroupsClaim()
+	if _, ok := claims[groupsClaim]; !ok {
+		if idToken, ok := token.Extra("id_token").(string); ok {
+			if idClaims, err := decodeIDTokenClaims(idToken); err == nil {
+				if groups, ok := idClaims[groupsClaim]; ok {
+					claims[groupsClaim] = groups
+				}
+			}
+		}
+	}
+
+	identity := &SSOIdentity{
+		Subject:  firstClaim(claims, "sub", "id", "oid"),
+		Email:    strings.ToLower(firstClaim(claims, "email", "mail", "userPrincipalName")),
+		Username: firstClaim(claims, "preferred_username", "login", "name"),
+		Groups:   claimStrings(claims[groupsClaim]),
+	}
+
+	if identity.Subject == "" {
+		return nil, domain.NewDomainError(domain.ErrCodeProviderError, "subject claim is missing from user info", 502)
+	}
+	if identity.Email == "" {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "email claim is required for SSO login", 400)
+	}
+	if identity.Username == "" {
+		identity.Username = strings.Split(identity.Email, "@")[0]
+	}
+
+	return identity, nil
+}
+
+// provisionSSOUser: SSO 사용자를 조회하거나 이메일로 연결하거나 JIT 방식으로 생성합니다
+// 비활성화된 사용자는 계정 연결이나 그룹 매핑 동기화 전에 거부합니다
+func (s *Service) provisionSSOUser(ctx context.Context, provider *domain.SSOProvider, identity *SSOIdentity) (*domain.User, error) {
+	domains := provider.GetDomains()
+	if len(domains) > 0 && !provider.MatchesEmail(identity.Email) {
+		return nil, domain.NewDomainError(domain.ErrCodeForbidden, "email domain is not allowed for this SSO provider", 403)
+	}
+
+	user, err := s.userRepo.GetByOIDC(provider.SubjectNamespace(), identity.Subject)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, "failed to check existing user", 500)
+	}
+	if user != nil {
+		if !user.IsActive() {
+			return nil, domain.NewDomainError(domain.ErrCodeUnauthorized, "account is deactivated", 401)
+		}
+		return user, nil
+	}
+
+	existing, err := s.userRepo.GetByEmail(identity.Email)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, "failed to check existing user", 500)
+	}
+	if existing != nil {
+		if !existing.IsActive() {
+			return nil, domain.NewDomainError(domain.ErrCodeUnauthorized, "account is deactivated", 401)
+		}
+		// Only link accounts when the provider owns the email domain
+		if len(domains) == 0 {
+			return nil, domain.NewDomainError(domain.ErrCodeConflict, "an account with this email already exists", 409)
+		}
+		existing.SetOIDCInfo(provider.SubjectNamespace(), identity.Subject)
+		if err := s.userRepo.Update(existing); err != nil {
+			return nil, domain.NewDomainError(domain.ErrCodeInternalError, "failed to link user to SSO provider", 500)
+		}
+		return existing, nil
+	}
+
+	if !provider.AutoProvision {
+		return nil, domain.NewDomainError(domain.ErrCodeForbidden, "user is not provisioned for this SSO provider", 403)
+	}
+
+	user = &domain.User{
+		Username:     identity.Username,
+		Email:        identity.Email,
+		OIDCProvider: provider.SubjectNamespace(),
+		OIDCSubject:  identity.Subject,
+		Active:       true,
+	}
+	if err := s.userRepo.Create(user); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, "failed to create user", 500)
+	}
+
+	defaultRole := provider.DefaultRole
+	if defaultRole == "" {
+		defaultRole = domain.UserRoleType
+	}
+	if err := s.rbacService.AssignRole(user.ID, defaultRole); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, "failed to assign role", 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &user.ID, domain.ActionUserRegister,
+		"POST /api/v1/auth/oidc/login",
+		map[string]interface{}{
+			"provider":      "sso",
+			"provider_id":   provider.ID.String(),
+			"provider_name": provider.Name,
+			"username":      user.Username,
+			"email":         user.Email,
+			"jit":           true,
+		},
+	)
+
+	return user, nil
+}
+
+// syncSSOGroupMappings: IdP 그룹을 기준으로 시스템 역할과 워크스페이스 멤버십을 동기화합니다
+// 매핑에서 관리하는 역할과 워크스페이스만 변경하며, 오류 발생 시 로그인을 거부합니다
+func (s *Service) syncSSOGroupMappings(ctx context.Context, provider *domain.SSOProvider, user *domain.User, groups []string) error {
+	if len(provider.GroupMappings) == 0 {
+		return nil
+	}
+
+	result := domain.ResolveSSOGroupMappings(provider.GroupMappings, groups)
+	syncErr := domain.NewDomainError(domain.ErrCodeInternalError, "failed to sync SSO group mappings", 500)
+
+	var changes []map[string]interface{}
+
+	// Sync system roles managed by the mappings
+	if len(result.ManagedRoles) > 0 {
+		currentRoles, err := s.rbacService.GetUserRoles(user.ID)
+		if err != nil {
+			return syncErr
+		}
+
+		granted := make(map[domain.Role]bool, len(result.Roles))
+		for _, role := range result.Roles {
+			granted[role] = true
+		}
+		current := make(map[domain.Role]bool, len(currentRoles))
+		for _, role := range currentRoles {
+			current[role] = true
+		}
+
+		for _, role := range result.Roles {
+			if current[role] {
+				continue
+			}
+			if err := s.rbacService.AssignRole(user.ID, role); err != nil {
+				return syncErr
+			}
+			current[role] = true
+			changes = append(changes, map[string]interface{}{"role": string(role), "change": "assigned"})
+		}
+		for _, role := range currentRoles {
+			if !result.ManagedRoles[role] || granted[role] {
+				continue
+			}
+			if err := s.rbacService.RemoveRole(user.ID, role); err != nil {
+				return syncErr
+			}
+			delete(current, role)
+			changes = append(changes, map[string]interface{}{"role": string(role), "change": "removed"})
+		}
+
+		// Never leave the user without a role
+		if len(current) == 0 {
+			defaultRole := provider.DefaultRole
+			if defaultRole == "" {
+				defaultRole = domain.UserRoleType
+			}
+			if err := s.rbacService.AssignRole(user.ID, defaultRole); err != nil {
+				return syncErr
+			}
+			changes = append(changes, map[string]interface{}{"role": string(defaultRole), "change": "assigned"})
+		}
+	}
+
+	// Sync workspace memberships managed by the mappings
+	userID := user.ID.String()
+	for workspaceID := range result.Workspaces {
+		workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID)
+		if err != nil {
+			return syncErr
+		}
+		if workspace == nil || !workspace.Active || workspace.OwnerID == userID {
+			continue
+		}
+
+		members, err := s.workspaceRepo.GetWorkspaceMembersWithRoles(ctx, workspaceID)
+		if err != nil {
+			return syncErr
+		}
+		currentRole := ""
+		for _, member := range members {
+			if member.UserID == userID {
+				currentRole = member.Role
+				break
+			}
+		}
+
+		desiredRole := result.WorkspaceRoles[workspaceID]
+		if currentRole == desiredRole {
+			continue
+		}
+		if currentRole != "" {
+			if err := s.workspaceRepo.RemoveUserFromWorkspace(ctx, userID, workspaceID); err != nil {
+				return syncErr
+			}
+		}
+		if desiredRole != "" {
+			if err := s.workspaceRepo.AddUserToWorkspace(ctx, userID, workspaceID, desiredRole); err != nil {
+				return syncErr
+			}
+		}
+		changes = append(changes, map[string]interface{}{
+			"workspace_id": workspaceID,
+			"from":         currentRole,
+			"to":           desiredRole,
+		})
+	}
+
+	if len(changes) > 0 {
+		common.LogAction(ctx, s.auditLogRepo, &user.ID, domain.ActionSSOMembershipSync,
+			"POST /api/v1/auth/oidc/login",
+			map[string]interface{}{
+				"provider_id":   provider.ID.String(),
+				"provider_name": provider.Name,
+				"groups":        groups,
+				"changes":       changes,
+			},
+		)
+	}
+
+	return nil
+}
+
+// DiscoverSSOProvider: 이메일 도메인으로 활성화된 SSO 프로바이더를 찾습니다
+func (s *Service) DiscoverSSOProvider(ctx context.Context, email string) (*domain.SSOProvider, error) {
+	if !strings.Contains(email, "@") {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "invalid email address", 400)
+	}
+
+	providers, err := s.ssoProviderRepo.ListEnabled()
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to list SSO providers: %v", err), 500)
+	}
+
+	for _, provider := range providers {
+		if provider.MatchesEmail(email) {
+			return provider, nil
+		}
+	}
+
+	return nil, domain.NewDomainError(domain.ErrCodeNotFound, "no SSO provider configured for this email domain", 404)
+}
+
+// CreateSSOProvider: 새로운 조직 SSO 프로바이더를 생성합니다
+func (s *Service) CreateSSOProvider(ctx context.Context, adminID uuid.UUID, provider *domain.SSOProvider) (*domain.SSOProvider, error) {
+	existing, err := s.ssoProviderRepo.GetByName(provider.Name)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to check existing provider: %v", err), 500)
+	}
+	if existing != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "provider with this name already exists", 400)
+	}
+
+	if provider.Protocol == "" {
+		provider.Protocol = domain.SSOProtocolOIDC
+	}
+	if provider.IsSAML() {
+		provider.ProviderType = domain.SSOProtocolSAML
+		if provider.SAMLSPCertificate == "" || provider.SAMLSPPrivateKey == "" {
+			certPEM, keyPEM, err := security.GenerateSelfSignedCertificate(provider.Name, SAMLCertificateValidity)
+			if err != nil {
+				return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to generate SAML SP certificate: %v", err), 500)
+			}
+			provider.SAMLSPCertificate = string(certPEM)
+			provider.SAMLSPPrivateKey = string(keyPEM) // Will be encrypted in repository
+		}
+	}
+
+	if err := s.validateSSOProvider(provider); err != nil {
+		return nil, err
+	}
+
+	mappings := provider.GroupMappings
+	for i := range mappings {
+		if err := mappings[i].Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	provider.CreatedBy = adminID
+	provider.GroupMappings = nil
+	if provider.DefaultRole == "" {
+		provider.DefaultRole = domain.UserRoleType
+	}
+
+	if err := s.ssoProviderRepo.Create(provider); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to create provider: %v", err), 500)
+	}
+
+	if len(mappings) > 0 {
+		if err := s.ssoProviderRepo.ReplaceGroupMappings(provider.ID, mappings); err != nil {
+			return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to create group mappings: %v", err), 500)
+		}
+		provider.GroupMappings = mappings
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &adminID, domain.ActionSSOProviderCreate,
+		"POST /api/v1/admin/sso-providers",
+		map[string]interface{}{
+			"provider_id":   provider.ID.String(),
+			"provider_name": provider.Name,
+			"provider_type": provider.ProviderType,
+			"protocol":      provider.Protocol,
+			"domains":       provider.Domains,
+		},
+	)
+
+	return provider, nil
+}
+
+// ListSSOProviders: 모든 조직 SSO 프로바이더를 조회합니다
+func (s *Service) ListSSOProviders(ctx context.Context) ([]*domain.SSOProvider, error) {
+	providers, err := s.ssoProviderRepo.List()
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to list SSO providers: %v", err), 500)
+	}
+	return providers, nil
+}
+
+// GetSSOProvider: 조직 SSO 프로바이더를 조회합니다
+func (s *Service) GetSSOProvider(ctx context.Context, providerID uuid.UUID) (*domain.SSOProvider, error) {
+	provider, err := s.ssoProviderRepo.GetByID(providerID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get provider: %v", err), 500)
+	}
+	if provider == nil {
+		return nil, domain.NewDomainError(domain.ErrCodeNotFound, "SSO provider not found", 404)
+	}
+	return provider, nil
+}
+
+// UpdateSSOProvider: 조직 SSO 프로바이더를 업데이트합니다
+func (s *Service) UpdateSSOProvider(ctx context.Context, adminID uuid.UUID, providerID uuid.UUID, req domain.UpdateSSOProviderRequest) (*domain.SSOProvider, error) {
+	provider, err := s.GetSSOProvider(ctx, providerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil && *req.Name != provider.Name {
+		existing, err := s.ssoProviderRepo.GetByName(*req.Name)
+		if err != nil {
+			return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to check existing provider: %v", err), 500)
+		}
+		if existing != nil && existing.ID != providerID {
+			return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "provider with this name already exists", 400)
+		}
+		provider.Name = *req.Name
+	}
+	if req.ClientID != nil {
+		provider.ClientID = *req.ClientID
+	}
+	if req.ClientSecret != nil {
+		provider.ClientSecret = *req.ClientSecret // Will be encrypted in repository
+	}
+	if req.RedirectURL != nil {
+		provider.RedirectURL = *req.RedirectURL
+	}
+	if req.AuthURL != nil {
+		provider.AuthURL = *req.AuthURL
+	}
+	if req.TokenURL != nil {
+		provider.TokenURL = *req.TokenURL
+	}
+	if req.UserInfoURL != nil {
+		provider.UserInfoURL = *req.UserInfoURL
+	}
+	if req.Scopes != nil {
+		provider.Scopes = *req.Scopes
+	}
+	if req.Domains != nil {
+		provider.Domains = *req.Domains
+	}
+	if req.GroupsClaim != nil {
+		provider.GroupsClaim = *req.GroupsClaim
+	}
+	if req.AutoProvision != nil {
+		provider.AutoProvision = *req.AutoProvision
+	}
+	if req.DefaultRole != nil {
+		provider.DefaultRole = domain.Role(*req.DefaultRole)
+	}
+	if req.Enabled != nil {
+		provider.Enabled = *req.Enabled
+	}
+	if req.SAMLIdPMetadata != nil {
+		provider.SAMLIdPMetadata = *req.SAMLIdPMetadata
+	}
+	if req.SAMLBaseURL != nil {
+		provider.SAMLBaseURL = *req.SAMLBaseURL
+	}
+	if req.EmailAttribute != nil {
+		provider.EmailAttribute = *req.EmailAttribute
+	}
+	if req.UsernameAttribute != nil {
+		provider.UsernameAttribute = *req.UsernameAttribute
+	}
+
+	if err := s.validateSSOProvider(provider); err != nil {
+		return nil, err
+	}
+
+	if err := s.ssoProviderRepo.Update(provider); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to update provider: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &adminID, domain.ActionSSOProviderUpdate,
+		fmt.Sprintf("PUT /api/v1/admin/sso-providers/%s", providerID),
+		map[string]interface{}{
+			"provider_id":   provider.ID.String(),
+			"provider_name": provider.Name,
+			"enabled":       provider.Enabled,
+		},
+	)
+
+	return provider, nil
+}
+
+// DeleteSSOProvider: 조직 SSO 프로바이더를 삭제합니다
+func (s *Service) DeleteSSOProvider(ctx context.Context, adminID uuid.UUID, providerID uuid.UUID) error {
+	provider, err := s.GetSSOProvider(ctx, providerID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.ssoProviderRepo.Delete(providerID); err != nil {
+		return domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to delete provider: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &adminID, domain.ActionSSOProviderDelete,
+		fmt.Sprintf("DELETE /api/v1/admin/sso-providers/%s", providerID),
+		map[string]interface{}{
+			"provider_id":   provider.ID.String(),
+			"provider_name": provider.Name,
+		},
+	)
+
+	return nil
+}
+
+// SetSSOGroupMappings: 프로바이더의 그룹 매핑을 교체합니다
+func (s *Service) SetSSOGroupMappings(ctx context.Context, adminID uuid.UUID, providerID uuid.UUID, mappings []domain.SSOGroupMapping) (*domain.SSOProvider, error) {
+	if _, err := s.GetSSOProvider(ctx, providerID); err != nil {
+		return nil, err
+	}
+
+	for i := range mappings {
+		if err := mappings[i].Validate(); err != nil {
+			return nil, err
+		}
+		if mappings[i].WorkspaceID != nil {
+			workspace, err := s.workspaceRepo.GetByID(ctx, mappings[i].WorkspaceID.String())
+			if err != nil {
+				return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get workspace: %v", err), 500)
+			}
+			if workspace == nil {
+				return nil, domain.NewDomainError(domain.ErrCodeNotFound, fmt.Sprintf("workspace %s not found", mappings[i].WorkspaceID), 404)
+			}
+		}
+	}
+
+	if err := s.ssoProviderRepo.ReplaceGroupMappings(providerID, mappings); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to update group mappings: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &adminID, domain.ActionSSOGroupMappingUpdate,
+		fmt.Sprintf("PUT /api/v1/admin/sso-providers/%s/group-mappings", providerID),
+		map[string]interface{}{
+			"provider_id": providerID.String(),
+			"mappings":    len(mappings),
+		},
+	)
+
+	return s.GetSSOProvider(ctx, providerID)
+}
+
+// validateSSOProvider: SSO 프로바이더 설정의 유효성을 검사합니다
+func (s *Service) validateSSOProvider(provider *domain.SSOProvider) error {
+	switch provider.Protocol {
+	case domain.SSOProtocolSAML:
+		if provider.SAMLBaseURL == "" {
+			return domain.NewDomainError(domain.ErrCodeValidationFailed, "saml_base_url is required for SAML providers", 400)
+		}
+		if baseURL, err := url.Parse(provider.SAMLBaseURL); err != nil || baseURL.Scheme == "" || baseURL.Host == "" {
+			return domain.NewDomainError(domain.ErrCodeValidationFailed, "saml_base_url must be an absolute URL", 400)
+		}
+		if _, err := parseSAMLIdPMetadata(provider.SAMLIdPMetadata); err != nil {
+			return domain.NewDomainError(domain.ErrCodeValidationFailed, fmt.Sprintf("invalid saml_idp_metadata: %v", err), 400)
+		}
+	case domain.SSOProtocolOIDC:
+		if provider.ClientID == "" || provider.ClientSecret == "" || provider.RedirectURL == "" {
+			return domain.NewDomainError(domain.ErrCodeValidationFailed, "client_id, client_secret and redirect_url are required for OIDC providers", 400)
+		}
+		switch provider.ProviderType {
+		case "google", "github", "azure", "microsoft":
+		case "custom":
+			if provider.AuthURL == "" || provider.TokenURL == "" || provider.UserInfoURL == "" {
+				return domain.NewDomainError(domain.ErrCodeValidationFailed, "auth_url, token_url and user_info_url are required for custom providers", 400)
+			}
+		default:
+			return domain.NewDomainError(domain.ErrCodeValidationFailed, fmt.Sprintf("unsupported provider type: %s", provider.ProviderType), 400)
+		}
+	default:
+		return domain.NewDomainError(domain.ErrCodeValidationFailed, fmt.Sprintf("unsupported protocol: %s", provider.Protocol), 400)
+	}
+
+	switch provider.DefaultRole {
+	case "", domain.AdminRoleType, domain.UserRoleType, domain.ViewerRoleType:
+	default:
+		return domain.NewDomainError(domain.ErrCodeValidationFailed, "invalid default role. Must be 'admin', 'user' or 'viewer'", 400)
+	}
+
+	return nil
+}
+
+// decodeIDTokenClaims: ID 토큰(JWT)의 페이로드를 디코딩합니다
+func decodeIDTokenClaims(idToken string) (map[string]interface{}, error) {
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("malformed id token")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, err
+	}
+	claims := make(map[string]interface{})
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// firstClaim: 주어진 키 순서대로 비어있지 않은 첫 번째 클레임 값을 문자열로 반환합니다
+func firstClaim(claims map[string]interface{}, keys ...string) string {
+	for _, key := range keys {
+		switch value := claims[key].(type) {
+		case string:
+			if value != "" {
+				return value
+			}
+		case json.Number:
+			return value.String()
+		}
+	}
+	return ""
+}
+
+// claimStrings: 문자열 배열 또는 쉼표로 구분된 문자열 클레임을 문자열 슬라이스로 변환합니다
+func claimStrings(value interface{}) []string {
+	var values []string
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if str, ok := item.(string); ok && str != "" {
+				values = append(values, str)
+			}
+		}
+	case string:
+		for _, part := range strings.Split(v, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				values = append(values, part)
+			}
+		}
+	}
+	return values
+}
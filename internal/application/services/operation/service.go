@@ -0,0 +1,255 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"skyclust/internal/domain"
+	"skyclust/internal/infrastructure/messaging"
+	"skyclust/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// pollLease: 상태를 조회 중인 작업의 임대 기간 (워커 중단 시 이후 다시 조회)
+	pollLease = 2 * time.Minute
+	// trackTimeout: 작업 하나의 상태 조회 제한 시간
+	trackTimeout = 30 * time.Second
+	// pollConcurrency: 동시에 상태를 조회하는 최대 작업 수
+	pollConcurrency = 5
+)
+
+// Service: domain.OperationService 인터페이스 구현체
+// Kubernetes, Network, VM 서비스가 등록한 비동기 작업을 추적기로 조회하여 완료될 때까지 진행 상황을 갱신합니다
+type Service struct {
+	operationRepo  domain.OperationRepository
+	workspaceRepo  domain.WorkspaceRepository
+	eventPublisher *messaging.Publisher
+
+	trackersMu sync.RWMutex
+	trackers   map[string]domain.OperationTracker
+}
+
+// NewService: 새로운 작업 서비스를 생성합니다
+func NewService(
+	operationRepo domain.OperationRepository,
+	workspaceRepo domain.WorkspaceRepository,
+	eventPublisher *messaging.Publisher,
+) *Service {
+	return &Service{
+		operationRepo:  operationRepo,
+		workspaceRepo:  workspaceRepo,
+		eventPublisher: eventPublisher,
+		trackers:       make(map[string]domain.OperationTracker),
+	}
+}
+
+// RegisterTracker: 작업 종류(kubernetes, network, vm)의 상태를 조회할 추적기를 등록합니다
+func (s *Service) RegisterTracker(kind string, tracker domain.OperationTracker) {
+	s.trackersMu.Lock()
+	defer s.trackersMu.Unlock()
+	s.trackers[kind] = tracker
+}
+
+// Start: 진행 중인 작업을 등록하고 첫 진행 상황을 발행합니다
+func (s *Service) Start(ctx context.Context, operation *domain.Operation) (*domain.Operation, error) {
+	if err := s.prepare(ctx, operation); err != nil {
+		return nil, err
+	}
+	operation.Begin(time.Now())
+	return s.create(ctx, operation)
+}
+
+// Record: 동기로 끝난 작업을 완료 상태로 등록합니다 (AWS VPC 삭제 등)
+func (s *Service) Record(ctx context.Context, operation *domain.Operation) (*domain.Operation, error) {
+	if err := s.prepare(ctx, operation); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	operation.Begin(now)
+	operation.Complete(now)
+	return s.create(ctx, operation)
+}
+
+// ListOperations: 워크스페이스의 작업 목록을 조회합니다
+func (s *Service) ListOperations(ctx context.Context, workspaceID uuid.UUID, filter domain.OperationFilter) ([]*domain.Operation, int64, error) {
+	workspace, err := s.getWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, 0, err
+	}
+	switch filter.Status {
+	case "", domain.OperationRunning, domain.OperationSucceeded, domain.OperationFailed, domain.OperationTimedOut:
+	default:
+		return nil, 0, domain.NewDomainError(domain.ErrCodeValidationFailed, "status must be running, succeeded, failed or timed_out", 400)
+	}
+
+	operations, total, err := s.operationRepo.List(ctx, workspace.ID, filter)
+	if err != nil {
+		return nil, 0, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to list operations: %v", err), 500)
+	}
+	return operations, total, nil
+}
+
+// GetOperation: 워크스페이스의 작업을 조회합니다
+func (s *Service) GetOperation(ctx context.Context, workspaceID, operationID uuid.UUID) (*domain.Operation, error) {
+	operation, err := s.operationRepo.GetByID(ctx, operationID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get operation: %v", err), 500)
+	}
+	if operation == nil || operation.WorkspaceID != workspaceID.String() {
+		return nil, domain.NewDomainError(domain.ErrCodeNotFound, "operation not found", 404)
+	}
+	return operation, nil
+}
+
+// PollDue: 조회 시각이 된 작업을 임대하여 클라우드 상태를 조회하고 조회한 수를 반환합니다
+func (s *Service) PollDue(ctx context.Context, limit int) (int, error) {
+	operations, err := s.operationRepo.ClaimDueOperations(ctx, limit, pollLease)
+	if err != nil {
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, pollConcurrency)
+	for _, operation := range operations {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(operation *domain.Operation) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			s.poll(ctx, operation)
+		}(operation)
+	}
+	wg.Wait()
+
+	return len(operations), nil
+}
+
+// PurgeOperations: 보존 기간이 지난 완료된 작업을 삭제합니다
+func (s *Service) PurgeOperations(ctx context.Context, retention time.Duration) (int64, error) {
+	return s.operationRepo.DeleteFinishedBefore(ctx, time.Now().Add(-retention))
+}
+
+// poll: 작업 하나의 상태를 조회하고 바뀌었으면 저장한 뒤 발행합니다
+func (s *Service) poll(ctx context.Context, operation *domain.Operation) {
+	progress, err := s.track(ctx, operation)
+	now := time.Now()
+	operation.PollAttempts++
+
+	var changed bool
+	if err != nil {
+		logger.Warn(fmt.Sprintf("Failed to read status of operation %s (%s %s): %v", operation.ID, operation.Type, operation.TargetID, err))
+		changed = operation.RecordPollError(err, now)
+	} else {
+		changed = operation.Apply(progress, now)
+	}
+	if !operation.IsTerminal() {
+		operation.NextPollAt = now.Add(domain.OperationPollDelay(operation.PollAttempts))
+	}
+
+	if err := s.operationRepo.Update(ctx, operation); err != nil {
+		// 임대가 끝나면 다시 조회
+		logger.Warn(fmt.Sprintf("Failed to save operation %s: %v", operation.ID, err))
+		return
+	}
+	if changed {
+		s.publish(ctx, operation)
+	}
+	if operation.IsTerminal() {
+		logger.Info(fmt.Sprintf("Operation %s (%s %s) %s", operation.ID, operation.Type, operation.TargetID, operation.Status))
+	}
+}
+
+// track: 작업 종류의 추적기로 클라우드 상태를 조회합니다
+func (s *Service) track(ctx context.Context, operation *domain.Operation) (*domain.OperationProgress, error) {
+	kind := domain.OperationKind(operation.Type)
+	s.trackersMu.RLock()
+	tracker, ok := s.trackers[kind]
+	s.trackersMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("no tracker registered for %s operations", kind)
+	}
+
+	trackCtx, cancel := context.WithTimeout(ctx, trackTimeout)
+	defer cancel()
+	progress, err := tracker.TrackOperation(trackCtx, operation)
+	if err != nil {
+		return nil, err
+	}
+	if progress == nil {
+		return nil, fmt.Errorf("tracker returned no progress")
+	}
+	return progress, nil
+}
+
+// prepare: 작업을 검증하고 요청 주체를 생성자로 기록합니다
+func (s *Service) prepare(ctx context.Context, operation *domain.Operation) error {
+	if err := operation.Validate(); err != nil {
+		return err
+	}
+	if operation.CreatedBy == nil {
+		if subject, ok := domain.SubjectFromContext(ctx); ok {
+			operation.CreatedBy = &subject
+		}
+	}
+	return nil
+}
+
+// create: 작업을 저장하고 발행합니다
+func (s *Service) create(ctx context.Context, operation *domain.Operation) (*domain.Operation, error) {
+	if err := s.operationRepo.Create(ctx, operation); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to record operation: %v", err), 500)
+	}
+	s.publish(ctx, operation)
+	return operation, nil
+}
+
+// publish: 작업 진행 상황을 operation.updated 이벤트로 발행합니다
+func (s *Service) publish(ctx context.Context, operation *domain.Operation) {
+	if s.eventPublisher == nil {
+		return
+	}
+	_ = s.eventPublisher.PublishOperationEvent(ctx, operation.WorkspaceID, operation.ID.String(), messaging.ActionUpdated, operationEventData(operation))
+}
+
+// getWorkspace: 워크스페이스를 조회합니다
+func (s *Service) getWorkspace(ctx context.Context, workspaceID uuid.UUID) (*domain.Workspace, error) {
+	workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID.String())
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get workspace: %v", err), 500)
+	}
+	if workspace == nil {
+		return nil, domain.ErrWorkspaceNotFound
+	}
+	return workspace, nil
+}
+
+// operationEventData: 이벤트로 전달할 작업 정보 (상태 조회용 내부 값은 제외)
+func operationEventData(operation *domain.Operation) map[string]interface{} {
+	data := map[string]interface{}{
+		"operation_type":  operation.Type,
+		"provider":        operation.Provider,
+		"region":          operation.Region,
+		"target_type":     operation.TargetType,
+		"target_id":       operation.TargetID,
+		"target_name":     operation.TargetName,
+		"status":          operation.Status,
+		"percent":         operation.Percent,
+		"steps":           operation.Steps,
+		"provider_status": operation.ProviderStatus,
+		"started_at":      operation.StartedAt,
+	}
+	if operation.CredentialID != "" {
+		data["credential_id"] = operation.CredentialID
+	}
+	if operation.Error != "" {
+		data["error"] = operation.Error
+	}
+	if operation.FinishedAt != nil {
+		data["finished_at"] = *operation.FinishedAt
+	}
+	return data
+}
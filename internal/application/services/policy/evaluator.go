@@ -0,0 +1,224 @@
+package policy
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"skyclust/internal/domain"
+)
+
+// attributeSet: 정책 평가에 사용되는 평탄화된 속성 집합
+// env.time, env.weekday는 정책의 시간대에 따라 달라지므로 평가 시점에 계산합니다
+type attributeSet struct {
+	values map[string]string
+	now    time.Time
+}
+
+// get: 속성 값을 조회합니다 (정책 시간대 기준 환경 속성 포함)
+func (a *attributeSet) get(attribute string, loc *time.Location) (string, bool) {
+	switch attribute {
+	case "env.time":
+		return a.now.In(loc).Format("15:04"), true
+	case "env.weekday":
+		return strings.ToLower(a.now.In(loc).Weekday().String()[:3]), true
+	case "env.date":
+		return a.now.In(loc).Format("2006-01-02"), true
+	}
+	value, ok := a.values[attribute]
+	return value, ok
+}
+
+// snapshot: 설명 응답에 포함할 속성 사본을 반환합니다 (환경 속성은 UTC 기준)
+func (a *attributeSet) snapshot() map[string]string {
+	result := make(map[string]string, len(a.values)+3)
+	for key, value := range a.values {
+		result[key] = value
+	}
+	for _, key := range []string{"env.time", "env.weekday", "env.date"} {
+		result[key], _ = a.get(key, time.UTC)
+	}
+	return result
+}
+
+// evaluatePolicies: 정책 목록을 평가하여 결정을 반환합니다
+// 일치하는 deny 정책이 있으면 거부하고, 적용 가능한 allow 정책이 있으면 하나 이상 일치해야 허용합니다
+func evaluatePolicies(policies []*domain.WorkspacePolicy, action domain.Permission, attrs *attributeSet) *domain.PolicyDecision {
+	decision := &domain.PolicyDecision{
+		Allowed:     true,
+		Action:      action,
+		Reason:      "no policy restricts this action",
+		Evaluations: []domain.PolicyEvaluation{},
+		Attributes:  attrs.snapshot(),
+		EvaluatedAt: attrs.now,
+	}
+
+	var (
+		deniedBy      *domain.PolicyEvaluation
+		allowedBy     *domain.PolicyEvaluation
+		allowPolicies int
+	)
+
+	for _, policy := range policies {
+		if !policy.MatchesAction(action) {
+			continue
+		}
+
+		evaluation := evaluatePolicy(policy, attrs)
+		decision.Evaluations = append(decision.Evaluations, evaluation)
+
+		switch policy.Effect {
+		case domain.PolicyEffectDeny:
+			if evaluation.Matched && deniedBy == nil {
+				deniedBy = &evaluation
+			}
+		case domain.PolicyEffectAllow:
+			allowPolicies++
+			if evaluation.Matched && allowedBy == nil {
+				allowedBy = &evaluation
+			}
+		}
+	}
+
+	switch {
+	case deniedBy != nil:
+		decision.Allowed = false
+		decision.DecidedBy = &deniedBy.PolicyID
+		decision.Reason = fmt.Sprintf("denied by policy %q: %s", deniedBy.Name, describeConditions(deniedBy.Conditions))
+	case allowPolicies > 0 && allowedBy == nil:
+		decision.Allowed = false
+		decision.Reason = fmt.Sprintf("none of the %d allow policies for %s matched", allowPolicies, action)
+	case allowedBy != nil:
+		decision.DecidedBy = &allowedBy.PolicyID
+		decision.Reason = fmt.Sprintf("allowed by policy %q", allowedBy.Name)
+	}
+
+	return decision
+}
+
+// evaluatePolicy: 단일 정책의 모든 조건을 평가합니다 (AND)
+func evaluatePolicy(policy *domain.WorkspacePolicy, attrs *attributeSet) domain.PolicyEvaluation {
+	loc, err := time.LoadLocation(policy.Timezone)
+	if err != nil || policy.Timezone == "" {
+		loc = time.UTC
+	}
+
+	evaluation := domain.PolicyEvaluation{
+		PolicyID:   policy.ID,
+		Name:       policy.Name,
+		Effect:     policy.Effect,
+		Matched:    true,
+		Conditions: make([]domain.PolicyConditionResult, 0, len(policy.Conditions)),
+	}
+
+	for _, condition := range policy.Conditions {
+		actual, present := attrs.get(condition.Attribute, loc)
+		matched := evaluateCondition(condition, actual, present)
+		evaluation.Conditions = append(evaluation.Conditions, domain.PolicyConditionResult{
+			PolicyCondition: condition,
+			Actual:          actual,
+			Present:         present,
+			Matched:         matched,
+		})
+		if !matched {
+			evaluation.Matched = false
+		}
+	}
+
+	return evaluation
+}
+
+// evaluateCondition: 단일 조건을 평가합니다
+// 속성이 없으면 exists/not_exists/ne/not_in을 제외한 연산자는 일치하지 않습니다
+func evaluateCondition(condition domain.PolicyCondition, actual string, present bool) bool {
+	switch condition.Operator {
+	case domain.PolicyOpExists:
+		return present && actual != ""
+	case domain.PolicyOpNotExists:
+		return !present || actual == ""
+	case domain.PolicyOpNotEquals:
+		return !present || !strings.EqualFold(actual, condition.Value)
+	case domain.PolicyOpNotIn:
+		return !present || !containsFold(condition.Values, actual)
+	}
+
+	if !present {
+		return false
+	}
+
+	switch condition.Operator {
+	case domain.PolicyOpEquals:
+		return strings.EqualFold(actual, condition.Value)
+	case domain.PolicyOpIn:
+		return containsFold(condition.Values, actual)
+	case domain.PolicyOpPrefix:
+		return strings.HasPrefix(actual, condition.Value)
+	case domain.PolicyOpContains:
+		return strings.Contains(actual, condition.Value)
+	case domain.PolicyOpMatches:
+		matched, _ := path.Match(condition.Value, actual)
+		return matched
+	case domain.PolicyOpBetween, domain.PolicyOpNotBetween:
+		inWindow, ok := withinTimeWindow(actual, condition.Value)
+		if !ok {
+			return false
+		}
+		if condition.Operator == domain.PolicyOpBetween {
+			return inWindow
+		}
+		return !inWindow
+	}
+	return false
+}
+
+// withinTimeWindow: HH:MM 시각이 시간 구간 안에 있는지 확인합니다 (자정을 넘는 구간 지원)
+func withinTimeWindow(actual, window string) (bool, bool) {
+	start, end, err := domain.ParseTimeWindow(window)
+	if err != nil {
+		return false, false
+	}
+	at, err := time.Parse("15:04", actual)
+	if err != nil {
+		return false, false
+	}
+	minute := at.Hour()*60 + at.Minute()
+	if start <= end {
+		return minute >= start && minute < end, true
+	}
+	return minute >= start || minute < end, true
+}
+
+// containsFold: 대소문자를 무시하고 목록에 값이 있는지 확인합니다
+func containsFold(values []string, actual string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, actual) {
+			return true
+		}
+	}
+	return false
+}
+
+// describeConditions: 일치한 조건을 사람이 읽을 수 있는 문장으로 변환합니다
+func describeConditions(results []domain.PolicyConditionResult) string {
+	if len(results) == 0 {
+		return "the policy applies unconditionally"
+	}
+	parts := make([]string, 0, len(results))
+	for _, result := range results {
+		expected := result.Value
+		if len(result.Values) > 0 {
+			values := append([]string(nil), result.Values...)
+			sort.Strings(values)
+			expected = "[" + strings.Join(values, ", ") + "]"
+		}
+		switch result.Operator {
+		case domain.PolicyOpExists, domain.PolicyOpNotExists:
+			parts = append(parts, fmt.Sprintf("%s %s", result.Attribute, result.Operator))
+		default:
+			parts = append(parts, fmt.Sprintf("%s=%q %s %s", result.Attribute, result.Actual, result.Operator, expected))
+		}
+	}
+	return strings.Join(parts, " and ")
+}
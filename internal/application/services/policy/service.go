@@ -0,0 +1,326 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"skyclust/internal/application/services/common"
+	"skyclust/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// Service: domain.PolicyService 인터페이스 구현체
+// 워크스페이스별 속성 기반 정책을 관리하고 클라우드 변경 작업 전에 평가합니다
+type Service struct {
+	policyRepo    domain.WorkspacePolicyRepository
+	workspaceRepo domain.WorkspaceRepository
+	rbacService   domain.WorkspaceRBACService
+	auditLogRepo  domain.AuditLogRepository
+}
+
+// NewService: 새로운 정책 서비스를 생성합니다
+func NewService(
+	policyRepo domain.WorkspacePolicyRepository,
+	workspaceRepo domain.WorkspaceRepository,
+	rbacService domain.WorkspaceRBACService,
+	auditLogRepo domain.AuditLogRepository,
+) *Service {
+	return &Service{
+		policyRepo:    policyRepo,
+		workspaceRepo: workspaceRepo,
+		rbacService:   rbacService,
+		auditLogRepo:  auditLogRepo,
+	}
+}
+
+// Evaluate: 정책 요청을 평가하고 결정과 설명을 반환합니다 (드라이런)
+func (s *Service) Evaluate(ctx context.Context, req domain.PolicyRequest) (*domain.PolicyDecision, error) {
+	if req.Action == "" {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "action is required", 400)
+	}
+
+	workspace, err := s.getWorkspace(ctx, req.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := s.policyRepo.ListEnabledByWorkspace(ctx, workspace.ID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to list workspace policies: %v", err), 500)
+	}
+
+	attrs, err := s.buildAttributes(ctx, workspace, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return evaluatePolicies(policies, req.Action, attrs), nil
+}
+
+// Enforce: 정책을 평가하여 거부되면 403 도메인 오류를 반환합니다
+// 평가 주체(요청 또는 컨텍스트의 사용자)가 없으면 subject 조건을 평가할 수 없으므로 거부합니다
+func (s *Service) Enforce(ctx context.Context, req domain.PolicyRequest) error {
+	subjectID := req.SubjectID
+	if subjectID == nil {
+		id, ok := domain.SubjectFromContext(ctx)
+		if !ok {
+			return domain.NewDomainError(domain.ErrCodeForbidden, fmt.Sprintf("operation %s is not permitted: no policy subject in request context", req.Action), 403).
+				WithDetails("action", string(req.Action))
+		}
+		subjectID = &id
+	}
+	req.SubjectID = subjectID
+
+	decision, err := s.Evaluate(ctx, req)
+	if err != nil {
+		return err
+	}
+	if decision.Allowed {
+		return nil
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, subjectID, domain.ActionPolicyDenied,
+		fmt.Sprintf("POLICY %s %s", req.Action, req.Resource.Type),
+		map[string]interface{}{
+			"workspace_id":  req.WorkspaceID,
+			"action":        string(req.Action),
+			"resource":      req.Resource,
+			"reason":        decision.Reason,
+			"decided_by":    decision.DecidedBy,
+			"evaluated_at":  decision.EvaluatedAt,
+			"policy_checks": len(decision.Evaluations),
+		},
+	)
+
+	domainErr := domain.NewDomainError(domain.ErrCodeForbidden, fmt.Sprintf("operation %s is not permitted: %s", req.Action, decision.Reason), 403).
+		WithDetails("action", string(req.Action)).
+		WithDetails("reason", decision.Reason)
+	if decision.DecidedBy != nil {
+		domainErr = domainErr.WithDetails("policy_id", decision.DecidedBy.String())
+	}
+	return domainErr
+}
+
+// ListPolicies: 워크스페이스의 정책 목록을 조회합니다
+func (s *Service) ListPolicies(ctx context.Context, workspaceID uuid.UUID) ([]*domain.WorkspacePolicy, error) {
+	workspace, err := s.getWorkspace(ctx, workspaceID.String())
+	if err != nil {
+		return nil, err
+	}
+	policies, err := s.policyRepo.ListByWorkspace(ctx, workspace.ID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to list workspace policies: %v", err), 500)
+	}
+	return policies, nil
+}
+
+// GetPolicy: 워크스페이스 정책을 조회합니다
+func (s *Service) GetPolicy(ctx context.Context, workspaceID, policyID uuid.UUID) (*domain.WorkspacePolicy, error) {
+	policy, err := s.policyRepo.GetByID(ctx, policyID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get workspace policy: %v", err), 500)
+	}
+	if policy == nil || policy.WorkspaceID != workspaceID.String() {
+		return nil, domain.NewDomainError(domain.ErrCodeNotFound, "workspace policy not found", 404)
+	}
+	return policy, nil
+}
+
+// CreatePolicy: 워크스페이스 정책을 생성합니다
+func (s *Service) CreatePolicy(ctx context.Context, actorID, workspaceID uuid.UUID, req domain.CreateWorkspacePolicyRequest) (*domain.WorkspacePolicy, error) {
+	workspace, err := s.getWorkspace(ctx, workspaceID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &domain.WorkspacePolicy{
+		WorkspaceID: workspace.ID,
+		Name:        strings.TrimSpace(req.Name),
+		Description: strings.TrimSpace(req.Description),
+		Effect:      req.Effect,
+		Actions:     domain.StringList(req.Actions),
+		Conditions:  domain.PolicyConditions(req.Conditions),
+		Timezone:    req.Timezone,
+		Enabled:     true,
+		CreatedBy:   &actorID,
+	}
+	if req.Enabled != nil {
+		policy.Enabled = *req.Enabled
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.policyRepo.Create(ctx, policy); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to create workspace policy: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionPolicyCreate,
+		fmt.Sprintf("POST /api/v1/workspaces/%s/policies", workspace.ID),
+		policyAuditDetails(policy),
+	)
+
+	return policy, nil
+}
+
+// UpdatePolicy: 워크스페이스 정책을 수정합니다
+func (s *Service) UpdatePolicy(ctx context.Context, actorID, workspaceID, policyID uuid.UUID, req domain.UpdateWorkspacePolicyRequest) (*domain.WorkspacePolicy, error) {
+	policy, err := s.GetPolicy(ctx, workspaceID, policyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		policy.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		policy.Description = strings.TrimSpace(*req.Description)
+	}
+	if req.Effect != nil {
+		policy.Effect = *req.Effect
+	}
+	if req.Actions != nil {
+		policy.Actions = domain.StringList(req.Actions)
+	}
+	if req.Conditions != nil {
+		policy.Conditions = domain.PolicyConditions(req.Conditions)
+	}
+	if req.Timezone != nil {
+		policy.Timezone = *req.Timezone
+	}
+	if req.Enabled != nil {
+		policy.Enabled = *req.Enabled
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.policyRepo.Update(ctx, policy); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to update workspace policy: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionPolicyUpdate,
+		fmt.Sprintf("PUT /api/v1/workspaces/%s/policies/%s", policy.WorkspaceID, policy.ID),
+		policyAuditDetails(policy),
+	)
+
+	return policy, nil
+}
+
+// DeletePolicy: 워크스페이스 정책을 삭제합니다
+func (s *Service) DeletePolicy(ctx context.Context, actorID, workspaceID, policyID uuid.UUID) error {
+	policy, err := s.GetPolicy(ctx, workspaceID, policyID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.policyRepo.Delete(ctx, policy.ID); err != nil {
+		return domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to delete workspace policy: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionPolicyDelete,
+		fmt.Sprintf("DELETE /api/v1/workspaces/%s/policies/%s", policy.WorkspaceID, policy.ID),
+		policyAuditDetails(policy),
+	)
+
+	return nil
+}
+
+// buildAttributes: 주체/리소스/작업/워크스페이스/환경 속성을 수집합니다
+func (s *Service) buildAttributes(ctx context.Context, workspace *domain.Workspace, req domain.PolicyRequest) (*attributeSet, error) {
+	now := time.Now().UTC()
+	if req.Time != nil {
+		now = req.Time.UTC()
+	}
+
+	values := map[string]string{
+		"action":         string(req.Action),
+		"workspace.id":   workspace.ID,
+		"workspace.name": workspace.Name,
+	}
+
+	if resource, verb, ok := strings.Cut(string(req.Action), ":"); ok {
+		values["action.resource"] = resource
+		values["action.verb"] = verb
+	}
+
+	for key, value := range workspace.Settings {
+		if value != nil {
+			values["workspace.settings."+key] = fmt.Sprint(value)
+		}
+	}
+
+	// Subject: explicit subject (dry-run) or the authenticated user in context
+	subjectID := req.SubjectID
+	if subjectID == nil {
+		if id, ok := domain.SubjectFromContext(ctx); ok {
+			subjectID = &id
+		}
+	}
+	if subjectID != nil {
+		values["subject.id"] = subjectID.String()
+		if s.rbacService != nil {
+			workspaceUUID, err := uuid.Parse(workspace.ID)
+			if err == nil {
+				access, err := s.rbacService.GetWorkspaceAccess(ctx, workspaceUUID, *subjectID)
+				if err != nil {
+					return nil, err
+				}
+				values["subject.role"] = access.Role
+			}
+		}
+	}
+
+	setIfPresent(values, "resource.type", req.Resource.Type)
+	setIfPresent(values, "resource.name", req.Resource.Name)
+	setIfPresent(values, "resource.provider", req.Resource.Provider)
+	setIfPresent(values, "resource.region", req.Resource.Region)
+	setIfPresent(values, "resource.credential_id", req.Resource.CredentialID)
+	for key, value := range req.Resource.Attributes {
+		setIfPresent(values, "resource."+key, value)
+	}
+
+	for key, value := range req.Environment {
+		setIfPresent(values, "env."+key, value)
+	}
+
+	return &attributeSet{values: values, now: now}, nil
+}
+
+// getWorkspace: 워크스페이스를 조회합니다
+func (s *Service) getWorkspace(ctx context.Context, workspaceID string) (*domain.Workspace, error) {
+	if workspaceID == "" {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "workspace_id is required", 400)
+	}
+	workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get workspace: %v", err), 500)
+	}
+	if workspace == nil {
+		return nil, domain.ErrWorkspaceNotFound
+	}
+	return workspace, nil
+}
+
+// setIfPresent: 값이 비어 있지 않을 때만 속성을 설정합니다
+func setIfPresent(values map[string]string, key, value string) {
+	if value != "" {
+		values[key] = value
+	}
+}
+
+// policyAuditDetails: 정책 감사 로그 상세 정보를 생성합니다
+func policyAuditDetails(policy *domain.WorkspacePolicy) map[string]interface{} {
+	return map[string]interface{}{
+		"workspace_id": policy.WorkspaceID,
+		"policy_id":    policy.ID.String(),
+		"name":         policy.Name,
+		"effect":       string(policy.Effect),
+		"actions":      policy.Actions,
+		"conditions":   policy.Conditions,
+		"enabled":      policy.Enabled,
+	}
+}
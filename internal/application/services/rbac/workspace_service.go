@@ -0,0 +1,353 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"skyclust/internal/application/services/common"
+	"skyclust/internal/domain"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// 워크스페이스 접근 역할 표시 이름
+const (
+	accessRoleOwner       = "owner"
+	accessRoleSystemAdmin = "system-admin"
+)
+
+// workspaceRBACService: domain.WorkspaceRBACService 인터페이스 구현체
+type workspaceRBACService struct {
+	roleRepo      domain.WorkspaceRoleRepository
+	workspaceRepo domain.WorkspaceRepository
+	rbacService   domain.RBACService
+	auditLogRepo  domain.AuditLogRepository
+}
+
+// NewWorkspaceService: 새로운 워크스페이스 범위 RBAC 서비스를 생성합니다
+func NewWorkspaceService(
+	roleRepo domain.WorkspaceRoleRepository,
+	workspaceRepo domain.WorkspaceRepository,
+	rbacService domain.RBACService,
+	auditLogRepo domain.AuditLogRepository,
+) domain.WorkspaceRBACService {
+	return &workspaceRBACService{
+		roleRepo:      roleRepo,
+		workspaceRepo: workspaceRepo,
+		rbacService:   rbacService,
+		auditLogRepo:  auditLogRepo,
+	}
+}
+
+// GetPermissionCatalog: 워크스페이스 역할에 부여할 수 있는 권한 카탈로그를 반환합니다
+func (s *workspaceRBACService) GetPermissionCatalog() []domain.PermissionDefinition {
+	return domain.WorkspacePermissionCatalog
+}
+
+// GetWorkspaceAccess: 사용자의 워크스페이스 내 유효 역할과 권한을 계산합니다
+// 소유자와 시스템 관리자는 모든 권한을 가지며, 멤버는 역할(기본 제공 또는 사용자 정의)의 권한을 가집니다
+func (s *workspaceRBACService) GetWorkspaceAccess(ctx context.Context, workspaceID, userID uuid.UUID) (*domain.WorkspaceAccess, error) {
+	workspace, err := s.getWorkspace(ctx, workspaceID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	access := &domain.WorkspaceAccess{
+		WorkspaceID: workspace.ID,
+		UserID:      userID.String(),
+		Permissions: domain.PermissionList{},
+	}
+
+	if workspace.OwnerID == userID.String() {
+		access.Role = accessRoleOwner
+		access.Permissions = domain.PermissionList(domain.BuiltinWorkspaceRolePermissions[domain.WorkspaceRoleAdmin])
+		return access, nil
+	}
+
+	isAdmin, err := s.rbacService.HasRole(userID, domain.AdminRoleType)
+	if err != nil {
+		return nil, err
+	}
+	if isAdmin {
+		access.Role = accessRoleSystemAdmin
+		access.Permissions = domain.PermissionList(domain.BuiltinWorkspaceRolePermissions[domain.WorkspaceRoleAdmin])
+		return access, nil
+	}
+
+	memberRole, err := s.roleRepo.GetMemberRole(ctx, workspace.ID, userID.String())
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, "failed to get workspace membership", 500)
+	}
+	if memberRole == "" {
+		return access, nil
+	}
+
+	access.Role = memberRole
+	permissions, err := s.rolePermissions(ctx, workspace.ID, memberRole)
+	if err != nil {
+		return nil, err
+	}
+	access.Permissions = permissions
+	return access, nil
+}
+
+// CheckWorkspacePermission: 사용자가 워크스페이스에서 권한을 가지고 있는지 확인합니다
+func (s *workspaceRBACService) CheckWorkspacePermission(ctx context.Context, workspaceID, userID uuid.UUID, permission domain.Permission) error {
+	access, err := s.GetWorkspaceAccess(ctx, workspaceID, userID)
+	if err != nil {
+		return err
+	}
+	if access.Has(permission) {
+		return nil
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &userID, domain.ActionWorkspaceAccessDenied,
+		fmt.Sprintf("CHECK /api/v1/workspaces/%s/permissions/%s", workspaceID, permission),
+		map[string]interface{}{
+			"workspace_id": workspaceID.String(),
+			"permission":   string(permission),
+			"role":         access.Role,
+		},
+	)
+
+	if access.Role == "" {
+		return domain.NewDomainError(domain.ErrCodeForbidden, "you are not a member of this workspace", 403)
+	}
+	return domain.NewDomainError(domain.ErrCodeForbidden, fmt.Sprintf("permission %s is required in this workspace", permission), 403)
+}
+
+// ValidateWorkspaceRole: 역할 이름이 기본 제공 역할이거나 워크스페이스의 사용자 정의 역할인지 확인합니다
+func (s *workspaceRBACService) ValidateWorkspaceRole(ctx context.Context, workspaceID, role string) error {
+	if domain.IsBuiltinWorkspaceRole(role) {
+		return nil
+	}
+
+	customRole, err := s.roleRepo.GetByName(ctx, workspaceID, role)
+	if err != nil {
+		return domain.NewDomainError(domain.ErrCodeInternalError, "failed to get workspace role", 500)
+	}
+	if customRole == nil {
+		return domain.NewDomainError(domain.ErrCodeBadRequest, fmt.Sprintf("invalid role %q. Must be admin, member, viewer or a custom role of this workspace", role), 400)
+	}
+	return nil
+}
+
+// AuthorizeRoleAssignment: 요청자가 멤버에게 역할을 할당할 수 있는지 확인합니다 (권한 상승 방지)
+func (s *workspaceRBACService) AuthorizeRoleAssignment(ctx context.Context, actorID, workspaceID uuid.UUID, role string) error {
+	if err := s.ValidateWorkspaceRole(ctx, workspaceID.String(), role); err != nil {
+		return err
+	}
+
+	access, err := s.GetWorkspaceAccess(ctx, workspaceID, actorID)
+	if err != nil {
+		return err
+	}
+	// admin 역할은 소유자, 시스템 관리자, admin 멤버만 할당할 수 있음
+	if role == domain.WorkspaceRoleAdmin {
+		switch access.Role {
+		case accessRoleOwner, accessRoleSystemAdmin, domain.WorkspaceRoleAdmin:
+		default:
+			return domain.NewDomainError(domain.ErrCodeForbidden, "only the workspace owner or an admin can assign the admin role", 403)
+		}
+	}
+
+	permissions, err := s.rolePermissions(ctx, workspaceID.String(), role)
+	if err != nil {
+		return err
+	}
+	for _, permission := range permissions {
+		if !access.Has(permission) {
+			return domain.NewDomainError(domain.ErrCodeForbidden, fmt.Sprintf("cannot assign role %q with permission %s that you do not have", role, permission), 403)
+		}
+	}
+	return nil
+}
+
+// ListWorkspaceRoles: 기본 제공 역할과 워크스페이스의 사용자 정의 역할을 조회합니다
+func (s *workspaceRBACService) ListWorkspaceRoles(ctx context.Context, workspaceID uuid.UUID) ([]*domain.WorkspaceRole, error) {
+	workspace, err := s.getWorkspace(ctx, workspaceID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	customRoles, err := s.roleRepo.ListByWorkspace(ctx, workspace.ID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to list workspace roles: %v", err), 500)
+	}
+
+	roles := []*domain.WorkspaceRole{
+		domain.NewBuiltinWorkspaceRole(workspace.ID, domain.WorkspaceRoleAdmin),
+		domain.NewBuiltinWorkspaceRole(workspace.ID, domain.WorkspaceRoleMember),
+		domain.NewBuiltinWorkspaceRole(workspace.ID, domain.WorkspaceRoleViewer),
+	}
+	return append(roles, customRoles...), nil
+}
+
+// CreateWorkspaceRole: 권한 카탈로그로 사용자 정의 워크스페이스 역할을 생성합니다
+func (s *workspaceRBACService) CreateWorkspaceRole(ctx context.Context, actorID, workspaceID uuid.UUID, req domain.CreateWorkspaceRoleRequest) (*domain.WorkspaceRole, error) {
+	workspace, err := s.getWorkspace(ctx, workspaceID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	role := &domain.WorkspaceRole{
+		WorkspaceID: workspace.ID,
+		Name:        strings.ToLower(strings.TrimSpace(req.Name)),
+		Description: strings.TrimSpace(req.Description),
+		Permissions: domain.PermissionList(req.Permissions),
+		CreatedBy:   &actorID,
+	}
+	if err := role.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.ensureGrantable(ctx, actorID, workspaceID, role.Permissions); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.roleRepo.GetByName(ctx, workspace.ID, role.Name)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, "failed to check existing role", 500)
+	}
+	if existing != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeAlreadyExists, fmt.Sprintf("role %q already exists in this workspace", role.Name), 409)
+	}
+
+	if err := s.roleRepo.Create(ctx, role); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to create workspace role: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionWorkspaceRoleCreate,
+		fmt.Sprintf("POST /api/v1/workspaces/%s/roles", workspace.ID),
+		map[string]interface{}{
+			"workspace_id": workspace.ID,
+			"role_id":      role.ID.String(),
+			"role":         role.Name,
+			"permissions":  role.Permissions,
+		},
+	)
+
+	return role, nil
+}
+
+// UpdateWorkspaceRole: 사용자 정의 워크스페이스 역할의 설명과 권한을 수정합니다
+func (s *workspaceRBACService) UpdateWorkspaceRole(ctx context.Context, actorID, workspaceID, roleID uuid.UUID, req domain.UpdateWorkspaceRoleRequest) (*domain.WorkspaceRole, error) {
+	role, err := s.getCustomRole(ctx, workspaceID, roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Description != nil {
+		role.Description = strings.TrimSpace(*req.Description)
+	}
+	if req.Permissions != nil {
+		role.Permissions = domain.PermissionList(req.Permissions)
+	}
+	if err := role.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.ensureGrantable(ctx, actorID, workspaceID, role.Permissions); err != nil {
+		return nil, err
+	}
+
+	if err := s.roleRepo.Update(ctx, role); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to update workspace role: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionWorkspaceRoleUpdate,
+		fmt.Sprintf("PUT /api/v1/workspaces/%s/roles/%s", role.WorkspaceID, role.ID),
+		map[string]interface{}{
+			"workspace_id": role.WorkspaceID,
+			"role_id":      role.ID.String(),
+			"role":         role.Name,
+			"permissions":  role.Permissions,
+		},
+	)
+
+	return role, nil
+}
+
+// DeleteWorkspaceRole: 사용자 정의 워크스페이스 역할을 삭제합니다 (할당된 멤버가 없어야 합니다)
+func (s *workspaceRBACService) DeleteWorkspaceRole(ctx context.Context, actorID, workspaceID, roleID uuid.UUID) error {
+	role, err := s.getCustomRole(ctx, workspaceID, roleID)
+	if err != nil {
+		return err
+	}
+
+	count, err := s.roleRepo.CountMembersWithRole(ctx, role.WorkspaceID, role.Name)
+	if err != nil {
+		return domain.NewDomainError(domain.ErrCodeInternalError, "failed to count role members", 500)
+	}
+	if count > 0 {
+		return domain.NewDomainError(domain.ErrCodeConflict, fmt.Sprintf("role %q is assigned to %d member(s); reassign them before deleting", role.Name, count), 409)
+	}
+
+	if err := s.roleRepo.Delete(ctx, role.ID); err != nil {
+		return domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to delete workspace role: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionWorkspaceRoleDelete,
+		fmt.Sprintf("DELETE /api/v1/workspaces/%s/roles/%s", role.WorkspaceID, role.ID),
+		map[string]interface{}{
+			"workspace_id": role.WorkspaceID,
+			"role_id":      role.ID.String(),
+			"role":         role.Name,
+		},
+	)
+
+	return nil
+}
+
+// rolePermissions: 역할 이름에 해당하는 권한 목록을 반환합니다
+func (s *workspaceRBACService) rolePermissions(ctx context.Context, workspaceID, role string) (domain.PermissionList, error) {
+	if permissions, ok := domain.BuiltinWorkspaceRolePermissions[role]; ok {
+		return domain.PermissionList(permissions), nil
+	}
+
+	customRole, err := s.roleRepo.GetByName(ctx, workspaceID, role)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, "failed to get workspace role", 500)
+	}
+	if customRole == nil {
+		// The role was removed out from under the member; grant nothing rather than guess
+		return domain.PermissionList{}, nil
+	}
+	return customRole.Permissions, nil
+}
+
+// ensureGrantable: 요청자가 자신이 가진 권한만 역할에 부여하도록 확인합니다 (권한 상승 방지)
+func (s *workspaceRBACService) ensureGrantable(ctx context.Context, actorID, workspaceID uuid.UUID, permissions domain.PermissionList) error {
+	access, err := s.GetWorkspaceAccess(ctx, workspaceID, actorID)
+	if err != nil {
+		return err
+	}
+	for _, permission := range permissions {
+		if !access.Has(permission) {
+			return domain.NewDomainError(domain.ErrCodeForbidden, fmt.Sprintf("cannot grant permission %s that you do not have", permission), 403)
+		}
+	}
+	return nil
+}
+
+// getCustomRole: 워크스페이스에 속한 사용자 정의 역할을 조회합니다
+func (s *workspaceRBACService) getCustomRole(ctx context.Context, workspaceID, roleID uuid.UUID) (*domain.WorkspaceRole, error) {
+	role, err := s.roleRepo.GetByID(ctx, roleID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get workspace role: %v", err), 500)
+	}
+	if role == nil || role.WorkspaceID != workspaceID.String() {
+		return nil, domain.NewDomainError(domain.ErrCodeNotFound, "workspace role not found", 404)
+	}
+	return role, nil
+}
+
+// getWorkspace: 워크스페이스를 조회합니다
+func (s *workspaceRBACService) getWorkspace(ctx context.Context, workspaceID string) (*domain.Workspace, error) {
+	workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get workspace: %v", err), 500)
+	}
+	if workspace == nil {
+		return nil, domain.ErrWorkspaceNotFound
+	}
+	return workspace, nil
+}
@@ -0,0 +1,29 @@
+package scim
+
+import "time"
+
+// SCIM Service Constants
+
+// Pagination constants
+const (
+	// DefaultPageSize is the number of resources returned when the client does not send count
+	DefaultPageSize = 100
+
+	// MaxPageSize is the maximum number of resources returned in a single list response
+	MaxPageSize = 200
+)
+
+// Token constants
+const (
+	// TokenPrefix is prepended to generated SCIM bearer tokens so they are recognisable in secret scanners
+	TokenPrefix = "scim_"
+
+	// TokenBytes is the number of random bytes in a generated SCIM bearer token
+	TokenBytes = 32
+
+	// TokenDisplayPrefixLength is the number of leading characters stored for identifying a token
+	TokenDisplayPrefixLength = 12
+
+	// TokenTouchInterval limits how often the last used timestamp is written
+	TokenTouchInterval = time.Minute
+)
@@ -0,0 +1,338 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"skyclust/internal/domain"
+	"strconv"
+	"strings"
+)
+
+// scimSchemaPrefixes: 필터/경로 속성에서 제거할 스키마 URN 접두사 (소문자)
+var scimSchemaPrefixes = []string{
+	strings.ToLower(domain.SCIMSchemaUser) + ":",
+	strings.ToLower(domain.SCIMSchemaGroup) + ":",
+}
+
+// filterOperators: 지원하는 비교 연산자
+var filterOperators = map[string]bool{
+	"eq": true, "ne": true, "co": true, "sw": true, "ew": true,
+	"gt": true, "ge": true, "lt": true, "le": true,
+}
+
+// filterToken: 필터 토크나이저가 생성하는 토큰
+type filterToken struct {
+	value  string
+	quoted bool
+}
+
+// filterParser: SCIM 필터 표현식 재귀 하강 파서
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+// ParseFilter: SCIM 필터 문자열을 파싱합니다 (RFC 7644 3.4.2.2)
+// 복수 값 속성의 값 필터(emails[type eq "work"].value)는 저장된 단일 값에 대한 비교로 축약됩니다
+func ParseFilter(input string) (*domain.SCIMFilter, error) {
+	if strings.TrimSpace(input) == "" {
+		return nil, nil
+	}
+
+	tokens, err := tokenizeFilter(input)
+	if err != nil {
+		return nil, invalidFilter(err.Error())
+	}
+
+	parser := &filterParser{tokens: tokens}
+	filter, err := parser.parseOr()
+	if err != nil {
+		return nil, invalidFilter(err.Error())
+	}
+	if parser.pos < len(parser.tokens) {
+		return nil, invalidFilter(fmt.Sprintf("unexpected token %q", parser.tokens[parser.pos].value))
+	}
+	return filter, nil
+}
+
+// ParsePath: PATCH 연산의 경로를 속성, 값 필터, 하위 속성으로 분리합니다
+// 예: members[value eq "id"] → ("members", filter, ""), emails[type eq "work"].value → ("emails", filter, "value")
+func ParsePath(path string) (string, *domain.SCIMFilter, string, error) {
+	path = strings.TrimSpace(path)
+	open := strings.Index(path, "[")
+	if open < 0 {
+		return normalizeAttribute(path), nil, "", nil
+	}
+
+	closeIdx := strings.LastIndex(path, "]")
+	if closeIdx < open {
+		return "", nil, "", domain.NewSCIMError(domain.SCIMErrorInvalidPath, fmt.Sprintf("invalid path: %s", path), 400)
+	}
+
+	attribute := normalizeAttribute(path[:open])
+	valueFilter, err := ParseFilter(path[open+1 : closeIdx])
+	if err != nil || valueFilter == nil {
+		return "", nil, "", domain.NewSCIMError(domain.SCIMErrorInvalidPath, fmt.Sprintf("invalid path: %s", path), 400)
+	}
+
+	subAttribute := strings.TrimPrefix(strings.ToLower(path[closeIdx+1:]), ".")
+	return attribute, valueFilter, subAttribute, nil
+}
+
+// parseOr: or 연산자로 연결된 표현식을 파싱합니다
+func (p *filterParser) parseOr() (*domain.SCIMFilter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &domain.SCIMFilter{Operator: "or", Children: []*domain.SCIMFilter{left, right}}
+	}
+	return left, nil
+}
+
+// parseAnd: and 연산자로 연결된 표현식을 파싱합니다
+func (p *filterParser) parseAnd() (*domain.SCIMFilter, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("and") {
+		p.pos++
+		right, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		left = &domain.SCIMFilter{Operator: "and", Children: []*domain.SCIMFilter{left, right}}
+	}
+	return left, nil
+}
+
+// parseFactor: not, 괄호 그룹 또는 속성 표현식을 파싱합니다
+func (p *filterParser) parseFactor() (*domain.SCIMFilter, error) {
+	if p.peekKeyword("not") {
+		p.pos++
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return &domain.SCIMFilter{Operator: "not", Children: []*domain.SCIMFilter{inner}}, nil
+	}
+
+	if p.peekSymbol("(") {
+		p.pos++
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return inner, nil
+	}
+
+	return p.parseAttributeExpression()
+}
+
+// parseAttributeExpression: attrPath pr | attrPath op value | attrPath[valFilter](.subAttr op value)?
+func (p *filterParser) parseAttributeExpression() (*domain.SCIMFilter, error) {
+	token, ok := p.next()
+	if !ok {
+		return nil, fmt.Errorf("unexpected end of filter")
+	}
+	if token.quoted || isFilterSymbol(token.value) {
+		return nil, fmt.Errorf("expected attribute path, got %q", token.value)
+	}
+	attribute := normalizeAttribute(token.value)
+
+	if p.peekSymbol("[") {
+		p.pos++
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+
+		// attr[filter].sub op value: the stored single value is treated as the matching element
+		if next, ok := p.peek(); ok && !next.quoted && strings.HasPrefix(next.value, ".") {
+			p.pos++
+			return p.parseComparison(attribute + strings.ToLower(next.value))
+		}
+
+		prefixFilterAttributes(inner, attribute)
+		return inner, nil
+	}
+
+	return p.parseComparison(attribute)
+}
+
+// parseComparison: 속성 뒤의 연산자와 비교 값을 파싱합니다
+func (p *filterParser) parseComparison(attribute string) (*domain.SCIMFilter, error) {
+	token, ok := p.next()
+	if !ok {
+		return nil, fmt.Errorf("missing operator after %s", attribute)
+	}
+	operator := strings.ToLower(token.value)
+	if operator == "pr" && !token.quoted {
+		return &domain.SCIMFilter{Operator: "pr", Attribute: attribute}, nil
+	}
+	if token.quoted || !filterOperators[operator] {
+		return nil, fmt.Errorf("unsupported operator %q", token.value)
+	}
+
+	valueToken, ok := p.next()
+	if !ok {
+		return nil, fmt.Errorf("missing value after %s %s", attribute, operator)
+	}
+	value, err := parseFilterValue(valueToken)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.SCIMFilter{Operator: operator, Attribute: attribute, Value: value}, nil
+}
+
+// expect: 다음 토큰이 지정한 기호인지 확인하고 소비합니다
+func (p *filterParser) expect(symbol string) error {
+	if !p.peekSymbol(symbol) {
+		return fmt.Errorf("expected %q", symbol)
+	}
+	p.pos++
+	return nil
+}
+
+// next: 다음 토큰을 소비하고 반환합니다
+func (p *filterParser) next() (filterToken, bool) {
+	token, ok := p.peek()
+	if ok {
+		p.pos++
+	}
+	return token, ok
+}
+
+// peek: 다음 토큰을 소비하지 않고 반환합니다
+func (p *filterParser) peek() (filterToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return filterToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+// peekKeyword: 다음 토큰이 지정한 키워드(대소문자 무시)인지 확인합니다
+func (p *filterParser) peekKeyword(keyword string) bool {
+	token, ok := p.peek()
+	return ok && !token.quoted && strings.EqualFold(token.value, keyword)
+}
+
+// peekSymbol: 다음 토큰이 지정한 기호인지 확인합니다
+func (p *filterParser) peekSymbol(symbol string) bool {
+	token, ok := p.peek()
+	return ok && !token.quoted && token.value == symbol
+}
+
+// tokenizeFilter: 필터 문자열을 토큰으로 분리합니다
+func tokenizeFilter(input string) ([]filterToken, error) {
+	var tokens []filterToken
+	runes := []rune(input)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			i++
+		case r == '(' || r == ')' || r == '[' || r == ']':
+			tokens = append(tokens, filterToken{value: string(r)})
+			i++
+		case r == '"':
+			// Find the closing quote, honouring JSON escapes
+			j := i + 1
+			for ; j < len(runes); j++ {
+				if runes[j] == '\\' {
+					j++
+					continue
+				}
+				if runes[j] == '"' {
+					break
+				}
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			var value string
+			if err := json.Unmarshal([]byte(string(runes[i:j+1])), &value); err != nil {
+				return nil, fmt.Errorf("invalid string literal")
+			}
+			tokens = append(tokens, filterToken{value: value, quoted: true})
+			i = j + 1
+		default:
+			j := i
+			for j < len(runes) && !strings.ContainsRune(" \t\n\r()[]\"", runes[j]) {
+				j++
+			}
+			tokens = append(tokens, filterToken{value: string(runes[i:j])})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+// parseFilterValue: 비교 값 토큰을 문자열, 불리언, null 또는 숫자로 변환합니다
+func parseFilterValue(token filterToken) (interface{}, error) {
+	if token.quoted {
+		return token.value, nil
+	}
+	switch strings.ToLower(token.value) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	number, err := strconv.ParseFloat(token.value, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid comparison value %q", token.value)
+	}
+	return number, nil
+}
+
+// prefixFilterAttributes: 값 필터의 속성에 상위 속성 경로를 붙입니다
+func prefixFilterAttributes(filter *domain.SCIMFilter, parent string) {
+	if filter.IsLogical() {
+		for _, child := range filter.Children {
+			prefixFilterAttributes(child, parent)
+		}
+		return
+	}
+	filter.Attribute = parent + "." + filter.Attribute
+}
+
+// normalizeAttribute: 속성 경로를 소문자로 바꾸고 스키마 URN 접두사를 제거합니다
+func normalizeAttribute(attribute string) string {
+	attribute = strings.ToLower(strings.TrimSpace(attribute))
+	for _, prefix := range scimSchemaPrefixes {
+		attribute = strings.TrimPrefix(attribute, prefix)
+	}
+	return attribute
+}
+
+// isFilterSymbol: 토큰이 괄호 기호인지 확인합니다
+func isFilterSymbol(value string) bool {
+	return value == "(" || value == ")" || value == "[" || value == "]"
+}
+
+// invalidFilter: invalidFilter 타입의 SCIM 오류를 생성합니다
+func invalidFilter(message string) error {
+	return domain.NewSCIMError(domain.SCIMErrorInvalidFilter, fmt.Sprintf("invalid filter: %s", message), 400)
+}
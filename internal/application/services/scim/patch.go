@@ -0,0 +1,295 @@
+package scim
+
+import (
+	"fmt"
+	"skyclust/internal/domain"
+	"strings"
+)
+
+// groupPatchState: PATCH 연산 적용 중의 그룹 상태
+type groupPatchState struct {
+	displayName string
+	externalID  string
+	members     map[string]bool
+}
+
+// applyUserPatch: 단일 PATCH 연산을 사용자 속성에 적용합니다
+// 지원하지 않는 속성은 RFC 7644 3.5.2에 따라 무시합니다
+func applyUserPatch(attrs *domain.SCIMUserAttributes, operation domain.SCIMPatchOperation) error {
+	op, err := normalizeOp(operation.Op)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(operation.Path) == "" {
+		if op == "remove" {
+			return domain.NewSCIMError(domain.SCIMErrorNoTarget, "remove operation requires a path", 400)
+		}
+		values, ok := operation.Value.(map[string]interface{})
+		if !ok {
+			return domain.NewSCIMError(domain.SCIMErrorInvalidValue, "operation without path requires an object value", 400)
+		}
+		for key, value := range values {
+			if err := setUserAttribute(attrs, normalizeAttribute(key), "", value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	attribute, _, subAttribute, err := ParsePath(operation.Path)
+	if err != nil {
+		return err
+	}
+
+	if op == "remove" {
+		return setUserAttribute(attrs, attribute, subAttribute, nil)
+	}
+	return setUserAttribute(attrs, attribute, subAttribute, operation.Value)
+}
+
+// setUserAttribute: 사용자 속성 하나를 설정합니다 (value가 nil이면 제거)
+func setUserAttribute(attrs *domain.SCIMUserAttributes, attribute, subAttribute string, value interface{}) error {
+	if subAttribute != "" {
+		attribute = attribute + "." + subAttribute
+	}
+
+	switch attribute {
+	case "username":
+		text, err := stringValue(attribute, value)
+		if err != nil {
+			return err
+		}
+		attrs.UserName = text
+	case "externalid":
+		text, err := stringValue(attribute, value)
+		if err != nil {
+			return err
+		}
+		attrs.ExternalID = text
+	case "emails", "emails.value":
+		email, err := emailValue(value)
+		if err != nil {
+			return err
+		}
+		attrs.Email = email
+	case "active":
+		active, err := boolValue(value)
+		if err != nil {
+			return err
+		}
+		attrs.Active = &active
+	case "password":
+		text, err := stringValue(attribute, value)
+		if err != nil {
+			return err
+		}
+		attrs.Password = text
+	}
+	return nil
+}
+
+// applyGroupPatch: 단일 PATCH 연산을 그룹 상태에 적용합니다
+func applyGroupPatch(state *groupPatchState, operation domain.SCIMPatchOperation) error {
+	op, err := normalizeOp(operation.Op)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(operation.Path) == "" {
+		if op == "remove" {
+			return domain.NewSCIMError(domain.SCIMErrorNoTarget, "remove operation requires a path", 400)
+		}
+		values, ok := operation.Value.(map[string]interface{})
+		if !ok {
+			return domain.NewSCIMError(domain.SCIMErrorInvalidValue, "operation without path requires an object value", 400)
+		}
+		for key, value := range values {
+			if err := applyGroupAttribute(state, op, normalizeAttribute(key), nil, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	attribute, valueFilter, _, err := ParsePath(operation.Path)
+	if err != nil {
+		return err
+	}
+	return applyGroupAttribute(state, op, attribute, valueFilter, operation.Value)
+}
+
+// applyGroupAttribute: 그룹 속성 하나에 연산을 적용합니다
+func applyGroupAttribute(state *groupPatchState, op, attribute string, valueFilter *domain.SCIMFilter, value interface{}) error {
+	switch attribute {
+	case "displayname":
+		if op == "remove" {
+			state.displayName = ""
+			return nil
+		}
+		text, err := stringValue(attribute, value)
+		if err != nil {
+			return err
+		}
+		state.displayName = text
+	case "externalid":
+		if op == "remove" {
+			state.externalID = ""
+			return nil
+		}
+		text, err := stringValue(attribute, value)
+		if err != nil {
+			return err
+		}
+		state.externalID = text
+	case "members":
+		return applyMembersPatch(state, op, valueFilter, value)
+	}
+	return nil
+}
+
+// applyMembersPatch: members 속성에 add/remove/replace 연산을 적용합니다
+func applyMembersPatch(state *groupPatchState, op string, valueFilter *domain.SCIMFilter, value interface{}) error {
+	switch op {
+	case "add":
+		ids, err := memberValues(value)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			state.members[id] = true
+		}
+	case "replace":
+		ids, err := memberValues(value)
+		if err != nil {
+			return err
+		}
+		state.members = make(map[string]bool, len(ids))
+		for _, id := range ids {
+			state.members[id] = true
+		}
+	case "remove":
+		if valueFilter != nil {
+			// members[value eq "id"] is the form Entra ID and Okta send for removals
+			if valueFilter.Operator != "eq" || valueFilter.Attribute != "members.value" {
+				return domain.NewSCIMError(domain.SCIMErrorInvalidPath, "only members[value eq \"id\"] filters are supported", 400)
+			}
+			id, ok := valueFilter.Value.(string)
+			if !ok {
+				return domain.NewSCIMError(domain.SCIMErrorInvalidPath, "member filter value must be a string", 400)
+			}
+			delete(state.members, strings.ToLower(id))
+			return nil
+		}
+		if value == nil {
+			state.members = make(map[string]bool)
+			return nil
+		}
+		ids, err := memberValues(value)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			delete(state.members, id)
+		}
+	}
+	return nil
+}
+
+// normalizeOp: PATCH 연산 이름을 소문자로 정규화하고 검증합니다
+func normalizeOp(op string) (string, error) {
+	op = strings.ToLower(strings.TrimSpace(op))
+	switch op {
+	case "add", "replace", "remove":
+		return op, nil
+	}
+	return "", domain.NewSCIMError(domain.SCIMErrorInvalidSyntax, fmt.Sprintf("unsupported patch operation: %s", op), 400)
+}
+
+// memberValues: members 값(객체 또는 객체 배열)에서 사용자 ID를 추출합니다
+func memberValues(value interface{}) ([]string, error) {
+	var items []interface{}
+	switch typed := value.(type) {
+	case []interface{}:
+		items = typed
+	case map[string]interface{}:
+		items = []interface{}{typed}
+	default:
+		return nil, domain.NewSCIMError(domain.SCIMErrorInvalidValue, "members must be a list of objects with value", 400)
+	}
+
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		member, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, domain.NewSCIMError(domain.SCIMErrorInvalidValue, "members must be a list of objects with value", 400)
+		}
+		id, ok := member["value"].(string)
+		if !ok || id == "" {
+			return nil, domain.NewSCIMError(domain.SCIMErrorInvalidValue, "member value is required", 400)
+		}
+		ids = append(ids, strings.ToLower(strings.TrimSpace(id)))
+	}
+	return ids, nil
+}
+
+// stringValue: 문자열 속성 값을 검증합니다 (nil은 빈 문자열)
+func stringValue(attribute string, value interface{}) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	text, ok := value.(string)
+	if !ok {
+		return "", domain.NewSCIMError(domain.SCIMErrorInvalidValue, fmt.Sprintf("%s must be a string", attribute), 400)
+	}
+	return strings.TrimSpace(text), nil
+}
+
+// boolValue: active 값을 불리언으로 변환합니다 (Entra ID는 "True"/"False" 문자열을 보냅니다)
+func boolValue(value interface{}) (bool, error) {
+	switch typed := value.(type) {
+	case bool:
+		return typed, nil
+	case string:
+		switch strings.ToLower(typed) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+	}
+	return false, domain.NewSCIMError(domain.SCIMErrorInvalidValue, "active must be a boolean", 400)
+}
+
+// emailValue: emails 값(문자열, 객체 또는 배열)에서 기본 이메일을 추출합니다
+func emailValue(value interface{}) (string, error) {
+	switch typed := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return strings.ToLower(strings.TrimSpace(typed)), nil
+	case map[string]interface{}:
+		email, _ := typed["value"].(string)
+		return strings.ToLower(strings.TrimSpace(email)), nil
+	case []interface{}:
+		var fallback string
+		for _, item := range typed {
+			entry, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			email, _ := entry["value"].(string)
+			if email == "" {
+				continue
+			}
+			if primary, _ := entry["primary"].(bool); primary {
+				return strings.ToLower(strings.TrimSpace(email)), nil
+			}
+			if fallback == "" {
+				fallback = email
+			}
+		}
+		return strings.ToLower(strings.TrimSpace(fallback)), nil
+	}
+	return "", domain.NewSCIMError(domain.SCIMErrorInvalidValue, "emails must be a list of objects with value", 400)
+}
@@ -0,0 +1,829 @@
+package scim
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"skyclust/internal/application/services/common"
+	"skyclust/internal/domain"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Service: SCIM 2.0 프로비저닝 서비스 구현체
+type Service struct {
+	scimRepo      domain.SCIMRepository
+	userService   domain.UserService
+	rbacService   domain.RBACService
+	workspaceRepo domain.WorkspaceRepository
+	auditLogRepo  domain.AuditLogRepository
+}
+
+// NewService: 새로운 SCIM 서비스를 생성합니다
+func NewService(
+	scimRepo domain.SCIMRepository,
+	userService domain.UserService,
+	rbacService domain.RBACService,
+	workspaceRepo domain.WorkspaceRepository,
+	auditLogRepo domain.AuditLogRepository,
+) domain.SCIMService {
+	return &Service{
+		scimRepo:      scimRepo,
+		userService:   userService,
+		rbacService:   rbacService,
+		workspaceRepo: workspaceRepo,
+		auditLogRepo:  auditLogRepo,
+	}
+}
+
+// AuthenticateToken: SCIM Bearer 토큰을 검증합니다
+func (s *Service) AuthenticateToken(ctx context.Context, token string) (*domain.SCIMToken, error) {
+	if !strings.HasPrefix(token, TokenPrefix) {
+		return nil, domain.NewDomainError(domain.ErrCodeUnauthorized, "invalid SCIM token", 401)
+	}
+
+	scimToken, err := s.scimRepo.GetTokenByHash(hashToken(token))
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, "failed to verify SCIM token", 500)
+	}
+	if scimToken == nil || scimToken.IsExpired() {
+		return nil, domain.NewDomainError(domain.ErrCodeUnauthorized, "invalid SCIM token", 401)
+	}
+
+	now := time.Now()
+	if scimToken.LastUsedAt == nil || now.Sub(*scimToken.LastUsedAt) > TokenTouchInterval {
+		_ = s.scimRepo.TouchToken(scimToken.ID, now)
+		scimToken.LastUsedAt = &now
+	}
+
+	return scimToken, nil
+}
+
+// CreateToken: 새로운 SCIM 토큰을 발급합니다 (평문 토큰은 이 응답에서만 반환됩니다)
+func (s *Service) CreateToken(ctx context.Context, adminID uuid.UUID, name string, expiresAt *time.Time) (*domain.SCIMToken, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return nil, "", domain.NewDomainError(domain.ErrCodeValidationFailed, "name must be between 1 and 100 characters", 400)
+	}
+	if expiresAt != nil && expiresAt.Before(time.Now()) {
+		return nil, "", domain.NewDomainError(domain.ErrCodeValidationFailed, "expires_at must be in the future", 400)
+	}
+
+	secret := make([]byte, TokenBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", domain.NewDomainError(domain.ErrCodeInternalError, "failed to generate token", 500)
+	}
+	plainToken := TokenPrefix + hex.EncodeToString(secret)
+
+	token := &domain.SCIMToken{
+		Name:        name,
+		TokenHash:   hashToken(plainToken),
+		TokenPrefix: plainToken[:TokenDisplayPrefixLength],
+		ExpiresAt:   expiresAt,
+		CreatedBy:   adminID,
+	}
+	if err := s.scimRepo.CreateToken(token); err != nil {
+		return nil, "", domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to create SCIM token: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &adminID, domain.ActionSCIMTokenCreate,
+		"POST /api/v1/admin/scim/tokens",
+		map[string]interface{}{
+			"token_id":   token.ID.String(),
+			"token_name": token.Name,
+		},
+	)
+
+	return token, plainToken, nil
+}
+
+// ListTokens: 모든 SCIM 토큰을 조회합니다
+func (s *Service) ListTokens(ctx context.Context) ([]*domain.SCIMToken, error) {
+	tokens, err := s.scimRepo.ListTokens()
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to list SCIM tokens: %v", err), 500)
+	}
+	return tokens, nil
+}
+
+// RevokeToken: SCIM 토큰을 폐기합니다
+func (s *Service) RevokeToken(ctx context.Context, adminID uuid.UUID, tokenID uuid.UUID) error {
+	if err := s.scimRepo.DeleteToken(tokenID); err != nil {
+		if strings.Contains(err.Error(), "record not found") {
+			return domain.NewDomainError(domain.ErrCodeNotFound, "SCIM token not found", 404)
+		}
+		return domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to revoke SCIM token: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &adminID, domain.ActionSCIMTokenRevoke,
+		fmt.Sprintf("DELETE /api/v1/admin/scim/tokens/%s", tokenID),
+		map[string]interface{}{
+			"token_id": tokenID.String(),
+		},
+	)
+
+	return nil
+}
+
+// ListUsers: SCIM 필터와 페이지네이션으로 사용자를 조회합니다
+func (s *Service) ListUsers(ctx context.Context, filter string, startIndex, count int) ([]*domain.User, int64, error) {
+	parsed, err := ParseFilter(filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	offset, limit := pagination(startIndex, count)
+	users, total, err := s.scimRepo.SearchUsers(parsed, offset, limit)
+	if err != nil {
+		return nil, 0, wrapRepositoryError(err, "failed to list users")
+	}
+	return users, total, nil
+}
+
+// GetUser: SCIM 사용자를 조회합니다
+func (s *Service) GetUser(ctx context.Context, userID string) (*domain.User, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, domain.NewSCIMError(domain.SCIMErrorNoTarget, "user not found", 404)
+	}
+
+	user, err := s.userService.GetUserByID(id)
+	if err != nil {
+		var domainErr *domain.DomainError
+		if errors.As(err, &domainErr) && domainErr.StatusCode == 404 {
+			return nil, domain.NewSCIMError(domain.SCIMErrorNoTarget, "user not found", 404)
+		}
+		return nil, err
+	}
+	if user == nil {
+		return nil, domain.NewSCIMError(domain.SCIMErrorNoTarget, "user not found", 404)
+	}
+	return user, nil
+}
+
+// CreateUser: SCIM 요청으로 사용자를 생성합니다
+func (s *Service) CreateUser(ctx context.Context, token *domain.SCIMToken, attrs domain.SCIMUserAttributes) (*domain.User, error) {
+	attrs.Normalize()
+	if attrs.UserName == "" {
+		return nil, domain.NewSCIMError(domain.SCIMErrorInvalidValue, "userName is required", 400)
+	}
+	if attrs.Email == "" {
+		return nil, domain.NewSCIMError(domain.SCIMErrorInvalidValue, "a primary email is required", 400)
+	}
+
+	// Provisioned users sign in through SSO; a random password keeps local login unusable
+	password := attrs.Password
+	if password == "" {
+		generated, err := randomPassword()
+		if err != nil {
+			return nil, domain.NewDomainError(domain.ErrCodeInternalError, "failed to generate password", 500)
+		}
+		password = generated
+	}
+
+	user, err := s.userService.CreateUser(ctx, domain.CreateUserRequest{
+		Username: attrs.UserName,
+		Email:    attrs.Email,
+		Password: password,
+	})
+	if err != nil {
+		return nil, toSCIMError(err)
+	}
+
+	user.ExternalID = attrs.ExternalID
+	if attrs.Active != nil {
+		user.Active = *attrs.Active
+	}
+	if user, err = s.userService.UpdateUserDirect(user); err != nil {
+		return nil, toSCIMError(err)
+	}
+
+	if err := s.rbacService.AssignRole(user.ID, domain.UserRoleType); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, "failed to assign role", 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &token.CreatedBy, domain.ActionSCIMUserCreate,
+		"POST /scim/v2/Users",
+		map[string]interface{}{
+			"token_id":    token.ID.String(),
+			"user_id":     user.ID.String(),
+			"username":    user.Username,
+			"email":       user.Email,
+			"external_id": user.ExternalID,
+		},
+	)
+
+	return user, nil
+}
+
+// ReplaceUser: SCIM PUT 요청으로 사용자 속성을 교체합니다
+func (s *Service) ReplaceUser(ctx context.Context, token *domain.SCIMToken, userID string, attrs domain.SCIMUserAttributes) (*domain.User, error) {
+	user, err := s.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs.Normalize()
+	if attrs.UserName == "" {
+		return nil, domain.NewSCIMError(domain.SCIMErrorInvalidValue, "userName is required", 400)
+	}
+	if attrs.Email == "" {
+		attrs.Email = user.Email
+	}
+
+	return s.applyUserAttributes(ctx, token, user, attrs, "PUT")
+}
+
+// PatchUser: SCIM PATCH 연산을 사용자에 적용합니다
+func (s *Service) PatchUser(ctx context.Context, token *domain.SCIMToken, userID string, operations []domain.SCIMPatchOperation) (*domain.User, error) {
+	user, err := s.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	active := user.Active
+	attrs := domain.SCIMUserAttributes{
+		UserName:   user.Username,
+		ExternalID: user.ExternalID,
+		Email:      user.Email,
+		Active:     &active,
+	}
+	for _, operation := range operations {
+		if err := applyUserPatch(&attrs, operation); err != nil {
+			return nil, err
+		}
+	}
+
+	attrs.Normalize()
+	if attrs.UserName == "" {
+		return nil, domain.NewSCIMError(domain.SCIMErrorMutability, "userName cannot be removed", 400)
+	}
+	if attrs.Email == "" {
+		return nil, domain.NewSCIMError(domain.SCIMErrorMutability, "the primary email cannot be removed", 400)
+	}
+
+	return s.applyUserAttributes(ctx, token, user, attrs, "PATCH")
+}
+
+// DeleteUser: SCIM 요청으로 사용자를 삭제합니다
+func (s *Service) DeleteUser(ctx context.Context, token *domain.SCIMToken, userID string) error {
+	user, err := s.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.userService.DeleteUserByID(user.ID); err != nil {
+		return toSCIMError(err)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &token.CreatedBy, domain.ActionSCIMUserDelete,
+		fmt.Sprintf("DELETE /scim/v2/Users/%s", user.ID),
+		map[string]interface{}{
+			"token_id":    token.ID.String(),
+			"user_id":     user.ID.String(),
+			"username":    user.Username,
+			"email":       user.Email,
+			"external_id": user.ExternalID,
+		},
+	)
+
+	return nil
+}
+
+// GetUserGroups: 사용자가 속한 SCIM 그룹을 조회합니다
+func (s *Service) GetUserGroups(ctx context.Context, userID uuid.UUID) ([]*domain.SCIMGroup, error) {
+	groups, err := s.scimRepo.ListGroupsByUser(userID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to list user groups: %v", err), 500)
+	}
+	return groups, nil
+}
+
+// ListGroups: SCIM 필터와 페이지네이션으로 그룹을 조회합니다
+func (s *Service) ListGroups(ctx context.Context, filter string, startIndex, count int) ([]*domain.SCIMGroup, int64, error) {
+	parsed, err := ParseFilter(filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	offset, limit := pagination(startIndex, count)
+	groups, total, err := s.scimRepo.SearchGroups(parsed, offset, limit)
+	if err != nil {
+		return nil, 0, wrapRepositoryError(err, "failed to list groups")
+	}
+	return groups, total, nil
+}
+
+// GetGroup: SCIM 그룹을 조회합니다
+func (s *Service) GetGroup(ctx context.Context, groupID string) (*domain.SCIMGroup, error) {
+	id, err := uuid.Parse(groupID)
+	if err != nil {
+		return nil, domain.NewSCIMError(domain.SCIMErrorNoTarget, "group not found", 404)
+	}
+
+	group, err := s.scimRepo.GetGroupByID(id)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get group: %v", err), 500)
+	}
+	if group == nil {
+		return nil, domain.NewSCIMError(domain.SCIMErrorNoTarget, "group not found", 404)
+	}
+	return group, nil
+}
+
+// CreateGroup: SCIM 요청으로 그룹을 생성합니다
+func (s *Service) CreateGroup(ctx context.Context, token *domain.SCIMToken, attrs domain.SCIMGroupAttributes) (*domain.SCIMGroup, error) {
+	displayName := strings.TrimSpace(attrs.DisplayName)
+	if displayName == "" {
+		return nil, domain.NewSCIMError(domain.SCIMErrorInvalidValue, "displayName is required", 400)
+	}
+	if err := s.ensureUniqueDisplayName(displayName, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	memberIDs, err := s.resolveMembers(attrs.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	group := &domain.SCIMGroup{
+		DisplayName: displayName,
+		ExternalID:  strings.TrimSpace(attrs.ExternalID),
+	}
+	if err := s.scimRepo.CreateGroup(group); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to create group: %v", err), 500)
+	}
+	if err := s.scimRepo.ReplaceGroupMembers(group.ID, memberIDs); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to set group members: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &token.CreatedBy, domain.ActionSCIMGroupCreate,
+		"POST /scim/v2/Groups",
+		map[string]interface{}{
+			"token_id":     token.ID.String(),
+			"group_id":     group.ID.String(),
+			"display_name": group.DisplayName,
+			"members":      len(memberIDs),
+		},
+	)
+
+	return s.GetGroup(ctx, group.ID.String())
+}
+
+// ReplaceGroup: SCIM PUT 요청으로 그룹 속성과 멤버를 교체합니다
+func (s *Service) ReplaceGroup(ctx context.Context, token *domain.SCIMToken, groupID string, attrs domain.SCIMGroupAttributes) (*domain.SCIMGroup, error) {
+	group, err := s.GetGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	displayName := strings.TrimSpace(attrs.DisplayName)
+	if displayName == "" {
+		return nil, domain.NewSCIMError(domain.SCIMErrorInvalidValue, "displayName is required", 400)
+	}
+	if err := s.ensureUniqueDisplayName(displayName, group.ID); err != nil {
+		return nil, err
+	}
+
+	memberIDs, err := s.resolveMembers(attrs.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	group.DisplayName = displayName
+	group.ExternalID = strings.TrimSpace(attrs.ExternalID)
+	return s.saveGroup(ctx, token, group, memberIDs, "PUT")
+}
+
+// PatchGroup: SCIM PATCH 연산을 그룹에 적용합니다
+func (s *Service) PatchGroup(ctx context.Context, token *domain.SCIMToken, groupID string, operations []domain.SCIMPatchOperation) (*domain.SCIMGroup, error) {
+	group, err := s.GetGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &groupPatchState{
+		displayName: group.DisplayName,
+		externalID:  group.ExternalID,
+		members:     make(map[string]bool, len(group.Members)),
+	}
+	for _, member := range group.Members {
+		state.members[member.UserID.String()] = true
+	}
+
+	for _, operation := range operations {
+		if err := applyGroupPatch(state, operation); err != nil {
+			return nil, err
+		}
+	}
+
+	state.displayName = strings.TrimSpace(state.displayName)
+	if state.displayName == "" {
+		return nil, domain.NewSCIMError(domain.SCIMErrorMutability, "displayName cannot be removed", 400)
+	}
+	if state.displayName != group.DisplayName {
+		if err := s.ensureUniqueDisplayName(state.displayName, group.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	members := make([]string, 0, len(state.members))
+	for memberID := range state.members {
+		members = append(members, memberID)
+	}
+	memberIDs, err := s.resolveMembers(members)
+	if err != nil {
+		return nil, err
+	}
+
+	group.DisplayName = state.displayName
+	group.ExternalID = strings.TrimSpace(state.externalID)
+	return s.saveGroup(ctx, token, group, memberIDs, "PATCH")
+}
+
+// DeleteGroup: SCIM 요청으로 그룹을 삭제하고 연결된 워크스페이스 멤버십을 정리합니다
+func (s *Service) DeleteGroup(ctx context.Context, token *domain.SCIMToken, groupID string) error {
+	group, err := s.GetGroup(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.scimRepo.DeleteGroup(group.ID); err != nil {
+		return domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to delete group: %v", err), 500)
+	}
+
+	if group.IsBound() {
+		// The group no longer grants access, so its workspace stays managed for this sync
+		managed := map[string]bool{group.WorkspaceID.String(): true}
+		if err := s.syncWorkspaceMemberships(ctx, &token.CreatedBy, group.MemberIDs(), managed); err != nil {
+			return err
+		}
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &token.CreatedBy, domain.ActionSCIMGroupDelete,
+		fmt.Sprintf("DELETE /scim/v2/Groups/%s", group.ID),
+		map[string]interface{}{
+			"token_id":     token.ID.String(),
+			"group_id":     group.ID.String(),
+			"display_name": group.DisplayName,
+		},
+	)
+
+	return nil
+}
+
+// BindGroupWorkspace: SCIM 그룹을 워크스페이스 역할에 연결하거나 연결을 해제합니다
+func (s *Service) BindGroupWorkspace(ctx context.Context, adminID uuid.UUID, groupID uuid.UUID, workspaceID *uuid.UUID, workspaceRole string) (*domain.SCIMGroup, error) {
+	group, err := s.scimRepo.GetGroupByID(groupID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get group: %v", err), 500)
+	}
+	if group == nil {
+		return nil, domain.NewDomainError(domain.ErrCodeNotFound, "SCIM group not found", 404)
+	}
+
+	managed := make(map[string]bool)
+	if group.IsBound() {
+		managed[group.WorkspaceID.String()] = true
+	}
+
+	group.WorkspaceID = workspaceID
+	group.WorkspaceRole = workspaceRole
+	if workspaceID == nil {
+		group.WorkspaceRole = ""
+	}
+	if err := group.ValidateBinding(); err != nil {
+		return nil, err
+	}
+
+	if workspaceID != nil {
+		workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID.String())
+		if err != nil {
+			return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get workspace: %v", err), 500)
+		}
+		if workspace == nil {
+			return nil, domain.NewDomainError(domain.ErrCodeNotFound, "workspace not found", 404)
+		}
+	}
+
+	if err := s.scimRepo.UpdateGroup(group); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to update group: %v", err), 500)
+	}
+
+	if err := s.syncWorkspaceMemberships(ctx, &adminID, group.MemberIDs(), managed); err != nil {
+		return nil, err
+	}
+
+	details := map[string]interface{}{
+		"group_id":     group.ID.String(),
+		"display_name": group.DisplayName,
+	}
+	if workspaceID != nil {
+		details["workspace_id"] = workspaceID.String()
+		details["workspace_role"] = group.WorkspaceRole
+	}
+	common.LogAction(ctx, s.auditLogRepo, &adminID, domain.ActionSCIMGroupBind,
+		fmt.Sprintf("PUT /api/v1/admin/scim/groups/%s/workspace", group.ID),
+		details,
+	)
+
+	return s.scimRepo.GetGroupByID(group.ID)
+}
+
+// applyUserAttributes: 변경된 사용자 속성을 저장하고 감사 로그를 남깁니다
+func (s *Service) applyUserAttributes(ctx context.Context, token *domain.SCIMToken, user *domain.User, attrs domain.SCIMUserAttributes, method string) (*domain.User, error) {
+	if len(attrs.UserName) < 3 || len(attrs.UserName) > 50 {
+		return nil, domain.NewSCIMError(domain.SCIMErrorInvalidValue, "userName must be between 3 and 50 characters", 400)
+	}
+
+	changes := make(map[string]interface{})
+	if user.Username != attrs.UserName {
+		changes["username"] = attrs.UserName
+		user.Username = attrs.UserName
+	}
+	if user.Email != attrs.Email {
+		changes["email"] = attrs.Email
+		user.Email = attrs.Email
+	}
+	if user.ExternalID != attrs.ExternalID {
+		changes["external_id"] = attrs.ExternalID
+		user.ExternalID = attrs.ExternalID
+	}
+	if attrs.Active != nil && user.Active != *attrs.Active {
+		changes["active"] = *attrs.Active
+		user.Active = *attrs.Active
+	}
+	if attrs.Password != "" {
+		if len(attrs.Password) < 8 {
+			return nil, domain.NewSCIMError(domain.SCIMErrorInvalidValue, "password must be at least 8 characters", 400)
+		}
+		hash, err := s.userService.HashPassword(attrs.Password)
+		if err != nil {
+			return nil, domain.NewDomainError(domain.ErrCodeInternalError, "failed to hash password", 500)
+		}
+		user.PasswordHash = hash
+		changes["password"] = "changed"
+	}
+
+	if len(changes) == 0 {
+		return user, nil
+	}
+
+	updated, err := s.userService.UpdateUserDirect(user)
+	if err != nil {
+		return nil, toSCIMError(err)
+	}
+
+	changes["token_id"] = token.ID.String()
+	changes["user_id"] = updated.ID.String()
+	common.LogAction(ctx, s.auditLogRepo, &token.CreatedBy, domain.ActionSCIMUserUpdate,
+		fmt.Sprintf("%s /scim/v2/Users/%s", method, updated.ID),
+		changes,
+	)
+
+	return updated, nil
+}
+
+// saveGroup: 그룹 속성과 멤버를 저장하고 워크스페이스 멤버십을 동기화합니다
+func (s *Service) saveGroup(ctx context.Context, token *domain.SCIMToken, group *domain.SCIMGroup, memberIDs []uuid.UUID, method string) (*domain.SCIMGroup, error) {
+	previous := group.MemberIDs()
+
+	if err := s.scimRepo.UpdateGroup(group); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to update group: %v", err), 500)
+	}
+	if err := s.scimRepo.ReplaceGroupMembers(group.ID, memberIDs); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to set group members: %v", err), 500)
+	}
+
+	added, removed := diffMembers(previous, memberIDs)
+	if group.IsBound() && len(added)+len(removed) > 0 {
+		affected := append(append([]uuid.UUID{}, added...), removed...)
+		if err := s.syncWorkspaceMemberships(ctx, &token.CreatedBy, affected, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &token.CreatedBy, domain.ActionSCIMGroupUpdate,
+		fmt.Sprintf("%s /scim/v2/Groups/%s", method, group.ID),
+		map[string]interface{}{
+			"token_id":        token.ID.String(),
+			"group_id":        group.ID.String(),
+			"display_name":    group.DisplayName,
+			"members_added":   len(added),
+			"members_removed": len(removed),
+		},
+	)
+
+	return s.GetGroup(ctx, group.ID.String())
+}
+
+// syncWorkspaceMemberships: 워크스페이스에 연결된 SCIM 그룹을 기준으로 사용자의 워크스페이스 역할을 동기화합니다
+// 연결된 그룹이 있는 워크스페이스(및 추가로 전달된 워크스페이스)만 관리하며, 소유자와 비활성 워크스페이스는 건너뜁니다
+func (s *Service) syncWorkspaceMemberships(ctx context.Context, actorID *uuid.UUID, userIDs []uuid.UUID, extraManaged map[string]bool) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	syncErr := domain.NewDomainError(domain.ErrCodeInternalError, "failed to sync workspace memberships", 500)
+
+	boundGroups, err := s.scimRepo.ListBoundGroups()
+	if err != nil {
+		return syncErr
+	}
+
+	managed := make(map[string]bool, len(extraManaged))
+	for workspaceID := range extraManaged {
+		managed[workspaceID] = true
+	}
+	desired := make(map[uuid.UUID]map[string]string, len(userIDs))
+	for _, userID := range userIDs {
+		desired[userID] = make(map[string]string)
+	}
+	for _, group := range boundGroups {
+		workspaceID := group.WorkspaceID.String()
+		managed[workspaceID] = true
+		for _, member := range group.Members {
+			roles, ok := desired[member.UserID]
+			if !ok {
+				continue
+			}
+			// admin wins over member when several groups target the same workspace
+			if roles[workspaceID] != "admin" {
+				roles[workspaceID] = group.WorkspaceRole
+			}
+		}
+	}
+
+	for workspaceID := range managed {
+		workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID)
+		if err != nil {
+			return syncErr
+		}
+		if workspace == nil || !workspace.Active {
+			continue
+		}
+
+		members, err := s.workspaceRepo.GetWorkspaceMembersWithRoles(ctx, workspaceID)
+		if err != nil {
+			return syncErr
+		}
+		currentRoles := make(map[string]string, len(members))
+		for _, member := range members {
+			currentRoles[member.UserID] = member.Role
+		}
+
+		for _, userID := range userIDs {
+			id := userID.String()
+			if workspace.OwnerID == id {
+				continue
+			}
+
+			currentRole := currentRoles[id]
+			desiredRole := desired[userID][workspaceID]
+			if currentRole == desiredRole {
+				continue
+			}
+			if currentRole != "" {
+				if err := s.workspaceRepo.RemoveUserFromWorkspace(ctx, id, workspaceID); err != nil {
+					return syncErr
+				}
+			}
+			if desiredRole != "" {
+				if err := s.workspaceRepo.AddUserToWorkspace(ctx, id, workspaceID, desiredRole); err != nil {
+					return syncErr
+				}
+			}
+
+			common.LogAction(ctx, s.auditLogRepo, actorID, domain.ActionSCIMMembershipSync,
+				fmt.Sprintf("PUT /api/v1/workspaces/%s/members/%s", workspaceID, id),
+				map[string]interface{}{
+					"user_id":      id,
+					"workspace_id": workspaceID,
+					"from":         currentRole,
+					"to":           desiredRole,
+				},
+			)
+		}
+	}
+
+	return nil
+}
+
+// ensureUniqueDisplayName: 같은 표시 이름의 다른 그룹이 없는지 확인합니다
+func (s *Service) ensureUniqueDisplayName(displayName string, groupID uuid.UUID) error {
+	existing, err := s.scimRepo.GetGroupByDisplayName(displayName)
+	if err != nil {
+		return domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to check existing group: %v", err), 500)
+	}
+	if existing != nil && existing.ID != groupID {
+		return domain.NewSCIMError(domain.SCIMErrorUniqueness, "a group with this displayName already exists", 409)
+	}
+	return nil
+}
+
+// resolveMembers: 멤버 ID 목록을 검증하고 존재하는 사용자 ID로 변환합니다
+func (s *Service) resolveMembers(members []string) ([]uuid.UUID, error) {
+	seen := make(map[uuid.UUID]bool, len(members))
+	ids := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		id, err := uuid.Parse(strings.TrimSpace(member))
+		if err != nil {
+			return nil, domain.NewSCIMError(domain.SCIMErrorInvalidValue, fmt.Sprintf("invalid member id: %s", member), 400)
+		}
+		if seen[id] {
+			continue
+		}
+		user, err := s.userService.GetUserByID(id)
+		if err != nil || user == nil {
+			return nil, domain.NewSCIMError(domain.SCIMErrorInvalidValue, fmt.Sprintf("member %s does not exist", member), 400)
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// pagination: SCIM startIndex(1부터 시작)와 count를 offset/limit으로 변환합니다
+func pagination(startIndex, count int) (int, int) {
+	if startIndex < 1 {
+		startIndex = 1
+	}
+	if count < 0 {
+		count = DefaultPageSize
+	}
+	if count > MaxPageSize {
+		count = MaxPageSize
+	}
+	return startIndex - 1, count
+}
+
+// diffMembers: 이전/이후 멤버 목록에서 추가/제거된 사용자를 계산합니다
+func diffMembers(previous, current []uuid.UUID) ([]uuid.UUID, []uuid.UUID) {
+	before := make(map[uuid.UUID]bool, len(previous))
+	for _, id := range previous {
+		before[id] = true
+	}
+	after := make(map[uuid.UUID]bool, len(current))
+	for _, id := range current {
+		after[id] = true
+	}
+
+	var added, removed []uuid.UUID
+	for _, id := range current {
+		if !before[id] {
+			added = append(added, id)
+		}
+	}
+	for _, id := range previous {
+		if !after[id] {
+			removed = append(removed, id)
+		}
+	}
+	return added, removed
+}
+
+// toSCIMError: 사용자 서비스 오류를 SCIM 오류 타입으로 변환합니다
+func toSCIMError(err error) error {
+	var domainErr *domain.DomainError
+	if !errors.As(err, &domainErr) {
+		return domain.NewDomainError(domain.ErrCodeInternalError, err.Error(), 500)
+	}
+	switch domainErr.StatusCode {
+	case 409:
+		return domain.NewSCIMError(domain.SCIMErrorUniqueness, domainErr.Message, 409)
+	case 400:
+		return domain.NewSCIMError(domain.SCIMErrorInvalidValue, domainErr.Message, 400)
+	case 404:
+		return domain.NewSCIMError(domain.SCIMErrorNoTarget, domainErr.Message, 404)
+	}
+	return domainErr
+}
+
+// wrapRepositoryError: 저장소 오류 중 도메인 오류는 그대로, 나머지는 내부 오류로 반환합니다
+func wrapRepositoryError(err error, message string) error {
+	var domainErr *domain.DomainError
+	if errors.As(err, &domainErr) {
+		return domainErr
+	}
+	return domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("%s: %v", message, err), 500)
+}
+
+// hashToken: SCIM 토큰의 SHA-256 해시를 반환합니다
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// randomPassword: 로컬 로그인에 사용되지 않는 임의 비밀번호를 생성합니다
+func randomPassword() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
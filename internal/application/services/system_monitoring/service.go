@@ -6,6 +6,7 @@ import (
 	"time"
 
 	serviceconstants "skyclust/internal/application/services"
+	"skyclust/internal/domain"
 	"skyclust/pkg/cache"
 	"skyclust/pkg/config"
 
@@ -19,6 +20,7 @@ type Service struct {
 	logger       *zap.Logger
 	config       *config.Config
 	cache        cache.Cache
+	outboxRepo   domain.OutboxRepository
 	startTime    time.Time
 	requestCount int64
 	errorCount   int64
@@ -29,11 +31,13 @@ func NewService(
 	logger *zap.Logger,
 	config *config.Config,
 	cache cache.Cache,
+	outboxRepo domain.OutboxRepository,
 ) *Service {
 	return &Service{
 		logger:       logger,
 		config:       config,
 		cache:        cache,
+		outboxRepo:   outboxRepo,
 		startTime:    time.Now(),
 		requestCount: 0,
 		errorCount:   0,
@@ -93,10 +97,14 @@ func (s *Service) GetHealthStatus() gin.H {
 
 // GetSystemMetrics: 시스템 성능 메트릭을 반환합니다
 func (s *Service) GetSystemMetrics() gin.H {
-	return gin.H{
+	metrics := gin.H{
 		"memory_usage": s.getMemoryMetrics(),
 		"performance":  s.getPerformanceMetrics(),
 	}
+	if s.outboxRepo != nil {
+		metrics["outbox"] = s.getOutboxMetrics()
+	}
+	return metrics
 }
 
 // GetAlerts: 현재 알림 상태를 반환합니다
@@ -343,6 +351,29 @@ func (s *Service) getPerformanceMetrics() gin.H {
 	}
 }
 
+// getOutboxMetrics: 발행 대기 중인 outbox 이벤트 지연과 실패 수를 반환합니다
+func (s *Service) getOutboxMetrics() gin.H {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	stats, err := s.outboxRepo.GetStats(ctx)
+	if err != nil {
+		s.logger.Warn("Failed to get outbox stats", zap.Error(err))
+		return gin.H{"error": "failed to get outbox stats"}
+	}
+
+	metrics := gin.H{
+		"pending":     stats.Pending,
+		"processing":  stats.Processing,
+		"failed":      stats.Failed,
+		"lag_seconds": stats.LagSeconds,
+	}
+	if stats.OldestPendingAt != nil {
+		metrics["oldest_pending_at"] = stats.OldestPendingAt.Format(time.RFC3339)
+	}
+	return metrics
+}
+
 // calculateErrorRate: 에러율을 계산합니다
 func (s *Service) calculateErrorRate() float64 {
 	if s.requestCount == 0 {
@@ -380,6 +411,7 @@ func (s *Service) collectAllAlerts(services, dependencies, metrics gin.H) []gin.
 	alerts = append(alerts, s.checkDependencyAlerts(dependencies)...)
 	alerts = append(alerts, s.checkPerformanceAlerts(metrics)...)
 	alerts = append(alerts, s.checkMemoryAlerts(metrics)...)
+	alerts = append(alerts, s.checkOutboxAlerts(metrics)...)
 
 	return alerts
 }
@@ -435,6 +467,38 @@ func (s *Service) checkPerformanceAlerts(metrics gin.H) []gin.H {
 	}}
 }
 
+// checkOutboxAlerts: outbox 발행 지연과 실패 알림을 확인합니다
+func (s *Service) checkOutboxAlerts(metrics gin.H) []gin.H {
+	metricsMap, ok := metrics["outbox"].(gin.H)
+	if !ok {
+		return nil
+	}
+
+	var alerts []gin.H
+
+	if lag, ok := metricsMap["lag_seconds"].(float64); ok && lag > serviceconstants.OutboxLagWarningSeconds {
+		alerts = append(alerts, gin.H{
+			"type":    "outbox",
+			"level":   "warning",
+			"message": "Outbox events are not being published",
+			"value":   lag,
+			"metric":  "lag_seconds",
+		})
+	}
+
+	if failed, ok := metricsMap["failed"].(int64); ok && failed > 0 {
+		alerts = append(alerts, gin.H{
+			"type":    "outbox",
+			"level":   "warning",
+			"message": "Outbox has failed events awaiting requeue",
+			"value":   failed,
+			"metric":  "failed",
+		})
+	}
+
+	return alerts
+}
+
 // checkMemoryAlerts: 메모리 알림을 확인합니다
 func (s *Service) checkMemoryAlerts(metrics gin.H) []gin.H {
 	metricsMap, ok := metrics["memory_usage"].(gin.H)
@@ -528,11 +592,12 @@ func (s *Service) checkStackMemoryAlert(metricsMap gin.H) gin.H {
 // getAlertThresholds: 알림 임계값을 반환합니다
 func (s *Service) getAlertThresholds() gin.H {
 	return gin.H{
-		"error_rate":       serviceconstants.HighErrorRateThreshold,
-		"memory_mb":        serviceconstants.MemoryWarningThreshold,
-		"system_memory_mb": serviceconstants.MemoryWarningThreshold * 2,
-		"stack_memory_mb":  50.0,
-		"uptime_hours":     24.0,
+		"error_rate":         serviceconstants.HighErrorRateThreshold,
+		"memory_mb":          serviceconstants.MemoryWarningThreshold,
+		"system_memory_mb":   serviceconstants.MemoryWarningThreshold * 2,
+		"stack_memory_mb":    50.0,
+		"uptime_hours":       24.0,
+		"outbox_lag_seconds": serviceconstants.OutboxLagWarningSeconds,
 	}
 }
 
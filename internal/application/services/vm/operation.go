@@ -0,0 +1,81 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"skyclust/internal/domain"
+
+	"go.uber.org/zap"
+)
+
+// operationTargetStatus: 작업 종류별로 완료를 의미하는 VM 상태
+var operationTargetStatus = map[string]domain.VMStatus{
+	domain.OperationVMStart:   domain.VMStatusRunning,
+	domain.OperationVMStop:    domain.VMStatusStopped,
+	domain.OperationVMRestart: domain.VMStatusRunning,
+}
+
+// startOperation: VM 상태 변경 작업을 등록합니다
+// 클라우드 요청은 이미 수락되었으므로 등록에 실패해도 요청을 실패로 처리하지 않습니다
+func (s *Service) startOperation(ctx context.Context, vm *domain.VM, operationType string) {
+	if s.operationService == nil {
+		return
+	}
+	_, err := s.operationService.Start(ctx, &domain.Operation{
+		WorkspaceID: vm.WorkspaceID,
+		Provider:    vm.Provider,
+		Region:      vm.Region,
+		Type:        operationType,
+		TargetType:  domain.OperationTargetVM,
+		TargetID:    vm.ID,
+		TargetName:  vm.Name,
+		Details:     domain.JSONBMap{"instance_id": vm.InstanceID},
+	})
+	if err != nil {
+		s.logger.Warn("Failed to record VM operation",
+			zap.String("type", operationType),
+			zap.String("vm_id", vm.ID),
+			zap.Error(err))
+	}
+}
+
+// TrackOperation: 컴퓨트 인스턴스 상태로 VM 시작/중지 진행 상황을 판단합니다 (domain.OperationTracker 구현)
+// 목표 상태에 도달하면 저장된 VM 상태도 갱신합니다
+func (s *Service) TrackOperation(ctx context.Context, operation *domain.Operation) (*domain.OperationProgress, error) {
+	target, ok := operationTargetStatus[operation.Type]
+	if !ok {
+		return nil, fmt.Errorf("unsupported VM operation: %s", operation.Type)
+	}
+	instanceID, _ := operation.Details["instance_id"].(string)
+	if instanceID == "" {
+		return nil, fmt.Errorf("VM operation has no instance id")
+	}
+
+	status, err := s.computeService.GetInstanceStatus(ctx, operation.Provider, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &domain.OperationProgress{
+		Status:         domain.OperationRunning,
+		ProviderStatus: status,
+		Percent:        operation.EstimatedPercent(time.Now()),
+	}
+	switch domain.VMStatus(status) {
+	case target:
+		progress.Status = domain.OperationSucceeded
+		if err := s.vmRepo.UpdateStatus(ctx, operation.TargetID, target); err != nil {
+			s.logger.Warn("Failed to update VM status", zap.Error(err), zap.String("vm_id", operation.TargetID))
+		}
+		if s.invalidator != nil {
+			_ = s.invalidator.InvalidateVMList(ctx, operation.WorkspaceID)
+			_ = s.invalidator.InvalidateByKey(ctx, s.keyBuilder.BuildVMItemKey(operation.TargetID))
+		}
+	case domain.VMStatusError, domain.VMStatusTerminated:
+		progress.Status = domain.OperationFailed
+		progress.Error = fmt.Sprintf("instance is %s", status)
+	}
+	return progress, nil
+}
@@ -15,15 +15,17 @@ import (
 
 // Service: domain.VMService 인터페이스 구현체
 type Service struct {
-	vmRepo         domain.VMRepository
-	workspaceRepo  domain.WorkspaceRepository
-	computeService computeservice.ComputeService
-	eventService   domain.EventService
-	auditLogRepo   domain.AuditLogRepository
-	cache          cache.Cache
-	keyBuilder     *cache.CacheKeyBuilder
-	invalidator    *cache.Invalidator
-	logger         *zap.Logger
+	vmRepo           domain.VMRepository
+	workspaceRepo    domain.WorkspaceRepository
+	computeService   computeservice.ComputeService
+	eventService     domain.EventService
+	auditLogRepo     domain.AuditLogRepository
+	policyEnforcer   domain.PolicyEnforcer
+	cache            cache.Cache
+	keyBuilder       *cache.CacheKeyBuilder
+	invalidator      *cache.Invalidator
+	operationService domain.OperationService // nil이면 시작/중지 진행 상황을 추적하지 않음
+	logger           *zap.Logger
 }
 
 // NewService: 새로운 VMService를 생성합니다
@@ -33,21 +35,25 @@ func NewService(
 	computeService computeservice.ComputeService,
 	eventService domain.EventService,
 	auditLogRepo domain.AuditLogRepository,
+	policyEnforcer domain.PolicyEnforcer,
 	cache cache.Cache,
 	keyBuilder *cache.CacheKeyBuilder,
 	invalidator *cache.Invalidator,
+	operationService domain.OperationService,
 	logger *zap.Logger,
 ) *Service {
 	return &Service{
-		vmRepo:         vmRepo,
-		workspaceRepo:  workspaceRepo,
-		computeService: computeService,
-		eventService:   eventService,
-		auditLogRepo:   auditLogRepo,
-		cache:          cache,
-		keyBuilder:     keyBuilder,
-		invalidator:    invalidator,
-		logger:         logger,
+		vmRepo:           vmRepo,
+		workspaceRepo:    workspaceRepo,
+		computeService:   computeService,
+		eventService:     eventService,
+		auditLogRepo:     auditLogRepo,
+		policyEnforcer:   policyEnforcer,
+		cache:            cache,
+		keyBuilder:       keyBuilder,
+		invalidator:      invalidator,
+		operationService: operationService,
+		logger:           logger,
 	}
 }
 
@@ -67,6 +73,11 @@ func (s *Service) CreateVM(ctx context.Context, req domain.CreateVMRequest) (*do
 		return nil, domain.ErrWorkspaceNotFound
 	}
 
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := s.enforcePolicy(ctx, req.WorkspaceID, domain.ComputeWrite, domain.PolicyResource{Name: req.Name, Provider: req.Provider, Region: req.Region, Attributes: map[string]string{"instance_type": req.Type}}); err != nil {
+		return nil, err
+	}
+
 	// Check if VM name already exists in workspace
 	existingVMs, err := s.vmRepo.GetByWorkspaceID(ctx, req.WorkspaceID)
 	if err != nil {
@@ -220,6 +231,11 @@ func (s *Service) UpdateVM(ctx context.Context, id string, req domain.UpdateVMRe
 		return nil, domain.ErrVMNotFound
 	}
 
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := s.enforcePolicy(ctx, vm.WorkspaceID, domain.ComputeWrite, vmPolicyResource(vm, "update")); err != nil {
+		return nil, err
+	}
+
 	// Update fields
 	if req.Name != nil {
 		vm.Name = *req.Name
@@ -292,6 +308,11 @@ func (s *Service) DeleteVM(ctx context.Context, id string) error {
 		return domain.ErrVMNotFound
 	}
 
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := s.enforcePolicy(ctx, vm.WorkspaceID, domain.ComputeDelete, vmPolicyResource(vm, "delete")); err != nil {
+		return err
+	}
+
 	// Delete compute instance
 	if err := s.computeService.DeleteInstance(ctx, vm.Provider, vm.InstanceID); err != nil {
 		s.logger.Error("Failed to delete compute instance",
@@ -398,6 +419,11 @@ func (s *Service) StartVM(ctx context.Context, id string) error {
 		return domain.ErrVMNotFound
 	}
 
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := s.enforcePolicy(ctx, vm.WorkspaceID, domain.ComputeWrite, vmPolicyResource(vm, "start")); err != nil {
+		return err
+	}
+
 	if vm.Status == domain.VMStatusRunning {
 		return domain.NewDomainError(domain.ErrCodeConflict, "VM is already running", 409)
 	}
@@ -410,6 +436,7 @@ func (s *Service) StartVM(ctx context.Context, id string) error {
 	if err := s.vmRepo.UpdateStatus(ctx, id, domain.VMStatusStarting); err != nil {
 		s.logger.Error("Failed to update VM status", zap.Error(err), zap.String("vm_id", id))
 	}
+	s.startOperation(ctx, vm, domain.OperationVMStart)
 
 	// 캐시 무효화
 	if s.invalidator != nil {
@@ -464,6 +491,11 @@ func (s *Service) StopVM(ctx context.Context, id string) error {
 		return domain.ErrVMNotFound
 	}
 
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := s.enforcePolicy(ctx, vm.WorkspaceID, domain.ComputeWrite, vmPolicyResource(vm, "stop")); err != nil {
+		return err
+	}
+
 	if vm.Status == domain.VMStatusStopped {
 		return domain.NewDomainError(domain.ErrCodeConflict, "VM is already stopped", 409)
 	}
@@ -476,6 +508,7 @@ func (s *Service) StopVM(ctx context.Context, id string) error {
 	if err := s.vmRepo.UpdateStatus(ctx, id, domain.VMStatusStopping); err != nil {
 		s.logger.Error("Failed to update VM status", zap.Error(err), zap.String("vm_id", id))
 	}
+	s.startOperation(ctx, vm, domain.OperationVMStop)
 
 	// 캐시 무효화
 	if s.invalidator != nil {
@@ -530,6 +563,11 @@ func (s *Service) RestartVM(ctx context.Context, id string) error {
 		return domain.ErrVMNotFound
 	}
 
+	// 변경 작업 전 워크스페이스 정책 평가
+	if err := s.enforcePolicy(ctx, vm.WorkspaceID, domain.ComputeWrite, vmPolicyResource(vm, "restart")); err != nil {
+		return err
+	}
+
 	// Stop first
 	if err := s.computeService.StopInstance(ctx, vm.Provider, vm.InstanceID); err != nil {
 		return domain.NewDomainError(domain.ErrCodeProviderError, fmt.Sprintf("failed to stop compute instance: %v", err), 502)
@@ -547,6 +585,7 @@ func (s *Service) RestartVM(ctx context.Context, id string) error {
 	if err := s.vmRepo.UpdateStatus(ctx, id, domain.VMStatusStarting); err != nil {
 		s.logger.Error("Failed to update VM status", zap.Error(err), zap.String("vm_id", id))
 	}
+	s.startOperation(ctx, vm, domain.OperationVMRestart)
 
 	// 캐시 무효화
 	if s.invalidator != nil {
@@ -621,3 +660,29 @@ func (s *Service) GetVMStatus(ctx context.Context, id string) (domain.VMStatus,
 
 	return newStatus, nil
 }
+
+// enforcePolicy: 변경 작업 전에 워크스페이스 정책을 평가합니다
+func (s *Service) enforcePolicy(ctx context.Context, workspaceID string, action domain.Permission, resource domain.PolicyResource) error {
+	if s.policyEnforcer == nil {
+		return nil
+	}
+	resource.Type = "vm"
+	return s.policyEnforcer.Enforce(ctx, domain.PolicyRequest{
+		WorkspaceID: workspaceID,
+		Action:      action,
+		Resource:    resource,
+	})
+}
+
+// vmPolicyResource: VM을 정책 평가 리소스 속성으로 변환합니다
+func vmPolicyResource(vm *domain.VM, operation string) domain.PolicyResource {
+	return domain.PolicyResource{
+		Name:     vm.Name,
+		Provider: vm.Provider,
+		Region:   vm.Region,
+		Attributes: map[string]string{
+			"id":        vm.ID,
+			"operation": operation,
+		},
+	}
+}
@@ -0,0 +1,212 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+	"skyclust/pkg/security"
+)
+
+const (
+	// deliveryTimeout: 요청 하나의 제한 시간
+	deliveryTimeout = 10 * time.Second
+	// deliveryLease: 전송 중인 기록의 임대 기간 (워커 중단 시 이후 다시 전송)
+	deliveryLease = time.Minute
+	// deliveryConcurrency: 동시에 전송하는 최대 요청 수
+	deliveryConcurrency = 5
+	// maxResponseBody: 전송 기록에 저장하는 응답 본문 최대 크기
+	maxResponseBody = 4 << 10
+	// maxRetryAfter: Retry-After 헤더로 미룰 수 있는 최대 기간
+	maxRetryAfter = time.Hour
+	userAgent     = "SkyClust-Webhooks/1.0"
+)
+
+// newHTTPClient: 리다이렉트를 따르지 않고 공인 주소에만 연결하는 웹훅 HTTP 클라이언트를 생성합니다
+// 연결 시점에 해석된 주소를 확인하므로 등록 후 DNS가 내부 주소로 바뀌어도 전송하지 않습니다
+func newHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout:   deliveryTimeout,
+		Transport: security.NewPublicTransport(deliveryTimeout),
+		// 리다이렉트는 서명 대상이 바뀌므로 실패로 처리
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// DeliverDue: 재시도 시각이 된 전송을 임대하여 전송하고 시도한 수를 반환합니다
+func (s *Service) DeliverDue(ctx context.Context, limit int) (int, error) {
+	deliveries, err := s.webhookRepo.ClaimDueDeliveries(ctx, limit, deliveryLease)
+	if err != nil {
+		return 0, err
+	}
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, deliveryConcurrency)
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(delivery *domain.WebhookDelivery) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			s.deliver(ctx, delivery)
+		}(delivery)
+	}
+	wg.Wait()
+
+	return len(deliveries), nil
+}
+
+// deliver: 전송 하나를 시도하고 결과를 기록합니다
+func (s *Service) deliver(ctx context.Context, delivery *domain.WebhookDelivery) {
+	subscription, err := s.webhookRepo.GetSubscription(ctx, delivery.SubscriptionID)
+	if err != nil {
+		// 임대가 끝나면 다시 시도
+		logger.Warn(fmt.Sprintf("Failed to load webhook %s for delivery %s: %v", delivery.SubscriptionID, delivery.ID, err))
+		return
+	}
+	if subscription == nil || !subscription.Enabled {
+		delivery.Status = domain.WebhookDeliveryFailed
+		delivery.Error = "webhook is disabled"
+		if subscription == nil {
+			delivery.Error = "webhook was deleted"
+		}
+		s.saveDelivery(ctx, delivery)
+		return
+	}
+
+	attemptedAt := time.Now()
+	retryAfter, err := s.send(ctx, subscription, delivery)
+	delivery.Attempts++
+	delivery.DurationMs = time.Since(attemptedAt).Milliseconds()
+
+	succeeded := err == nil
+	if succeeded {
+		deliveredAt := time.Now()
+		delivery.Status = domain.WebhookDeliverySucceeded
+		delivery.Error = ""
+		delivery.DeliveredAt = &deliveredAt
+	} else {
+		delivery.Error = err.Error()
+		if delivery.Attempts >= domain.MaxWebhookAttempts {
+			delivery.Status = domain.WebhookDeliveryFailed
+		} else {
+			delay := domain.WebhookRetryDelay(delivery.Attempts)
+			if retryAfter > delay {
+				delay = retryAfter
+			}
+			delivery.NextAttemptAt = time.Now().Add(delay)
+		}
+	}
+	s.saveDelivery(ctx, delivery)
+
+	updated, disabled, err := s.webhookRepo.RecordAttempt(ctx, subscription.ID, succeeded, attemptedAt)
+	if err != nil {
+		logger.Warn(fmt.Sprintf("Failed to record webhook attempt for %s: %v", subscription.ID, err))
+		return
+	}
+	if disabled {
+		s.notifyDisabled(ctx, updated)
+	}
+}
+
+// send: 서명한 페이로드를 전송하고 응답 코드와 본문을 전송 기록에 남깁니다
+// 실패 시 응답의 Retry-After 기간을 함께 반환합니다
+func (s *Service) send(ctx context.Context, subscription *domain.WebhookSubscription, delivery *domain.WebhookDelivery) (time.Duration, error) {
+	delivery.ResponseCode = 0
+	delivery.ResponseBody = ""
+
+	body, err := json.Marshal(delivery.Payload)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(domain.WebhookEventHeader, delivery.EventType)
+	req.Header.Set(domain.WebhookDeliveryHeader, delivery.ID.String())
+	req.Header.Set(domain.WebhookTimestampHeader, timestamp)
+	req.Header.Set(domain.WebhookSignatureHeader, "sha256="+sign(subscription.Secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			// 오류 메시지에 전체 URL(쿼리 포함)이 들어가지 않도록 제거
+			err = urlErr.Err
+		}
+		return 0, fmt.Errorf("failed to deliver webhook to %s: %w", redactURL(subscription.URL), err)
+	}
+	defer resp.Body.Close()
+
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+	delivery.ResponseCode = resp.StatusCode
+	delivery.ResponseBody = strings.ToValidUTF8(string(data), "")
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return parseRetryAfter(resp.Header.Get("Retry-After")), fmt.Errorf("webhook endpoint returned status %d", resp.StatusCode)
+	}
+	return 0, nil
+}
+
+// saveDelivery: 전송 기록을 저장합니다
+func (s *Service) saveDelivery(ctx context.Context, delivery *domain.WebhookDelivery) {
+	if err := s.webhookRepo.UpdateDelivery(ctx, delivery); err != nil {
+		logger.Warn(fmt.Sprintf("Failed to save webhook delivery %s: %v", delivery.ID, err))
+	}
+}
+
+// sign: 타임스탬프와 본문에 대한 HMAC-SHA256 서명을 계산합니다
+func sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// parseRetryAfter: 초 단위 Retry-After 헤더를 파싱합니다
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	delay := time.Duration(seconds) * time.Second
+	if delay > maxRetryAfter {
+		delay = maxRetryAfter
+	}
+	return delay
+}
+
+// redactURL: 자격 증명이 포함될 수 있는 사용자 정보와 쿼리를 제거합니다
+func redactURL(raw string) string {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	parsed.User = nil
+	parsed.RawQuery = ""
+	parsed.Fragment = ""
+	return parsed.String()
+}
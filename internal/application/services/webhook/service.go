@@ -0,0 +1,465 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"skyclust/internal/application/services/common"
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// Service: domain.WebhookService 인터페이스 구현체
+// 워크스페이스 이벤트를 일치하는 웹훅 구독의 전송 기록으로 저장하고, 서명하여 재시도와 함께 전송합니다
+type Service struct {
+	webhookRepo         domain.WebhookRepository
+	workspaceRepo       domain.WorkspaceRepository
+	auditLogRepo        domain.AuditLogRepository
+	notificationService domain.NotificationService
+	client              *http.Client
+	workspaceResolver   *common.EventWorkspaceResolver
+}
+
+// NewService: 새로운 웹훅 서비스를 생성합니다
+func NewService(
+	webhookRepo domain.WebhookRepository,
+	workspaceRepo domain.WorkspaceRepository,
+	credentialRepo domain.CredentialRepository,
+	auditLogRepo domain.AuditLogRepository,
+	notificationService domain.NotificationService,
+) *Service {
+	return &Service{
+		webhookRepo:         webhookRepo,
+		workspaceRepo:       workspaceRepo,
+		auditLogRepo:        auditLogRepo,
+		notificationService: notificationService,
+		client:              newHTTPClient(),
+		workspaceResolver:   common.NewEventWorkspaceResolver(credentialRepo),
+	}
+}
+
+// ListWebhooks: 워크스페이스의 웹훅 구독 목록을 조회합니다
+func (s *Service) ListWebhooks(ctx context.Context, workspaceID uuid.UUID) ([]*domain.WebhookSubscription, error) {
+	workspace, err := s.getWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions, err := s.webhookRepo.ListSubscriptions(ctx, workspace.ID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to list webhooks: %v", err), 500)
+	}
+	return subscriptions, nil
+}
+
+// GetWebhook: 워크스페이스의 웹훅 구독을 조회합니다
+func (s *Service) GetWebhook(ctx context.Context, workspaceID, webhookID uuid.UUID) (*domain.WebhookSubscription, error) {
+	subscription, err := s.webhookRepo.GetSubscription(ctx, webhookID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get webhook: %v", err), 500)
+	}
+	if subscription == nil || subscription.WorkspaceID != workspaceID.String() {
+		return nil, domain.NewDomainError(domain.ErrCodeNotFound, "webhook not found", 404)
+	}
+	return subscription, nil
+}
+
+// CreateWebhook: 웹훅 구독을 생성하고 서명 비밀키를 한 번만 반환합니다
+func (s *Service) CreateWebhook(ctx context.Context, actorID, workspaceID uuid.UUID, req domain.CreateWebhookRequest) (*domain.WebhookWithSecret, error) {
+	workspace, err := s.getWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		if secret, err = generateSecret(); err != nil {
+			return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to generate webhook secret: %v", err), 500)
+		}
+	}
+
+	subscription := &domain.WebhookSubscription{
+		WorkspaceID: workspace.ID,
+		Name:        strings.TrimSpace(req.Name),
+		URL:         strings.TrimSpace(req.URL),
+		EventTypes:  domain.StringList(normalizeEventTypes(req.EventTypes)),
+		Secret:      secret,
+		Enabled:     true,
+		CreatedBy:   &actorID,
+	}
+	if req.Enabled != nil {
+		subscription.Enabled = *req.Enabled
+	}
+	if err := subscription.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.webhookRepo.CreateSubscription(ctx, subscription); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to create webhook: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionWebhookCreate,
+		fmt.Sprintf("POST /api/v1/workspaces/%s/webhooks", workspace.ID),
+		webhookAuditDetails(subscription),
+	)
+
+	return &domain.WebhookWithSecret{WebhookSubscription: subscription, Secret: secret}, nil
+}
+
+// UpdateWebhook: 웹훅 구독을 수정합니다
+func (s *Service) UpdateWebhook(ctx context.Context, actorID, workspaceID, webhookID uuid.UUID, req domain.UpdateWebhookRequest) (*domain.WebhookSubscription, error) {
+	subscription, err := s.GetWebhook(ctx, workspaceID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		subscription.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.URL != nil {
+		subscription.URL = strings.TrimSpace(*req.URL)
+	}
+	if req.EventTypes != nil {
+		subscription.EventTypes = domain.StringList(normalizeEventTypes(req.EventTypes))
+	}
+	if req.Secret != nil {
+		subscription.Secret = *req.Secret
+	}
+	if req.Enabled != nil {
+		if *req.Enabled {
+			subscription.Enable()
+		} else {
+			subscription.Enabled = false
+		}
+	}
+	if err := subscription.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.webhookRepo.UpdateSubscription(ctx, subscription); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to update webhook: %v", err), 500)
+	}
+
+	details := webhookAuditDetails(subscription)
+	details["secret_rotated"] = req.Secret != nil
+	common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionWebhookUpdate,
+		fmt.Sprintf("PUT /api/v1/workspaces/%s/webhooks/%s", subscription.WorkspaceID, subscription.ID),
+		details,
+	)
+
+	return subscription, nil
+}
+
+// DeleteWebhook: 웹훅 구독과 전송 기록을 삭제합니다
+func (s *Service) DeleteWebhook(ctx context.Context, actorID, workspaceID, webhookID uuid.UUID) error {
+	subscription, err := s.GetWebhook(ctx, workspaceID, webhookID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.webhookRepo.DeleteSubscription(ctx, subscription.ID); err != nil {
+		return domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to delete webhook: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionWebhookDelete,
+		fmt.Sprintf("DELETE /api/v1/workspaces/%s/webhooks/%s", subscription.WorkspaceID, subscription.ID),
+		webhookAuditDetails(subscription),
+	)
+
+	return nil
+}
+
+// ListDeliveries: 웹훅 구독의 전송 기록을 조회합니다
+func (s *Service) ListDeliveries(ctx context.Context, workspaceID, webhookID uuid.UUID, filter domain.WebhookDeliveryFilter) ([]*domain.WebhookDelivery, int64, error) {
+	subscription, err := s.GetWebhook(ctx, workspaceID, webhookID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if filter.Status != "" && filter.Status != domain.WebhookDeliveryPending &&
+		filter.Status != domain.WebhookDeliverySucceeded && filter.Status != domain.WebhookDeliveryFailed {
+		return nil, 0, domain.NewDomainError(domain.ErrCodeValidationFailed, "status must be pending, succeeded or failed", 400)
+	}
+
+	deliveries, total, err := s.webhookRepo.ListDeliveries(ctx, subscription.ID, filter)
+	if err != nil {
+		return nil, 0, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to list webhook deliveries: %v", err), 500)
+	}
+	return deliveries, total, nil
+}
+
+// GetDelivery: 웹훅 전송 기록을 조회합니다
+func (s *Service) GetDelivery(ctx context.Context, workspaceID, webhookID, deliveryID uuid.UUID) (*domain.WebhookDelivery, error) {
+	subscription, err := s.GetWebhook(ctx, workspaceID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.webhookRepo.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get webhook delivery: %v", err), 500)
+	}
+	if delivery == nil || delivery.SubscriptionID != subscription.ID {
+		return nil, domain.NewDomainError(domain.ErrCodeNotFound, "webhook delivery not found", 404)
+	}
+	return delivery, nil
+}
+
+// Redeliver: 같은 이벤트(같은 이벤트 ID)를 새 전송으로 즉시 다시 보냅니다
+func (s *Service) Redeliver(ctx context.Context, actorID, workspaceID, webhookID, deliveryID uuid.UUID) (*domain.WebhookDelivery, error) {
+	original, err := s.GetDelivery(ctx, workspaceID, webhookID, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	subscription, err := s.GetWebhook(ctx, workspaceID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if !subscription.Enabled {
+		return nil, domain.NewDomainError(domain.ErrCodeConflict, "webhook is disabled; enable it before redelivering", 409)
+	}
+
+	delivery := &domain.WebhookDelivery{
+		ID:             uuid.New(),
+		SubscriptionID: original.SubscriptionID,
+		WorkspaceID:    original.WorkspaceID,
+		EventID:        original.EventID,
+		EventType:      original.EventType,
+		Payload:        original.Payload,
+		Status:         domain.WebhookDeliveryPending,
+		NextAttemptAt:  time.Now(),
+		RedeliveryOf:   &original.ID,
+	}
+	if err := s.webhookRepo.CreateDeliveries(ctx, []*domain.WebhookDelivery{delivery}); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to queue webhook redelivery: %v", err), 500)
+	}
+
+	common.LogAction(ctx, s.auditLogRepo, &actorID, domain.ActionWebhookRedeliver,
+		fmt.Sprintf("POST /api/v1/workspaces/%s/webhooks/%s/deliveries/%s/redeliver", subscription.WorkspaceID, subscription.ID, original.ID),
+		map[string]interface{}{
+			"workspace_id": subscription.WorkspaceID,
+			"webhook_id":   subscription.ID.String(),
+			"delivery_id":  delivery.ID.String(),
+			"original_id":  original.ID.String(),
+			"event_id":     original.EventID.String(),
+			"event_type":   original.EventType,
+		},
+	)
+
+	return delivery, nil
+}
+
+// Enqueue: 이벤트의 워크스페이스에서 이벤트 타입과 일치하는 활성 구독마다 전송 기록을 생성합니다
+func (s *Service) Enqueue(ctx context.Context, event domain.WebhookEvent) (int, error) {
+	workspaceID := s.workspaceResolver.Resolve(ctx, event.Type, event.WorkspaceID, event.Data)
+	if workspaceID == "" {
+		return 0, nil
+	}
+
+	subscriptions, err := s.webhookRepo.ListEnabledSubscriptions(ctx, workspaceID)
+	if err != nil {
+		return 0, err
+	}
+
+	var matched []*domain.WebhookSubscription
+	for _, subscription := range subscriptions {
+		if subscription.Matches(event.Type) {
+			matched = append(matched, subscription)
+		}
+	}
+	if len(matched) == 0 {
+		return 0, nil
+	}
+
+	eventID := uuid.New()
+	payload, err := webhookPayload(eventID, workspaceID, event)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	deliveries := make([]*domain.WebhookDelivery, 0, len(matched))
+	for _, subscription := range matched {
+		deliveries = append(deliveries, &domain.WebhookDelivery{
+			ID:             uuid.New(),
+			SubscriptionID: subscription.ID,
+			WorkspaceID:    workspaceID,
+			EventID:        eventID,
+			EventType:      event.Type,
+			Payload:        payload,
+			Status:         domain.WebhookDeliveryPending,
+			NextAttemptAt:  now,
+		})
+	}
+	if err := s.webhookRepo.CreateDeliveries(ctx, deliveries); err != nil {
+		return 0, err
+	}
+	return len(deliveries), nil
+}
+
+// Trigger: 구독의 이벤트 패턴과 관계없이 이벤트를 구독 하나로 즉시 전송하도록 등록합니다 (자동화 규칙에서 사용)
+func (s *Service) Trigger(ctx context.Context, workspaceID, webhookID uuid.UUID, event domain.WebhookEvent) (*domain.WebhookDelivery, error) {
+	subscription, err := s.GetWebhook(ctx, workspaceID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if !subscription.Enabled {
+		return nil, domain.NewDomainError(domain.ErrCodeConflict, "webhook is disabled", 409)
+	}
+
+	eventID := uuid.New()
+	payload, err := webhookPayload(eventID, subscription.WorkspaceID, event)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, err.Error(), 500)
+	}
+	delivery := &domain.WebhookDelivery{
+		ID:             uuid.New(),
+		SubscriptionID: subscription.ID,
+		WorkspaceID:    subscription.WorkspaceID,
+		EventID:        eventID,
+		EventType:      event.Type,
+		Payload:        payload,
+		Status:         domain.WebhookDeliveryPending,
+		NextAttemptAt:  time.Now(),
+	}
+	if err := s.webhookRepo.CreateDeliveries(ctx, []*domain.WebhookDelivery{delivery}); err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to queue webhook delivery: %v", err), 500)
+	}
+	return delivery, nil
+}
+
+// PurgeDeliveries: 보존 기간이 지난 완료된 전송 기록을 삭제합니다
+func (s *Service) PurgeDeliveries(ctx context.Context, retention time.Duration) (int64, error) {
+	return s.webhookRepo.DeleteDeliveriesBefore(ctx, time.Now().Add(-retention))
+}
+
+// getWorkspace: 워크스페이스를 조회합니다
+func (s *Service) getWorkspace(ctx context.Context, workspaceID uuid.UUID) (*domain.Workspace, error) {
+	workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID.String())
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get workspace: %v", err), 500)
+	}
+	if workspace == nil {
+		return nil, domain.ErrWorkspaceNotFound
+	}
+	return workspace, nil
+}
+
+// notifyDisabled: 자동 비활성화된 웹훅을 워크스페이스 소유자와 관리자에게 알립니다
+func (s *Service) notifyDisabled(ctx context.Context, subscription *domain.WebhookSubscription) {
+	logger.Warn(fmt.Sprintf("Webhook %s (%s) disabled: %s", subscription.ID, subscription.Name, subscription.DisabledReason))
+
+	if subscription.CreatedBy != nil {
+		common.LogAction(ctx, s.auditLogRepo, subscription.CreatedBy, domain.ActionWebhookDisabled,
+			fmt.Sprintf("WEBHOOK %s", subscription.ID),
+			webhookAuditDetails(subscription),
+		)
+	}
+
+	if s.notificationService == nil {
+		return
+	}
+	adminIDs, err := common.WorkspaceAdminIDs(ctx, s.workspaceRepo, subscription.WorkspaceID)
+	if err != nil {
+		logger.Warn(fmt.Sprintf("Failed to resolve workspace admins for webhook %s: %v", subscription.ID, err))
+		return
+	}
+	if len(adminIDs) == 0 {
+		return
+	}
+
+	data, _ := json.Marshal(map[string]interface{}{
+		"webhook_id":           subscription.ID,
+		"workspace_id":         subscription.WorkspaceID,
+		"consecutive_failures": subscription.ConsecutiveFailures,
+		"failing_since":        subscription.FailingSince,
+	})
+	notification := &domain.Notification{
+		ID:          uuid.New().String(),
+		WorkspaceID: subscription.WorkspaceID,
+		Type:        "error",
+		Title:       fmt.Sprintf("Webhook %q was disabled", subscription.Name),
+		Message:     fmt.Sprintf("Deliveries to %s have failed %d times in a row since %s. Fix the endpoint and re-enable the webhook.", subscription.URL, subscription.ConsecutiveFailures, subscription.FailingSince.UTC().Format(time.RFC3339)),
+		Category:    "system",
+		Priority:    "high",
+		Data:        string(data),
+		CreatedAt:   time.Now(),
+	}
+	if err := s.notificationService.SendBulkNotification(ctx, adminIDs, notification); err != nil {
+		logger.Warn(fmt.Sprintf("Failed to send webhook disabled notification for %s: %v", subscription.ID, err))
+	}
+}
+
+// normalizeEventTypes: 이벤트 패턴의 공백과 중복을 제거합니다
+func normalizeEventTypes(eventTypes []string) []string {
+	seen := make(map[string]bool, len(eventTypes))
+	normalized := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		eventType = strings.TrimSpace(eventType)
+		if seen[eventType] {
+			continue
+		}
+		seen[eventType] = true
+		normalized = append(normalized, eventType)
+	}
+	return normalized
+}
+
+// generateSecret: 서명 비밀키를 생성합니다
+func generateSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(buf), nil
+}
+
+// webhookPayload: 이벤트를 웹훅 요청 본문으로 변환합니다
+func webhookPayload(eventID uuid.UUID, workspaceID string, event domain.WebhookEvent) (domain.JSONBMap, error) {
+	createdAt := time.Now().UTC()
+	if event.Timestamp > 0 {
+		createdAt = time.Unix(event.Timestamp, 0).UTC()
+	}
+	payload, err := toJSONBMap(domain.WebhookPayload{
+		ID:          eventID.String(),
+		Type:        event.Type,
+		WorkspaceID: workspaceID,
+		CreatedAt:   createdAt,
+		Data:        event.Data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+	return payload, nil
+}
+
+// toJSONBMap: 구조체를 JSONB 맵으로 변환합니다
+func toJSONBMap(value interface{}) (domain.JSONBMap, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var result domain.JSONBMap
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// webhookAuditDetails: 감사 로그에 기록할 웹훅 정보 (비밀키 제외)
+func webhookAuditDetails(subscription *domain.WebhookSubscription) map[string]interface{} {
+	return map[string]interface{}{
+		"workspace_id": subscription.WorkspaceID,
+		"webhook_id":   subscription.ID.String(),
+		"name":         subscription.Name,
+		"url":          redactURL(subscription.URL),
+		"event_types":  []string(subscription.EventTypes),
+		"enabled":      subscription.Enabled,
+	}
+}
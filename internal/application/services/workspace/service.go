@@ -16,21 +16,23 @@ import (
 // Service: Workspace 서비스 인터페이스 구현체
 // 워크스페이스 관련 비즈니스 로직을 처리합니다
 type Service struct {
-	workspaceRepo  domain.WorkspaceRepository // 워크스페이스 저장소
-	userRepo       domain.UserRepository      // 사용자 저장소
-	eventService   domain.EventService        // 이벤트 서비스
-	auditLogRepo   domain.AuditLogRepository  // 감사 로그 저장소
-	eventPublisher *messaging.Publisher       // 이벤트 발행자
+	workspaceRepo  domain.WorkspaceRepository     // 워크스페이스 저장소
+	userRepo       domain.UserRepository          // 사용자 저장소
+	eventService   domain.EventService            // 이벤트 서비스
+	auditLogRepo   domain.AuditLogRepository      // 감사 로그 저장소
+	eventPublisher *messaging.Publisher           // 이벤트 발행자
+	roleRepo       domain.WorkspaceRoleRepository // 사용자 정의 워크스페이스 역할 저장소
 }
 
 // NewService: 새로운 Workspace 서비스 인스턴스를 생성합니다
-func NewService(workspaceRepo domain.WorkspaceRepository, userRepo domain.UserRepository, eventService domain.EventService, auditLogRepo domain.AuditLogRepository, eventPublisher *messaging.Publisher) *Service {
+func NewService(workspaceRepo domain.WorkspaceRepository, userRepo domain.UserRepository, eventService domain.EventService, auditLogRepo domain.AuditLogRepository, eventPublisher *messaging.Publisher, roleRepo domain.WorkspaceRoleRepository) *Service {
 	return &Service{
 		workspaceRepo:  workspaceRepo,
 		userRepo:       userRepo,
 		eventService:   eventService,
 		auditLogRepo:   auditLogRepo,
 		eventPublisher: eventPublisher,
+		roleRepo:       roleRepo,
 	}
 }
 
@@ -315,8 +317,8 @@ func (s *Service) AddMemberByEmail(ctx context.Context, workspaceID, email, role
 	}
 
 	// 역할 유효성 검사
-	if role != "admin" && role != "member" {
-		return domain.NewDomainError(domain.ErrCodeBadRequest, "invalid role. Must be 'admin' or 'member'", 400)
+	if err := s.validateRole(ctx, workspaceID, role); err != nil {
+		return err
 	}
 
 	return s.addUserToWorkspaceWithRole(ctx, workspaceID, user.ID.String(), role)
@@ -348,8 +350,8 @@ func (s *Service) addUserToWorkspaceWithRole(ctx context.Context, workspaceID, u
 	}
 
 	// 역할 유효성 검사
-	if role != "admin" && role != "member" {
-		return domain.NewDomainError(domain.ErrCodeBadRequest, "invalid role. Must be 'admin' or 'member'", 400)
+	if err := s.validateRole(ctx, workspaceID, role); err != nil {
+		return err
 	}
 
 	// 이미 멤버인지 확인
@@ -504,8 +506,8 @@ func (s *Service) UpdateMemberRole(ctx context.Context, workspaceID, userID, rol
 	}
 
 	// 역할 유효성 검사
-	if role != "admin" && role != "member" {
-		return domain.NewDomainError(domain.ErrCodeBadRequest, "invalid role. Must be 'admin' or 'member'", 400)
+	if err := s.validateRole(ctx, workspaceID, role); err != nil {
+		return err
 	}
 
 	// 소유자 역할은 변경 불가
@@ -580,3 +582,20 @@ func (s *Service) UpdateMemberRole(ctx context.Context, workspaceID, userID, rol
 	logger.Info(fmt.Sprintf("User %s role updated to %s in workspace %s", userID, role, workspaceID))
 	return nil
 }
+
+// validateRole: 역할이 기본 제공 역할(admin, member, viewer)이거나 워크스페이스의 사용자 정의 역할인지 확인합니다
+func (s *Service) validateRole(ctx context.Context, workspaceID, role string) error {
+	if domain.IsBuiltinWorkspaceRole(role) {
+		return nil
+	}
+	if s.roleRepo != nil {
+		customRole, err := s.roleRepo.GetByName(ctx, workspaceID, role)
+		if err != nil {
+			return domain.NewDomainError(domain.ErrCodeInternalError, fmt.Sprintf("failed to get workspace role: %v", err), 500)
+		}
+		if customRole != nil {
+			return nil
+		}
+	}
+	return domain.NewDomainError(domain.ErrCodeBadRequest, "invalid role. Must be 'admin', 'member', 'viewer' or a custom role of this workspace", 400)
+}
@@ -7,8 +7,10 @@ import (
 	"time"
 
 	"github.com/redis/go-redis/v9"
+	"skyclust/internal/application/services/audit_log/sink"
 	"skyclust/internal/domain"
 	"skyclust/internal/infrastructure/database"
+	"skyclust/internal/infrastructure/messaging"
 	"skyclust/pkg/cache"
 	"skyclust/pkg/config"
 	"skyclust/pkg/logger"
@@ -29,9 +31,10 @@ type Container struct {
 	workerModule         *WorkerModule
 
 	// Core dependencies
-	db     *gorm.DB
-	cache  cache.Cache
-	logger *zap.Logger
+	db        *gorm.DB
+	cache     cache.Cache
+	logger    *zap.Logger
+	messaging *messaging.NATSService // nil when services use the in-process LocalBus
 
 	// Initialization state
 	initialized bool
@@ -67,22 +70,38 @@ func (c *Container) Initialize(ctx context.Context, cfg *config.Config) error {
 	} else {
 		logger.Warn("Redis client not available, TokenBlacklist will be disabled")
 	}
-	c.repositoryModule = NewRepositoryModule(c.db, redisClient)
+	auditSinks := newAuditSinks(cfg.Audit)
+	c.repositoryModule = NewRepositoryModule(c.db, redisClient, sink.Routes(auditSinks))
 	logger.Info("Repository module initialized")
 
 	// Create service configuration with Redis client
 	logger.Info("Creating service configuration...")
 
 	serviceConfig := ServiceConfig{
-		JWTSecret:     cfg.Security.JWTSecret,
-		JWTExpiry:     cfg.Security.JWTExpiration,
-		EncryptionKey: cfg.Security.EncryptionKey,
-		RedisClient:   redisClient, // Pass Redis client for TokenBlacklist
-		Cache:         c.cache,     // Pass cache for OIDC state storage
+		JWTSecret:              cfg.Security.JWTSecret,
+		JWTExpiry:              cfg.Security.JWTExpiration,
+		EncryptionKey:          cfg.Security.EncryptionKey,
+		EncryptionKeys:         cfg.Security.EncryptionKeys,
+		EncryptionPrimaryKeyID: cfg.Security.EncryptionPrimaryKeyID,
+		RedisClient:            redisClient, // Pass Redis client for TokenBlacklist
+		Cache:                  c.cache,     // Pass cache for OIDC state storage
+		SecretStores:           cfg.SecretStores,
+		Audit:                  cfg.Audit,
+		Notification:           cfg.Notification,
+		Automation:             cfg.Automation,
+		Operation:              cfg.Operation,
+		AuditSinks:             auditSinks,
+	}
+	if c.messaging != nil {
+		serviceConfig.MessagingBus = c.messaging
 	}
 
 	logger.Info("Initializing service module...")
-	c.serviceModule = NewServiceModule(c.repositoryModule.GetContainer(), c.db, serviceConfig)
+	serviceModule, err := NewServiceModule(c.repositoryModule.GetContainer(), c.db, serviceConfig)
+	if err != nil {
+		return fmt.Errorf("failed to initialize service module: %w", err)
+	}
+	c.serviceModule = serviceModule
 	logger.Info("Service module initialized")
 
 	logger.Info("Initializing domain module...")
@@ -97,8 +116,15 @@ func (c *Container) Initialize(ctx context.Context, cfg *config.Config) error {
 	c.infrastructureModule.infrastructure.Database = c.db
 	c.infrastructureModule.infrastructure.Cache = c.cache
 	c.infrastructureModule.infrastructure.Logger = c.logger
+	if c.messaging != nil {
+		c.infrastructureModule.infrastructure.Messaging = c.messaging
+	}
 	// TransactionManager is already set in NewInfrastructureModule
 
+	logger.Info("Initializing worker module...")
+	c.workerModule = NewWorkerModule(c.serviceModule, c.repositoryModule, c.cache, c.serviceModule.GetMessagingBus(), c.logger, cfg.Audit, cfg.Webhook, cfg.Notification, cfg.Automation, cfg.Operation)
+	logger.Info("Worker module initialized")
+
 	c.initialized = true
 	return nil
 }
@@ -141,6 +167,31 @@ func (c *Container) initializeInfrastructure(ctx context.Context, cfg *config.Co
 		logger.Info("Successfully initialized Redis cache")
 	}
 
+	// Initialize NATS JetStream messaging (opt-in, falls back to the in-process LocalBus)
+	if cfg.NATS.JetStream.Enabled && cfg.NATS.URL != "" {
+		natsService, err := messaging.NewNATSService(messaging.NATSConfig{
+			URL:                  cfg.NATS.URL,
+			Cluster:              cfg.NATS.Cluster,
+			Username:             cfg.NATS.Username,
+			Password:             cfg.NATS.Password,
+			CompressionType:      messaging.CompressionType(cfg.NATS.CompressionType),
+			CompressionThreshold: cfg.NATS.CompressionThreshold,
+			JetStream: messaging.JetStreamConfig{
+				Enabled:          true,
+				MaxAge:           cfg.NATS.JetStream.MaxAge,
+				MaxDeliver:       cfg.NATS.JetStream.MaxDeliver,
+				AckWait:          cfg.NATS.JetStream.AckWait,
+				Backoff:          cfg.NATS.JetStream.Backoff,
+				DeadLetterMaxAge: cfg.NATS.JetStream.DeadLetterMaxAge,
+			},
+		})
+		if err != nil {
+			logger.Warnf("Failed to initialize NATS JetStream, falling back to local event bus: %v", err)
+		} else {
+			c.messaging = natsService
+		}
+	}
+
 	// Initialize logger
 	c.logger = logger.DefaultLogger.GetLogger()
 
@@ -189,6 +240,34 @@ func (c *Container) GetOIDCProviderRepository() domain.OIDCProviderRepository {
 	return c.repositoryModule.GetContainer().OIDCProviderRepository
 }
 
+// GetSSOProviderRepository returns the organization SSO provider repository
+func (c *Container) GetSSOProviderRepository() domain.SSOProviderRepository {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.repositoryModule.GetContainer().SSOProviderRepository
+}
+
+// GetWorkspaceRoleRepository returns the custom workspace role repository
+func (c *Container) GetWorkspaceRoleRepository() domain.WorkspaceRoleRepository {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.repositoryModule.GetContainer().WorkspaceRoleRepository
+}
+
+// GetWorkspacePolicyRepository returns the workspace policy repository
+func (c *Container) GetWorkspacePolicyRepository() domain.WorkspacePolicyRepository {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.repositoryModule.GetContainer().WorkspacePolicyRepository
+}
+
+// GetSCIMRepository returns the SCIM provisioning repository
+func (c *Container) GetSCIMRepository() domain.SCIMRepository {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.repositoryModule.GetContainer().SCIMRepository
+}
+
 // GetOutboxRepository returns the outbox repository
 func (c *Container) GetOutboxRepository() domain.OutboxRepository {
 	c.mu.RLock()
@@ -196,6 +275,13 @@ func (c *Container) GetOutboxRepository() domain.OutboxRepository {
 	return c.repositoryModule.GetContainer().OutboxRepository
 }
 
+// GetStreamEventRepository returns the SSE event history repository
+func (c *Container) GetStreamEventRepository() domain.StreamEventRepository {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.repositoryModule.GetContainer().StreamEventRepository
+}
+
 // GetUserService returns the user service
 func (c *Container) GetUserService() domain.UserService {
 	c.mu.RLock()
@@ -329,6 +415,13 @@ func (c *Container) GetAuditLogService() domain.AuditLogService {
 	return c.serviceModule.GetContainer().AuditLogService
 }
 
+// GetCloudAuditService returns the cloud audit ingestion service
+func (c *Container) GetCloudAuditService() domain.CloudAuditService {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.serviceModule.GetContainer().CloudAuditService
+}
+
 // GetOIDCService returns the OIDC service
 func (c *Container) GetOIDCService() domain.OIDCService {
 	c.mu.RLock()
@@ -336,6 +429,55 @@ func (c *Container) GetOIDCService() domain.OIDCService {
 	return c.serviceModule.GetContainer().OIDCService
 }
 
+// GetWorkspaceRBACService returns the workspace-scoped RBAC service
+func (c *Container) GetWorkspaceRBACService() domain.WorkspaceRBACService {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.serviceModule.GetContainer().WorkspaceRBACService
+}
+
+// GetPolicyService returns the workspace policy (ABAC) service
+func (c *Container) GetPolicyService() domain.PolicyService {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.serviceModule.GetContainer().PolicyService
+}
+
+// GetWebhookService returns the outgoing workspace webhook service
+func (c *Container) GetWebhookService() domain.WebhookService {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.serviceModule.GetContainer().WebhookService
+}
+
+// GetChatChannelService returns the workspace chat channel service
+func (c *Container) GetChatChannelService() domain.ChatChannelService {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.serviceModule.GetContainer().ChatChannelService
+}
+
+// GetAutomationService returns the workspace automation rule service
+func (c *Container) GetAutomationService() domain.AutomationService {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.serviceModule.GetContainer().AutomationService
+}
+
+// GetOperationService returns the long-running cloud operation service
+func (c *Container) GetOperationService() domain.OperationService {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.serviceModule.GetContainer().OperationService
+}
+
+// GetSCIMService returns the SCIM provisioning service
+func (c *Container) GetSCIMService() domain.SCIMService {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.serviceModule.GetContainer().SCIMService
+}
+
 // GetLogoutService returns the logout service
 func (c *Container) GetLogoutService() domain.LogoutService {
 	c.mu.RLock()
@@ -435,6 +577,11 @@ func (c *Container) Close() error {
 		c.workerModule.StopAll()
 	}
 
+	// Close NATS connection
+	if c.messaging != nil {
+		c.messaging.Close()
+	}
+
 	// Close database connection
 	if c.db != nil {
 		if sqlDB, err := c.db.DB(); err == nil {
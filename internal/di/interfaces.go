@@ -13,7 +13,12 @@ type ContainerInterface interface {
 	GetCredentialRepository() domain.CredentialRepository
 	GetAuditLogRepository() domain.AuditLogRepository
 	GetOIDCProviderRepository() domain.OIDCProviderRepository
+	GetSSOProviderRepository() domain.SSOProviderRepository
+	GetSCIMRepository() domain.SCIMRepository
+	GetWorkspaceRoleRepository() domain.WorkspaceRoleRepository
+	GetWorkspacePolicyRepository() domain.WorkspacePolicyRepository
 	GetOutboxRepository() domain.OutboxRepository
+	GetStreamEventRepository() domain.StreamEventRepository
 
 	// Service interfaces
 	GetUserService() domain.UserService
@@ -22,8 +27,16 @@ type ContainerInterface interface {
 	GetAuthService() domain.AuthService
 	GetCredentialService() domain.CredentialService
 	GetRBACService() domain.RBACService
+	GetWorkspaceRBACService() domain.WorkspaceRBACService
+	GetPolicyService() domain.PolicyService
+	GetWebhookService() domain.WebhookService
+	GetChatChannelService() domain.ChatChannelService
+	GetAutomationService() domain.AutomationService
+	GetOperationService() domain.OperationService
 	GetAuditLogService() domain.AuditLogService
+	GetCloudAuditService() domain.CloudAuditService
 	GetOIDCService() domain.OIDCService
+	GetSCIMService() domain.SCIMService
 	GetLogoutService() domain.LogoutService
 	GetNotificationService() domain.NotificationService
 	GetSystemMonitoringService() interface{}
@@ -60,9 +73,21 @@ type RepositoryContainer struct {
 	AuditLogRepository                domain.AuditLogRepository
 	NotificationRepository            domain.NotificationRepository
 	NotificationPreferencesRepository domain.NotificationPreferencesRepository
+	NotificationTemplateRepository    domain.NotificationTemplateRepository
 	OIDCProviderRepository            domain.OIDCProviderRepository
+	SSOProviderRepository             domain.SSOProviderRepository
+	SCIMRepository                    domain.SCIMRepository
 	RBACRepository                    domain.RBACRepository
+	WorkspaceRoleRepository           domain.WorkspaceRoleRepository
+	WorkspacePolicyRepository         domain.WorkspacePolicyRepository
 	OutboxRepository                  domain.OutboxRepository
+	CloudAuditRepository              domain.CloudAuditRepository
+	AuditQueryRepository              domain.AuditQueryRepository
+	StreamEventRepository             domain.StreamEventRepository
+	WebhookRepository                 domain.WebhookRepository
+	ChatChannelRepository             domain.ChatChannelRepository
+	AutomationRepository              domain.AutomationRepository
+	OperationRepository               domain.OperationRepository
 }
 
 // ServiceContainer holds service dependencies
@@ -73,10 +98,18 @@ type ServiceContainer struct {
 	AuthService             domain.AuthService
 	CredentialService       domain.CredentialService
 	RBACService             domain.RBACService
+	WorkspaceRBACService    domain.WorkspaceRBACService
+	PolicyService           domain.PolicyService
 	AuditLogService         domain.AuditLogService
+	CloudAuditService       domain.CloudAuditService
 	OIDCService             domain.OIDCService
+	SCIMService             domain.SCIMService
 	LogoutService           domain.LogoutService
 	NotificationService     domain.NotificationService
+	WebhookService          domain.WebhookService
+	ChatChannelService      domain.ChatChannelService
+	AutomationService       domain.AutomationService
+	OperationService        domain.OperationService
 	SystemMonitoringService interface{} // SystemMonitoringService for system health and metrics
 	KubernetesService       interface{} // KubernetesService for K8s cluster management
 	NetworkService          interface{} // NetworkService for VPC, Subnet, Security Group management
@@ -2,18 +2,26 @@ package di
 
 import (
 	"context"
+	"crypto/sha256"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 	auditlogservice "skyclust/internal/application/services/audit_log"
+	"skyclust/internal/application/services/audit_log/sink"
 	authservice "skyclust/internal/application/services/auth"
+	automationservice "skyclust/internal/application/services/automation"
 	cacheservice "skyclust/internal/application/services/cache"
+	chatservice "skyclust/internal/application/services/chat"
+	cloudauditservice "skyclust/internal/application/services/cloud_audit"
 	computeservice "skyclust/internal/application/services/compute"
 	costanalysisservice "skyclust/internal/application/services/cost_analysis"
 	credentialservice "skyclust/internal/application/services/credential"
+	"skyclust/internal/application/services/credential/secretstore"
 	dashboardservice "skyclust/internal/application/services/dashboard"
 	eventservice "skyclust/internal/application/services/event"
 	exportservice "skyclust/internal/application/services/export"
@@ -22,17 +30,29 @@ import (
 	networkservice "skyclust/internal/application/services/network"
 	notificationservice "skyclust/internal/application/services/notification"
 	oidcservice "skyclust/internal/application/services/oidc"
+	operationservice "skyclust/internal/application/services/operation"
+	policyservice "skyclust/internal/application/services/policy"
 	rbacservice "skyclust/internal/application/services/rbac"
+	scimservice "skyclust/internal/application/services/scim"
 	systemmonitoringservice "skyclust/internal/application/services/system_monitoring"
 	userservice "skyclust/internal/application/services/user"
 	vmservice "skyclust/internal/application/services/vm"
+	webhookservice "skyclust/internal/application/services/webhook"
 	workspaceservice "skyclust/internal/application/services/workspace"
 	"skyclust/internal/domain"
 	"skyclust/internal/infrastructure/database/postgres"
 	"skyclust/internal/infrastructure/messaging"
+	infranotification "skyclust/internal/infrastructure/notification"
+	auditworker "skyclust/internal/workers/audit"
+	automationworker "skyclust/internal/workers/automation"
+	credentialworker "skyclust/internal/workers/credential"
 	k8sworker "skyclust/internal/workers/kubernetes"
 	networkworker "skyclust/internal/workers/network"
+	notificationworker "skyclust/internal/workers/notification"
+	operationworker "skyclust/internal/workers/operation"
+	webhookworker "skyclust/internal/workers/webhook"
 	"skyclust/pkg/cache"
+	"skyclust/pkg/config"
 	"skyclust/pkg/logger"
 	"skyclust/pkg/security"
 )
@@ -43,7 +63,8 @@ type RepositoryModule struct {
 }
 
 // NewRepositoryModule creates a new repository module
-func NewRepositoryModule(db *gorm.DB, redisClient *redis.Client) *RepositoryModule {
+// auditSinks are the SIEM sinks that audit log writes enqueue outbox events for
+func NewRepositoryModule(db *gorm.DB, redisClient *redis.Client, auditSinks []domain.AuditSinkRoute) *RepositoryModule {
 	logger.Info("Initializing repository module...")
 
 	// Create repositories
@@ -53,11 +74,20 @@ func NewRepositoryModule(db *gorm.DB, redisClient *redis.Client) *RepositoryModu
 	workspaceRepo := postgres.NewWorkspaceRepository(db)
 	vmRepo := postgres.NewVMRepository(db)
 	credentialRepo := postgres.NewCredentialRepository(db)
-	auditLogRepo := postgres.NewAuditLogRepository(db)
+	auditLogRepo := postgres.NewAuditLogRepository(db, auditSinks...)
 	notificationRepo := postgres.NewNotificationRepository(db)
 	notificationPreferencesRepo := postgres.NewNotificationPreferencesRepository(db)
+	notificationTemplateRepo := postgres.NewNotificationTemplateRepository(db)
 	rbacRepo := postgres.NewRBACRepository(db)
+	workspaceRoleRepo := postgres.NewWorkspaceRoleRepository(db)
+	workspacePolicyRepo := postgres.NewWorkspacePolicyRepository(db)
 	outboxRepo := postgres.NewOutboxRepository(db)
+	scimRepo := postgres.NewSCIMRepository(db)
+	cloudAuditRepo := postgres.NewCloudAuditRepository(db)
+	auditQueryRepo := postgres.NewAuditQueryRepository(db)
+	streamEventRepo := postgres.NewStreamEventRepository(db)
+	automationRepo := postgres.NewAutomationRepository(db)
+	operationRepo := postgres.NewOperationRepository(db)
 
 	logger.Info("Repository module initialized")
 
@@ -70,9 +100,21 @@ func NewRepositoryModule(db *gorm.DB, redisClient *redis.Client) *RepositoryModu
 			AuditLogRepository:                auditLogRepo,
 			NotificationRepository:            notificationRepo,
 			NotificationPreferencesRepository: notificationPreferencesRepo,
+			NotificationTemplateRepository:    notificationTemplateRepo,
 			OIDCProviderRepository:            nil, // Will be set later after encryptor is available
+			SSOProviderRepository:             nil, // Will be set later after encryptor is available
+			WebhookRepository:                 nil, // Will be set later after encryptor is available
+			ChatChannelRepository:             nil, // Will be set later after encryptor is available
+			SCIMRepository:                    scimRepo,
 			RBACRepository:                    rbacRepo,
+			WorkspaceRoleRepository:           workspaceRoleRepo,
+			WorkspacePolicyRepository:         workspacePolicyRepo,
 			OutboxRepository:                  outboxRepo,
+			CloudAuditRepository:              cloudAuditRepo,
+			AuditQueryRepository:              auditQueryRepo,
+			StreamEventRepository:             streamEventRepo,
+			AutomationRepository:              automationRepo,
+			OperationRepository:               operationRepo,
 		},
 	}
 }
@@ -84,8 +126,9 @@ func (m *RepositoryModule) GetContainer() *RepositoryContainer {
 
 // ServiceModule initializes service dependencies
 type ServiceModule struct {
-	services     *ServiceContainer
-	messagingBus messaging.Bus
+	services       *ServiceContainer
+	messagingBus   messaging.Bus
+	auditSinkQueue *sink.Dispatcher
 }
 
 // GetMessagingBus returns the messaging bus used by services
@@ -93,8 +136,13 @@ func (m *ServiceModule) GetMessagingBus() messaging.Bus {
 	return m.messagingBus
 }
 
+// GetAuditSinkDispatcher returns the dispatcher that forwards audit logs to SIEM sinks
+func (m *ServiceModule) GetAuditSinkDispatcher() *sink.Dispatcher {
+	return m.auditSinkQueue
+}
+
 // NewServiceModule creates a new service module with actual service implementations
-func NewServiceModule(repos *RepositoryContainer, db *gorm.DB, config ServiceConfig) *ServiceModule {
+func NewServiceModule(repos *RepositoryContainer, db *gorm.DB, config ServiceConfig) (*ServiceModule, error) {
 	logger.Info("Starting service module initialization...")
 
 	// Get Redis client from config
@@ -112,15 +160,22 @@ func NewServiceModule(repos *RepositoryContainer, db *gorm.DB, config ServiceCon
 		logger.DefaultLogger.GetLogger(),
 		nil,          // Config will be set later or use defaults
 		config.Cache, // Cache interface for health checks
+		repos.OutboxRepository,
 	)
 
 	// Create security components
 	hasher := security.NewBcryptHasher(12) // Use bcrypt with cost 12
-	encryptor := security.NewAESEncryptor([]byte(config.EncryptionKey))
+	encryptor, err := newEnvelopeEncryptor(config)
+	if err != nil {
+		return nil, err
+	}
 	blacklist := cache.NewTokenBlacklist(redisClient)
 
 	// Create messaging bus (shared across services)
-	messagingBus := messaging.NewLocalBus()
+	messagingBus := config.MessagingBus
+	if messagingBus == nil {
+		messagingBus = messaging.NewLocalBus()
+	}
 
 	// Create EventService (requires messaging bus)
 	eventService := eventservice.NewService(messagingBus)
@@ -128,6 +183,12 @@ func NewServiceModule(repos *RepositoryContainer, db *gorm.DB, config ServiceCon
 	// Create RBACService first (needed by AuthService)
 	rbacService := rbacservice.NewService(repos.RBACRepository)
 
+	// Create WorkspaceRBACService (workspace-scoped roles and permissions)
+	workspaceRBACService := rbacservice.NewWorkspaceService(repos.WorkspaceRoleRepository, repos.WorkspaceRepository, rbacService, repos.AuditLogRepository)
+
+	// Create PolicyService (attribute-based workspace policies, consulted before mutating cloud calls)
+	policyService := policyservice.NewService(repos.WorkspacePolicyRepository, repos.WorkspaceRepository, workspaceRBACService, repos.AuditLogRepository)
+
 	// Create AuthService
 	authService := authservice.NewService(
 		repos.UserRepository,
@@ -144,16 +205,27 @@ func NewServiceModule(repos *RepositoryContainer, db *gorm.DB, config ServiceCon
 
 	// Create CredentialService with event publisher
 	credentialEventPublisher := messaging.NewPublisher(messagingBus, logger.DefaultLogger.GetLogger())
-	credentialService := credentialservice.NewService(repos.CredentialRepository, repos.AuditLogRepository, encryptor, credentialEventPublisher)
+	credentialService := credentialservice.NewService(repos.CredentialRepository, repos.AuditLogRepository, encryptor, credentialEventPublisher, newSecretStoreRegistry(config.SecretStores))
+
+	// Create OperationService (progress of long-running cloud operations; trackers are registered below)
+	operationService := operationservice.NewService(repos.OperationRepository, repos.WorkspaceRepository, messaging.NewPublisher(messagingBus, logger.DefaultLogger.GetLogger()))
 
 	// Create Kubernetes service
-	k8sService := kubernetesservice.NewService(credentialService, config.Cache, messagingBus, repos.AuditLogRepository, logger.DefaultLogger.GetLogger())
+	k8sService := kubernetesservice.NewService(credentialService, config.Cache, messagingBus, repos.AuditLogRepository, policyService, operationService, logger.DefaultLogger.GetLogger())
 
 	// Create Network service (after credentialService is created)
-	networkService := networkservice.NewService(credentialService, config.Cache, messagingBus, repos.AuditLogRepository, logger.DefaultLogger.GetLogger())
+	networkService := networkservice.NewService(credentialService, config.Cache, messagingBus, repos.AuditLogRepository, policyService, operationService, logger.DefaultLogger.GetLogger())
+
+	// Create AuditLogService (sink dispatcher delivers entries queued in the outbox)
+	auditSinkDispatcher := sink.NewDispatcher(repos.OutboxRepository, config.Audit.SinkBatchSize, config.AuditSinks...)
+	auditSigner, err := newAuditSigner(config)
+	if err != nil {
+		return nil, err
+	}
+	auditLogService := auditlogservice.NewService(repos.AuditLogRepository, repos.AuditQueryRepository, auditSigner, auditSinkDispatcher)
 
-	// Create AuditLogService
-	auditLogService := auditlogservice.NewService(repos.AuditLogRepository)
+	// Create CloudAuditService (CloudTrail and GCP Admin Activity ingestion)
+	cloudAuditService := cloudauditservice.NewService(credentialService, repos.AuditLogRepository, repos.CloudAuditRepository)
 
 	// Create CacheService for OIDC state storage
 	cacheService := cacheservice.NewService(config.Cache)
@@ -162,22 +234,67 @@ func NewServiceModule(repos *RepositoryContainer, db *gorm.DB, config ServiceCon
 	oidcProviderRepo := postgres.NewOIDCProviderRepository(db, encryptor)
 	repos.OIDCProviderRepository = oidcProviderRepo
 
+	// Create SSOProviderRepository (needs encryptor)
+	ssoProviderRepo := postgres.NewSSOProviderRepository(db, encryptor)
+	repos.SSOProviderRepository = ssoProviderRepo
+
 	// Create OIDCService
-	oidcService := oidcservice.NewService(repos.UserRepository, repos.AuditLogRepository, authService, cacheService, oidcProviderRepo)
+	oidcService := oidcservice.NewService(repos.UserRepository, repos.AuditLogRepository, authService, cacheService, oidcProviderRepo, ssoProviderRepo, rbacService, repos.WorkspaceRepository)
+
+	// Create SCIMService
+	scimService := scimservice.NewService(repos.SCIMRepository, userService, rbacService, repos.WorkspaceRepository, repos.AuditLogRepository)
 
 	// Create WorkspaceService with event publisher
 	workspaceEventPublisher := messaging.NewPublisher(messagingBus, logger.DefaultLogger.GetLogger())
-	workspaceService := workspaceservice.NewService(repos.WorkspaceRepository, repos.UserRepository, eventService, repos.AuditLogRepository, workspaceEventPublisher)
+	workspaceService := workspaceservice.NewService(repos.WorkspaceRepository, repos.UserRepository, eventService, repos.AuditLogRepository, workspaceEventPublisher, repos.WorkspaceRoleRepository)
+
+	// Create ChatChannelRepository (needs encryptor for webhook URLs and bot tokens)
+	chatChannelRepo := postgres.NewChatChannelRepository(db, encryptor)
+	repos.ChatChannelRepository = chatChannelRepo
+
+	// Create ChatChannelService (Slack, Microsoft Teams and Discord delivery with acknowledge callbacks)
+	chatChannelService := chatservice.NewService(
+		chatChannelRepo,
+		repos.NotificationRepository,
+		repos.NotificationPreferencesRepository,
+		repos.WorkspaceRepository,
+		repos.AuditLogRepository,
+		infranotification.NewChatService(),
+		chatservice.Config{
+			PublicURL: config.Notification.PublicURL,
+			AckKey:    newChatAckKey(config),
+			AckTTL:    config.Notification.AckTTL,
+		},
+	)
 
 	// Create NotificationService
 	notificationService := notificationservice.NewService(
 		logger.DefaultLogger.GetLogger(),
 		repos.NotificationRepository,
 		repos.NotificationPreferencesRepository,
+		repos.NotificationTemplateRepository,
 		repos.AuditLogRepository,
 		repos.UserRepository,
 		repos.WorkspaceRepository,
 		eventService,
+		chatChannelService,
+		newNotificationMailer(config),
+		notificationservice.Config{
+			DedupWindow: config.Notification.DedupWindow,
+		},
+	)
+
+	// Create WebhookRepository (needs encryptor for signing secrets)
+	webhookRepo := postgres.NewWebhookRepository(db, encryptor)
+	repos.WebhookRepository = webhookRepo
+
+	// Create WebhookService (outgoing workspace webhooks)
+	webhookService := webhookservice.NewService(
+		webhookRepo,
+		repos.WorkspaceRepository,
+		repos.CredentialRepository,
+		repos.AuditLogRepository,
+		notificationService,
 	)
 
 	// Create ComputeService first (needed by VMService)
@@ -191,12 +308,34 @@ func NewServiceModule(repos *RepositoryContainer, db *gorm.DB, config ServiceCon
 		computeService,
 		eventService,
 		repos.AuditLogRepository,
+		policyService,
 		config.Cache,
 		cache.NewCacheKeyBuilder(),
 		cache.NewInvalidatorWithEvents(config.Cache, vmEventPublisher),
+		operationService,
 		logger.DefaultLogger.GetLogger(),
 	)
 
+	// Register operation trackers (operation types are prefixed with the owning service kind)
+	operationService.RegisterTracker("kubernetes", k8sService)
+	operationService.RegisterTracker("network", networkService)
+	operationService.RegisterTracker("vm", vmService)
+
+	// Create AutomationService (workspace automation rules; actions run as the rule creator)
+	automationService := automationservice.NewService(
+		repos.AutomationRepository,
+		repos.WorkspaceRepository,
+		repos.CredentialRepository,
+		repos.AuditLogRepository,
+		notificationService,
+		webhookService,
+		vmService,
+		workspaceRBACService,
+		automationservice.Config{
+			ActionTimeout: config.Automation.ActionTimeout,
+		},
+	)
+
 	// Create ExportService
 	exportService := exportservice.NewService(
 		logger.DefaultLogger.GetLogger(),
@@ -240,23 +379,192 @@ func NewServiceModule(repos *RepositoryContainer, db *gorm.DB, config ServiceCon
 			UserService:             userService,
 			CredentialService:       credentialService,
 			RBACService:             rbacService,
+			WorkspaceRBACService:    workspaceRBACService,
+			PolicyService:           policyService,
 			AuditLogService:         auditLogService,
+			CloudAuditService:       cloudAuditService,
 			KubernetesService:       k8sService,
 			NetworkService:          networkService,
 			SystemMonitoringService: systemMonitoringService,
 			OIDCService:             oidcService,
+			SCIMService:             scimService,
 			LogoutService:           logoutService,
 			WorkspaceService:        workspaceService,
 			VMService:               vmService,
 			NotificationService:     notificationService,
+			WebhookService:          webhookService,
+			ChatChannelService:      chatChannelService,
+			AutomationService:       automationService,
+			OperationService:        operationService,
 			ExportService:           exportService,
 			CostAnalysisService:     costAnalysisService,
 			ComputeService:          computeService,
 			DashboardService:        dashboardService,
 			BusinessRuleService:     nil, // BusinessRuleService is in DomainContainer, not ServiceContainer
 		},
-		messagingBus: messagingBus,
+		messagingBus:   messagingBus,
+		auditSinkQueue: auditSinkDispatcher,
+	}, nil
+}
+
+// newEnvelopeEncryptor creates the envelope encryptor for stored secrets
+// When ENCRYPTION_KEYS is not set, ENCRYPTION_KEY becomes master key "v1"; it is always kept for legacy ciphertexts
+// An invalid ENCRYPTION_KEYS or ENCRYPTION_PRIMARY_KEY_ID stops startup instead of silently encrypting with another key
+func newEnvelopeEncryptor(config ServiceConfig) (security.Encryptor, error) {
+	keys, err := security.ParseMasterKeys(config.EncryptionKeys)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ENCRYPTION_KEYS: %w", err)
+	}
+	if len(keys) == 0 {
+		if strings.TrimSpace(config.EncryptionKeys) != "" {
+			return nil, fmt.Errorf("invalid ENCRYPTION_KEYS: no master keys configured")
+		}
+		keys = []security.MasterKey{{ID: "v1", Key: []byte(config.EncryptionKey)}}
+	}
+
+	encryptor, err := security.NewEnvelopeEncryptor(keys, config.EncryptionPrimaryKeyID, []byte(config.EncryptionKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid ENCRYPTION_KEYS or ENCRYPTION_PRIMARY_KEY_ID: %w", err)
+	}
+
+	logger.Infof("Envelope encryption initialized (primary key: %s, keys: %v)", encryptor.PrimaryKeyID(), encryptor.KeyIDs())
+	return encryptor, nil
+}
+
+// newAuditSigner creates the signer for audit checkpoints and archives
+// Without AUDIT_SIGNING_KEY the key is derived from ENCRYPTION_KEY so signatures survive restarts
+// An invalid AUDIT_SIGNING_KEY stops startup so checkpoints are never signed with an unexpected key
+func newAuditSigner(config ServiceConfig) (*security.Ed25519Signer, error) {
+	if config.Audit.SigningKey != "" {
+		signer, err := security.NewEd25519Signer(config.Audit.SigningKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AUDIT_SIGNING_KEY: %w", err)
+		}
+		logger.Infof("Audit checkpoint signing key loaded (key id: %s)", signer.KeyID())
+		return signer, nil
+	}
+
+	signer := security.DeriveEd25519Signer([]byte(config.EncryptionKey), "skyclust-audit-checkpoint")
+	logger.Warnf("AUDIT_SIGNING_KEY is not set, using a key derived from ENCRYPTION_KEY (key id: %s); set a dedicated key for production", signer.KeyID())
+	return signer, nil
+}
+
+// newNotificationMailer returns the SMTP mailer for digest and escalation emails, or nil when SMTP is not configured
+func newNotificationMailer(config ServiceConfig) notificationservice.Mailer {
+	settings := config.Notification
+	if settings.SMTPHost == "" {
+		logger.Info("SMTP_HOST not set; notification digests and escalation emails are disabled")
+		return nil
+	}
+
+	port := settings.SMTPPort
+	if port == "" {
+		port = "587"
+	}
+	fromName := settings.EmailFromName
+	if fromName == "" {
+		fromName = "SkyClust"
+	}
+	return infranotification.NewEmailService(settings.SMTPHost, port, settings.SMTPUsername, settings.SMTPPassword, settings.EmailFrom, fromName)
+}
+
+// newChatAckKey returns the key that signs chat acknowledge tokens
+// Without NOTIFICATION_ACK_SIGNING_KEY the key is derived from ENCRYPTION_KEY so links survive restarts
+func newChatAckKey(config ServiceConfig) []byte {
+	if config.Notification.AckSigningKey != "" {
+		return []byte(config.Notification.AckSigningKey)
+	}
+
+	derived := sha256.Sum256([]byte("skyclust-chat-ack:" + config.EncryptionKey))
+	logger.Warn("NOTIFICATION_ACK_SIGNING_KEY is not set, using a key derived from ENCRYPTION_KEY for chat acknowledge links")
+	return derived[:]
+}
+
+// newAuditSinks creates the enabled audit log sinks; invalid sinks are logged and skipped
+func newAuditSinks(cfg config.AuditConfig) []sink.Sink {
+	var sinks []sink.Sink
+	for _, sc := range cfg.Sinks {
+		if sc.Disabled {
+			continue
+		}
+		s, err := sink.New(sink.Config{
+			Name:   sc.Name,
+			Type:   sc.Type,
+			Format: sc.Format,
+			Filter: domain.AuditSinkFilter{
+				Actions:        sc.Actions,
+				ExcludeActions: sc.ExcludeActions,
+				Resources:      sc.Resources,
+			},
+			Address:            sc.Address,
+			TLS:                sc.TLS,
+			AppName:            sc.AppName,
+			Hostname:           sc.Hostname,
+			URL:                sc.URL,
+			Secret:             sc.Secret,
+			Token:              sc.Token,
+			Index:              sc.Index,
+			Source:             sc.Source,
+			SourceType:         sc.SourceType,
+			CAFile:             sc.CAFile,
+			InsecureSkipVerify: sc.InsecureSkipVerify,
+			Timeout:            sc.Timeout,
+		})
+		if err != nil {
+			logger.Errorf("Audit sink disabled: %v", err)
+			continue
+		}
+		logger.Infof("Audit sink enabled: %s (%s, %s)", s.Name(), s.Type(), s.Target())
+		sinks = append(sinks, s)
+	}
+	return sinks
+}
+
+// newSecretStoreRegistry registers the external secret stores enabled in configuration
+func newSecretStoreRegistry(cfg config.SecretStoresConfig) *secretstore.Registry {
+	var stores []domain.SecretStore
+
+	if cfg.Vault.Address != "" {
+		vault, err := secretstore.NewVaultStore(secretstore.VaultConfig{
+			Address:   cfg.Vault.Address,
+			Token:     cfg.Vault.Token,
+			Mount:     cfg.Vault.Mount,
+			Namespace: cfg.Vault.Namespace,
+			Timeout:   cfg.Vault.Timeout,
+		})
+		if err != nil {
+			logger.Errorf("Vault secret store disabled: %v", err)
+		} else {
+			stores = append(stores, vault)
+		}
+	}
+
+	if cfg.FileDir != "" {
+		file, err := secretstore.NewFileStore(cfg.FileDir)
+		if err != nil {
+			logger.Errorf("File secret store disabled: %v", err)
+		} else {
+			stores = append(stores, file)
+		}
 	}
+
+	ttl := cfg.CacheTTL
+	if ttl == 0 {
+		ttl = secretstore.DefaultCacheTTL
+	}
+
+	prefix := cfg.WorkspacePathPrefix
+	if prefix == "" {
+		prefix = secretstore.DefaultWorkspacePathPrefix
+	}
+	if !strings.Contains(prefix, secretstore.WorkspaceIDPlaceholder) {
+		logger.Warnf("SECRET_STORE_WORKSPACE_PREFIX %q has no %s placeholder; all workspaces share the same secret paths", prefix, secretstore.WorkspaceIDPlaceholder)
+	}
+
+	for _, store := range stores {
+		logger.Infof("Secret store enabled: %s (workspace prefix: %s)", store.Name(), prefix)
+	}
+	return secretstore.NewRegistry(ttl, prefix, stores...)
 }
 
 // GetContainer returns the service container
@@ -269,8 +577,18 @@ type ServiceConfig struct {
 	JWTSecret     string
 	JWTExpiry     time.Duration
 	EncryptionKey string
-	RedisClient   interface{} // Redis client for TokenBlacklist
-	Cache         cache.Cache // Cache for OIDC state storage
+	// EncryptionKeys / EncryptionPrimaryKeyID configure versioned master keys for envelope encryption
+	EncryptionKeys         string
+	EncryptionPrimaryKeyID string
+	RedisClient            interface{} // Redis client for TokenBlacklist
+	Cache                  cache.Cache // Cache for OIDC state storage
+	SecretStores           config.SecretStoresConfig
+	Audit                  config.AuditConfig
+	Notification           config.NotificationConfig
+	Automation             config.AutomationConfig
+	Operation              config.OperationConfig
+	AuditSinks             []sink.Sink   // built before the repository module so audit writes can enqueue for them
+	MessagingBus           messaging.Bus // NATS JetStream when enabled; LocalBus is used when nil
 }
 
 // DomainModule initializes domain service dependencies
@@ -347,8 +665,18 @@ type WorkerModule struct {
 
 // WorkerContainer holds worker dependencies
 type WorkerContainer struct {
-	KubernetesSyncWorker *k8sworker.SyncWorker
-	NetworkSyncWorker    *networkworker.SyncWorker
+	KubernetesSyncWorker    *k8sworker.SyncWorker
+	NetworkSyncWorker       *networkworker.SyncWorker
+	CredentialHealthWorker  *credentialworker.HealthWorker
+	AuditCheckpointWorker   *auditworker.CheckpointWorker
+	AuditSinkWorker         *auditworker.SinkWorker
+	CloudIngestWorker       *auditworker.CloudIngestWorker
+	AuditSubscriptionWorker *auditworker.SubscriptionWorker
+	OutboxWorker            *messaging.OutboxWorker
+	WebhookDeliveryWorker   *webhookworker.DeliveryWorker
+	NotificationWorker      *notificationworker.DeliveryWorker
+	AutomationRuleWorker    *automationworker.RuleWorker
+	OperationPoller         *operationworker.Poller
 }
 
 // NewWorkerModule creates a new worker module
@@ -358,6 +686,11 @@ func NewWorkerModule(
 	cacheService cache.Cache,
 	messagingBus interface{},
 	logger *zap.Logger,
+	auditConfig config.AuditConfig,
+	webhookConfig config.WebhookConfig,
+	notificationConfig config.NotificationConfig,
+	automationConfig config.AutomationConfig,
+	operationConfig config.OperationConfig,
 ) *WorkerModule {
 	// Get required services
 	services := serviceModule.GetContainer()
@@ -422,10 +755,152 @@ func NewWorkerModule(
 		logger.Info("Network sync worker created")
 	}
 
+	// Create credential health worker
+	credentialHealthWorker := credentialworker.NewHealthWorker(
+		services.CredentialService,
+		repos.CredentialRepository,
+		repos.WorkspaceRepository,
+		services.WorkspaceRBACService,
+		services.NotificationService,
+		logger,
+		credentialworker.HealthWorkerConfig{
+			CheckInterval:  10 * time.Minute,
+			VerifyInterval: 6 * time.Hour,
+			BatchSize:      100,
+			MaxConcurrency: 5,
+		},
+	)
+	logger.Info("Credential health worker created")
+
+	// Create audit checkpoint worker
+	auditCheckpointWorker := auditworker.NewCheckpointWorker(
+		services.AuditLogService,
+		logger,
+		auditworker.CheckpointWorkerConfig{
+			Interval: auditConfig.CheckpointInterval,
+		},
+	)
+	logger.Info("Audit checkpoint worker created")
+
+	// Create audit sink worker (only when SIEM sinks are configured)
+	var auditSinkWorker *auditworker.SinkWorker
+	if dispatcher := serviceModule.GetAuditSinkDispatcher(); dispatcher.Enabled() {
+		auditSinkWorker = auditworker.NewSinkWorker(
+			dispatcher,
+			logger,
+			auditworker.SinkWorkerConfig{
+				Interval: auditConfig.SinkPollInterval,
+			},
+		)
+		logger.Info("Audit sink worker created")
+	}
+
+	// Create cloud audit ingest worker (opt-in; needs read access to provider audit logs)
+	var cloudIngestWorker *auditworker.CloudIngestWorker
+	if auditConfig.CloudIngestEnabled {
+		cloudIngestWorker = auditworker.NewCloudIngestWorker(
+			services.CloudAuditService,
+			repos.CredentialRepository,
+			repos.WorkspaceRepository,
+			services.NotificationService,
+			logger,
+			auditworker.CloudIngestWorkerConfig{
+				Interval:       auditConfig.CloudIngestInterval,
+				MaxConcurrency: 3,
+			},
+		)
+		logger.Info("Cloud audit ingest worker created")
+	}
+
+	// Create audit query subscription worker
+	auditSubscriptionWorker := auditworker.NewSubscriptionWorker(
+		services.AuditLogService,
+		services.NotificationService,
+		services.RBACService,
+		logger,
+		auditworker.SubscriptionWorkerConfig{
+			Interval: auditConfig.SubscriptionInterval,
+		},
+	)
+	logger.Info("Audit subscription worker created")
+
+	// Create outbox worker (safe to run on every replica; events are leased with SKIP LOCKED)
+	outboxWorker := messaging.NewOutboxWorker(
+		repos.OutboxRepository,
+		messaging.NewPublisher(eventBus, logger),
+		logger,
+		messaging.DefaultOutboxWorkerConfig(),
+	)
+	logger.Info("Outbox worker created")
+
+	// Create webhook delivery worker (events are recorded once via a durable consumer; deliveries are leased)
+	var webhookDeliveryWorker *webhookworker.DeliveryWorker
+	if services.WebhookService != nil {
+		webhookDeliveryWorker = webhookworker.NewDeliveryWorker(
+			services.WebhookService,
+			eventBus,
+			logger,
+			webhookworker.DeliveryWorkerConfig{
+				PollInterval: webhookConfig.PollInterval,
+				Retention:    webhookConfig.DeliveryRetention,
+			},
+		)
+		logger.Info("Webhook delivery worker created")
+	}
+
+	// Create notification delivery worker (quiet hours release, escalations and digests; rows are claimed with SKIP LOCKED)
+	notificationWorker := notificationworker.NewDeliveryWorker(
+		services.NotificationService,
+		logger,
+		notificationworker.DeliveryWorkerConfig{
+			PollInterval:   notificationConfig.DeliveryPollInterval,
+			DigestInterval: notificationConfig.DigestCheckInterval,
+		},
+	)
+	logger.Info("Notification delivery worker created")
+
+	// Create automation rule worker (each event is evaluated once via a durable consumer)
+	var automationRuleWorker *automationworker.RuleWorker
+	if services.AutomationService != nil {
+		automationRuleWorker = automationworker.NewRuleWorker(
+			services.AutomationService,
+			eventBus,
+			logger,
+			automationworker.RuleWorkerConfig{
+				Retention: automationConfig.ExecutionRetention,
+			},
+		)
+		logger.Info("Automation rule worker created")
+	}
+
+	// Create operation poller (follows running cloud operations until they finish)
+	var operationPoller *operationworker.Poller
+	if services.OperationService != nil {
+		operationPoller = operationworker.NewPoller(
+			services.OperationService,
+			logger,
+			operationworker.PollerConfig{
+				PollInterval: operationConfig.PollInterval,
+				Retention:    operationConfig.Retention,
+			},
+		)
+		logger.Info("Operation poller created")
+	}
+
 	return &WorkerModule{
 		workers: &WorkerContainer{
-			KubernetesSyncWorker: k8sWorker,
-			NetworkSyncWorker:    networkWorker,
+			KubernetesSyncWorker:    k8sWorker,
+			NetworkSyncWorker:       networkWorker,
+			CredentialHealthWorker:  credentialHealthWorker,
+			AuditCheckpointWorker:   auditCheckpointWorker,
+			AuditSinkWorker:         auditSinkWorker,
+			CloudIngestWorker:       cloudIngestWorker,
+			AuditSubscriptionWorker: auditSubscriptionWorker,
+			OutboxWorker:            outboxWorker,
+			WebhookDeliveryWorker:   webhookDeliveryWorker,
+			NotificationWorker:      notificationWorker,
+			AutomationRuleWorker:    automationRuleWorker,
+			OperationPoller:         operationPoller,
 		},
 	}
 }
@@ -449,6 +924,69 @@ func (m *WorkerModule) StartAll(ctx context.Context) error {
 		}
 	}
 
+	if m.workers.CredentialHealthWorker != nil {
+		if err := m.workers.CredentialHealthWorker.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start credential health worker: %w", err)
+		}
+	}
+
+	if m.workers.AuditCheckpointWorker != nil {
+		if err := m.workers.AuditCheckpointWorker.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start audit checkpoint worker: %w", err)
+		}
+	}
+
+	if m.workers.AuditSinkWorker != nil {
+		if err := m.workers.AuditSinkWorker.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start audit sink worker: %w", err)
+		}
+	}
+
+	if m.workers.CloudIngestWorker != nil {
+		if err := m.workers.CloudIngestWorker.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start cloud audit ingest worker: %w", err)
+		}
+	}
+
+	if m.workers.AuditSubscriptionWorker != nil {
+		if err := m.workers.AuditSubscriptionWorker.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start audit subscription worker: %w", err)
+		}
+	}
+
+	if m.workers.OutboxWorker != nil {
+		// OutboxWorker.Start blocks until stopped
+		go func(worker *messaging.OutboxWorker) {
+			if err := worker.Start(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				logger.Errorf("Outbox worker stopped with error: %v", err)
+			}
+		}(m.workers.OutboxWorker)
+	}
+
+	if m.workers.WebhookDeliveryWorker != nil {
+		if err := m.workers.WebhookDeliveryWorker.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start webhook delivery worker: %w", err)
+		}
+	}
+
+	if m.workers.NotificationWorker != nil {
+		if err := m.workers.NotificationWorker.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start notification delivery worker: %w", err)
+		}
+	}
+
+	if m.workers.AutomationRuleWorker != nil {
+		if err := m.workers.AutomationRuleWorker.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start automation rule worker: %w", err)
+		}
+	}
+
+	if m.workers.OperationPoller != nil {
+		if err := m.workers.OperationPoller.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start operation poller: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -461,4 +999,44 @@ func (m *WorkerModule) StopAll() {
 	if m.workers.NetworkSyncWorker != nil {
 		m.workers.NetworkSyncWorker.Stop()
 	}
+
+	if m.workers.CredentialHealthWorker != nil {
+		m.workers.CredentialHealthWorker.Stop()
+	}
+
+	if m.workers.AuditCheckpointWorker != nil {
+		m.workers.AuditCheckpointWorker.Stop()
+	}
+
+	if m.workers.AuditSinkWorker != nil {
+		m.workers.AuditSinkWorker.Stop()
+	}
+
+	if m.workers.CloudIngestWorker != nil {
+		m.workers.CloudIngestWorker.Stop()
+	}
+
+	if m.workers.AuditSubscriptionWorker != nil {
+		m.workers.AuditSubscriptionWorker.Stop()
+	}
+
+	if m.workers.OutboxWorker != nil {
+		m.workers.OutboxWorker.Stop()
+	}
+
+	if m.workers.WebhookDeliveryWorker != nil {
+		m.workers.WebhookDeliveryWorker.Stop()
+	}
+
+	if m.workers.NotificationWorker != nil {
+		m.workers.NotificationWorker.Stop()
+	}
+
+	if m.workers.AutomationRuleWorker != nil {
+		m.workers.AutomationRuleWorker.Stop()
+	}
+
+	if m.workers.OperationPoller != nil {
+		m.workers.OperationPoller.Stop()
+	}
 }
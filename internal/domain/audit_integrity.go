@@ -0,0 +1,221 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditSegmentLayout: 감사 로그 세그먼트(일 단위, UTC) 형식
+const AuditSegmentLayout = "2006-01-02"
+
+// 세그먼트 검증 상태
+const (
+	AuditSegmentValid   = "valid"
+	AuditSegmentInvalid = "invalid"
+)
+
+// 무결성 검증 이슈 유형
+const (
+	AuditIssueGap                = "gap"                 // 시퀀스 누락 (삭제된 항목)
+	AuditIssueHashMismatch       = "hash_mismatch"       // 항목 내용 변조
+	AuditIssueChainBroken        = "chain_broken"        // 이전 해시 불일치
+	AuditIssueTruncated          = "truncated"           // 체크포인트 이후 항목 삭제
+	AuditIssueCheckpointMismatch = "checkpoint_mismatch" // 체크포인트가 가리키는 항목 해시 불일치
+	AuditIssueCheckpointInvalid  = "checkpoint_invalid"  // 체크포인트 해시 또는 서명 불일치
+	AuditIssueCheckpointMissing  = "checkpoint_missing"  // 체크포인트 체인 누락
+	AuditIssueArchiveInvalid     = "archive_invalid"     // 아카이브 다이제스트 또는 서명 불일치
+	AuditIssueUnexpectedEntries  = "unexpected_entries"  // 아카이브된 세그먼트에 남은 항목
+)
+
+// AuditCheckpoint: 세그먼트 헤드 해시에 대한 서명된 체크포인트
+// 체크포인트끼리도 해시로 연결되어 세그먼트 전체 삭제를 탐지합니다
+type AuditCheckpoint struct {
+	ID                 uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	Number             int64     `json:"number" gorm:"not null;uniqueIndex"`
+	Segment            string    `json:"segment" gorm:"not null;size:10;index"`
+	Sequence           int64     `json:"sequence" gorm:"not null"`           // 체크포인트가 포함하는 마지막 항목 시퀀스
+	EntryHash          string    `json:"entry_hash" gorm:"not null;size:64"` // 해당 항목의 해시
+	PrevCheckpointHash string    `json:"prev_checkpoint_hash" gorm:"size:64"`
+	Hash               string    `json:"hash" gorm:"not null;size:64"`
+	KeyID              string    `json:"key_id" gorm:"not null;size:16"`
+	Signature          string    `json:"signature" gorm:"not null;size:128"`
+	CreatedAt          time.Time `json:"created_at" gorm:"index"`
+}
+
+// TableName: AuditCheckpoint의 테이블 이름을 반환합니다
+func (AuditCheckpoint) TableName() string {
+	return "audit_checkpoints"
+}
+
+// AuditArchive: 보존 기간이 지나 감사 로그 테이블에서 이동된 세그먼트
+// 항목은 gzip JSON Lines로 보관되며 최종 체크포인트와 함께 서명됩니다
+type AuditArchive struct {
+	ID             uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	Segment        string     `json:"segment" gorm:"not null;size:10;uniqueIndex"`
+	EntryCount     int64      `json:"entry_count"`
+	LegacyCount    int64      `json:"legacy_count"` // 해시 체인 도입 이전 항목 수
+	LastSequence   int64      `json:"last_sequence"`
+	HeadHash       string     `json:"head_hash" gorm:"size:64"`
+	CheckpointID   *uuid.UUID `json:"checkpoint_id,omitempty" gorm:"type:uuid"`
+	Digest         string     `json:"digest" gorm:"not null;size:64"` // 압축 데이터의 SHA-256
+	KeyID          string     `json:"key_id" gorm:"not null;size:16"`
+	Signature      string     `json:"signature" gorm:"not null;size:128"`
+	Data           []byte     `json:"-" gorm:"type:bytea"`
+	ArchivedAt     time.Time  `json:"archived_at" gorm:"index"`
+	RetentionDays  int        `json:"retention_days"`
+	CompressedSize int64      `json:"compressed_size"`
+}
+
+// TableName: AuditArchive의 테이블 이름을 반환합니다
+func (AuditArchive) TableName() string {
+	return "audit_archives"
+}
+
+// AuditIntegrityIssue: 무결성 검증에서 발견된 문제
+type AuditIntegrityIssue struct {
+	Type     string `json:"type"`
+	Segment  string `json:"segment"`
+	Sequence int64  `json:"sequence,omitempty"`
+	EntryID  string `json:"entry_id,omitempty"`
+	Message  string `json:"message"`
+}
+
+// AuditSegmentVerification: 세그먼트별 검증 결과
+type AuditSegmentVerification struct {
+	Segment      string                `json:"segment"`
+	Status       string                `json:"status"`
+	Archived     bool                  `json:"archived"`
+	Entries      int64                 `json:"entries"`
+	HeadSequence int64                 `json:"head_sequence"`
+	HeadHash     string                `json:"head_hash,omitempty"`
+	Checkpoints  int                   `json:"checkpoints"`
+	Issues       []AuditIntegrityIssue `json:"issues,omitempty"`
+}
+
+// AuditVerificationReport: 감사 로그 무결성 검증 결과
+type AuditVerificationReport struct {
+	Valid         bool                       `json:"valid"`
+	From          string                     `json:"from"`
+	To            string                     `json:"to"`
+	Segments      []AuditSegmentVerification `json:"segments"`
+	IssueCount    int                        `json:"issue_count"`
+	UnchainedLogs int64                      `json:"unchained_logs"` // 해시 체인 도입 이전 항목 수 (검증 대상 아님)
+	KeyID         string                     `json:"key_id"`
+	PublicKey     string                     `json:"public_key"`
+	VerifiedAt    time.Time                  `json:"verified_at"`
+}
+
+// AuditCleanupResult: 감사 로그 아카이브 결과
+type AuditCleanupResult struct {
+	ArchivedSegments []string `json:"archived_segments"`
+	ArchivedEntries  int64    `json:"archived_entries"`
+	RetentionDays    int      `json:"retention_days"`
+}
+
+// AuditSegmentFor: 시각이 속한 세그먼트를 반환합니다
+func AuditSegmentFor(t time.Time) string {
+	return t.UTC().Format(AuditSegmentLayout)
+}
+
+// AuditSegmentGenesisHash: 세그먼트 첫 항목의 이전 해시를 반환합니다
+func AuditSegmentGenesisHash(segment string) string {
+	sum := sha256.Sum256([]byte("skyclust-audit-segment:" + segment))
+	return hex.EncodeToString(sum[:])
+}
+
+// auditLogHashInput: 해시 계산에 사용하는 정규화된 항목 표현 (필드 순서 고정)
+type auditLogHashInput struct {
+	ID        string          `json:"id"`
+	Segment   string          `json:"segment"`
+	Sequence  int64           `json:"sequence"`
+	PrevHash  string          `json:"prev_hash"`
+	UserID    string          `json:"user_id"`
+	Action    string          `json:"action"`
+	Resource  string          `json:"resource"`
+	IPAddress string          `json:"ip_address"`
+	UserAgent string          `json:"user_agent"`
+	Details   json.RawMessage `json:"details"`
+	CreatedAt string          `json:"created_at"`
+
+	// 클라우드 수집 항목에만 존재 (기존 항목의 해시가 바뀌지 않도록 비어 있으면 생략)
+	Source     string `json:"source,omitempty"`
+	ExternalID string `json:"external_id,omitempty"`
+}
+
+// NormalizeForChain: 저장 후 다시 읽어도 같은 해시가 나오도록 항목을 정규화합니다
+// 시각은 PostgreSQL 정밀도(마이크로초)로 자르고, 상세 정보는 JSONB 왕복과 같은 형태로 변환합니다
+func (l *AuditLog) NormalizeForChain() error {
+	l.CreatedAt = l.CreatedAt.UTC().Truncate(time.Microsecond)
+	if l.Details == nil {
+		return nil
+	}
+	data, err := json.Marshal(l.Details)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit details: %w", err)
+	}
+	normalized := make(JSONBMap)
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return fmt.Errorf("failed to normalize audit details: %w", err)
+	}
+	l.Details = normalized
+	return nil
+}
+
+// ComputeHash: 이전 해시와 항목 내용으로 체인 해시를 계산합니다
+func (l *AuditLog) ComputeHash() (string, error) {
+	details, err := json.Marshal(l.Details)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode audit details: %w", err)
+	}
+
+	ipAddress := l.IPAddress
+	if ip := net.ParseIP(ipAddress); ip != nil {
+		ipAddress = ip.String()
+	}
+
+	payload, err := json.Marshal(auditLogHashInput{
+		ID:         l.ID.String(),
+		Segment:    l.Segment,
+		Sequence:   l.Sequence,
+		PrevHash:   l.PrevHash,
+		UserID:     l.UserID.String(),
+		Action:     l.Action,
+		Resource:   l.Resource,
+		IPAddress:  ipAddress,
+		UserAgent:  l.UserAgent,
+		Details:    details,
+		CreatedAt:  l.CreatedAt.UTC().Format(time.RFC3339Nano),
+		Source:     l.Source,
+		ExternalID: l.ExternalID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// SigningPayload: 체크포인트 해시와 서명 대상 바이트를 반환합니다
+func (c *AuditCheckpoint) SigningPayload() []byte {
+	return []byte(fmt.Sprintf("skyclust-audit-checkpoint|%d|%s|%d|%s|%s|%s",
+		c.Number, c.Segment, c.Sequence, c.EntryHash, c.PrevCheckpointHash, c.CreatedAt.UTC().Format(time.RFC3339Nano)))
+}
+
+// ComputeHash: 체크포인트 해시를 계산합니다
+func (c *AuditCheckpoint) ComputeHash() string {
+	sum := sha256.Sum256(c.SigningPayload())
+	return hex.EncodeToString(sum[:])
+}
+
+// SigningPayload: 아카이브 서명 대상 바이트를 반환합니다
+func (a *AuditArchive) SigningPayload() []byte {
+	return []byte(fmt.Sprintf("skyclust-audit-archive|%s|%d|%d|%d|%s|%s",
+		a.Segment, a.EntryCount, a.LegacyCount, a.LastSequence, a.HeadHash, a.Digest))
+}
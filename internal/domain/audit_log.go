@@ -55,6 +55,16 @@ type AuditLog struct {
 	Details   JSONBMap  `json:"details" gorm:"type:jsonb"`
 	CreatedAt time.Time `json:"created_at" gorm:"index"`
 
+	// 해시 체인 (일 단위 세그먼트)
+	Segment  string `json:"segment,omitempty" gorm:"size:10;uniqueIndex:idx_audit_logs_segment_sequence"` // YYYY-MM-DD (UTC)
+	Sequence int64  `json:"sequence,omitempty" gorm:"uniqueIndex:idx_audit_logs_segment_sequence"`
+	PrevHash string `json:"prev_hash,omitempty" gorm:"size:64"`
+	Hash     string `json:"hash,omitempty" gorm:"size:64"`
+
+	// 출처 (비어 있으면 SkyClust API, cloud는 CloudTrail/GCP 감사 로그에서 수집한 항목)
+	Source     string `json:"source,omitempty" gorm:"size:20;index"`
+	ExternalID string `json:"external_id,omitempty" gorm:"size:255;index"` // 클라우드 이벤트 ID (중복 수집 방지)
+
 	// Relationships
 	User *User `json:"user,omitempty" gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
 }
@@ -97,6 +107,12 @@ type AuditLogSummary struct {
 
 // AuditAction: 다양한 액션을 나타내는 상수
 const (
+	// 감사 로그 관련 액션
+	ActionAuditLogArchive = "audit_log_archive"
+
+	// 클라우드 활동 (CloudTrail, GCP Admin Activity에서 수집)
+	ActionCloudActivity = "cloud_activity"
+
 	// 사용자 관련 액션
 	ActionUserRegister   = "user_register"
 	ActionUserLogin      = "user_login"
@@ -107,18 +123,64 @@ const (
 	ActionUserDelete     = "user_delete"
 	ActionPasswordChange = "password_change"
 
+	// SSO 관련 액션
+	ActionSSOProviderCreate     = "sso_provider_create"
+	ActionSSOProviderUpdate     = "sso_provider_update"
+	ActionSSOProviderDelete     = "sso_provider_delete"
+	ActionSSOGroupMappingUpdate = "sso_group_mapping_update"
+	ActionSSOMembershipSync     = "sso_membership_sync"
+
+	// SCIM 프로비저닝 관련 액션
+	ActionSCIMTokenCreate    = "scim_token_create"
+	ActionSCIMTokenRevoke    = "scim_token_revoke"
+	ActionSCIMUserCreate     = "scim_user_create"
+	ActionSCIMUserUpdate     = "scim_user_update"
+	ActionSCIMUserDelete     = "scim_user_delete"
+	ActionSCIMGroupCreate    = "scim_group_create"
+	ActionSCIMGroupUpdate    = "scim_group_update"
+	ActionSCIMGroupDelete    = "scim_group_delete"
+	ActionSCIMGroupBind      = "scim_group_bind"
+	ActionSCIMMembershipSync = "scim_membership_sync"
+
 	// 자격증명 관련 액션
-	ActionCredentialCreate = "credential_create"
-	ActionCredentialUpdate = "credential_update"
-	ActionCredentialDelete = "credential_delete"
+	ActionCredentialCreate    = "credential_create"
+	ActionCredentialUpdate    = "credential_update"
+	ActionCredentialDelete    = "credential_delete"
+	ActionCredentialReencrypt = "credential_reencrypt"
+	ActionCredentialVerify    = "credential_verify"
+	ActionCredentialVend      = "credential_vend"
 
 	// 워크스페이스 관련 액션
-	ActionWorkspaceCreate          = "workspace_create"
-	ActionWorkspaceUpdate          = "workspace_update"
-	ActionWorkspaceDelete          = "workspace_delete"
-	ActionWorkspaceUserAdded       = "workspace_user_added"
-	ActionWorkspaceUserRemoved     = "workspace_user_removed"
-	ActionWorkspaceUserRoleUpdated = "workspace_user_role_updated"
+	ActionWorkspaceCreate              = "workspace_create"
+	ActionWorkspaceUpdate              = "workspace_update"
+	ActionWorkspaceDelete              = "workspace_delete"
+	ActionWorkspaceUserAdded           = "workspace_user_added"
+	ActionWorkspaceUserRemoved         = "workspace_user_removed"
+	ActionWorkspaceUserRoleUpdated     = "workspace_user_role_updated"
+	ActionWorkspaceRoleCreate          = "workspace_role_create"
+	ActionWorkspaceRoleUpdate          = "workspace_role_update"
+	ActionWorkspaceRoleDelete          = "workspace_role_delete"
+	ActionWorkspaceAccessDenied        = "workspace_access_denied"
+	ActionPolicyCreate                 = "policy_create"
+	ActionPolicyUpdate                 = "policy_update"
+	ActionPolicyDelete                 = "policy_delete"
+	ActionPolicyDenied                 = "policy_denied"
+	ActionWebhookCreate                = "webhook_create"
+	ActionWebhookUpdate                = "webhook_update"
+	ActionWebhookDelete                = "webhook_delete"
+	ActionWebhookRedeliver             = "webhook_redeliver"
+	ActionWebhookDisabled              = "webhook_disabled"
+	ActionAutomationRuleCreate         = "automation_rule_create"
+	ActionAutomationRuleUpdate         = "automation_rule_update"
+	ActionAutomationRuleDelete         = "automation_rule_delete"
+	ActionAutomationOperation          = "automation_operation"
+	ActionChatChannelCreate            = "chat_channel_create"
+	ActionChatChannelUpdate            = "chat_channel_update"
+	ActionChatChannelDelete            = "chat_channel_delete"
+	ActionNotificationAcknowledge      = "notification_acknowledge"
+	ActionNotificationTemplateSave     = "notification_template_save"
+	ActionNotificationTemplateActivate = "notification_template_activate"
+	ActionNotificationTemplateDelete   = "notification_template_delete"
 
 	// VM 관련 액션
 	ActionVMCreate  = "vm_create"
@@ -15,7 +15,6 @@ type AuditLogRepository interface {
 	GetByDateRange(start, end time.Time, limit, offset int) ([]*AuditLog, error)
 	CountByUserID(userID uuid.UUID) (int64, error)
 	CountByAction(action string) (int64, error)
-	DeleteOldLogs(before time.Time) (int64, error)
 	// Statistics methods
 	GetTotalCount(filters AuditStatsFilters) (int64, error)
 	GetUniqueUsersCount(filters AuditStatsFilters) (int64, error)
@@ -26,4 +25,25 @@ type AuditLogRepository interface {
 	GetMostActiveUser(startTime, endTime time.Time) (uuid.UUID, int64, error)
 	GetSecurityEventsCount(startTime, endTime time.Time) (int64, error)
 	GetErrorEventsCount(startTime, endTime time.Time) (int64, error)
+	// Hash chain methods (segments are UTC days formatted as YYYY-MM-DD)
+	GetSegmentHead(segment string) (*AuditLog, error)
+	ListSegments(from, to string) ([]string, error)
+	ListSegmentEntries(segment string, afterSequence int64, limit int) ([]*AuditLog, error)
+	CountUnchainedLogs() (int64, error)
+	// Checkpoint methods; seal is called inside the append transaction with the latest checkpoint
+	AppendCheckpoint(checkpoint *AuditCheckpoint, seal func(previous *AuditCheckpoint) error) error
+	GetLatestCheckpoint(segment string) (*AuditCheckpoint, error)
+	GetCheckpointByNumber(number int64) (*AuditCheckpoint, error)
+	ListCheckpoints(from, to string) ([]*AuditCheckpoint, error)
+	// Archive methods (replace deletion of expired logs)
+	ListArchivableSegments(before string) ([]string, error)
+	ListSegmentArchiveEntries(segment string, offset, limit int) ([]*AuditLog, error)
+	ArchiveSegment(archive *AuditArchive) error
+	GetArchive(segment string) (*AuditArchive, error)
+	ListArchives(from, to string) ([]*AuditArchive, error)
+	// Cloud ingestion methods
+	ListExistingExternalIDs(source string, externalIDs []string) (map[string]bool, error)
+	ListManagedResourceIDs(credentialID string, keys []string) ([]string, error)
+	// Search methods (query language over columns, details JSONB and full-text index)
+	Search(filters AuditSearchFilters) ([]*AuditLog, int64, error)
 }
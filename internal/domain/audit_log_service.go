@@ -19,6 +19,28 @@ type AuditLogService interface {
 	GetAuditLogByID(id uuid.UUID) (*AuditLog, error)
 	GetAuditStats(filters AuditStatsFilters) (*AuditStats, error)
 	ExportAuditLogs(filters AuditLogFilters, format string) ([]byte, error)
-	CleanupAuditLogs(retentionDays int) (int64, error)
+	CleanupAuditLogs(actorID *uuid.UUID, retentionDays int) (*AuditCleanupResult, error)
 	GetAuditLogSummary(startTime, endTime time.Time) (*AuditLogSummary, error)
+
+	// Integrity methods
+	CreateCheckpoints() ([]*AuditCheckpoint, error)
+	VerifyAuditLogs(from, to string) (*AuditVerificationReport, error)
+	ListCheckpoints(from, to string) ([]*AuditCheckpoint, error)
+	ListArchives(from, to string) ([]*AuditArchive, error)
+	GetArchive(segment string) (*AuditArchive, error)
+
+	// SIEM forwarding
+	GetSinkStatus() ([]*AuditSinkStatus, error)
+
+	// Search, saved queries and subscriptions
+	SearchAuditLogs(req AuditSearchRequest) (*AuditSearchResult, error)
+	CreateSavedQuery(ownerID uuid.UUID, req SaveAuditQueryRequest) (*AuditSavedQuery, error)
+	ListSavedQueries(userID uuid.UUID) ([]*AuditSavedQuery, error)
+	GetSavedQuery(userID, id uuid.UUID) (*AuditSavedQuery, error)
+	UpdateSavedQuery(userID, id uuid.UUID, req SaveAuditQueryRequest) (*AuditSavedQuery, error)
+	DeleteSavedQuery(userID, id uuid.UUID) error
+	RunSavedQuery(userID, id uuid.UUID, req AuditSearchRequest) (*AuditSearchResult, error)
+	SubscribeSavedQuery(userID, id uuid.UUID, priority string) (*AuditQuerySubscription, error)
+	UnsubscribeSavedQuery(userID, id uuid.UUID) error
+	EvaluateSubscriptions() ([]*AuditSubscriptionMatch, error)
 }
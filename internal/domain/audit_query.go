@@ -0,0 +1,564 @@
+package domain
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 감사 로그 검색 쿼리 언어
+//
+//	action:credential.* AND details.provider:aws
+//	(resource:"POST /api/v1/credentials"* OR action:credential_delete) NOT ip:10.0.0.0/8
+//	prod-cluster details.region:ap-northeast-2
+//
+// 조건 사이에 연산자가 없으면 AND로 결합하고, 우선순위는 NOT > AND > OR 입니다
+// 필드 없는 단어는 액션, 리소스, IP, User-Agent, details 전체에 대한 전문 검색입니다
+// 값 끝의 '*'는 접두사 일치이며, action 값의 '.'은 '_'와 같습니다 (credential.* = credential_*)
+
+// AuditQueryOp: 쿼리 노드 종류
+type AuditQueryOp string
+
+const (
+	AuditQueryAnd  AuditQueryOp = "and"
+	AuditQueryOr   AuditQueryOp = "or"
+	AuditQueryNot  AuditQueryOp = "not"
+	AuditQueryTerm AuditQueryOp = "term" // 필드 조건
+	AuditQueryText AuditQueryOp = "text" // 전문 검색
+)
+
+// 검색 가능한 감사 로그 필드
+const (
+	AuditQueryFieldAction     = "action"
+	AuditQueryFieldResource   = "resource"
+	AuditQueryFieldUserID     = "user_id"
+	AuditQueryFieldIP         = "ip"
+	AuditQueryFieldUserAgent  = "user_agent"
+	AuditQueryFieldSource     = "source"
+	AuditQueryFieldExternalID = "external_id"
+	AuditQueryFieldDetails    = "details" // details.<key>[.<key>...]
+)
+
+// auditQueryFieldAliases: 쿼리에서 허용하는 필드 이름과 표준 이름
+var auditQueryFieldAliases = map[string]string{
+	"action":      AuditQueryFieldAction,
+	"resource":    AuditQueryFieldResource,
+	"user_id":     AuditQueryFieldUserID,
+	"user":        AuditQueryFieldUserID,
+	"ip":          AuditQueryFieldIP,
+	"ip_address":  AuditQueryFieldIP,
+	"user_agent":  AuditQueryFieldUserAgent,
+	"source":      AuditQueryFieldSource,
+	"external_id": AuditQueryFieldExternalID,
+}
+
+const (
+	// MaxAuditQueryLength: 쿼리 문자열 최대 길이
+	MaxAuditQueryLength = 1000
+	// maxAuditQueryTerms: 쿼리에 포함할 수 있는 최대 조건 수
+	maxAuditQueryTerms = 32
+	// maxAuditQueryDepth: 괄호 중첩 최대 깊이
+	maxAuditQueryDepth = 8
+)
+
+// AuditQueryNode: 파싱된 검색 쿼리 트리의 노드
+type AuditQueryNode struct {
+	Op       AuditQueryOp      `json:"op"`
+	Children []*AuditQueryNode `json:"children,omitempty"` // and, or, not
+	Field    string            `json:"field,omitempty"`    // term: 표준 필드 이름
+	Path     []string          `json:"path,omitempty"`     // term: details 하위 키 경로
+	Value    string            `json:"value,omitempty"`
+	Prefix   bool              `json:"prefix,omitempty"` // 값 끝의 '*' (접두사 일치)
+	Phrase   bool              `json:"phrase,omitempty"` // text: 따옴표로 묶은 구문
+}
+
+// String: 노드를 정규화된 쿼리 문자열로 변환합니다
+func (n *AuditQueryNode) String() string {
+	switch n.Op {
+	case AuditQueryAnd, AuditQueryOr:
+		parts := make([]string, 0, len(n.Children))
+		for _, child := range n.Children {
+			s := child.String()
+			if n.Op == AuditQueryAnd && child.Op == AuditQueryOr {
+				s = "(" + s + ")"
+			}
+			parts = append(parts, s)
+		}
+		return strings.Join(parts, " "+strings.ToUpper(string(n.Op))+" ")
+	case AuditQueryNot:
+		s := n.Children[0].String()
+		if n.Children[0].Op == AuditQueryAnd || n.Children[0].Op == AuditQueryOr {
+			s = "(" + s + ")"
+		}
+		return "NOT " + s
+	case AuditQueryTerm:
+		field := n.Field
+		if len(n.Path) > 0 {
+			field += "." + strings.Join(n.Path, ".")
+		}
+		return field + ":" + formatAuditQueryValue(n.Value, n.Prefix)
+	default:
+		if n.Phrase {
+			return quoteAuditQueryValue(n.Value)
+		}
+		return formatAuditQueryValue(n.Value, n.Prefix)
+	}
+}
+
+// formatAuditQueryValue: 값을 쿼리 문자열 표기로 변환합니다
+func formatAuditQueryValue(value string, prefix bool) string {
+	s := value
+	if strings.ContainsAny(value, " \t()\"*") || value == "" || isAuditQueryKeyword(value) {
+		s = quoteAuditQueryValue(value)
+	}
+	if prefix {
+		s += "*"
+	}
+	return s
+}
+
+// quoteAuditQueryValue: 값을 따옴표로 감쌉니다
+func quoteAuditQueryValue(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+// ParseAuditQuery: 검색 쿼리 문자열을 파싱하고 필드 값을 검증합니다
+func ParseAuditQuery(query string) (*AuditQueryNode, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, NewDomainError(ErrCodeValidationFailed, "search query is required", 400)
+	}
+	if len(query) > MaxAuditQueryLength {
+		return nil, NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("search query cannot exceed %d characters", MaxAuditQueryLength), 400)
+	}
+
+	tokens, err := lexAuditQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &auditQueryParser{tokens: tokens}
+	node, err := p.parseOr(0)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, auditQueryError("unexpected %q", p.tokens[p.pos].text)
+	}
+	if p.terms > maxAuditQueryTerms {
+		return nil, auditQueryError("too many conditions (maximum %d)", maxAuditQueryTerms)
+	}
+	return node, nil
+}
+
+// auditQueryError: 쿼리 구문 오류를 생성합니다
+func auditQueryError(format string, args ...interface{}) error {
+	return NewDomainError(ErrCodeValidationFailed, "invalid search query: "+fmt.Sprintf(format, args...), 400)
+}
+
+// auditQueryTokenKind: 토큰 종류
+type auditQueryTokenKind int
+
+const (
+	auditTokenTerm auditQueryTokenKind = iota
+	auditTokenLParen
+	auditTokenRParen
+	auditTokenAnd
+	auditTokenOr
+	auditTokenNot
+)
+
+// auditQueryToken: 쿼리 토큰
+type auditQueryToken struct {
+	kind   auditQueryTokenKind
+	text   string // 원문 (오류 메시지용)
+	field  string // field:value 형식의 필드 부분
+	value  string
+	quoted bool
+}
+
+// lexAuditQuery: 쿼리를 토큰으로 분리합니다
+func lexAuditQuery(query string) ([]auditQueryToken, error) {
+	var tokens []auditQueryToken
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		switch r := runes[i]; {
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			i++
+		case r == '(':
+			tokens = append(tokens, auditQueryToken{kind: auditTokenLParen, text: "("})
+			i++
+		case r == ')':
+			tokens = append(tokens, auditQueryToken{kind: auditTokenRParen, text: ")"})
+			i++
+		case r == '-' && i+1 < len(runes) && (runes[i+1] == '(' || runes[i+1] == '"' || isAuditQueryFieldStart(runes[i+1:])):
+			// 괄호, 따옴표, field: 조건 앞의 '-'는 NOT (-prod 같은 단어와 prod-cluster의 '-'는 그대로 검색)
+			tokens = append(tokens, auditQueryToken{kind: auditTokenNot, text: "-"})
+			i++
+		default:
+			token, next, err := lexAuditQueryTerm(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token)
+			i = next
+		}
+	}
+	return tokens, nil
+}
+
+// lexAuditQueryTerm: 단어, 따옴표 문자열, field:value 조건 하나를 읽습니다
+func lexAuditQueryTerm(runes []rune, start int) (auditQueryToken, int, error) {
+	var builder strings.Builder
+	token := auditQueryToken{kind: auditTokenTerm}
+	i := start
+	for i < len(runes) {
+		r := runes[i]
+		if isAuditQuerySpace(r) || r == '(' || r == ')' {
+			break
+		}
+		if r == '"' {
+			value, next, err := readAuditQueryQuoted(runes, i)
+			if err != nil {
+				return token, 0, err
+			}
+			builder.WriteString(value)
+			token.quoted = true
+			i = next
+			continue
+		}
+		if r == ':' && token.field == "" && !token.quoted {
+			if field := builder.String(); isAuditQueryField(field) {
+				token.field = field
+				builder.Reset()
+				i++
+				continue
+			}
+		}
+		builder.WriteRune(r)
+		i++
+	}
+
+	token.value = builder.String()
+	token.text = string(runes[start:i])
+	if token.field == "" && !token.quoted {
+		switch strings.ToUpper(token.value) {
+		case "AND", "&&":
+			token.kind = auditTokenAnd
+		case "OR", "||":
+			token.kind = auditTokenOr
+		case "NOT":
+			token.kind = auditTokenNot
+		}
+	}
+	return token, i, nil
+}
+
+// readAuditQueryQuoted: 따옴표 문자열을 읽습니다 (\" 와 \\ 이스케이프 지원)
+func readAuditQueryQuoted(runes []rune, start int) (string, int, error) {
+	var builder strings.Builder
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			if i+1 < len(runes) {
+				i++
+				builder.WriteRune(runes[i])
+			}
+		case '"':
+			return builder.String(), i + 1, nil
+		default:
+			builder.WriteRune(runes[i])
+		}
+	}
+	return "", 0, auditQueryError("unterminated quoted string")
+}
+
+// isAuditQueryField: 검색 가능한 필드 이름인지 확인합니다
+func isAuditQueryField(name string) bool {
+	name = strings.ToLower(name)
+	if _, ok := auditQueryFieldAliases[name]; ok {
+		return true
+	}
+	return strings.HasPrefix(name, AuditQueryFieldDetails+".")
+}
+
+// isAuditQueryFieldStart: 위치가 field: 조건으로 시작하는지 확인합니다
+func isAuditQueryFieldStart(runes []rune) bool {
+	for i, r := range runes {
+		if r == ':' {
+			return isAuditQueryField(string(runes[:i]))
+		}
+		if isAuditQuerySpace(r) || r == '(' || r == ')' || r == '"' {
+			return false
+		}
+	}
+	return false
+}
+
+// isAuditQuerySpace: 공백 문자인지 확인합니다
+func isAuditQuerySpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n' || r == '\r'
+}
+
+// isAuditQueryKeyword: 연산자 키워드인지 확인합니다
+func isAuditQueryKeyword(value string) bool {
+	switch strings.ToUpper(value) {
+	case "AND", "OR", "NOT", "&&", "||":
+		return true
+	}
+	return false
+}
+
+// auditQueryParser: 재귀 하강 파서
+type auditQueryParser struct {
+	tokens []auditQueryToken
+	pos    int
+	terms  int
+}
+
+// peek: 현재 토큰을 반환합니다
+func (p *auditQueryParser) peek() *auditQueryToken {
+	if p.pos >= len(p.tokens) {
+		return nil
+	}
+	return &p.tokens[p.pos]
+}
+
+// parseOr: or := and ("OR" and)*
+func (p *auditQueryParser) parseOr(depth int) (*AuditQueryNode, error) {
+	node, err := p.parseAnd(depth)
+	if err != nil {
+		return nil, err
+	}
+	children := []*AuditQueryNode{node}
+	for token := p.peek(); token != nil && token.kind == auditTokenOr; token = p.peek() {
+		p.pos++
+		next, err := p.parseAnd(depth)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, next)
+	}
+	return combineAuditQueryNodes(AuditQueryOr, children), nil
+}
+
+// parseAnd: and := not (["AND"] not)*
+func (p *auditQueryParser) parseAnd(depth int) (*AuditQueryNode, error) {
+	node, err := p.parseNot(depth)
+	if err != nil {
+		return nil, err
+	}
+	children := []*AuditQueryNode{node}
+	for token := p.peek(); token != nil && token.kind != auditTokenOr && token.kind != auditTokenRParen; token = p.peek() {
+		if token.kind == auditTokenAnd {
+			p.pos++
+		}
+		next, err := p.parseNot(depth)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, next)
+	}
+	return combineAuditQueryNodes(AuditQueryAnd, children), nil
+}
+
+// parseNot: not := ("NOT" | "-") not | primary
+func (p *auditQueryParser) parseNot(depth int) (*AuditQueryNode, error) {
+	if token := p.peek(); token != nil && token.kind == auditTokenNot {
+		p.pos++
+		child, err := p.parseNot(depth)
+		if err != nil {
+			return nil, err
+		}
+		return &AuditQueryNode{Op: AuditQueryNot, Children: []*AuditQueryNode{child}}, nil
+	}
+	return p.parsePrimary(depth)
+}
+
+// parsePrimary: primary := "(" or ")" | term
+func (p *auditQueryParser) parsePrimary(depth int) (*AuditQueryNode, error) {
+	token := p.peek()
+	if token == nil {
+		return nil, auditQueryError("unexpected end of query")
+	}
+
+	switch token.kind {
+	case auditTokenLParen:
+		if depth >= maxAuditQueryDepth {
+			return nil, auditQueryError("parentheses are nested too deeply (maximum %d)", maxAuditQueryDepth)
+		}
+		p.pos++
+		node, err := p.parseOr(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.peek(); closing == nil || closing.kind != auditTokenRParen {
+			return nil, auditQueryError("missing closing parenthesis")
+		}
+		p.pos++
+		return node, nil
+	case auditTokenTerm:
+		p.pos++
+		p.terms++
+		return newAuditQueryTerm(token)
+	default:
+		return nil, auditQueryError("unexpected %q", token.text)
+	}
+}
+
+// combineAuditQueryNodes: 자식이 하나면 그대로, 여럿이면 연산 노드로 묶습니다
+func combineAuditQueryNodes(op AuditQueryOp, children []*AuditQueryNode) *AuditQueryNode {
+	if len(children) == 1 {
+		return children[0]
+	}
+	return &AuditQueryNode{Op: op, Children: children}
+}
+
+// newAuditQueryTerm: 토큰을 조건 노드로 변환하고 값을 검증합니다
+func newAuditQueryTerm(token *auditQueryToken) (*AuditQueryNode, error) {
+	value := token.value
+	prefix := false
+	if !token.quoted || strings.HasSuffix(token.text, "*") {
+		if trimmed, ok := strings.CutSuffix(value, "*"); ok {
+			value, prefix = trimmed, true
+		}
+	}
+	if !token.quoted && strings.Contains(value, "*") {
+		return nil, auditQueryError("'*' is only supported at the end of %q", token.text)
+	}
+
+	if token.field == "" {
+		if strings.TrimSpace(value) == "" {
+			return nil, auditQueryError("empty search term")
+		}
+		return &AuditQueryNode{Op: AuditQueryText, Value: value, Prefix: prefix, Phrase: token.quoted && !prefix}, nil
+	}
+
+	node := &AuditQueryNode{Op: AuditQueryTerm, Value: value, Prefix: prefix}
+	name := strings.ToLower(token.field)
+	if strings.HasPrefix(name, AuditQueryFieldDetails+".") {
+		node.Field = AuditQueryFieldDetails
+		// details 키는 대소문자를 구분하므로 원문 그대로 사용
+		node.Path = strings.Split(token.field[len(AuditQueryFieldDetails)+1:], ".")
+		for _, key := range node.Path {
+			if key == "" {
+				return nil, auditQueryError("invalid details path %q", token.field)
+			}
+		}
+	} else {
+		node.Field = auditQueryFieldAliases[name]
+	}
+
+	if value == "" && !prefix {
+		return nil, auditQueryError("missing value for %s", token.field)
+	}
+
+	switch node.Field {
+	case AuditQueryFieldAction:
+		node.Value = strings.ReplaceAll(value, ".", "_")
+	case AuditQueryFieldUserID:
+		if prefix {
+			return nil, auditQueryError("user_id does not support '*'")
+		}
+		if _, err := uuid.Parse(value); err != nil {
+			return nil, auditQueryError("user_id must be a UUID")
+		}
+	case AuditQueryFieldIP:
+		if prefix {
+			return nil, auditQueryError("ip does not support '*'; use CIDR notation instead")
+		}
+		if net.ParseIP(value) == nil {
+			if _, _, err := net.ParseCIDR(value); err != nil {
+				return nil, auditQueryError("ip must be an IP address or CIDR")
+			}
+		}
+	}
+	return node, nil
+}
+
+// AuditSearchRequest: 감사 로그 검색 요청
+type AuditSearchRequest struct {
+	Query     string
+	StartTime *time.Time
+	EndTime   *time.Time
+	Limit     int
+	Offset    int
+}
+
+// AuditSearchResult: 감사 로그 검색 결과
+type AuditSearchResult struct {
+	Logs   []*AuditLog `json:"logs"`
+	Total  int64       `json:"total"`
+	Query  string      `json:"query"` // 정규화된 쿼리
+	Limit  int         `json:"limit"`
+	Offset int         `json:"offset"`
+}
+
+// AuditSearchFilters: 파싱된 쿼리와 기간, 페이지 조건
+type AuditSearchFilters struct {
+	Query  *AuditQueryNode
+	After  *time.Time // created_at > After
+	Before *time.Time // created_at <= Before
+	Limit  int
+	Offset int
+}
+
+// SaveAuditQueryRequest: 검색 쿼리 저장/수정 요청
+type SaveAuditQueryRequest struct {
+	Name        string `json:"name" validate:"required,min=1,max=100"`
+	Description string `json:"description" validate:"max=500"`
+	Query       string `json:"query" validate:"required"`
+	Shared      bool   `json:"shared"` // 다른 관리자에게 공개
+}
+
+// AuditSavedQuery: 저장된 감사 로그 검색 쿼리
+type AuditSavedQuery struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	Name        string    `json:"name" gorm:"not null;size:100"`
+	Description string    `json:"description,omitempty" gorm:"size:500"`
+	Query       string    `json:"query" gorm:"type:text;not null"`
+	OwnerID     uuid.UUID `json:"owner_id" gorm:"type:uuid;not null;index"`
+	Shared      bool      `json:"shared" gorm:"default:false"` // 다른 관리자에게 공개
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+
+	// 조회 시 요청 사용자의 구독 여부
+	Subscribed bool `json:"subscribed" gorm:"-"`
+}
+
+// TableName: AuditSavedQuery의 테이블 이름을 반환합니다
+func (AuditSavedQuery) TableName() string {
+	return "audit_saved_queries"
+}
+
+// AuditQuerySubscription: 저장된 쿼리에 새로 일치하는 감사 로그가 생기면 알림을 받는 구독
+type AuditQuerySubscription struct {
+	ID            uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	SavedQueryID  uuid.UUID  `json:"saved_query_id" gorm:"type:uuid;not null;uniqueIndex:idx_audit_query_subscriptions_query_user"`
+	UserID        uuid.UUID  `json:"user_id" gorm:"type:uuid;not null;uniqueIndex:idx_audit_query_subscriptions_query_user;index"`
+	Priority      string     `json:"priority" gorm:"size:20;default:'medium'"` // 알림 우선순위
+	LastCheckedAt time.Time  `json:"last_checked_at"`                          // 이 시각 이후 기록된 항목부터 확인
+	LastMatchedAt *time.Time `json:"last_matched_at,omitempty"`
+	MatchCount    int64      `json:"match_count"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+
+	SavedQuery *AuditSavedQuery `json:"saved_query,omitempty" gorm:"foreignKey:SavedQueryID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName: AuditQuerySubscription의 테이블 이름을 반환합니다
+func (AuditQuerySubscription) TableName() string {
+	return "audit_query_subscriptions"
+}
+
+// AuditSubscriptionMatch: 구독 쿼리에 새로 일치한 감사 로그
+type AuditSubscriptionMatch struct {
+	Subscription *AuditQuerySubscription `json:"subscription"`
+	Logs         []*AuditLog             `json:"logs"` // 최근 항목 일부
+	Total        int64                   `json:"total"`
+	From         time.Time               `json:"from"`
+	To           time.Time               `json:"to"`
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditQueryRepository defines the interface for saved audit queries and their subscriptions
+type AuditQueryRepository interface {
+	// Saved queries
+	CreateSavedQuery(query *AuditSavedQuery) error
+	GetSavedQuery(id uuid.UUID) (*AuditSavedQuery, error)
+	ListSavedQueries(userID uuid.UUID) ([]*AuditSavedQuery, error) // owned by the user or shared
+	UpdateSavedQuery(query *AuditSavedQuery) error
+	DeleteSavedQuery(id uuid.UUID) error
+
+	// Subscriptions
+	SaveSubscription(subscription *AuditQuerySubscription) error // one per saved query and user
+	GetSubscription(savedQueryID, userID uuid.UUID) (*AuditQuerySubscription, error)
+	ListSubscribedQueryIDs(userID uuid.UUID) (map[uuid.UUID]bool, error)
+	ListSubscriptions() ([]*AuditQuerySubscription, error) // with SavedQuery preloaded
+	UpdateSubscriptionProgress(id uuid.UUID, checkedAt time.Time, matched int64) error
+	DeleteSubscription(savedQueryID, userID uuid.UUID) error
+}
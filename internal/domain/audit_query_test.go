@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseAuditQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string // 정규화된 쿼리
+	}{
+		{"field term", "action:credential_create", "action:credential_create"},
+		{"action dots become underscores", "action:credential.*", "action:credential_*"},
+		{"field alias", "user:6f1c3c8e-4b7a-4a51-9d5e-0c2f0a1b2c3d", "user_id:6f1c3c8e-4b7a-4a51-9d5e-0c2f0a1b2c3d"},
+		{"field name is case insensitive", "ACTION:login", "action:login"},
+		{"implicit and", "action:login source:cloudtrail", "action:login AND source:cloudtrail"},
+		{"and binds tighter than or", "a OR b AND c", "a OR b AND c"},
+		{"parentheses group or inside and", "(a OR b) c", "(a OR b) AND c"},
+		{"not binds tighter than and", "NOT a b", "NOT a AND b"},
+		{"not applies to a group", "NOT (a OR b)", "NOT (a OR b)"},
+		{"dash negates a field term", "-action:login", "NOT action:login"},
+		{"dash negates a group", "-(a b)", "NOT (a AND b)"},
+		{"dash inside a word is literal", "prod-cluster", "prod-cluster"},
+		{"leading dash word is literal", "-prod", "-prod"},
+		{"symbolic operators", "a && b || c", "a AND b OR c"},
+		{"lowercase keywords", "a or b and not c", "a OR b AND NOT c"},
+		{"double negation", "NOT NOT a", "NOT NOT a"},
+		{"nested groups flatten", "((a))", "a"},
+		{"quoted phrase", `"access denied"`, `"access denied"`},
+		{"quoted field value", `resource:"POST /api/v1/credentials"`, `resource:"POST /api/v1/credentials"`},
+		{"quoted prefix value", `resource:"POST /api/v1/credentials"*`, `resource:"POST /api/v1/credentials"*`},
+		{"quoted star is literal", `resource:"a*b"`, `resource:"a*b"`},
+		{"escaped quote", `"say \"hi\""`, `"say \"hi\""`},
+		{"quoted keyword stays a term", `"OR"`, `"OR"`},
+		{"text prefix", "prod*", "prod*"},
+		{"unknown field is text", "foo:bar", "foo:bar"},
+		{"details path", "details.provider:aws", "details.provider:aws"},
+		{"details nested path keeps case", "details.Tags.Env:prod", "details.Tags.Env:prod"},
+		{"details prefix", "details.region:ap-*", "details.region:ap-*"},
+		{"ip address", "ip:10.0.0.1", "ip:10.0.0.1"},
+		{"ip cidr", "ip:10.0.0.0/8", "ip:10.0.0.0/8"},
+		{"ipv6 cidr", "ip_address:fd00::/8", "ip:fd00::/8"},
+		{
+			"documented example",
+			`(resource:"POST /api/v1/credentials"* OR action:credential_delete) NOT ip:10.0.0.0/8`,
+			`(resource:"POST /api/v1/credentials"* OR action:credential_delete) AND NOT ip:10.0.0.0/8`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := ParseAuditQuery(tt.query)
+			if err != nil {
+				t.Fatalf("ParseAuditQuery(%q) error = %v", tt.query, err)
+			}
+			if got := node.String(); got != tt.want {
+				t.Fatalf("ParseAuditQuery(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+
+			// 정규화된 쿼리는 다시 파싱해도 같아야 함
+			reparsed, err := ParseAuditQuery(node.String())
+			if err != nil {
+				t.Fatalf("ParseAuditQuery(%q) error = %v", node.String(), err)
+			}
+			if got := reparsed.String(); got != tt.want {
+				t.Fatalf("reparsed %q = %q, want %q", node.String(), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAuditQueryTree(t *testing.T) {
+	node, err := ParseAuditQuery("a OR b c")
+	if err != nil {
+		t.Fatalf("ParseAuditQuery() error = %v", err)
+	}
+	if node.Op != AuditQueryOr || len(node.Children) != 2 {
+		t.Fatalf("root = %s with %d children, want or with 2", node.Op, len(node.Children))
+	}
+	if and := node.Children[1]; and.Op != AuditQueryAnd || len(and.Children) != 2 {
+		t.Fatalf("second child = %s, want and of b and c", and.Op)
+	}
+
+	node, err = ParseAuditQuery("details.Tags.Env:prod*")
+	if err != nil {
+		t.Fatalf("ParseAuditQuery() error = %v", err)
+	}
+	if node.Field != AuditQueryFieldDetails || strings.Join(node.Path, ".") != "Tags.Env" || node.Value != "prod" || !node.Prefix {
+		t.Fatalf("details term = %+v", node)
+	}
+}
+
+func TestParseAuditQueryErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		message string
+	}{
+		{"empty", "   ", "search query is required"},
+		{"too long", strings.Repeat("a", MaxAuditQueryLength+1), "cannot exceed"},
+		{"unterminated quote", `"abc`, "unterminated quoted string"},
+		{"missing closing parenthesis", "(a OR b", "missing closing parenthesis"},
+		{"unexpected closing parenthesis", "a)", `unexpected ")"`},
+		{"dangling operator", "a AND", "unexpected end of query"},
+		{"leading operator", "OR a", `unexpected "OR"`},
+		{"empty group", "()", `unexpected ")"`},
+		{"star in the middle", "ab*c", "'*' is only supported at the end"},
+		{"missing value", "action:", "missing value for action"},
+		{"invalid user id", "user_id:alice", "user_id must be a UUID"},
+		{"user id prefix", "user_id:6f1c*", "user_id does not support '*'"},
+		{"invalid ip", "ip:10.0.0", "ip must be an IP address or CIDR"},
+		{"ip prefix", "ip:10.0.*", "ip does not support '*'"},
+		{"empty details key", "details..provider:aws", "invalid details path"},
+		{"nested too deeply", strings.Repeat("(", maxAuditQueryDepth+1) + "a" + strings.Repeat(")", maxAuditQueryDepth+1), "nested too deeply"},
+		{"too many conditions", strings.TrimSpace(strings.Repeat("a ", maxAuditQueryTerms+1)), "too many conditions"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseAuditQuery(tt.query)
+			if err == nil {
+				t.Fatalf("ParseAuditQuery(%q) succeeded, want error containing %q", tt.query, tt.message)
+			}
+			domainErr := GetDomainError(err)
+			if domainErr.Code != ErrCodeValidationFailed || !strings.Contains(domainErr.Message, tt.message) {
+				t.Fatalf("ParseAuditQuery(%q) error = %v, want validation error containing %q", tt.query, err, tt.message)
+			}
+		})
+	}
+}
+
+func TestParseAuditQueryDepthLimit(t *testing.T) {
+	query := strings.Repeat("(", maxAuditQueryDepth) + "a" + strings.Repeat(")", maxAuditQueryDepth)
+	if _, err := ParseAuditQuery(query); err != nil {
+		t.Fatalf("ParseAuditQuery() at the depth limit error = %v", err)
+	}
+
+	terms := strings.TrimSpace(strings.Repeat("a ", maxAuditQueryTerms))
+	if _, err := ParseAuditQuery(terms); err != nil {
+		t.Fatalf("ParseAuditQuery() at the term limit error = %v", err)
+	}
+}
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 감사 로그 싱크 유형
+const (
+	AuditSinkTypeSyslog    = "syslog"     // RFC 5424 syslog (TCP/TLS)
+	AuditSinkTypeWebhook   = "webhook"    // HMAC 서명 HTTPS 웹훅
+	AuditSinkTypeSplunkHEC = "splunk_hec" // Splunk HTTP Event Collector
+)
+
+// 감사 로그 싱크 메시지 형식
+const (
+	AuditSinkFormatJSON = "json"
+	AuditSinkFormatCEF  = "cef"
+)
+
+// AuditSinkTopicPrefix: 싱크별 outbox 이벤트 토픽 접두사 (일반 outbox 발행 대상에서 제외)
+const AuditSinkTopicPrefix = "audit.sink."
+
+// AuditSinkEventType: 감사 로그 전달 outbox 이벤트 유형
+const AuditSinkEventType = "audit_log.forward"
+
+// AuditSinkFilter: 싱크로 전달할 감사 로그 조건
+// 패턴은 정확히 일치하거나 끝의 '*'로 접두사 일치합니다
+type AuditSinkFilter struct {
+	Actions        []string `json:"actions,omitempty"`         // 비어 있으면 모든 액션
+	ExcludeActions []string `json:"exclude_actions,omitempty"` // 제외할 액션
+	Resources      []string `json:"resources,omitempty"`       // 비어 있으면 모든 리소스 (예: "POST /api/v1/credentials*")
+}
+
+// Matches: 감사 로그가 필터 조건을 만족하는지 확인합니다
+func (f AuditSinkFilter) Matches(log *AuditLog) bool {
+	if matchAuditSinkPatterns(f.ExcludeActions, log.Action) {
+		return false
+	}
+	if len(f.Actions) > 0 && !matchAuditSinkPatterns(f.Actions, log.Action) {
+		return false
+	}
+	if len(f.Resources) > 0 && !matchAuditSinkPatterns(f.Resources, log.Resource) {
+		return false
+	}
+	return true
+}
+
+// matchAuditSinkPatterns: 값이 패턴 중 하나와 일치하는지 확인합니다
+func matchAuditSinkPatterns(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(value, prefix) {
+				return true
+			}
+		} else if pattern == value {
+			return true
+		}
+	}
+	return false
+}
+
+// AuditSinkRoute: 감사 로그 기록 시 outbox 이벤트를 생성할 싱크
+type AuditSinkRoute struct {
+	Name   string
+	Filter AuditSinkFilter
+}
+
+// AuditSinkTopic: 싱크의 outbox 토픽을 반환합니다
+func AuditSinkTopic(sinkName string) string {
+	return AuditSinkTopicPrefix + sinkName
+}
+
+// NewAuditSinkEvent: 감사 로그를 싱크로 전달하기 위한 outbox 이벤트를 생성합니다
+func NewAuditSinkEvent(sinkName string, log *AuditLog) (*OutboxEvent, error) {
+	entry := *log
+	entry.User = nil
+
+	payload, err := json.Marshal(&entry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode audit log for sink %s: %w", sinkName, err)
+	}
+	data := make(JSONBMap)
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return nil, fmt.Errorf("failed to encode audit log for sink %s: %w", sinkName, err)
+	}
+
+	return &OutboxEvent{
+		ID:        uuid.New(),
+		Topic:     AuditSinkTopic(sinkName),
+		EventType: AuditSinkEventType,
+		Data:      data,
+		Status:    OutboxStatusPending,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// AuditLogFromSinkEvent: outbox 이벤트에서 감사 로그를 복원합니다
+func AuditLogFromSinkEvent(event *OutboxEvent) (*AuditLog, error) {
+	payload, err := json.Marshal(event.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode audit sink event %s: %w", event.ID, err)
+	}
+	var log AuditLog
+	if err := json.Unmarshal(payload, &log); err != nil {
+		return nil, fmt.Errorf("failed to decode audit sink event %s: %w", event.ID, err)
+	}
+	return &log, nil
+}
+
+// AuditSinkStatus: 싱크별 전달 상태 지표
+type AuditSinkStatus struct {
+	Name                string          `json:"name"`
+	Type                string          `json:"type"`
+	Format              string          `json:"format"`
+	Target              string          `json:"target"`
+	Filter              AuditSinkFilter `json:"filter"`
+	Healthy             bool            `json:"healthy"`
+	Pending             int64           `json:"pending"`
+	Delivered           int64           `json:"delivered"`
+	Failed              int64           `json:"failed"` // 실패한 전송 시도 수 (항목은 재시도를 위해 대기열에 남음)
+	ConsecutiveFailures int             `json:"consecutive_failures"`
+	LastAttemptAt       *time.Time      `json:"last_attempt_at,omitempty"`
+	LastDeliveredAt     *time.Time      `json:"last_delivered_at,omitempty"`
+	LastFailureAt       *time.Time      `json:"last_failure_at,omitempty"`
+	LastError           string          `json:"last_error,omitempty"`
+}
@@ -0,0 +1,382 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 자동화 액션 종류
+const (
+	AutomationActionNotify    = "notify"    // 워크스페이스 멤버와 채팅 채널에 알림 전송
+	AutomationActionWebhook   = "webhook"   // 등록된 워크스페이스 웹훅으로 이벤트 전송
+	AutomationActionOperation = "operation" // SkyClust 작업 실행 (VM 시작/중지/재시작)
+)
+
+// 자동화 작업 (operation 액션)
+const (
+	AutomationOperationVMStart   = "vm.start"
+	AutomationOperationVMStop    = "vm.stop"
+	AutomationOperationVMRestart = "vm.restart"
+)
+
+// 알림 액션 수신자
+const (
+	AutomationRecipientsAdmins  = "admins"  // 워크스페이스 소유자와 관리자
+	AutomationRecipientsMembers = "members" // 워크스페이스 전체 멤버
+	AutomationRecipientsUsers   = "users"   // user_ids에 지정한 멤버만
+)
+
+// 자동화 실행 상태
+const (
+	AutomationExecutionRunning     = "running"      // 액션 실행 중
+	AutomationExecutionSucceeded   = "succeeded"    // 모든 액션 성공
+	AutomationExecutionPartial     = "partial"      // 일부 액션 실패
+	AutomationExecutionFailed      = "failed"       // 모든 액션 실패
+	AutomationExecutionRateLimited = "rate_limited" // 실행 한도 초과로 액션을 실행하지 않음
+)
+
+const (
+	// MaxAutomationEventTypes: 규칙 하나에 등록할 수 있는 최대 이벤트 패턴 수
+	MaxAutomationEventTypes = 32
+	// MaxAutomationActions: 규칙 하나에 등록할 수 있는 최대 액션 수
+	MaxAutomationActions = 10
+	// MaxAutomationConditionLength: 조건식 최대 길이
+	MaxAutomationConditionLength = 4096
+	// DefaultAutomationRateLimit, DefaultAutomationRateWindow: 실행 한도 기본값 (1시간에 10회)
+	DefaultAutomationRateLimit  = 10
+	DefaultAutomationRateWindow = 3600
+	// maxAutomationRateLimit, min/maxAutomationRateWindow: 실행 한도 설정 범위
+	maxAutomationRateLimit     = 1000
+	minAutomationRateWindow    = 60
+	maxAutomationRateWindow    = 7 * 24 * 3600
+	maxAutomationTemplateSize  = 2000
+	maxAutomationNotifyUserIDs = 50
+)
+
+// AutomationRule: 워크스페이스 이벤트에 반응하여 액션을 실행하는 자동화 규칙
+// 이벤트 패턴은 웹훅과 같은 NATS subject 와일드카드를 따르며, 조건식은 이벤트(event, data)에 대해 평가됩니다
+// 조건식 문법은 서비스에서 컴파일하여 검증합니다
+type AutomationRule struct {
+	ID                uuid.UUID         `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	WorkspaceID       string            `json:"workspace_id" gorm:"type:uuid;not null;index"`
+	Name              string            `json:"name" gorm:"not null;size:100"`
+	Description       string            `json:"description,omitempty" gorm:"size:500"`
+	Enabled           bool              `json:"enabled" gorm:"not null"`
+	EventTypes        StringList        `json:"event_types" gorm:"type:jsonb;not null"`
+	Condition         string            `json:"condition,omitempty" gorm:"type:text"` // 비어 있으면 항상 실행
+	Actions           AutomationActions `json:"actions" gorm:"type:jsonb;not null"`
+	RateLimit         int               `json:"rate_limit" gorm:"not null;default:10"`            // 기간 내 최대 실행 횟수
+	RateWindowSeconds int               `json:"rate_window_seconds" gorm:"not null;default:3600"` // 실행 한도 기간 (초)
+	LastTriggeredAt   *time.Time        `json:"last_triggered_at,omitempty"`
+	LastError         string            `json:"last_error,omitempty" gorm:"size:1000"` // 마지막 조건식 평가 오류
+	LastErrorAt       *time.Time        `json:"last_error_at,omitempty"`
+	CreatedBy         *uuid.UUID        `json:"created_by,omitempty" gorm:"type:uuid"` // 액션은 생성자 권한으로 실행
+	CreatedAt         time.Time         `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt         time.Time         `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName: AutomationRule의 테이블 이름을 반환합니다
+func (AutomationRule) TableName() string {
+	return "automation_rules"
+}
+
+// Validate: 자동화 규칙 정의를 검증합니다 (조건식과 템플릿 문법은 제외)
+func (r *AutomationRule) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return NewDomainError(ErrCodeValidationFailed, "automation rule name is required", 400)
+	}
+	if len(r.Name) > 100 {
+		return NewDomainError(ErrCodeValidationFailed, "automation rule name must be at most 100 characters", 400)
+	}
+	if len(r.Description) > 500 {
+		return NewDomainError(ErrCodeValidationFailed, "automation rule description must be at most 500 characters", 400)
+	}
+	if len(r.EventTypes) == 0 {
+		return NewDomainError(ErrCodeValidationFailed, "at least one event type is required", 400)
+	}
+	if len(r.EventTypes) > MaxAutomationEventTypes {
+		return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("an automation rule can match at most %d event types", MaxAutomationEventTypes), 400)
+	}
+	for _, pattern := range r.EventTypes {
+		if err := ValidateEventPattern(pattern); err != nil {
+			return err
+		}
+	}
+	if len(r.Condition) > MaxAutomationConditionLength {
+		return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("condition must be at most %d characters", MaxAutomationConditionLength), 400)
+	}
+	if len(r.Actions) == 0 {
+		return NewDomainError(ErrCodeValidationFailed, "at least one action is required", 400)
+	}
+	if len(r.Actions) > MaxAutomationActions {
+		return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("an automation rule can have at most %d actions", MaxAutomationActions), 400)
+	}
+	for i := range r.Actions {
+		if err := r.Actions[i].Validate(); err != nil {
+			if domainErr, ok := err.(*DomainError); ok {
+				domainErr.Message = fmt.Sprintf("actions[%d]: %s", i, domainErr.Message)
+			}
+			return err
+		}
+	}
+	if r.RateLimit < 1 || r.RateLimit > maxAutomationRateLimit {
+		return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("rate_limit must be between 1 and %d", maxAutomationRateLimit), 400)
+	}
+	if r.RateWindowSeconds < minAutomationRateWindow || r.RateWindowSeconds > maxAutomationRateWindow {
+		return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("rate_window_seconds must be between %d and %d", minAutomationRateWindow, maxAutomationRateWindow), 400)
+	}
+	return nil
+}
+
+// Matches: 규칙이 이벤트 타입에 반응하는지 확인합니다
+func (r *AutomationRule) Matches(eventType string) bool {
+	for _, pattern := range r.EventTypes {
+		if MatchEventPattern(pattern, eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+// RateWindow: 실행 한도 기간을 반환합니다
+func (r *AutomationRule) RateWindow() time.Duration {
+	return time.Duration(r.RateWindowSeconds) * time.Second
+}
+
+// AutomationAction: 규칙이 실행하는 액션
+// title, message에는 {{ 식 }} 형태로 이벤트 값을 넣을 수 있고, target은 대상 ID를 반환하는 식입니다 (예: data.vm_id)
+type AutomationAction struct {
+	Type string `json:"type"` // notify, webhook, operation
+
+	// notify
+	Title      string   `json:"title,omitempty"`
+	Message    string   `json:"message,omitempty"`
+	Priority   string   `json:"priority,omitempty"`   // low, medium, high, urgent (기본값 medium)
+	Category   string   `json:"category,omitempty"`   // 기본값 system
+	Recipients string   `json:"recipients,omitempty"` // admins(기본값), members, users
+	UserIDs    []string `json:"user_ids,omitempty"`   // 추가 수신자 (워크스페이스 멤버)
+
+	// webhook
+	WebhookID string `json:"webhook_id,omitempty"`
+
+	// operation
+	Operation string `json:"operation,omitempty"` // vm.start, vm.stop, vm.restart
+	Target    string `json:"target,omitempty"`
+}
+
+// Validate: 액션 정의를 검증합니다
+func (a *AutomationAction) Validate() error {
+	switch a.Type {
+	case AutomationActionNotify:
+		if strings.TrimSpace(a.Title) == "" {
+			return NewDomainError(ErrCodeValidationFailed, "notify action requires a title", 400)
+		}
+		if len(a.Title) > maxAutomationTemplateSize || len(a.Message) > maxAutomationTemplateSize {
+			return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("title and message must be at most %d characters", maxAutomationTemplateSize), 400)
+		}
+		switch a.Priority {
+		case "", "low", "medium", "high", "urgent":
+		default:
+			return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("unsupported priority: %s (supported: low, medium, high, urgent)", a.Priority), 400)
+		}
+		switch a.Recipients {
+		case "", AutomationRecipientsAdmins, AutomationRecipientsMembers:
+		case AutomationRecipientsUsers:
+			if len(a.UserIDs) == 0 {
+				return NewDomainError(ErrCodeValidationFailed, "recipients \"users\" requires user_ids", 400)
+			}
+		default:
+			return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("unsupported recipients: %s (supported: admins, members, users)", a.Recipients), 400)
+		}
+		if len(a.UserIDs) > maxAutomationNotifyUserIDs {
+			return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("a notify action can name at most %d users", maxAutomationNotifyUserIDs), 400)
+		}
+		for _, userID := range a.UserIDs {
+			if _, err := uuid.Parse(userID); err != nil {
+				return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("invalid user id: %s", userID), 400)
+			}
+		}
+	case AutomationActionWebhook:
+		if _, err := uuid.Parse(a.WebhookID); err != nil {
+			return NewDomainError(ErrCodeValidationFailed, "webhook action requires a valid webhook_id", 400)
+		}
+	case AutomationActionOperation:
+		switch a.Operation {
+		case AutomationOperationVMStart, AutomationOperationVMStop, AutomationOperationVMRestart:
+		default:
+			return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("unsupported operation: %s (supported: vm.start, vm.stop, vm.restart)", a.Operation), 400)
+		}
+		if strings.TrimSpace(a.Target) == "" {
+			return NewDomainError(ErrCodeValidationFailed, "operation action requires a target expression", 400)
+		}
+	default:
+		return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("unsupported action type: %s (supported: notify, webhook, operation)", a.Type), 400)
+	}
+	return nil
+}
+
+// AutomationActions: JSONB로 저장되는 액션 목록
+type AutomationActions []AutomationAction
+
+// Value: driver.Valuer 인터페이스를 구현합니다
+func (a AutomationActions) Value() (driver.Value, error) {
+	if a == nil {
+		return json.Marshal([]AutomationAction{})
+	}
+	return json.Marshal([]AutomationAction(a))
+}
+
+// Scan: sql.Scanner 인터페이스를 구현합니다
+func (a *AutomationActions) Scan(value interface{}) error {
+	if value == nil {
+		*a = nil
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unsupported automation actions type %T", value)
+		}
+		bytes = []byte(str)
+	}
+	return json.Unmarshal(bytes, (*[]AutomationAction)(a))
+}
+
+// AutomationEvent: 자동화 규칙을 평가할 플랫폼 이벤트
+type AutomationEvent struct {
+	Type        string                 `json:"type"` // messaging/topics.go의 subject 또는 도메인 이벤트 타입
+	WorkspaceID string                 `json:"workspace_id,omitempty"`
+	UserID      string                 `json:"user_id,omitempty"`
+	Data        map[string]interface{} `json:"data"`
+	Timestamp   int64                  `json:"timestamp"`
+}
+
+// AutomationExecution: 자동화 규칙 실행 기록
+type AutomationExecution struct {
+	ID            uuid.UUID               `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	RuleID        uuid.UUID               `json:"rule_id" gorm:"type:uuid;not null;index:idx_automation_executions_rule,priority:1"`
+	WorkspaceID   string                  `json:"workspace_id" gorm:"type:uuid;not null;index"`
+	EventType     string                  `json:"event_type" gorm:"not null;size:255"`
+	EventData     JSONBMap                `json:"event_data" gorm:"type:jsonb"`
+	Status        string                  `json:"status" gorm:"not null;size:20"`
+	ActionResults AutomationActionResults `json:"action_results" gorm:"type:jsonb"`
+	Error         string                  `json:"error,omitempty" gorm:"type:text"`
+	DurationMs    int64                   `json:"duration_ms,omitempty"`
+	FinishedAt    *time.Time              `json:"finished_at,omitempty"`
+	CreatedAt     time.Time               `json:"created_at" gorm:"autoCreateTime;index:idx_automation_executions_rule,priority:2,sort:desc"`
+}
+
+// TableName: AutomationExecution의 테이블 이름을 반환합니다
+func (AutomationExecution) TableName() string {
+	return "automation_executions"
+}
+
+// Finish: 액션 결과로 실행 상태를 결정합니다
+func (e *AutomationExecution) Finish(results []AutomationActionResult, at time.Time) {
+	e.ActionResults = results
+	e.FinishedAt = &at
+	e.DurationMs = at.Sub(e.CreatedAt).Milliseconds()
+
+	failed := 0
+	for _, result := range results {
+		if result.Error != "" {
+			failed++
+		}
+	}
+	switch {
+	case failed == 0:
+		e.Status = AutomationExecutionSucceeded
+	case failed == len(results):
+		e.Status = AutomationExecutionFailed
+		e.Error = fmt.Sprintf("all %d actions failed", failed)
+	default:
+		e.Status = AutomationExecutionPartial
+		e.Error = fmt.Sprintf("%d of %d actions failed", failed, len(results))
+	}
+}
+
+// AutomationActionResult: 액션 하나의 실행 결과
+type AutomationActionResult struct {
+	Type   string `json:"type"`
+	Detail string `json:"detail,omitempty"` // 생성된 알림 제목, 웹훅 전송 ID, 작업 대상 등
+	Error  string `json:"error,omitempty"`
+}
+
+// AutomationActionResults: JSONB로 저장되는 액션 결과 목록
+type AutomationActionResults []AutomationActionResult
+
+// Value: driver.Valuer 인터페이스를 구현합니다
+func (r AutomationActionResults) Value() (driver.Value, error) {
+	if r == nil {
+		return json.Marshal([]AutomationActionResult{})
+	}
+	return json.Marshal([]AutomationActionResult(r))
+}
+
+// Scan: sql.Scanner 인터페이스를 구현합니다
+func (r *AutomationActionResults) Scan(value interface{}) error {
+	if value == nil {
+		*r = nil
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unsupported automation action results type %T", value)
+		}
+		bytes = []byte(str)
+	}
+	return json.Unmarshal(bytes, (*[]AutomationActionResult)(r))
+}
+
+// AutomationExecutionFilter: 실행 기록 조회 조건
+type AutomationExecutionFilter struct {
+	Status string
+	Limit  int
+	Offset int
+}
+
+// CreateAutomationRuleRequest: 자동화 규칙 생성 요청
+type CreateAutomationRuleRequest struct {
+	Name              string             `json:"name" validate:"required,min=1,max=100"`
+	Description       string             `json:"description,omitempty" validate:"max=500"`
+	EventTypes        []string           `json:"event_types" validate:"required,min=1"`
+	Condition         string             `json:"condition,omitempty"`
+	Actions           []AutomationAction `json:"actions" validate:"required,min=1"`
+	RateLimit         *int               `json:"rate_limit,omitempty"`
+	RateWindowSeconds *int               `json:"rate_window_seconds,omitempty"`
+	Enabled           *bool              `json:"enabled,omitempty"`
+}
+
+// UpdateAutomationRuleRequest: 자동화 규칙 수정 요청
+type UpdateAutomationRuleRequest struct {
+	Name              *string            `json:"name,omitempty"`
+	Description       *string            `json:"description,omitempty"`
+	EventTypes        []string           `json:"event_types,omitempty"`
+	Condition         *string            `json:"condition,omitempty"`
+	Actions           []AutomationAction `json:"actions,omitempty"`
+	RateLimit         *int               `json:"rate_limit,omitempty"`
+	RateWindowSeconds *int               `json:"rate_window_seconds,omitempty"`
+	Enabled           *bool              `json:"enabled,omitempty"`
+}
+
+// TestAutomationRuleRequest: 자동화 규칙 시험 요청 (액션은 실행하지 않음)
+type TestAutomationRuleRequest struct {
+	EventType string                 `json:"event_type" validate:"required"`
+	Data      map[string]interface{} `json:"data"`
+}
+
+// AutomationTestResult: 자동화 규칙 시험 결과
+type AutomationTestResult struct {
+	Matched   bool                     `json:"matched"`   // 이벤트 타입이 규칙의 패턴과 일치하는지
+	Condition bool                     `json:"condition"` // 조건식 평가 결과
+	Error     string                   `json:"error,omitempty"`
+	Actions   []AutomationActionResult `json:"actions,omitempty"` // 실행될 액션 (템플릿과 대상 식 적용 결과)
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AutomationRepository defines the interface for automation rules and their execution history
+type AutomationRepository interface {
+	// Rules
+	CreateRule(ctx context.Context, rule *AutomationRule) error
+	GetRule(ctx context.Context, id uuid.UUID) (*AutomationRule, error)
+	ListRules(ctx context.Context, workspaceID string) ([]*AutomationRule, error)
+	ListEnabledRules(ctx context.Context, workspaceID string) ([]*AutomationRule, error)
+	UpdateRule(ctx context.Context, rule *AutomationRule) error
+	DeleteRule(ctx context.Context, id uuid.UUID) error
+	// RecordRuleError stores the latest condition evaluation error (an empty message clears it)
+	RecordRuleError(ctx context.Context, id uuid.UUID, message string, at time.Time) error
+	// DisableRule turns the rule off and records why in last_error
+	DisableRule(ctx context.Context, id uuid.UUID, reason string, at time.Time) error
+
+	// Executions
+	// ReserveExecution stores the execution as running, or as rate_limited when the rule already ran limit times
+	// within window; reservations of the same rule are serialized so the limit holds across replicas
+	ReserveExecution(ctx context.Context, execution *AutomationExecution, limit int, window time.Duration) (bool, error)
+	UpdateExecution(ctx context.Context, execution *AutomationExecution) error
+	GetExecution(ctx context.Context, id uuid.UUID) (*AutomationExecution, error)
+	ListExecutions(ctx context.Context, ruleID uuid.UUID, filter AutomationExecutionFilter) ([]*AutomationExecution, int64, error)
+	// DeleteExecutionsBefore removes executions created before the cutoff
+	DeleteExecutionsBefore(ctx context.Context, cutoff time.Time) (int64, error)
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AutomationService defines the interface for workspace automation rules
+type AutomationService interface {
+	// Rule management
+	ListRules(ctx context.Context, workspaceID uuid.UUID) ([]*AutomationRule, error)
+	GetRule(ctx context.Context, workspaceID, ruleID uuid.UUID) (*AutomationRule, error)
+	CreateRule(ctx context.Context, actorID, workspaceID uuid.UUID, req CreateAutomationRuleRequest) (*AutomationRule, error)
+	UpdateRule(ctx context.Context, actorID, workspaceID, ruleID uuid.UUID, req UpdateAutomationRuleRequest) (*AutomationRule, error)
+	DeleteRule(ctx context.Context, actorID, workspaceID, ruleID uuid.UUID) error
+	// TestRule evaluates a rule against a sample event and renders its actions without running them
+	TestRule(ctx context.Context, workspaceID, ruleID uuid.UUID, req TestAutomationRuleRequest) (*AutomationTestResult, error)
+
+	// Execution history
+	ListExecutions(ctx context.Context, workspaceID, ruleID uuid.UUID, filter AutomationExecutionFilter) ([]*AutomationExecution, int64, error)
+	GetExecution(ctx context.Context, workspaceID, ruleID, executionID uuid.UUID) (*AutomationExecution, error)
+
+	// Dispatch
+	// HandleEvent evaluates the enabled rules of the event's workspace and starts the actions of every matching rule
+	HandleEvent(ctx context.Context, event AutomationEvent) (int, error)
+	// PurgeExecutions removes executions older than the retention period
+	PurgeExecutions(ctx context.Context, retention time.Duration) (int64, error)
+}
@@ -0,0 +1,185 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 채팅 채널 종류
+const (
+	ChatChannelSlack   = "slack"
+	ChatChannelTeams   = "teams"
+	ChatChannelDiscord = "discord"
+)
+
+// Slack 채널 전송 방식
+const (
+	ChatModeWebhook = "webhook" // Incoming Webhook URL
+	ChatModeBot     = "bot"     // 봇 토큰 + chat.postMessage
+)
+
+// SlackAckActionID: Slack 확인 버튼의 action_id (인터랙션 콜백에서 사용)
+const SlackAckActionID = "skyclust_acknowledge"
+
+// MaxChatChannelsPerWorkspace: 워크스페이스 하나에 등록할 수 있는 최대 채팅 채널 수
+const MaxChatChannelsPerWorkspace = 20
+
+// ChatChannel: 워크스페이스 알림을 전달하는 채팅 채널 (Slack, Microsoft Teams, Discord)
+// 카테고리와 최소 우선순위가 일치하는 워크스페이스 알림만 전송합니다
+type ChatChannel struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	WorkspaceID string    `json:"workspace_id" gorm:"type:uuid;not null;index"`
+	Name        string    `json:"name" gorm:"not null;size:100"`
+	Type        string    `json:"type" gorm:"not null;size:20"` // slack, teams, discord
+	Mode        string    `json:"mode" gorm:"not null;size:20"` // webhook, bot (bot은 Slack만)
+
+	// 전송 대상 (암호화 저장, 응답에 포함하지 않음)
+	WebhookURL    string `json:"-" gorm:"type:text"`
+	BotToken      string `json:"-" gorm:"type:text"`
+	SigningSecret string `json:"-" gorm:"type:text"` // Slack 앱 서명 비밀키 (확인 버튼 콜백 검증)
+	SlackChannel  string `json:"slack_channel,omitempty" gorm:"size:100"`
+
+	// 라우팅: 비어 있으면 모든 카테고리
+	Categories  StringList `json:"categories" gorm:"type:jsonb"`
+	MinPriority string     `json:"min_priority" gorm:"not null;size:20;default:'low'"`
+
+	Enabled    bool       `json:"enabled" gorm:"not null"`
+	LastSentAt *time.Time `json:"last_sent_at,omitempty"`
+	LastError  string     `json:"last_error,omitempty" gorm:"size:1000"`
+	CreatedBy  *uuid.UUID `json:"created_by,omitempty" gorm:"type:uuid"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+
+	// Interactive: 확인 버튼 콜백을 받을 수 있는지 여부 (조회 시 계산)
+	Interactive bool `json:"interactive" gorm:"-"`
+}
+
+// TableName: ChatChannel의 테이블 이름을 반환합니다
+func (ChatChannel) TableName() string {
+	return "chat_channels"
+}
+
+// Validate: 채팅 채널 정의를 검증하고 전송 방식을 결정합니다
+func (c *ChatChannel) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return NewDomainError(ErrCodeValidationFailed, "chat channel name is required", 400)
+	}
+
+	switch c.Type {
+	case ChatChannelSlack:
+		switch {
+		case c.BotToken != "":
+			if !strings.HasPrefix(c.BotToken, "xoxb-") {
+				return NewDomainError(ErrCodeValidationFailed, "slack bot token must start with xoxb-", 400)
+			}
+			if strings.TrimSpace(c.SlackChannel) == "" {
+				return NewDomainError(ErrCodeValidationFailed, "slack_channel is required with a bot token", 400)
+			}
+			c.Mode = ChatModeBot
+		case c.WebhookURL != "":
+			c.Mode = ChatModeWebhook
+		default:
+			return NewDomainError(ErrCodeValidationFailed, "slack channels need a webhook_url or a bot_token", 400)
+		}
+	case ChatChannelTeams, ChatChannelDiscord:
+		if c.BotToken != "" || c.SigningSecret != "" {
+			return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("%s channels only support webhook_url", c.Type), 400)
+		}
+		if c.WebhookURL == "" {
+			return NewDomainError(ErrCodeValidationFailed, "webhook_url is required", 400)
+		}
+		c.Mode = ChatModeWebhook
+	default:
+		return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("unsupported chat channel type: %s", c.Type), 400)
+	}
+
+	if c.Mode == ChatModeWebhook {
+		if err := ValidateWebhookURL(c.WebhookURL); err != nil {
+			return err
+		}
+	}
+
+	if c.MinPriority == "" {
+		c.MinPriority = NotificationPriorityLow
+	}
+	if !IsValidNotificationPriority(c.MinPriority) {
+		return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("invalid min_priority: %s", c.MinPriority), 400)
+	}
+	return nil
+}
+
+// Accepts: 알림의 카테고리와 우선순위가 채널 라우팅과 일치하는지 확인합니다
+func (c *ChatChannel) Accepts(category, priority string) bool {
+	if NotificationPriorityRank(priority) < NotificationPriorityRank(c.MinPriority) {
+		return false
+	}
+	if len(c.Categories) == 0 {
+		return true
+	}
+	for _, allowed := range c.Categories {
+		if allowed == category {
+			return true
+		}
+	}
+	return false
+}
+
+// ChatMessage: 채팅 채널로 전송하는 알림 메시지
+type ChatMessage struct {
+	NotificationID string // 알림 그룹 ID
+	WorkspaceName  string
+	Type           string // info, warning, error, success
+	Title          string
+	Message        string
+	Category       string
+	Priority       string
+	CreatedAt      time.Time
+
+	// AckURL: 확인 페이지 링크 (공개 URL이 설정된 경우)
+	AckURL string
+	// AckValue: Slack 인터랙티브 버튼 값 (서명된 확인 토큰)
+	AckValue string
+	// AcknowledgedBy: 확인한 사용자 (설정 시 확인 버튼 대신 확인 정보를 표시)
+	AcknowledgedBy string
+}
+
+// ChatSender: 채팅 채널 종류별 전송 구현
+type ChatSender interface {
+	Send(ctx context.Context, channel *ChatChannel, message *ChatMessage) error
+}
+
+// CreateChatChannelRequest: 채팅 채널 생성 요청 DTO
+type CreateChatChannelRequest struct {
+	Name          string   `json:"name" validate:"required,min=1,max=100"`
+	Type          string   `json:"type" validate:"required,oneof=slack teams discord"`
+	WebhookURL    string   `json:"webhook_url,omitempty"`
+	BotToken      string   `json:"bot_token,omitempty"`
+	SigningSecret string   `json:"signing_secret,omitempty"`
+	SlackChannel  string   `json:"slack_channel,omitempty"`
+	Categories    []string `json:"categories,omitempty"`
+	MinPriority   string   `json:"min_priority,omitempty"`
+	Enabled       *bool    `json:"enabled,omitempty"`
+}
+
+// UpdateChatChannelRequest: 채팅 채널 수정 요청 DTO (비밀 값은 지정한 경우에만 교체)
+type UpdateChatChannelRequest struct {
+	Name          *string  `json:"name,omitempty"`
+	WebhookURL    *string  `json:"webhook_url,omitempty"`
+	BotToken      *string  `json:"bot_token,omitempty"`
+	SigningSecret *string  `json:"signing_secret,omitempty"`
+	SlackChannel  *string  `json:"slack_channel,omitempty"`
+	Categories    []string `json:"categories,omitempty"`
+	MinPriority   *string  `json:"min_priority,omitempty"`
+	Enabled       *bool    `json:"enabled,omitempty"`
+}
+
+// SlackInteraction: Slack 인터랙티브 콜백 요청 (서명 검증용 원본 포함)
+type SlackInteraction struct {
+	Timestamp string // X-Slack-Request-Timestamp
+	Signature string // X-Slack-Signature
+	Body      []byte // application/x-www-form-urlencoded 원본 본문
+}
@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChatChannelRepository defines the interface for workspace chat channel persistence
+// Webhook URLs, bot tokens and signing secrets are encrypted at rest
+type ChatChannelRepository interface {
+	Create(ctx context.Context, channel *ChatChannel) error
+	GetByID(ctx context.Context, id uuid.UUID) (*ChatChannel, error)
+	ListByWorkspace(ctx context.Context, workspaceID string) ([]*ChatChannel, error)
+	ListEnabledByWorkspace(ctx context.Context, workspaceID string) ([]*ChatChannel, error)
+	Update(ctx context.Context, channel *ChatChannel) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// RecordResult stores the outcome of the latest send (errMessage is empty on success)
+	RecordResult(ctx context.Context, id uuid.UUID, sentAt time.Time, errMessage string) error
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ChatChannelService defines the interface for workspace chat channels (Slack, Microsoft Teams, Discord)
+type ChatChannelService interface {
+	// Channel management
+	ListChannels(ctx context.Context, workspaceID uuid.UUID) ([]*ChatChannel, error)
+	GetChannel(ctx context.Context, workspaceID, channelID uuid.UUID) (*ChatChannel, error)
+	CreateChannel(ctx context.Context, actorID, workspaceID uuid.UUID, req CreateChatChannelRequest) (*ChatChannel, error)
+	UpdateChannel(ctx context.Context, actorID, workspaceID, channelID uuid.UUID, req UpdateChatChannelRequest) (*ChatChannel, error)
+	DeleteChannel(ctx context.Context, actorID, workspaceID, channelID uuid.UUID) error
+	// TestChannel sends a test message to the channel and returns the send error, if any
+	TestChannel(ctx context.Context, actorID, workspaceID, channelID uuid.UUID) error
+
+	// Delivery
+	// Dispatch posts a workspace notification to every enabled channel whose routing matches it
+	// recipientIDs are the notified users; the post is skipped when none of them allows chat delivery
+	Dispatch(ctx context.Context, notification *Notification, recipientIDs []string) error
+
+	// Acknowledgement callbacks
+	// AcknowledgeByToken acknowledges the notification referenced by a signed acknowledgement token
+	AcknowledgeByToken(ctx context.Context, token, acknowledgedBy string) (*Notification, error)
+	// HandleSlackInteraction verifies and processes a Slack interactive button callback
+	HandleSlackInteraction(ctx context.Context, interaction SlackInteraction) error
+}
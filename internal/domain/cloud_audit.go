@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditSourceCloud: 클라우드 감사 로그에서 수집한 항목의 출처
+const AuditSourceCloud = "cloud"
+
+// CloudAuditManagedKeys: SkyClust가 생성한 리소스 식별자를 담는 감사 로그 상세 키
+var CloudAuditManagedKeys = []string{
+	"vpc_id", "subnet_id", "security_group_id",
+	"cluster_id", "cluster_name", "node_group_name", "node_pool_name",
+	"instance_id", "vm_id",
+}
+
+// CloudAuditCursor: 자격증명별 클라우드 감사 로그 수집 위치와 상태
+type CloudAuditCursor struct {
+	ID             uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	CredentialID   uuid.UUID  `json:"credential_id" gorm:"type:uuid;not null;uniqueIndex"`
+	WorkspaceID    uuid.UUID  `json:"workspace_id" gorm:"type:uuid;not null;index"`
+	Provider       string     `json:"provider" gorm:"not null;size:20"`
+	CollectedUntil *time.Time `json:"collected_until,omitempty"` // 이 시각까지의 이벤트는 수집 완료
+	LastRunAt      *time.Time `json:"last_run_at,omitempty"`
+	LastError      string     `json:"last_error,omitempty" gorm:"size:1000"`
+	IngestedCount  int64      `json:"ingested_count"`
+	OutOfBandCount int64      `json:"out_of_band_count"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// TableName: CloudAuditCursor의 테이블 이름을 반환합니다
+func (CloudAuditCursor) TableName() string {
+	return "cloud_audit_cursors"
+}
+
+// CloudActivityResource: 클라우드 이벤트가 대상으로 한 리소스
+type CloudActivityResource struct {
+	Type string `json:"type,omitempty"`
+	Name string `json:"name"`
+}
+
+// CloudActivityEvent: 프로바이더별 감사 이벤트를 정규화한 표현
+type CloudActivityEvent struct {
+	EventID       string
+	EventTime     time.Time
+	EventName     string // RunInstances, v1.compute.instances.insert 등
+	EventSource   string // ec2.amazonaws.com, compute.googleapis.com 등
+	Region        string
+	Principal     string // IAM ARN 또는 서비스 계정/사용자 이메일
+	PrincipalType string
+	AccessKeyID   string
+	SourceIP      string
+	UserAgent     string
+	Resources     []CloudActivityResource
+	ErrorCode     string
+	ErrorMessage  string
+}
+
+// CloudOutOfBandChange: SkyClust 밖에서 관리 대상 리소스를 변경한 이벤트
+type CloudOutOfBandChange struct {
+	AuditLogID uuid.UUID `json:"audit_log_id"`
+	EventName  string    `json:"event_name"`
+	Principal  string    `json:"principal"`
+	Resources  []string  `json:"resources"`
+	EventTime  time.Time `json:"event_time"`
+}
+
+// CloudAuditIngestResult: 자격증명 하나에 대한 수집 결과
+type CloudAuditIngestResult struct {
+	CredentialID uuid.UUID              `json:"credential_id"`
+	WorkspaceID  uuid.UUID              `json:"workspace_id"`
+	Provider     string                 `json:"provider"`
+	From         time.Time              `json:"from"`
+	To           time.Time              `json:"to"`
+	Fetched      int                    `json:"fetched"`
+	Ingested     int                    `json:"ingested"`
+	Duplicates   int                    `json:"duplicates"`
+	Truncated    bool                   `json:"truncated"` // 회당 최대 이벤트 수에 도달해 나머지는 다음 수집에서 처리
+	OutOfBand    []CloudOutOfBandChange `json:"out_of_band"`
+}
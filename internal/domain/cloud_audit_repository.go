@@ -0,0 +1,12 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// CloudAuditRepository: 클라우드 감사 로그 수집 위치 저장소
+type CloudAuditRepository interface {
+	GetCursor(credentialID uuid.UUID) (*CloudAuditCursor, error) // 없으면 nil
+	SaveCursor(cursor *CloudAuditCursor) error
+	ListCursors(workspaceID *uuid.UUID) ([]*CloudAuditCursor, error)
+}
@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// CloudAuditService: 클라우드 감사 로그 수집 서비스
+type CloudAuditService interface {
+	IngestCredential(ctx context.Context, credential *Credential) (*CloudAuditIngestResult, error)
+	IngestWorkspaceCredential(ctx context.Context, workspaceID, credentialID uuid.UUID) (*CloudAuditIngestResult, error)
+	ListIngestionStatus(ctx context.Context, workspaceID *uuid.UUID) ([]*CloudAuditCursor, error)
+}
@@ -12,38 +12,115 @@ type Credential struct {
 	WorkspaceID   uuid.UUID              `json:"workspace_id" gorm:"type:uuid;not null;index"`
 	Provider      string                 `json:"provider" gorm:"not null;size:20;index"` // aws, gcp, openstack, azure
 	Name          string                 `json:"name" gorm:"not null;size:100"`
-	EncryptedData []byte                 `json:"-" gorm:"type:bytea;not null"` // 암호화된 자격증명 데이터
+	EncryptedData []byte                 `json:"-" gorm:"type:bytea;not null"`                // 암호화된 자격증명 데이터 (외부 저장소 참조 시 비어 있음)
+	SecretStore   string                 `json:"secret_store,omitempty" gorm:"size:32;index"` // 외부 비밀 저장소 이름 (vault, file)
+	SecretPath    string                 `json:"secret_path,omitempty" gorm:"size:512"`       // 외부 비밀 저장소 내 비밀 경로
+	KeyVersion    string                 `json:"key_version,omitempty" gorm:"size:32;index"`  // 데이터 키를 감싼 마스터 키 버전 (비어 있으면 legacy)
 	IsActive      bool                   `json:"is_active" gorm:"default:true"`
 	MaskedData    map[string]interface{} `json:"masked_data,omitempty" gorm:"-"`             // 마스킹된 데이터 (응답 전용)
 	CreatedBy     uuid.UUID              `json:"created_by" gorm:"type:uuid;not null;index"` // 생성한 사용자
-	CreatedAt     time.Time              `json:"created_at"`
-	UpdatedAt     time.Time              `json:"updated_at"`
+
+	// 상태 점검 (CredentialHealthWorker가 주기적으로 갱신)
+	HealthStatus     string     `json:"health_status" gorm:"size:20;default:unknown;index"` // unknown, healthy, degraded, unhealthy
+	HealthMessage    string     `json:"health_message,omitempty" gorm:"size:1000"`
+	VerifiedIdentity string     `json:"verified_identity,omitempty" gorm:"size:255"` // 점검 시 확인된 주체 (ARN, 서비스 계정 등)
+	LastVerifiedAt   *time.Time `json:"last_verified_at,omitempty" gorm:"index"`
+	PermissionGaps   []string   `json:"permission_gaps,omitempty" gorm:"serializer:json;type:jsonb"` // 누락된 권한
+	KeyCreatedAt     *time.Time `json:"key_created_at,omitempty"`                                    // 액세스 키/서비스 계정 키 생성 시각
+	KeyAgeDays       *int       `json:"key_age_days,omitempty" gorm:"-"`                             // 키 생성 후 경과 일수 (응답 전용)
+	RotationDue      bool       `json:"rotation_due" gorm:"default:false"`                           // 키 교체 주기 초과 여부
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 
 	// 관계
 	Workspace *Workspace `json:"workspace,omitempty" gorm:"foreignKey:WorkspaceID;constraint:OnDelete:CASCADE"`
 }
 
+// CredentialKeyVersionCount: 마스터 키 버전별 자격증명 수
+type CredentialKeyVersionCount struct {
+	KeyVersion string `json:"key_version"`
+	Count      int64  `json:"count"`
+	Primary    bool   `json:"primary"`    // 새 암호화에 사용되는 키인지 여부
+	Configured bool   `json:"configured"` // 현재 설정된 키인지 여부 (false면 복호화 불가)
+}
+
+// CredentialEncryptionStatus: 자격증명 암호화 키 현황
+type CredentialEncryptionStatus struct {
+	PrimaryKeyID        string                      `json:"primary_key_id"`
+	ConfiguredKeys      []string                    `json:"configured_keys"`
+	Versions            []CredentialKeyVersionCount `json:"versions"`
+	Total               int64                       `json:"total"`
+	PendingReencryption int64                       `json:"pending_reencryption"`
+}
+
+// CredentialReencryptionFailure: 재암호화에 실패한 자격증명
+type CredentialReencryptionFailure struct {
+	CredentialID uuid.UUID `json:"credential_id"`
+	KeyVersion   string    `json:"key_version"`
+	Error        string    `json:"error"`
+}
+
+// CredentialReencryptionResult: 재암호화 작업 결과
+type CredentialReencryptionResult struct {
+	PrimaryKeyID string                          `json:"primary_key_id"`
+	Scanned      int                             `json:"scanned"`
+	Reencrypted  int                             `json:"reencrypted"`
+	Skipped      int                             `json:"skipped"` // 작업 중 다른 요청으로 변경된 자격증명
+	Failed       int                             `json:"failed"`
+	Failures     []CredentialReencryptionFailure `json:"failures,omitempty"`
+	StartedAt    time.Time                       `json:"started_at"`
+	CompletedAt  time.Time                       `json:"completed_at"`
+}
+
+// 재암호화 작업 상태
+const (
+	CredentialReencryptionRunning   = "running"
+	CredentialReencryptionCompleted = "completed"
+	CredentialReencryptionFailed    = "failed"
+)
+
+// CredentialReencryptionJob: 백그라운드에서 실행되는 재암호화 작업과 진행 상황
+type CredentialReencryptionJob struct {
+	ID          uuid.UUID                    `json:"id"`
+	Status      string                       `json:"status"` // running, completed, failed
+	BatchSize   int                          `json:"batch_size"`
+	RequestedBy *uuid.UUID                   `json:"requested_by,omitempty"`
+	Result      CredentialReencryptionResult `json:"result"` // 실행 중에는 지금까지의 진행 상황
+	Error       string                       `json:"error,omitempty"`
+	CreatedAt   time.Time                    `json:"created_at"`
+	UpdatedAt   time.Time                    `json:"updated_at"`
+}
+
 // CreateCredentialRequest: 자격증명 생성 요청 DTO
 type CreateCredentialRequest struct {
 	WorkspaceID string                 `json:"workspace_id" validate:"required,uuid"`
 	Provider    string                 `json:"provider" validate:"required,oneof=aws gcp openstack azure"`
 	Name        string                 `json:"name" validate:"required,min=1,max=100"`
-	Data        map[string]interface{} `json:"data" validate:"required"`
+	Data        map[string]interface{} `json:"data" validate:"required_without=SecretRef"`
+	SecretRef   *SecretReference       `json:"secret_ref,omitempty" validate:"omitempty"` // Data 대신 외부 비밀 저장소 참조
 }
 
 // UpdateCredentialRequest: 자격증명 업데이트 요청 DTO
 type UpdateCredentialRequest struct {
-	Name *string                `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
-	Data map[string]interface{} `json:"data,omitempty"`
+	Name      *string                `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+	SecretRef *SecretReference       `json:"secret_ref,omitempty" validate:"omitempty"` // 외부 비밀 저장소 참조로 전환
 }
 
 // CredentialData: 다양한 제공자별 자격증명 데이터 구조
 type CredentialData struct {
 	// AWS 자격증명 필드
-	AccessKey string `json:"access_key,omitempty"`
-	SecretKey string `json:"secret_key,omitempty"`
-	Region    string `json:"region,omitempty"`
-	RoleARN   string `json:"role_arn,omitempty"`
+	AuthType        string          `json:"auth_type,omitempty"` // access_key(기본값), assume_role
+	AccessKey       string          `json:"access_key,omitempty"`
+	SecretKey       string          `json:"secret_key,omitempty"`
+	SessionToken    string          `json:"session_token,omitempty"`
+	Region          string          `json:"region,omitempty"`
+	RoleARN         string          `json:"role_arn,omitempty"`
+	ExternalID      string          `json:"external_id,omitempty"`
+	SessionName     string          `json:"session_name,omitempty"`
+	DurationSeconds int32           `json:"duration_seconds,omitempty"`
+	RoleChain       []AWSAssumeRole `json:"role_chain,omitempty"` // role_arn 이전에 순서대로 assume 할 중간 역할
 
 	// GCP 자격증명 필드
 	ProjectID       string `json:"project_id,omitempty"`
@@ -63,6 +140,19 @@ type CredentialData struct {
 	SubscriptionID string `json:"subscription_id,omitempty"`
 }
 
+// AWS 인증 방식
+const (
+	AWSAuthTypeAccessKey  = "access_key"
+	AWSAuthTypeAssumeRole = "assume_role"
+)
+
+// AWSAssumeRole: AssumeRole 체인의 한 단계
+type AWSAssumeRole struct {
+	RoleARN     string `json:"role_arn"`
+	ExternalID  string `json:"external_id,omitempty"`
+	SessionName string `json:"session_name,omitempty"`
+}
+
 // MaskString: 문자열을 마스킹하여 처음과 끝의 일부 문자만 표시합니다
 func MaskString(s string, showFirst, showLast int) string {
 	if len(s) <= showFirst+showLast {
@@ -97,8 +187,11 @@ func MaskCredentialData(data map[string]interface{}) map[string]interface{} {
 		case "private_key_id":
 			// GCP Private Key ID: 처음 4자와 마지막 4자만 표시
 			masked[key] = MaskString(strValue, 4, 4)
-		case "credentials_json":
-			// JSON: 전혀 표시하지 않음
+		case "external_id":
+			// AssumeRole External ID: 처음 2자와 마지막 2자만 표시
+			masked[key] = MaskString(strValue, 2, 2)
+		case "credentials_json", "session_token":
+			// JSON, 세션 토큰: 전혀 표시하지 않음
 			masked[key] = "****"
 		default:
 			// 민감하지 않은 필드: 그대로 표시
@@ -0,0 +1,49 @@
+package domain
+
+import "time"
+
+// 자격증명 상태
+const (
+	CredentialHealthUnknown   = "unknown"
+	CredentialHealthHealthy   = "healthy"
+	CredentialHealthDegraded  = "degraded"  // 인증은 성공했지만 권한 누락 또는 키 교체 필요
+	CredentialHealthUnhealthy = "unhealthy" // 인증 실패 (폐기/만료/잘못된 자격증명)
+)
+
+// CredentialKeyRotationAge: 액세스 키 교체 권장 주기
+const CredentialKeyRotationAge = 90 * 24 * time.Hour
+
+// CredentialHealth: 자격증명 상태 점검 결과
+type CredentialHealth struct {
+	Status         string     `json:"status"`
+	Message        string     `json:"message,omitempty"`
+	Identity       string     `json:"identity,omitempty"`
+	PermissionGaps []string   `json:"permission_gaps"`
+	KeyCreatedAt   *time.Time `json:"key_created_at,omitempty"`
+	RotationDue    bool       `json:"rotation_due"`
+	VerifiedAt     time.Time  `json:"verified_at"`
+
+	// 점검 전 상태 (알림 판단용)
+	PreviousStatus      string `json:"previous_status,omitempty"`
+	PreviousRotationDue bool   `json:"-"`
+}
+
+// Failed: 이번 점검에서 새로 실패 상태가 되었는지 확인합니다
+func (h *CredentialHealth) Failed() bool {
+	return h.Status == CredentialHealthUnhealthy && h.PreviousStatus != CredentialHealthUnhealthy
+}
+
+// RotationBecameDue: 이번 점검에서 새로 키 교체 주기를 초과했는지 확인합니다
+func (h *CredentialHealth) RotationBecameDue() bool {
+	return h.RotationDue && !h.PreviousRotationDue
+}
+
+// SetKeyAge: 키 생성 시각으로 응답용 경과 일수를 계산합니다
+func (c *Credential) SetKeyAge(now time.Time) {
+	if c.KeyCreatedAt == nil {
+		c.KeyAgeDays = nil
+		return
+	}
+	days := int(now.Sub(*c.KeyCreatedAt).Hours() / 24)
+	c.KeyAgeDays = &days
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 권한 분석 대상 SkyClust 기능
+const (
+	CredentialFeatureKubernetes = "kubernetes" // EKS / GKE
+	CredentialFeatureNetwork    = "network"    // VPC, 서브넷, 보안 그룹 / 방화벽
+	CredentialFeatureCost       = "cost"       // Cost Explorer / Cloud Billing
+)
+
+// 기능별 권한 수준
+const (
+	FeatureAccessFull     = "full"
+	FeatureAccessReadOnly = "read_only"
+	FeatureAccessNone     = "none"
+)
+
+// 권한 분석 방식
+const (
+	PermissionAnalysisAWSSimulate = "iam:SimulatePrincipalPolicy"
+	PermissionAnalysisGCPTest     = "projects.testIamPermissions"
+)
+
+// 생성되는 정책 문서 형식
+const (
+	PolicyFormatAWSIAMPolicy  = "aws_iam_policy"
+	PolicyFormatGCPCustomRole = "gcp_custom_role"
+)
+
+// FeatureAction: 기능에 필요한 프로바이더 권한
+type FeatureAction struct {
+	Action   string `json:"action"`
+	ReadOnly bool   `json:"read_only"` // 조회 전용 권한 여부
+}
+
+// CredentialFeatureStatus: 기능별 권한 분석 결과
+type CredentialFeatureStatus struct {
+	Feature     string   `json:"feature"`
+	Description string   `json:"description"`
+	Enabled     bool     `json:"enabled"` // 모든 권한 보유
+	Access      string   `json:"access"`  // full, read_only, none
+	Granted     []string `json:"granted"`
+	Missing     []string `json:"missing"`
+}
+
+// CredentialPermissionReport: 자격증명의 최소 권한 분석 결과
+type CredentialPermissionReport struct {
+	CredentialID   uuid.UUID                 `json:"credential_id"`
+	Provider       string                    `json:"provider"`
+	Principal      string                    `json:"principal"`
+	Method         string                    `json:"method"`
+	Features       []CredentialFeatureStatus `json:"features"`
+	MissingActions []string                  `json:"missing_actions"`
+	AnalyzedAt     time.Time                 `json:"analyzed_at"`
+}
+
+// CredentialPolicyDocument: 부여해야 할 최소 권한 정책 문서
+type CredentialPolicyDocument struct {
+	CredentialID uuid.UUID       `json:"credential_id"`
+	Provider     string          `json:"provider"`
+	Format       string          `json:"format"` // aws_iam_policy, gcp_custom_role
+	Features     []string        `json:"features"`
+	MissingOnly  bool            `json:"missing_only"`
+	Actions      []string        `json:"actions"`
+	Document     json.RawMessage `json:"document"`
+}
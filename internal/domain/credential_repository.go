@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -13,4 +15,16 @@ type CredentialRepository interface {
 	Update(credential *Credential) error
 	Delete(id uuid.UUID) error
 	DeleteByWorkspaceID(workspaceID uuid.UUID) error
+
+	// Key version management (envelope encryption)
+	CountByKeyVersion() (map[string]int64, error)
+	ListNotOnKeyVersion(keyVersion string, afterID uuid.UUID, limit int) ([]*Credential, error)
+	UpdateEncryptedData(id uuid.UUID, previous, encryptedData []byte, keyVersion string) (bool, error)
+
+	// Health checks
+	ListDueForVerification(verifiedBefore time.Time, limit int) ([]*Credential, error)
+	UpdateHealth(id uuid.UUID, health *CredentialHealth) error
+
+	// Cloud audit ingestion
+	ListActiveByProviders(providers []string) ([]*Credential, error)
 }
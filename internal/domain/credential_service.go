@@ -15,5 +15,22 @@ type CredentialService interface {
 	DeleteCredential(ctx context.Context, workspaceID, credentialID uuid.UUID) error
 	EncryptCredentialData(ctx context.Context, data map[string]interface{}) ([]byte, error)
 	DecryptCredentialData(ctx context.Context, encryptedData []byte) (map[string]interface{}, error)
+	ResolveCredentialData(ctx context.Context, credential *Credential) (map[string]interface{}, error)
 	GetCredentialByIDDirect(ctx context.Context, credentialID uuid.UUID) (*Credential, error)
+
+	// Envelope encryption key management (admin)
+	GetEncryptionStatus(ctx context.Context) (*CredentialEncryptionStatus, error)
+	StartReencryption(ctx context.Context, actorID *uuid.UUID, batchSize int) (*CredentialReencryptionJob, error)
+	GetReencryptionJob(ctx context.Context, jobID uuid.UUID) (*CredentialReencryptionJob, error)
+
+	// Health checks
+	VerifyCredential(ctx context.Context, workspaceID, credentialID uuid.UUID) (*Credential, error)
+	CheckCredentialHealth(ctx context.Context, credential *Credential) (*CredentialHealth, error)
+
+	// Least-privilege analysis
+	AnalyzeCredentialPermissions(ctx context.Context, workspaceID, credentialID uuid.UUID) (*CredentialPermissionReport, error)
+	GenerateCredentialPolicy(ctx context.Context, workspaceID, credentialID uuid.UUID, features []string, missingOnly bool) (*CredentialPolicyDocument, error)
+
+	// Short-lived credential vending
+	VendCredential(ctx context.Context, workspaceID, credentialID, requesterID uuid.UUID, req CredentialVendRequest) (*VendedCredential, error)
 }
@@ -0,0 +1,61 @@
+package domain
+
+import "time"
+
+// 발급 자격증명 권한 수준
+const (
+	VendAccessReadOnly = "read_only"
+	VendAccessFull     = "full"
+)
+
+// 발급 자격증명 기본/최대 유효 시간 (초)
+const (
+	DefaultVendDurationSeconds = 3600
+	MinVendDurationSeconds     = 900
+	MaxVendDurationSeconds     = 43200
+	MaxGCPVendDurationSeconds  = 3600 // generateAccessToken 기본 최대 수명
+)
+
+// 발급 자격증명 출력 형식 (VendedCredential.Formats 키)
+const (
+	VendFormatEnv          = "env"
+	VendFormatAWSConfigure = "aws_configure"
+	VendFormatGcloud       = "gcloud"
+)
+
+// CredentialVendRequest: 단기 자격증명 발급 요청
+type CredentialVendRequest struct {
+	Access          string   `json:"access,omitempty" validate:"omitempty,oneof=read_only full"` // 기본값 read_only
+	Features        []string `json:"features,omitempty"`                                         // 비어 있으면 역할이 허용하는 모든 기능
+	DurationSeconds int32    `json:"duration_seconds,omitempty" validate:"omitempty,min=900,max=43200"`
+}
+
+// VendedAWSCredentials: STS 세션 자격증명
+type VendedAWSCredentials struct {
+	AccessKeyID     string `json:"access_key_id"`
+	SecretAccessKey string `json:"secret_access_key"`
+	SessionToken    string `json:"session_token"`
+	Region          string `json:"region,omitempty"`
+}
+
+// VendedGCPToken: 서비스 계정 가장(impersonation) 액세스 토큰
+type VendedGCPToken struct {
+	AccessToken    string   `json:"access_token"`
+	ProjectID      string   `json:"project_id"`
+	ServiceAccount string   `json:"service_account"`
+	Scopes         []string `json:"scopes"`
+}
+
+// VendedCredential: 최종 사용자에게 발급된 단기 자격증명
+type VendedCredential struct {
+	CredentialID    string                `json:"credential_id"`
+	Provider        string                `json:"provider"`
+	Access          string                `json:"access"`
+	Features        []string              `json:"features"`
+	Principal       string                `json:"principal"` // 발급된 세션의 주체 (역할/사용자 ARN, 서비스 계정)
+	DurationSeconds int32                 `json:"duration_seconds"`
+	ExpiresAt       time.Time             `json:"expires_at"`
+	AWS             *VendedAWSCredentials `json:"aws,omitempty"`
+	GCP             *VendedGCPToken       `json:"gcp,omitempty"`
+	Formats         map[string]string     `json:"formats"` // env, aws_configure, gcloud
+}
@@ -6,8 +6,11 @@ import (
 
 // Notification: 알림을 나타내는 도메인 엔티티
 type Notification struct {
-	ID        string     `json:"id" gorm:"primaryKey"`
-	UserID    string     `json:"user_id" gorm:"not null;index"`
+	ID          string `json:"id" gorm:"primaryKey"`
+	UserID      string `json:"user_id" gorm:"not null;index"`
+	WorkspaceID string `json:"workspace_id,omitempty" gorm:"index"` // 설정 시 워크스페이스 채팅 채널로도 전송
+	// GroupID: 한 번의 전송으로 여러 사용자에게 생성된 알림을 묶는 ID (확인 처리 단위)
+	GroupID   string     `json:"group_id,omitempty" gorm:"index"`
 	Type      string     `json:"type" gorm:"not null"` // info, warning, error, success
 	Title     string     `json:"title" gorm:"not null"`
 	Message   string     `json:"message" gorm:"not null"`
@@ -17,6 +20,55 @@ type Notification struct {
 	Data      string     `json:"data"` // JSON metadata
 	CreatedAt time.Time  `json:"created_at"`
 	ReadAt    *time.Time `json:"read_at,omitempty"`
+
+	// 확인(acknowledge) 정보: 앱 또는 채팅 채널의 확인 버튼으로 기록
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty"`
+	AcknowledgedBy string     `json:"acknowledged_by,omitempty"`
+
+	// 전달 정보: 알림 설정(방해 금지 시간, 다이제스트)에 따라 결정
+	// DedupKey: 중복 판단 키 (비어 있으면 유형, 카테고리, 제목, 본문으로 계산)
+	DedupKey string `json:"dedup_key,omitempty" gorm:"size:255;index"`
+	// DuplicateCount: 중복 억제 기간 내에 같은 알림이 반복된 횟수
+	DuplicateCount int        `json:"duplicate_count" gorm:"default:0"`
+	DeliveryStatus string     `json:"delivery_status" gorm:"size:20;default:'delivered';index"` // delivered, deferred, digest, digested
+	DeliverAfter   *time.Time `json:"deliver_after,omitempty" gorm:"index"`                     // 방해 금지 시간이 끝나는 시각
+
+	// 에스컬레이션 정보: 긴급 알림이 제한 시간 내에 확인되지 않으면 보조 수신자에게 전달
+	EscalateTo  string     `json:"escalate_to,omitempty"`
+	EscalateAt  *time.Time `json:"escalate_at,omitempty" gorm:"index"`
+	EscalatedAt *time.Time `json:"escalated_at,omitempty"`
+}
+
+// Notification priorities
+const (
+	NotificationPriorityLow    = "low"
+	NotificationPriorityMedium = "medium"
+	NotificationPriorityHigh   = "high"
+	NotificationPriorityUrgent = "urgent"
+)
+
+// NotificationPriorityRank: 우선순위 비교용 순위를 반환합니다 (알 수 없는 값은 medium)
+func NotificationPriorityRank(priority string) int {
+	switch priority {
+	case NotificationPriorityLow:
+		return 1
+	case NotificationPriorityHigh:
+		return 3
+	case NotificationPriorityUrgent:
+		return 4
+	default:
+		return 2
+	}
+}
+
+// IsValidNotificationPriority: 지원하는 우선순위인지 확인합니다
+func IsValidNotificationPriority(priority string) bool {
+	switch priority {
+	case NotificationPriorityLow, NotificationPriorityMedium, NotificationPriorityHigh, NotificationPriorityUrgent:
+		return true
+	default:
+		return false
+	}
 }
 
 // NotificationPreferences: 알림 설정을 나타내는 도메인 엔티티
@@ -27,6 +79,8 @@ type NotificationPreferences struct {
 	PushEnabled    bool   `json:"push_enabled" gorm:"default:true"`
 	BrowserEnabled bool   `json:"browser_enabled" gorm:"default:true"`
 	InAppEnabled   bool   `json:"in_app_enabled" gorm:"default:true"`
+	// ChatEnabled: 워크스페이스 채팅 채널(Slack, Teams, Discord)로의 전송 허용 여부
+	ChatEnabled bool `json:"chat_enabled" gorm:"default:true"`
 
 	// 카테고리별 설정
 	SystemNotifications   bool `json:"system_notifications" gorm:"default:true"`
@@ -44,11 +98,59 @@ type NotificationPreferences struct {
 	QuietHoursStart string `json:"quiet_hours_start"` // HH:MM format
 	QuietHoursEnd   string `json:"quiet_hours_end"`   // HH:MM format
 	Timezone        string `json:"timezone" gorm:"default:'UTC'"`
+	// Locale: 템플릿 알림에 사용할 언어 (en, ko)
+	Locale string `json:"locale" gorm:"size:10;default:'en'"`
+
+	// 다이제스트 설정: 낮은 우선순위 알림을 모아 이메일로 전송 (none, hourly, daily)
+	DigestFrequency string     `json:"digest_frequency" gorm:"size:10;default:'none'"`
+	LastDigestAt    *time.Time `json:"last_digest_at,omitempty"`
+
+	// 에스컬레이션 설정: 확인되지 않은 긴급 알림을 전달할 보조 수신자와 대기 시간(분)
+	EscalationUserID       string `json:"escalation_user_id,omitempty"`
+	EscalationDelayMinutes int    `json:"escalation_delay_minutes" gorm:"default:15"`
 
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// AllowsChat: 카테고리와 우선순위 설정이 채팅 채널 전송을 허용하는지 확인합니다
+func (p *NotificationPreferences) AllowsChat(category, priority string) bool {
+	return p.ChatEnabled && p.Allows(category, priority)
+}
+
+// Allows: 카테고리와 우선순위 설정이 알림 수신을 허용하는지 확인합니다
+func (p *NotificationPreferences) Allows(category, priority string) bool {
+	switch category {
+	case "system":
+		if !p.SystemNotifications {
+			return false
+		}
+	case "vm":
+		if !p.VMNotifications {
+			return false
+		}
+	case "cost":
+		if !p.CostNotifications {
+			return false
+		}
+	case "security":
+		if !p.SecurityNotifications {
+			return false
+		}
+	}
+
+	switch priority {
+	case NotificationPriorityLow:
+		return p.LowPriorityEnabled
+	case NotificationPriorityHigh:
+		return p.HighPriorityEnabled
+	case NotificationPriorityUrgent:
+		return p.UrgentPriorityEnabled
+	default:
+		return p.MediumPriorityEnabled
+	}
+}
+
 // NotificationStats: 알림 통계를 나타내는 도메인 엔티티
 type NotificationStats struct {
 	TotalNotifications  int `json:"total_notifications"`
@@ -89,6 +191,7 @@ type UpdateNotificationPreferencesRequest struct {
 	PushEnabled           *bool  `json:"push_enabled"`
 	BrowserEnabled        *bool  `json:"browser_enabled"`
 	InAppEnabled          *bool  `json:"in_app_enabled"`
+	ChatEnabled           *bool  `json:"chat_enabled"`
 	SystemNotifications   *bool  `json:"system_notifications"`
 	VMNotifications       *bool  `json:"vm_notifications"`
 	CostNotifications     *bool  `json:"cost_notifications"`
@@ -100,4 +203,9 @@ type UpdateNotificationPreferencesRequest struct {
 	QuietHoursStart       string `json:"quiet_hours_start"`
 	QuietHoursEnd         string `json:"quiet_hours_end"`
 	Timezone              string `json:"timezone"`
+	Locale                string `json:"locale" validate:"omitempty,oneof=en ko"`
+	DigestFrequency       string `json:"digest_frequency" validate:"omitempty,oneof=none hourly daily"`
+	// EscalationUserID: 빈 문자열을 보내면 에스컬레이션을 해제합니다
+	EscalationUserID       *string `json:"escalation_user_id"`
+	EscalationDelayMinutes *int    `json:"escalation_delay_minutes" validate:"omitempty,min=1,max=1440"`
 }
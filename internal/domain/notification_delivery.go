@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Notification delivery statuses
+const (
+	NotificationDeliveryDelivered = "delivered" // 즉시 전달됨
+	NotificationDeliveryDeferred  = "deferred"  // 방해 금지 시간이 끝나면 전달
+	NotificationDeliveryDigest    = "digest"    // 다음 다이제스트 이메일에 포함될 예정
+	NotificationDeliveryDigested  = "digested"  // 다이제스트 이메일로 전달됨
+)
+
+// Notification digest frequencies
+const (
+	NotificationDigestNone   = "none"
+	NotificationDigestHourly = "hourly"
+	NotificationDigestDaily  = "daily"
+)
+
+// DefaultEscalationDelay: 에스컬레이션 대기 시간 기본값
+const DefaultEscalationDelay = 15 * time.Minute
+
+// NotificationDedupKey: 알림의 중복 판단 키를 반환합니다
+// 호출자가 지정한 키가 없으면 유형, 카테고리, 제목, 본문의 해시를 사용합니다
+func NotificationDedupKey(notification *Notification) string {
+	if key := strings.TrimSpace(notification.DedupKey); key != "" {
+		return key
+	}
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		notification.Type,
+		notification.Category,
+		notification.Title,
+		notification.Message,
+	}, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// ValidateDelivery: 방해 금지 시간, 시간대, 다이제스트, 에스컬레이션 설정을 검증합니다
+func (p *NotificationPreferences) ValidateDelivery() error {
+	if (p.QuietHoursStart == "") != (p.QuietHoursEnd == "") {
+		return NewDomainError(ErrCodeValidationFailed, "quiet_hours_start and quiet_hours_end must be set together", 400)
+	}
+	if p.QuietHoursStart != "" {
+		if _, err := parseClock(p.QuietHoursStart); err != nil {
+			return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("invalid quiet_hours_start: %s (expected HH:MM)", p.QuietHoursStart), 400)
+		}
+		if _, err := parseClock(p.QuietHoursEnd); err != nil {
+			return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("invalid quiet_hours_end: %s (expected HH:MM)", p.QuietHoursEnd), 400)
+		}
+	}
+	if p.Timezone != "" {
+		if _, err := time.LoadLocation(p.Timezone); err != nil {
+			return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("invalid timezone: %s", p.Timezone), 400)
+		}
+	}
+	switch p.DigestFrequency {
+	case "", NotificationDigestNone, NotificationDigestHourly, NotificationDigestDaily:
+	default:
+		return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("unsupported digest_frequency: %s (supported: none, hourly, daily)", p.DigestFrequency), 400)
+	}
+	if p.EscalationUserID != "" && p.EscalationUserID == p.UserID {
+		return NewDomainError(ErrCodeValidationFailed, "escalation_user_id must be a different user", 400)
+	}
+	if p.EscalationDelayMinutes < 0 || p.EscalationDelayMinutes > 24*60 {
+		return NewDomainError(ErrCodeValidationFailed, "escalation_delay_minutes must be between 1 and 1440", 400)
+	}
+	return nil
+}
+
+// QuietHoursUntil: at이 방해 금지 시간에 속하면 방해 금지 시간이 끝나는 시각을 반환합니다
+// 시작이 종료보다 늦으면(예: 22:00-07:00) 자정을 넘기는 구간으로 처리합니다
+func (p *NotificationPreferences) QuietHoursUntil(at time.Time) (time.Time, bool) {
+	start, err := parseClock(p.QuietHoursStart)
+	if err != nil {
+		return time.Time{}, false
+	}
+	end, err := parseClock(p.QuietHoursEnd)
+	if err != nil || start == end {
+		return time.Time{}, false
+	}
+
+	local := at.In(p.location())
+	now := local.Hour()*60 + local.Minute()
+	endAt := func(days int) time.Time {
+		return time.Date(local.Year(), local.Month(), local.Day()+days, end/60, end%60, 0, 0, local.Location())
+	}
+
+	if start < end {
+		if now >= start && now < end {
+			return endAt(0), true
+		}
+		return time.Time{}, false
+	}
+
+	// 자정을 넘기는 구간
+	if now >= start {
+		return endAt(1), true
+	}
+	if now < end {
+		return endAt(0), true
+	}
+	return time.Time{}, false
+}
+
+// DigestInterval: 다이제스트 전송 주기를 반환합니다 (다이제스트를 사용하지 않으면 0)
+func (p *NotificationPreferences) DigestInterval() time.Duration {
+	switch p.DigestFrequency {
+	case NotificationDigestHourly:
+		return time.Hour
+	case NotificationDigestDaily:
+		return 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// DigestDue: 마지막 다이제스트 이후 전송 주기가 지났는지 확인합니다
+func (p *NotificationPreferences) DigestDue(now time.Time) bool {
+	interval := p.DigestInterval()
+	if interval == 0 {
+		return true
+	}
+	return p.LastDigestAt == nil || !now.Before(p.LastDigestAt.Add(interval))
+}
+
+// EscalationDelay: 에스컬레이션 대기 시간을 반환합니다
+func (p *NotificationPreferences) EscalationDelay() time.Duration {
+	if p.EscalationDelayMinutes <= 0 {
+		return DefaultEscalationDelay
+	}
+	return time.Duration(p.EscalationDelayMinutes) * time.Minute
+}
+
+// location: 설정된 시간대를 반환합니다 (잘못된 값이면 UTC)
+func (p *NotificationPreferences) location() *time.Location {
+	if p.Timezone == "" {
+		return time.UTC
+	}
+	location, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// parseClock: HH:MM 형식의 시각을 자정 기준 분으로 변환합니다
+func parseClock(value string) (int, error) {
+	parsed, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, err
+	}
+	return parsed.Hour()*60 + parsed.Minute(), nil
+}
@@ -16,13 +16,50 @@ type NotificationRepository interface {
 	MarkAsRead(ctx context.Context, userID, notificationID string) error
 	MarkAllAsRead(ctx context.Context, userID string) error
 	GetStats(ctx context.Context, userID string) (*NotificationStats, error)
+	// GetByGroupID returns one notification of a group (nil when not found)
+	GetByGroupID(ctx context.Context, groupID string) (*Notification, error)
+	// Acknowledge marks every unacknowledged notification of a group as acknowledged and read
+	Acknowledge(ctx context.Context, groupID, acknowledgedBy string, at time.Time) (int64, error)
+	// FindRecentDuplicate returns the latest notification of a user with the same dedup key created since the given time (nil when not found)
+	FindRecentDuplicate(ctx context.Context, userID, dedupKey string, since time.Time) (*Notification, error)
+	// IncrementDuplicate counts a suppressed repeat of a notification
+	IncrementDuplicate(ctx context.Context, notificationID string) error
+	// ReleaseDeferred marks deferred notifications whose quiet hours have ended as delivered and returns them
+	ReleaseDeferred(ctx context.Context, now time.Time, limit int) ([]*Notification, error)
+	// ClaimEscalations marks unacknowledged notifications whose escalation is due as escalated and returns them
+	ClaimEscalations(ctx context.Context, now time.Time, limit int) ([]*Notification, error)
+	// ListDigestUserIDs returns users with notifications waiting for a digest
+	ListDigestUserIDs(ctx context.Context) ([]string, error)
+	// ClaimDigest marks a user's notifications waiting for a digest as digested and returns them (oldest first)
+	ClaimDigest(ctx context.Context, userID string, limit int) ([]*Notification, error)
+	// RequeueDigest puts claimed notifications back into the digest queue after a failed send
+	RequeueDigest(ctx context.Context, notificationIDs []string) error
 	CleanupOld(ctx context.Context, olderThan time.Duration) error
 }
 
+// NotificationTemplateRepository 알림 템플릿 저장소 인터페이스
+type NotificationTemplateRepository interface {
+	// CreateVersion stores the template as the next version of its key and locale
+	// When activate is true the new version replaces the current active version
+	CreateVersion(ctx context.Context, template *NotificationTemplate, activate bool) error
+	// GetActive returns the active version of a key and locale (nil when not found)
+	GetActive(ctx context.Context, key, locale string) (*NotificationTemplate, error)
+	// GetVersion returns a specific version (nil when not found)
+	GetVersion(ctx context.Context, key, locale string, version int) (*NotificationTemplate, error)
+	ListActive(ctx context.Context, key string) ([]*NotificationTemplate, error)
+	ListVersions(ctx context.Context, key, locale string) ([]*NotificationTemplate, error)
+	// Activate makes the given version the active one
+	Activate(ctx context.Context, key, locale string, version int) error
+	// Delete removes every version of a key and locale
+	Delete(ctx context.Context, key, locale string) (int64, error)
+}
+
 // NotificationPreferencesRepository 알림 설정 저장소 인터페이스
 type NotificationPreferencesRepository interface {
 	GetByUserID(ctx context.Context, userID string) (*NotificationPreferences, error)
 	Create(ctx context.Context, preferences *NotificationPreferences) error
 	Update(ctx context.Context, preferences *NotificationPreferences) error
 	Upsert(ctx context.Context, preferences *NotificationPreferences) error
+	// SetLastDigestAt records when the last digest was sent to a user
+	SetLastDigestAt(ctx context.Context, userID string, at time.Time) error
 }
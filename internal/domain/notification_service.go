@@ -3,6 +3,8 @@ package domain
 import (
 	"context"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 // NotificationService 알림 서비스 인터페이스
@@ -18,6 +20,8 @@ type NotificationService interface {
 	// 읽음 처리
 	MarkAsRead(ctx context.Context, userID, notificationID string) error
 	MarkAllAsRead(ctx context.Context, userID string) error
+	// 확인 처리 (같은 그룹의 모든 수신자 알림에 기록)
+	AcknowledgeNotification(ctx context.Context, userID, notificationID string) (*Notification, error)
 
 	// 알림 설정
 	GetNotificationPreferences(ctx context.Context, userID string) (*NotificationPreferences, error)
@@ -29,6 +33,27 @@ type NotificationService interface {
 	// 알림 전송
 	SendNotification(ctx context.Context, userID string, notification *Notification) error
 	SendBulkNotification(ctx context.Context, userIDs []string, notification *Notification) error
+	// 템플릿 알림 전송 (수신자 로케일별 렌더링)
+	SendTemplateNotification(ctx context.Context, req TemplateNotificationRequest) error
+
+	// 알림 템플릿 관리
+	ListTemplates(ctx context.Context, key string) ([]*NotificationTemplate, error)
+	// GetTemplate returns the given version, or the active version when version is 0
+	GetTemplate(ctx context.Context, key, locale string, version int) (*NotificationTemplate, error)
+	ListTemplateVersions(ctx context.Context, key, locale string) ([]*NotificationTemplate, error)
+	// SaveTemplate stores the request as a new version of its key and locale
+	SaveTemplate(ctx context.Context, actorID uuid.UUID, req SaveNotificationTemplateRequest) (*NotificationTemplate, error)
+	ActivateTemplateVersion(ctx context.Context, actorID uuid.UUID, key, locale string, version int) (*NotificationTemplate, error)
+	DeleteTemplate(ctx context.Context, actorID uuid.UUID, key, locale string) error
+	PreviewTemplate(ctx context.Context, req PreviewNotificationTemplateRequest) (*NotificationTemplatePreview, error)
+
+	// 알림 전달 파이프라인 (백그라운드 워커에서 주기적으로 호출)
+	// ReleaseDeferredNotifications delivers notifications held during quiet hours once they end
+	ReleaseDeferredNotifications(ctx context.Context, limit int) (int, error)
+	// EscalateUnacknowledged forwards urgent notifications that were not acknowledged in time to the secondary recipient
+	EscalateUnacknowledged(ctx context.Context, limit int) (int, error)
+	// SendDigests emails batched low priority notifications to users whose digest is due
+	SendDigests(ctx context.Context) (int, error)
 
 	// 알림 정리
 	CleanupOldNotifications(ctx context.Context, olderThan time.Duration) error
@@ -0,0 +1,306 @@
+package domain
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 알림 템플릿 로케일
+const (
+	NotificationLocaleEnglish = "en"
+	NotificationLocaleKorean  = "ko"
+
+	// DefaultNotificationLocale: 사용자 로케일의 템플릿이 없을 때 사용하는 로케일
+	DefaultNotificationLocale = NotificationLocaleEnglish
+)
+
+// notificationTemplateKeyPattern: 템플릿 키 형식 (예: credential.unhealthy)
+var notificationTemplateKeyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,99}$`)
+
+// notificationTemplateVariablePattern: 템플릿 변수 이름 형식 (Go 식별자)
+var notificationTemplateVariablePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// NormalizeNotificationLocale: 로케일 값을 지원하는 로케일로 정규화합니다 (예: ko-KR -> ko)
+// 지원하지 않는 로케일이면 빈 문자열을 반환합니다
+func NormalizeNotificationLocale(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if i := strings.IndexAny(locale, "-_"); i > 0 {
+		locale = locale[:i]
+	}
+	switch locale {
+	case NotificationLocaleEnglish, NotificationLocaleKorean:
+		return locale
+	default:
+		return ""
+	}
+}
+
+// NotificationTemplate: DB에 저장되는 알림 템플릿 (키 + 로케일별 버전 관리)
+// 수정할 때마다 새 버전을 만들고, 키와 로케일마다 활성 버전은 하나입니다
+type NotificationTemplate struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	Key         string    `json:"key" gorm:"not null;size:100;uniqueIndex:idx_notification_templates_version,priority:1"`
+	Locale      string    `json:"locale" gorm:"not null;size:10;uniqueIndex:idx_notification_templates_version,priority:2"`
+	Version     int       `json:"version" gorm:"not null;uniqueIndex:idx_notification_templates_version,priority:3"`
+	Active      bool      `json:"active" gorm:"not null;index"`
+	Name        string    `json:"name" gorm:"not null;size:100"`
+	Description string    `json:"description,omitempty" gorm:"size:500"`
+
+	// 생성되는 알림의 속성
+	Type     string `json:"type" gorm:"not null;size:20"`     // info, warning, error, success
+	Category string `json:"category" gorm:"not null;size:50"` // system, vm, cost, security 등
+	Priority string `json:"priority" gorm:"not null;size:20"` // low, medium, high, urgent
+
+	// 채널별 본문 (Go text/template 문법, 이메일 HTML은 html/template로 이스케이프)
+	Title    string `json:"title" gorm:"type:text;not null"`
+	TextBody string `json:"text_body" gorm:"type:text;not null"` // 앱 내 알림 및 이메일 대체 텍스트
+	HTMLBody string `json:"html_body,omitempty" gorm:"type:text"`
+	ChatBody string `json:"chat_body,omitempty" gorm:"type:text"` // Slack/Teams/Discord용 마크다운 (비어 있으면 TextBody)
+
+	// Variables: 렌더링 시 반드시 전달해야 하는 변수
+	Variables StringList `json:"variables" gorm:"type:jsonb"`
+	// SampleData: 미리보기에 사용하는 예시 변수 값
+	SampleData JSONBMap `json:"sample_data,omitempty" gorm:"type:jsonb"`
+
+	CreatedBy *uuid.UUID `json:"created_by,omitempty" gorm:"type:uuid"`
+	CreatedAt time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName: NotificationTemplate의 테이블 이름을 반환합니다
+func (NotificationTemplate) TableName() string {
+	return "notification_templates"
+}
+
+// RenderedNotification: 템플릿을 렌더링한 채널별 결과
+type RenderedNotification struct {
+	Title string `json:"title"`
+	Text  string `json:"text"`
+	HTML  string `json:"html,omitempty"`
+	Chat  string `json:"chat"`
+}
+
+// notificationTemplateFuncs: 템플릿에서 사용할 수 있는 함수
+var notificationTemplateFuncs = map[string]interface{}{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"join": func(values []interface{}, sep string) string {
+		parts := make([]string, len(values))
+		for i, value := range values {
+			parts[i] = fmt.Sprint(value)
+		}
+		return strings.Join(parts, sep)
+	},
+	"default": func(fallback, value interface{}) interface{} {
+		if value == nil || value == "" {
+			return fallback
+		}
+		return value
+	},
+}
+
+// Validate: 템플릿 정의와 문법을 검증합니다
+func (t *NotificationTemplate) Validate() error {
+	if !notificationTemplateKeyPattern.MatchString(t.Key) {
+		return NewDomainError(ErrCodeValidationFailed, "template key must be lowercase letters, digits, '.', '_' or '-' (max 100)", 400)
+	}
+	if NormalizeNotificationLocale(t.Locale) != t.Locale {
+		return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("unsupported locale: %s (supported: en, ko)", t.Locale), 400)
+	}
+	if strings.TrimSpace(t.Name) == "" {
+		return NewDomainError(ErrCodeValidationFailed, "template name is required", 400)
+	}
+
+	switch t.Type {
+	case "info", "warning", "error", "success":
+	default:
+		return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("invalid notification type: %s", t.Type), 400)
+	}
+	if strings.TrimSpace(t.Category) == "" {
+		return NewDomainError(ErrCodeValidationFailed, "template category is required", 400)
+	}
+	if !IsValidNotificationPriority(t.Priority) {
+		return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("invalid priority: %s", t.Priority), 400)
+	}
+
+	if strings.TrimSpace(t.Title) == "" || strings.TrimSpace(t.TextBody) == "" {
+		return NewDomainError(ErrCodeValidationFailed, "title and text_body are required", 400)
+	}
+	for _, variable := range t.Variables {
+		if !notificationTemplateVariablePattern.MatchString(variable) {
+			return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("invalid variable name: %s", variable), 400)
+		}
+	}
+
+	if _, err := t.parse(); err != nil {
+		return err
+	}
+
+	// 예시 데이터가 있으면 실제로 렌더링해 필수 변수와 실행 오류를 확인
+	if len(t.SampleData) > 0 {
+		if _, err := t.Render(t.SampleData); err != nil {
+			return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("sample_data does not render: %v", err), 400)
+		}
+	}
+	return nil
+}
+
+// MissingVariables: 전달되지 않은 필수 변수 목록을 반환합니다
+func (t *NotificationTemplate) MissingVariables(data map[string]interface{}) []string {
+	var missing []string
+	for _, variable := range t.Variables {
+		if _, ok := data[variable]; !ok {
+			missing = append(missing, variable)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// Render: 변수를 채워 채널별 본문을 렌더링합니다
+// 필수 변수가 빠졌거나 템플릿이 정의되지 않은 변수를 참조하면 오류를 반환합니다
+func (t *NotificationTemplate) Render(data map[string]interface{}) (*RenderedNotification, error) {
+	if missing := t.MissingVariables(data); len(missing) > 0 {
+		return nil, NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("missing template variables: %s", strings.Join(missing, ", ")), 400)
+	}
+
+	parsed, err := t.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := &RenderedNotification{}
+	if rendered.Title, err = executeText(parsed.title, data); err != nil {
+		return nil, renderError("title", err)
+	}
+	rendered.Title = strings.TrimSpace(rendered.Title)
+	if rendered.Text, err = executeText(parsed.text, data); err != nil {
+		return nil, renderError("text_body", err)
+	}
+	if parsed.html != nil {
+		var buf bytes.Buffer
+		if err := parsed.html.Execute(&buf, data); err != nil {
+			return nil, renderError("html_body", err)
+		}
+		rendered.HTML = buf.String()
+	}
+	if parsed.chat != nil {
+		if rendered.Chat, err = executeText(parsed.chat, data); err != nil {
+			return nil, renderError("chat_body", err)
+		}
+	} else {
+		rendered.Chat = rendered.Text
+	}
+	return rendered, nil
+}
+
+// parsedNotificationTemplate: 파싱된 채널별 템플릿
+type parsedNotificationTemplate struct {
+	title *template.Template
+	text  *template.Template
+	html  *htmltemplate.Template
+	chat  *template.Template
+}
+
+// parse: 채널별 본문을 파싱합니다
+func (t *NotificationTemplate) parse() (*parsedNotificationTemplate, error) {
+	parsed := &parsedNotificationTemplate{}
+	var err error
+	if parsed.title, err = parseText("title", t.Title); err != nil {
+		return nil, err
+	}
+	if parsed.text, err = parseText("text_body", t.TextBody); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(t.ChatBody) != "" {
+		if parsed.chat, err = parseText("chat_body", t.ChatBody); err != nil {
+			return nil, err
+		}
+	}
+	if strings.TrimSpace(t.HTMLBody) != "" {
+		parsed.html, err = htmltemplate.New("html_body").Option("missingkey=error").Funcs(notificationTemplateFuncs).Parse(t.HTMLBody)
+		if err != nil {
+			return nil, NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("invalid html_body template: %v", err), 400)
+		}
+	}
+	return parsed, nil
+}
+
+// parseText: text/template 본문을 파싱합니다
+func parseText(name, body string) (*template.Template, error) {
+	parsed, err := template.New(name).Option("missingkey=error").Funcs(notificationTemplateFuncs).Parse(body)
+	if err != nil {
+		return nil, NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("invalid %s template: %v", name, err), 400)
+	}
+	return parsed, nil
+}
+
+// executeText: text/template을 실행합니다
+func executeText(tmpl *template.Template, data map[string]interface{}) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// renderError: 렌더링 실행 오류를 검증 오류로 변환합니다
+func renderError(field string, err error) error {
+	return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("failed to render %s: %v", field, err), 400)
+}
+
+// SaveNotificationTemplateRequest: 알림 템플릿 저장 요청 DTO (저장할 때마다 새 버전 생성)
+type SaveNotificationTemplateRequest struct {
+	Key         string                 `json:"key" validate:"required,max=100"`
+	Locale      string                 `json:"locale" validate:"required"`
+	Name        string                 `json:"name" validate:"required,max=100"`
+	Description string                 `json:"description,omitempty" validate:"max=500"`
+	Type        string                 `json:"type" validate:"required,oneof=info warning error success"`
+	Category    string                 `json:"category" validate:"required,max=50"`
+	Priority    string                 `json:"priority" validate:"required,oneof=low medium high urgent"`
+	Title       string                 `json:"title" validate:"required"`
+	TextBody    string                 `json:"text_body" validate:"required"`
+	HTMLBody    string                 `json:"html_body,omitempty"`
+	ChatBody    string                 `json:"chat_body,omitempty"`
+	Variables   []string               `json:"variables,omitempty"`
+	SampleData  map[string]interface{} `json:"sample_data,omitempty"`
+	// Activate: false면 새 버전을 저장만 하고 현재 활성 버전을 유지합니다 (기본 true)
+	Activate *bool `json:"activate,omitempty"`
+}
+
+// PreviewNotificationTemplateRequest: 알림 템플릿 미리보기 요청 DTO
+// Template이 있으면 저장하지 않은 초안을, 없으면 Key/Locale/Version의 저장된 템플릿을 렌더링합니다
+type PreviewNotificationTemplateRequest struct {
+	Key      string                           `json:"key,omitempty"`
+	Locale   string                           `json:"locale,omitempty"`
+	Version  int                              `json:"version,omitempty"` // 0이면 활성 버전
+	Template *SaveNotificationTemplateRequest `json:"template,omitempty"`
+	// Data: 렌더링할 변수 (없으면 템플릿의 sample_data)
+	Data map[string]interface{} `json:"data,omitempty"`
+}
+
+// NotificationTemplatePreview: 알림 템플릿 미리보기 결과
+type NotificationTemplatePreview struct {
+	Key      string                `json:"key"`
+	Locale   string                `json:"locale"`
+	Version  int                   `json:"version,omitempty"`
+	Rendered *RenderedNotification `json:"rendered"`
+}
+
+// TemplateNotificationRequest: 템플릿 알림 전송 요청
+// 수신자마다 알림 설정의 로케일에 맞는 템플릿으로 렌더링합니다
+type TemplateNotificationRequest struct {
+	TemplateKey string
+	UserIDs     []string
+	WorkspaceID string
+	Variables   map[string]interface{}
+	// Data: 알림에 함께 저장할 JSON 메타데이터
+	Data string
+}
@@ -0,0 +1,406 @@
+package domain
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 작업 상태
+const (
+	OperationRunning   = "running"   // 클라우드에서 진행 중
+	OperationSucceeded = "succeeded" // 완료
+	OperationFailed    = "failed"    // 클라우드 작업 실패 또는 상태 조회 반복 실패
+	OperationTimedOut  = "timed_out" // 제한 시간 안에 끝나지 않음
+)
+
+// 작업 종류 (첫 토큰은 상태를 조회하는 추적기의 종류)
+const (
+	OperationClusterCreate   = "kubernetes.cluster.create"
+	OperationClusterDelete   = "kubernetes.cluster.delete"
+	OperationNodeGroupCreate = "kubernetes.node_group.create"
+	OperationNodeGroupDelete = "kubernetes.node_group.delete"
+	OperationVPCDelete       = "network.vpc.delete"
+	OperationVMStart         = "vm.start"
+	OperationVMStop          = "vm.stop"
+	OperationVMRestart       = "vm.restart"
+)
+
+// OperationKinds: 작업을 추적하는 서비스 종류 (작업 종류의 첫 토큰)
+var OperationKinds = []string{"kubernetes", "network", "vm"}
+
+// 작업 대상 리소스 종류
+const (
+	OperationTargetCluster   = "cluster"
+	OperationTargetNodeGroup = "node_group"
+	OperationTargetVPC       = "vpc"
+	OperationTargetVM        = "vm"
+)
+
+// 작업 단계 상태
+const (
+	OperationStepPending   = "pending"
+	OperationStepRunning   = "running"
+	OperationStepSucceeded = "succeeded"
+	OperationStepFailed    = "failed"
+)
+
+const (
+	// DefaultOperationTimeout: 작업 종류에 제한 시간이 없을 때 사용하는 기본값
+	DefaultOperationTimeout = time.Hour
+	// MaxOperationPollErrors: 상태 조회가 연속으로 이 횟수만큼 실패하면 작업을 실패로 처리
+	MaxOperationPollErrors = 10
+)
+
+// operationTimeouts: 작업 종류별 제한 시간 (EKS 클러스터 생성은 보통 10~20분)
+var operationTimeouts = map[string]time.Duration{
+	OperationClusterCreate:   time.Hour,
+	OperationClusterDelete:   time.Hour,
+	OperationNodeGroupCreate: 45 * time.Minute,
+	OperationNodeGroupDelete: 45 * time.Minute,
+	OperationVPCDelete:       15 * time.Minute,
+	OperationVMStart:         15 * time.Minute,
+	OperationVMStop:          15 * time.Minute,
+	OperationVMRestart:       15 * time.Minute,
+}
+
+// operationSteps: 작업 종류별 진행 단계 (추적기가 보고하는 단계 이름)
+var operationSteps = map[string][]string{
+	OperationClusterCreate:   {"requested", "provisioning", "ready"},
+	OperationClusterDelete:   {"requested", "deleting", "deleted"},
+	OperationNodeGroupCreate: {"requested", "provisioning", "ready"},
+	OperationNodeGroupDelete: {"requested", "deleting", "deleted"},
+	OperationVPCDelete:       {"requested", "deleting", "deleted"},
+	OperationVMStart:         {"requested", "starting", "running"},
+	OperationVMStop:          {"requested", "stopping", "stopped"},
+	OperationVMRestart:       {"requested", "restarting", "running"},
+}
+
+// OperationTimeout: 작업 종류의 제한 시간을 반환합니다
+func OperationTimeout(operationType string) time.Duration {
+	if timeout, ok := operationTimeouts[operationType]; ok {
+		return timeout
+	}
+	return DefaultOperationTimeout
+}
+
+// OperationKind: 작업 종류의 첫 토큰(kubernetes, network, vm)을 반환합니다
+func OperationKind(operationType string) string {
+	kind, _, _ := strings.Cut(operationType, ".")
+	return kind
+}
+
+// OperationReadPermission: 작업을 조회하는 데 필요한 워크스페이스 권한을 반환합니다
+func OperationReadPermission(operationType string) Permission {
+	switch OperationKind(operationType) {
+	case "kubernetes":
+		return KubernetesRead
+	case "network":
+		return NetworkRead
+	default:
+		return ComputeRead
+	}
+}
+
+// OperationPollDelay: 상태 조회 횟수에 따른 다음 조회까지의 간격 (10초부터 최대 1분)
+func OperationPollDelay(attempts int) time.Duration {
+	delay := 10 * time.Second
+	for i := 0; i < attempts/6 && delay < time.Minute; i++ {
+		delay *= 2
+	}
+	if delay > time.Minute {
+		delay = time.Minute
+	}
+	return delay
+}
+
+// Operation: 클라우드에서 비동기로 진행되는 작업 (클러스터 생성, VPC 삭제 등)
+// 생성한 서비스가 등록하고 작업 폴링 워커가 클라우드 상태를 조회하여 완료될 때까지 갱신합니다
+type Operation struct {
+	ID                  uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	WorkspaceID         string         `json:"workspace_id" gorm:"type:uuid;not null;index:idx_operations_workspace,priority:1"`
+	CredentialID        string         `json:"credential_id,omitempty" gorm:"size:36"`
+	Provider            string         `json:"provider" gorm:"not null;size:20"`
+	Region              string         `json:"region,omitempty" gorm:"size:100"`
+	Type                string         `json:"type" gorm:"not null;size:100"`
+	TargetType          string         `json:"target_type" gorm:"not null;size:50"`
+	TargetID            string         `json:"target_id" gorm:"not null;size:500;index"`
+	TargetName          string         `json:"target_name,omitempty" gorm:"size:255"`
+	Status              string         `json:"status" gorm:"not null;size:20;index:idx_operations_poll,priority:1"`
+	Percent             int            `json:"percent" gorm:"not null;default:0"`
+	Steps               OperationSteps `json:"steps" gorm:"type:jsonb"`
+	Error               string         `json:"error,omitempty" gorm:"type:text"`
+	ProviderOperationID string         `json:"provider_operation_id,omitempty" gorm:"size:500"` // GKE/Compute 작업 이름
+	ProviderStatus      string         `json:"provider_status,omitempty" gorm:"size:100"`       // 클라우드가 보고한 원래 상태
+	Details             JSONBMap       `json:"details,omitempty" gorm:"type:jsonb"`             // 추적기가 상태 조회에 사용하는 값 (project_id, location, cluster_name 등)
+	PollErrors          int            `json:"-" gorm:"not null;default:0"`                     // 연속 상태 조회 실패 수
+	PollAttempts        int            `json:"poll_attempts" gorm:"not null;default:0"`
+	NextPollAt          time.Time      `json:"-" gorm:"not null;index:idx_operations_poll,priority:2"`
+	Deadline            time.Time      `json:"deadline"`
+	CreatedBy           *uuid.UUID     `json:"created_by,omitempty" gorm:"type:uuid"`
+	StartedAt           time.Time      `json:"started_at" gorm:"not null;index:idx_operations_workspace,priority:2,sort:desc"`
+	FinishedAt          *time.Time     `json:"finished_at,omitempty"`
+	UpdatedAt           time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName: Operation의 테이블 이름을 반환합니다
+func (Operation) TableName() string {
+	return "operations"
+}
+
+// Validate: 작업 등록 요청을 검증합니다
+func (o *Operation) Validate() error {
+	if o.WorkspaceID == "" {
+		return NewDomainError(ErrCodeValidationFailed, "operation workspace is required", 400)
+	}
+	if _, ok := operationSteps[o.Type]; !ok {
+		return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("unsupported operation type: %s", o.Type), 400)
+	}
+	if o.TargetType == "" || o.TargetID == "" {
+		return NewDomainError(ErrCodeValidationFailed, "operation target is required", 400)
+	}
+	return nil
+}
+
+// IsTerminal: 작업이 끝났는지 확인합니다
+func (o *Operation) IsTerminal() bool {
+	return o.Status != OperationRunning
+}
+
+// Begin: 작업 종류의 단계를 만들고 첫 단계를 완료한 진행 중 상태로 초기화합니다
+func (o *Operation) Begin(at time.Time) {
+	o.Status = OperationRunning
+	o.StartedAt = at
+	o.NextPollAt = at.Add(OperationPollDelay(0))
+	if o.Deadline.IsZero() {
+		o.Deadline = at.Add(OperationTimeout(o.Type))
+	}
+
+	names := operationSteps[o.Type]
+	o.Steps = make(OperationSteps, len(names))
+	for i, name := range names {
+		o.Steps[i] = OperationStep{Name: name, Status: OperationStepPending}
+	}
+	if len(o.Steps) > 0 {
+		o.Steps[0].Status = OperationStepSucceeded
+		o.Steps[0].StartedAt = &at
+		o.Steps[0].FinishedAt = &at
+	}
+	if len(o.Steps) > 1 {
+		o.Steps[1].Status = OperationStepRunning
+		o.Steps[1].StartedAt = &at
+	}
+	o.Percent = 5
+}
+
+// Apply: 추적기가 보고한 진행 상황을 반영하고 상태가 바뀌었는지 반환합니다
+func (o *Operation) Apply(progress *OperationProgress, at time.Time) bool {
+	before := o.fingerprint()
+
+	o.PollErrors = 0
+	if progress.ProviderStatus != "" {
+		o.ProviderStatus = progress.ProviderStatus
+	}
+
+	switch progress.Status {
+	case OperationSucceeded:
+		o.finish(OperationSucceeded, "", at)
+	case OperationFailed:
+		message := progress.Error
+		if message == "" {
+			message = fmt.Sprintf("operation failed with provider status %s", o.ProviderStatus)
+		}
+		o.finish(OperationFailed, message, at)
+	default:
+		if progress.Percent > o.Percent {
+			// 완료 전에는 100%를 보고하지 않음
+			o.Percent = min(progress.Percent, 99)
+		}
+		step := progress.Step
+		if step == "" {
+			step = o.currentStep()
+		}
+		if step != "" {
+			o.advanceTo(step, progress.Message, at)
+		}
+		if at.After(o.Deadline) {
+			o.finish(OperationTimedOut, fmt.Sprintf("operation did not finish within %s", o.Deadline.Sub(o.StartedAt).Round(time.Minute)), at)
+		}
+	}
+
+	return before != o.fingerprint()
+}
+
+// RecordPollError: 상태 조회 실패를 기록하고, 연속 실패가 한도에 이르거나 제한 시간이 지나면 작업을 끝냅니다
+// 작업이 끝났으면 true를 반환합니다
+func (o *Operation) RecordPollError(err error, at time.Time) bool {
+	o.PollErrors++
+	switch {
+	case o.PollErrors >= MaxOperationPollErrors:
+		o.finish(OperationFailed, fmt.Sprintf("failed to read operation status %d times: %v", o.PollErrors, err), at)
+		return true
+	case at.After(o.Deadline):
+		o.finish(OperationTimedOut, fmt.Sprintf("operation did not finish before its deadline; last status check failed: %v", err), at)
+		return true
+	}
+	return false
+}
+
+// Complete: 동기로 끝난 작업을 성공 상태로 기록합니다
+func (o *Operation) Complete(at time.Time) {
+	o.finish(OperationSucceeded, "", at)
+}
+
+// finish: 작업을 끝내고 남은 단계를 정리합니다
+func (o *Operation) finish(status, message string, at time.Time) {
+	o.Status = status
+	o.Error = message
+	o.FinishedAt = &at
+	for i := range o.Steps {
+		step := &o.Steps[i]
+		switch {
+		case status == OperationSucceeded && step.Status != OperationStepSucceeded:
+			if step.StartedAt == nil {
+				step.StartedAt = &at
+			}
+			step.Status = OperationStepSucceeded
+			step.FinishedAt = &at
+		case status != OperationSucceeded && step.Status == OperationStepRunning:
+			step.Status = OperationStepFailed
+			step.FinishedAt = &at
+			step.Message = message
+		}
+	}
+	if status == OperationSucceeded {
+		o.Percent = 100
+	}
+}
+
+// advanceTo: 지정한 단계 이전의 단계를 완료하고 해당 단계를 진행 중으로 표시합니다
+func (o *Operation) advanceTo(name, message string, at time.Time) {
+	index := -1
+	for i, step := range o.Steps {
+		if step.Name == name {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return
+	}
+	for i := 0; i < index; i++ {
+		step := &o.Steps[i]
+		if step.Status != OperationStepSucceeded {
+			if step.StartedAt == nil {
+				step.StartedAt = &at
+			}
+			step.Status = OperationStepSucceeded
+			step.FinishedAt = &at
+		}
+	}
+	step := &o.Steps[index]
+	if step.Status == OperationStepPending {
+		step.Status = OperationStepRunning
+		step.StartedAt = &at
+	}
+	if message != "" {
+		step.Message = message
+	}
+}
+
+// EstimatedPercent: 진행률을 보고하지 않는 클라우드 작업의 경과 시간 기준 추정 진행률 (제한 시간의 1/4을 예상 소요 시간으로 보고 최대 95%)
+func (o *Operation) EstimatedPercent(at time.Time) int {
+	expected := OperationTimeout(o.Type) / 4
+	percent := 5 + int(90*at.Sub(o.StartedAt)/expected)
+	return max(5, min(percent, 95))
+}
+
+// currentStep: 진행 중인 단계 이름을 반환합니다
+func (o *Operation) currentStep() string {
+	for _, step := range o.Steps {
+		if step.Status == OperationStepRunning {
+			return step.Name
+		}
+	}
+	return ""
+}
+
+// fingerprint: 클라이언트에 알릴 만한 상태 변화를 비교하기 위한 값
+func (o *Operation) fingerprint() string {
+	message := ""
+	for _, step := range o.Steps {
+		if step.Status == OperationStepRunning {
+			message = step.Message
+		}
+	}
+	return fmt.Sprintf("%s|%s|%d|%s|%s", o.Status, o.ProviderStatus, o.Percent, o.currentStep(), message)
+}
+
+// OperationStep: 작업의 진행 단계
+type OperationStep struct {
+	Name       string     `json:"name"`
+	Status     string     `json:"status"`
+	Message    string     `json:"message,omitempty"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+}
+
+// OperationSteps: JSONB로 저장되는 작업 단계 목록
+type OperationSteps []OperationStep
+
+// Value: driver.Valuer 인터페이스를 구현합니다
+func (s OperationSteps) Value() (driver.Value, error) {
+	if s == nil {
+		return json.Marshal([]OperationStep{})
+	}
+	return json.Marshal([]OperationStep(s))
+}
+
+// Scan: sql.Scanner 인터페이스를 구현합니다
+func (s *OperationSteps) Scan(value interface{}) error {
+	if value == nil {
+		*s = nil
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unsupported operation steps type %T", value)
+		}
+		bytes = []byte(str)
+	}
+	return json.Unmarshal(bytes, (*[]OperationStep)(s))
+}
+
+// OperationProgress: 추적기가 클라우드에서 조회한 작업 진행 상황
+type OperationProgress struct {
+	Status         string // OperationRunning, OperationSucceeded, OperationFailed
+	ProviderStatus string // 클라우드가 보고한 원래 상태 (CREATING, RUNNING, DONE 등)
+	Percent        int    // 알 수 있으면 0~100
+	Step           string // 진행 중인 단계 이름 (operationSteps 참고, 비어 있으면 현재 단계 유지)
+	Message        string // 진행 중인 단계에 표시할 설명
+	Error          string // 실패 시 클라우드가 보고한 오류
+}
+
+// OperationTracker: 작업 종류별로 클라우드의 작업 상태를 조회합니다
+// Kubernetes, Network, VM 서비스가 구현하고 작업 서비스에 등록합니다
+type OperationTracker interface {
+	TrackOperation(ctx context.Context, operation *Operation) (*OperationProgress, error)
+}
+
+// OperationFilter: 작업 조회 조건
+type OperationFilter struct {
+	Kinds      []string // 비어 있지 않으면 해당 종류(kubernetes, network, vm)의 작업만 조회
+	Status     string
+	Type       string
+	TargetType string
+	TargetID   string
+	Limit      int
+	Offset     int
+}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OperationRepository defines the interface for tracked cloud operations
+type OperationRepository interface {
+	Create(ctx context.Context, operation *Operation) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Operation, error)
+	List(ctx context.Context, workspaceID string, filter OperationFilter) ([]*Operation, int64, error)
+	Update(ctx context.Context, operation *Operation) error
+	// ClaimDueOperations leases running operations whose next poll is due by pushing it forward by lease (SKIP LOCKED, safe across replicas)
+	ClaimDueOperations(ctx context.Context, limit int, lease time.Duration) ([]*Operation, error)
+	// DeleteFinishedBefore removes finished operations that ended before the cutoff
+	DeleteFinishedBefore(ctx context.Context, cutoff time.Time) (int64, error)
+}
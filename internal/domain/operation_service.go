@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OperationService defines the interface for tracking long-running cloud operations
+type OperationService interface {
+	// RegisterTracker sets the tracker that polls operations of a kind (the first token of the operation type: kubernetes, network, vm)
+	RegisterTracker(kind string, tracker OperationTracker)
+	// Start records a running operation and publishes its first update; the creator is taken from the policy subject in ctx
+	Start(ctx context.Context, operation *Operation) (*Operation, error)
+	// Record stores an operation that already finished synchronously so clients can follow every call the same way
+	Record(ctx context.Context, operation *Operation) (*Operation, error)
+
+	ListOperations(ctx context.Context, workspaceID uuid.UUID, filter OperationFilter) ([]*Operation, int64, error)
+	GetOperation(ctx context.Context, workspaceID, operationID uuid.UUID) (*Operation, error)
+
+	// PollDue checks the status of up to limit due operations and returns how many were polled
+	PollDue(ctx context.Context, limit int) (int, error)
+	// PurgeOperations removes finished operations older than the retention period
+	PurgeOperations(ctx context.Context, retention time.Duration) (int64, error)
+}
@@ -17,8 +17,35 @@ type OutboxEvent struct {
 	Status      OutboxEventStatus `json:"status" gorm:"type:varchar(20);not null;default:'pending';index"`
 	RetryCount  int               `json:"retry_count" gorm:"default:0"`
 	LastError   *string           `json:"last_error" gorm:"type:text"`
-	CreatedAt   time.Time         `json:"created_at" gorm:"not null;index"`
+	CreatedAt   time.Time         `json:"created_at" gorm:"not null;index;index:idx_outbox_events_aggregate,priority:2"`
 	PublishedAt *time.Time        `json:"published_at" gorm:"index"`
+
+	// AggregateID orders events: only the oldest unpublished event of an aggregate is published at a time
+	// 같은 aggregate의 이벤트는 생성 순서대로 하나씩 발행 (비어 있으면 토픽을 사용)
+	AggregateID string `json:"aggregate_id" gorm:"size:255;index:idx_outbox_events_aggregate,priority:1"`
+
+	// NextAttemptAt delays a retry with exponential backoff
+	// 재시도를 지수 백오프로 지연
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty" gorm:"index"`
+
+	// LockedBy / LockedUntil lease a processing event to a worker; an expired lease is reclaimed
+	// 처리 중인 이벤트를 워커에 임대하며, 만료된 임대는 다른 워커가 다시 가져감
+	LockedBy    string     `json:"locked_by,omitempty" gorm:"size:255"`
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+}
+
+// OutboxNotifyChannel is the Postgres NOTIFY channel signalled when an outbox event is enqueued
+// outbox 이벤트가 추가되면 알림을 보내는 Postgres NOTIFY 채널
+const OutboxNotifyChannel = "outbox_events"
+
+// OutboxStats summarizes outbox events waiting to be published
+// 발행 대기 중인 outbox 이벤트 요약
+type OutboxStats struct {
+	Pending         int64      `json:"pending"`
+	Processing      int64      `json:"processing"`
+	Failed          int64      `json:"failed"`
+	OldestPendingAt *time.Time `json:"oldest_pending_at,omitempty"`
+	LagSeconds      float64    `json:"lag_seconds"`
 }
 
 // OutboxEventStatus represents the status of an outbox event
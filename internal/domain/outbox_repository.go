@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 )
 
 // OutboxRepository defines the interface for outbox event persistence
@@ -11,10 +12,44 @@ type OutboxRepository interface {
 	// 새로운 outbox 이벤트를 저장
 	Create(ctx context.Context, event *OutboxEvent) error
 
+	// ClaimPendingEvents leases due events to a worker, skipping rows locked by other workers
+	// and events whose aggregate still has an older unpublished event
+	// 다른 워커가 잠근 행과, 같은 aggregate에 먼저 발행할 이벤트가 남은 이벤트를 건너뛰고 워커에 임대
+	ClaimPendingEvents(ctx context.Context, workerID string, limit int, lease time.Duration) ([]*OutboxEvent, error)
+
+	// ClaimPendingEventsByTopic leases the oldest due events of a single topic to a worker
+	// Only one worker holds a topic at a time, so consumers that deliver in order (audit sinks) are safe on every replica
+	// 단일 토픽의 가장 오래된 이벤트를 워커에 임대 (토픽당 한 워커만 임대하므로 여러 인스턴스에서도 순서가 유지됨)
+	ClaimPendingEventsByTopic(ctx context.Context, topic, workerID string, limit int, lease time.Duration) ([]*OutboxEvent, error)
+
+	// ReleaseClaimedEvents returns leased events to pending without counting an attempt
+	// 임대한 이벤트를 시도 횟수 변경 없이 pending으로 되돌림
+	ReleaseClaimedEvents(ctx context.Context, ids []string) error
+
+	// ScheduleRetry returns a failed event to pending with an incremented retry count and the next attempt time
+	// 실패한 이벤트의 재시도 횟수를 증가시키고 다음 시도 시각과 함께 pending으로 되돌림
+	ScheduleRetry(ctx context.Context, id string, nextAttemptAt time.Time, errorMsg string) error
+
+	// RequeueFailedEvents returns failed events to pending with a reset retry count (all failed events when ids is empty)
+	// 실패한 이벤트를 재시도 횟수를 초기화하여 pending으로 되돌림 (ids가 비어 있으면 전체)
+	RequeueFailedEvents(ctx context.Context, ids []string) (int64, error)
+
+	// GetStats summarizes events waiting to be published
+	// 발행 대기 중인 이벤트 요약 조회
+	GetStats(ctx context.Context) (*OutboxStats, error)
+
+	// ListenForEvents signals whenever an event is enqueued; the channel is closed when listening stops
+	// 이벤트가 추가될 때마다 알리며, 수신이 중단되면 채널을 닫음
+	ListenForEvents(ctx context.Context) (<-chan struct{}, error)
+
 	// GetPendingEvents retrieves pending events up to the specified limit
 	// 지정된 제한까지 대기 중인 이벤트를 조회
 	GetPendingEvents(ctx context.Context, limit int) ([]*OutboxEvent, error)
 
+	// CountPendingByTopic counts undelivered (pending or leased) events of a single topic
+	// 단일 토픽의 전달되지 않은(대기 또는 임대 중) 이벤트 수를 조회
+	CountPendingByTopic(ctx context.Context, topic string) (int64, error)
+
 	// UpdateStatus updates the status of an outbox event
 	// Outbox 이벤트의 상태를 업데이트
 	UpdateStatus(ctx context.Context, id string, status OutboxEventStatus, errorMsg *string) error
@@ -0,0 +1,317 @@
+package domain
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PolicyEffect: 정책 효과
+type PolicyEffect string
+
+const (
+	PolicyEffectAllow PolicyEffect = "allow"
+	PolicyEffectDeny  PolicyEffect = "deny"
+)
+
+// PolicyOperator: 조건 비교 연산자
+type PolicyOperator string
+
+const (
+	PolicyOpEquals     PolicyOperator = "eq"
+	PolicyOpNotEquals  PolicyOperator = "ne"
+	PolicyOpIn         PolicyOperator = "in"
+	PolicyOpNotIn      PolicyOperator = "not_in"
+	PolicyOpPrefix     PolicyOperator = "prefix"
+	PolicyOpContains   PolicyOperator = "contains"
+	PolicyOpMatches    PolicyOperator = "matches"     // glob 패턴 (path.Match)
+	PolicyOpBetween    PolicyOperator = "between"     // "09:00-18:00" 형식 (env.time 전용)
+	PolicyOpNotBetween PolicyOperator = "not_between" // "09:00-18:00" 형식 (env.time 전용)
+	PolicyOpExists     PolicyOperator = "exists"
+	PolicyOpNotExists  PolicyOperator = "not_exists"
+)
+
+// 정책 조건 속성 접두사
+const (
+	PolicyAttrSubjectPrefix   = "subject."
+	PolicyAttrResourcePrefix  = "resource."
+	PolicyAttrWorkspacePrefix = "workspace."
+	PolicyAttrEnvPrefix       = "env."
+	PolicyAttrAction          = "action"
+)
+
+// policyOperators: 지원하는 조건 연산자 목록
+var policyOperators = map[PolicyOperator]bool{
+	PolicyOpEquals: true, PolicyOpNotEquals: true, PolicyOpIn: true, PolicyOpNotIn: true,
+	PolicyOpPrefix: true, PolicyOpContains: true, PolicyOpMatches: true,
+	PolicyOpBetween: true, PolicyOpNotBetween: true, PolicyOpExists: true, PolicyOpNotExists: true,
+}
+
+// PolicyCondition: 속성 기반 정책 조건
+type PolicyCondition struct {
+	Attribute string         `json:"attribute"` // subject.role, resource.region, workspace.settings.environment, env.time 등
+	Operator  PolicyOperator `json:"operator"`
+	Value     string         `json:"value,omitempty"`
+	Values    []string       `json:"values,omitempty"` // in, not_in 연산자용
+}
+
+// Validate: 조건의 속성과 연산자를 검증합니다
+func (c PolicyCondition) Validate() error {
+	if c.Attribute != PolicyAttrAction &&
+		!strings.HasPrefix(c.Attribute, PolicyAttrSubjectPrefix) &&
+		!strings.HasPrefix(c.Attribute, PolicyAttrResourcePrefix) &&
+		!strings.HasPrefix(c.Attribute, PolicyAttrWorkspacePrefix) &&
+		!strings.HasPrefix(c.Attribute, PolicyAttrEnvPrefix) {
+		return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("unknown attribute %q: must start with subject., resource., workspace. or env.", c.Attribute), 400)
+	}
+	if !policyOperators[c.Operator] {
+		return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("unknown operator %q", c.Operator), 400)
+	}
+
+	switch c.Operator {
+	case PolicyOpIn, PolicyOpNotIn:
+		if len(c.Values) == 0 {
+			return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("operator %s requires values", c.Operator), 400)
+		}
+	case PolicyOpBetween, PolicyOpNotBetween:
+		if _, _, err := ParseTimeWindow(c.Value); err != nil {
+			return err
+		}
+	case PolicyOpMatches:
+		if _, err := path.Match(c.Value, ""); err != nil {
+			return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("invalid pattern %q", c.Value), 400)
+		}
+	case PolicyOpExists, PolicyOpNotExists:
+	default:
+		if c.Value == "" {
+			return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("operator %s requires a value", c.Operator), 400)
+		}
+	}
+	return nil
+}
+
+// PolicyConditions: JSONB 컬럼으로 저장되는 조건 목록
+type PolicyConditions []PolicyCondition
+
+// Value: driver.Valuer 인터페이스를 구현합니다
+func (p PolicyConditions) Value() (driver.Value, error) {
+	if p == nil {
+		return json.Marshal([]PolicyCondition{})
+	}
+	return json.Marshal([]PolicyCondition(p))
+}
+
+// Scan: sql.Scanner 인터페이스를 구현합니다
+func (p *PolicyConditions) Scan(value interface{}) error {
+	if value == nil {
+		*p = nil
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unsupported policy conditions type %T", value)
+		}
+		bytes = []byte(str)
+	}
+	return json.Unmarshal(bytes, (*[]PolicyCondition)(p))
+}
+
+// StringList: JSONB 컬럼으로 저장되는 문자열 목록
+type StringList []string
+
+// Value: driver.Valuer 인터페이스를 구현합니다
+func (s StringList) Value() (driver.Value, error) {
+	if s == nil {
+		return json.Marshal([]string{})
+	}
+	return json.Marshal([]string(s))
+}
+
+// Scan: sql.Scanner 인터페이스를 구현합니다
+func (s *StringList) Scan(value interface{}) error {
+	if value == nil {
+		*s = nil
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unsupported string list type %T", value)
+		}
+		bytes = []byte(str)
+	}
+	return json.Unmarshal(bytes, (*[]string)(s))
+}
+
+// WorkspacePolicy: 워크스페이스별 속성 기반 접근 정책 (ABAC)
+// 평가 규칙: 일치하는 deny 정책이 하나라도 있으면 거부하고,
+// 작업에 적용되는 allow 정책이 있으면 그 중 하나 이상이 일치해야 허용합니다
+type WorkspacePolicy struct {
+	ID          uuid.UUID        `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	WorkspaceID string           `json:"workspace_id" gorm:"type:uuid;not null;index"`
+	Name        string           `json:"name" gorm:"not null;size:100"`
+	Description string           `json:"description" gorm:"size:500"`
+	Effect      PolicyEffect     `json:"effect" gorm:"not null;size:10"`
+	Actions     StringList       `json:"actions" gorm:"type:jsonb;not null"` // kubernetes:delete, network:*, *:write 등
+	Conditions  PolicyConditions `json:"conditions" gorm:"type:jsonb"`
+	Timezone    string           `json:"timezone" gorm:"size:64;default:'UTC'"` // env.time, env.weekday 평가 기준 시간대
+	Enabled     bool             `json:"enabled" gorm:"not null"`
+	CreatedBy   *uuid.UUID       `json:"created_by,omitempty" gorm:"type:uuid"`
+	CreatedAt   time.Time        `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time        `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName: WorkspacePolicy의 테이블 이름을 반환합니다
+func (WorkspacePolicy) TableName() string {
+	return "workspace_policies"
+}
+
+// Validate: 정책 정의를 검증합니다
+func (p *WorkspacePolicy) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return NewDomainError(ErrCodeValidationFailed, "policy name is required", 400)
+	}
+	if p.Effect != PolicyEffectAllow && p.Effect != PolicyEffectDeny {
+		return NewDomainError(ErrCodeValidationFailed, "policy effect must be allow or deny", 400)
+	}
+	if len(p.Actions) == 0 {
+		return NewDomainError(ErrCodeValidationFailed, "at least one action is required", 400)
+	}
+	for _, action := range p.Actions {
+		if _, err := path.Match(action, ""); err != nil || !strings.Contains(action, ":") && action != "*" {
+			return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("invalid action pattern %q: use resource:verb, e.g. kubernetes:delete or network:*", action), 400)
+		}
+	}
+	for _, condition := range p.Conditions {
+		if err := condition.Validate(); err != nil {
+			return err
+		}
+	}
+	if p.Timezone == "" {
+		p.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(p.Timezone); err != nil {
+		return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("invalid timezone %q", p.Timezone), 400)
+	}
+	return nil
+}
+
+// MatchesAction: 정책이 작업에 적용되는지 확인합니다
+func (p *WorkspacePolicy) MatchesAction(action Permission) bool {
+	for _, pattern := range p.Actions {
+		if matched, _ := path.Match(pattern, string(action)); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseTimeWindow: "HH:MM-HH:MM" 형식의 시간 구간을 분 단위로 파싱합니다
+func ParseTimeWindow(window string) (int, int, error) {
+	parts := strings.Split(window, "-")
+	if len(parts) != 2 {
+		return 0, 0, NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("invalid time window %q: expected HH:MM-HH:MM", window), 400)
+	}
+	start, err := time.Parse("15:04", strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("invalid time window %q: expected HH:MM-HH:MM", window), 400)
+	}
+	end, err := time.Parse("15:04", strings.TrimSpace(parts[1]))
+	if err != nil {
+		return 0, 0, NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("invalid time window %q: expected HH:MM-HH:MM", window), 400)
+	}
+	return start.Hour()*60 + start.Minute(), end.Hour()*60 + end.Minute(), nil
+}
+
+// PolicyResource: 정책 평가 대상 리소스 속성
+type PolicyResource struct {
+	Type         string            `json:"type"` // cluster, node_group, vpc, subnet, security_group, vm
+	Name         string            `json:"name,omitempty"`
+	Provider     string            `json:"provider,omitempty"`
+	Region       string            `json:"region,omitempty"`
+	CredentialID string            `json:"credential_id,omitempty"`
+	Attributes   map[string]string `json:"attributes,omitempty"`
+}
+
+// PolicyRequest: 정책 평가 요청
+type PolicyRequest struct {
+	WorkspaceID string            `json:"workspace_id"`
+	SubjectID   *uuid.UUID        `json:"subject_id,omitempty"` // 비어 있으면 컨텍스트의 사용자
+	Action      Permission        `json:"action"`
+	Resource    PolicyResource    `json:"resource"`
+	Environment map[string]string `json:"environment,omitempty"` // 추가 환경 속성 (ip 등)
+	Time        *time.Time        `json:"time,omitempty"`        // 드라이런 시 평가 시각 지정
+}
+
+// PolicyConditionResult: 조건 평가 결과
+type PolicyConditionResult struct {
+	PolicyCondition
+	Actual  string `json:"actual"`
+	Present bool   `json:"present"`
+	Matched bool   `json:"matched"`
+}
+
+// PolicyEvaluation: 단일 정책 평가 결과
+type PolicyEvaluation struct {
+	PolicyID   uuid.UUID               `json:"policy_id"`
+	Name       string                  `json:"name"`
+	Effect     PolicyEffect            `json:"effect"`
+	Matched    bool                    `json:"matched"`
+	Conditions []PolicyConditionResult `json:"conditions"`
+}
+
+// PolicyDecision: 정책 평가 결정과 설명
+type PolicyDecision struct {
+	Allowed     bool               `json:"allowed"`
+	Action      Permission         `json:"action"`
+	Reason      string             `json:"reason"`
+	DecidedBy   *uuid.UUID         `json:"decided_by,omitempty"` // 결정을 내린 정책 ID
+	Evaluations []PolicyEvaluation `json:"evaluations"`
+	Attributes  map[string]string  `json:"attributes"`
+	EvaluatedAt time.Time          `json:"evaluated_at"`
+}
+
+// CreateWorkspacePolicyRequest: 워크스페이스 정책 생성 요청
+type CreateWorkspacePolicyRequest struct {
+	Name        string            `json:"name" validate:"required,min=1,max=100"`
+	Description string            `json:"description" validate:"max=500"`
+	Effect      PolicyEffect      `json:"effect" validate:"required,oneof=allow deny"`
+	Actions     []string          `json:"actions" validate:"required,min=1"`
+	Conditions  []PolicyCondition `json:"conditions"`
+	Timezone    string            `json:"timezone"`
+	Enabled     *bool             `json:"enabled,omitempty"`
+}
+
+// UpdateWorkspacePolicyRequest: 워크스페이스 정책 수정 요청
+type UpdateWorkspacePolicyRequest struct {
+	Name        *string           `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Description *string           `json:"description,omitempty" validate:"omitempty,max=500"`
+	Effect      *PolicyEffect     `json:"effect,omitempty" validate:"omitempty,oneof=allow deny"`
+	Actions     []string          `json:"actions,omitempty"`
+	Conditions  []PolicyCondition `json:"conditions,omitempty"`
+	Timezone    *string           `json:"timezone,omitempty"`
+	Enabled     *bool             `json:"enabled,omitempty"`
+}
+
+// policySubjectContextKey: 정책 평가 주체(사용자 ID)를 저장하는 컨텍스트 키
+type policySubjectContextKey struct{}
+
+// ContextWithSubject: 정책 평가 주체를 컨텍스트에 저장합니다
+func ContextWithSubject(ctx context.Context, userID uuid.UUID) context.Context {
+	return context.WithValue(ctx, policySubjectContextKey{}, userID)
+}
+
+// SubjectFromContext: 컨텍스트에서 정책 평가 주체를 조회합니다
+func SubjectFromContext(ctx context.Context) (uuid.UUID, bool) {
+	userID, ok := ctx.Value(policySubjectContextKey{}).(uuid.UUID)
+	return userID, ok && userID != uuid.Nil
+}
@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// WorkspacePolicyRepository defines the interface for workspace policy data operations
+type WorkspacePolicyRepository interface {
+	Create(ctx context.Context, policy *WorkspacePolicy) error
+	GetByID(ctx context.Context, id uuid.UUID) (*WorkspacePolicy, error)
+	ListByWorkspace(ctx context.Context, workspaceID string) ([]*WorkspacePolicy, error)
+	ListEnabledByWorkspace(ctx context.Context, workspaceID string) ([]*WorkspacePolicy, error)
+	Update(ctx context.Context, policy *WorkspacePolicy) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// PolicyEnforcer evaluates workspace policies before mutating cloud operations
+type PolicyEnforcer interface {
+	// Enforce returns a 403 DomainError describing the deciding policy when the request is denied
+	Enforce(ctx context.Context, req PolicyRequest) error
+}
+
+// PolicyService defines the interface for attribute-based workspace policy management and evaluation
+type PolicyService interface {
+	PolicyEnforcer
+
+	// Evaluation
+	Evaluate(ctx context.Context, req PolicyRequest) (*PolicyDecision, error)
+
+	// Policy management
+	ListPolicies(ctx context.Context, workspaceID uuid.UUID) ([]*WorkspacePolicy, error)
+	GetPolicy(ctx context.Context, workspaceID, policyID uuid.UUID) (*WorkspacePolicy, error)
+	CreatePolicy(ctx context.Context, actorID, workspaceID uuid.UUID, req CreateWorkspacePolicyRequest) (*WorkspacePolicy, error)
+	UpdatePolicy(ctx context.Context, actorID, workspaceID, policyID uuid.UUID, req UpdateWorkspacePolicyRequest) (*WorkspacePolicy, error)
+	DeletePolicy(ctx context.Context, actorID, workspaceID, policyID uuid.UUID) error
+}
@@ -103,3 +103,22 @@ var RoleHierarchy = map[Role][]Role{
 	UserRoleType:   {ViewerRoleType},
 	ViewerRoleType: {},
 }
+
+// rolePrecedence: 대표 역할 선택 시 우선순위 (권한이 높은 순)
+var rolePrecedence = []Role{AdminRoleType, UserRoleType, ViewerRoleType}
+
+// HighestRole: 부여된 역할 중 권한이 가장 높은 역할을 반환합니다 (admin > user > viewer)
+// 역할이 없으면 user를, 알 수 없는 역할만 있으면 첫 번째 역할을 반환합니다
+func HighestRole(roles []Role) Role {
+	if len(roles) == 0 {
+		return UserRoleType
+	}
+	for _, candidate := range rolePrecedence {
+		for _, role := range roles {
+			if role == candidate {
+				return role
+			}
+		}
+	}
+	return roles[0]
+}
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"context"
+
 	"github.com/google/uuid"
 )
 
@@ -31,3 +33,27 @@ type RBACService interface {
 	// Statistics
 	GetRoleDistribution() (map[Role]int, error)
 }
+
+// WorkspaceAuthorizer checks permissions in the context of a workspace
+type WorkspaceAuthorizer interface {
+	CheckWorkspacePermission(ctx context.Context, workspaceID, userID uuid.UUID, permission Permission) error
+}
+
+// WorkspaceRBACService defines the interface for workspace-scoped role-based access control
+type WorkspaceRBACService interface {
+	WorkspaceAuthorizer
+
+	// Permission catalog
+	GetPermissionCatalog() []PermissionDefinition
+
+	// Effective access
+	GetWorkspaceAccess(ctx context.Context, workspaceID, userID uuid.UUID) (*WorkspaceAccess, error)
+	ValidateWorkspaceRole(ctx context.Context, workspaceID, role string) error
+	AuthorizeRoleAssignment(ctx context.Context, actorID, workspaceID uuid.UUID, role string) error
+
+	// Custom role management
+	ListWorkspaceRoles(ctx context.Context, workspaceID uuid.UUID) ([]*WorkspaceRole, error)
+	CreateWorkspaceRole(ctx context.Context, actorID, workspaceID uuid.UUID, req CreateWorkspaceRoleRequest) (*WorkspaceRole, error)
+	UpdateWorkspaceRole(ctx context.Context, actorID, workspaceID, roleID uuid.UUID, req UpdateWorkspaceRoleRequest) (*WorkspaceRole, error)
+	DeleteWorkspaceRole(ctx context.Context, actorID, workspaceID, roleID uuid.UUID) error
+}
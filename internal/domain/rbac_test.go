@@ -0,0 +1,27 @@
+package domain
+
+import "testing"
+
+func TestHighestRole(t *testing.T) {
+	tests := []struct {
+		name  string
+		roles []Role
+		want  Role
+	}{
+		{"no roles defaults to user", nil, UserRoleType},
+		{"single role", []Role{ViewerRoleType}, ViewerRoleType},
+		{"admin wins regardless of order", []Role{ViewerRoleType, UserRoleType, AdminRoleType}, AdminRoleType},
+		{"admin granted after user", []Role{UserRoleType, AdminRoleType}, AdminRoleType},
+		{"user over viewer", []Role{ViewerRoleType, UserRoleType}, UserRoleType},
+		{"known role over unknown", []Role{"auditor", ViewerRoleType}, ViewerRoleType},
+		{"unknown roles keep the first", []Role{"auditor", "billing"}, "auditor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HighestRole(tt.roles); got != tt.want {
+				t.Fatalf("HighestRole(%v) = %q, want %q", tt.roles, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SCIM 2.0 스키마 URN 상수
+const (
+	SCIMSchemaUser          = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SCIMSchemaGroup         = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SCIMSchemaListResponse  = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SCIMSchemaPatchOp       = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SCIMSchemaError         = "urn:ietf:params:scim:api:messages:2.0:Error"
+	SCIMSchemaServiceConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	SCIMSchemaResourceType  = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+)
+
+// SCIM 오류 타입 상수 (RFC 7644 3.12)
+const (
+	SCIMErrorInvalidFilter = "invalidFilter"
+	SCIMErrorInvalidPath   = "invalidPath"
+	SCIMErrorInvalidValue  = "invalidValue"
+	SCIMErrorInvalidSyntax = "invalidSyntax"
+	SCIMErrorUniqueness    = "uniqueness"
+	SCIMErrorNoTarget      = "noTarget"
+	SCIMErrorMutability    = "mutability"
+)
+
+// SCIMToken: IdP가 SCIM 엔드포인트를 호출할 때 사용하는 전용 Bearer 토큰
+// 평문 토큰은 생성 시 한 번만 반환되며, 해시만 저장됩니다
+type SCIMToken struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	Name        string     `json:"name" gorm:"not null;size:100"`
+	TokenHash   string     `json:"-" gorm:"uniqueIndex;not null;size:64"`
+	TokenPrefix string     `json:"token_prefix" gorm:"not null;size:16"` // Non-secret prefix shown to admins to identify the token
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	CreatedBy   uuid.UUID  `json:"created_by" gorm:"type:uuid;not null"`
+	CreatedAt   time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName: SCIMToken의 테이블 이름을 반환합니다
+func (SCIMToken) TableName() string {
+	return "scim_tokens"
+}
+
+// IsExpired: 토큰이 만료되었는지 확인합니다
+func (t *SCIMToken) IsExpired() bool {
+	return t.ExpiresAt != nil && time.Now().After(*t.ExpiresAt)
+}
+
+// SCIMGroup: IdP에서 SCIM으로 동기화된 그룹
+// 관리자가 워크스페이스를 연결하면 그룹 멤버십이 해당 워크스페이스 역할로 동기화됩니다
+type SCIMGroup struct {
+	ID            uuid.UUID         `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	DisplayName   string            `json:"display_name" gorm:"uniqueIndex;not null;size:255"`
+	ExternalID    string            `json:"external_id,omitempty" gorm:"size:255;index"`
+	WorkspaceID   *uuid.UUID        `json:"workspace_id,omitempty" gorm:"type:uuid;index"` // Workspace whose membership is driven by this group
+	WorkspaceRole string            `json:"workspace_role,omitempty" gorm:"size:20"`       // admin, member
+	CreatedAt     time.Time         `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt     time.Time         `json:"updated_at" gorm:"autoUpdateTime"`
+	Members       []SCIMGroupMember `json:"members,omitempty" gorm:"foreignKey:GroupID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName: SCIMGroup의 테이블 이름을 반환합니다
+func (SCIMGroup) TableName() string {
+	return "scim_groups"
+}
+
+// IsBound: 그룹이 워크스페이스에 연결되어 있는지 확인합니다
+func (g *SCIMGroup) IsBound() bool {
+	return g.WorkspaceID != nil && g.WorkspaceRole != ""
+}
+
+// MemberIDs: 그룹 멤버의 사용자 ID 목록을 반환합니다
+func (g *SCIMGroup) MemberIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(g.Members))
+	for _, member := range g.Members {
+		ids = append(ids, member.UserID)
+	}
+	return ids
+}
+
+// ValidateBinding: 워크스페이스 연결 설정의 유효성을 검사합니다
+func (g *SCIMGroup) ValidateBinding() error {
+	if g.WorkspaceID == nil {
+		if g.WorkspaceRole != "" {
+			return NewDomainError(ErrCodeValidationFailed, "workspace_role requires workspace_id", 400)
+		}
+		return nil
+	}
+	if g.WorkspaceRole != "admin" && g.WorkspaceRole != "member" {
+		return NewDomainError(ErrCodeValidationFailed, "workspace_role must be 'admin' or 'member'", 400)
+	}
+	return nil
+}
+
+// SCIMGroupMember: SCIM 그룹과 사용자의 멤버십
+type SCIMGroupMember struct {
+	GroupID   uuid.UUID `json:"group_id" gorm:"type:uuid;primaryKey"`
+	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;primaryKey;index"`
+	User      *User     `json:"user,omitempty" gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName: SCIMGroupMember의 테이블 이름을 반환합니다
+func (SCIMGroupMember) TableName() string {
+	return "scim_group_members"
+}
+
+// SCIMFilter: 파싱된 SCIM 필터 표현식 (RFC 7644 3.4.2.2)
+// Operator가 and/or/not이면 Children을, 그 외에는 Attribute와 Value를 사용합니다
+type SCIMFilter struct {
+	Operator  string        `json:"operator"` // and, or, not, eq, ne, co, sw, ew, gt, ge, lt, le, pr
+	Attribute string        `json:"attribute,omitempty"`
+	Value     interface{}   `json:"value,omitempty"`
+	Children  []*SCIMFilter `json:"children,omitempty"`
+}
+
+// IsLogical: 논리 연산자 노드인지 확인합니다
+func (f *SCIMFilter) IsLogical() bool {
+	switch f.Operator {
+	case "and", "or", "not":
+		return true
+	}
+	return false
+}
+
+// Attributes: 필터에서 참조하는 모든 속성 경로를 반환합니다
+func (f *SCIMFilter) Attributes() []string {
+	if f == nil {
+		return nil
+	}
+	if !f.IsLogical() {
+		return []string{f.Attribute}
+	}
+	var attributes []string
+	for _, child := range f.Children {
+		attributes = append(attributes, child.Attributes()...)
+	}
+	return attributes
+}
+
+// SCIMUserAttributes: SCIM User 리소스에서 SkyClust 사용자로 매핑되는 속성
+type SCIMUserAttributes struct {
+	UserName   string
+	ExternalID string
+	Email      string
+	Password   string
+	Active     *bool
+}
+
+// Normalize: 비교와 저장에 사용할 수 있도록 속성 값을 정리합니다
+func (a *SCIMUserAttributes) Normalize() {
+	a.UserName = strings.TrimSpace(a.UserName)
+	a.ExternalID = strings.TrimSpace(a.ExternalID)
+	a.Email = strings.ToLower(strings.TrimSpace(a.Email))
+	if a.Email == "" && strings.Contains(a.UserName, "@") {
+		a.Email = strings.ToLower(a.UserName)
+	}
+}
+
+// SCIMGroupAttributes: SCIM Group 리소스의 속성
+type SCIMGroupAttributes struct {
+	DisplayName string
+	ExternalID  string
+	Members     []string
+}
+
+// SCIMPatchOperation: SCIM PATCH 요청의 단일 연산 (RFC 7644 3.5.2)
+type SCIMPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// NewSCIMError: SCIM 오류 타입을 포함한 도메인 오류를 생성합니다
+func NewSCIMError(scimType, message string, statusCode int) *DomainError {
+	code := ErrCodeBadRequest
+	switch statusCode {
+	case 404:
+		code = ErrCodeNotFound
+	case 409:
+		code = ErrCodeConflict
+	}
+	err := NewDomainError(code, message, statusCode)
+	err.Details["scim_type"] = scimType
+	return err
+}
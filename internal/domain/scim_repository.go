@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SCIMRepository defines the interface for SCIM token, group and user query operations
+type SCIMRepository interface {
+	// Tokens
+	CreateToken(token *SCIMToken) error
+	GetTokenByHash(hash string) (*SCIMToken, error)
+	ListTokens() ([]*SCIMToken, error)
+	DeleteToken(id uuid.UUID) error
+	TouchToken(id uuid.UUID, usedAt time.Time) error
+
+	// Users
+	SearchUsers(filter *SCIMFilter, offset, limit int) ([]*User, int64, error)
+
+	// Groups
+	CreateGroup(group *SCIMGroup) error
+	GetGroupByID(id uuid.UUID) (*SCIMGroup, error)
+	GetGroupByDisplayName(displayName string) (*SCIMGroup, error)
+	SearchGroups(filter *SCIMFilter, offset, limit int) ([]*SCIMGroup, int64, error)
+	UpdateGroup(group *SCIMGroup) error
+	DeleteGroup(id uuid.UUID) error
+	ReplaceGroupMembers(groupID uuid.UUID, userIDs []uuid.UUID) error
+	AddGroupMembers(groupID uuid.UUID, userIDs []uuid.UUID) error
+	RemoveGroupMembers(groupID uuid.UUID, userIDs []uuid.UUID) error
+	ListGroupsByUser(userID uuid.UUID) ([]*SCIMGroup, error)
+	ListBoundGroups() ([]*SCIMGroup, error)
+}
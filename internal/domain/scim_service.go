@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SCIMService defines the interface for SCIM 2.0 provisioning
+type SCIMService interface {
+	// Token management (admin only)
+	AuthenticateToken(ctx context.Context, token string) (*SCIMToken, error)
+	CreateToken(ctx context.Context, adminID uuid.UUID, name string, expiresAt *time.Time) (*SCIMToken, string, error)
+	ListTokens(ctx context.Context) ([]*SCIMToken, error)
+	RevokeToken(ctx context.Context, adminID uuid.UUID, tokenID uuid.UUID) error
+
+	// Users
+	ListUsers(ctx context.Context, filter string, startIndex, count int) ([]*User, int64, error)
+	GetUser(ctx context.Context, userID string) (*User, error)
+	CreateUser(ctx context.Context, token *SCIMToken, attrs SCIMUserAttributes) (*User, error)
+	ReplaceUser(ctx context.Context, token *SCIMToken, userID string, attrs SCIMUserAttributes) (*User, error)
+	PatchUser(ctx context.Context, token *SCIMToken, userID string, operations []SCIMPatchOperation) (*User, error)
+	DeleteUser(ctx context.Context, token *SCIMToken, userID string) error
+	GetUserGroups(ctx context.Context, userID uuid.UUID) ([]*SCIMGroup, error)
+
+	// Groups
+	ListGroups(ctx context.Context, filter string, startIndex, count int) ([]*SCIMGroup, int64, error)
+	GetGroup(ctx context.Context, groupID string) (*SCIMGroup, error)
+	CreateGroup(ctx context.Context, token *SCIMToken, attrs SCIMGroupAttributes) (*SCIMGroup, error)
+	ReplaceGroup(ctx context.Context, token *SCIMToken, groupID string, attrs SCIMGroupAttributes) (*SCIMGroup, error)
+	PatchGroup(ctx context.Context, token *SCIMToken, groupID string, operations []SCIMPatchOperation) (*SCIMGroup, error)
+	DeleteGroup(ctx context.Context, token *SCIMToken, groupID string) error
+
+	// Workspace binding (admin only)
+	BindGroupWorkspace(ctx context.Context, adminID uuid.UUID, groupID uuid.UUID, workspaceID *uuid.UUID, workspaceRole string) (*SCIMGroup, error)
+}
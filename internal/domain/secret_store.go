@@ -0,0 +1,28 @@
+package domain
+
+import "context"
+
+// 외부 비밀 저장소 이름
+const (
+	SecretStoreVault = "vault"
+	SecretStoreFile  = "file"
+)
+
+// SecretStore: 자격증명 비밀 값을 보관하는 외부 저장소
+type SecretStore interface {
+	// Name: 저장소 이름 (Credential.SecretStore 값)
+	Name() string
+	// GetSecret: 경로의 비밀 값을 조회합니다
+	GetSecret(ctx context.Context, path string) (map[string]interface{}, error)
+}
+
+// SecretReference: 외부 비밀 저장소에 있는 자격증명 비밀 위치
+type SecretReference struct {
+	Store string `json:"store" validate:"required,oneof=vault file"`
+	Path  string `json:"path" validate:"required,min=1,max=512"`
+}
+
+// HasExternalSecret: 자격증명 데이터가 외부 비밀 저장소에 있는지 확인합니다
+func (c *Credential) HasExternalSecret() bool {
+	return c.SecretStore != ""
+}
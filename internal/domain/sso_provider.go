@@ -0,0 +1,245 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SSOProvider: 관리자가 구성하는 조직 단위 SSO 아이덴티티 제공자를 나타내는 도메인 엔티티
+// 사용자별 OIDCProvider와 달리 모든 사용자가 공유하며, 이메일 도메인으로 검색됩니다
+type SSOProvider struct {
+	ID            uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	Name          string    `json:"name" gorm:"uniqueIndex;not null;size:100"`
+	Protocol      string    `json:"protocol" gorm:"not null;size:10;default:oidc"` // oidc, saml
+	ProviderType  string    `json:"provider_type" gorm:"not null;size:50"`         // google, github, azure, microsoft, custom, saml
+	ClientID      string    `json:"client_id" gorm:"size:255"`
+	ClientSecret  string    `json:"-" gorm:"size:255"` // Encrypted, not returned in JSON
+	RedirectURL   string    `json:"redirect_url" gorm:"size:500"`
+	AuthURL       string    `json:"auth_url" gorm:"size:500"`
+	TokenURL      string    `json:"token_url" gorm:"size:500"`
+	UserInfoURL   string    `json:"user_info_url" gorm:"size:500"`
+	Scopes        string    `json:"scopes" gorm:"size:500"`                      // Comma-separated scopes
+	Domains       string    `json:"domains" gorm:"size:1000"`                    // Comma-separated email domains used for IdP discovery
+	GroupsClaim   string    `json:"groups_claim" gorm:"size:100;default:groups"` // Claim that carries IdP group names
+	AutoProvision bool      `json:"auto_provision" gorm:"default:true"`          // Just-in-time user provisioning
+	DefaultRole   Role      `json:"default_role" gorm:"size:20;default:user"`    // Role assigned to provisioned users without a mapped role
+	Enabled       bool      `json:"enabled" gorm:"default:true"`
+
+	// SAML 2.0 settings (protocol = saml)
+	SAMLIdPMetadata   string `json:"saml_idp_metadata,omitempty" gorm:"type:text"`   // IdP metadata XML (entity ID, SSO/SLO endpoints, signing certificates)
+	SAMLBaseURL       string `json:"saml_base_url,omitempty" gorm:"size:500"`        // Public base URL used to build the SP metadata, ACS and SLO URLs
+	SAMLSPCertificate string `json:"saml_sp_certificate,omitempty" gorm:"type:text"` // SP signing certificate (PEM)
+	SAMLSPPrivateKey  string `json:"-" gorm:"type:text"`                             // SP signing key (PEM, encrypted)
+	EmailAttribute    string `json:"email_attribute,omitempty" gorm:"size:255"`      // Assertion attribute mapped to User.Email
+	UsernameAttribute string `json:"username_attribute,omitempty" gorm:"size:255"`   // Assertion attribute mapped to User.Username
+
+	CreatedBy uuid.UUID `json:"created_by" gorm:"type:uuid"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+
+	// Relationships
+	GroupMappings []SSOGroupMapping `json:"group_mappings,omitempty" gorm:"foreignKey:ProviderID;constraint:OnDelete:CASCADE"`
+}
+
+// SSO 프로토콜 상수
+const (
+	SSOProtocolOIDC = "oidc"
+	SSOProtocolSAML = "saml"
+)
+
+// TableName: SSOProvider의 테이블 이름을 반환합니다
+func (SSOProvider) TableName() string {
+	return "sso_providers"
+}
+
+// IsSAML: SAML 2.0 프로바이더인지 확인합니다
+func (p *SSOProvider) IsSAML() bool {
+	return p.Protocol == SSOProtocolSAML
+}
+
+// SAMLMetadataURL: SP 메타데이터 URL을 반환합니다 (SP 엔티티 ID로도 사용)
+func (p *SSOProvider) SAMLMetadataURL() string {
+	return p.samlEndpoint("metadata")
+}
+
+// SAMLACSURL: Assertion Consumer Service URL을 반환합니다
+func (p *SSOProvider) SAMLACSURL() string {
+	return p.samlEndpoint("acs")
+}
+
+// SAMLSLOURL: Single Logout 서비스 URL을 반환합니다
+func (p *SSOProvider) SAMLSLOURL() string {
+	return p.samlEndpoint("slo")
+}
+
+// samlEndpoint: SP 엔드포인트 URL을 구성합니다
+func (p *SSOProvider) samlEndpoint(name string) string {
+	return strings.TrimRight(p.SAMLBaseURL, "/") + "/api/v1/auth/saml/" + p.ID.String() + "/" + name
+}
+
+// IsEnabled: 제공자가 활성화되어 있는지 확인합니다
+func (p *SSOProvider) IsEnabled() bool {
+	return p.Enabled
+}
+
+// SubjectNamespace: 이 제공자로 로그인한 사용자의 User.OIDCProvider 값을 반환합니다
+func (p *SSOProvider) SubjectNamespace() string {
+	return "sso:" + p.ID.String()
+}
+
+// GetDomains: 구성된 이메일 도메인 목록을 소문자로 반환합니다
+func (p *SSOProvider) GetDomains() []string {
+	var domains []string
+	for _, part := range strings.Split(p.Domains, ",") {
+		domain := strings.ToLower(strings.TrimSpace(part))
+		if domain != "" {
+			domains = append(domains, domain)
+		}
+	}
+	return domains
+}
+
+// MatchesEmail: 이메일 주소의 도메인이 제공자에 구성된 도메인과 일치하는지 확인합니다
+func (p *SSOProvider) MatchesEmail(email string) bool {
+	at := strings.LastIndex(email, "@")
+	if at < 0 || at == len(email)-1 {
+		return false
+	}
+	emailDomain := strings.ToLower(email[at+1:])
+	for _, domain := range p.GetDomains() {
+		if emailDomain == domain {
+			return true
+		}
+	}
+	return false
+}
+
+// GetGroupsClaim: 그룹 클레임 이름을 반환합니다 (기본값: groups)
+func (p *SSOProvider) GetGroupsClaim() string {
+	if p.GroupsClaim == "" {
+		return "groups"
+	}
+	return p.GroupsClaim
+}
+
+// ToOIDCProvider: OAuth2 흐름을 재사용하기 위해 OIDCProvider 형태로 변환합니다
+func (p *SSOProvider) ToOIDCProvider() *OIDCProvider {
+	return &OIDCProvider{
+		ID:           p.ID,
+		Name:         p.Name,
+		ProviderType: p.ProviderType,
+		ClientID:     p.ClientID,
+		ClientSecret: p.ClientSecret,
+		RedirectURL:  p.RedirectURL,
+		AuthURL:      p.AuthURL,
+		TokenURL:     p.TokenURL,
+		UserInfoURL:  p.UserInfoURL,
+		Scopes:       p.Scopes,
+		Enabled:      p.Enabled,
+	}
+}
+
+// SSOGroupMapping: IdP 그룹을 시스템 역할 및 워크스페이스 멤버십에 매핑하는 엔티티
+type SSOGroupMapping struct {
+	ID            uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	ProviderID    uuid.UUID  `json:"provider_id" gorm:"type:uuid;not null;index"`
+	Group         string     `json:"group" gorm:"column:group_name;not null;size:255"`
+	Role          Role       `json:"role,omitempty" gorm:"size:20"`                 // Optional system role granted to group members
+	WorkspaceID   *uuid.UUID `json:"workspace_id,omitempty" gorm:"type:uuid;index"` // Optional workspace membership
+	WorkspaceRole string     `json:"workspace_role,omitempty" gorm:"size:20"`       // admin or member
+	CreatedAt     time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName: SSOGroupMapping의 테이블 이름을 반환합니다
+func (SSOGroupMapping) TableName() string {
+	return "sso_group_mappings"
+}
+
+// Validate: 그룹 매핑의 유효성을 검사합니다
+func (m *SSOGroupMapping) Validate() error {
+	if strings.TrimSpace(m.Group) == "" {
+		return NewDomainError(ErrCodeValidationFailed, "group is required", 400)
+	}
+	if m.Role == "" && m.WorkspaceID == nil {
+		return NewDomainError(ErrCodeValidationFailed, "mapping must grant a role or a workspace membership", 400)
+	}
+	if m.Role != "" && m.Role != AdminRoleType && m.Role != UserRoleType && m.Role != ViewerRoleType {
+		return NewDomainError(ErrCodeValidationFailed, "invalid role. Must be 'admin', 'user' or 'viewer'", 400)
+	}
+	if m.WorkspaceID != nil && m.WorkspaceRole != "admin" && m.WorkspaceRole != "member" {
+		return NewDomainError(ErrCodeValidationFailed, "invalid workspace role. Must be 'admin' or 'member'", 400)
+	}
+	return nil
+}
+
+// UpdateSSOProviderRequest: SSO 프로바이더 업데이트 요청
+type UpdateSSOProviderRequest struct {
+	Name          *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	ClientID      *string `json:"client_id,omitempty" validate:"omitempty,min=1"`
+	ClientSecret  *string `json:"client_secret,omitempty" validate:"omitempty,min=1"`
+	RedirectURL   *string `json:"redirect_url,omitempty" validate:"omitempty,url"`
+	AuthURL       *string `json:"auth_url,omitempty" validate:"omitempty,url"`
+	TokenURL      *string `json:"token_url,omitempty" validate:"omitempty,url"`
+	UserInfoURL   *string `json:"user_info_url,omitempty" validate:"omitempty,url"`
+	Scopes        *string `json:"scopes,omitempty"`
+	Domains       *string `json:"domains,omitempty"`
+	GroupsClaim   *string `json:"groups_claim,omitempty"`
+	AutoProvision *bool   `json:"auto_provision,omitempty"`
+	DefaultRole   *string `json:"default_role,omitempty" validate:"omitempty,oneof=admin user viewer"`
+	Enabled       *bool   `json:"enabled,omitempty"`
+
+	SAMLIdPMetadata   *string `json:"saml_idp_metadata,omitempty"`
+	SAMLBaseURL       *string `json:"saml_base_url,omitempty" validate:"omitempty,url"`
+	EmailAttribute    *string `json:"email_attribute,omitempty"`
+	UsernameAttribute *string `json:"username_attribute,omitempty"`
+}
+
+// SSOMappingResult: 사용자의 그룹에 매핑을 적용한 결과
+type SSOMappingResult struct {
+	Roles          []Role            // Granted system roles
+	ManagedRoles   map[Role]bool     // All system roles managed by the provider's mappings
+	WorkspaceRoles map[string]string // Workspace ID -> workspace role
+	Workspaces     map[string]bool   // All workspaces managed by the provider's mappings
+}
+
+// ResolveSSOGroupMappings: IdP 그룹 목록에 해당하는 역할과 워크스페이스 멤버십을 계산합니다
+// 같은 워크스페이스에 여러 매핑이 일치하면 admin이 member보다 우선합니다
+func ResolveSSOGroupMappings(mappings []SSOGroupMapping, groups []string) *SSOMappingResult {
+	result := &SSOMappingResult{
+		ManagedRoles:   make(map[Role]bool),
+		WorkspaceRoles: make(map[string]string),
+		Workspaces:     make(map[string]bool),
+	}
+
+	memberOf := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		memberOf[group] = true
+	}
+
+	grantedRoles := make(map[Role]bool)
+	for _, mapping := range mappings {
+		if mapping.Role != "" {
+			result.ManagedRoles[mapping.Role] = true
+		}
+		if mapping.WorkspaceID != nil {
+			result.Workspaces[mapping.WorkspaceID.String()] = true
+		}
+		if !memberOf[mapping.Group] {
+			continue
+		}
+		if mapping.Role != "" && !grantedRoles[mapping.Role] {
+			grantedRoles[mapping.Role] = true
+			result.Roles = append(result.Roles, mapping.Role)
+		}
+		if mapping.WorkspaceID != nil {
+			workspaceID := mapping.WorkspaceID.String()
+			if result.WorkspaceRoles[workspaceID] != "admin" {
+				result.WorkspaceRoles[workspaceID] = mapping.WorkspaceRole
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// SSOProviderRepository defines the interface for organization-level SSO provider persistence
+type SSOProviderRepository interface {
+	Create(provider *SSOProvider) error
+	GetByID(id uuid.UUID) (*SSOProvider, error)
+	GetByName(name string) (*SSOProvider, error)
+	List() ([]*SSOProvider, error)
+	ListEnabled() ([]*SSOProvider, error)
+	Update(provider *SSOProvider) error
+	Delete(id uuid.UUID) error
+	ReplaceGroupMappings(providerID uuid.UUID, mappings []SSOGroupMapping) error
+}
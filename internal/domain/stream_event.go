@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// StreamEvent: SSE로 전달되는 리소스 이벤트 기록
+// ID는 단조 증가하며 SSE 이벤트 ID(Last-Event-ID)로 사용됩니다
+type StreamEvent struct {
+	ID           int64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	Subject      string    `json:"subject" gorm:"size:255;not null"`
+	EventType    string    `json:"event_type" gorm:"size:100;not null"`
+	CredentialID string    `json:"credential_id,omitempty" gorm:"size:64;index:idx_stream_events_resource,priority:1"`
+	ResourceType string    `json:"resource_type,omitempty" gorm:"size:50;index:idx_stream_events_resource,priority:2"`
+	ResourceID   string    `json:"resource_id,omitempty" gorm:"size:255;index:idx_stream_events_resource,priority:3"`
+	Data         JSONBMap  `json:"data" gorm:"type:jsonb"`
+	CreatedAt    time.Time `json:"created_at" gorm:"index"`
+}
+
+// TableName: StreamEvent의 테이블 이름을 반환합니다
+func (StreamEvent) TableName() string {
+	return "stream_events"
+}
+
+// StreamEventHistoryFilter: 리소스별 이벤트 기록 조회 조건
+type StreamEventHistoryFilter struct {
+	CredentialID string
+	ResourceType string // 예: clusters, nodepools, vpcs, subnets, security-groups
+	ResourceID   string
+	Limit        int
+}
@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+// StreamEventRepository: SSE 이벤트 기록(링 버퍼) 저장소 인터페이스
+type StreamEventRepository interface {
+	// Append: 이벤트를 기록하고 ID를 할당합니다
+	Append(event *StreamEvent) error
+	// ListAfter: ID 이후의 이벤트를 ID 순으로 조회합니다 (재연결 시 재전송)
+	ListAfter(afterID int64, limit int) ([]*StreamEvent, error)
+	// ListHistory: 리소스의 최근 이벤트를 ID 순으로 조회합니다
+	ListHistory(filter StreamEventHistoryFilter) ([]*StreamEvent, error)
+	// GetOldestID: 남아 있는 가장 오래된 이벤트 ID를 반환합니다 (없으면 0)
+	GetOldestID() (int64, error)
+	// Trim: 최근 keep개를 넘거나 before 이전에 기록된 이벤트를 삭제합니다
+	Trim(keep int, before time.Time) (int64, error)
+}
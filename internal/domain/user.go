@@ -12,8 +12,9 @@ type User struct {
 	Username     string    `json:"username" gorm:"not null;size:50"` // 고유하지 않음 - 여러 사용자가 동일한 사용자명을 가질 수 있음
 	Email        string    `json:"email" gorm:"uniqueIndex;not null;size:100"`
 	PasswordHash string    `json:"-" gorm:"column:password_hash;not null;size:255"`
-	OIDCProvider string    `json:"oidc_provider,omitempty" gorm:"size:20"` // google, github, azure
-	OIDCSubject  string    `json:"oidc_subject,omitempty" gorm:"size:100"` // OIDC subject ID
+	OIDCProvider string    `json:"oidc_provider,omitempty" gorm:"size:50"`      // google, github, azure, sso:<provider id>
+	OIDCSubject  string    `json:"oidc_subject,omitempty" gorm:"size:100"`      // OIDC subject ID
+	ExternalID   string    `json:"external_id,omitempty" gorm:"size:255;index"` // SCIM externalId assigned by the provisioning IdP
 	Active       bool      `json:"is_active" gorm:"default:true"`
 	CreatedAt    time.Time `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt    time.Time `json:"updated_at" gorm:"autoUpdateTime"`
@@ -33,6 +33,7 @@ type AuthService interface {
 	LoginWithContext(email, password, clientIP, userAgent string) (*User, string, error) // Returns user and JWT token with context
 	ValidateToken(token string) (*User, error)
 	Logout(userID uuid.UUID, token string) error
+	IssueToken(user *User) (string, error) // Issues a JWT for an already authenticated user (OIDC/SSO)
 }
 
 // OIDCService defines the interface for OIDC authentication
@@ -48,6 +49,22 @@ type OIDCService interface {
 	GetProvider(ctx context.Context, userID uuid.UUID, providerID uuid.UUID) (*OIDCProvider, error)
 	UpdateProvider(ctx context.Context, userID uuid.UUID, providerID uuid.UUID, provider *OIDCProvider) (*OIDCProvider, error)
 	DeleteProvider(ctx context.Context, userID uuid.UUID, providerID uuid.UUID) error
+
+	// Organization SSO provider management (admin only)
+	CreateSSOProvider(ctx context.Context, adminID uuid.UUID, provider *SSOProvider) (*SSOProvider, error)
+	ListSSOProviders(ctx context.Context) ([]*SSOProvider, error)
+	GetSSOProvider(ctx context.Context, providerID uuid.UUID) (*SSOProvider, error)
+	UpdateSSOProvider(ctx context.Context, adminID uuid.UUID, providerID uuid.UUID, req UpdateSSOProviderRequest) (*SSOProvider, error)
+	DeleteSSOProvider(ctx context.Context, adminID uuid.UUID, providerID uuid.UUID) error
+	SetSSOGroupMappings(ctx context.Context, adminID uuid.UUID, providerID uuid.UUID, mappings []SSOGroupMapping) (*SSOProvider, error)
+	DiscoverSSOProvider(ctx context.Context, email string) (*SSOProvider, error)
+
+	// SAML 2.0 service provider
+	GetSAMLMetadata(ctx context.Context, providerID uuid.UUID) ([]byte, error)
+	GetSAMLAuthURL(ctx context.Context, providerID uuid.UUID, relayState string) (string, error)
+	ConsumeSAMLResponse(ctx context.Context, providerID uuid.UUID, samlResponse, relayState string) (*User, string, error)
+	InitiateSAMLLogout(ctx context.Context, providerID, userID uuid.UUID, token, relayState string) (string, error)
+	HandleSAMLLogout(ctx context.Context, providerID uuid.UUID, samlRequest, samlResponse, relayState string, redirectBinding bool) (string, error)
 }
 
 // LogoutService defines the interface for logout operations
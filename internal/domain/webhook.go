@@ -0,0 +1,291 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"skyclust/pkg/security"
+
+	"github.com/google/uuid"
+)
+
+// 웹훅 전송 상태
+const (
+	WebhookDeliveryPending   = "pending"   // 전송 대기 또는 재시도 대기
+	WebhookDeliverySucceeded = "succeeded" // 2xx 응답
+	WebhookDeliveryFailed    = "failed"    // 재시도 횟수 초과
+)
+
+// 웹훅 요청 헤더
+// 서명은 "<timestamp>.<body>"에 대한 HMAC-SHA256이며 "sha256=<hex>" 형식으로 전달됩니다
+const (
+	WebhookSignatureHeader = "X-SkyClust-Signature"
+	WebhookTimestampHeader = "X-SkyClust-Timestamp"
+	WebhookEventHeader     = "X-SkyClust-Event"
+	WebhookDeliveryHeader  = "X-SkyClust-Delivery"
+)
+
+const (
+	// MaxWebhookEventTypes: 구독 하나에 등록할 수 있는 최대 이벤트 패턴 수
+	MaxWebhookEventTypes = 32
+	// MaxWebhookAttempts: 전송 하나의 최대 시도 횟수 (30초부터 두 배씩, 최대 1시간 간격)
+	MaxWebhookAttempts = 8
+	// WebhookDisableAfterFailures: 자동 비활성화에 필요한 최소 연속 실패 시도 수
+	WebhookDisableAfterFailures = 10
+	// WebhookDisableAfter: 자동 비활성화까지 실패가 지속되어야 하는 기간
+	WebhookDisableAfter = 24 * time.Hour
+	// minWebhookSecretLength, maxWebhookSecretLength: 서명 비밀키 길이 제한
+	minWebhookSecretLength = 16
+	maxWebhookSecretLength = 256
+	// webhookHostLookupTimeout: 검증 시 웹훅 호스트 이름 해석 제한 시간
+	webhookHostLookupTimeout = 5 * time.Second
+)
+
+// WebhookSubscription: 워크스페이스 이벤트를 외부 URL로 전송하는 웹훅 구독
+// 이벤트 패턴은 NATS subject 와일드카드를 따릅니다 (kubernetes.*.*.*.clusters.created, network.>, credential.>)
+type WebhookSubscription struct {
+	ID                  uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	WorkspaceID         string     `json:"workspace_id" gorm:"type:uuid;not null;index"`
+	Name                string     `json:"name" gorm:"not null;size:100"`
+	URL                 string     `json:"url" gorm:"not null;size:2048"`
+	EventTypes          StringList `json:"event_types" gorm:"type:jsonb;not null"`
+	Secret              string     `json:"-" gorm:"not null;size:512"` // Encrypted, not returned in JSON
+	Enabled             bool       `json:"enabled" gorm:"not null"`
+	DisabledReason      string     `json:"disabled_reason,omitempty" gorm:"size:500"`
+	DisabledAt          *time.Time `json:"disabled_at,omitempty"`
+	ConsecutiveFailures int        `json:"consecutive_failures" gorm:"not null;default:0"` // 마지막 성공 이후 실패한 시도 수
+	FailingSince        *time.Time `json:"failing_since,omitempty"`                        // 연속 실패가 시작된 시각
+	LastDeliveryAt      *time.Time `json:"last_delivery_at,omitempty"`
+	LastSuccessAt       *time.Time `json:"last_success_at,omitempty"`
+	CreatedBy           *uuid.UUID `json:"created_by,omitempty" gorm:"type:uuid"`
+	CreatedAt           time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt           time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName: WebhookSubscription의 테이블 이름을 반환합니다
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// Validate: 웹훅 구독 정의를 검증합니다
+func (s *WebhookSubscription) Validate() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return NewDomainError(ErrCodeValidationFailed, "webhook name is required", 400)
+	}
+	if err := ValidateWebhookURL(s.URL); err != nil {
+		return err
+	}
+	if len(s.EventTypes) == 0 {
+		return NewDomainError(ErrCodeValidationFailed, "at least one event type is required", 400)
+	}
+	if len(s.EventTypes) > MaxWebhookEventTypes {
+		return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("a webhook can subscribe to at most %d event types", MaxWebhookEventTypes), 400)
+	}
+	for _, pattern := range s.EventTypes {
+		if err := ValidateEventPattern(pattern); err != nil {
+			return err
+		}
+	}
+	if len(s.Secret) < minWebhookSecretLength || len(s.Secret) > maxWebhookSecretLength {
+		return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("webhook secret must be %d to %d characters", minWebhookSecretLength, maxWebhookSecretLength), 400)
+	}
+	return nil
+}
+
+// Matches: 구독이 이벤트 타입을 수신하는지 확인합니다
+func (s *WebhookSubscription) Matches(eventType string) bool {
+	for _, pattern := range s.EventTypes {
+		if MatchEventPattern(pattern, eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+// RecordAttempt: 전송 시도 결과로 연속 실패 상태를 갱신합니다
+// 실패가 WebhookDisableAfter 이상 지속되고 WebhookDisableAfterFailures회 이상 연속되면 비활성화하고 true를 반환합니다
+func (s *WebhookSubscription) RecordAttempt(succeeded bool, at time.Time) bool {
+	s.LastDeliveryAt = &at
+	if succeeded {
+		s.LastSuccessAt = &at
+		s.ConsecutiveFailures = 0
+		s.FailingSince = nil
+		return false
+	}
+
+	s.ConsecutiveFailures++
+	if s.FailingSince == nil {
+		s.FailingSince = &at
+	}
+	if !s.Enabled || s.ConsecutiveFailures < WebhookDisableAfterFailures || at.Sub(*s.FailingSince) < WebhookDisableAfter {
+		return false
+	}
+
+	s.Enabled = false
+	s.DisabledAt = &at
+	s.DisabledReason = fmt.Sprintf("disabled after %d consecutive failed deliveries since %s", s.ConsecutiveFailures, s.FailingSince.UTC().Format(time.RFC3339))
+	return true
+}
+
+// Enable: 구독을 활성화하고 자동 비활성화 상태와 연속 실패 수를 초기화합니다
+func (s *WebhookSubscription) Enable() {
+	s.Enabled = true
+	s.DisabledAt = nil
+	s.DisabledReason = ""
+	s.ConsecutiveFailures = 0
+	s.FailingSince = nil
+}
+
+// ValidateWebhookURL: 웹훅 URL이 https 절대 URL이고 공인 주소로만 해석되는지 검증합니다
+// 전송 시에도 연결 주소를 다시 확인하므로 DNS 재바인딩으로 우회할 수 없습니다
+func ValidateWebhookURL(raw string) error {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" {
+		return NewDomainError(ErrCodeValidationFailed, "webhook url must be an absolute URL", 400)
+	}
+	if parsed.Scheme != "https" {
+		return NewDomainError(ErrCodeValidationFailed, "webhook url must use https", 400)
+	}
+	if parsed.User != nil {
+		return NewDomainError(ErrCodeValidationFailed, "webhook url must not contain credentials; use the signing secret instead", 400)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), webhookHostLookupTimeout)
+	defer cancel()
+	if err := security.CheckPublicHost(ctx, parsed.Hostname()); err != nil {
+		if errors.Is(err, security.ErrNonPublicAddress) {
+			return NewDomainError(ErrCodeValidationFailed, "webhook url must not point to a loopback, private, link-local or reserved address", 400)
+		}
+		return NewDomainError(ErrCodeValidationFailed, "webhook url host could not be resolved", 400)
+	}
+	return nil
+}
+
+// ValidateEventPattern: NATS subject 형식의 이벤트 패턴을 검증합니다
+// 토큰은 '.'으로 구분하며 '*'는 토큰 하나, 마지막 토큰의 '>'는 나머지 전체와 일치합니다
+func ValidateEventPattern(pattern string) error {
+	if pattern == "" {
+		return NewDomainError(ErrCodeValidationFailed, "event type pattern must not be empty", 400)
+	}
+	tokens := strings.Split(pattern, ".")
+	for i, token := range tokens {
+		switch {
+		case token == "":
+			return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("invalid event type pattern %q: empty token", pattern), 400)
+		case token == ">" && i != len(tokens)-1:
+			return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("invalid event type pattern %q: '>' must be the last token", pattern), 400)
+		case token != "*" && token != ">" && strings.ContainsAny(token, "*> \t"):
+			return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("invalid event type pattern %q: wildcards must be whole tokens", pattern), 400)
+		}
+	}
+	return nil
+}
+
+// MatchEventPattern: 이벤트 타입이 NATS subject 패턴과 일치하는지 확인합니다
+func MatchEventPattern(pattern, eventType string) bool {
+	patternTokens := strings.Split(pattern, ".")
+	typeTokens := strings.Split(eventType, ".")
+	for i, token := range patternTokens {
+		if token == ">" {
+			return len(typeTokens) > i
+		}
+		if i >= len(typeTokens) {
+			return false
+		}
+		if token != "*" && token != typeTokens[i] {
+			return false
+		}
+	}
+	return len(patternTokens) == len(typeTokens)
+}
+
+// WebhookEvent: 웹훅으로 전송할 플랫폼 이벤트
+type WebhookEvent struct {
+	Type        string                 `json:"type"` // messaging/topics.go의 subject (kubernetes.aws.<credential_id>.<region>.clusters.created)
+	WorkspaceID string                 `json:"workspace_id,omitempty"`
+	Data        map[string]interface{} `json:"data"`
+	Timestamp   int64                  `json:"timestamp"`
+}
+
+// WebhookPayload: 웹훅 요청 본문
+type WebhookPayload struct {
+	ID          string                 `json:"id"` // 이벤트 ID (재전송 시에도 동일, 수신 측 중복 제거용)
+	Type        string                 `json:"type"`
+	WorkspaceID string                 `json:"workspace_id"`
+	CreatedAt   time.Time              `json:"created_at"`
+	Data        map[string]interface{} `json:"data"`
+}
+
+// WebhookDelivery: 웹훅 전송 기록
+type WebhookDelivery struct {
+	ID             uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	SubscriptionID uuid.UUID  `json:"subscription_id" gorm:"type:uuid;not null;index:idx_webhook_deliveries_subscription,priority:1"`
+	WorkspaceID    string     `json:"workspace_id" gorm:"type:uuid;not null;index"`
+	EventID        uuid.UUID  `json:"event_id" gorm:"type:uuid;not null;index"`
+	EventType      string     `json:"event_type" gorm:"not null;size:255"`
+	Payload        JSONBMap   `json:"payload" gorm:"type:jsonb;not null"` // WebhookPayload
+	Status         string     `json:"status" gorm:"not null;size:20;index:idx_webhook_deliveries_due,priority:1"`
+	Attempts       int        `json:"attempts" gorm:"not null;default:0"`
+	NextAttemptAt  time.Time  `json:"next_attempt_at" gorm:"not null;index:idx_webhook_deliveries_due,priority:2"`
+	ResponseCode   int        `json:"response_code,omitempty"`
+	ResponseBody   string     `json:"response_body,omitempty" gorm:"type:text"` // 앞부분만 저장
+	Error          string     `json:"error,omitempty" gorm:"type:text"`
+	DurationMs     int64      `json:"duration_ms,omitempty"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+	RedeliveryOf   *uuid.UUID `json:"redelivery_of,omitempty" gorm:"type:uuid"` // 수동 재전송의 원본 전송 ID
+	CreatedAt      time.Time  `json:"created_at" gorm:"autoCreateTime;index:idx_webhook_deliveries_subscription,priority:2,sort:desc"`
+	UpdatedAt      time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName: WebhookDelivery의 테이블 이름을 반환합니다
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// WebhookRetryDelay: 실패한 시도 횟수에 따른 재시도 간격을 반환합니다
+func WebhookRetryDelay(attempts int) time.Duration {
+	delay := 30 * time.Second
+	for i := 1; i < attempts && delay < time.Hour; i++ {
+		delay *= 2
+	}
+	if delay > time.Hour {
+		delay = time.Hour
+	}
+	return delay
+}
+
+// WebhookDeliveryFilter: 전송 기록 조회 조건
+type WebhookDeliveryFilter struct {
+	Status string
+	Limit  int
+	Offset int
+}
+
+// CreateWebhookRequest: 웹훅 구독 생성 요청
+type CreateWebhookRequest struct {
+	Name       string   `json:"name" validate:"required,min=1,max=100"`
+	URL        string   `json:"url" validate:"required,url"`
+	EventTypes []string `json:"event_types" validate:"required,min=1"`
+	Secret     string   `json:"secret,omitempty"` // 비어 있으면 생성하여 응답에 한 번만 포함
+	Enabled    *bool    `json:"enabled,omitempty"`
+}
+
+// UpdateWebhookRequest: 웹훅 구독 수정 요청
+// enabled를 true로 바꾸면 자동 비활성화 상태와 연속 실패 수가 초기화됩니다
+type UpdateWebhookRequest struct {
+	Name       *string  `json:"name,omitempty"`
+	URL        *string  `json:"url,omitempty"`
+	EventTypes []string `json:"event_types,omitempty"`
+	Secret     *string  `json:"secret,omitempty"`
+	Enabled    *bool    `json:"enabled,omitempty"`
+}
+
+// WebhookWithSecret: 생성 직후 서명 비밀키를 포함한 웹훅 구독
+type WebhookWithSecret struct {
+	*WebhookSubscription
+	Secret string `json:"secret"`
+}
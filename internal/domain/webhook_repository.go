@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookRepository defines the interface for webhook subscriptions and their delivery log
+type WebhookRepository interface {
+	// Subscriptions (secrets are encrypted at rest and decrypted on read)
+	CreateSubscription(ctx context.Context, subscription *WebhookSubscription) error
+	GetSubscription(ctx context.Context, id uuid.UUID) (*WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context, workspaceID string) ([]*WebhookSubscription, error)
+	ListEnabledSubscriptions(ctx context.Context, workspaceID string) ([]*WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, subscription *WebhookSubscription) error
+	DeleteSubscription(ctx context.Context, id uuid.UUID) error
+
+	// RecordAttempt applies WebhookSubscription.RecordAttempt under a row lock and reports whether the attempt disabled the subscription
+	RecordAttempt(ctx context.Context, id uuid.UUID, succeeded bool, at time.Time) (*WebhookSubscription, bool, error)
+
+	// Deliveries
+	CreateDeliveries(ctx context.Context, deliveries []*WebhookDelivery) error
+	GetDelivery(ctx context.Context, id uuid.UUID) (*WebhookDelivery, error)
+	ListDeliveries(ctx context.Context, subscriptionID uuid.UUID, filter WebhookDeliveryFilter) ([]*WebhookDelivery, int64, error)
+	// ClaimDueDeliveries leases pending deliveries whose next attempt is due by pushing it forward by lease (SKIP LOCKED, safe across replicas)
+	ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, delivery *WebhookDelivery) error
+	// DeleteDeliveriesBefore removes finished deliveries created before the cutoff
+	DeleteDeliveriesBefore(ctx context.Context, cutoff time.Time) (int64, error)
+}
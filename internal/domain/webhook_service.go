@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookService defines the interface for workspace webhook subscriptions and event delivery
+type WebhookService interface {
+	// Subscription management
+	ListWebhooks(ctx context.Context, workspaceID uuid.UUID) ([]*WebhookSubscription, error)
+	GetWebhook(ctx context.Context, workspaceID, webhookID uuid.UUID) (*WebhookSubscription, error)
+	CreateWebhook(ctx context.Context, actorID, workspaceID uuid.UUID, req CreateWebhookRequest) (*WebhookWithSecret, error)
+	UpdateWebhook(ctx context.Context, actorID, workspaceID, webhookID uuid.UUID, req UpdateWebhookRequest) (*WebhookSubscription, error)
+	DeleteWebhook(ctx context.Context, actorID, workspaceID, webhookID uuid.UUID) error
+
+	// Delivery log
+	ListDeliveries(ctx context.Context, workspaceID, webhookID uuid.UUID, filter WebhookDeliveryFilter) ([]*WebhookDelivery, int64, error)
+	GetDelivery(ctx context.Context, workspaceID, webhookID, deliveryID uuid.UUID) (*WebhookDelivery, error)
+	// Redeliver queues a new delivery of the same event (same event ID) for immediate sending
+	Redeliver(ctx context.Context, actorID, workspaceID, webhookID, deliveryID uuid.UUID) (*WebhookDelivery, error)
+
+	// Dispatch
+	// Enqueue records a delivery for every enabled subscription in the event's workspace that matches its type
+	Enqueue(ctx context.Context, event WebhookEvent) (int, error)
+	// Trigger queues a delivery of the event to one subscription regardless of its event types (used by automation rules)
+	Trigger(ctx context.Context, workspaceID, webhookID uuid.UUID, event WebhookEvent) (*WebhookDelivery, error)
+	// DeliverDue sends up to limit due deliveries and returns how many were attempted
+	DeliverDue(ctx context.Context, limit int) (int, error)
+	// PurgeDeliveries removes finished deliveries older than the retention period
+	PurgeDeliveries(ctx context.Context, retention time.Duration) (int64, error)
+}
@@ -0,0 +1,290 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 워크스페이스 범위 권한 (리소스:동작)
+const (
+	// 워크스페이스 관리 권한
+	WorkspaceMembersManage Permission = "workspace:members"
+	WorkspaceRolesManage   Permission = "workspace:roles"
+	WorkspacePolicies      Permission = "workspace:policies"
+	WorkspaceWebhooks      Permission = "workspace:webhooks"
+	WorkspaceNotifications Permission = "workspace:notifications"
+	WorkspaceAutomation    Permission = "workspace:automation"
+
+	// 자격증명 권한
+	CredentialRead   Permission = "credential:read"
+	CredentialWrite  Permission = "credential:write"
+	CredentialDelete Permission = "credential:delete"
+	CredentialUse    Permission = "credential:use" // 자격증명으로 클라우드 API 호출
+
+	// Kubernetes 권한
+	KubernetesRead   Permission = "kubernetes:read"
+	KubernetesWrite  Permission = "kubernetes:write"
+	KubernetesDelete Permission = "kubernetes:delete"
+
+	// 네트워크 권한
+	NetworkRead   Permission = "network:read"
+	NetworkWrite  Permission = "network:write"
+	NetworkDelete Permission = "network:delete"
+
+	// 컴퓨트(VM) 권한
+	ComputeRead   Permission = "compute:read"
+	ComputeWrite  Permission = "compute:write"
+	ComputeDelete Permission = "compute:delete"
+
+	// 비용 분석 권한
+	CostRead Permission = "cost:read"
+)
+
+// 워크스페이스 권한 리소스
+const (
+	PermissionResourceWorkspace  = "workspace"
+	PermissionResourceCredential = "credential"
+	PermissionResourceKubernetes = "kubernetes"
+	PermissionResourceNetwork    = "network"
+	PermissionResourceCompute    = "compute"
+	PermissionResourceCost       = "cost"
+)
+
+// 기본 제공 워크스페이스 역할
+const (
+	WorkspaceRoleAdmin  = "admin"
+	WorkspaceRoleMember = "member"
+	WorkspaceRoleViewer = "viewer"
+)
+
+// WorkspaceAuthorizerContextKey: 요청 컨텍스트에 WorkspaceAuthorizer를 저장하는 키
+const WorkspaceAuthorizerContextKey = "workspace_authorizer"
+
+// PermissionDefinition: 권한 카탈로그 항목
+type PermissionDefinition struct {
+	Permission  Permission `json:"permission"`
+	Resource    string     `json:"resource"`
+	Description string     `json:"description"`
+}
+
+// WorkspacePermissionCatalog: 워크스페이스 역할에 부여할 수 있는 권한 카탈로그
+var WorkspacePermissionCatalog = []PermissionDefinition{
+	{WorkspaceRead, PermissionResourceWorkspace, "View the workspace, its members and dashboards"},
+	{WorkspaceUpdate, PermissionResourceWorkspace, "Update workspace name, description and settings"},
+	{WorkspaceDelete, PermissionResourceWorkspace, "Delete the workspace"},
+	{WorkspaceMembersManage, PermissionResourceWorkspace, "Add, remove and change roles of workspace members"},
+	{WorkspaceRolesManage, PermissionResourceWorkspace, "Create, update and delete custom workspace roles"},
+	{WorkspacePolicies, PermissionResourceWorkspace, "Create, update and delete attribute-based workspace policies"},
+	{WorkspaceWebhooks, PermissionResourceWorkspace, "Manage outgoing webhooks and view their delivery log"},
+	{WorkspaceNotifications, PermissionResourceWorkspace, "Manage chat channels (Slack, Teams, Discord) that receive workspace notifications"},
+	{WorkspaceAutomation, PermissionResourceWorkspace, "Manage automation rules and view their execution history"},
+	{CredentialRead, PermissionResourceCredential, "List credentials and view their metadata"},
+	{CredentialWrite, PermissionResourceCredential, "Create and update credentials"},
+	{CredentialDelete, PermissionResourceCredential, "Delete credentials"},
+	{CredentialUse, PermissionResourceCredential, "Call cloud provider APIs with workspace credentials"},
+	{KubernetesRead, PermissionResourceKubernetes, "View Kubernetes clusters, node groups and kubeconfigs"},
+	{KubernetesWrite, PermissionResourceKubernetes, "Create and modify Kubernetes clusters and node groups"},
+	{KubernetesDelete, PermissionResourceKubernetes, "Delete Kubernetes clusters and node groups"},
+	{NetworkRead, PermissionResourceNetwork, "View VPCs, subnets and security groups"},
+	{NetworkWrite, PermissionResourceNetwork, "Create and modify VPCs, subnets and security groups"},
+	{NetworkDelete, PermissionResourceNetwork, "Delete VPCs, subnets and security groups"},
+	{ComputeRead, PermissionResourceCompute, "View virtual machines"},
+	{ComputeWrite, PermissionResourceCompute, "Create, start, stop and modify virtual machines"},
+	{ComputeDelete, PermissionResourceCompute, "Delete virtual machines"},
+	{CostRead, PermissionResourceCost, "View cost analysis, predictions and budget alerts"},
+}
+
+// BuiltinWorkspaceRolePermissions: 기본 제공 워크스페이스 역할의 권한
+var BuiltinWorkspaceRolePermissions = map[string][]Permission{
+	WorkspaceRoleAdmin: allWorkspacePermissions(),
+	WorkspaceRoleMember: {
+		WorkspaceRead,
+		CredentialRead, CredentialWrite, CredentialUse,
+		KubernetesRead, KubernetesWrite,
+		NetworkRead, NetworkWrite,
+		ComputeRead, ComputeWrite,
+		CostRead,
+	},
+	WorkspaceRoleViewer: {
+		WorkspaceRead,
+		CredentialRead,
+		KubernetesRead,
+		NetworkRead,
+		ComputeRead,
+		CostRead,
+	},
+}
+
+// workspaceRoleNamePattern: 사용자 정의 역할 이름 형식 (소문자, 숫자, -, _)
+var workspaceRoleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{1,49}$`)
+
+// allWorkspacePermissions: 카탈로그의 모든 권한을 반환합니다
+func allWorkspacePermissions() []Permission {
+	permissions := make([]Permission, 0, len(WorkspacePermissionCatalog))
+	for _, definition := range WorkspacePermissionCatalog {
+		permissions = append(permissions, definition.Permission)
+	}
+	return permissions
+}
+
+// IsWorkspacePermission: 권한이 워크스페이스 권한 카탈로그에 있는지 확인합니다
+func IsWorkspacePermission(permission Permission) bool {
+	for _, definition := range WorkspacePermissionCatalog {
+		if definition.Permission == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBuiltinWorkspaceRole: 기본 제공 워크스페이스 역할인지 확인합니다
+func IsBuiltinWorkspaceRole(name string) bool {
+	_, ok := BuiltinWorkspaceRolePermissions[name]
+	return ok
+}
+
+// WorkspaceActionPermission: 리소스와 HTTP 메서드에 해당하는 워크스페이스 권한을 반환합니다
+// GET/HEAD는 read, DELETE는 delete, 그 외는 write 권한에 대응합니다
+func WorkspaceActionPermission(resource, method string) Permission {
+	switch strings.ToUpper(method) {
+	case "GET", "HEAD":
+		return Permission(resource + ":read")
+	case "DELETE":
+		return Permission(resource + ":delete")
+	default:
+		return Permission(resource + ":write")
+	}
+}
+
+// PermissionList: JSONB 컬럼으로 저장되는 권한 목록
+type PermissionList []Permission
+
+// Value: driver.Valuer 인터페이스를 구현합니다
+func (p PermissionList) Value() (driver.Value, error) {
+	if p == nil {
+		return json.Marshal([]Permission{})
+	}
+	return json.Marshal([]Permission(p))
+}
+
+// Scan: sql.Scanner 인터페이스를 구현합니다
+func (p *PermissionList) Scan(value interface{}) error {
+	if value == nil {
+		*p = nil
+		return nil
+	}
+
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
+		return fmt.Errorf("unsupported permission list type %T", value)
+	}
+
+	if len(bytes) == 0 {
+		*p = PermissionList{}
+		return nil
+	}
+	return json.Unmarshal(bytes, (*[]Permission)(p))
+}
+
+// Contains: 목록에 권한이 포함되어 있는지 확인합니다
+func (p PermissionList) Contains(permission Permission) bool {
+	for _, candidate := range p {
+		if candidate == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// WorkspaceRole: 워크스페이스 관리자가 권한 카탈로그로 정의한 사용자 정의 역할
+// WorkspaceUser.Role에는 기본 제공 역할 이름 또는 사용자 정의 역할 이름이 저장됩니다
+type WorkspaceRole struct {
+	ID          uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	WorkspaceID string         `json:"workspace_id" gorm:"type:uuid;not null;uniqueIndex:idx_workspace_roles_workspace_name"`
+	Name        string         `json:"name" gorm:"not null;size:50;uniqueIndex:idx_workspace_roles_workspace_name"`
+	Description string         `json:"description" gorm:"size:255"`
+	Permissions PermissionList `json:"permissions" gorm:"type:jsonb;not null"`
+	BuiltIn     bool           `json:"built_in" gorm:"-"`
+	CreatedBy   *uuid.UUID     `json:"created_by,omitempty" gorm:"type:uuid"`
+	CreatedAt   time.Time      `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName: WorkspaceRole의 테이블 이름을 반환합니다
+func (WorkspaceRole) TableName() string {
+	return "workspace_roles"
+}
+
+// Validate: 사용자 정의 역할의 이름과 권한을 검증합니다
+func (r *WorkspaceRole) Validate() error {
+	if !workspaceRoleNamePattern.MatchString(r.Name) {
+		return NewDomainError(ErrCodeValidationFailed, "role name must be 2-50 lowercase letters, digits, '-' or '_' and start with a letter", 400)
+	}
+	if IsBuiltinWorkspaceRole(r.Name) || r.Name == "owner" {
+		return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("role name %q is reserved", r.Name), 400)
+	}
+	if len(r.Permissions) == 0 {
+		return NewDomainError(ErrCodeValidationFailed, "at least one permission is required", 400)
+	}
+
+	seen := make(map[Permission]bool, len(r.Permissions))
+	unique := make(PermissionList, 0, len(r.Permissions))
+	for _, permission := range r.Permissions {
+		if !IsWorkspacePermission(permission) {
+			return NewDomainError(ErrCodeValidationFailed, fmt.Sprintf("unknown permission: %s", permission), 400)
+		}
+		if !seen[permission] {
+			seen[permission] = true
+			unique = append(unique, permission)
+		}
+	}
+	r.Permissions = unique
+	return nil
+}
+
+// NewBuiltinWorkspaceRole: 기본 제공 역할을 WorkspaceRole 형태로 반환합니다
+func NewBuiltinWorkspaceRole(workspaceID, name string) *WorkspaceRole {
+	return &WorkspaceRole{
+		WorkspaceID: workspaceID,
+		Name:        name,
+		Permissions: PermissionList(BuiltinWorkspaceRolePermissions[name]),
+		BuiltIn:     true,
+	}
+}
+
+// WorkspaceAccess: 사용자의 워크스페이스 내 유효 역할과 권한
+type WorkspaceAccess struct {
+	WorkspaceID string         `json:"workspace_id"`
+	UserID      string         `json:"user_id"`
+	Role        string         `json:"role"` // owner, system-admin, 기본 제공 역할 또는 사용자 정의 역할
+	Permissions PermissionList `json:"permissions"`
+}
+
+// Has: 권한이 부여되어 있는지 확인합니다
+func (a *WorkspaceAccess) Has(permission Permission) bool {
+	return a != nil && a.Permissions.Contains(permission)
+}
+
+// CreateWorkspaceRoleRequest: 사용자 정의 워크스페이스 역할 생성 요청
+type CreateWorkspaceRoleRequest struct {
+	Name        string       `json:"name" validate:"required"`
+	Description string       `json:"description"`
+	Permissions []Permission `json:"permissions" validate:"required,min=1"`
+}
+
+// UpdateWorkspaceRoleRequest: 사용자 정의 워크스페이스 역할 수정 요청
+type UpdateWorkspaceRoleRequest struct {
+	Description *string      `json:"description,omitempty"`
+	Permissions []Permission `json:"permissions,omitempty"`
+}
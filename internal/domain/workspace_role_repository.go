@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// WorkspaceRoleRepository defines the interface for workspace-scoped role data operations
+type WorkspaceRoleRepository interface {
+	Create(ctx context.Context, role *WorkspaceRole) error
+	GetByID(ctx context.Context, id uuid.UUID) (*WorkspaceRole, error)
+	GetByName(ctx context.Context, workspaceID, name string) (*WorkspaceRole, error)
+	ListByWorkspace(ctx context.Context, workspaceID string) ([]*WorkspaceRole, error)
+	Update(ctx context.Context, role *WorkspaceRole) error
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// Membership lookups
+	GetMemberRole(ctx context.Context, workspaceID, userID string) (string, error) // Returns "" when the user is not a member
+	CountMembersWithRole(ctx context.Context, workspaceID, role string) (int64, error)
+}
@@ -2,9 +2,10 @@ package database
 
 import (
 	"fmt"
-	"skyclust/internal/domain"
 	"time"
 
+	"skyclust/internal/infrastructure/database/postgres"
+
 	"gorm.io/gorm"
 )
 
@@ -127,6 +128,12 @@ func (o *DatabaseOptimizer) createAuditLogIndexes() error {
 
 		// Time-based partitioning support
 		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_audit_logs_created_at_desc ON audit_logs(created_at DESC)",
+
+		// Search indexes: full-text document, details containment (details.key:value) and prefix matches (action:credential_*)
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_audit_logs_search ON audit_logs USING GIN (" + postgres.AuditLogSearchDocument + ")",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_audit_logs_details ON audit_logs USING GIN (details jsonb_path_ops)",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_audit_logs_action_pattern ON audit_logs(action text_pattern_ops)",
+		"CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_audit_logs_resource_pattern ON audit_logs(resource text_pattern_ops)",
 	}
 
 	for _, indexSQL := range indexes {
@@ -274,10 +281,8 @@ type DatabaseStats struct {
 
 // CleanupOldData removes old data to maintain performance
 func (o *DatabaseOptimizer) CleanupOldData() error {
-	// Clean up old audit logs (older than 90 days)
-	if err := o.db.Where("created_at < ?", time.Now().AddDate(0, 0, -90)).Delete(&domain.AuditLog{}).Error; err != nil {
-		return fmt.Errorf("failed to cleanup old audit logs: %w", err)
-	}
+	// Audit logs are hash chained and must not be deleted here;
+	// expired segments are archived with their checkpoint by the audit log service
 
 	// Note: Soft delete has been removed, so no cleanup of soft-deleted records is needed
 	// Records are now permanently deleted when Delete() is called
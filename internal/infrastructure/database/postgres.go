@@ -109,10 +109,31 @@ func NewPostgresService(config PostgresConfig) (*PostgresService, error) {
 		&domain.Workspace{},
 		&domain.Credential{},
 		&domain.AuditLog{},
+		&domain.AuditCheckpoint{},
+		&domain.AuditArchive{},
+		&domain.OutboxEvent{},
+		&domain.CloudAuditCursor{},
+		&domain.AuditSavedQuery{},
+		&domain.AuditQuerySubscription{},
+		&domain.StreamEvent{},
 		&domain.WorkspaceUser{},
+		&domain.WorkspaceRole{},
+		&domain.WorkspacePolicy{},
+		&domain.WebhookSubscription{},
+		&domain.WebhookDelivery{},
+		&domain.ChatChannel{},
+		&domain.AutomationRule{},
+		&domain.AutomationExecution{},
+		&domain.Operation{},
+		&domain.NotificationTemplate{},
 		&domain.UserRole{},
 		&domain.RolePermission{},
 		&domain.OIDCProvider{},
+		&domain.SSOProvider{},
+		&domain.SSOGroupMapping{},
+		&domain.SCIMToken{},
+		&domain.SCIMGroup{},
+		&domain.SCIMGroupMember{},
 		&domain.Notification{},
 		&domain.NotificationPreferences{},
 		&domain.VM{},
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"fmt"
 	"skyclust/internal/domain"
 	"skyclust/pkg/logger"
 	"time"
@@ -9,19 +10,90 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	auditSegmentLockPrefix = "audit_log_segment:"
+	auditCheckpointLock    = "audit_log_checkpoint"
+)
+
 // auditLogRepository: domain.AuditLogRepository 인터페이스 구현체
 type auditLogRepository struct {
-	db *gorm.DB
+	db    *gorm.DB
+	sinks []domain.AuditSinkRoute
 }
 
 // NewAuditLogRepository: 새로운 AuditLogRepository를 생성합니다
-func NewAuditLogRepository(db *gorm.DB) domain.AuditLogRepository {
-	return &auditLogRepository{db: db}
+// sinks가 주어지면 기록과 같은 트랜잭션에서 싱크별 outbox 이벤트를 생성합니다
+func NewAuditLogRepository(db *gorm.DB, sinks ...domain.AuditSinkRoute) domain.AuditLogRepository {
+	return &auditLogRepository{db: db, sinks: sinks}
 }
 
-// Create: 새로운 감사 로그 항목을 생성합니다
+// Create: 새로운 감사 로그 항목을 세그먼트 해시 체인에 연결하여 생성합니다
 func (r *auditLogRepository) Create(log *domain.AuditLog) error {
-	if err := r.db.Create(log).Error; err != nil {
+	if log.ID == uuid.Nil {
+		log.ID = uuid.New()
+	}
+	if log.CreatedAt.IsZero() {
+		log.CreatedAt = time.Now()
+	}
+	if err := log.NormalizeForChain(); err != nil {
+		logger.Errorf("Failed to normalize audit log: %v", err)
+		return err
+	}
+	log.Segment = domain.AuditSegmentFor(log.CreatedAt)
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// 같은 세그먼트에 대한 동시 기록을 직렬화하여 시퀀스와 이전 해시를 확정
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", auditSegmentLockPrefix+log.Segment).Error; err != nil {
+			return err
+		}
+
+		var head domain.AuditLog
+		result := tx.Select("sequence", "hash").
+			Where("segment = ?", log.Segment).
+			Order("sequence DESC").
+			Limit(1).
+			Find(&head)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		log.Sequence = head.Sequence + 1
+		log.PrevHash = head.Hash
+		if result.RowsAffected == 0 {
+			log.PrevHash = domain.AuditSegmentGenesisHash(log.Segment)
+		}
+
+		hash, err := log.ComputeHash()
+		if err != nil {
+			return err
+		}
+		log.Hash = hash
+
+		create := tx
+		if log.IPAddress == "" {
+			// inet 컬럼은 빈 문자열을 허용하지 않으므로 NULL로 저장 (워커, 클라우드 수집 항목)
+			create = tx.Omit("IPAddress")
+		}
+		if err := create.Create(log).Error; err != nil {
+			return err
+		}
+
+		// 싱크가 중단되어도 항목이 유실되지 않도록 outbox에 함께 기록
+		for _, sink := range r.sinks {
+			if !sink.Filter.Matches(log) {
+				continue
+			}
+			event, err := domain.NewAuditSinkEvent(sink.Name, log)
+			if err != nil {
+				return err
+			}
+			if err := tx.Create(event).Error; err != nil {
+				return fmt.Errorf("failed to enqueue audit log for sink %s: %w", sink.Name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
 		logger.Errorf("Failed to create audit log: %v", err)
 		return err
 	}
@@ -118,22 +190,6 @@ func (r *auditLogRepository) CountByAction(action string) (int64, error) {
 	return count, nil
 }
 
-// DeleteOldLogs: 지정된 시간보다 오래된 감사 로그를 삭제하고 삭제된 로그 수를 반환합니다
-func (r *auditLogRepository) DeleteOldLogs(before time.Time) (int64, error) {
-	result := r.db.Where("created_at < ?", before).Delete(&domain.AuditLog{})
-	if result.Error != nil {
-		logger.Errorf("Failed to delete old audit logs: %v", result.Error)
-		return 0, result.Error
-	}
-
-	deletedCount := result.RowsAffected
-	if deletedCount > 0 {
-		logger.Infof("Deleted %d old audit logs (older than %v)", deletedCount, before)
-	}
-
-	return deletedCount, nil
-}
-
 // GetTotalCount: 감사 로그의 총 개수를 반환합니다
 func (r *auditLogRepository) GetTotalCount(filters domain.AuditStatsFilters) (int64, error) {
 	var count int64
@@ -361,3 +417,307 @@ func (r *auditLogRepository) GetErrorEventsCount(startTime, endTime time.Time) (
 
 	return count, nil
 }
+
+// GetSegmentHead: 세그먼트의 마지막 항목을 반환합니다 (항목이 없으면 nil)
+func (r *auditLogRepository) GetSegmentHead(segment string) (*domain.AuditLog, error) {
+	var logs []*domain.AuditLog
+	if err := r.db.Where("segment = ?", segment).Order("sequence DESC").Limit(1).Find(&logs).Error; err != nil {
+		logger.Errorf("Failed to get audit segment head: %v", err)
+		return nil, err
+	}
+	if len(logs) == 0 {
+		return nil, nil
+	}
+	return logs[0], nil
+}
+
+// ListSegments: 기간 내 해시 체인이 있는 세그먼트 목록을 반환합니다
+func (r *auditLogRepository) ListSegments(from, to string) ([]string, error) {
+	var segments []string
+	if err := r.db.Model(&domain.AuditLog{}).
+		Distinct("segment").
+		Where("segment BETWEEN ? AND ?", from, to).
+		Order("segment").
+		Pluck("segment", &segments).Error; err != nil {
+		logger.Errorf("Failed to list audit segments: %v", err)
+		return nil, err
+	}
+	return segments, nil
+}
+
+// ListSegmentEntries: 세그먼트 항목을 시퀀스 순서로 조회합니다 (afterSequence 이후부터)
+func (r *auditLogRepository) ListSegmentEntries(segment string, afterSequence int64, limit int) ([]*domain.AuditLog, error) {
+	var logs []*domain.AuditLog
+	if err := r.db.Where("segment = ? AND sequence > ?", segment, afterSequence).
+		Order("sequence ASC").
+		Limit(limit).
+		Find(&logs).Error; err != nil {
+		logger.Errorf("Failed to list audit segment entries: %v", err)
+		return nil, err
+	}
+	return logs, nil
+}
+
+// CountUnchainedLogs: 해시 체인 도입 이전에 기록된 항목 수를 반환합니다
+func (r *auditLogRepository) CountUnchainedLogs() (int64, error) {
+	var count int64
+	if err := r.db.Model(&domain.AuditLog{}).Where("segment IS NULL OR segment = ''").Count(&count).Error; err != nil {
+		logger.Errorf("Failed to count unchained audit logs: %v", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// ListExistingExternalIDs: 이미 기록된 외부 이벤트 ID를 조회합니다
+func (r *auditLogRepository) ListExistingExternalIDs(source string, externalIDs []string) (map[string]bool, error) {
+	existing := make(map[string]bool, len(externalIDs))
+	if len(externalIDs) == 0 {
+		return existing, nil
+	}
+
+	var ids []string
+	if err := r.db.Model(&domain.AuditLog{}).
+		Where("source = ? AND external_id IN ?", source, externalIDs).
+		Pluck("external_id", &ids).Error; err != nil {
+		logger.Errorf("Failed to list existing audit external IDs: %v", err)
+		return nil, err
+	}
+	for _, id := range ids {
+		existing[id] = true
+	}
+	return existing, nil
+}
+
+// ListManagedResourceIDs: 자격증명으로 SkyClust를 통해 기록된 리소스 식별자를 조회합니다
+func (r *auditLogRepository) ListManagedResourceIDs(credentialID string, keys []string) ([]string, error) {
+	var ids []string
+	err := r.db.Raw(`
+		SELECT DISTINCT d.value
+		FROM audit_logs, jsonb_each_text(audit_logs.details) AS d
+		WHERE audit_logs.details->>'credential_id' = ?
+		  AND (audit_logs.source IS NULL OR audit_logs.source = '')
+		  AND d.key IN ?
+		  AND d.value <> ''`, credentialID, keys).
+		Scan(&ids).Error
+	if err != nil {
+		logger.Errorf("Failed to list managed resource IDs: %v", err)
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Search: 검색 쿼리와 기간 조건에 맞는 감사 로그를 최신순으로 조회합니다
+func (r *auditLogRepository) Search(filters domain.AuditSearchFilters) ([]*domain.AuditLog, int64, error) {
+	query := r.db.Model(&domain.AuditLog{})
+	if filters.Query != nil {
+		clause, args, err := compileAuditQuery(filters.Query)
+		if err != nil {
+			logger.Errorf("Failed to compile audit search query: %v", err)
+			return nil, 0, err
+		}
+		query = query.Where(clause, args...)
+	}
+	if filters.After != nil {
+		query = query.Where("created_at > ?", *filters.After)
+	}
+	if filters.Before != nil {
+		query = query.Where("created_at <= ?", *filters.Before)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		logger.Errorf("Failed to count audit search results: %v", err)
+		return nil, 0, err
+	}
+	if total == 0 {
+		return []*domain.AuditLog{}, 0, nil
+	}
+
+	var logs []*domain.AuditLog
+	query = query.Order("created_at DESC")
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	}
+	if filters.Offset > 0 {
+		query = query.Offset(filters.Offset)
+	}
+	if err := query.Find(&logs).Error; err != nil {
+		logger.Errorf("Failed to search audit logs: %v", err)
+		return nil, 0, err
+	}
+	return logs, total, nil
+}
+
+// AppendCheckpoint: 체크포인트 체인에 새 체크포인트를 추가합니다
+// seal은 트랜잭션 안에서 직전 체크포인트와 함께 호출되어 번호, 해시, 서명을 채웁니다
+func (r *auditLogRepository) AppendCheckpoint(checkpoint *domain.AuditCheckpoint, seal func(previous *domain.AuditCheckpoint) error) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", auditCheckpointLock).Error; err != nil {
+			return err
+		}
+
+		var latest []*domain.AuditCheckpoint
+		if err := tx.Order("number DESC").Limit(1).Find(&latest).Error; err != nil {
+			return err
+		}
+		var previous *domain.AuditCheckpoint
+		if len(latest) > 0 {
+			previous = latest[0]
+		}
+
+		if err := seal(previous); err != nil {
+			return err
+		}
+		return tx.Create(checkpoint).Error
+	})
+	if err != nil {
+		logger.Errorf("Failed to append audit checkpoint: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetLatestCheckpoint: 세그먼트의 최신 체크포인트를 반환합니다 (없으면 nil)
+func (r *auditLogRepository) GetLatestCheckpoint(segment string) (*domain.AuditCheckpoint, error) {
+	var checkpoints []*domain.AuditCheckpoint
+	if err := r.db.Where("segment = ?", segment).Order("number DESC").Limit(1).Find(&checkpoints).Error; err != nil {
+		logger.Errorf("Failed to get latest audit checkpoint: %v", err)
+		return nil, err
+	}
+	if len(checkpoints) == 0 {
+		return nil, nil
+	}
+	return checkpoints[0], nil
+}
+
+// GetCheckpointByNumber: 번호로 체크포인트를 조회합니다 (없으면 nil)
+func (r *auditLogRepository) GetCheckpointByNumber(number int64) (*domain.AuditCheckpoint, error) {
+	var checkpoints []*domain.AuditCheckpoint
+	if err := r.db.Where("number = ?", number).Limit(1).Find(&checkpoints).Error; err != nil {
+		logger.Errorf("Failed to get audit checkpoint by number: %v", err)
+		return nil, err
+	}
+	if len(checkpoints) == 0 {
+		return nil, nil
+	}
+	return checkpoints[0], nil
+}
+
+// ListCheckpoints: 기간 내 세그먼트의 체크포인트를 번호 순서로 조회합니다
+func (r *auditLogRepository) ListCheckpoints(from, to string) ([]*domain.AuditCheckpoint, error) {
+	var checkpoints []*domain.AuditCheckpoint
+	if err := r.db.Where("segment BETWEEN ? AND ?", from, to).Order("number ASC").Find(&checkpoints).Error; err != nil {
+		logger.Errorf("Failed to list audit checkpoints: %v", err)
+		return nil, err
+	}
+	return checkpoints, nil
+}
+
+// ListArchivableSegments: before 이전 날짜의 세그먼트 목록을 반환합니다
+// 해시 체인 도입 이전 항목은 생성일(UTC)을 세그먼트로 간주합니다
+func (r *auditLogRepository) ListArchivableSegments(before string) ([]string, error) {
+	cutoff, err := time.Parse(domain.AuditSegmentLayout, before)
+	if err != nil {
+		return nil, err
+	}
+
+	var segments []string
+	if err := r.db.Raw(`SELECT DISTINCT COALESCE(NULLIF(segment, ''), to_char(created_at AT TIME ZONE 'UTC', 'YYYY-MM-DD')) AS segment
+		FROM audit_logs WHERE created_at < ? ORDER BY segment`, cutoff).
+		Scan(&segments).Error; err != nil {
+		logger.Errorf("Failed to list archivable audit segments: %v", err)
+		return nil, err
+	}
+	return segments, nil
+}
+
+// ListSegmentArchiveEntries: 아카이브할 세그먼트 항목을 조회합니다 (체인 이전 항목 먼저, 이후 시퀀스 순서)
+func (r *auditLogRepository) ListSegmentArchiveEntries(segment string, offset, limit int) ([]*domain.AuditLog, error) {
+	query, err := r.segmentScope(r.db, segment)
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []*domain.AuditLog
+	if err := query.Order("sequence ASC NULLS FIRST").
+		Order("created_at ASC").
+		Order("id ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&logs).Error; err != nil {
+		logger.Errorf("Failed to list audit archive entries: %v", err)
+		return nil, err
+	}
+	return logs, nil
+}
+
+// ArchiveSegment: 아카이브를 저장하고 세그먼트 항목을 감사 로그 테이블에서 제거합니다
+func (r *auditLogRepository) ArchiveSegment(archive *domain.AuditArchive) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", auditSegmentLockPrefix+archive.Segment).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(archive).Error; err != nil {
+			return err
+		}
+
+		query, err := r.segmentScope(tx, archive.Segment)
+		if err != nil {
+			return err
+		}
+		result := query.Delete(&domain.AuditLog{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != archive.EntryCount+archive.LegacyCount {
+			return fmt.Errorf("audit segment %s changed during archiving: archived %d entries, found %d",
+				archive.Segment, archive.EntryCount+archive.LegacyCount, result.RowsAffected)
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Errorf("Failed to archive audit segment %s: %v", archive.Segment, err)
+		return err
+	}
+
+	logger.Infof("Archived audit segment %s (%d entries)", archive.Segment, archive.EntryCount+archive.LegacyCount)
+	return nil
+}
+
+// GetArchive: 세그먼트 아카이브를 데이터와 함께 조회합니다
+func (r *auditLogRepository) GetArchive(segment string) (*domain.AuditArchive, error) {
+	var archive domain.AuditArchive
+	if err := r.db.Where("segment = ?", segment).First(&archive).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.NewDomainError(domain.ErrCodeNotFound, "audit archive not found", 404)
+		}
+		logger.Errorf("Failed to get audit archive: %v", err)
+		return nil, err
+	}
+	return &archive, nil
+}
+
+// ListArchives: 기간 내 아카이브 목록을 데이터 없이 조회합니다
+func (r *auditLogRepository) ListArchives(from, to string) ([]*domain.AuditArchive, error) {
+	var archives []*domain.AuditArchive
+	if err := r.db.Omit("data").
+		Where("segment BETWEEN ? AND ?", from, to).
+		Order("segment ASC").
+		Find(&archives).Error; err != nil {
+		logger.Errorf("Failed to list audit archives: %v", err)
+		return nil, err
+	}
+	return archives, nil
+}
+
+// segmentScope: 세그먼트 항목과 같은 날짜의 체인 이전 항목을 선택하는 쿼리를 반환합니다
+func (r *auditLogRepository) segmentScope(db *gorm.DB, segment string) (*gorm.DB, error) {
+	dayStart, err := time.Parse(domain.AuditSegmentLayout, segment)
+	if err != nil {
+		return nil, domain.NewDomainError(domain.ErrCodeValidationFailed, "invalid audit segment", 400)
+	}
+	return db.Model(&domain.AuditLog{}).Where(
+		"(segment = ? OR ((segment IS NULL OR segment = '') AND created_at >= ? AND created_at < ?))",
+		segment, dayStart, dayStart.AddDate(0, 0, 1),
+	), nil
+}
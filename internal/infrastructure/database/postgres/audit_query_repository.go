@@ -0,0 +1,168 @@
+package postgres
+
+import (
+	"time"
+
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// auditQueryRepository: domain.AuditQueryRepository 인터페이스 구현체
+type auditQueryRepository struct {
+	db *gorm.DB
+}
+
+// NewAuditQueryRepository: 새로운 AuditQueryRepository를 생성합니다
+func NewAuditQueryRepository(db *gorm.DB) domain.AuditQueryRepository {
+	return &auditQueryRepository{db: db}
+}
+
+// CreateSavedQuery: 검색 쿼리를 저장합니다
+func (r *auditQueryRepository) CreateSavedQuery(query *domain.AuditSavedQuery) error {
+	if err := r.db.Create(query).Error; err != nil {
+		logger.Errorf("Failed to create saved audit query: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetSavedQuery: 저장된 쿼리를 조회합니다 (없으면 nil)
+func (r *auditQueryRepository) GetSavedQuery(id uuid.UUID) (*domain.AuditSavedQuery, error) {
+	var query domain.AuditSavedQuery
+	result := r.db.Where("id = ?", id).Limit(1).Find(&query)
+	if result.Error != nil {
+		logger.Errorf("Failed to get saved audit query: %v", result.Error)
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &query, nil
+}
+
+// ListSavedQueries: 사용자가 소유했거나 공유된 쿼리를 이름순으로 조회합니다
+func (r *auditQueryRepository) ListSavedQueries(userID uuid.UUID) ([]*domain.AuditSavedQuery, error) {
+	var queries []*domain.AuditSavedQuery
+	if err := r.db.Where("owner_id = ? OR shared = ?", userID, true).
+		Order("name ASC").
+		Find(&queries).Error; err != nil {
+		logger.Errorf("Failed to list saved audit queries: %v", err)
+		return nil, err
+	}
+	return queries, nil
+}
+
+// UpdateSavedQuery: 저장된 쿼리를 수정합니다
+func (r *auditQueryRepository) UpdateSavedQuery(query *domain.AuditSavedQuery) error {
+	if err := r.db.Model(query).
+		Select("name", "description", "query", "shared", "updated_at").
+		Updates(query).Error; err != nil {
+		logger.Errorf("Failed to update saved audit query: %v", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteSavedQuery: 저장된 쿼리와 구독을 삭제합니다
+func (r *auditQueryRepository) DeleteSavedQuery(id uuid.UUID) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("saved_query_id = ?", id).Delete(&domain.AuditQuerySubscription{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&domain.AuditSavedQuery{}).Error
+	})
+	if err != nil {
+		logger.Errorf("Failed to delete saved audit query: %v", err)
+		return err
+	}
+	return nil
+}
+
+// SaveSubscription: 구독을 생성하거나 우선순위를 갱신합니다 (쿼리와 사용자별 하나)
+func (r *auditQueryRepository) SaveSubscription(subscription *domain.AuditQuerySubscription) error {
+	if subscription.ID == uuid.Nil {
+		subscription.ID = uuid.New()
+	}
+	err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "saved_query_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"priority", "updated_at"}),
+	}).Omit("SavedQuery").Create(subscription).Error
+	if err != nil {
+		logger.Errorf("Failed to save audit query subscription: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetSubscription: 사용자의 쿼리 구독을 조회합니다 (없으면 nil)
+func (r *auditQueryRepository) GetSubscription(savedQueryID, userID uuid.UUID) (*domain.AuditQuerySubscription, error) {
+	var subscription domain.AuditQuerySubscription
+	result := r.db.Where("saved_query_id = ? AND user_id = ?", savedQueryID, userID).Limit(1).Find(&subscription)
+	if result.Error != nil {
+		logger.Errorf("Failed to get audit query subscription: %v", result.Error)
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &subscription, nil
+}
+
+// ListSubscribedQueryIDs: 사용자가 구독한 쿼리 ID를 조회합니다
+func (r *auditQueryRepository) ListSubscribedQueryIDs(userID uuid.UUID) (map[uuid.UUID]bool, error) {
+	var ids []uuid.UUID
+	if err := r.db.Model(&domain.AuditQuerySubscription{}).
+		Where("user_id = ?", userID).
+		Pluck("saved_query_id", &ids).Error; err != nil {
+		logger.Errorf("Failed to list subscribed audit queries: %v", err)
+		return nil, err
+	}
+	subscribed := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		subscribed[id] = true
+	}
+	return subscribed, nil
+}
+
+// ListSubscriptions: 모든 구독을 저장된 쿼리와 함께 조회합니다
+func (r *auditQueryRepository) ListSubscriptions() ([]*domain.AuditQuerySubscription, error) {
+	var subscriptions []*domain.AuditQuerySubscription
+	if err := r.db.Preload("SavedQuery").
+		Order("last_checked_at ASC").
+		Find(&subscriptions).Error; err != nil {
+		logger.Errorf("Failed to list audit query subscriptions: %v", err)
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+// UpdateSubscriptionProgress: 구독의 확인 시각과 일치 건수를 갱신합니다
+func (r *auditQueryRepository) UpdateSubscriptionProgress(id uuid.UUID, checkedAt time.Time, matched int64) error {
+	updates := map[string]interface{}{
+		"last_checked_at": checkedAt,
+		"updated_at":      time.Now(),
+	}
+	if matched > 0 {
+		updates["last_matched_at"] = checkedAt
+		updates["match_count"] = gorm.Expr("match_count + ?", matched)
+	}
+	if err := r.db.Model(&domain.AuditQuerySubscription{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+		logger.Errorf("Failed to update audit query subscription: %v", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteSubscription: 사용자의 쿼리 구독을 삭제합니다
+func (r *auditQueryRepository) DeleteSubscription(savedQueryID, userID uuid.UUID) error {
+	if err := r.db.Where("saved_query_id = ? AND user_id = ?", savedQueryID, userID).
+		Delete(&domain.AuditQuerySubscription{}).Error; err != nil {
+		logger.Errorf("Failed to delete audit query subscription: %v", err)
+		return err
+	}
+	return nil
+}
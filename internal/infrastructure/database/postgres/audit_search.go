@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"skyclust/internal/domain"
+)
+
+// AuditLogSearchDocument: 감사 로그 전문 검색 문서 식
+// DatabaseOptimizer의 GIN 인덱스(idx_audit_logs_search)와 같은 식을 사용해야 인덱스가 사용됩니다
+const AuditLogSearchDocument = `to_tsvector('simple', coalesce(action, '') || ' ' || coalesce(resource, '') || ' ' || coalesce(host(ip_address), '') || ' ' || coalesce(user_agent, '') || ' ' || coalesce(details::text, ''))`
+
+// auditQueryColumns: 쿼리 필드와 컬럼
+var auditQueryColumns = map[string]string{
+	domain.AuditQueryFieldAction:     "action",
+	domain.AuditQueryFieldResource:   "resource",
+	domain.AuditQueryFieldUserAgent:  "user_agent",
+	domain.AuditQueryFieldSource:     "source",
+	domain.AuditQueryFieldExternalID: "external_id",
+}
+
+// compileAuditQuery: 파싱된 검색 쿼리를 WHERE 절과 인자로 변환합니다
+func compileAuditQuery(node *domain.AuditQueryNode) (string, []interface{}, error) {
+	switch node.Op {
+	case domain.AuditQueryAnd, domain.AuditQueryOr:
+		parts := make([]string, 0, len(node.Children))
+		var args []interface{}
+		for _, child := range node.Children {
+			clause, childArgs, err := compileAuditQuery(child)
+			if err != nil {
+				return "", nil, err
+			}
+			parts = append(parts, clause)
+			args = append(args, childArgs...)
+		}
+		return "(" + strings.Join(parts, " "+strings.ToUpper(string(node.Op))+" ") + ")", args, nil
+	case domain.AuditQueryNot:
+		clause, args, err := compileAuditQuery(node.Children[0])
+		if err != nil {
+			return "", nil, err
+		}
+		// NULL 컬럼도 NOT 조건을 만족하도록 처리
+		return "(NOT coalesce(" + clause + ", false))", args, nil
+	case domain.AuditQueryText:
+		return compileAuditTextQuery(node)
+	case domain.AuditQueryTerm:
+		return compileAuditTermQuery(node)
+	default:
+		return "", nil, fmt.Errorf("unsupported audit query node %q", node.Op)
+	}
+}
+
+// compileAuditTextQuery: 전문 검색 조건을 변환합니다
+func compileAuditTextQuery(node *domain.AuditQueryNode) (string, []interface{}, error) {
+	switch {
+	case node.Prefix:
+		// quote_literal로 하나의 어휘소로 만든 뒤 접두사 일치
+		value := strings.ReplaceAll(node.Value, `\`, "")
+		return AuditLogSearchDocument + " @@ to_tsquery('simple', quote_literal(lower(?)) || ':*')", []interface{}{value}, nil
+	case node.Phrase:
+		return AuditLogSearchDocument + " @@ phraseto_tsquery('simple', ?)", []interface{}{node.Value}, nil
+	default:
+		return AuditLogSearchDocument + " @@ plainto_tsquery('simple', ?)", []interface{}{node.Value}, nil
+	}
+}
+
+// compileAuditTermQuery: 필드 조건을 변환합니다
+func compileAuditTermQuery(node *domain.AuditQueryNode) (string, []interface{}, error) {
+	switch node.Field {
+	case domain.AuditQueryFieldUserID:
+		return "user_id = ?", []interface{}{node.Value}, nil
+	case domain.AuditQueryFieldIP:
+		if strings.Contains(node.Value, "/") {
+			return "ip_address <<= ?::inet", []interface{}{node.Value}, nil
+		}
+		return "ip_address = ?::inet", []interface{}{node.Value}, nil
+	case domain.AuditQueryFieldDetails:
+		return compileAuditDetailsQuery(node)
+	}
+
+	column, ok := auditQueryColumns[node.Field]
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported audit query field %q", node.Field)
+	}
+	if node.Prefix {
+		return column + " LIKE ? ESCAPE '\\'", []interface{}{escapeLikePattern(node.Value) + "%"}, nil
+	}
+	return column + " = ?", []interface{}{node.Value}, nil
+}
+
+// compileAuditDetailsQuery: details JSONB 경로 조건을 변환합니다
+// 문자열 값의 정확한 일치는 GIN 인덱스(jsonb_path_ops)를 사용하는 포함 연산자(@>)로 조회합니다
+func compileAuditDetailsQuery(node *domain.AuditQueryNode) (string, []interface{}, error) {
+	path := "{" + strings.Join(quoteJSONPath(node.Path), ",") + "}"
+	if node.Prefix {
+		return "details #>> ?::text[] ILIKE ? ESCAPE '\\'", []interface{}{path, escapeLikePattern(node.Value) + "%"}, nil
+	}
+	if isJSONScalarLiteral(node.Value) {
+		// 숫자, 불리언 값은 JSON 타입과 관계없이 텍스트로 비교
+		return "details #>> ?::text[] = ?", []interface{}{path, node.Value}, nil
+	}
+
+	var document interface{} = node.Value
+	for i := len(node.Path) - 1; i >= 0; i-- {
+		document = map[string]interface{}{node.Path[i]: document}
+	}
+	containment, err := json.Marshal(document)
+	if err != nil {
+		return "", nil, err
+	}
+	return "details @> ?::jsonb", []interface{}{string(containment)}, nil
+}
+
+// quoteJSONPath: text[] 경로 리터럴의 각 키를 따옴표로 감쌉니다
+func quoteJSONPath(keys []string) []string {
+	quoted := make([]string, len(keys))
+	for i, key := range keys {
+		quoted[i] = `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(key) + `"`
+	}
+	return quoted
+}
+
+// isJSONScalarLiteral: 값이 JSON 숫자나 불리언으로 저장될 수 있는지 확인합니다
+func isJSONScalarLiteral(value string) bool {
+	if value == "true" || value == "false" {
+		return true
+	}
+	_, err := strconv.ParseFloat(value, 64)
+	return err == nil
+}
+
+// escapeLikePattern: LIKE 패턴의 특수 문자를 이스케이프합니다
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
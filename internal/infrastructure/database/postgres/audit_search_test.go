@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"reflect"
+	"testing"
+
+	"skyclust/internal/domain"
+)
+
+func TestCompileAuditQuery(t *testing.T) {
+	const doc = AuditLogSearchDocument
+
+	tests := []struct {
+		name   string
+		query  string
+		clause string
+		args   []interface{}
+	}{
+		{
+			name:   "exact column",
+			query:  "action:credential.create",
+			clause: "action = ?",
+			args:   []interface{}{"credential_create"},
+		},
+		{
+			name:   "prefix escapes like wildcards",
+			query:  "action:credential_*",
+			clause: `action LIKE ? ESCAPE '\'`,
+			args:   []interface{}{`credential\_%`},
+		},
+		{
+			name:   "prefix escapes percent and backslash",
+			query:  `resource:"50% off\\"*`,
+			clause: `resource LIKE ? ESCAPE '\'`,
+			args:   []interface{}{`50\% off\\%`},
+		},
+		{
+			name:   "user id",
+			query:  "user_id:6f1c3c8e-4b7a-4a51-9d5e-0c2f0a1b2c3d",
+			clause: "user_id = ?",
+			args:   []interface{}{"6f1c3c8e-4b7a-4a51-9d5e-0c2f0a1b2c3d"},
+		},
+		{
+			name:   "ip address",
+			query:  "ip:10.0.0.1",
+			clause: "ip_address = ?::inet",
+			args:   []interface{}{"10.0.0.1"},
+		},
+		{
+			name:   "ip cidr",
+			query:  "ip:10.0.0.0/8",
+			clause: "ip_address <<= ?::inet",
+			args:   []interface{}{"10.0.0.0/8"},
+		},
+		{
+			name:   "details string uses containment",
+			query:  "details.provider:aws",
+			clause: "details @> ?::jsonb",
+			args:   []interface{}{`{"provider":"aws"}`},
+		},
+		{
+			name:   "details nested path",
+			query:  `details.tags.env:"prod \"eu\""`,
+			clause: "details @> ?::jsonb",
+			args:   []interface{}{`{"tags":{"env":"prod \"eu\""}}`},
+		},
+		{
+			name:   "details number compares as text",
+			query:  "details.count:3",
+			clause: "details #>> ?::text[] = ?",
+			args:   []interface{}{`{"count"}`, "3"},
+		},
+		{
+			name:   "details prefix quotes path keys",
+			query:  `details.a\b.c:x_*`,
+			clause: `details #>> ?::text[] ILIKE ? ESCAPE '\'`,
+			args:   []interface{}{`{"a\\b","c"}`, `x\_%`},
+		},
+		{
+			name:   "text",
+			query:  "prod-cluster",
+			clause: doc + " @@ plainto_tsquery('simple', ?)",
+			args:   []interface{}{"prod-cluster"},
+		},
+		{
+			name:   "text phrase",
+			query:  `"access denied"`,
+			clause: doc + " @@ phraseto_tsquery('simple', ?)",
+			args:   []interface{}{"access denied"},
+		},
+		{
+			name:   "text prefix",
+			query:  "prod*",
+			clause: doc + " @@ to_tsquery('simple', quote_literal(lower(?)) || ':*')",
+			args:   []interface{}{"prod"},
+		},
+		{
+			name:   "not keeps null columns",
+			query:  "NOT source:cloudtrail",
+			clause: "(NOT coalesce(source = ?, false))",
+			args:   []interface{}{"cloudtrail"},
+		},
+		{
+			name:   "and binds tighter than or",
+			query:  "action:login OR action:logout source:api",
+			clause: "(action = ? OR (action = ? AND source = ?))",
+			args:   []interface{}{"login", "logout", "api"},
+		},
+		{
+			name:   "not over grouped or",
+			query:  "-(action:login OR ip:10.0.0.0/8) user_agent:curl*",
+			clause: `((NOT coalesce((action = ? OR ip_address <<= ?::inet), false)) AND user_agent LIKE ? ESCAPE '\')`,
+			args:   []interface{}{"login", "10.0.0.0/8", "curl%"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := domain.ParseAuditQuery(tt.query)
+			if err != nil {
+				t.Fatalf("ParseAuditQuery(%q) error = %v", tt.query, err)
+			}
+			clause, args, err := compileAuditQuery(node)
+			if err != nil {
+				t.Fatalf("compileAuditQuery(%q) error = %v", tt.query, err)
+			}
+			if clause != tt.clause {
+				t.Errorf("clause = %s\nwant    %s", clause, tt.clause)
+			}
+			if !reflect.DeepEqual(args, tt.args) {
+				t.Errorf("args = %#v, want %#v", args, tt.args)
+			}
+		})
+	}
+}
+
+func TestCompileAuditQueryRejectsUnknownNodes(t *testing.T) {
+	if _, _, err := compileAuditQuery(&domain.AuditQueryNode{Op: "xor"}); err == nil {
+		t.Fatal("expected an error for an unknown operator")
+	}
+	if _, _, err := compileAuditQuery(&domain.AuditQueryNode{Op: domain.AuditQueryTerm, Field: "password", Value: "x"}); err == nil {
+		t.Fatal("expected an error for an unknown field")
+	}
+}
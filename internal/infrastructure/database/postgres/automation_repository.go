@@ -0,0 +1,266 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// automationRepository: domain.AutomationRepository 인터페이스 구현체
+type automationRepository struct {
+	db *gorm.DB
+}
+
+// NewAutomationRepository: 새로운 자동화 규칙 저장소를 생성합니다
+func NewAutomationRepository(db *gorm.DB) domain.AutomationRepository {
+	return &automationRepository{db: db}
+}
+
+// CreateRule: 자동화 규칙을 생성합니다
+func (r *automationRepository) CreateRule(ctx context.Context, rule *domain.AutomationRule) error {
+	if rule.ID == uuid.Nil {
+		rule.ID = uuid.New()
+	}
+	if err := GetTransaction(ctx, r.db).Create(rule).Error; err != nil {
+		logger.Errorf("Failed to create automation rule: %v", err)
+		return fmt.Errorf("failed to create automation rule: %w", err)
+	}
+	return nil
+}
+
+// GetRule: ID로 자동화 규칙을 조회합니다
+func (r *automationRepository) GetRule(ctx context.Context, id uuid.UUID) (*domain.AutomationRule, error) {
+	var rule domain.AutomationRule
+	if err := GetTransaction(ctx, r.db).Where("id = ?", id).First(&rule).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		logger.Errorf("Failed to get automation rule by ID: %v", err)
+		return nil, fmt.Errorf("failed to get automation rule: %w", err)
+	}
+	return &rule, nil
+}
+
+// ListRules: 워크스페이스의 모든 자동화 규칙을 조회합니다
+func (r *automationRepository) ListRules(ctx context.Context, workspaceID string) ([]*domain.AutomationRule, error) {
+	var rules []*domain.AutomationRule
+	err := GetTransaction(ctx, r.db).
+		Where("workspace_id = ?", workspaceID).
+		Order("created_at ASC").
+		Find(&rules).Error
+	if err != nil {
+		logger.Errorf("Failed to list automation rules: %v", err)
+		return nil, fmt.Errorf("failed to list automation rules: %w", err)
+	}
+	return rules, nil
+}
+
+// ListEnabledRules: 워크스페이스의 활성화된 자동화 규칙을 조회합니다
+func (r *automationRepository) ListEnabledRules(ctx context.Context, workspaceID string) ([]*domain.AutomationRule, error) {
+	var rules []*domain.AutomationRule
+	err := GetTransaction(ctx, r.db).
+		Where("workspace_id = ? AND enabled = ?", workspaceID, true).
+		Order("created_at ASC").
+		Find(&rules).Error
+	if err != nil {
+		logger.Errorf("Failed to list enabled automation rules: %v", err)
+		return nil, fmt.Errorf("failed to list enabled automation rules: %w", err)
+	}
+	return rules, nil
+}
+
+// UpdateRule: 자동화 규칙 설정을 업데이트합니다 (실행 통계는 ReserveExecution, RecordRuleError가 갱신)
+func (r *automationRepository) UpdateRule(ctx context.Context, rule *domain.AutomationRule) error {
+	err := GetTransaction(ctx, r.db).
+		Model(&domain.AutomationRule{}).
+		Where("id = ?", rule.ID).
+		Updates(map[string]interface{}{
+			"name":                rule.Name,
+			"description":         rule.Description,
+			"enabled":             rule.Enabled,
+			"event_types":         rule.EventTypes,
+			"condition":           rule.Condition,
+			"actions":             rule.Actions,
+			"rate_limit":          rule.RateLimit,
+			"rate_window_seconds": rule.RateWindowSeconds,
+			"last_error":          rule.LastError,
+			"last_error_at":       rule.LastErrorAt,
+			"updated_at":          time.Now(),
+		}).Error
+	if err != nil {
+		logger.Errorf("Failed to update automation rule: %v", err)
+		return fmt.Errorf("failed to update automation rule: %w", err)
+	}
+	return nil
+}
+
+// DeleteRule: 자동화 규칙과 실행 기록을 삭제합니다
+func (r *automationRepository) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	return GetTransaction(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("rule_id = ?", id).Delete(&domain.AutomationExecution{}).Error; err != nil {
+			logger.Errorf("Failed to delete automation executions: %v", err)
+			return fmt.Errorf("failed to delete automation executions: %w", err)
+		}
+		result := tx.Where("id = ?", id).Delete(&domain.AutomationRule{})
+		if result.Error != nil {
+			logger.Errorf("Failed to delete automation rule: %v", result.Error)
+			return fmt.Errorf("failed to delete automation rule: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// RecordRuleError: 마지막 조건식 평가 오류를 기록합니다 (빈 메시지는 오류를 지웁니다)
+// updated_at은 규칙 정의의 수정 시각이므로 갱신하지 않습니다
+func (r *automationRepository) RecordRuleError(ctx context.Context, id uuid.UUID, message string, at time.Time) error {
+	var errorAt *time.Time
+	if message != "" {
+		errorAt = &at
+	}
+	err := GetTransaction(ctx, r.db).
+		Model(&domain.AutomationRule{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{
+			"last_error":    message,
+			"last_error_at": errorAt,
+		}).Error
+	if err != nil {
+		logger.Errorf("Failed to record automation rule error: %v", err)
+		return fmt.Errorf("failed to record automation rule error: %w", err)
+	}
+	return nil
+}
+
+// DisableRule: 규칙을 비활성화하고 사유를 last_error에 기록합니다
+func (r *automationRepository) DisableRule(ctx context.Context, id uuid.UUID, reason string, at time.Time) error {
+	err := GetTransaction(ctx, r.db).
+		Model(&domain.AutomationRule{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{
+			"enabled":       false,
+			"last_error":    reason,
+			"last_error_at": at,
+		}).Error
+	if err != nil {
+		logger.Errorf("Failed to disable automation rule: %v", err)
+		return fmt.Errorf("failed to disable automation rule: %w", err)
+	}
+	return nil
+}
+
+// ReserveExecution: 규칙 행을 잠근 뒤 실행 한도를 확인하여 실행 기록을 생성합니다
+// 기간 내 실행(한도 초과 기록 제외)이 limit회 이상이면 rate_limited 상태로 기록하고 false를 반환합니다
+func (r *automationRepository) ReserveExecution(ctx context.Context, execution *domain.AutomationExecution, limit int, window time.Duration) (bool, error) {
+	if execution.ID == uuid.Nil {
+		execution.ID = uuid.New()
+	}
+
+	var reserved bool
+	err := GetTransaction(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var rule domain.AutomationRule
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where("id = ?", execution.RuleID).First(&rule).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		var recent int64
+		if err := tx.Model(&domain.AutomationExecution{}).
+			Where("rule_id = ? AND status <> ? AND created_at > ?", execution.RuleID, domain.AutomationExecutionRateLimited, now.Add(-window)).
+			Count(&recent).Error; err != nil {
+			return err
+		}
+
+		reserved = recent < int64(limit)
+		execution.CreatedAt = now
+		if reserved {
+			execution.Status = domain.AutomationExecutionRunning
+		} else {
+			execution.Status = domain.AutomationExecutionRateLimited
+			execution.Error = fmt.Sprintf("rate limit of %d executions per %s reached", limit, window)
+			execution.FinishedAt = &now
+		}
+		if err := tx.Create(execution).Error; err != nil {
+			return err
+		}
+		if !reserved {
+			return nil
+		}
+		return tx.Model(&domain.AutomationRule{}).
+			Where("id = ?", execution.RuleID).
+			UpdateColumn("last_triggered_at", now).Error
+	})
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			// 평가 중 규칙이 삭제됨
+			return false, nil
+		}
+		logger.Errorf("Failed to reserve automation execution: %v", err)
+		return false, fmt.Errorf("failed to reserve automation execution: %w", err)
+	}
+	return reserved, nil
+}
+
+// UpdateExecution: 실행 결과를 저장합니다
+func (r *automationRepository) UpdateExecution(ctx context.Context, execution *domain.AutomationExecution) error {
+	if err := GetTransaction(ctx, r.db).Save(execution).Error; err != nil {
+		logger.Errorf("Failed to update automation execution: %v", err)
+		return fmt.Errorf("failed to update automation execution: %w", err)
+	}
+	return nil
+}
+
+// GetExecution: ID로 실행 기록을 조회합니다
+func (r *automationRepository) GetExecution(ctx context.Context, id uuid.UUID) (*domain.AutomationExecution, error) {
+	var execution domain.AutomationExecution
+	if err := GetTransaction(ctx, r.db).Where("id = ?", id).First(&execution).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		logger.Errorf("Failed to get automation execution by ID: %v", err)
+		return nil, fmt.Errorf("failed to get automation execution: %w", err)
+	}
+	return &execution, nil
+}
+
+// ListExecutions: 규칙의 실행 기록을 최신순으로 조회합니다
+func (r *automationRepository) ListExecutions(ctx context.Context, ruleID uuid.UUID, filter domain.AutomationExecutionFilter) ([]*domain.AutomationExecution, int64, error) {
+	query := GetTransaction(ctx, r.db).Model(&domain.AutomationExecution{}).Where("rule_id = ?", ruleID)
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		logger.Errorf("Failed to count automation executions: %v", err)
+		return nil, 0, fmt.Errorf("failed to count automation executions: %w", err)
+	}
+
+	var executions []*domain.AutomationExecution
+	if err := query.Order("created_at DESC").Limit(filter.Limit).Offset(filter.Offset).Find(&executions).Error; err != nil {
+		logger.Errorf("Failed to list automation executions: %v", err)
+		return nil, 0, fmt.Errorf("failed to list automation executions: %w", err)
+	}
+	return executions, total, nil
+}
+
+// DeleteExecutionsBefore: 보존 기간이 지난 실행 기록을 삭제합니다
+func (r *automationRepository) DeleteExecutionsBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := GetTransaction(ctx, r.db).
+		Where("created_at < ?", cutoff).
+		Delete(&domain.AutomationExecution{})
+	if result.Error != nil {
+		logger.Errorf("Failed to delete old automation executions: %v", result.Error)
+		return 0, fmt.Errorf("failed to delete old automation executions: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,204 @@
+package postgres
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+	"skyclust/pkg/security"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// chatChannelRepository: domain.ChatChannelRepository 인터페이스 구현체
+type chatChannelRepository struct {
+	db        *gorm.DB
+	encryptor security.Encryptor
+}
+
+// NewChatChannelRepository: 새로운 채팅 채널 저장소를 생성합니다
+func NewChatChannelRepository(db *gorm.DB, encryptor security.Encryptor) domain.ChatChannelRepository {
+	return &chatChannelRepository{
+		db:        db,
+		encryptor: encryptor,
+	}
+}
+
+// Create: 전송 대상 비밀 값을 암호화하여 채팅 채널을 생성합니다
+func (r *chatChannelRepository) Create(ctx context.Context, channel *domain.ChatChannel) error {
+	encrypted, err := r.encryptSecrets(channel)
+	if err != nil {
+		return err
+	}
+	// Save the encrypted copy so the caller keeps the plain text values
+	if err := GetTransaction(ctx, r.db).Create(encrypted).Error; err != nil {
+		logger.Errorf("Failed to create chat channel: %v", err)
+		return fmt.Errorf("failed to create chat channel: %w", err)
+	}
+	channel.ID = encrypted.ID
+	channel.CreatedAt = encrypted.CreatedAt
+	channel.UpdatedAt = encrypted.UpdatedAt
+	return nil
+}
+
+// GetByID: ID로 채팅 채널을 조회합니다
+func (r *chatChannelRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.ChatChannel, error) {
+	var channel domain.ChatChannel
+	if err := GetTransaction(ctx, r.db).Where("id = ?", id).First(&channel).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		logger.Errorf("Failed to get chat channel by ID: %v", err)
+		return nil, fmt.Errorf("failed to get chat channel: %w", err)
+	}
+	if err := r.decryptSecrets(&channel); err != nil {
+		return nil, err
+	}
+	return &channel, nil
+}
+
+// ListByWorkspace: 워크스페이스의 모든 채팅 채널을 조회합니다
+func (r *chatChannelRepository) ListByWorkspace(ctx context.Context, workspaceID string) ([]*domain.ChatChannel, error) {
+	return r.list(ctx, GetTransaction(ctx, r.db).Where("workspace_id = ?", workspaceID))
+}
+
+// ListEnabledByWorkspace: 워크스페이스의 활성화된 채팅 채널을 조회합니다
+func (r *chatChannelRepository) ListEnabledByWorkspace(ctx context.Context, workspaceID string) ([]*domain.ChatChannel, error) {
+	return r.list(ctx, GetTransaction(ctx, r.db).Where("workspace_id = ? AND enabled = ?", workspaceID, true))
+}
+
+// Update: 채팅 채널 설정을 업데이트합니다 (전송 결과는 RecordResult가 갱신)
+func (r *chatChannelRepository) Update(ctx context.Context, channel *domain.ChatChannel) error {
+	encrypted, err := r.encryptSecrets(channel)
+	if err != nil {
+		return err
+	}
+
+	err = GetTransaction(ctx, r.db).
+		Model(&domain.ChatChannel{}).
+		Where("id = ?", channel.ID).
+		Updates(map[string]interface{}{
+			"name":           encrypted.Name,
+			"mode":           encrypted.Mode,
+			"webhook_url":    encrypted.WebhookURL,
+			"bot_token":      encrypted.BotToken,
+			"signing_secret": encrypted.SigningSecret,
+			"slack_channel":  encrypted.SlackChannel,
+			"categories":     encrypted.Categories,
+			"min_priority":   encrypted.MinPriority,
+			"enabled":        encrypted.Enabled,
+			"updated_at":     time.Now(),
+		}).Error
+	if err != nil {
+		logger.Errorf("Failed to update chat channel: %v", err)
+		return fmt.Errorf("failed to update chat channel: %w", err)
+	}
+	return nil
+}
+
+// Delete: 채팅 채널을 삭제합니다
+func (r *chatChannelRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := GetTransaction(ctx, r.db).Where("id = ?", id).Delete(&domain.ChatChannel{})
+	if result.Error != nil {
+		logger.Errorf("Failed to delete chat channel: %v", result.Error)
+		return fmt.Errorf("failed to delete chat channel: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.NewDomainError(domain.ErrCodeNotFound, "chat channel not found", 404)
+	}
+	return nil
+}
+
+// RecordResult: 마지막 전송 결과를 기록합니다
+func (r *chatChannelRepository) RecordResult(ctx context.Context, id uuid.UUID, sentAt time.Time, errMessage string) error {
+	updates := map[string]interface{}{
+		"last_error": errMessage,
+	}
+	if errMessage == "" {
+		updates["last_sent_at"] = sentAt
+	}
+
+	err := GetTransaction(ctx, r.db).
+		Model(&domain.ChatChannel{}).
+		Where("id = ?", id).
+		UpdateColumns(updates).Error
+	if err != nil {
+		logger.Errorf("Failed to record chat channel result: %v", err)
+		return fmt.Errorf("failed to record chat channel result: %w", err)
+	}
+	return nil
+}
+
+// list: 조건에 맞는 채팅 채널을 생성 순서로 조회하고 비밀 값을 복호화합니다
+func (r *chatChannelRepository) list(ctx context.Context, query *gorm.DB) ([]*domain.ChatChannel, error) {
+	var channels []*domain.ChatChannel
+	if err := query.Order("created_at ASC").Find(&channels).Error; err != nil {
+		logger.Errorf("Failed to list chat channels: %v", err)
+		return nil, fmt.Errorf("failed to list chat channels: %w", err)
+	}
+	for _, channel := range channels {
+		if err := r.decryptSecrets(channel); err != nil {
+			return nil, err
+		}
+	}
+	return channels, nil
+}
+
+// encryptSecrets: 비밀 값을 암호화한 채널 사본을 반환합니다
+func (r *chatChannelRepository) encryptSecrets(channel *domain.ChatChannel) (*domain.ChatChannel, error) {
+	encrypted := *channel
+	for _, field := range []*string{&encrypted.WebhookURL, &encrypted.BotToken, &encrypted.SigningSecret} {
+		value, err := r.encrypt(*field)
+		if err != nil {
+			logger.Errorf("Failed to encrypt chat channel secret: %v", err)
+			return nil, fmt.Errorf("failed to encrypt chat channel secret: %w", err)
+		}
+		*field = value
+	}
+	return &encrypted, nil
+}
+
+// decryptSecrets: 채널의 비밀 값을 복호화합니다
+func (r *chatChannelRepository) decryptSecrets(channel *domain.ChatChannel) error {
+	for _, field := range []*string{&channel.WebhookURL, &channel.BotToken, &channel.SigningSecret} {
+		value, err := r.decrypt(*field)
+		if err != nil {
+			logger.Errorf("Failed to decrypt chat channel secret: %v", err)
+			return fmt.Errorf("failed to decrypt chat channel secret: %w", err)
+		}
+		*field = value
+	}
+	return nil
+}
+
+// encrypt: 값을 암호화하여 base64 문자열로 반환합니다
+func (r *chatChannelRepository) encrypt(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	encrypted, err := r.encryptor.Encrypt([]byte(value))
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// decrypt: base64로 인코딩된 암호문을 복호화합니다
+func (r *chatChannelRepository) decrypt(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	encryptedBytes, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode base64: %w", err)
+	}
+	decrypted, err := r.encryptor.Decrypt(encryptedBytes)
+	if err != nil {
+		return "", err
+	}
+	return string(decrypted), nil
+}
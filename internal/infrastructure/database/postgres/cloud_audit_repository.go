@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// cloudAuditRepository: domain.CloudAuditRepository 인터페이스 구현체
+type cloudAuditRepository struct {
+	db *gorm.DB
+}
+
+// NewCloudAuditRepository: 새로운 CloudAuditRepository를 생성합니다
+func NewCloudAuditRepository(db *gorm.DB) domain.CloudAuditRepository {
+	return &cloudAuditRepository{db: db}
+}
+
+// GetCursor: 자격증명의 수집 위치를 조회합니다 (없으면 nil)
+func (r *cloudAuditRepository) GetCursor(credentialID uuid.UUID) (*domain.CloudAuditCursor, error) {
+	var cursor domain.CloudAuditCursor
+	result := r.db.Where("credential_id = ?", credentialID).Limit(1).Find(&cursor)
+	if result.Error != nil {
+		logger.Errorf("Failed to get cloud audit cursor: %v", result.Error)
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &cursor, nil
+}
+
+// SaveCursor: 수집 위치와 상태를 저장합니다 (자격증명별 하나)
+func (r *cloudAuditRepository) SaveCursor(cursor *domain.CloudAuditCursor) error {
+	if cursor.ID == uuid.Nil {
+		cursor.ID = uuid.New()
+	}
+	err := r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "credential_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"workspace_id", "provider", "collected_until", "last_run_at", "last_error",
+			"ingested_count", "out_of_band_count", "updated_at",
+		}),
+	}).Create(cursor).Error
+	if err != nil {
+		logger.Errorf("Failed to save cloud audit cursor: %v", err)
+		return err
+	}
+	return nil
+}
+
+// ListCursors: 수집 위치 목록을 조회합니다 (workspaceID가 nil이면 전체)
+func (r *cloudAuditRepository) ListCursors(workspaceID *uuid.UUID) ([]*domain.CloudAuditCursor, error) {
+	var cursors []*domain.CloudAuditCursor
+	query := r.db.Order("workspace_id ASC, created_at ASC")
+	if workspaceID != nil {
+		query = query.Where("workspace_id = ?", *workspaceID)
+	}
+	if err := query.Find(&cursors).Error; err != nil {
+		logger.Errorf("Failed to list cloud audit cursors: %v", err)
+		return nil, err
+	}
+	return cursors, nil
+}
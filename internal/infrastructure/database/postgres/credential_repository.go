@@ -1,13 +1,19 @@
 package postgres
 
 import (
+	"time"
+
 	"skyclust/internal/domain"
 	"skyclust/pkg/logger"
+	"skyclust/pkg/security"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
+// inlineSecretCondition: 암호화 데이터를 직접 보관하는 자격증명 조건 (외부 비밀 저장소 참조 제외)
+const inlineSecretCondition = "(secret_store IS NULL OR secret_store = '')"
+
 // credentialRepository: domain.CredentialRepository 인터페이스 구현체
 type credentialRepository struct {
 	db *gorm.DB
@@ -90,3 +96,112 @@ func (r *credentialRepository) Delete(id uuid.UUID) error {
 	}
 	return nil
 }
+
+// CountByKeyVersion: 마스터 키 버전별 자격증명 수를 조회합니다 (버전이 없으면 legacy, 외부 참조 제외)
+func (r *credentialRepository) CountByKeyVersion() (map[string]int64, error) {
+	var rows []struct {
+		KeyVersion *string
+		Count      int64
+	}
+	err := r.db.Model(&domain.Credential{}).
+		Select("key_version, COUNT(*) AS count").
+		Where(inlineSecretCondition).
+		Group("key_version").
+		Scan(&rows).Error
+	if err != nil {
+		logger.Errorf("Failed to count credentials by key version: %v", err)
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		version := security.LegacyKeyVersion
+		if row.KeyVersion != nil && *row.KeyVersion != "" {
+			version = *row.KeyVersion
+		}
+		counts[version] += row.Count
+	}
+	return counts, nil
+}
+
+// ListNotOnKeyVersion: 지정한 키 버전이 아닌 자격증명을 ID 순으로 조회합니다 (afterID 이후)
+func (r *credentialRepository) ListNotOnKeyVersion(keyVersion string, afterID uuid.UUID, limit int) ([]*domain.Credential, error) {
+	var credentials []*domain.Credential
+	err := r.db.Where("(key_version IS NULL OR key_version <> ?) AND id > ?", keyVersion, afterID).
+		Where(inlineSecretCondition).
+		Order("id ASC").
+		Limit(limit).
+		Find(&credentials).Error
+	if err != nil {
+		logger.Errorf("Failed to list credentials not on key version %s: %v", keyVersion, err)
+		return nil, err
+	}
+	return credentials, nil
+}
+
+// UpdateEncryptedData: 암호문이 previous와 같을 때만 암호화 데이터와 키 버전을 교체합니다
+// 재암호화 중 동시에 수정된 자격증명을 덮어쓰지 않도록 false를 반환합니다
+func (r *credentialRepository) UpdateEncryptedData(id uuid.UUID, previous, encryptedData []byte, keyVersion string) (bool, error) {
+	result := r.db.Model(&domain.Credential{}).
+		Where("id = ? AND encrypted_data = ?", id, previous).
+		Updates(map[string]interface{}{
+			"encrypted_data": encryptedData,
+			"key_version":    keyVersion,
+		})
+	if result.Error != nil {
+		logger.Errorf("Failed to update credential encrypted data: %v", result.Error)
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ListDueForVerification: 마지막 점검이 verifiedBefore 이전이거나 점검 이력이 없는 활성 자격증명을 조회합니다
+func (r *credentialRepository) ListDueForVerification(verifiedBefore time.Time, limit int) ([]*domain.Credential, error) {
+	var credentials []*domain.Credential
+	err := r.db.Where("is_active = ? AND (last_verified_at IS NULL OR last_verified_at < ?)", true, verifiedBefore).
+		Order("last_verified_at ASC NULLS FIRST").
+		Limit(limit).
+		Find(&credentials).Error
+	if err != nil {
+		logger.Errorf("Failed to list credentials due for verification: %v", err)
+		return nil, err
+	}
+	return credentials, nil
+}
+
+// ListActiveByProviders: 지정한 프로바이더의 활성 자격증명을 조회합니다
+func (r *credentialRepository) ListActiveByProviders(providers []string) ([]*domain.Credential, error) {
+	var credentials []*domain.Credential
+	err := r.db.Where("is_active = ? AND provider IN ?", true, providers).
+		Order("created_at ASC").
+		Find(&credentials).Error
+	if err != nil {
+		logger.Errorf("Failed to list active credentials by provider: %v", err)
+		return nil, err
+	}
+	return credentials, nil
+}
+
+// UpdateHealth: 자격증명 상태 점검 결과를 저장합니다 (암호화 데이터는 변경하지 않음)
+func (r *credentialRepository) UpdateHealth(id uuid.UUID, health *domain.CredentialHealth) error {
+	gaps := health.PermissionGaps
+	if gaps == nil {
+		gaps = []string{}
+	}
+	err := r.db.Model(&domain.Credential{ID: id}).
+		Select("health_status", "health_message", "verified_identity", "last_verified_at", "permission_gaps", "key_created_at", "rotation_due").
+		Updates(&domain.Credential{
+			HealthStatus:     health.Status,
+			HealthMessage:    health.Message,
+			VerifiedIdentity: health.Identity,
+			LastVerifiedAt:   &health.VerifiedAt,
+			PermissionGaps:   gaps,
+			KeyCreatedAt:     health.KeyCreatedAt,
+			RotationDue:      health.RotationDue,
+		}).Error
+	if err != nil {
+		logger.Errorf("Failed to update credential health: %v", err)
+		return err
+	}
+	return nil
+}
@@ -8,7 +8,9 @@ package postgres
 import (
 	"context"
 	"skyclust/internal/domain"
+	"time"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
@@ -37,6 +39,7 @@ func (r *notificationPreferencesRepository) GetByUserID(ctx context.Context, use
 				PushEnabled:           true,
 				BrowserEnabled:        true,
 				InAppEnabled:          true,
+				ChatEnabled:           true,
 				SystemNotifications:   true,
 				VMNotifications:       true,
 				CostNotifications:     true,
@@ -46,6 +49,8 @@ func (r *notificationPreferencesRepository) GetByUserID(ctx context.Context, use
 				HighPriorityEnabled:   true,
 				UrgentPriorityEnabled: true,
 				Timezone:              "UTC",
+				Locale:                domain.DefaultNotificationLocale,
+				DigestFrequency:       domain.NotificationDigestNone,
 			}
 
 			if err := r.Create(ctx, defaultPreferences); err != nil {
@@ -62,6 +67,9 @@ func (r *notificationPreferencesRepository) GetByUserID(ctx context.Context, use
 
 // Create 알림 설정 생성
 func (r *notificationPreferencesRepository) Create(ctx context.Context, preferences *domain.NotificationPreferences) error {
+	if preferences.ID == "" {
+		preferences.ID = uuid.New().String()
+	}
 	return r.db.WithContext(ctx).Create(preferences).Error
 }
 
@@ -71,9 +79,37 @@ func (r *notificationPreferencesRepository) Update(ctx context.Context, preferen
 }
 
 // Upsert 알림 설정 생성 또는 업데이트
+// 구조체 Assign은 false 같은 zero 값을 건너뛰므로 기존 설정은 Save로 모든 필드를 저장합니다
 func (r *notificationPreferencesRepository) Upsert(ctx context.Context, preferences *domain.NotificationPreferences) error {
-	return r.db.WithContext(ctx).
+	var existing domain.NotificationPreferences
+	err := r.db.WithContext(ctx).
 		Where("user_id = ?", preferences.UserID).
-		Assign(preferences).
-		FirstOrCreate(preferences).Error
+		First(&existing).Error
+	if err == gorm.ErrRecordNotFound {
+		// 기본값이 있는 필드의 zero 값은 생성 시 기본값으로 바뀌므로 생성 후 다시 저장
+		values := *preferences
+		if err := r.Create(ctx, preferences); err != nil {
+			return err
+		}
+		values.ID = preferences.ID
+		values.CreatedAt = preferences.CreatedAt
+		*preferences = values
+		return r.Update(ctx, preferences)
+	}
+	if err != nil {
+		return err
+	}
+
+	preferences.ID = existing.ID
+	preferences.CreatedAt = existing.CreatedAt
+	preferences.LastDigestAt = existing.LastDigestAt
+	return r.Update(ctx, preferences)
+}
+
+// SetLastDigestAt 마지막 다이제스트 전송 시각 기록
+func (r *notificationPreferencesRepository) SetLastDigestAt(ctx context.Context, userID string, at time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.NotificationPreferences{}).
+		Where("user_id = ?", userID).
+		Update("last_digest_at", at).Error
 }
@@ -8,6 +8,7 @@ package postgres
 import (
 	"context"
 	"skyclust/internal/domain"
+	"sort"
 	"time"
 
 	"gorm.io/gorm"
@@ -236,3 +237,155 @@ func (r *notificationRepository) CleanupOld(ctx context.Context, olderThan time.
 		Where("created_at < ?", cutoffTime).
 		Delete(&domain.Notification{}).Error
 }
+
+// GetByGroupID 알림 그룹의 알림 하나 조회 (없으면 nil)
+func (r *notificationRepository) GetByGroupID(ctx context.Context, groupID string) (*domain.Notification, error) {
+	var notification domain.Notification
+	err := r.db.WithContext(ctx).
+		Where("group_id = ?", groupID).
+		Order("created_at ASC").
+		First(&notification).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &notification, nil
+}
+
+// Acknowledge 알림 그룹 확인 처리 (읽음 처리 포함, 이미 확인된 알림은 유지)
+func (r *notificationRepository) Acknowledge(ctx context.Context, groupID, acknowledgedBy string, at time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&domain.Notification{}).
+		Where("group_id = ? AND acknowledged_at IS NULL", groupID).
+		Updates(map[string]interface{}{
+			"acknowledged_at": at,
+			"acknowledged_by": acknowledgedBy,
+			"is_read":         true,
+			"read_at":         gorm.Expr("COALESCE(read_at, ?)", at),
+		})
+
+	return result.RowsAffected, result.Error
+}
+
+// FindRecentDuplicate 중복 억제 기간 내 같은 키의 최근 알림 조회 (없으면 nil)
+func (r *notificationRepository) FindRecentDuplicate(ctx context.Context, userID, dedupKey string, since time.Time) (*domain.Notification, error) {
+	var notification domain.Notification
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND dedup_key = ? AND created_at >= ?", userID, dedupKey, since).
+		Order("created_at DESC").
+		First(&notification).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &notification, nil
+}
+
+// IncrementDuplicate 억제된 중복 알림 횟수 증가
+func (r *notificationRepository) IncrementDuplicate(ctx context.Context, notificationID string) error {
+	return r.db.WithContext(ctx).
+		Model(&domain.Notification{}).
+		Where("id = ?", notificationID).
+		Update("duplicate_count", gorm.Expr("duplicate_count + 1")).Error
+}
+
+// ReleaseDeferred 방해 금지 시간이 끝난 알림을 전달 상태로 전환 (여러 인스턴스에서 중복 처리되지 않도록 행 잠금)
+func (r *notificationRepository) ReleaseDeferred(ctx context.Context, now time.Time, limit int) ([]*domain.Notification, error) {
+	var notifications []*domain.Notification
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE notifications SET delivery_status = ?
+		WHERE id IN (
+			SELECT id FROM notifications
+			WHERE delivery_status = ? AND deliver_after <= ?
+			ORDER BY deliver_after ASC
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		domain.NotificationDeliveryDelivered,
+		domain.NotificationDeliveryDeferred, now,
+		limit,
+	).Scan(&notifications).Error
+
+	return notifications, err
+}
+
+// ClaimEscalations 확인되지 않은 긴급 알림 중 에스컬레이션 시각이 지난 알림을 에스컬레이션 처리
+func (r *notificationRepository) ClaimEscalations(ctx context.Context, now time.Time, limit int) ([]*domain.Notification, error) {
+	var notifications []*domain.Notification
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE notifications SET escalated_at = ?
+		WHERE id IN (
+			SELECT id FROM notifications
+			WHERE escalate_at <= ? AND escalated_at IS NULL AND acknowledged_at IS NULL AND escalate_to <> ''
+			ORDER BY escalate_at ASC
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now,
+		now,
+		limit,
+	).Scan(&notifications).Error
+
+	return notifications, err
+}
+
+// ListDigestUserIDs 다이제스트 대기 알림이 있는 사용자 목록 조회
+func (r *notificationRepository) ListDigestUserIDs(ctx context.Context) ([]string, error) {
+	var userIDs []string
+	err := r.db.WithContext(ctx).
+		Model(&domain.Notification{}).
+		Where("delivery_status = ?", domain.NotificationDeliveryDigest).
+		Distinct("user_id").
+		Pluck("user_id", &userIDs).Error
+
+	return userIDs, err
+}
+
+// ClaimDigest 사용자의 다이제스트 대기 알림을 다이제스트 전송 상태로 전환하여 반환 (오래된 순)
+func (r *notificationRepository) ClaimDigest(ctx context.Context, userID string, limit int) ([]*domain.Notification, error) {
+	var notifications []*domain.Notification
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE notifications SET delivery_status = ?
+		WHERE id IN (
+			SELECT id FROM notifications
+			WHERE user_id = ? AND delivery_status = ?
+			ORDER BY created_at ASC
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		domain.NotificationDeliveryDigested,
+		userID, domain.NotificationDeliveryDigest,
+		limit,
+	).Scan(&notifications).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// RETURNING 결과는 순서를 보장하지 않으므로 생성 시각 순으로 정렬
+	sort.Slice(notifications, func(i, j int) bool {
+		return notifications[i].CreatedAt.Before(notifications[j].CreatedAt)
+	})
+	return notifications, nil
+}
+
+// RequeueDigest 전송에 실패한 다이제스트 알림을 다시 대기 상태로 전환
+func (r *notificationRepository) RequeueDigest(ctx context.Context, notificationIDs []string) error {
+	if len(notificationIDs) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Model(&domain.Notification{}).
+		Where("id IN ? AND delivery_status = ?", notificationIDs, domain.NotificationDeliveryDigested).
+		Update("delivery_status", domain.NotificationDeliveryDigest).Error
+}
@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+
+	"gorm.io/gorm"
+)
+
+// notificationTemplateRepository: domain.NotificationTemplateRepository 인터페이스 구현체
+type notificationTemplateRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationTemplateRepository: 새로운 알림 템플릿 저장소를 생성합니다
+func NewNotificationTemplateRepository(db *gorm.DB) domain.NotificationTemplateRepository {
+	return &notificationTemplateRepository{db: db}
+}
+
+// CreateVersion: 키와 로케일의 다음 버전으로 템플릿을 저장합니다
+// 같은 키와 로케일의 동시 저장은 advisory lock으로 직렬화하여 버전 번호가 겹치지 않도록 합니다
+func (r *notificationTemplateRepository) CreateVersion(ctx context.Context, template *domain.NotificationTemplate, activate bool) error {
+	err := GetTransaction(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "notification_template:"+template.Key+":"+template.Locale).Error; err != nil {
+			return err
+		}
+
+		var latest int
+		if err := tx.Model(&domain.NotificationTemplate{}).
+			Where("key = ? AND locale = ?", template.Key, template.Locale).
+			Select("COALESCE(MAX(version), 0)").
+			Scan(&latest).Error; err != nil {
+			return err
+		}
+
+		// 첫 버전은 항상 활성화
+		if latest == 0 {
+			activate = true
+		}
+		if activate {
+			if err := tx.Model(&domain.NotificationTemplate{}).
+				Where("key = ? AND locale = ? AND active = ?", template.Key, template.Locale, true).
+				Update("active", false).Error; err != nil {
+				return err
+			}
+		}
+
+		template.Version = latest + 1
+		template.Active = activate
+		return tx.Create(template).Error
+	})
+	if err != nil {
+		logger.Errorf("Failed to create notification template version: %v", err)
+		return fmt.Errorf("failed to create notification template version: %w", err)
+	}
+	return nil
+}
+
+// GetActive: 키와 로케일의 활성 버전을 조회합니다
+func (r *notificationTemplateRepository) GetActive(ctx context.Context, key, locale string) (*domain.NotificationTemplate, error) {
+	return r.first(GetTransaction(ctx, r.db).Where("key = ? AND locale = ? AND active = ?", key, locale, true))
+}
+
+// GetVersion: 키와 로케일의 특정 버전을 조회합니다
+func (r *notificationTemplateRepository) GetVersion(ctx context.Context, key, locale string, version int) (*domain.NotificationTemplate, error) {
+	return r.first(GetTransaction(ctx, r.db).Where("key = ? AND locale = ? AND version = ?", key, locale, version))
+}
+
+// ListActive: 활성 템플릿 목록을 조회합니다 (key가 있으면 해당 키만)
+func (r *notificationTemplateRepository) ListActive(ctx context.Context, key string) ([]*domain.NotificationTemplate, error) {
+	query := GetTransaction(ctx, r.db).Where("active = ?", true)
+	if key != "" {
+		query = query.Where("key = ?", key)
+	}
+
+	var templates []*domain.NotificationTemplate
+	if err := query.Order("key ASC, locale ASC").Find(&templates).Error; err != nil {
+		logger.Errorf("Failed to list notification templates: %v", err)
+		return nil, fmt.Errorf("failed to list notification templates: %w", err)
+	}
+	return templates, nil
+}
+
+// ListVersions: 키와 로케일의 모든 버전을 최신순으로 조회합니다
+func (r *notificationTemplateRepository) ListVersions(ctx context.Context, key, locale string) ([]*domain.NotificationTemplate, error) {
+	var templates []*domain.NotificationTemplate
+	if err := GetTransaction(ctx, r.db).
+		Where("key = ? AND locale = ?", key, locale).
+		Order("version DESC").
+		Find(&templates).Error; err != nil {
+		logger.Errorf("Failed to list notification template versions: %v", err)
+		return nil, fmt.Errorf("failed to list notification template versions: %w", err)
+	}
+	return templates, nil
+}
+
+// Activate: 지정한 버전을 활성 버전으로 전환합니다
+func (r *notificationTemplateRepository) Activate(ctx context.Context, key, locale string, version int) error {
+	return GetTransaction(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.NotificationTemplate{}).
+			Where("key = ? AND locale = ? AND version = ?", key, locale, version).
+			Update("active", true)
+		if result.Error != nil {
+			logger.Errorf("Failed to activate notification template version: %v", result.Error)
+			return fmt.Errorf("failed to activate notification template version: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return domain.NewDomainError(domain.ErrCodeNotFound, fmt.Sprintf("notification template %s (%s) version %d not found", key, locale, version), 404)
+		}
+
+		if err := tx.Model(&domain.NotificationTemplate{}).
+			Where("key = ? AND locale = ? AND version <> ? AND active = ?", key, locale, version, true).
+			Update("active", false).Error; err != nil {
+			logger.Errorf("Failed to deactivate notification template versions: %v", err)
+			return fmt.Errorf("failed to deactivate notification template versions: %w", err)
+		}
+		return nil
+	})
+}
+
+// Delete: 키와 로케일의 모든 버전을 삭제합니다
+func (r *notificationTemplateRepository) Delete(ctx context.Context, key, locale string) (int64, error) {
+	result := GetTransaction(ctx, r.db).
+		Where("key = ? AND locale = ?", key, locale).
+		Delete(&domain.NotificationTemplate{})
+	if result.Error != nil {
+		logger.Errorf("Failed to delete notification template: %v", result.Error)
+		return 0, fmt.Errorf("failed to delete notification template: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// first: 조건에 맞는 템플릿 하나를 조회합니다 (없으면 nil)
+func (r *notificationTemplateRepository) first(query *gorm.DB) (*domain.NotificationTemplate, error) {
+	var template domain.NotificationTemplate
+	if err := query.First(&template).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		logger.Errorf("Failed to get notification template: %v", err)
+		return nil, fmt.Errorf("failed to get notification template: %w", err)
+	}
+	return &template, nil
+}
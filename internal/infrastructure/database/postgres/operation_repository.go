@@ -0,0 +1,135 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// operationRepository: domain.OperationRepository 인터페이스 구현체
+type operationRepository struct {
+	db *gorm.DB
+}
+
+// NewOperationRepository: 새로운 작업 저장소를 생성합니다
+func NewOperationRepository(db *gorm.DB) domain.OperationRepository {
+	return &operationRepository{db: db}
+}
+
+// Create: 작업을 생성합니다
+func (r *operationRepository) Create(ctx context.Context, operation *domain.Operation) error {
+	if operation.ID == uuid.Nil {
+		operation.ID = uuid.New()
+	}
+	if err := GetTransaction(ctx, r.db).Create(operation).Error; err != nil {
+		logger.Errorf("Failed to create operation: %v", err)
+		return fmt.Errorf("failed to create operation: %w", err)
+	}
+	return nil
+}
+
+// GetByID: ID로 작업을 조회합니다
+func (r *operationRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Operation, error) {
+	var operation domain.Operation
+	if err := GetTransaction(ctx, r.db).Where("id = ?", id).First(&operation).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		logger.Errorf("Failed to get operation by ID: %v", err)
+		return nil, fmt.Errorf("failed to get operation: %w", err)
+	}
+	return &operation, nil
+}
+
+// List: 워크스페이스의 작업을 최신순으로 조회합니다
+func (r *operationRepository) List(ctx context.Context, workspaceID string, filter domain.OperationFilter) ([]*domain.Operation, int64, error) {
+	query := GetTransaction(ctx, r.db).Model(&domain.Operation{}).Where("workspace_id = ?", workspaceID)
+	if len(filter.Kinds) > 0 {
+		conditions := make([]string, 0, len(filter.Kinds))
+		args := make([]interface{}, 0, len(filter.Kinds))
+		for _, kind := range filter.Kinds {
+			conditions = append(conditions, "type LIKE ?")
+			args = append(args, kind+".%")
+		}
+		query = query.Where("("+strings.Join(conditions, " OR ")+")", args...)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+	if filter.TargetType != "" {
+		query = query.Where("target_type = ?", filter.TargetType)
+	}
+	if filter.TargetID != "" {
+		query = query.Where("target_id = ?", filter.TargetID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		logger.Errorf("Failed to count operations: %v", err)
+		return nil, 0, fmt.Errorf("failed to count operations: %w", err)
+	}
+
+	var operations []*domain.Operation
+	if err := query.Order("started_at DESC").Limit(filter.Limit).Offset(filter.Offset).Find(&operations).Error; err != nil {
+		logger.Errorf("Failed to list operations: %v", err)
+		return nil, 0, fmt.Errorf("failed to list operations: %w", err)
+	}
+	return operations, total, nil
+}
+
+// Update: 작업 진행 상황을 저장합니다
+func (r *operationRepository) Update(ctx context.Context, operation *domain.Operation) error {
+	if err := GetTransaction(ctx, r.db).Save(operation).Error; err != nil {
+		logger.Errorf("Failed to update operation: %v", err)
+		return fmt.Errorf("failed to update operation: %w", err)
+	}
+	return nil
+}
+
+// ClaimDueOperations: 조회 시각이 된 진행 중 작업을 임대합니다
+// 다음 조회 시각을 임대 기간만큼 미루므로 워커가 중단되어도 임대가 끝나면 다시 조회됩니다
+func (r *operationRepository) ClaimDueOperations(ctx context.Context, limit int, lease time.Duration) ([]*domain.Operation, error) {
+	now := time.Now()
+
+	var operations []*domain.Operation
+	if err := r.db.WithContext(ctx).Raw(`
+		UPDATE operations SET next_poll_at = ?
+		WHERE id IN (
+			SELECT id FROM operations
+			WHERE status = ? AND next_poll_at <= ?
+			ORDER BY next_poll_at ASC
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now.Add(lease),
+		domain.OperationRunning, now,
+		limit,
+	).Scan(&operations).Error; err != nil {
+		logger.Errorf("Failed to claim due operations: %v", err)
+		return nil, fmt.Errorf("failed to claim due operations: %w", err)
+	}
+	return operations, nil
+}
+
+// DeleteFinishedBefore: 보존 기간이 지난 완료된 작업을 삭제합니다
+func (r *operationRepository) DeleteFinishedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := GetTransaction(ctx, r.db).
+		Where("status <> ? AND finished_at < ?", domain.OperationRunning, cutoff).
+		Delete(&domain.Operation{})
+	if result.Error != nil {
+		logger.Errorf("Failed to delete old operations: %v", result.Error)
+		return 0, fmt.Errorf("failed to delete old operations: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
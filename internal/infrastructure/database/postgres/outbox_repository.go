@@ -2,13 +2,17 @@ package postgres
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"skyclust/internal/domain"
 	"skyclust/pkg/logger"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/stdlib"
 	"gorm.io/gorm"
 )
 
@@ -39,25 +43,324 @@ func (r *outboxRepository) Create(ctx context.Context, event *domain.OutboxEvent
 	if event.Status == "" {
 		event.Status = domain.OutboxStatusPending
 	}
+	if event.AggregateID == "" {
+		event.AggregateID = event.Topic
+	}
 
 	if err := db.WithContext(ctx).Create(event).Error; err != nil {
 		logger.Errorf("Failed to create outbox event: %v", err)
 		return fmt.Errorf("failed to create outbox event: %w", err)
 	}
 
+	// NOTIFY는 트랜잭션 커밋 시점에 전달되므로 커밋된 이벤트만 워커를 깨움
+	// Audit sink events are polled by the audit sink worker
+	if !strings.HasPrefix(event.Topic, domain.AuditSinkTopicPrefix) {
+		if err := db.WithContext(ctx).Exec("SELECT pg_notify(?, ?)", domain.OutboxNotifyChannel, event.Topic).Error; err != nil {
+			// 알림 실패 시에도 워커가 주기적으로 조회하므로 이벤트는 발행됨
+			logger.Warnf("Failed to notify outbox event %s: %v", event.ID, err)
+		}
+	}
+
 	logger.Debug(fmt.Sprintf("Created outbox event: id=%s, topic=%s, event_type=%s",
 		event.ID.String(), event.Topic, event.EventType))
 
 	return nil
 }
 
+// ClaimPendingEvents leases due events to a worker, skipping rows locked by other workers
+// and events whose aggregate still has an older unpublished event
+// 다른 워커가 잠근 행과, 같은 aggregate에 먼저 발행할 이벤트가 남은 이벤트를 건너뛰고 워커에 임대
+func (r *outboxRepository) ClaimPendingEvents(ctx context.Context, workerID string, limit int, lease time.Duration) ([]*domain.OutboxEvent, error) {
+	now := time.Now()
+	lockedUntil := now.Add(lease)
+
+	// 대기 중이면서 재시도 시각이 된 이벤트, 또는 임대가 만료된 처리 중 이벤트(워커 중단)를 가져옴
+	// 감사 싱크 토픽은 싱크 디스패처가 ClaimPendingEventsByTopic으로 싱크별로 임대
+	// 같은 aggregate에 더 오래된 pending/processing 이벤트가 있으면 순서를 지키기 위해 건너뜀
+	var events []*domain.OutboxEvent
+	if err := r.db.WithContext(ctx).Raw(`
+		UPDATE outbox_events SET status = ?, locked_by = ?, locked_until = ?
+		WHERE id IN (
+			SELECT e.id FROM outbox_events e
+			WHERE ((e.status = ? AND (e.next_attempt_at IS NULL OR e.next_attempt_at <= ?))
+				OR (e.status = ? AND e.locked_until < ?))
+				AND e.topic NOT LIKE ?
+				AND NOT EXISTS (
+					SELECT 1 FROM outbox_events prev
+					WHERE e.aggregate_id <> ''
+						AND prev.aggregate_id = e.aggregate_id
+						AND prev.status IN (?, ?)
+						AND (prev.created_at, prev.id) < (e.created_at, e.id)
+				)
+			ORDER BY e.created_at ASC, e.id ASC
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		domain.OutboxStatusProcessing, workerID, lockedUntil,
+		domain.OutboxStatusPending, now,
+		domain.OutboxStatusProcessing, now,
+		domain.AuditSinkTopicPrefix+"%",
+		domain.OutboxStatusPending, domain.OutboxStatusProcessing,
+		limit,
+	).Scan(&events).Error; err != nil {
+		logger.Errorf("Failed to claim pending outbox events: %v", err)
+		return nil, fmt.Errorf("failed to claim pending outbox events: %w", err)
+	}
+
+	// RETURNING은 순서를 보장하지 않으므로 생성 순서로 정렬
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].CreatedAt.Before(events[j].CreatedAt)
+	})
+
+	return events, nil
+}
+
+// ClaimPendingEventsByTopic leases the oldest due events of a single topic to a worker
+// Only one worker holds a topic at a time, so consumers that deliver in order (audit sinks) are safe on every replica
+// 단일 토픽의 가장 오래된 이벤트를 워커에 임대 (토픽당 한 워커만 임대하므로 여러 인스턴스에서도 순서가 유지됨)
+func (r *outboxRepository) ClaimPendingEventsByTopic(ctx context.Context, topic, workerID string, limit int, lease time.Duration) ([]*domain.OutboxEvent, error) {
+	now := time.Now()
+	lockedUntil := now.Add(lease)
+
+	var events []*domain.OutboxEvent
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 같은 토픽을 동시에 임대하려는 워커를 직렬화 (트랜잭션이 끝나면 해제되고 이후에는 임대가 토픽을 보호)
+		var acquired bool
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(hashtext(?))", topic).Scan(&acquired).Error; err != nil {
+			return err
+		}
+		if !acquired {
+			return nil
+		}
+
+		// 다른 워커의 임대가 유효하거나 재시도 대기 중인 이벤트가 있으면 순서를 지키기 위해 토픽 전체를 건너뜀
+		return tx.Raw(`
+			UPDATE outbox_events SET status = ?, locked_by = ?, locked_until = ?
+			WHERE id IN (
+				SELECT e.id FROM outbox_events e
+				WHERE e.topic = ?
+					AND (e.status = ? OR (e.status = ? AND (e.locked_until IS NULL OR e.locked_until < ?)))
+					AND NOT EXISTS (
+						SELECT 1 FROM outbox_events held
+						WHERE held.topic = e.topic
+							AND ((held.status = ? AND held.locked_until >= ?)
+								OR (held.status = ? AND held.next_attempt_at > ?))
+					)
+				ORDER BY e.created_at ASC, e.id ASC
+				LIMIT ?
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING *`,
+			domain.OutboxStatusProcessing, workerID, lockedUntil,
+			topic,
+			domain.OutboxStatusPending, domain.OutboxStatusProcessing, now,
+			domain.OutboxStatusProcessing, now,
+			domain.OutboxStatusPending, now,
+			limit,
+		).Scan(&events).Error
+	})
+	if err != nil {
+		logger.Errorf("Failed to claim pending outbox events for topic %s: %v", topic, err)
+		return nil, fmt.Errorf("failed to claim pending outbox events for topic %s: %w", topic, err)
+	}
+
+	// RETURNING은 순서를 보장하지 않으므로 생성 순서로 정렬
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].CreatedAt.Equal(events[j].CreatedAt) {
+			return events[i].ID.String() < events[j].ID.String()
+		}
+		return events[i].CreatedAt.Before(events[j].CreatedAt)
+	})
+
+	return events, nil
+}
+
+// ReleaseClaimedEvents returns leased events to pending without counting an attempt
+// 임대한 이벤트를 시도 횟수 변경 없이 pending으로 되돌림
+func (r *outboxRepository) ReleaseClaimedEvents(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if err := r.db.WithContext(ctx).
+		Model(&domain.OutboxEvent{}).
+		Where("id IN ? AND status = ?", ids, domain.OutboxStatusProcessing).
+		Updates(map[string]interface{}{
+			"status":       domain.OutboxStatusPending,
+			"locked_by":    "",
+			"locked_until": nil,
+		}).Error; err != nil {
+		logger.Errorf("Failed to release claimed outbox events: %v", err)
+		return fmt.Errorf("failed to release claimed outbox events: %w", err)
+	}
+
+	return nil
+}
+
+// ScheduleRetry returns a failed event to pending with an incremented retry count and the next attempt time
+// 실패한 이벤트의 재시도 횟수를 증가시키고 다음 시도 시각과 함께 pending으로 되돌림
+func (r *outboxRepository) ScheduleRetry(ctx context.Context, id string, nextAttemptAt time.Time, errorMsg string) error {
+	if err := r.db.WithContext(ctx).
+		Model(&domain.OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":          domain.OutboxStatusPending,
+			"retry_count":     gorm.Expr("retry_count + 1"),
+			"last_error":      errorMsg,
+			"next_attempt_at": nextAttemptAt,
+			"locked_by":       "",
+			"locked_until":    nil,
+		}).Error; err != nil {
+		logger.Errorf("Failed to schedule outbox event retry: %v", err)
+		return fmt.Errorf("failed to schedule outbox event retry: %w", err)
+	}
+
+	return nil
+}
+
+// RequeueFailedEvents returns failed events to pending with a reset retry count (all failed events when ids is empty)
+// 실패한 이벤트를 재시도 횟수를 초기화하여 pending으로 되돌림 (ids가 비어 있으면 전체)
+func (r *outboxRepository) RequeueFailedEvents(ctx context.Context, ids []string) (int64, error) {
+	query := r.db.WithContext(ctx).
+		Model(&domain.OutboxEvent{}).
+		Where("status = ?", domain.OutboxStatusFailed)
+	if len(ids) > 0 {
+		query = query.Where("id IN ?", ids)
+	}
+
+	result := query.Updates(map[string]interface{}{
+		"status":          domain.OutboxStatusPending,
+		"retry_count":     0,
+		"next_attempt_at": nil,
+		"locked_by":       "",
+		"locked_until":    nil,
+	})
+	if result.Error != nil {
+		logger.Errorf("Failed to requeue failed outbox events: %v", result.Error)
+		return 0, fmt.Errorf("failed to requeue failed outbox events: %w", result.Error)
+	}
+
+	if result.RowsAffected > 0 {
+		if err := r.db.WithContext(ctx).Exec("SELECT pg_notify(?, ?)", domain.OutboxNotifyChannel, "requeue").Error; err != nil {
+			logger.Warnf("Failed to notify requeued outbox events: %v", err)
+		}
+	}
+
+	return result.RowsAffected, nil
+}
+
+// GetStats summarizes events waiting to be published
+// 발행 대기 중인 이벤트 요약 조회
+func (r *outboxRepository) GetStats(ctx context.Context) (*domain.OutboxStats, error) {
+	var row struct {
+		Pending         int64
+		Processing      int64
+		Failed          int64
+		OldestPendingAt *time.Time
+	}
+	if err := r.db.WithContext(ctx).
+		Model(&domain.OutboxEvent{}).
+		Select(`count(*) FILTER (WHERE status = ?) AS pending,
+			count(*) FILTER (WHERE status = ?) AS processing,
+			count(*) FILTER (WHERE status = ?) AS failed,
+			min(created_at) FILTER (WHERE status IN (?, ?)) AS oldest_pending_at`,
+			domain.OutboxStatusPending, domain.OutboxStatusProcessing, domain.OutboxStatusFailed,
+			domain.OutboxStatusPending, domain.OutboxStatusProcessing).
+		Scan(&row).Error; err != nil {
+		logger.Errorf("Failed to get outbox stats: %v", err)
+		return nil, fmt.Errorf("failed to get outbox stats: %w", err)
+	}
+
+	stats := &domain.OutboxStats{
+		Pending:         row.Pending,
+		Processing:      row.Processing,
+		Failed:          row.Failed,
+		OldestPendingAt: row.OldestPendingAt,
+	}
+	if row.OldestPendingAt != nil {
+		stats.LagSeconds = time.Since(*row.OldestPendingAt).Seconds()
+	}
+
+	return stats, nil
+}
+
+// ListenForEvents signals whenever an event is enqueued; the channel is closed when listening stops
+// 이벤트가 추가될 때마다 알리며, 수신이 중단되면 채널을 닫음
+func (r *outboxRepository) ListenForEvents(ctx context.Context) (<-chan struct{}, error) {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database handle: %w", err)
+	}
+
+	// LISTEN은 연결 단위이므로 풀에서 전용 연결을 하나 점유
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire connection for LISTEN: %w", err)
+	}
+
+	notifications := make(chan struct{}, 1)
+	ready := make(chan error, 1)
+
+	go func() {
+		defer close(notifications)
+		defer conn.Close()
+
+		err := conn.Raw(func(driverConn any) error {
+			stdConn, ok := driverConn.(*stdlib.Conn)
+			if !ok {
+				ready <- fmt.Errorf("LISTEN requires the pgx driver, got %T", driverConn)
+				return nil
+			}
+			pgConn := stdConn.Conn()
+
+			if _, err := pgConn.Exec(ctx, "LISTEN "+domain.OutboxNotifyChannel); err != nil {
+				ready <- fmt.Errorf("failed to LISTEN on %s: %w", domain.OutboxNotifyChannel, err)
+				return driver.ErrBadConn
+			}
+			ready <- nil
+
+			for {
+				if _, err := pgConn.WaitForNotification(ctx); err != nil {
+					if ctx.Err() == nil {
+						logger.Warnf("Stopped listening for outbox events: %v", err)
+					}
+					// LISTEN 상태의 연결이 풀로 돌아가지 않도록 폐기
+					return driver.ErrBadConn
+				}
+				select {
+				case notifications <- struct{}{}:
+				default:
+					// 이미 깨울 신호가 대기 중이면 합침
+				}
+			}
+		})
+		if err != nil {
+			// 연결을 얻지 못해 콜백이 실행되지 않은 경우에도 대기 중인 호출자를 깨움
+			select {
+			case ready <- err:
+			default:
+			}
+		}
+	}()
+
+	if err := <-ready; err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
 // GetPendingEvents retrieves pending events up to the specified limit
 // 지정된 제한까지 대기 중인 이벤트를 조회
 func (r *outboxRepository) GetPendingEvents(ctx context.Context, limit int) ([]*domain.OutboxEvent, error) {
 	var events []*domain.OutboxEvent
 
+	// Audit sink events are delivered by the audit sink worker, not published to NATS
+	// 감사 로그 싱크 이벤트는 NATS가 아닌 감사 싱크 워커가 전달
 	query := r.db.WithContext(ctx).
 		Where("status = ?", domain.OutboxStatusPending).
+		Where("topic NOT LIKE ?", domain.AuditSinkTopicPrefix+"%").
 		Order("created_at ASC")
 
 	if limit > 0 {
@@ -72,6 +375,21 @@ func (r *outboxRepository) GetPendingEvents(ctx context.Context, limit int) ([]*
 	return events, nil
 }
 
+// CountPendingByTopic counts undelivered (pending or leased) events of a single topic
+// 단일 토픽의 전달되지 않은(대기 또는 임대 중) 이벤트 수를 조회
+func (r *outboxRepository) CountPendingByTopic(ctx context.Context, topic string) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).
+		Model(&domain.OutboxEvent{}).
+		Where("topic = ? AND status IN ?", topic, []domain.OutboxEventStatus{domain.OutboxStatusPending, domain.OutboxStatusProcessing}).
+		Count(&count).Error; err != nil {
+		logger.Errorf("Failed to count pending outbox events for topic %s: %v", topic, err)
+		return 0, fmt.Errorf("failed to count pending outbox events for topic %s: %w", topic, err)
+	}
+
+	return count, nil
+}
+
 // UpdateStatus updates the status of an outbox event
 // Outbox 이벤트의 상태를 업데이트
 func (r *outboxRepository) UpdateStatus(ctx context.Context, id string, status domain.OutboxEventStatus, errorMsg *string) error {
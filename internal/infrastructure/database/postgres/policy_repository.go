@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"skyclust/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"skyclust/pkg/logger"
+)
+
+// workspacePolicyRepository: domain.WorkspacePolicyRepository 인터페이스 구현체
+type workspacePolicyRepository struct {
+	db *gorm.DB
+}
+
+// NewWorkspacePolicyRepository: 새로운 워크스페이스 정책 저장소를 생성합니다
+func NewWorkspacePolicyRepository(db *gorm.DB) domain.WorkspacePolicyRepository {
+	return &workspacePolicyRepository{db: db}
+}
+
+// Create: 워크스페이스 정책을 생성합니다
+func (r *workspacePolicyRepository) Create(ctx context.Context, policy *domain.WorkspacePolicy) error {
+	if err := GetTransaction(ctx, r.db).Create(policy).Error; err != nil {
+		logger.Errorf("Failed to create workspace policy: %v", err)
+		return fmt.Errorf("failed to create workspace policy: %w", err)
+	}
+	return nil
+}
+
+// GetByID: ID로 워크스페이스 정책을 조회합니다
+func (r *workspacePolicyRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.WorkspacePolicy, error) {
+	var policy domain.WorkspacePolicy
+	if err := GetTransaction(ctx, r.db).Where("id = ?", id).First(&policy).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		logger.Errorf("Failed to get workspace policy by ID: %v", err)
+		return nil, fmt.Errorf("failed to get workspace policy: %w", err)
+	}
+	return &policy, nil
+}
+
+// ListByWorkspace: 워크스페이스의 모든 정책을 조회합니다
+func (r *workspacePolicyRepository) ListByWorkspace(ctx context.Context, workspaceID string) ([]*domain.WorkspacePolicy, error) {
+	var policies []*domain.WorkspacePolicy
+	err := GetTransaction(ctx, r.db).
+		Where("workspace_id = ?", workspaceID).
+		Order("created_at ASC").
+		Find(&policies).Error
+	if err != nil {
+		logger.Errorf("Failed to list workspace policies: %v", err)
+		return nil, fmt.Errorf("failed to list workspace policies: %w", err)
+	}
+	return policies, nil
+}
+
+// ListEnabledByWorkspace: 워크스페이스의 활성화된 정책을 조회합니다
+func (r *workspacePolicyRepository) ListEnabledByWorkspace(ctx context.Context, workspaceID string) ([]*domain.WorkspacePolicy, error) {
+	var policies []*domain.WorkspacePolicy
+	err := GetTransaction(ctx, r.db).
+		Where("workspace_id = ? AND enabled = ?", workspaceID, true).
+		Order("created_at ASC").
+		Find(&policies).Error
+	if err != nil {
+		logger.Errorf("Failed to list enabled workspace policies: %v", err)
+		return nil, fmt.Errorf("failed to list enabled workspace policies: %w", err)
+	}
+	return policies, nil
+}
+
+// Update: 워크스페이스 정책을 업데이트합니다
+func (r *workspacePolicyRepository) Update(ctx context.Context, policy *domain.WorkspacePolicy) error {
+	if err := GetTransaction(ctx, r.db).Save(policy).Error; err != nil {
+		logger.Errorf("Failed to update workspace policy: %v", err)
+		return fmt.Errorf("failed to update workspace policy: %w", err)
+	}
+	return nil
+}
+
+// Delete: 워크스페이스 정책을 삭제합니다
+func (r *workspacePolicyRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := GetTransaction(ctx, r.db).Where("id = ?", id).Delete(&domain.WorkspacePolicy{})
+	if result.Error != nil {
+		logger.Errorf("Failed to delete workspace policy: %v", result.Error)
+		return fmt.Errorf("failed to delete workspace policy: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
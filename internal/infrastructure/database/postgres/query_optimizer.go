@@ -233,39 +233,6 @@ func (q *OptimizedAuditLogQueries) GetAuditLogsByDateRangeOptimized(startDate, e
 	return logs, total, nil
 }
 
-// BatchInsertAuditLogs inserts multiple audit logs in a single transaction
-func (q *OptimizedAuditLogQueries) BatchInsertAuditLogs(logs []*domain.AuditLog) error {
-	if len(logs) == 0 {
-		return nil
-	}
-
-	// Use batch insert for better performance
-	return q.db.Transaction(func(tx *gorm.DB) error {
-		// Split into batches of 1000 for optimal performance
-		batchSize := 1000
-		for i := 0; i < len(logs); i += batchSize {
-			end := i + batchSize
-			if end > len(logs) {
-				end = len(logs)
-			}
-
-			if err := tx.CreateInBatches(logs[i:end], batchSize).Error; err != nil {
-				return fmt.Errorf("failed to batch insert audit logs: %w", err)
-			}
-		}
-		return nil
-	})
-}
-
-// CleanupOldAuditLogs removes old audit logs to maintain performance
-func (q *OptimizedAuditLogQueries) CleanupOldAuditLogs(olderThan time.Time) (int64, error) {
-	result := q.db.Where("created_at < ?", olderThan).Delete(&domain.AuditLog{})
-	if result.Error != nil {
-		return 0, fmt.Errorf("failed to cleanup old audit logs: %w", result.Error)
-	}
-	return result.RowsAffected, nil
-}
-
 // QueryBuilder provides a fluent query builder
 type QueryBuilder struct {
 	query *gorm.DB
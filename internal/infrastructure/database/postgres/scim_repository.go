@@ -0,0 +1,424 @@
+package postgres
+
+import (
+	"fmt"
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// scimColumnKind: SCIM 속성이 매핑되는 컬럼의 비교 방식
+type scimColumnKind int
+
+const (
+	scimColumnText scimColumnKind = iota
+	scimColumnCaseInsensitive
+	scimColumnBool
+	scimColumnTime
+	scimColumnMembers
+)
+
+// scimColumn: SCIM 필터 속성과 SQL 표현식의 매핑
+type scimColumn struct {
+	expr string
+	kind scimColumnKind
+}
+
+// scimUserColumns: SCIM User 필터 속성 → users 테이블 컬럼
+var scimUserColumns = map[string]scimColumn{
+	"id":                {expr: "id::text", kind: scimColumnText},
+	"username":          {expr: "username", kind: scimColumnCaseInsensitive},
+	"displayname":       {expr: "username", kind: scimColumnCaseInsensitive},
+	"externalid":        {expr: "external_id", kind: scimColumnText},
+	"emails":            {expr: "email", kind: scimColumnCaseInsensitive},
+	"emails.value":      {expr: "email", kind: scimColumnCaseInsensitive},
+	"active":            {expr: "active", kind: scimColumnBool},
+	"meta.created":      {expr: "created_at", kind: scimColumnTime},
+	"meta.lastmodified": {expr: "updated_at", kind: scimColumnTime},
+}
+
+// scimGroupColumns: SCIM Group 필터 속성 → scim_groups 테이블 컬럼
+var scimGroupColumns = map[string]scimColumn{
+	"id":                {expr: "scim_groups.id::text", kind: scimColumnText},
+	"displayname":       {expr: "scim_groups.display_name", kind: scimColumnCaseInsensitive},
+	"externalid":        {expr: "scim_groups.external_id", kind: scimColumnText},
+	"members":           {kind: scimColumnMembers},
+	"members.value":     {kind: scimColumnMembers},
+	"meta.created":      {expr: "scim_groups.created_at", kind: scimColumnTime},
+	"meta.lastmodified": {expr: "scim_groups.updated_at", kind: scimColumnTime},
+}
+
+// scimRepository: domain.SCIMRepository 인터페이스 구현체
+type scimRepository struct {
+	db *gorm.DB
+}
+
+// NewSCIMRepository: 새로운 SCIMRepository를 생성합니다
+func NewSCIMRepository(db *gorm.DB) domain.SCIMRepository {
+	return &scimRepository{
+		db: db,
+	}
+}
+
+// CreateToken: 새로운 SCIM 토큰을 생성합니다
+func (r *scimRepository) CreateToken(token *domain.SCIMToken) error {
+	if err := r.db.Create(token).Error; err != nil {
+		logger.Errorf("Failed to create SCIM token: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetTokenByHash: 토큰 해시로 SCIM 토큰을 조회합니다
+func (r *scimRepository) GetTokenByHash(hash string) (*domain.SCIMToken, error) {
+	var token domain.SCIMToken
+	if err := r.db.Where("token_hash = ?", hash).First(&token).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		logger.Errorf("Failed to get SCIM token: %v", err)
+		return nil, err
+	}
+	return &token, nil
+}
+
+// ListTokens: 모든 SCIM 토큰을 조회합니다
+func (r *scimRepository) ListTokens() ([]*domain.SCIMToken, error) {
+	var tokens []*domain.SCIMToken
+	if err := r.db.Order("created_at DESC").Find(&tokens).Error; err != nil {
+		logger.Errorf("Failed to list SCIM tokens: %v", err)
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// DeleteToken: SCIM 토큰을 삭제합니다
+func (r *scimRepository) DeleteToken(id uuid.UUID) error {
+	result := r.db.Where("id = ?", id).Delete(&domain.SCIMToken{})
+	if result.Error != nil {
+		logger.Errorf("Failed to delete SCIM token: %v", result.Error)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// TouchToken: SCIM 토큰의 마지막 사용 시각을 갱신합니다
+func (r *scimRepository) TouchToken(id uuid.UUID, usedAt time.Time) error {
+	if err := r.db.Model(&domain.SCIMToken{}).Where("id = ?", id).Update("last_used_at", usedAt).Error; err != nil {
+		logger.Errorf("Failed to update SCIM token usage: %v", err)
+		return err
+	}
+	return nil
+}
+
+// SearchUsers: SCIM 필터로 사용자를 검색합니다
+func (r *scimRepository) SearchUsers(filter *domain.SCIMFilter, offset, limit int) ([]*domain.User, int64, error) {
+	query := r.db.Model(&domain.User{})
+	if filter != nil {
+		where, args, err := buildSCIMWhere(filter, scimUserColumns)
+		if err != nil {
+			return nil, 0, domain.NewSCIMError(domain.SCIMErrorInvalidFilter, err.Error(), 400)
+		}
+		query = query.Where(where, args...)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		logger.Errorf("Failed to count SCIM users: %v", err)
+		return nil, 0, err
+	}
+
+	var users []*domain.User
+	if limit > 0 {
+		if err := query.Order("created_at ASC, id ASC").Offset(offset).Limit(limit).Find(&users).Error; err != nil {
+			logger.Errorf("Failed to search SCIM users: %v", err)
+			return nil, 0, err
+		}
+	}
+	return users, total, nil
+}
+
+// CreateGroup: 새로운 SCIM 그룹을 생성합니다 (멤버는 ReplaceGroupMembers로 관리)
+func (r *scimRepository) CreateGroup(group *domain.SCIMGroup) error {
+	if err := r.db.Omit("Members").Create(group).Error; err != nil {
+		logger.Errorf("Failed to create SCIM group: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetGroupByID: ID로 SCIM 그룹과 멤버를 조회합니다
+func (r *scimRepository) GetGroupByID(id uuid.UUID) (*domain.SCIMGroup, error) {
+	var group domain.SCIMGroup
+	if err := r.db.Preload("Members.User").Where("id = ?", id).First(&group).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		logger.Errorf("Failed to get SCIM group by ID: %v", err)
+		return nil, err
+	}
+	return &group, nil
+}
+
+// GetGroupByDisplayName: 표시 이름으로 SCIM 그룹을 조회합니다
+func (r *scimRepository) GetGroupByDisplayName(displayName string) (*domain.SCIMGroup, error) {
+	var group domain.SCIMGroup
+	if err := r.db.Where("LOWER(display_name) = LOWER(?)", displayName).First(&group).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		logger.Errorf("Failed to get SCIM group by display name: %v", err)
+		return nil, err
+	}
+	return &group, nil
+}
+
+// SearchGroups: SCIM 필터로 그룹을 검색합니다
+func (r *scimRepository) SearchGroups(filter *domain.SCIMFilter, offset, limit int) ([]*domain.SCIMGroup, int64, error) {
+	query := r.db.Model(&domain.SCIMGroup{})
+	if filter != nil {
+		where, args, err := buildSCIMWhere(filter, scimGroupColumns)
+		if err != nil {
+			return nil, 0, domain.NewSCIMError(domain.SCIMErrorInvalidFilter, err.Error(), 400)
+		}
+		query = query.Where(where, args...)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		logger.Errorf("Failed to count SCIM groups: %v", err)
+		return nil, 0, err
+	}
+
+	var groups []*domain.SCIMGroup
+	if limit > 0 {
+		if err := query.Preload("Members.User").
+			Order("scim_groups.created_at ASC, scim_groups.id ASC").
+			Offset(offset).Limit(limit).
+			Find(&groups).Error; err != nil {
+			logger.Errorf("Failed to search SCIM groups: %v", err)
+			return nil, 0, err
+		}
+	}
+	return groups, total, nil
+}
+
+// UpdateGroup: SCIM 그룹 정보를 업데이트합니다 (멤버는 별도 메서드로 관리)
+func (r *scimRepository) UpdateGroup(group *domain.SCIMGroup) error {
+	if err := r.db.Omit("Members").Save(group).Error; err != nil {
+		logger.Errorf("Failed to update SCIM group: %v", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteGroup: SCIM 그룹과 멤버십을 삭제합니다
+func (r *scimRepository) DeleteGroup(id uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("group_id = ?", id).Delete(&domain.SCIMGroupMember{}).Error; err != nil {
+			logger.Errorf("Failed to delete SCIM group members: %v", err)
+			return err
+		}
+		if err := tx.Where("id = ?", id).Delete(&domain.SCIMGroup{}).Error; err != nil {
+			logger.Errorf("Failed to delete SCIM group: %v", err)
+			return err
+		}
+		return nil
+	})
+}
+
+// ReplaceGroupMembers: 그룹 멤버를 전달된 사용자 목록으로 교체합니다
+func (r *scimRepository) ReplaceGroupMembers(groupID uuid.UUID, userIDs []uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("group_id = ?", groupID).Delete(&domain.SCIMGroupMember{}).Error; err != nil {
+			logger.Errorf("Failed to clear SCIM group members: %v", err)
+			return err
+		}
+		return r.insertGroupMembers(tx, groupID, userIDs)
+	})
+}
+
+// AddGroupMembers: 그룹에 사용자를 추가합니다 (이미 멤버인 사용자는 무시)
+func (r *scimRepository) AddGroupMembers(groupID uuid.UUID, userIDs []uuid.UUID) error {
+	return r.insertGroupMembers(r.db, groupID, userIDs)
+}
+
+// RemoveGroupMembers: 그룹에서 사용자를 제거합니다
+func (r *scimRepository) RemoveGroupMembers(groupID uuid.UUID, userIDs []uuid.UUID) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	if err := r.db.Where("group_id = ? AND user_id IN ?", groupID, userIDs).Delete(&domain.SCIMGroupMember{}).Error; err != nil {
+		logger.Errorf("Failed to remove SCIM group members: %v", err)
+		return err
+	}
+	return nil
+}
+
+// ListGroupsByUser: 사용자가 속한 SCIM 그룹을 조회합니다
+func (r *scimRepository) ListGroupsByUser(userID uuid.UUID) ([]*domain.SCIMGroup, error) {
+	var groups []*domain.SCIMGroup
+	if err := r.db.Joins("JOIN scim_group_members ON scim_group_members.group_id = scim_groups.id").
+		Where("scim_group_members.user_id = ?", userID).
+		Order("scim_groups.display_name ASC").
+		Find(&groups).Error; err != nil {
+		logger.Errorf("Failed to list SCIM groups by user: %v", err)
+		return nil, err
+	}
+	return groups, nil
+}
+
+// ListBoundGroups: 워크스페이스에 연결된 SCIM 그룹과 멤버를 조회합니다
+func (r *scimRepository) ListBoundGroups() ([]*domain.SCIMGroup, error) {
+	var groups []*domain.SCIMGroup
+	if err := r.db.Preload("Members").
+		Where("workspace_id IS NOT NULL AND workspace_role <> ''").
+		Find(&groups).Error; err != nil {
+		logger.Errorf("Failed to list bound SCIM groups: %v", err)
+		return nil, err
+	}
+	return groups, nil
+}
+
+// insertGroupMembers: 그룹 멤버십을 중복 없이 추가합니다
+func (r *scimRepository) insertGroupMembers(tx *gorm.DB, groupID uuid.UUID, userIDs []uuid.UUID) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	members := make([]domain.SCIMGroupMember, 0, len(userIDs))
+	for _, userID := range userIDs {
+		members = append(members, domain.SCIMGroupMember{GroupID: groupID, UserID: userID})
+	}
+	if err := tx.Omit("User").Clauses(clause.OnConflict{DoNothing: true}).Create(&members).Error; err != nil {
+		logger.Errorf("Failed to add SCIM group members: %v", err)
+		return err
+	}
+	return nil
+}
+
+// buildSCIMWhere: SCIM 필터를 SQL WHERE 절과 인자로 변환합니다
+func buildSCIMWhere(filter *domain.SCIMFilter, columns map[string]scimColumn) (string, []interface{}, error) {
+	switch filter.Operator {
+	case "and", "or":
+		var clauses []string
+		var args []interface{}
+		for _, child := range filter.Children {
+			where, childArgs, err := buildSCIMWhere(child, columns)
+			if err != nil {
+				return "", nil, err
+			}
+			clauses = append(clauses, "("+where+")")
+			args = append(args, childArgs...)
+		}
+		return strings.Join(clauses, " "+strings.ToUpper(filter.Operator)+" "), args, nil
+	case "not":
+		if len(filter.Children) != 1 {
+			return "", nil, fmt.Errorf("not requires exactly one expression")
+		}
+		where, args, err := buildSCIMWhere(filter.Children[0], columns)
+		if err != nil {
+			return "", nil, err
+		}
+		return "NOT (" + where + ")", args, nil
+	}
+
+	column, ok := columns[filter.Attribute]
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported filter attribute: %s", filter.Attribute)
+	}
+
+	if column.kind == scimColumnMembers {
+		value, ok := filter.Value.(string)
+		if filter.Operator != "eq" || !ok {
+			return "", nil, fmt.Errorf("members only supports eq with a string value")
+		}
+		return "EXISTS (SELECT 1 FROM scim_group_members WHERE scim_group_members.group_id = scim_groups.id AND scim_group_members.user_id::text = ?)", []interface{}{value}, nil
+	}
+
+	if filter.Operator == "pr" {
+		if column.kind == scimColumnText || column.kind == scimColumnCaseInsensitive {
+			return fmt.Sprintf("(%s IS NOT NULL AND %s <> '')", column.expr, column.expr), nil, nil
+		}
+		return column.expr + " IS NOT NULL", nil, nil
+	}
+
+	switch column.kind {
+	case scimColumnBool:
+		value, ok := filter.Value.(bool)
+		if !ok {
+			return "", nil, fmt.Errorf("%s requires a boolean value", filter.Attribute)
+		}
+		switch filter.Operator {
+		case "eq":
+			return column.expr + " = ?", []interface{}{value}, nil
+		case "ne":
+			return column.expr + " <> ?", []interface{}{value}, nil
+		}
+		return "", nil, fmt.Errorf("operator %s is not supported for %s", filter.Operator, filter.Attribute)
+	case scimColumnTime:
+		raw, ok := filter.Value.(string)
+		if !ok {
+			return "", nil, fmt.Errorf("%s requires a date-time value", filter.Attribute)
+		}
+		value, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return "", nil, fmt.Errorf("%s requires an RFC 3339 date-time value", filter.Attribute)
+		}
+		operator, ok := scimComparisonOperators[filter.Operator]
+		if !ok {
+			return "", nil, fmt.Errorf("operator %s is not supported for %s", filter.Operator, filter.Attribute)
+		}
+		return column.expr + " " + operator + " ?", []interface{}{value}, nil
+	}
+
+	value, ok := filter.Value.(string)
+	if !ok {
+		return "", nil, fmt.Errorf("%s requires a string value", filter.Attribute)
+	}
+	expr := column.expr
+	like := "LIKE"
+	if column.kind == scimColumnCaseInsensitive {
+		expr = "LOWER(" + column.expr + ")"
+		value = strings.ToLower(value)
+		like = "ILIKE"
+	}
+
+	switch filter.Operator {
+	case "co":
+		return column.expr + " " + like + " ?", []interface{}{"%" + escapeLike(value) + "%"}, nil
+	case "sw":
+		return column.expr + " " + like + " ?", []interface{}{escapeLike(value) + "%"}, nil
+	case "ew":
+		return column.expr + " " + like + " ?", []interface{}{"%" + escapeLike(value)}, nil
+	}
+
+	operator, ok := scimComparisonOperators[filter.Operator]
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported filter operator: %s", filter.Operator)
+	}
+	return expr + " " + operator + " ?", []interface{}{value}, nil
+}
+
+// scimComparisonOperators: SCIM 비교 연산자 → SQL 연산자
+var scimComparisonOperators = map[string]string{
+	"eq": "=",
+	"ne": "<>",
+	"gt": ">",
+	"ge": ">=",
+	"lt": "<",
+	"le": "<=",
+}
+
+// escapeLike: LIKE 패턴의 와일드카드 문자를 이스케이프합니다
+func escapeLike(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(value)
+}
@@ -0,0 +1,226 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"fmt"
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+	"skyclust/pkg/security"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ssoProviderRepository: domain.SSOProviderRepository 인터페이스 구현체
+type ssoProviderRepository struct {
+	db        *gorm.DB
+	encryptor security.Encryptor
+}
+
+// NewSSOProviderRepository: 새로운 SSOProviderRepository를 생성합니다
+func NewSSOProviderRepository(db *gorm.DB, encryptor security.Encryptor) domain.SSOProviderRepository {
+	return &ssoProviderRepository{
+		db:        db,
+		encryptor: encryptor,
+	}
+}
+
+// Create: 새로운 SSO 프로바이더를 생성합니다
+func (r *ssoProviderRepository) Create(provider *domain.SSOProvider) error {
+	plainSecret, plainKey := provider.ClientSecret, provider.SAMLSPPrivateKey
+	if err := r.encryptSecrets(provider); err != nil {
+		return err
+	}
+	// Restore plain text secrets on the caller's struct after saving
+	defer func() { provider.ClientSecret, provider.SAMLSPPrivateKey = plainSecret, plainKey }()
+
+	if err := r.db.Create(provider).Error; err != nil {
+		logger.Errorf("Failed to create SSO provider: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetByID: ID로 SSO 프로바이더와 그룹 매핑을 조회합니다
+func (r *ssoProviderRepository) GetByID(id uuid.UUID) (*domain.SSOProvider, error) {
+	var provider domain.SSOProvider
+	if err := r.db.Preload("GroupMappings").Where("id = ?", id).First(&provider).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		logger.Errorf("Failed to get SSO provider by ID: %v", err)
+		return nil, err
+	}
+
+	if err := r.decryptProvider(&provider); err != nil {
+		return nil, err
+	}
+	return &provider, nil
+}
+
+// GetByName: 이름으로 SSO 프로바이더를 조회합니다
+func (r *ssoProviderRepository) GetByName(name string) (*domain.SSOProvider, error) {
+	var provider domain.SSOProvider
+	if err := r.db.Where("name = ?", name).First(&provider).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		logger.Errorf("Failed to get SSO provider by name: %v", err)
+		return nil, err
+	}
+
+	if err := r.decryptProvider(&provider); err != nil {
+		return nil, err
+	}
+	return &provider, nil
+}
+
+// List: 모든 SSO 프로바이더를 조회합니다
+func (r *ssoProviderRepository) List() ([]*domain.SSOProvider, error) {
+	var providers []*domain.SSOProvider
+	if err := r.db.Preload("GroupMappings").
+		Order("created_at DESC").
+		Find(&providers).Error; err != nil {
+		logger.Errorf("Failed to list SSO providers: %v", err)
+		return nil, err
+	}
+
+	for _, provider := range providers {
+		if err := r.decryptProvider(provider); err != nil {
+			return nil, err
+		}
+	}
+	return providers, nil
+}
+
+// ListEnabled: 활성화된 SSO 프로바이더를 조회합니다
+func (r *ssoProviderRepository) ListEnabled() ([]*domain.SSOProvider, error) {
+	var providers []*domain.SSOProvider
+	if err := r.db.Where("enabled = true").
+		Order("created_at DESC").
+		Find(&providers).Error; err != nil {
+		logger.Errorf("Failed to list enabled SSO providers: %v", err)
+		return nil, err
+	}
+
+	for _, provider := range providers {
+		if err := r.decryptProvider(provider); err != nil {
+			return nil, err
+		}
+	}
+	return providers, nil
+}
+
+// Update: SSO 프로바이더 정보를 업데이트합니다 (그룹 매핑은 ReplaceGroupMappings로 관리)
+func (r *ssoProviderRepository) Update(provider *domain.SSOProvider) error {
+	plainSecret, plainKey := provider.ClientSecret, provider.SAMLSPPrivateKey
+	if err := r.encryptSecrets(provider); err != nil {
+		return err
+	}
+	defer func() { provider.ClientSecret, provider.SAMLSPPrivateKey = plainSecret, plainKey }()
+
+	if err := r.db.Omit("GroupMappings").Save(provider).Error; err != nil {
+		logger.Errorf("Failed to update SSO provider: %v", err)
+		return err
+	}
+	return nil
+}
+
+// Delete: SSO 프로바이더와 그룹 매핑을 영구 삭제합니다
+func (r *ssoProviderRepository) Delete(id uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("provider_id = ?", id).Delete(&domain.SSOGroupMapping{}).Error; err != nil {
+			logger.Errorf("Failed to delete SSO group mappings: %v", err)
+			return err
+		}
+		if err := tx.Where("id = ?", id).Delete(&domain.SSOProvider{}).Error; err != nil {
+			logger.Errorf("Failed to delete SSO provider: %v", err)
+			return err
+		}
+		return nil
+	})
+}
+
+// ReplaceGroupMappings: 프로바이더의 그룹 매핑을 전달된 목록으로 교체합니다
+func (r *ssoProviderRepository) ReplaceGroupMappings(providerID uuid.UUID, mappings []domain.SSOGroupMapping) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("provider_id = ?", providerID).Delete(&domain.SSOGroupMapping{}).Error; err != nil {
+			logger.Errorf("Failed to clear SSO group mappings: %v", err)
+			return err
+		}
+		if len(mappings) == 0 {
+			return nil
+		}
+		for i := range mappings {
+			mappings[i].ID = uuid.Nil
+			mappings[i].ProviderID = providerID
+		}
+		if err := tx.Create(&mappings).Error; err != nil {
+			logger.Errorf("Failed to create SSO group mappings: %v", err)
+			return err
+		}
+		return nil
+	})
+}
+
+// encryptSecrets: 저장 전에 클라이언트 시크릿과 SAML SP 개인키를 암호화합니다
+func (r *ssoProviderRepository) encryptSecrets(provider *domain.SSOProvider) error {
+	encryptedSecret, err := r.encrypt(provider.ClientSecret)
+	if err != nil {
+		logger.Errorf("Failed to encrypt client secret: %v", err)
+		return fmt.Errorf("failed to encrypt client secret: %w", err)
+	}
+	encryptedKey, err := r.encrypt(provider.SAMLSPPrivateKey)
+	if err != nil {
+		logger.Errorf("Failed to encrypt SAML SP private key: %v", err)
+		return fmt.Errorf("failed to encrypt SAML SP private key: %w", err)
+	}
+	provider.ClientSecret = encryptedSecret
+	provider.SAMLSPPrivateKey = encryptedKey
+	return nil
+}
+
+// decryptProvider: 조회한 프로바이더의 클라이언트 시크릿과 SAML SP 개인키를 복호화합니다
+func (r *ssoProviderRepository) decryptProvider(provider *domain.SSOProvider) error {
+	secret, err := r.decrypt(provider.ClientSecret)
+	if err != nil {
+		logger.Errorf("Failed to decrypt client secret for SSO provider %s: %v", provider.ID, err)
+		return fmt.Errorf("failed to decrypt client secret: %w", err)
+	}
+	key, err := r.decrypt(provider.SAMLSPPrivateKey)
+	if err != nil {
+		logger.Errorf("Failed to decrypt SAML SP private key for SSO provider %s: %v", provider.ID, err)
+		return fmt.Errorf("failed to decrypt SAML SP private key: %w", err)
+	}
+	provider.ClientSecret = secret
+	provider.SAMLSPPrivateKey = key
+	return nil
+}
+
+// encrypt: 값을 암호화하여 base64 문자열로 반환합니다
+func (r *ssoProviderRepository) encrypt(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	encrypted, err := r.encryptor.Encrypt([]byte(value))
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// decrypt: base64로 인코딩된 암호문을 복호화합니다
+func (r *ssoProviderRepository) decrypt(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	encryptedBytes, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode base64: %w", err)
+	}
+	decrypted, err := r.encryptor.Decrypt(encryptedBytes)
+	if err != nil {
+		return "", err
+	}
+	return string(decrypted), nil
+}
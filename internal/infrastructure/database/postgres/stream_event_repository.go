@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"time"
+
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+
+	"gorm.io/gorm"
+)
+
+// streamEventRepository: domain.StreamEventRepository 인터페이스 구현체
+type streamEventRepository struct {
+	db *gorm.DB
+}
+
+// NewStreamEventRepository: 새로운 StreamEventRepository를 생성합니다
+func NewStreamEventRepository(db *gorm.DB) domain.StreamEventRepository {
+	return &streamEventRepository{db: db}
+}
+
+// Append: 이벤트를 기록하고 ID를 할당합니다
+func (r *streamEventRepository) Append(event *domain.StreamEvent) error {
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+	if err := r.db.Create(event).Error; err != nil {
+		logger.Errorf("Failed to append stream event: %v", err)
+		return err
+	}
+	return nil
+}
+
+// ListAfter: ID 이후의 이벤트를 ID 순으로 조회합니다
+func (r *streamEventRepository) ListAfter(afterID int64, limit int) ([]*domain.StreamEvent, error) {
+	var events []*domain.StreamEvent
+	if err := r.db.Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&events).Error; err != nil {
+		logger.Errorf("Failed to list stream events: %v", err)
+		return nil, err
+	}
+	return events, nil
+}
+
+// ListHistory: 리소스의 최근 이벤트를 ID 순으로 조회합니다
+func (r *streamEventRepository) ListHistory(filter domain.StreamEventHistoryFilter) ([]*domain.StreamEvent, error) {
+	query := r.db.Where("credential_id = ?", filter.CredentialID)
+	if filter.ResourceType != "" {
+		query = query.Where("resource_type = ?", filter.ResourceType)
+	}
+	if filter.ResourceID != "" {
+		query = query.Where("resource_id = ?", filter.ResourceID)
+	}
+
+	var events []*domain.StreamEvent
+	if err := query.Order("id DESC").Limit(filter.Limit).Find(&events).Error; err != nil {
+		logger.Errorf("Failed to list stream event history: %v", err)
+		return nil, err
+	}
+
+	// 최근 N개를 오래된 순서로 반환
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+	return events, nil
+}
+
+// GetOldestID: 남아 있는 가장 오래된 이벤트 ID를 반환합니다 (없으면 0)
+func (r *streamEventRepository) GetOldestID() (int64, error) {
+	var oldest int64
+	if err := r.db.Model(&domain.StreamEvent{}).
+		Select("coalesce(min(id), 0)").
+		Scan(&oldest).Error; err != nil {
+		logger.Errorf("Failed to get oldest stream event: %v", err)
+		return 0, err
+	}
+	return oldest, nil
+}
+
+// Trim: 최근 keep개를 넘거나 before 이전에 기록된 이벤트를 삭제합니다
+func (r *streamEventRepository) Trim(keep int, before time.Time) (int64, error) {
+	result := r.db.Where("created_at < ? OR id <= (SELECT coalesce(max(id), 0) - ? FROM stream_events)", before, keep).
+		Delete(&domain.StreamEvent{})
+	if result.Error != nil {
+		logger.Errorf("Failed to trim stream events: %v", result.Error)
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,312 @@
+package postgres
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"skyclust/internal/domain"
+	"skyclust/pkg/logger"
+	"skyclust/pkg/security"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// webhookRepository: domain.WebhookRepository 인터페이스 구현체
+type webhookRepository struct {
+	db        *gorm.DB
+	encryptor security.Encryptor
+}
+
+// NewWebhookRepository: 새로운 웹훅 저장소를 생성합니다
+func NewWebhookRepository(db *gorm.DB, encryptor security.Encryptor) domain.WebhookRepository {
+	return &webhookRepository{
+		db:        db,
+		encryptor: encryptor,
+	}
+}
+
+// CreateSubscription: 서명 비밀키를 암호화하여 웹훅 구독을 생성합니다
+func (r *webhookRepository) CreateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	plainSecret := subscription.Secret
+	encrypted, err := r.encrypt(plainSecret)
+	if err != nil {
+		logger.Errorf("Failed to encrypt webhook secret: %v", err)
+		return fmt.Errorf("failed to encrypt webhook secret: %w", err)
+	}
+	subscription.Secret = encrypted
+	// Restore the plain text secret on the caller's struct after saving
+	defer func() { subscription.Secret = plainSecret }()
+
+	if err := GetTransaction(ctx, r.db).Create(subscription).Error; err != nil {
+		logger.Errorf("Failed to create webhook subscription: %v", err)
+		return fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+	return nil
+}
+
+// GetSubscription: ID로 웹훅 구독을 조회합니다
+func (r *webhookRepository) GetSubscription(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error) {
+	var subscription domain.WebhookSubscription
+	if err := GetTransaction(ctx, r.db).Where("id = ?", id).First(&subscription).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		logger.Errorf("Failed to get webhook subscription by ID: %v", err)
+		return nil, fmt.Errorf("failed to get webhook subscription: %w", err)
+	}
+	if err := r.decryptSecret(&subscription); err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+// ListSubscriptions: 워크스페이스의 모든 웹훅 구독을 조회합니다
+func (r *webhookRepository) ListSubscriptions(ctx context.Context, workspaceID string) ([]*domain.WebhookSubscription, error) {
+	var subscriptions []*domain.WebhookSubscription
+	err := GetTransaction(ctx, r.db).
+		Where("workspace_id = ?", workspaceID).
+		Order("created_at ASC").
+		Find(&subscriptions).Error
+	if err != nil {
+		logger.Errorf("Failed to list webhook subscriptions: %v", err)
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+	return subscriptions, nil
+}
+
+// ListEnabledSubscriptions: 워크스페이스의 활성화된 웹훅 구독을 비밀키와 함께 조회합니다
+func (r *webhookRepository) ListEnabledSubscriptions(ctx context.Context, workspaceID string) ([]*domain.WebhookSubscription, error) {
+	var subscriptions []*domain.WebhookSubscription
+	err := GetTransaction(ctx, r.db).
+		Where("workspace_id = ? AND enabled = ?", workspaceID, true).
+		Order("created_at ASC").
+		Find(&subscriptions).Error
+	if err != nil {
+		logger.Errorf("Failed to list enabled webhook subscriptions: %v", err)
+		return nil, fmt.Errorf("failed to list enabled webhook subscriptions: %w", err)
+	}
+	for _, subscription := range subscriptions {
+		if err := r.decryptSecret(subscription); err != nil {
+			return nil, err
+		}
+	}
+	return subscriptions, nil
+}
+
+// UpdateSubscription: 웹훅 구독 설정을 업데이트합니다 (전송 통계는 RecordAttempt가 갱신)
+func (r *webhookRepository) UpdateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	encrypted, err := r.encrypt(subscription.Secret)
+	if err != nil {
+		logger.Errorf("Failed to encrypt webhook secret: %v", err)
+		return fmt.Errorf("failed to encrypt webhook secret: %w", err)
+	}
+
+	err = GetTransaction(ctx, r.db).
+		Model(&domain.WebhookSubscription{}).
+		Where("id = ?", subscription.ID).
+		Updates(map[string]interface{}{
+			"name":                 subscription.Name,
+			"url":                  subscription.URL,
+			"event_types":          subscription.EventTypes,
+			"secret":               encrypted,
+			"enabled":              subscription.Enabled,
+			"disabled_reason":      subscription.DisabledReason,
+			"disabled_at":          subscription.DisabledAt,
+			"consecutive_failures": subscription.ConsecutiveFailures,
+			"failing_since":        subscription.FailingSince,
+			"updated_at":           time.Now(),
+		}).Error
+	if err != nil {
+		logger.Errorf("Failed to update webhook subscription: %v", err)
+		return fmt.Errorf("failed to update webhook subscription: %w", err)
+	}
+	return nil
+}
+
+// DeleteSubscription: 웹훅 구독과 전송 기록을 삭제합니다
+func (r *webhookRepository) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	return GetTransaction(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("subscription_id = ?", id).Delete(&domain.WebhookDelivery{}).Error; err != nil {
+			logger.Errorf("Failed to delete webhook deliveries: %v", err)
+			return fmt.Errorf("failed to delete webhook deliveries: %w", err)
+		}
+		result := tx.Where("id = ?", id).Delete(&domain.WebhookSubscription{})
+		if result.Error != nil {
+			logger.Errorf("Failed to delete webhook subscription: %v", result.Error)
+			return fmt.Errorf("failed to delete webhook subscription: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// RecordAttempt: 행 잠금 후 전송 시도 결과를 구독의 연속 실패 상태에 반영합니다
+func (r *webhookRepository) RecordAttempt(ctx context.Context, id uuid.UUID, succeeded bool, at time.Time) (*domain.WebhookSubscription, bool, error) {
+	var subscription domain.WebhookSubscription
+	var disabled bool
+	err := GetTransaction(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&subscription).Error; err != nil {
+			return err
+		}
+		disabled = subscription.RecordAttempt(succeeded, at)
+		return tx.Model(&domain.WebhookSubscription{}).
+			Where("id = ?", id).
+			Updates(map[string]interface{}{
+				"enabled":              subscription.Enabled,
+				"disabled_reason":      subscription.DisabledReason,
+				"disabled_at":          subscription.DisabledAt,
+				"consecutive_failures": subscription.ConsecutiveFailures,
+				"failing_since":        subscription.FailingSince,
+				"last_delivery_at":     subscription.LastDeliveryAt,
+				"last_success_at":      subscription.LastSuccessAt,
+			}).Error
+	})
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			// 전송 중 구독이 삭제됨
+			return nil, false, nil
+		}
+		logger.Errorf("Failed to record webhook delivery attempt: %v", err)
+		return nil, false, fmt.Errorf("failed to record webhook delivery attempt: %w", err)
+	}
+	subscription.Secret = ""
+	return &subscription, disabled, nil
+}
+
+// CreateDeliveries: 전송 기록을 일괄 생성합니다
+func (r *webhookRepository) CreateDeliveries(ctx context.Context, deliveries []*domain.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	if err := GetTransaction(ctx, r.db).Create(&deliveries).Error; err != nil {
+		logger.Errorf("Failed to create webhook deliveries: %v", err)
+		return fmt.Errorf("failed to create webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// GetDelivery: ID로 전송 기록을 조회합니다
+func (r *webhookRepository) GetDelivery(ctx context.Context, id uuid.UUID) (*domain.WebhookDelivery, error) {
+	var delivery domain.WebhookDelivery
+	if err := GetTransaction(ctx, r.db).Where("id = ?", id).First(&delivery).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		logger.Errorf("Failed to get webhook delivery by ID: %v", err)
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+	return &delivery, nil
+}
+
+// ListDeliveries: 구독의 전송 기록을 최신순으로 조회합니다
+func (r *webhookRepository) ListDeliveries(ctx context.Context, subscriptionID uuid.UUID, filter domain.WebhookDeliveryFilter) ([]*domain.WebhookDelivery, int64, error) {
+	query := GetTransaction(ctx, r.db).Model(&domain.WebhookDelivery{}).Where("subscription_id = ?", subscriptionID)
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		logger.Errorf("Failed to count webhook deliveries: %v", err)
+		return nil, 0, fmt.Errorf("failed to count webhook deliveries: %w", err)
+	}
+
+	var deliveries []*domain.WebhookDelivery
+	if err := query.Order("created_at DESC").Limit(filter.Limit).Offset(filter.Offset).Find(&deliveries).Error; err != nil {
+		logger.Errorf("Failed to list webhook deliveries: %v", err)
+		return nil, 0, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	return deliveries, total, nil
+}
+
+// ClaimDueDeliveries: 재시도 시각이 된 대기 중 전송을 임대합니다
+// 다음 시도 시각을 임대 기간만큼 미루므로 워커가 중단되어도 임대가 끝나면 다시 전송됩니다
+func (r *webhookRepository) ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*domain.WebhookDelivery, error) {
+	now := time.Now()
+
+	var deliveries []*domain.WebhookDelivery
+	if err := r.db.WithContext(ctx).Raw(`
+		UPDATE webhook_deliveries SET next_attempt_at = ?, updated_at = ?
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = ? AND next_attempt_at <= ?
+			ORDER BY next_attempt_at ASC
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now.Add(lease), now,
+		domain.WebhookDeliveryPending, now,
+		limit,
+	).Scan(&deliveries).Error; err != nil {
+		logger.Errorf("Failed to claim due webhook deliveries: %v", err)
+		return nil, fmt.Errorf("failed to claim due webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// UpdateDelivery: 전송 시도 결과를 저장합니다
+func (r *webhookRepository) UpdateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	if err := GetTransaction(ctx, r.db).Save(delivery).Error; err != nil {
+		logger.Errorf("Failed to update webhook delivery: %v", err)
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// DeleteDeliveriesBefore: 보존 기간이 지난 완료된 전송 기록을 삭제합니다
+func (r *webhookRepository) DeleteDeliveriesBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := GetTransaction(ctx, r.db).
+		Where("status <> ? AND created_at < ?", domain.WebhookDeliveryPending, cutoff).
+		Delete(&domain.WebhookDelivery{})
+	if result.Error != nil {
+		logger.Errorf("Failed to delete old webhook deliveries: %v", result.Error)
+		return 0, fmt.Errorf("failed to delete old webhook deliveries: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// decryptSecret: 구독의 서명 비밀키를 복호화합니다
+func (r *webhookRepository) decryptSecret(subscription *domain.WebhookSubscription) error {
+	secret, err := r.decrypt(subscription.Secret)
+	if err != nil {
+		logger.Errorf("Failed to decrypt webhook secret: %v", err)
+		return fmt.Errorf("failed to decrypt webhook secret: %w", err)
+	}
+	subscription.Secret = secret
+	return nil
+}
+
+// encrypt: 값을 암호화하여 base64 문자열로 반환합니다
+func (r *webhookRepository) encrypt(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	encrypted, err := r.encryptor.Encrypt([]byte(value))
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// decrypt: base64로 인코딩된 암호문을 복호화합니다
+func (r *webhookRepository) decrypt(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	encryptedBytes, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode base64: %w", err)
+	}
+	decrypted, err := r.encryptor.Decrypt(encryptedBytes)
+	if err != nil {
+		return "", err
+	}
+	return string(decrypted), nil
+}
@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"skyclust/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"skyclust/pkg/logger"
+)
+
+// workspaceRoleRepository: domain.WorkspaceRoleRepository 인터페이스 구현체
+type workspaceRoleRepository struct {
+	db *gorm.DB
+}
+
+// NewWorkspaceRoleRepository: 새로운 워크스페이스 역할 저장소를 생성합니다
+func NewWorkspaceRoleRepository(db *gorm.DB) domain.WorkspaceRoleRepository {
+	return &workspaceRoleRepository{db: db}
+}
+
+// Create: 사용자 정의 워크스페이스 역할을 생성합니다
+func (r *workspaceRoleRepository) Create(ctx context.Context, role *domain.WorkspaceRole) error {
+	if err := GetTransaction(ctx, r.db).Create(role).Error; err != nil {
+		logger.Errorf("Failed to create workspace role: %v", err)
+		return fmt.Errorf("failed to create workspace role: %w", err)
+	}
+	return nil
+}
+
+// GetByID: ID로 사용자 정의 워크스페이스 역할을 조회합니다
+func (r *workspaceRoleRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.WorkspaceRole, error) {
+	var role domain.WorkspaceRole
+	if err := GetTransaction(ctx, r.db).Where("id = ?", id).First(&role).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		logger.Errorf("Failed to get workspace role by ID: %v", err)
+		return nil, fmt.Errorf("failed to get workspace role: %w", err)
+	}
+	return &role, nil
+}
+
+// GetByName: 워크스페이스 내 이름으로 사용자 정의 역할을 조회합니다
+func (r *workspaceRoleRepository) GetByName(ctx context.Context, workspaceID, name string) (*domain.WorkspaceRole, error) {
+	var role domain.WorkspaceRole
+	err := GetTransaction(ctx, r.db).
+		Where("workspace_id = ? AND name = ?", workspaceID, name).
+		First(&role).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		logger.Errorf("Failed to get workspace role by name: %v", err)
+		return nil, fmt.Errorf("failed to get workspace role: %w", err)
+	}
+	return &role, nil
+}
+
+// ListByWorkspace: 워크스페이스의 사용자 정의 역할 목록을 조회합니다
+func (r *workspaceRoleRepository) ListByWorkspace(ctx context.Context, workspaceID string) ([]*domain.WorkspaceRole, error) {
+	var roles []*domain.WorkspaceRole
+	err := GetTransaction(ctx, r.db).
+		Where("workspace_id = ?", workspaceID).
+		Order("name ASC").
+		Find(&roles).Error
+	if err != nil {
+		logger.Errorf("Failed to list workspace roles: %v", err)
+		return nil, fmt.Errorf("failed to list workspace roles: %w", err)
+	}
+	return roles, nil
+}
+
+// Update: 사용자 정의 워크스페이스 역할을 업데이트합니다
+func (r *workspaceRoleRepository) Update(ctx context.Context, role *domain.WorkspaceRole) error {
+	if err := GetTransaction(ctx, r.db).Save(role).Error; err != nil {
+		logger.Errorf("Failed to update workspace role: %v", err)
+		return fmt.Errorf("failed to update workspace role: %w", err)
+	}
+	return nil
+}
+
+// Delete: 사용자 정의 워크스페이스 역할을 삭제합니다
+func (r *workspaceRoleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := GetTransaction(ctx, r.db).Where("id = ?", id).Delete(&domain.WorkspaceRole{})
+	if result.Error != nil {
+		logger.Errorf("Failed to delete workspace role: %v", result.Error)
+		return fmt.Errorf("failed to delete workspace role: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetMemberRole: 워크스페이스에서 사용자의 역할을 조회합니다 (멤버가 아니면 빈 문자열)
+func (r *workspaceRoleRepository) GetMemberRole(ctx context.Context, workspaceID, userID string) (string, error) {
+	var member domain.WorkspaceUser
+	err := GetTransaction(ctx, r.db).
+		Where("workspace_id = ? AND user_id = ?", workspaceID, userID).
+		First(&member).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return "", nil
+		}
+		logger.Errorf("Failed to get workspace member role: %v", err)
+		return "", fmt.Errorf("failed to get workspace member role: %w", err)
+	}
+	return member.Role, nil
+}
+
+// CountMembersWithRole: 워크스페이스에서 지정한 역할을 가진 멤버 수를 조회합니다
+func (r *workspaceRoleRepository) CountMembersWithRole(ctx context.Context, workspaceID, role string) (int64, error) {
+	var count int64
+	err := GetTransaction(ctx, r.db).
+		Model(&domain.WorkspaceUser{}).
+		Where("workspace_id = ? AND role = ?", workspaceID, role).
+		Count(&count).Error
+	if err != nil {
+		logger.Errorf("Failed to count workspace members with role: %v", err)
+		return 0, fmt.Errorf("failed to count workspace members: %w", err)
+	}
+	return count, nil
+}
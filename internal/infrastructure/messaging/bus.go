@@ -2,7 +2,10 @@ package messaging
 
 import (
 	"context"
+	"strings"
 	"sync"
+
+	"skyclust/internal/domain"
 )
 
 // Bus defines the interface for event publishing
@@ -14,6 +17,13 @@ type Bus interface {
 	Health(ctx context.Context) error
 }
 
+// DurableBus is a Bus that delivers events to named durable consumers
+// Each durable consumer receives every event once across all instances, and failed events are redelivered
+type DurableBus interface {
+	Bus
+	SubscribeDurable(durable, eventType string, handler EventHandler) error
+}
+
 // EventHandler defines the interface for event handlers
 type EventHandler interface {
 	Handle(ctx context.Context, event Event) error
@@ -29,8 +39,10 @@ type Event struct {
 }
 
 // LocalBus implements a local event bus
+// Event types containing NATS wildcards ('*' for one token, '>' for the rest) subscribe to every matching type
 type LocalBus struct {
 	handlers map[string][]EventHandler
+	patterns map[string][]EventHandler
 	mu       sync.RWMutex
 }
 
@@ -38,13 +50,19 @@ type LocalBus struct {
 func NewLocalBus() *LocalBus {
 	return &LocalBus{
 		handlers: make(map[string][]EventHandler),
+		patterns: make(map[string][]EventHandler),
 	}
 }
 
 // Publish publishes an event
 func (b *LocalBus) Publish(ctx context.Context, event Event) error {
 	b.mu.RLock()
-	handlers := b.handlers[event.Type]
+	handlers := append([]EventHandler(nil), b.handlers[event.Type]...)
+	for pattern, patternHandlers := range b.patterns {
+		if domain.MatchEventPattern(pattern, event.Type) {
+			handlers = append(handlers, patternHandlers...)
+		}
+	}
 	b.mu.RUnlock()
 
 	for _, handler := range handlers {
@@ -65,6 +83,10 @@ func (b *LocalBus) Subscribe(eventType string, handler EventHandler) error {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
+	if strings.ContainsAny(eventType, "*>") {
+		b.patterns[eventType] = append(b.patterns[eventType], handler)
+		return nil
+	}
 	b.handlers[eventType] = append(b.handlers[eventType], handler)
 	return nil
 }
@@ -0,0 +1,188 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"skyclust/pkg/logger"
+	"strconv"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// ErrDeadLetterNotFound is returned when a dead-lettered event does not exist
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// DeadLetter represents an event that a durable consumer failed to process
+type DeadLetter struct {
+	Sequence       uint64          `json:"sequence"`
+	Subject        string          `json:"subject"`
+	Consumer       string          `json:"consumer"`
+	Error          string          `json:"error"`
+	Deliveries     uint64          `json:"deliveries"`
+	StreamSequence uint64          `json:"stream_sequence"`
+	FailedAt       time.Time       `json:"failed_at"`
+	Data           json.RawMessage `json:"data"`
+}
+
+// DeadLetterFilter filters dead-lettered events
+type DeadLetterFilter struct {
+	Consumer      string
+	AfterSequence uint64
+	Limit         int
+}
+
+// DeadLetterQueue defines the interface for inspecting, replaying and purging dead-lettered events
+type DeadLetterQueue interface {
+	ListDeadLetters(ctx context.Context, filter DeadLetterFilter) ([]*DeadLetter, error)
+	GetDeadLetter(ctx context.Context, sequence uint64) (*DeadLetter, error)
+	ReplayDeadLetter(ctx context.Context, sequence uint64) error
+	DeleteDeadLetter(ctx context.Context, sequence uint64) error
+	PurgeDeadLetters(ctx context.Context, consumer string) error
+}
+
+// ListDeadLetters lists dead-lettered events in sequence order (implements DeadLetterQueue interface)
+func (n *NATSService) ListDeadLetters(ctx context.Context, filter DeadLetterFilter) ([]*DeadLetter, error) {
+	if n.deadLetters == nil {
+		return nil, ErrJetStreamDisabled
+	}
+
+	subject := DeadLetterSubjectPrefix + ">"
+	if filter.Consumer != "" {
+		subject = DeadLetterSubjectPrefix + consumerName(filter.Consumer)
+	}
+
+	deadLetters := make([]*DeadLetter, 0, filter.Limit)
+	sequence := filter.AfterSequence + 1
+	for len(deadLetters) < filter.Limit {
+		// 삭제된 시퀀스는 건너뛰고 subject가 일치하는 다음 메시지를 조회
+		raw, err := n.deadLetters.GetMsg(ctx, sequence, jetstream.WithGetMsgSubject(subject))
+		if errors.Is(err, jetstream.ErrMsgNotFound) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list dead letters: %w", err)
+		}
+		deadLetters = append(deadLetters, n.toDeadLetter(raw))
+		sequence = raw.Sequence + 1
+	}
+	return deadLetters, nil
+}
+
+// GetDeadLetter returns a dead-lettered event, or nil if it does not exist (implements DeadLetterQueue interface)
+func (n *NATSService) GetDeadLetter(ctx context.Context, sequence uint64) (*DeadLetter, error) {
+	raw, err := n.getDeadLetterMsg(ctx, sequence)
+	if err != nil {
+		if errors.Is(err, ErrDeadLetterNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return n.toDeadLetter(raw), nil
+}
+
+// ReplayDeadLetter republishes a dead-lettered event to its original subject for the consumer that failed it and removes it from the queue (implements DeadLetterQueue interface)
+func (n *NATSService) ReplayDeadLetter(ctx context.Context, sequence uint64) error {
+	raw, err := n.getDeadLetterMsg(ctx, sequence)
+	if err != nil {
+		return err
+	}
+
+	subject := raw.Header.Get(HeaderOriginalSubject)
+	if subject == "" {
+		return fmt.Errorf("dead letter %d has no original subject", sequence)
+	}
+
+	msg := nats.NewMsg(subject)
+	msg.Data = raw.Data
+	if consumer := raw.Header.Get(HeaderConsumer); consumer != "" {
+		msg.Header.Set(HeaderReplayConsumer, consumer)
+	}
+	if _, err := n.js.PublishMsg(ctx, msg); err != nil {
+		return fmt.Errorf("failed to replay dead letter %d: %w", sequence, err)
+	}
+
+	if err := n.deadLetters.DeleteMsg(ctx, sequence); err != nil {
+		// 이미 재전송되었으므로 삭제 실패는 로그만 남김 (다시 재처리하면 중복 전달됨)
+		logger.Warn(fmt.Sprintf("Replayed dead letter %d but failed to remove it: %v", sequence, err))
+	}
+	logger.Info(fmt.Sprintf("Replayed dead letter %d to %s", sequence, subject))
+	return nil
+}
+
+// DeleteDeadLetter removes a single dead-lettered event (implements DeadLetterQueue interface)
+func (n *NATSService) DeleteDeadLetter(ctx context.Context, sequence uint64) error {
+	if n.deadLetters == nil {
+		return ErrJetStreamDisabled
+	}
+	if err := n.deadLetters.DeleteMsg(ctx, sequence); err != nil {
+		if errors.Is(err, jetstream.ErrMsgNotFound) {
+			return ErrDeadLetterNotFound
+		}
+		return fmt.Errorf("failed to delete dead letter %d: %w", sequence, err)
+	}
+	return nil
+}
+
+// PurgeDeadLetters removes all dead-lettered events, or only those of a consumer (implements DeadLetterQueue interface)
+func (n *NATSService) PurgeDeadLetters(ctx context.Context, consumer string) error {
+	if n.deadLetters == nil {
+		return ErrJetStreamDisabled
+	}
+
+	var opts []jetstream.StreamPurgeOpt
+	if consumer != "" {
+		opts = append(opts, jetstream.WithPurgeSubject(DeadLetterSubjectPrefix+consumerName(consumer)))
+	}
+	if err := n.deadLetters.Purge(ctx, opts...); err != nil {
+		return fmt.Errorf("failed to purge dead letters: %w", err)
+	}
+	logger.Info(fmt.Sprintf("Purged dead letters (consumer: %q)", consumer))
+	return nil
+}
+
+// getDeadLetterMsg loads a raw dead-letter message by sequence
+func (n *NATSService) getDeadLetterMsg(ctx context.Context, sequence uint64) (*jetstream.RawStreamMsg, error) {
+	if n.deadLetters == nil {
+		return nil, ErrJetStreamDisabled
+	}
+	raw, err := n.deadLetters.GetMsg(ctx, sequence)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrMsgNotFound) {
+			return nil, ErrDeadLetterNotFound
+		}
+		return nil, fmt.Errorf("failed to get dead letter %d: %w", sequence, err)
+	}
+	return raw, nil
+}
+
+// toDeadLetter converts a raw dead-letter message, decompressing its payload
+func (n *NATSService) toDeadLetter(raw *jetstream.RawStreamMsg) *DeadLetter {
+	deadLetter := &DeadLetter{
+		Sequence: raw.Sequence,
+		Subject:  raw.Header.Get(HeaderOriginalSubject),
+		Consumer: raw.Header.Get(HeaderConsumer),
+		Error:    raw.Header.Get(HeaderError),
+		FailedAt: raw.Time,
+	}
+	deadLetter.Deliveries, _ = strconv.ParseUint(raw.Header.Get(HeaderDeliveries), 10, 64)
+	deadLetter.StreamSequence, _ = strconv.ParseUint(raw.Header.Get(HeaderStreamSequence), 10, 64)
+	if failedAt, err := time.Parse(time.RFC3339, raw.Header.Get(HeaderFailedAt)); err == nil {
+		deadLetter.FailedAt = failedAt
+	}
+
+	data, err := n.decompressMessage(raw.Data)
+	if err != nil {
+		data = raw.Data
+	}
+	if json.Valid(data) {
+		deadLetter.Data = data
+	} else {
+		// JSON이 아닌 페이로드는 문자열로 반환
+		deadLetter.Data, _ = json.Marshal(string(data))
+	}
+	return deadLetter
+}
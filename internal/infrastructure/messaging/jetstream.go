@@ -0,0 +1,391 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"skyclust/pkg/logger"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// JetStream stream and subject names
+const (
+	EventStreamName         = "CMP_EVENTS"
+	EventSubjectPrefix      = "cmp.events."
+	DeadLetterStreamName    = "CMP_EVENTS_DLQ"
+	DeadLetterSubjectPrefix = "cmp.dlq."
+
+	// maxDeliveriesAdvisorySubject is emitted by the server when a message was never acked within MaxDeliver attempts
+	maxDeliveriesAdvisorySubject = "$JS.EVENT.ADVISORY.CONSUMER.MAX_DELIVERIES." + EventStreamName + ".*"
+	maxDeliveriesAdvisoryQueue   = "cmp-dead-letter"
+)
+
+// JetStream defaults
+const (
+	DefaultJetStreamMaxAge     = 24 * time.Hour
+	DefaultJetStreamMaxDeliver = 5
+	DefaultJetStreamAckWait    = 30 * time.Second
+	DefaultDeadLetterMaxAge    = 7 * 24 * time.Hour
+	jetStreamDuplicateWindow   = 2 * time.Minute
+	jetStreamSetupTimeout      = 10 * time.Second
+)
+
+// DefaultJetStreamBackoff is the redelivery delay per failed attempt (the last value repeats)
+var DefaultJetStreamBackoff = []time.Duration{time.Second, 5 * time.Second, 30 * time.Second, 2 * time.Minute}
+
+// Dead-letter message headers
+const (
+	HeaderOriginalSubject = "Cmp-Original-Subject"
+	HeaderConsumer        = "Cmp-Consumer"
+	HeaderError           = "Cmp-Error"
+	HeaderDeliveries      = "Cmp-Deliveries"
+	HeaderStreamSequence  = "Cmp-Stream-Sequence"
+	HeaderFailedAt        = "Cmp-Failed-At"
+	// HeaderReplayConsumer limits a replayed message to the consumer that dead-lettered it
+	HeaderReplayConsumer = "Cmp-Replay-Consumer"
+)
+
+// ErrJetStreamDisabled is returned by dead-letter operations when JetStream is not enabled
+var ErrJetStreamDisabled = errors.New("jetstream is not enabled")
+
+// JetStreamConfig holds JetStream stream, consumer and dead-letter configuration
+type JetStreamConfig struct {
+	Enabled          bool
+	MaxAge           time.Duration   // Event stream retention
+	MaxDeliver       int             // Deliveries before an event is dead-lettered
+	AckWait          time.Duration   // Redelivery timeout when a consumer crashes before acking
+	Backoff          []time.Duration // Redelivery delay per failed attempt
+	DeadLetterMaxAge time.Duration
+}
+
+// permanentError marks a handler failure that must not be retried
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps a handler error so the message is dead-lettered without further redelivery
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether a handler error was marked with Permanent
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+	return errors.As(err, &permanent)
+}
+
+// maxDeliveriesAdvisory is the payload of a MAX_DELIVERIES consumer advisory
+type maxDeliveriesAdvisory struct {
+	Stream     string `json:"stream"`
+	Consumer   string `json:"consumer"`
+	StreamSeq  uint64 `json:"stream_seq"`
+	Deliveries uint64 `json:"deliveries"`
+}
+
+// applyJetStreamDefaults fills unset JetStream options
+func applyJetStreamDefaults(config *JetStreamConfig) {
+	if config.MaxAge <= 0 {
+		config.MaxAge = DefaultJetStreamMaxAge
+	}
+	if config.MaxDeliver <= 0 {
+		config.MaxDeliver = DefaultJetStreamMaxDeliver
+	}
+	if config.AckWait <= 0 {
+		config.AckWait = DefaultJetStreamAckWait
+	}
+	if len(config.Backoff) == 0 {
+		config.Backoff = DefaultJetStreamBackoff
+	}
+	if config.DeadLetterMaxAge <= 0 {
+		config.DeadLetterMaxAge = DefaultDeadLetterMaxAge
+	}
+}
+
+// setupJetStream creates (or updates) the event and dead-letter streams and watches for exhausted deliveries
+func (n *NATSService) setupJetStream() error {
+	applyJetStreamDefaults(&n.config.JetStream)
+
+	js, err := jetstream.New(n.conn)
+	if err != nil {
+		return fmt.Errorf("failed to create JetStream context: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), jetStreamSetupTimeout)
+	defer cancel()
+
+	if _, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:       EventStreamName,
+		Subjects:   []string{EventSubjectPrefix + ">"},
+		Retention:  jetstream.LimitsPolicy,
+		Storage:    jetstream.FileStorage,
+		MaxAge:     n.config.JetStream.MaxAge,
+		Duplicates: jetStreamDuplicateWindow,
+	}); err != nil {
+		return fmt.Errorf("failed to create stream %s: %w", EventStreamName, err)
+	}
+
+	deadLetters, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:      DeadLetterStreamName,
+		Subjects:  []string{DeadLetterSubjectPrefix + ">"},
+		Retention: jetstream.LimitsPolicy,
+		Storage:   jetstream.FileStorage,
+		MaxAge:    n.config.JetStream.DeadLetterMaxAge,
+		// 같은 메시지가 핸들러와 advisory 양쪽에서 중복 기록되지 않도록 Nats-Msg-Id로 중복 제거
+		Duplicates: jetStreamDuplicateWindow,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create stream %s: %w", DeadLetterStreamName, err)
+	}
+
+	// 핸들러가 ack 전에 죽어 MaxDeliver를 모두 소진한 메시지도 dead-letter 스트림으로 옮김
+	if _, err := n.conn.QueueSubscribe(maxDeliveriesAdvisorySubject, maxDeliveriesAdvisoryQueue, n.handleMaxDeliveriesAdvisory); err != nil {
+		return fmt.Errorf("failed to subscribe to max deliveries advisories: %w", err)
+	}
+
+	n.js = js
+	n.deadLetters = deadLetters
+
+	logger.Info(fmt.Sprintf("JetStream enabled (stream: %s, max deliver: %d, dead letters: %s)",
+		EventStreamName, n.config.JetStream.MaxDeliver, DeadLetterStreamName))
+	return nil
+}
+
+// JetStreamEnabled reports whether events are persisted and delivered through JetStream
+func (n *NATSService) JetStreamEnabled() bool {
+	return n.js != nil
+}
+
+// SubscribeDurable delivers every event of a type once to the named durable consumer (implements DurableBus interface)
+// Without JetStream the consumer falls back to a queue subscription with no redelivery
+func (n *NATSService) SubscribeDurable(durable, eventType string, handler EventHandler) error {
+	subject := EventSubjectPrefix + eventType
+	handle := func(data []byte) error {
+		var event Event
+		if err := json.Unmarshal(data, &event); err != nil {
+			return Permanent(fmt.Errorf("failed to unmarshal event: %w", err))
+		}
+		return handler.Handle(context.Background(), event)
+	}
+
+	if n.js == nil {
+		_, err := n.conn.QueueSubscribe(subject, consumerName(durable), func(msg *nats.Msg) {
+			decompressed, err := n.decompressMessage(msg.Data)
+			if err != nil {
+				logger.Errorf("Failed to decompress message: %v", err)
+				return
+			}
+			if err := handle(decompressed); err != nil {
+				logger.Errorf("Error processing event: %v", err)
+			}
+		})
+		return err
+	}
+
+	return n.consumeDurable(durable, subject, 1, handle)
+}
+
+// consumeDurable creates a durable pull consumer with explicit ack and processes its messages
+func (n *NATSService) consumeDurable(durable, subject string, concurrency int, handler func([]byte) error) error {
+	name := consumerName(durable)
+
+	ctx, cancel := context.WithTimeout(context.Background(), jetStreamSetupTimeout)
+	defer cancel()
+
+	consumer, err := n.js.CreateOrUpdateConsumer(ctx, EventStreamName, jetstream.ConsumerConfig{
+		Durable:       name,
+		FilterSubject: subject,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       n.config.JetStream.AckWait,
+		MaxDeliver:    n.config.JetStream.MaxDeliver,
+		DeliverPolicy: jetstream.DeliverNewPolicy,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create consumer %s on subject %s: %w", name, subject, err)
+	}
+
+	// 동시성 제어를 위한 세마포어 (가득 차면 더 가져오지 않음)
+	sem := make(chan struct{}, concurrency)
+	consumeCtx, err := consumer.Consume(func(msg jetstream.Msg) {
+		sem <- struct{}{}
+		go func() {
+			defer func() { <-sem }()
+			n.handleJetStreamMessage(name, msg, handler)
+		}()
+	}, jetstream.PullMaxMessages(2*concurrency))
+	if err != nil {
+		return fmt.Errorf("failed to consume %s on subject %s: %w", name, subject, err)
+	}
+
+	n.subsMux.Lock()
+	n.consumers[fmt.Sprintf("%s:%s", subject, name)] = consumeCtx
+	n.subsMux.Unlock()
+
+	logger.Info(fmt.Sprintf("Consuming subject %s with durable consumer %s", subject, name))
+	return nil
+}
+
+// handleJetStreamMessage runs the handler and acks, delays redelivery or dead-letters the message
+func (n *NATSService) handleJetStreamMessage(consumer string, msg jetstream.Msg, handler func([]byte) error) {
+	meta, err := msg.Metadata()
+	if err != nil {
+		logger.Errorf("Failed to read JetStream message metadata: %v", err)
+		_ = msg.Term()
+		return
+	}
+
+	// 재처리 요청된 메시지는 dead-letter로 보낸 consumer만 처리
+	if target := msg.Headers().Get(HeaderReplayConsumer); target != "" && target != consumer {
+		_ = msg.Ack()
+		return
+	}
+
+	decompressed, err := n.decompressMessage(msg.Data())
+	if err == nil {
+		err = handler(decompressed)
+	} else {
+		err = Permanent(fmt.Errorf("failed to decompress message: %w", err))
+	}
+	if err == nil {
+		if ackErr := msg.Ack(); ackErr != nil {
+			logger.Warn(fmt.Sprintf("Failed to ack message %d on %s: %v", meta.Sequence.Stream, consumer, ackErr))
+		}
+		return
+	}
+
+	if IsPermanent(err) || meta.NumDelivered >= uint64(n.config.JetStream.MaxDeliver) {
+		n.deadLetterMessage(consumer, msg, meta, err)
+		return
+	}
+
+	delay := n.redeliveryDelay(meta.NumDelivered)
+	logger.Warn(fmt.Sprintf("Error processing message %d on %s (delivery %d/%d), redelivering in %s: %v",
+		meta.Sequence.Stream, consumer, meta.NumDelivered, n.config.JetStream.MaxDeliver, delay, err))
+	if nakErr := msg.NakWithDelay(delay); nakErr != nil {
+		logger.Warn(fmt.Sprintf("Failed to nak message %d on %s: %v", meta.Sequence.Stream, consumer, nakErr))
+	}
+}
+
+// deadLetterMessage copies a failed message to the dead-letter stream and stops its redelivery
+func (n *NATSService) deadLetterMessage(consumer string, msg jetstream.Msg, meta *jetstream.MsgMetadata, cause error) {
+	ctx, cancel := context.WithTimeout(context.Background(), jetStreamSetupTimeout)
+	defer cancel()
+
+	dlq := newDeadLetterMsg(msg.Subject(), msg.Headers(), msg.Data(), consumer, meta.Sequence.Stream, meta.NumDelivered, cause.Error())
+	if err := n.publishDeadLetter(ctx, dlq, consumer, meta.Sequence.Stream); err != nil {
+		// 기록하지 못하면 종료하지 않고 재전달에 맡김 (MaxDeliver 소진 시 advisory에서 다시 시도)
+		logger.Errorf("Failed to dead-letter message %d on %s: %v", meta.Sequence.Stream, consumer, err)
+		_ = msg.NakWithDelay(n.redeliveryDelay(meta.NumDelivered))
+		return
+	}
+
+	if err := msg.TermWithReason(truncateReason(cause.Error())); err != nil {
+		logger.Warn(fmt.Sprintf("Failed to terminate message %d on %s: %v", meta.Sequence.Stream, consumer, err))
+	}
+	logger.Warn(fmt.Sprintf("Dead-lettered message %d on %s after %d deliveries: %v",
+		meta.Sequence.Stream, consumer, meta.NumDelivered, cause))
+}
+
+// handleMaxDeliveriesAdvisory copies a message whose deliveries were exhausted without an ack to the dead-letter stream
+func (n *NATSService) handleMaxDeliveriesAdvisory(msg *nats.Msg) {
+	if n.js == nil {
+		return
+	}
+
+	var advisory maxDeliveriesAdvisory
+	if err := json.Unmarshal(msg.Data, &advisory); err != nil {
+		logger.Errorf("Failed to unmarshal max deliveries advisory: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), jetStreamSetupTimeout)
+	defer cancel()
+
+	stream, err := n.js.Stream(ctx, advisory.Stream)
+	if err != nil {
+		logger.Errorf("Failed to open stream %s for max deliveries advisory: %v", advisory.Stream, err)
+		return
+	}
+	raw, err := stream.GetMsg(ctx, advisory.StreamSeq)
+	if err != nil {
+		logger.Errorf("Failed to load message %d for max deliveries advisory: %v", advisory.StreamSeq, err)
+		return
+	}
+
+	dlq := newDeadLetterMsg(raw.Subject, raw.Header, raw.Data, advisory.Consumer, advisory.StreamSeq, advisory.Deliveries,
+		"maximum deliveries exceeded without acknowledgement")
+	if err := n.publishDeadLetter(ctx, dlq, advisory.Consumer, advisory.StreamSeq); err != nil {
+		logger.Errorf("Failed to dead-letter message %d on %s: %v", advisory.StreamSeq, advisory.Consumer, err)
+		return
+	}
+	logger.Warn(fmt.Sprintf("Dead-lettered message %d on %s after %d unacknowledged deliveries",
+		advisory.StreamSeq, advisory.Consumer, advisory.Deliveries))
+}
+
+// publishDeadLetter writes to the dead-letter stream, deduplicated per consumer and stream sequence
+func (n *NATSService) publishDeadLetter(ctx context.Context, msg *nats.Msg, consumer string, streamSeq uint64) error {
+	_, err := n.js.PublishMsg(ctx, msg, jetstream.WithMsgID(fmt.Sprintf("%s:%s:%d", EventStreamName, consumer, streamSeq)))
+	return err
+}
+
+// redeliveryDelay returns the backoff before the next delivery attempt
+func (n *NATSService) redeliveryDelay(delivered uint64) time.Duration {
+	backoff := n.config.JetStream.Backoff
+	if len(backoff) == 0 {
+		return 0
+	}
+	index := int(delivered) - 1
+	if index < 0 {
+		index = 0
+	}
+	if index >= len(backoff) {
+		index = len(backoff) - 1
+	}
+	return backoff[index]
+}
+
+// newDeadLetterMsg builds a dead-letter message carrying the original payload and failure details
+func newDeadLetterMsg(subject string, header nats.Header, data []byte, consumer string, streamSeq, deliveries uint64, reason string) *nats.Msg {
+	msg := nats.NewMsg(DeadLetterSubjectPrefix + consumer)
+	for key, values := range header {
+		if key == HeaderReplayConsumer || key == nats.MsgIdHdr {
+			continue
+		}
+		for _, value := range values {
+			msg.Header.Add(key, value)
+		}
+	}
+	msg.Header.Set(HeaderOriginalSubject, subject)
+	msg.Header.Set(HeaderConsumer, consumer)
+	msg.Header.Set(HeaderError, truncateReason(reason))
+	msg.Header.Set(HeaderDeliveries, strconv.FormatUint(deliveries, 10))
+	msg.Header.Set(HeaderStreamSequence, strconv.FormatUint(streamSeq, 10))
+	msg.Header.Set(HeaderFailedAt, time.Now().UTC().Format(time.RFC3339))
+	msg.Data = data
+	return msg
+}
+
+// consumerName converts a durable name to a valid JetStream consumer name
+func consumerName(durable string) string {
+	return strings.NewReplacer(".", "_", "*", "_", ">", "_", " ", "_").Replace(durable)
+}
+
+// truncateReason keeps failure reasons short enough for message headers
+func truncateReason(reason string) string {
+	const maxReasonLength = 512
+	if len(reason) > maxReasonLength {
+		return reason[:maxReasonLength]
+	}
+	return reason
+}
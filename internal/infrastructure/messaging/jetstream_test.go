@@ -0,0 +1,224 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const testMaxDeliver = 3
+
+// handlerFunc adapts a function to EventHandler
+type handlerFunc func(ctx context.Context, event Event) error
+
+func (f handlerFunc) Handle(ctx context.Context, event Event) error { return f(ctx, event) }
+
+// startJetStreamServer runs an embedded NATS server with JetStream and connects a service to it
+func startJetStreamServer(t *testing.T) *NATSService {
+	t.Helper()
+
+	ns, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	if err != nil {
+		t.Fatalf("failed to create nats server: %v", err)
+	}
+	go ns.Start()
+	if !ns.ReadyForConnections(10 * time.Second) {
+		t.Fatal("nats server did not start")
+	}
+	t.Cleanup(ns.Shutdown)
+
+	service, err := NewNATSService(NATSConfig{
+		URL: ns.ClientURL(),
+		JetStream: JetStreamConfig{
+			Enabled:    true,
+			MaxDeliver: testMaxDeliver,
+			AckWait:    time.Second,
+			Backoff:    []time.Duration{10 * time.Millisecond},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create nats service: %v", err)
+	}
+	t.Cleanup(service.Close)
+	return service
+}
+
+// waitForDeadLetters polls the dead-letter stream until it holds count messages for the consumer
+func waitForDeadLetters(t *testing.T, service *NATSService, consumer string, count int) []*DeadLetter {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		deadLetters, err := service.ListDeadLetters(context.Background(), DeadLetterFilter{Consumer: consumer, Limit: 10})
+		if err != nil {
+			t.Fatalf("ListDeadLetters() error = %v", err)
+		}
+		if len(deadLetters) >= count {
+			return deadLetters
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d dead letters for %s, got %d", count, consumer, len(deadLetters))
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func TestSubscribeDurableDeadLettersAfterMaxDeliver(t *testing.T) {
+	service := startJetStreamServer(t)
+
+	var deliveries atomic.Int32
+	handler := handlerFunc(func(ctx context.Context, event Event) error {
+		deliveries.Add(1)
+		return errors.New("downstream unavailable")
+	})
+	if err := service.SubscribeDurable("test.failing", "test.created", handler); err != nil {
+		t.Fatalf("SubscribeDurable() error = %v", err)
+	}
+
+	if err := service.Publish(context.Background(), Event{Type: "test.created", Data: map[string]interface{}{"id": "1"}}); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	deadLetters := waitForDeadLetters(t, service, "test.failing", 1)
+	deadLetter := deadLetters[0]
+	if deadLetter.Consumer != "test_failing" {
+		t.Errorf("Consumer = %q, want test_failing", deadLetter.Consumer)
+	}
+	if deadLetter.Subject != EventSubjectPrefix+"test.created" {
+		t.Errorf("Subject = %q, want %s", deadLetter.Subject, EventSubjectPrefix+"test.created")
+	}
+	if deadLetter.Deliveries != testMaxDeliver {
+		t.Errorf("Deliveries = %d, want %d", deadLetter.Deliveries, testMaxDeliver)
+	}
+	if deadLetter.Error != "downstream unavailable" {
+		t.Errorf("Error = %q, want handler error", deadLetter.Error)
+	}
+	if !strings.Contains(string(deadLetter.Data), `"id":"1"`) {
+		t.Errorf("Data = %s, want original event payload", deadLetter.Data)
+	}
+
+	// 종료된 메시지는 더 이상 재전달되지 않아야 함
+	time.Sleep(200 * time.Millisecond)
+	if got := deliveries.Load(); got != testMaxDeliver {
+		t.Errorf("handler called %d times, want %d", got, testMaxDeliver)
+	}
+}
+
+func TestSubscribeDurableDeadLettersPermanentErrorsImmediately(t *testing.T) {
+	service := startJetStreamServer(t)
+
+	var deliveries atomic.Int32
+	handler := handlerFunc(func(ctx context.Context, event Event) error {
+		deliveries.Add(1)
+		return Permanent(errors.New("invalid event"))
+	})
+	if err := service.SubscribeDurable("test.permanent", "test.created", handler); err != nil {
+		t.Fatalf("SubscribeDurable() error = %v", err)
+	}
+
+	if err := service.Publish(context.Background(), Event{Type: "test.created"}); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	deadLetter := waitForDeadLetters(t, service, "test.permanent", 1)[0]
+	if deadLetter.Deliveries != 1 {
+		t.Errorf("Deliveries = %d, want 1", deadLetter.Deliveries)
+	}
+	if got := deliveries.Load(); got != 1 {
+		t.Errorf("handler called %d times, want 1", got)
+	}
+}
+
+func TestMaxDeliveriesAdvisoryDeadLettersUnackedMessages(t *testing.T) {
+	service := startJetStreamServer(t)
+	ctx := context.Background()
+
+	// ack하지 않는 consumer로 MaxDeliver를 소진시켜 advisory 경로를 검증
+	consumer, err := service.js.CreateOrUpdateConsumer(ctx, EventStreamName, jetstream.ConsumerConfig{
+		Durable:       "unacked",
+		FilterSubject: EventSubjectPrefix + "test.created",
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       100 * time.Millisecond,
+		MaxDeliver:    2,
+	})
+	if err != nil {
+		t.Fatalf("CreateOrUpdateConsumer() error = %v", err)
+	}
+	if err := service.Publish(ctx, Event{Type: "test.created"}); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+	// 마지막 pull 요청에서 서버가 MaxDeliver 소진을 감지해 advisory를 보냄
+	for i := 0; i < 3; i++ {
+		batch, err := consumer.Fetch(1, jetstream.FetchMaxWait(time.Second))
+		if err != nil {
+			t.Fatalf("Fetch() error = %v", err)
+		}
+		for range batch.Messages() {
+		}
+	}
+
+	deadLetter := waitForDeadLetters(t, service, "unacked", 1)[0]
+	if deadLetter.Deliveries != 2 {
+		t.Errorf("Deliveries = %d, want 2", deadLetter.Deliveries)
+	}
+	if deadLetter.Error != "maximum deliveries exceeded without acknowledgement" {
+		t.Errorf("Error = %q, want max deliveries reason", deadLetter.Error)
+	}
+}
+
+func TestReplayDeadLetterRedeliversToFailedConsumer(t *testing.T) {
+	service := startJetStreamServer(t)
+	ctx := context.Background()
+
+	var fail atomic.Bool
+	fail.Store(true)
+	handled := make(chan Event, 1)
+	handler := handlerFunc(func(ctx context.Context, event Event) error {
+		if fail.Load() {
+			return Permanent(errors.New("not ready"))
+		}
+		handled <- event
+		return nil
+	})
+	if err := service.SubscribeDurable("test.replay", "test.created", handler); err != nil {
+		t.Fatalf("SubscribeDurable() error = %v", err)
+	}
+	if err := service.Publish(ctx, Event{Type: "test.created", Data: map[string]interface{}{"id": "2"}}); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	deadLetter := waitForDeadLetters(t, service, "test.replay", 1)[0]
+	fail.Store(false)
+	if err := service.ReplayDeadLetter(ctx, deadLetter.Sequence); err != nil {
+		t.Fatalf("ReplayDeadLetter() error = %v", err)
+	}
+
+	select {
+	case event := <-handled:
+		if event.Data["id"] != "2" {
+			t.Errorf("replayed event data = %v, want id 2", event.Data)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("replayed event was not delivered")
+	}
+	remaining, err := service.GetDeadLetter(ctx, deadLetter.Sequence)
+	if err != nil {
+		t.Fatalf("GetDeadLetter() error = %v", err)
+	}
+	if remaining != nil {
+		t.Error("replayed dead letter should be removed from the queue")
+	}
+}
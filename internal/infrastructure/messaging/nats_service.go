@@ -5,19 +5,24 @@ import (
 	"encoding/json"
 	"fmt"
 	"skyclust/pkg/logger"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 )
 
 // NATSConfig holds NATS configuration
 type NATSConfig struct {
 	URL                  string
 	Cluster              string
+	Username             string
+	Password             string
 	Subject              string
 	CompressionType      CompressionType
 	CompressionThreshold int // Minimum size in bytes to compress
+	JetStream            JetStreamConfig
 }
 
 // QueueSubscription represents a queue subscription with management capabilities
@@ -40,7 +45,12 @@ type NATSService struct {
 	conn          *nats.Conn
 	config        NATSConfig
 	subscriptions map[string]*QueueSubscription
+	consumers     map[string]jetstream.ConsumeContext
 	subsMux       sync.RWMutex
+
+	// JetStream (nil when disabled)
+	js          jetstream.JetStream
+	deadLetters jetstream.Stream
 }
 
 // NewNATSService creates a new NATS service
@@ -69,22 +79,41 @@ func NewNATSService(config NATSConfig) (*NATSService, error) {
 		}),
 	}
 
+	if config.Username != "" {
+		opts = append(opts, nats.UserInfo(config.Username, config.Password))
+	}
+
 	conn, err := nats.Connect(config.URL, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
 	}
 
 	logger.Info(fmt.Sprintf("Successfully connected to NATS (compression: %s, threshold: %d bytes)", config.CompressionType, config.CompressionThreshold))
-	return &NATSService{
+	service := &NATSService{
 		conn:          conn,
 		config:        config,
 		subscriptions: make(map[string]*QueueSubscription),
-	}, nil
+		consumers:     make(map[string]jetstream.ConsumeContext),
+	}
+
+	if config.JetStream.Enabled {
+		if err := service.setupJetStream(); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	return service, nil
+}
+
+// Conn returns the underlying NATS connection for raw subject subscriptions
+func (n *NATSService) Conn() *nats.Conn {
+	return n.conn
 }
 
 // Publish publishes an event (implements Bus interface)
 func (n *NATSService) Publish(ctx context.Context, event Event) error {
-	subject := fmt.Sprintf("cmp.events.%s", event.Type)
+	subject := EventSubjectPrefix + event.Type
 	message, err := json.Marshal(event)
 	if err != nil {
 		return fmt.Errorf("failed to marshal event: %w", err)
@@ -96,6 +125,14 @@ func (n *NATSService) Publish(ctx context.Context, event Event) error {
 		return fmt.Errorf("failed to compress message: %w", err)
 	}
 
+	// JetStream이 활성화되어 있으면 스트림 저장이 확인될 때까지 대기
+	if n.js != nil {
+		if _, err := n.js.Publish(ctx, subject, compressed); err != nil {
+			return fmt.Errorf("failed to publish event to JetStream: %w", err)
+		}
+		return nil
+	}
+
 	return n.conn.Publish(subject, compressed)
 }
 
@@ -113,7 +150,7 @@ func (n *NATSService) PublishToUser(ctx context.Context, userID string, event *E
 
 // Subscribe subscribes to an event type (implements Bus interface)
 func (n *NATSService) Subscribe(eventType string, handler EventHandler) error {
-	subject := fmt.Sprintf("cmp.events.%s", eventType)
+	subject := EventSubjectPrefix + eventType
 	_, err := n.conn.Subscribe(subject, func(msg *nats.Msg) {
 		// 압축 해제
 		decompressed, err := n.decompressMessage(msg.Data)
@@ -182,6 +219,8 @@ func (n *NATSService) SubscribeWithQueue(ctx context.Context, subject, queue str
 }
 
 // SubscribeWithQueueAdvanced subscribes to a subject with queue group with advanced options
+// When JetStream is enabled, event subjects are consumed by a durable consumer named after the queue,
+// so failed messages are redelivered with backoff and dead-lettered instead of retried in-process
 func (n *NATSService) SubscribeWithQueueAdvanced(
 	ctx context.Context,
 	subject, queue string,
@@ -197,9 +236,6 @@ func (n *NATSService) SubscribeWithQueueAdvanced(
 		retryDelay = time.Second
 	}
 
-	// 동시성 제어를 위한 세마포어
-	sem := make(chan struct{}, concurrency)
-
 	sub := &QueueSubscription{
 		Subject:     subject,
 		Queue:       queue,
@@ -209,6 +245,13 @@ func (n *NATSService) SubscribeWithQueueAdvanced(
 		RetryDelay:  retryDelay,
 	}
 
+	if n.js != nil && strings.HasPrefix(subject, EventSubjectPrefix) {
+		return n.subscribeWithQueueJetStream(sub)
+	}
+
+	// 동시성 제어를 위한 세마포어
+	sem := make(chan struct{}, concurrency)
+
 	subscription, err := n.conn.QueueSubscribe(subject, queue, func(msg *nats.Msg) {
 		// 동시성 제어: 세마포어 획득
 		sem <- struct{}{}
@@ -241,7 +284,7 @@ func (n *NATSService) SubscribeWithQueueAdvanced(
 		}
 		sub.mu.Unlock()
 
-		// ACK/NAK 처리는 JetStream에서만 지원되므로 일반 NATS에서는 로깅만 수행
+		// ACK/NAK 처리는 JetStream에서만 지원되므로 일반 NATS에서는 로깅만 수행 (메시지 유실)
 		if err != nil {
 			logger.Errorf("Message processing failed after retries: %v", err)
 		}
@@ -263,6 +306,36 @@ func (n *NATSService) SubscribeWithQueueAdvanced(
 	return nil
 }
 
+// subscribeWithQueueJetStream consumes a queue subscription through a durable JetStream consumer
+func (n *NATSService) subscribeWithQueueJetStream(sub *QueueSubscription) error {
+	err := n.consumeDurable(sub.Queue, sub.Subject, sub.Concurrency, func(data []byte) error {
+		sub.mu.Lock()
+		sub.activeCount++
+		sub.mu.Unlock()
+
+		// 재시도는 JetStream 재전달에 맡김
+		err := sub.Handler(data)
+
+		sub.mu.Lock()
+		sub.activeCount--
+		if err != nil {
+			sub.failed++
+		} else {
+			sub.processed++
+		}
+		sub.mu.Unlock()
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to queue %s on subject %s: %w", sub.Queue, sub.Subject, err)
+	}
+
+	n.subsMux.Lock()
+	n.subscriptions[fmt.Sprintf("%s:%s", sub.Subject, sub.Queue)] = sub
+	n.subsMux.Unlock()
+	return nil
+}
+
 // processMessageWithRetry processes a message with retry logic
 func (n *NATSService) processMessageWithRetry(sub *QueueSubscription, data []byte) error {
 	var lastErr error
@@ -301,6 +374,12 @@ func (n *NATSService) UnsubscribeFromQueue(subject, queue string) error {
 			return fmt.Errorf("failed to unsubscribe: %w", err)
 		}
 	}
+	// durable consumer는 서버에 남겨 두고 이 인스턴스의 수신만 중단
+	consumerKey := fmt.Sprintf("%s:%s", subject, consumerName(queue))
+	if consumeCtx, ok := n.consumers[consumerKey]; ok {
+		consumeCtx.Stop()
+		delete(n.consumers, consumerKey)
+	}
 
 	delete(n.subscriptions, key)
 	logger.Info(fmt.Sprintf("Unsubscribed from queue %s on subject %s", queue, subject))
@@ -435,6 +514,13 @@ func (n *NATSService) Request(ctx context.Context, subject string, data interfac
 
 // Close closes the NATS connection
 func (n *NATSService) Close() {
+	n.subsMux.Lock()
+	for key, consumeCtx := range n.consumers {
+		consumeCtx.Stop()
+		delete(n.consumers, key)
+	}
+	n.subsMux.Unlock()
+
 	n.conn.Close()
 }
 
@@ -448,5 +534,6 @@ func (n *NATSService) GetStats() map[string]interface{} {
 		"out_bytes":  stats.OutBytes,
 		"reconnects": stats.Reconnects,
 		"connected":  n.conn.IsConnected(),
+		"jetstream":  n.js != nil,
 	}
 }
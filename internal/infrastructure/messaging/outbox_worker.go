@@ -3,6 +3,9 @@ package messaging
 import (
 	"context"
 	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"skyclust/internal/domain"
@@ -12,59 +15,94 @@ import (
 
 // OutboxWorker processes events from the outbox table and publishes them to NATS
 // Outbox 테이블의 이벤트를 처리하여 NATS로 발행하는 워커
+// Several replicas can run it at once: events are leased with SELECT ... FOR UPDATE SKIP LOCKED
+// 여러 인스턴스에서 동시에 실행해도 이벤트는 임대(lease)되어 한 워커만 발행
 type OutboxWorker struct {
 	outboxRepo domain.OutboxRepository
 	publisher  *Publisher
 	logger     *zap.Logger
 	config     OutboxWorkerConfig
 	stopCh     chan struct{}
+	stopOnce   sync.Once
+
+	// Counters since start
+	// 시작 이후 누적 카운터
+	published int64
+	retried   int64
+	failed    int64
 }
 
 // OutboxWorkerConfig contains configuration for the outbox worker
 // Outbox 워커 설정
 type OutboxWorkerConfig struct {
+	// WorkerID identifies the lease owner (defaults to hostname and pid)
+	// 임대 소유자 식별자 (기본값: 호스트 이름과 pid)
+	WorkerID string
+
 	// BatchSize is the number of events to process in each batch
 	// 각 배치에서 처리할 이벤트 수
 	BatchSize int
 
 	// PollInterval is the interval between polling for new events
-	// 새 이벤트를 폴링하는 간격
+	// LISTEN/NOTIFY wakes the worker immediately; polling picks up retries and missed notifications
+	// 새 이벤트를 폴링하는 간격 (LISTEN/NOTIFY로 즉시 깨어나며, 폴링은 재시도와 놓친 알림을 처리)
 	PollInterval time.Duration
 
 	// MaxRetries is the maximum number of retries before marking an event as failed
 	// 이벤트를 실패로 표시하기 전 최대 재시도 횟수
 	MaxRetries int
 
-	// RetryDelay is the delay between retries
-	// 재시도 간 지연 시간
+	// RetryDelay is the initial retry delay, doubled on each retry of an event
+	// 첫 재시도 지연 시간 (이벤트별로 재시도마다 두 배)
 	RetryDelay time.Duration
+
+	// MaxRetryDelay caps the exponential backoff
+	// 지수 백오프 최대 지연 시간
+	MaxRetryDelay time.Duration
+
+	// LeaseDuration is how long a claimed event stays locked to this worker
+	// 가져간 이벤트가 이 워커에 잠겨 있는 시간 (만료되면 다른 워커가 다시 가져감)
+	LeaseDuration time.Duration
 }
 
 // DefaultOutboxWorkerConfig returns default configuration for the outbox worker
 // Outbox 워커의 기본 설정 반환
 func DefaultOutboxWorkerConfig() OutboxWorkerConfig {
 	return OutboxWorkerConfig{
-		BatchSize:    10,
-		PollInterval: 5 * time.Second,
-		MaxRetries:   3,
-		RetryDelay:   1 * time.Second,
+		BatchSize:     10,
+		PollInterval:  5 * time.Second,
+		MaxRetries:    10,
+		RetryDelay:    1 * time.Second,
+		MaxRetryDelay: 5 * time.Minute,
+		LeaseDuration: 1 * time.Minute,
 	}
 }
 
 // NewOutboxWorker creates a new outbox worker
 // 새로운 outbox 워커 생성
 func NewOutboxWorker(outboxRepo domain.OutboxRepository, publisher *Publisher, logger *zap.Logger, config OutboxWorkerConfig) *OutboxWorker {
+	defaults := DefaultOutboxWorkerConfig()
+	if config.WorkerID == "" {
+		hostname, _ := os.Hostname()
+		config.WorkerID = fmt.Sprintf("%s-%d", hostname, os.Getpid())
+	}
 	if config.BatchSize == 0 {
-		config.BatchSize = 10
+		config.BatchSize = defaults.BatchSize
 	}
 	if config.PollInterval == 0 {
-		config.PollInterval = 5 * time.Second
+		config.PollInterval = defaults.PollInterval
 	}
 	if config.MaxRetries == 0 {
-		config.MaxRetries = 3
+		config.MaxRetries = defaults.MaxRetries
 	}
 	if config.RetryDelay == 0 {
-		config.RetryDelay = 1 * time.Second
+		config.RetryDelay = defaults.RetryDelay
+	}
+	if config.MaxRetryDelay == 0 {
+		config.MaxRetryDelay = defaults.MaxRetryDelay
+	}
+	if config.LeaseDuration == 0 {
+		config.LeaseDuration = defaults.LeaseDuration
 	}
 
 	return &OutboxWorker{
@@ -80,13 +118,22 @@ func NewOutboxWorker(outboxRepo domain.OutboxRepository, publisher *Publisher, l
 // Outbox 워커 시작
 func (w *OutboxWorker) Start(ctx context.Context) error {
 	w.logger.Info("Starting outbox worker",
+		zap.String("worker_id", w.config.WorkerID),
 		zap.Int("batch_size", w.config.BatchSize),
 		zap.Duration("poll_interval", w.config.PollInterval))
 
 	ticker := time.NewTicker(w.config.PollInterval)
 	defer ticker.Stop()
 
+	listenCtx, cancelListen := context.WithCancel(ctx)
+	defer cancelListen()
+	notifications := w.listen(listenCtx)
+
 	for {
+		// Drain the backlog before waiting again
+		// 다시 대기하기 전에 밀린 이벤트를 모두 처리
+		w.drain(ctx)
+
 		select {
 		case <-ctx.Done():
 			w.logger.Info("Outbox worker stopped (context cancelled)")
@@ -94,12 +141,15 @@ func (w *OutboxWorker) Start(ctx context.Context) error {
 		case <-w.stopCh:
 			w.logger.Info("Outbox worker stopped")
 			return nil
+		case _, ok := <-notifications:
+			if !ok {
+				// Listener stopped: fall back to polling and try again on the next tick
+				// 수신이 중단되면 폴링으로 처리하고 다음 주기에 다시 LISTEN
+				notifications = nil
+			}
 		case <-ticker.C:
-			if err := w.processBatch(ctx); err != nil {
-				w.logger.Warn("Error processing outbox batch",
-					zap.Error(err))
-				// Continue processing even if one batch fails
-				// 한 배치가 실패해도 계속 처리
+			if notifications == nil {
+				notifications = w.listen(listenCtx)
 			}
 		}
 	}
@@ -108,88 +158,151 @@ func (w *OutboxWorker) Start(ctx context.Context) error {
 // Stop stops the outbox worker
 // Outbox 워커 중지
 func (w *OutboxWorker) Stop() {
-	close(w.stopCh)
+	w.stopOnce.Do(func() {
+		close(w.stopCh)
+	})
 }
 
-// processBatch processes a batch of pending events
-// 대기 중인 이벤트 배치 처리
-func (w *OutboxWorker) processBatch(ctx context.Context) error {
-	// Get pending events
-	// 대기 중인 이벤트 조회
-	events, err := w.outboxRepo.GetPendingEvents(ctx, w.config.BatchSize)
-	if err != nil {
-		return fmt.Errorf("failed to get pending events: %w", err)
+// Stats returns counters since the worker started
+// 워커 시작 이후 누적 카운터 반환
+func (w *OutboxWorker) Stats() map[string]interface{} {
+	return map[string]interface{}{
+		"worker_id": w.config.WorkerID,
+		"published": atomic.LoadInt64(&w.published),
+		"retried":   atomic.LoadInt64(&w.retried),
+		"failed":    atomic.LoadInt64(&w.failed),
 	}
+}
 
-	if len(events) == 0 {
+// listen subscribes to outbox notifications, returning nil when LISTEN is unavailable
+// outbox 알림을 구독하며, LISTEN을 사용할 수 없으면 nil 반환 (폴링만 사용)
+func (w *OutboxWorker) listen(ctx context.Context) <-chan struct{} {
+	notifications, err := w.outboxRepo.ListenForEvents(ctx)
+	if err != nil {
+		w.logger.Warn("Outbox LISTEN unavailable, polling only", zap.Error(err))
 		return nil
 	}
+	return notifications
+}
 
-	w.logger.Debug("Processing outbox batch",
-		zap.Int("count", len(events)))
+// drain processes batches until fewer than a full batch is claimed
+// 가득 찬 배치보다 적게 가져올 때까지 배치를 반복 처리
+func (w *OutboxWorker) drain(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.stopCh:
+			return
+		default:
+		}
+
+		processed, err := w.processBatch(ctx)
+		if err != nil {
+			w.logger.Warn("Error processing outbox batch",
+				zap.Error(err))
+			// Continue on the next wake-up even if one batch fails
+			// 한 배치가 실패해도 다음 주기에 계속 처리
+			return
+		}
+		if processed < w.config.BatchSize {
+			return
+		}
+	}
+}
 
-	// Mark events as processing
-	// 이벤트를 처리 중으로 표시
-	eventIDs := make([]string, len(events))
-	for i, event := range events {
-		eventIDs[i] = event.ID.String()
+// processBatch claims and publishes a batch of due events, returning how many were claimed
+// 발행할 이벤트 배치를 가져와 처리하고, 가져온 이벤트 수를 반환
+func (w *OutboxWorker) processBatch(ctx context.Context) (int, error) {
+	// Claim due events (one per aggregate, skipping rows locked by other workers)
+	// 발행할 이벤트 임대 (aggregate별 하나, 다른 워커가 잠근 행은 건너뜀)
+	events, err := w.outboxRepo.ClaimPendingEvents(ctx, w.config.WorkerID, w.config.BatchSize, w.config.LeaseDuration)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim pending events: %w", err)
 	}
 
-	if err := w.outboxRepo.MarkAsProcessing(ctx, eventIDs); err != nil {
-		return fmt.Errorf("failed to mark events as processing: %w", err)
+	if len(events) == 0 {
+		return 0, nil
 	}
 
+	w.logger.Debug("Processing outbox batch",
+		zap.Int("count", len(events)))
+
 	// Process each event
 	// 각 이벤트 처리
 	for _, event := range events {
 		if err := w.processEvent(ctx, event); err != nil {
-			w.logger.Warn("Failed to process outbox event",
+			w.handleFailure(ctx, event, err)
+			continue
+		}
+
+		// Mark as published
+		// 발행됨으로 표시
+		if err := w.outboxRepo.MarkAsPublished(ctx, event.ID.String()); err != nil {
+			w.logger.Error("Failed to mark event as published",
 				zap.String("event_id", event.ID.String()),
-				zap.String("topic", event.Topic),
 				zap.Error(err))
+			continue
+		}
+		atomic.AddInt64(&w.published, 1)
+	}
 
-			// Increment retry count
-			// 재시도 횟수 증가
-			if err := w.outboxRepo.IncrementRetryCount(ctx, event.ID.String()); err != nil {
-				w.logger.Error("Failed to increment retry count",
-					zap.String("event_id", event.ID.String()),
-					zap.Error(err))
-			}
+	return len(events), nil
+}
 
-			// Check if max retries exceeded
-			// 최대 재시도 횟수 초과 확인
-			if event.RetryCount+1 >= w.config.MaxRetries {
-				errorMsg := err.Error()
-				if updateErr := w.outboxRepo.UpdateStatus(ctx, event.ID.String(), domain.OutboxStatusFailed, &errorMsg); updateErr != nil {
-					w.logger.Error("Failed to mark event as failed",
-						zap.String("event_id", event.ID.String()),
-						zap.Error(updateErr))
-				}
-			} else {
-				// Reset to pending for retry
-				// 재시도를 위해 pending으로 재설정
-				if updateErr := w.outboxRepo.UpdateStatus(ctx, event.ID.String(), domain.OutboxStatusPending, nil); updateErr != nil {
-					w.logger.Error("Failed to reset event status",
-						zap.String("event_id", event.ID.String()),
-						zap.Error(updateErr))
-				}
-			}
+// handleFailure schedules a retry with exponential backoff, or marks the event failed after MaxRetries
+// 지수 백오프로 재시도를 예약하거나, 최대 재시도 횟수를 넘으면 실패로 표시
+func (w *OutboxWorker) handleFailure(ctx context.Context, event *domain.OutboxEvent, err error) {
+	errorMsg := err.Error()
 
-			// Wait before retrying
-			// 재시도 전 대기
-			time.Sleep(w.config.RetryDelay)
-		} else {
-			// Mark as published
-			// 발행됨으로 표시
-			if err := w.outboxRepo.MarkAsPublished(ctx, event.ID.String()); err != nil {
-				w.logger.Error("Failed to mark event as published",
-					zap.String("event_id", event.ID.String()),
-					zap.Error(err))
-			}
+	// Check if max retries exceeded
+	// 최대 재시도 횟수 초과 확인
+	if event.RetryCount+1 >= w.config.MaxRetries {
+		w.logger.Error("Outbox event failed after retries",
+			zap.String("event_id", event.ID.String()),
+			zap.String("topic", event.Topic),
+			zap.Int("retries", event.RetryCount+1),
+			zap.Error(err))
+		if updateErr := w.outboxRepo.UpdateStatus(ctx, event.ID.String(), domain.OutboxStatusFailed, &errorMsg); updateErr != nil {
+			w.logger.Error("Failed to mark event as failed",
+				zap.String("event_id", event.ID.String()),
+				zap.Error(updateErr))
+			return
 		}
+		atomic.AddInt64(&w.failed, 1)
+		return
 	}
 
-	return nil
+	delay := w.retryDelay(event.RetryCount)
+	w.logger.Warn("Failed to process outbox event, retrying",
+		zap.String("event_id", event.ID.String()),
+		zap.String("topic", event.Topic),
+		zap.Int("retry", event.RetryCount+1),
+		zap.Duration("delay", delay),
+		zap.Error(err))
+
+	// Later events of the same aggregate wait until this one is published
+	// 같은 aggregate의 이후 이벤트는 이 이벤트가 발행될 때까지 대기
+	if updateErr := w.outboxRepo.ScheduleRetry(ctx, event.ID.String(), time.Now().Add(delay), errorMsg); updateErr != nil {
+		w.logger.Error("Failed to schedule event retry",
+			zap.String("event_id", event.ID.String()),
+			zap.Error(updateErr))
+		return
+	}
+	atomic.AddInt64(&w.retried, 1)
+}
+
+// retryDelay returns RetryDelay * 2^retryCount, capped at MaxRetryDelay
+// RetryDelay * 2^retryCount (최대 MaxRetryDelay)
+func (w *OutboxWorker) retryDelay(retryCount int) time.Duration {
+	delay := w.config.RetryDelay
+	for i := 0; i < retryCount; i++ {
+		delay *= 2
+		if delay >= w.config.MaxRetryDelay {
+			return w.config.MaxRetryDelay
+		}
+	}
+	return delay
 }
 
 // processEvent processes a single outbox event
@@ -340,6 +340,47 @@ func (p *Publisher) PublishCredentialEvent(ctx context.Context, workspaceID, pro
 	return nil
 }
 
+// PublishOperationEvent publishes a long-running operation event
+func (p *Publisher) PublishOperationEvent(ctx context.Context, workspaceID, operationID, action string, operationData map[string]interface{}) error {
+	topic := BuildOperationTopic(action)
+
+	data := map[string]interface{}{
+		"workspace_id": workspaceID,
+		"operation_id": operationID,
+		"action":       action,
+	}
+
+	for k, v := range operationData {
+		data[k] = v
+	}
+
+	event := Event{
+		Type:        topic,
+		Data:        data,
+		Timestamp:   time.Now().Unix(),
+		WorkspaceID: workspaceID,
+	}
+
+	if err := p.bus.Publish(ctx, event); err != nil {
+		p.logger.Warn("Failed to publish Operation event, continuing without event",
+			zap.String("topic", topic),
+			zap.String("workspace_id", workspaceID),
+			zap.String("operation_id", operationID),
+			zap.Error(err))
+		// Don't fail the operation if event publishing fails
+		// This allows graceful degradation when NATS is unavailable
+		return nil
+	}
+
+	p.logger.Debug("Published Operation event",
+		zap.String("topic", topic),
+		zap.String("workspace_id", workspaceID),
+		zap.String("operation_id", operationID),
+		zap.String("action", action))
+
+	return nil
+}
+
 // PublishToNATS publishes an event directly to NATS (for NATSService)
 func (p *Publisher) PublishToNATS(ctx context.Context, subject string, data interface{}) error {
 	if natsService, ok := p.bus.(*NATSService); ok {
@@ -379,6 +420,13 @@ func (p *Publisher) convertToEventData(data interface{}) (map[string]interface{}
 // PublishToOutbox stores an event in the outbox table for transactional publishing
 // 트랜잭션 발행을 위해 이벤트를 outbox 테이블에 저장
 func (p *Publisher) PublishToOutbox(ctx context.Context, outboxRepo domain.OutboxRepository, topic, eventType string, data map[string]interface{}, workspaceID *string) error {
+	return p.PublishToOutboxForAggregate(ctx, outboxRepo, topic, eventType, "", data, workspaceID)
+}
+
+// PublishToOutboxForAggregate stores an event that is published in order with the other events of its aggregate
+// (e.g. a resource ID); events of the same topic are ordered when aggregateID is empty
+// aggregate(예: 리소스 ID)의 다른 이벤트와 순서대로 발행되도록 이벤트를 outbox에 저장 (비어 있으면 토픽 단위로 순서 보장)
+func (p *Publisher) PublishToOutboxForAggregate(ctx context.Context, outboxRepo domain.OutboxRepository, topic, eventType, aggregateID string, data map[string]interface{}, workspaceID *string) error {
 	// Convert data to JSONBMap
 	// data를 JSONBMap으로 변환
 	jsonbData := domain.JSONBMap(data)
@@ -386,11 +434,12 @@ func (p *Publisher) PublishToOutbox(ctx context.Context, outboxRepo domain.Outbo
 	// Create OutboxEvent
 	// OutboxEvent 생성
 	event := &domain.OutboxEvent{
-		Topic:     topic,
-		EventType: eventType,
-		Data:      jsonbData,
-		Status:    domain.OutboxStatusPending,
-		CreatedAt: time.Now(),
+		Topic:       topic,
+		EventType:   eventType,
+		Data:        jsonbData,
+		Status:      domain.OutboxStatusPending,
+		AggregateID: aggregateID,
+		CreatedAt:   time.Now(),
 	}
 
 	// Set workspace ID if provided
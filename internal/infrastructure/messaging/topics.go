@@ -11,6 +11,7 @@ const (
 	ResourceCost       = "cost"
 	ResourceWorkspace  = "workspace"
 	ResourceCredential = "credential"
+	ResourceOperation  = "operation"
 )
 
 // Event actions
@@ -114,6 +115,15 @@ func BuildCredentialTopic(workspaceID, provider, action string) string {
 	return ResourceCredential + "." + workspaceID + "." + provider + "." + action
 }
 
+// Operation topic builders
+// Format: operation.{action}
+// Example: operation.updated (the workspace is carried in the event, not the subject)
+
+// BuildOperationTopic builds a NATS topic for long-running operation events
+func BuildOperationTopic(action string) string {
+	return ResourceOperation + "." + action
+}
+
 // Topic patterns for wildcard subscriptions
 const (
 	PatternKubernetesAll        = "kubernetes.*"
@@ -0,0 +1,142 @@
+/**
+ * Chat Notification Service
+ * 채팅 채널(Slack, Microsoft Teams, Discord) 알림 전송 서비스
+ */
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"skyclust/internal/domain"
+	"skyclust/pkg/security"
+	"strings"
+	"time"
+)
+
+const (
+	// chatRequestTimeout 채팅 API 요청 제한 시간
+	chatRequestTimeout = 10 * time.Second
+	// maxChatErrorBody 오류 메시지에 포함하는 응답 본문 최대 크기
+	maxChatErrorBody = 512
+)
+
+// ChatService 채널 종류별 전송 구현으로 라우팅하는 채팅 전송 서비스
+type ChatService struct {
+	slack   *SlackService
+	teams   *TeamsService
+	discord *DiscordService
+}
+
+// NewChatService 채팅 전송 서비스 생성
+func NewChatService() *ChatService {
+	client := newChatHTTPClient()
+	return &ChatService{
+		slack:   NewSlackService(client),
+		teams:   NewTeamsService(client),
+		discord: NewDiscordService(client),
+	}
+}
+
+// Send 채널 종류에 맞는 형식으로 메시지 전송 (domain.ChatSender 구현)
+func (s *ChatService) Send(ctx context.Context, channel *domain.ChatChannel, message *domain.ChatMessage) error {
+	switch channel.Type {
+	case domain.ChatChannelSlack:
+		return s.slack.Send(ctx, channel, message)
+	case domain.ChatChannelTeams:
+		return s.teams.Send(ctx, channel, message)
+	case domain.ChatChannelDiscord:
+		return s.discord.Send(ctx, channel, message)
+	default:
+		return fmt.Errorf("unsupported chat channel type: %s", channel.Type)
+	}
+}
+
+// RespondSlack Slack 인터랙션의 response_url로 원본 메시지를 교체
+func (s *ChatService) RespondSlack(ctx context.Context, responseURL string, message *domain.ChatMessage) error {
+	return s.slack.ReplaceOriginal(ctx, responseURL, message)
+}
+
+// newChatHTTPClient 리다이렉트를 따르지 않고 공인 주소에만 연결하는 채팅 API HTTP 클라이언트 생성
+func newChatHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout:   chatRequestTimeout,
+		Transport: security.NewPublicTransport(chatRequestTimeout),
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// postChatJSON JSON 본문을 POST하고 2xx가 아니면 오류 반환
+func postChatJSON(ctx context.Context, client *http.Client, target string, headers map[string]string, payload interface{}) ([]byte, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal chat message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create chat request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			// 웹훅 URL에는 토큰이 포함되므로 오류 메시지에서 제거
+			err = urlErr.Err
+		}
+		return nil, fmt.Errorf("failed to send chat message: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return respBody, fmt.Errorf("chat endpoint returned status %d: %s", resp.StatusCode, truncateChatText(strings.TrimSpace(string(respBody)), maxChatErrorBody))
+	}
+	return respBody, nil
+}
+
+// truncateChatText 최대 길이(문자 수)로 자르고 말줄임 표시 추가
+func truncateChatText(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+	return string(runes[:limit-1]) + "…"
+}
+
+// chatPriorityLabel 우선순위 표시 문자열
+func chatPriorityLabel(priority string) string {
+	if priority == "" {
+		priority = domain.NotificationPriorityMedium
+	}
+	return strings.ToUpper(priority)
+}
+
+// chatColor 알림 종류와 우선순위에 따른 강조 색상 (RGB)
+func chatColor(message *domain.ChatMessage) int {
+	switch {
+	case message.AcknowledgedBy != "":
+		return 0x6B7280 // gray
+	case message.Type == "error" || message.Priority == domain.NotificationPriorityUrgent:
+		return 0xDC2626 // red
+	case message.Type == "warning" || message.Priority == domain.NotificationPriorityHigh:
+		return 0xF59E0B // amber
+	case message.Type == "success":
+		return 0x16A34A // green
+	default:
+		return 0x2563EB // blue
+	}
+}
@@ -0,0 +1,70 @@
+/**
+ * Discord Notification Service
+ * Discord 알림 전송 서비스 (웹훅 embed)
+ */
+
+package notification
+
+import (
+	"context"
+	"net/http"
+	"skyclust/internal/domain"
+	"time"
+)
+
+type DiscordService struct {
+	client *http.Client
+}
+
+// NewDiscordService Discord 서비스 생성
+func NewDiscordService(client *http.Client) *DiscordService {
+	return &DiscordService{client: client}
+}
+
+// Send Discord 웹훅으로 embed 메시지 전송
+func (s *DiscordService) Send(ctx context.Context, channel *domain.ChatChannel, message *domain.ChatMessage) error {
+	description := truncateChatText(message.Message, 3500)
+	switch {
+	case message.AcknowledgedBy != "":
+		description += "\n\n✅ Acknowledged by " + message.AcknowledgedBy
+	case message.AckURL != "":
+		// 웹훅 메시지는 버튼 컴포넌트를 지원하지 않으므로 확인 링크를 본문에 포함
+		description += "\n\n[Acknowledge](" + message.AckURL + ")"
+	}
+
+	fields := []map[string]interface{}{
+		{"name": "Priority", "value": chatPriorityLabel(message.Priority), "inline": true},
+		{"name": "Category", "value": defaultChatValue(message.Category), "inline": true},
+	}
+	if message.WorkspaceName != "" {
+		fields = append(fields, map[string]interface{}{"name": "Workspace", "value": message.WorkspaceName, "inline": true})
+	}
+
+	embed := map[string]interface{}{
+		"title":       truncateChatText(message.Title, 256),
+		"description": description,
+		"color":       chatColor(message),
+		"fields":      fields,
+	}
+	if !message.CreatedAt.IsZero() {
+		embed["timestamp"] = message.CreatedAt.UTC().Format(time.RFC3339)
+	}
+
+	payload := map[string]interface{}{
+		"username": "SkyClust",
+		"embeds":   []map[string]interface{}{embed},
+		// 알림 본문의 @everyone 등이 멘션으로 처리되지 않도록 차단
+		"allowed_mentions": map[string]interface{}{"parse": []string{}},
+	}
+
+	_, err := postChatJSON(ctx, s.client, channel.WebhookURL, nil, payload)
+	return err
+}
+
+// defaultChatValue 빈 값 대신 표시할 문자열 (Discord는 빈 필드를 거부)
+func defaultChatValue(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
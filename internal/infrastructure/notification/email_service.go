@@ -50,11 +50,32 @@ func (s *EmailService) SendNotification(userEmail string, notification *domain.N
 	return s.sendEmail(userEmail, subject, body)
 }
 
+// SendDigest 다이제스트 이메일 전송 (여러 알림을 하나의 메일로 묶음)
+func (s *EmailService) SendDigest(userEmail string, notifications []*domain.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	subject := fmt.Sprintf("[DIGEST] %d new notifications", len(notifications))
+	if len(notifications) == 1 {
+		subject = "[DIGEST] 1 new notification"
+	}
+	body, err := s.generateDigestBody(notifications)
+	if err != nil {
+		return fmt.Errorf("failed to generate digest body: %w", err)
+	}
+
+	return s.sendEmail(userEmail, subject, body)
+}
+
 // sendEmail 이메일 전송
 func (s *EmailService) sendEmail(to, subject, body string) error {
 	// SMTP 설정
 	addr := fmt.Sprintf("%s:%s", s.smtpHost, s.smtpPort)
-	auth := smtp.PlainAuth("", s.smtpUsername, s.smtpPassword, s.smtpHost)
+	var auth smtp.Auth
+	if s.smtpUsername != "" {
+		auth = smtp.PlainAuth("", s.smtpUsername, s.smtpPassword, s.smtpHost)
+	}
 
 	// 이메일 헤더 생성
 	headers := make(map[string]string)
@@ -230,3 +251,106 @@ func (s *EmailService) generateEmailBody(notification *domain.Notification) (str
 
 	return buf.String(), nil
 }
+
+// generateDigestBody 다이제스트 이메일 본문 생성
+func (s *EmailService) generateDigestBody(notifications []*domain.Notification) (string, error) {
+	tmpl := `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>Notification digest</title>
+    <style>
+        body {
+            font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, sans-serif;
+            line-height: 1.6;
+            color: #333;
+            max-width: 600px;
+            margin: 0 auto;
+            padding: 20px;
+            background-color: #f5f5f5;
+        }
+        .container {
+            background-color: white;
+            border-radius: 8px;
+            padding: 30px;
+            box-shadow: 0 2px 10px rgba(0,0,0,0.1);
+        }
+        .title {
+            font-size: 24px;
+            font-weight: bold;
+            color: #3b82f6;
+            margin: 0 0 20px 0;
+        }
+        .item {
+            padding: 12px 0;
+            border-bottom: 1px solid #eee;
+        }
+        .item-title {
+            font-weight: bold;
+        }
+        .item-meta {
+            font-size: 12px;
+            color: #666;
+        }
+        .footer {
+            margin-top: 30px;
+            font-size: 14px;
+            color: #666;
+        }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <h1 class="title">{{len .Items}} notification(s) since your last digest</h1>
+        {{range .Items}}
+        <div class="item">
+            <div class="item-title">{{.Title}}</div>
+            <div>{{.Message}}</div>
+            <div class="item-meta">{{.Type}}{{if .Category}} · {{.Category}}{{end}} · {{.CreatedAt}}{{if .Repeated}} · repeated {{.Repeated}} more time(s){{end}}</div>
+        </div>
+        {{end}}
+        <div class="footer">
+            <p>You receive this digest because low priority notifications are batched in your notification preferences.</p>
+        </div>
+    </div>
+</body>
+</html>
+`
+
+	type digestItem struct {
+		Title     string
+		Message   string
+		Type      string
+		Category  string
+		CreatedAt string
+		Repeated  int
+	}
+
+	// 템플릿 데이터
+	items := make([]digestItem, 0, len(notifications))
+	for _, notification := range notifications {
+		items = append(items, digestItem{
+			Title:     notification.Title,
+			Message:   notification.Message,
+			Type:      notification.Type,
+			Category:  notification.Category,
+			CreatedAt: notification.CreatedAt.UTC().Format("2006-01-02 15:04 UTC"),
+			Repeated:  notification.DuplicateCount,
+		})
+	}
+
+	// 템플릿 실행
+	t, err := template.New("digest").Parse(tmpl)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, struct{ Items []digestItem }{Items: items}); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,161 @@
+/**
+ * Slack Notification Service
+ * Slack 알림 전송 서비스 (Incoming Webhook, 봇 토큰 + Block Kit)
+ */
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"skyclust/internal/domain"
+	"strings"
+)
+
+type SlackService struct {
+	client *http.Client
+	apiURL string
+}
+
+// slackAPIResponse Slack Web API 응답 구조체
+type slackAPIResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+}
+
+// NewSlackService Slack 서비스 생성
+func NewSlackService(client *http.Client) *SlackService {
+	return &SlackService{
+		client: client,
+		apiURL: "https://slack.com/api",
+	}
+}
+
+// Send Slack 채널로 Block Kit 메시지 전송
+func (s *SlackService) Send(ctx context.Context, channel *domain.ChatChannel, message *domain.ChatMessage) error {
+	payload := map[string]interface{}{
+		"text":   slackFallbackText(message),
+		"blocks": s.buildBlocks(channel, message),
+	}
+
+	if channel.Mode != domain.ChatModeBot {
+		_, err := postChatJSON(ctx, s.client, channel.WebhookURL, nil, payload)
+		return err
+	}
+
+	// 봇 토큰: chat.postMessage (HTTP 200이어도 ok=false이면 실패)
+	payload["channel"] = channel.SlackChannel
+	body, err := postChatJSON(ctx, s.client, s.apiURL+"/chat.postMessage", map[string]string{
+		"Authorization": "Bearer " + channel.BotToken,
+	}, payload)
+	if err != nil {
+		return err
+	}
+
+	var resp slackAPIResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return fmt.Errorf("failed to decode slack response: %w", err)
+	}
+	if !resp.OK {
+		return fmt.Errorf("slack chat.postMessage failed: %s", resp.Error)
+	}
+	return nil
+}
+
+// ReplaceOriginal 인터랙션 response_url로 원본 메시지를 교체 (확인 후 버튼 제거)
+func (s *SlackService) ReplaceOriginal(ctx context.Context, responseURL string, message *domain.ChatMessage) error {
+	if !strings.HasPrefix(responseURL, "https://") {
+		return fmt.Errorf("invalid slack response_url")
+	}
+	_, err := postChatJSON(ctx, s.client, responseURL, nil, map[string]interface{}{
+		"replace_original": true,
+		"text":             slackFallbackText(message),
+		"blocks":           s.buildBlocks(nil, message),
+	})
+	return err
+}
+
+// buildBlocks Block Kit 블록 생성
+// channel이 nil이면 (응답 교체) 인터랙티브 버튼을 사용
+func (s *SlackService) buildBlocks(channel *domain.ChatChannel, message *domain.ChatMessage) []map[string]interface{} {
+	blocks := []map[string]interface{}{
+		{
+			"type": "header",
+			"text": map[string]interface{}{
+				"type":  "plain_text",
+				"text":  truncateChatText(message.Title, 150),
+				"emoji": true,
+			},
+		},
+		{
+			"type": "section",
+			"text": map[string]interface{}{
+				"type": "mrkdwn",
+				"text": truncateChatText(slackEscape(message.Message), 3000),
+			},
+		},
+	}
+
+	contextText := fmt.Sprintf("*%s* · %s", chatPriorityLabel(message.Priority), slackEscape(defaultChatValue(message.Category)))
+	if message.WorkspaceName != "" {
+		contextText += " · " + slackEscape(message.WorkspaceName)
+	}
+	if !message.CreatedAt.IsZero() {
+		contextText += fmt.Sprintf(" · <!date^%d^{date_short_pretty} {time}|%s>", message.CreatedAt.Unix(), message.CreatedAt.UTC().Format("2006-01-02 15:04 UTC"))
+	}
+	blocks = append(blocks, map[string]interface{}{
+		"type": "context",
+		"elements": []map[string]interface{}{
+			{"type": "mrkdwn", "text": contextText},
+		},
+	})
+
+	if message.AcknowledgedBy != "" {
+		blocks = append(blocks, map[string]interface{}{
+			"type": "context",
+			"elements": []map[string]interface{}{
+				{"type": "mrkdwn", "text": ":white_check_mark: Acknowledged by " + slackEscape(message.AcknowledgedBy)},
+			},
+		})
+		return blocks
+	}
+
+	button := map[string]interface{}{
+		"type":      "button",
+		"action_id": domain.SlackAckActionID,
+		"text": map[string]interface{}{
+			"type": "plain_text",
+			"text": "Acknowledge",
+		},
+		"style": "primary",
+	}
+	interactive := channel == nil || channel.SigningSecret != ""
+	switch {
+	case interactive && message.AckValue != "":
+		// Slack 앱 인터랙티비티로 콜백 (서명 검증)
+		button["value"] = message.AckValue
+	case message.AckURL != "":
+		// 앱 없이 받는 Incoming Webhook은 확인 페이지 링크로 대신
+		button["url"] = message.AckURL
+	default:
+		return blocks
+	}
+
+	return append(blocks, map[string]interface{}{
+		"type":     "actions",
+		"elements": []map[string]interface{}{button},
+	})
+}
+
+// slackFallbackText 알림 미리보기용 텍스트
+func slackFallbackText(message *domain.ChatMessage) string {
+	return fmt.Sprintf("[%s] %s", chatPriorityLabel(message.Priority), message.Title)
+}
+
+// slackEscape mrkdwn 제어 문자 이스케이프
+func slackEscape(text string) string {
+	replacer := strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	return replacer.Replace(text)
+}
@@ -0,0 +1,114 @@
+/**
+ * Microsoft Teams Notification Service
+ * Microsoft Teams 알림 전송 서비스 (Adaptive Card)
+ */
+
+package notification
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"skyclust/internal/domain"
+)
+
+type TeamsService struct {
+	client *http.Client
+}
+
+// NewTeamsService Teams 서비스 생성
+func NewTeamsService(client *http.Client) *TeamsService {
+	return &TeamsService{client: client}
+}
+
+// Send Teams 웹훅(Incoming Webhook 또는 Workflows)으로 Adaptive Card 전송
+func (s *TeamsService) Send(ctx context.Context, channel *domain.ChatChannel, message *domain.ChatMessage) error {
+	payload := map[string]interface{}{
+		"type": "message",
+		"attachments": []map[string]interface{}{
+			{
+				"contentType": "application/vnd.microsoft.card.adaptive",
+				"content":     s.buildCard(message),
+			},
+		},
+	}
+
+	_, err := postChatJSON(ctx, s.client, channel.WebhookURL, nil, payload)
+	return err
+}
+
+// buildCard Adaptive Card 1.4 생성
+func (s *TeamsService) buildCard(message *domain.ChatMessage) map[string]interface{} {
+	facts := []map[string]string{
+		{"title": "Priority", "value": chatPriorityLabel(message.Priority)},
+		{"title": "Category", "value": defaultChatValue(message.Category)},
+	}
+	if message.WorkspaceName != "" {
+		facts = append(facts, map[string]string{"title": "Workspace", "value": message.WorkspaceName})
+	}
+	if !message.CreatedAt.IsZero() {
+		facts = append(facts, map[string]string{"title": "Time", "value": message.CreatedAt.UTC().Format("2006-01-02 15:04 UTC")})
+	}
+
+	body := []map[string]interface{}{
+		{
+			"type":   "TextBlock",
+			"text":   message.Title,
+			"weight": "Bolder",
+			"size":   "Medium",
+			"color":  teamsColor(message),
+			"wrap":   true,
+		},
+		{
+			"type": "TextBlock",
+			"text": truncateChatText(message.Message, 4000),
+			"wrap": true,
+		},
+		{
+			"type":  "FactSet",
+			"facts": facts,
+		},
+	}
+
+	card := map[string]interface{}{
+		"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+		"type":    "AdaptiveCard",
+		"version": "1.4",
+		"msteams": map[string]string{"width": "Full"},
+		"body":    body,
+	}
+
+	switch {
+	case message.AcknowledgedBy != "":
+		card["body"] = append(body, map[string]interface{}{
+			"type":     "TextBlock",
+			"text":     fmt.Sprintf("Acknowledged by %s", message.AcknowledgedBy),
+			"isSubtle": true,
+			"wrap":     true,
+		})
+	case message.AckURL != "":
+		// 웹훅 카드는 Action.Submit 콜백을 받을 수 없으므로 확인 페이지를 연다
+		card["actions"] = []map[string]interface{}{
+			{
+				"type":  "Action.OpenUrl",
+				"title": "Acknowledge",
+				"url":   message.AckURL,
+			},
+		}
+	}
+	return card
+}
+
+// teamsColor Adaptive Card 텍스트 색상
+func teamsColor(message *domain.ChatMessage) string {
+	switch {
+	case message.Type == "error" || message.Priority == domain.NotificationPriorityUrgent:
+		return "Attention"
+	case message.Type == "warning" || message.Priority == domain.NotificationPriorityHigh:
+		return "Warning"
+	case message.Type == "success":
+		return "Good"
+	default:
+		return "Accent"
+	}
+}
@@ -6,18 +6,25 @@ import (
 	"skyclust/internal/application/handlers/admin"
 	"skyclust/internal/application/handlers/audit"
 	"skyclust/internal/application/handlers/auth"
+	"skyclust/internal/application/handlers/automation"
 	"skyclust/internal/application/handlers/common"
 	"skyclust/internal/application/handlers/cost_analysis"
 	"skyclust/internal/application/handlers/credential"
 	dashboard "skyclust/internal/application/handlers/dashboard"
+	"skyclust/internal/application/handlers/dead_letter"
 	"skyclust/internal/application/handlers/export"
 	"skyclust/internal/application/handlers/kubernetes"
 	"skyclust/internal/application/handlers/network"
 	"skyclust/internal/application/handlers/notification"
 	"skyclust/internal/application/handlers/oidc"
+	"skyclust/internal/application/handlers/operation"
+	"skyclust/internal/application/handlers/outbox"
+	"skyclust/internal/application/handlers/policy"
 	"skyclust/internal/application/handlers/rbac"
+	"skyclust/internal/application/handlers/scim"
 	"skyclust/internal/application/handlers/sse"
 	"skyclust/internal/application/handlers/system"
+	"skyclust/internal/application/handlers/webhook"
 	"skyclust/internal/application/handlers/workspace"
 	costanalysisservice "skyclust/internal/application/services/cost_analysis"
 	dashboardservice "skyclust/internal/application/services/dashboard"
@@ -25,6 +32,7 @@ import (
 	kubernetesservice "skyclust/internal/application/services/kubernetes"
 	networkservice "skyclust/internal/application/services/network"
 	"skyclust/internal/di"
+	"skyclust/internal/infrastructure/messaging"
 	"skyclust/pkg/config"
 	"skyclust/pkg/middleware"
 )
@@ -81,6 +89,9 @@ func (rm *RouteManager) setupPublicRoutes(router *gin.Engine) {
 		// OIDC routes (public)
 		oidcAuthGroup := v1Public.Group("/auth/oidc")
 		rm.setupOIDCRoutes(oidcAuthGroup)
+		// SAML 2.0 service provider routes (public)
+		samlAuthGroup := v1Public.Group("/auth/saml")
+		rm.setupSAMLRoutes(samlAuthGroup)
 		// OIDC providers (public - list available provider types)
 		oidcGroup := v1Public.Group("/oidc")
 		oidcProvidersGroup := oidcGroup.Group("/providers")
@@ -88,7 +99,13 @@ func (rm *RouteManager) setupPublicRoutes(router *gin.Engine) {
 		// System monitoring routes (no authentication required)
 		systemGroup := v1Public.Group("/system")
 		rm.setupSystemRoutes(systemGroup)
+		// Chat integration callbacks (authenticated by signed tokens and Slack signatures)
+		integrationsGroup := v1Public.Group("/integrations")
+		rm.setupChatCallbackRoutes(integrationsGroup)
 	}
+	// SCIM 2.0 provisioning routes (authenticated with a dedicated SCIM token)
+	scimGroup := router.Group(scim.BasePath)
+	rm.setupSCIMRoutes(scimGroup)
 }
 
 // setupProtectedRoutes sets up protected routes that require authentication
@@ -98,6 +115,8 @@ func (rm *RouteManager) setupProtectedRoutes(router *gin.Engine) {
 	v1Protected := router.Group("/api/" + apiVersion)
 	// Apply authentication middleware to all protected routes
 	v1Protected.Use(rm.middleware.AuthMiddleware())
+	// Inject the workspace authorizer so handlers can check permissions per workspace
+	v1Protected.Use(middleware.WorkspaceRBACMiddleware(rm.container.GetWorkspaceRBACService()))
 	{
 		// Authentication routes (protected) - logout and profile
 		authGroup := v1Protected.Group("/auth")
@@ -132,6 +151,9 @@ func (rm *RouteManager) setupProtectedRoutes(router *gin.Engine) {
 		// OIDC provider management routes (protected)
 		oidcProviderGroup := v1Protected.Group("/oidc")
 		rm.setupUserOIDCProviderRoutes(oidcProviderGroup)
+		// SAML session routes (protected)
+		samlSessionGroup := v1Protected.Group("/auth/saml")
+		rm.setupSAMLSessionRoutes(samlSessionGroup)
 	}
 }
 
@@ -140,9 +162,10 @@ func (rm *RouteManager) setupAdminRoutes(router *gin.Engine) {
 	// API v1 admin routes
 	apiVersion := common.CurrentAPIVersion()
 	v1Admin := router.Group("/api/" + apiVersion + "/admin")
-	// Apply admin middleware to all admin routes
+	// Apply authentication middleware; admin role is checked by each handler
 	// TODO: Implement AdminMiddleware
 	// v1Admin.Use(rm.middleware.AdminMiddleware())
+	v1Admin.Use(rm.middleware.AuthMiddleware())
 	{
 		// Admin user management routes
 		adminUsersGroup := v1Admin.Group("/users")
@@ -156,6 +179,24 @@ func (rm *RouteManager) setupAdminRoutes(router *gin.Engine) {
 		// RBAC management routes
 		rbacGroup := v1Admin.Group("/rbac")
 		rm.setupRBACRoutes(rbacGroup)
+		// Organization SSO provider routes
+		ssoProvidersGroup := v1Admin.Group("/sso-providers")
+		rm.setupSSOProviderRoutes(ssoProvidersGroup)
+		// SCIM token and group binding routes
+		scimAdminGroup := v1Admin.Group("/scim")
+		rm.setupSCIMAdminRoutes(scimAdminGroup)
+		// Credential encryption key management routes
+		credentialAdminGroup := v1Admin.Group("/credentials")
+		rm.setupCredentialAdminRoutes(credentialAdminGroup)
+		// Dead-lettered event management routes
+		deadLetterGroup := v1Admin.Group("/messaging/dead-letters")
+		rm.setupDeadLetterRoutes(deadLetterGroup)
+		// Outbox monitoring and requeue routes
+		outboxGroup := v1Admin.Group("/messaging/outbox")
+		rm.setupOutboxRoutes(outboxGroup)
+		// Notification template management routes
+		notificationTemplatesGroup := v1Admin.Group("/notification-templates")
+		rm.setupNotificationTemplateRoutes(notificationTemplatesGroup)
 	}
 }
 
@@ -199,6 +240,20 @@ func (rm *RouteManager) setupOIDCRoutes(router *gin.RouterGroup) {
 	}
 }
 
+// setupSAMLRoutes sets up SAML 2.0 service provider routes (public)
+func (rm *RouteManager) setupSAMLRoutes(router *gin.RouterGroup) {
+	if oidcService := rm.container.GetOIDCService(); oidcService != nil {
+		oidc.SetupSAMLRoutes(router, oidcService)
+	}
+}
+
+// setupSAMLSessionRoutes sets up SAML session routes (protected)
+func (rm *RouteManager) setupSAMLSessionRoutes(router *gin.RouterGroup) {
+	if oidcService := rm.container.GetOIDCService(); oidcService != nil {
+		oidc.SetupSAMLSessionRoutes(router, oidcService)
+	}
+}
+
 // setupOIDCProvidersRoutes sets up OIDC providers routes (public)
 func (rm *RouteManager) setupOIDCProvidersRoutes(router *gin.RouterGroup) {
 	if oidcService := rm.container.GetOIDCService(); oidcService != nil {
@@ -207,6 +262,34 @@ func (rm *RouteManager) setupOIDCProvidersRoutes(router *gin.RouterGroup) {
 	}
 }
 
+// setupSSOProviderRoutes sets up organization SSO provider management routes (admin)
+func (rm *RouteManager) setupSSOProviderRoutes(router *gin.RouterGroup) {
+	if oidcService := rm.container.GetOIDCService(); oidcService != nil {
+		oidc.SetupSSOProviderRoutes(router, oidcService)
+	}
+}
+
+// setupSCIMRoutes sets up SCIM 2.0 provisioning routes
+func (rm *RouteManager) setupSCIMRoutes(router *gin.RouterGroup) {
+	if scimService := rm.container.GetSCIMService(); scimService != nil {
+		scim.SetupRoutes(router, scimService)
+	}
+}
+
+// setupCredentialAdminRoutes sets up credential encryption key management routes (admin)
+func (rm *RouteManager) setupCredentialAdminRoutes(router *gin.RouterGroup) {
+	if credentialService := rm.container.GetCredentialService(); credentialService != nil {
+		credential.SetupAdminRoutes(router, credentialService)
+	}
+}
+
+// setupSCIMAdminRoutes sets up SCIM token and group binding routes (admin)
+func (rm *RouteManager) setupSCIMAdminRoutes(router *gin.RouterGroup) {
+	if scimService := rm.container.GetSCIMService(); scimService != nil {
+		scim.SetupAdminRoutes(router, scimService)
+	}
+}
+
 // setupUserOIDCProviderRoutes sets up user OIDC provider management routes (protected)
 func (rm *RouteManager) setupUserOIDCProviderRoutes(router *gin.RouterGroup) {
 	if oidcService := rm.container.GetOIDCService(); oidcService != nil {
@@ -236,9 +319,24 @@ func (rm *RouteManager) setupCredentialRoutes(router *gin.RouterGroup) {
 func (rm *RouteManager) setupWorkspaceRoutes(router *gin.RouterGroup) {
 	if workspaceService := rm.container.GetWorkspaceService(); workspaceService != nil {
 		if userService := rm.container.GetUserService(); userService != nil {
-			workspace.SetupRoutes(router, workspaceService, userService)
+			workspace.SetupRoutes(router, workspaceService, userService, rm.container.GetWorkspaceRBACService())
 		}
 	}
+	if policyService := rm.container.GetPolicyService(); policyService != nil {
+		policy.SetupRoutes(router, policyService)
+	}
+	if webhookService := rm.container.GetWebhookService(); webhookService != nil {
+		webhook.SetupRoutes(router, webhookService)
+	}
+	if automationService := rm.container.GetAutomationService(); automationService != nil {
+		automation.SetupRoutes(router, automationService)
+	}
+	if operationService := rm.container.GetOperationService(); operationService != nil {
+		operation.SetupRoutes(router, operationService)
+	}
+	if chatService := rm.container.GetChatChannelService(); chatService != nil {
+		notification.SetupChatChannelRoutes(router, chatService)
+	}
 }
 
 // setupProviderSpecificRoutes sets up provider-specific routes (RESTful)
@@ -384,6 +482,20 @@ func (rm *RouteManager) setupNotificationRoutes(router *gin.RouterGroup) {
 	}
 }
 
+// setupNotificationTemplateRoutes sets up notification template management routes (admin)
+func (rm *RouteManager) setupNotificationTemplateRoutes(router *gin.RouterGroup) {
+	if notificationService := rm.container.GetNotificationService(); notificationService != nil {
+		notification.SetupTemplateRoutes(router, notificationService)
+	}
+}
+
+// setupChatCallbackRoutes sets up public chat acknowledgement callback routes
+func (rm *RouteManager) setupChatCallbackRoutes(router *gin.RouterGroup) {
+	if chatService := rm.container.GetChatChannelService(); chatService != nil {
+		notification.SetupChatCallbackRoutes(router, chatService)
+	}
+}
+
 // setupExportRoutes sets up export routes
 func (rm *RouteManager) setupExportRoutes(router *gin.RouterGroup) {
 	exportHandler := export.NewHandler()
@@ -410,7 +522,7 @@ func (rm *RouteManager) setupAdminUserRoutes(router *gin.RouterGroup) {
 // setupAuditRoutes sets up audit log routes
 func (rm *RouteManager) setupAuditRoutes(router *gin.RouterGroup) {
 	if auditLogService := rm.container.GetAuditLogService(); auditLogService != nil {
-		audit.SetupRoutes(router, auditLogService)
+		audit.SetupRoutes(router, auditLogService, rm.container.GetCloudAuditService())
 	}
 }
 
@@ -423,7 +535,32 @@ func (rm *RouteManager) setupRBACRoutes(router *gin.RouterGroup) {
 
 // setupSSERoutes sets up SSE routes
 func (rm *RouteManager) setupSSERoutes(router *gin.RouterGroup) {
-	sse.SetupRoutes(router)
+	natsService, ok := rm.container.GetMessaging().(*messaging.NATSService)
+	if !ok || natsService == nil {
+		rm.logger.Warn("NATS is not available, SSE events endpoint is disabled")
+		sse.SetupRoutes(router, nil)
+		return
+	}
+
+	authorizer := sse.NewEventAuthorizer(rm.container.GetCredentialRepository(), rm.container.GetWorkspaceRBACService())
+	sse.SetupRoutes(router, sse.NewSSEHandler(rm.logger, natsService.Conn(), natsService, authorizer, rm.container.GetStreamEventRepository()))
+}
+
+// setupDeadLetterRoutes sets up dead-lettered event management routes (admin)
+func (rm *RouteManager) setupDeadLetterRoutes(router *gin.RouterGroup) {
+	deadLetterQueue, ok := rm.container.GetMessaging().(messaging.DeadLetterQueue)
+	if !ok || deadLetterQueue == nil {
+		rm.logger.Warn("NATS JetStream is not available, dead-letter endpoints are disabled")
+		return
+	}
+	dead_letter.SetupRoutes(router, deadLetterQueue)
+}
+
+// setupOutboxRoutes sets up outbox monitoring and requeue routes (admin)
+func (rm *RouteManager) setupOutboxRoutes(router *gin.RouterGroup) {
+	if outboxRepo := rm.container.GetOutboxRepository(); outboxRepo != nil {
+		outbox.SetupRoutes(router, outboxRepo)
+	}
 }
 
 // setupSystemRoutes sets up system monitoring routes
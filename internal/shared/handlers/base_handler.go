@@ -34,6 +34,7 @@ type BaseHandler struct {
 	auditLogger        *AuditLogger
 	validationRules    *validation.ValidationRules
 	errorBuilder       *responses.ErrorBuilder
+	workspaceResource  string
 }
 
 // NewBaseHandler creates a new base handler with common dependencies
@@ -112,9 +113,9 @@ func (h *BaseHandler) GetCredentialFromRequest(c *gin.Context, credentialService
 		return nil, err
 	}
 
-	// 5. Validate user has access to this credential (created by user)
-	if credential.CreatedBy != userID {
-		return nil, domain.NewDomainError(domain.ErrCodeForbidden, "access denied", 403)
+	// 5. Validate user may use this credential (and act on the resource) in its workspace
+	if err := h.authorizeCredentialUse(c, credential.WorkspaceID, userID); err != nil {
+		return nil, err
 	}
 
 	// 6. Validate workspace access using workspaceID from credential
@@ -181,9 +182,9 @@ func (h *BaseHandler) GetCredentialFromBody(c *gin.Context, credentialService do
 		return nil, err
 	}
 
-	// 5. Validate user has access to this credential (created by user)
-	if credential.CreatedBy != userID {
-		return nil, domain.NewDomainError(domain.ErrCodeForbidden, "access denied", 403)
+	// 5. Validate user may use this credential (and act on the resource) in its workspace
+	if err := h.authorizeCredentialUse(c, credential.WorkspaceID, userID); err != nil {
+		return nil, err
 	}
 
 	// 6. Validate workspace access using workspaceID from credential
@@ -204,6 +205,57 @@ func (h *BaseHandler) GetCredentialFromBody(c *gin.Context, credentialService do
 	return credential, nil
 }
 
+// WithWorkspaceResource sets the workspace permission resource (e.g. kubernetes, network)
+// checked by GetCredentialFromRequest/GetCredentialFromBody in addition to credential:use
+func (h *BaseHandler) WithWorkspaceResource(resource string) *BaseHandler {
+	h.workspaceResource = resource
+	return h
+}
+
+// RequireWorkspacePermission checks that the authenticated user holds the permission in the workspace
+// The authorizer is injected into the request context by WorkspaceRBACMiddleware
+func (h *BaseHandler) RequireWorkspacePermission(c *gin.Context, workspaceID uuid.UUID, permission domain.Permission) error {
+	userID, err := h.ExtractUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	return h.checkWorkspacePermission(c, workspaceID, userID, permission)
+}
+
+// RequireWorkspacePermissionFromString parses the workspace ID and checks the permission
+func (h *BaseHandler) RequireWorkspacePermissionFromString(c *gin.Context, workspaceID string, permission domain.Permission) error {
+	workspaceUUID, err := uuid.Parse(workspaceID)
+	if err != nil {
+		return domain.NewDomainError(domain.ErrCodeBadRequest, "invalid workspace ID format", 400)
+	}
+	return h.RequireWorkspacePermission(c, workspaceUUID, permission)
+}
+
+// checkWorkspacePermission delegates the permission check to the workspace authorizer in context
+func (h *BaseHandler) checkWorkspacePermission(c *gin.Context, workspaceID, userID uuid.UUID, permission domain.Permission) error {
+	value, exists := c.Get(domain.WorkspaceAuthorizerContextKey)
+	if !exists {
+		// Fail closed: routes without workspace authorization must not reach workspace resources
+		return domain.NewDomainError(domain.ErrCodeInternalError, "workspace authorization is not configured", 500)
+	}
+	authorizer, ok := value.(domain.WorkspaceAuthorizer)
+	if !ok {
+		return domain.NewDomainError(domain.ErrCodeInternalError, "workspace authorization is not configured", 500)
+	}
+	return authorizer.CheckWorkspacePermission(c.Request.Context(), workspaceID, userID, permission)
+}
+
+// authorizeCredentialUse checks credential:use and, when configured, the resource action permission
+func (h *BaseHandler) authorizeCredentialUse(c *gin.Context, workspaceID, userID uuid.UUID) error {
+	if err := h.checkWorkspacePermission(c, workspaceID, userID, domain.CredentialUse); err != nil {
+		return err
+	}
+	if h.workspaceResource == "" {
+		return nil
+	}
+	return h.checkWorkspacePermission(c, workspaceID, userID, domain.WorkspaceActionPermission(h.workspaceResource, c.Request.Method))
+}
+
 // ValidateRequest validates the request body against the provided struct with enhanced error handling
 func (h *BaseHandler) ValidateRequest(c *gin.Context, req interface{}) error {
 	if err := c.ShouldBindJSON(req); err != nil {
@@ -519,6 +571,10 @@ func (h *BaseHandler) EnrichContextWithRequestMetadata(c *gin.Context) context.C
 	userAgent := c.GetHeader("User-Agent")
 	ctx = context.WithValue(ctx, contextKeyClientIP, clientIP)
 	ctx = context.WithValue(ctx, contextKeyUserAgent, userAgent)
+	// Carry the authenticated user so services can evaluate workspace policies for the subject
+	if userID, err := h.ExtractUserIDFromContext(c); err == nil {
+		ctx = domain.ContextWithSubject(ctx, userID)
+	}
 	return ctx
 }
 
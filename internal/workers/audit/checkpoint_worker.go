@@ -0,0 +1,116 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"skyclust/internal/domain"
+)
+
+// CheckpointWorker periodically signs the head of the current audit log segments
+type CheckpointWorker struct {
+	auditLogService domain.AuditLogService
+	logger          *zap.Logger
+
+	// Worker configuration
+	interval time.Duration
+	running  bool
+	mu       sync.RWMutex
+	stopCh   chan struct{}
+}
+
+// CheckpointWorkerConfig holds configuration for the checkpoint worker
+type CheckpointWorkerConfig struct {
+	Interval time.Duration // how often a checkpoint is written for segments with new entries
+}
+
+// NewCheckpointWorker creates a new audit checkpoint worker
+func NewCheckpointWorker(
+	auditLogService domain.AuditLogService,
+	logger *zap.Logger,
+	config CheckpointWorkerConfig,
+) *CheckpointWorker {
+	if config.Interval == 0 {
+		config.Interval = 15 * time.Minute
+	}
+
+	return &CheckpointWorker{
+		auditLogService: auditLogService,
+		logger:          logger,
+		interval:        config.Interval,
+		stopCh:          make(chan struct{}),
+	}
+}
+
+// Start starts the checkpoint worker
+func (w *CheckpointWorker) Start(ctx context.Context) error {
+	w.mu.Lock()
+	if w.running {
+		w.mu.Unlock()
+		return fmt.Errorf("audit checkpoint worker is already running")
+	}
+	w.running = true
+	w.mu.Unlock()
+
+	w.logger.Info("Starting audit checkpoint worker",
+		zap.Duration("interval", w.interval))
+
+	go w.checkpointLoop(ctx)
+
+	return nil
+}
+
+// Stop stops the checkpoint worker
+func (w *CheckpointWorker) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.running {
+		return
+	}
+
+	w.running = false
+	close(w.stopCh)
+
+	w.logger.Info("Stopped audit checkpoint worker")
+}
+
+// checkpointLoop runs the main checkpoint loop
+func (w *CheckpointWorker) checkpointLoop(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	// Initial checkpoint
+	w.createCheckpoints()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.createCheckpoints()
+		case <-w.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// createCheckpoints writes checkpoints for segments that changed since their last checkpoint
+func (w *CheckpointWorker) createCheckpoints() {
+	checkpoints, err := w.auditLogService.CreateCheckpoints()
+	if err != nil {
+		w.logger.Error("Failed to create audit checkpoints", zap.Error(err))
+		return
+	}
+
+	for _, checkpoint := range checkpoints {
+		w.logger.Debug("Created audit checkpoint",
+			zap.Int64("number", checkpoint.Number),
+			zap.String("segment", checkpoint.Segment),
+			zap.Int64("sequence", checkpoint.Sequence))
+	}
+}
@@ -0,0 +1,266 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/google/uuid"
+
+	cloudaudit "skyclust/internal/application/services/cloud_audit"
+	"skyclust/internal/domain"
+)
+
+// maxOutOfBandListed limits how many changes are spelled out in one notification message
+const maxOutOfBandListed = 5
+
+// CloudIngestWorker periodically ingests CloudTrail and GCP Admin Activity events for every active credential
+// and notifies workspace admins when resources managed by SkyClust were changed outside of SkyClust
+type CloudIngestWorker struct {
+	cloudAuditService   domain.CloudAuditService
+	credentialRepo      domain.CredentialRepository
+	workspaceRepo       domain.WorkspaceRepository
+	notificationService domain.NotificationService
+	logger              *zap.Logger
+
+	// Worker configuration
+	interval       time.Duration
+	maxConcurrency int
+	running        bool
+	mu             sync.RWMutex
+	stopCh         chan struct{}
+}
+
+// CloudIngestWorkerConfig holds configuration for the cloud ingest worker
+type CloudIngestWorkerConfig struct {
+	Interval       time.Duration // how often provider audit logs are pulled
+	MaxConcurrency int
+}
+
+// NewCloudIngestWorker creates a new cloud audit ingest worker
+func NewCloudIngestWorker(
+	cloudAuditService domain.CloudAuditService,
+	credentialRepo domain.CredentialRepository,
+	workspaceRepo domain.WorkspaceRepository,
+	notificationService domain.NotificationService,
+	logger *zap.Logger,
+	config CloudIngestWorkerConfig,
+) *CloudIngestWorker {
+	if config.Interval == 0 {
+		config.Interval = 15 * time.Minute
+	}
+	if config.MaxConcurrency == 0 {
+		config.MaxConcurrency = 3
+	}
+
+	return &CloudIngestWorker{
+		cloudAuditService:   cloudAuditService,
+		credentialRepo:      credentialRepo,
+		workspaceRepo:       workspaceRepo,
+		notificationService: notificationService,
+		logger:              logger,
+		interval:            config.Interval,
+		maxConcurrency:      config.MaxConcurrency,
+		stopCh:              make(chan struct{}),
+	}
+}
+
+// Start starts the cloud ingest worker
+func (w *CloudIngestWorker) Start(ctx context.Context) error {
+	w.mu.Lock()
+	if w.running {
+		w.mu.Unlock()
+		return fmt.Errorf("cloud audit ingest worker is already running")
+	}
+	w.running = true
+	w.mu.Unlock()
+
+	w.logger.Info("Starting cloud audit ingest worker",
+		zap.Duration("interval", w.interval),
+		zap.Int("max_concurrency", w.maxConcurrency))
+
+	go w.ingestLoop(ctx)
+
+	return nil
+}
+
+// Stop stops the cloud ingest worker
+func (w *CloudIngestWorker) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.running {
+		return
+	}
+
+	w.running = false
+	close(w.stopCh)
+
+	w.logger.Info("Stopped cloud audit ingest worker")
+}
+
+// ingestLoop runs the main ingestion loop
+func (w *CloudIngestWorker) ingestLoop(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	// Initial ingestion
+	w.ingestAll(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			w.ingestAll(ctx)
+		case <-w.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// ingestAll ingests provider audit logs for every active AWS and GCP credential
+func (w *CloudIngestWorker) ingestAll(ctx context.Context) {
+	credentials, err := w.credentialRepo.ListActiveByProviders(cloudaudit.SupportedProviders)
+	if err != nil {
+		w.logger.Error("Failed to list credentials for cloud audit ingestion", zap.Error(err))
+		return
+	}
+	if len(credentials) == 0 {
+		return
+	}
+
+	w.logger.Debug("Ingesting cloud audit logs", zap.Int("credentials", len(credentials)))
+
+	semaphore := make(chan struct{}, w.maxConcurrency)
+	var wg sync.WaitGroup
+	for _, credential := range credentials {
+		select {
+		case <-w.stopCh:
+			wg.Wait()
+			return
+		default:
+		}
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(credential *domain.Credential) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			w.ingestCredential(ctx, credential)
+		}(credential)
+	}
+	wg.Wait()
+}
+
+// ingestCredential ingests a single credential and reports out-of-band changes
+func (w *CloudIngestWorker) ingestCredential(ctx context.Context, credential *domain.Credential) {
+	result, err := w.cloudAuditService.IngestCredential(ctx, credential)
+	if err != nil {
+		w.logger.Warn("Failed to ingest cloud audit logs",
+			zap.String("credential_id", credential.ID.String()),
+			zap.String("provider", credential.Provider),
+			zap.Error(err))
+		return
+	}
+
+	if result.Ingested > 0 {
+		w.logger.Debug("Ingested cloud audit logs",
+			zap.String("credential_id", credential.ID.String()),
+			zap.Int("ingested", result.Ingested),
+			zap.Int("out_of_band", len(result.OutOfBand)))
+	}
+
+	if len(result.OutOfBand) > 0 {
+		w.logger.Warn("Detected out-of-band changes to managed resources",
+			zap.String("credential_id", credential.ID.String()),
+			zap.String("provider", credential.Provider),
+			zap.Int("count", len(result.OutOfBand)))
+		w.notifyOutOfBand(ctx, credential, result.OutOfBand)
+	}
+}
+
+// notifyOutOfBand sends one notification per run summarizing out-of-band changes to workspace admins
+func (w *CloudIngestWorker) notifyOutOfBand(ctx context.Context, credential *domain.Credential, changes []domain.CloudOutOfBandChange) {
+	if w.notificationService == nil {
+		return
+	}
+
+	adminIDs, err := w.workspaceAdminIDs(ctx, credential.WorkspaceID.String())
+	if err != nil {
+		w.logger.Warn("Failed to resolve workspace admins for out-of-band notification",
+			zap.String("workspace_id", credential.WorkspaceID.String()),
+			zap.Error(err))
+		return
+	}
+	if len(adminIDs) == 0 {
+		return
+	}
+
+	lines := make([]string, 0, maxOutOfBandListed+1)
+	for i, change := range changes {
+		if i == maxOutOfBandListed {
+			lines = append(lines, fmt.Sprintf("... and %d more", len(changes)-maxOutOfBandListed))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("%s on %s by %s", change.EventName, strings.Join(change.Resources, ", "), change.Principal))
+	}
+
+	data, _ := json.Marshal(map[string]interface{}{
+		"credential_id": credential.ID,
+		"workspace_id":  credential.WorkspaceID,
+		"provider":      credential.Provider,
+		"changes":       changes,
+	})
+
+	notification := &domain.Notification{
+		ID:          uuid.New().String(),
+		WorkspaceID: credential.WorkspaceID.String(),
+		Type:        "warning",
+		Title:       fmt.Sprintf("%d out-of-band change(s) detected on %s", len(changes), credential.Name),
+		Message:     fmt.Sprintf("Resources managed by SkyClust were changed outside of SkyClust using %s credential %q: %s", credential.Provider, credential.Name, strings.Join(lines, "; ")),
+		Category:    "security",
+		Priority:    "high",
+		Data:        string(data),
+		CreatedAt:   time.Now(),
+	}
+
+	if err := w.notificationService.SendBulkNotification(ctx, adminIDs, notification); err != nil {
+		w.logger.Warn("Failed to send out-of-band notification",
+			zap.String("credential_id", credential.ID.String()),
+			zap.Error(err))
+	}
+}
+
+// workspaceAdminIDs returns the owner and admin member IDs of a workspace
+func (w *CloudIngestWorker) workspaceAdminIDs(ctx context.Context, workspaceID string) ([]string, error) {
+	workspace, err := w.workspaceRepo.GetByID(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var adminIDs []string
+	if workspace != nil && workspace.OwnerID != "" {
+		seen[workspace.OwnerID] = true
+		adminIDs = append(adminIDs, workspace.OwnerID)
+	}
+
+	members, err := w.workspaceRepo.GetWorkspaceMembersWithRoles(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if member.Role == domain.WorkspaceRoleAdmin && !seen[member.UserID] {
+			seen[member.UserID] = true
+			adminIDs = append(adminIDs, member.UserID)
+		}
+	}
+
+	return adminIDs, nil
+}
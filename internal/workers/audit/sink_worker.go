@@ -0,0 +1,112 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"skyclust/internal/application/services/audit_log/sink"
+)
+
+// SinkWorker delivers audit log entries queued in the outbox to the configured SIEM sinks
+type SinkWorker struct {
+	dispatcher *sink.Dispatcher
+	logger     *zap.Logger
+
+	// Worker configuration
+	interval time.Duration
+	running  bool
+	mu       sync.RWMutex
+	stopCh   chan struct{}
+}
+
+// SinkWorkerConfig holds configuration for the sink worker
+type SinkWorkerConfig struct {
+	Interval time.Duration // how often pending entries are polled
+}
+
+// NewSinkWorker creates a new audit sink worker
+func NewSinkWorker(
+	dispatcher *sink.Dispatcher,
+	logger *zap.Logger,
+	config SinkWorkerConfig,
+) *SinkWorker {
+	if config.Interval == 0 {
+		config.Interval = 5 * time.Second
+	}
+
+	return &SinkWorker{
+		dispatcher: dispatcher,
+		logger:     logger,
+		interval:   config.Interval,
+		stopCh:     make(chan struct{}),
+	}
+}
+
+// Start starts the sink worker
+func (w *SinkWorker) Start(ctx context.Context) error {
+	w.mu.Lock()
+	if w.running {
+		w.mu.Unlock()
+		return fmt.Errorf("audit sink worker is already running")
+	}
+	w.running = true
+	w.mu.Unlock()
+
+	w.logger.Info("Starting audit sink worker",
+		zap.Duration("interval", w.interval))
+
+	go w.deliveryLoop(ctx)
+
+	return nil
+}
+
+// Stop stops the sink worker and closes sink connections
+func (w *SinkWorker) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.running {
+		return
+	}
+
+	w.running = false
+	close(w.stopCh)
+	w.dispatcher.Close()
+
+	w.logger.Info("Stopped audit sink worker")
+}
+
+// deliveryLoop runs the main delivery loop
+func (w *SinkWorker) deliveryLoop(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.deliver(ctx)
+
+		select {
+		case <-ticker.C:
+		case <-w.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// deliver drains pending batches until every sink is caught up or backing off
+func (w *SinkWorker) deliver(ctx context.Context) {
+	for w.dispatcher.Dispatch(ctx) {
+		select {
+		case <-w.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		default:
+		}
+	}
+}
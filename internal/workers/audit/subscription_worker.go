@@ -0,0 +1,178 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/google/uuid"
+
+	"skyclust/internal/domain"
+)
+
+// SubscriptionWorker periodically re-runs subscribed saved audit queries and notifies subscribers of new matches
+type SubscriptionWorker struct {
+	auditLogService     domain.AuditLogService
+	notificationService domain.NotificationService
+	rbacService         domain.RBACService
+	logger              *zap.Logger
+
+	// Worker configuration
+	interval time.Duration
+	running  bool
+	mu       sync.RWMutex
+	stopCh   chan struct{}
+}
+
+// SubscriptionWorkerConfig holds configuration for the subscription worker
+type SubscriptionWorkerConfig struct {
+	Interval time.Duration // how often subscribed queries are evaluated
+}
+
+// NewSubscriptionWorker creates a new audit query subscription worker
+func NewSubscriptionWorker(
+	auditLogService domain.AuditLogService,
+	notificationService domain.NotificationService,
+	rbacService domain.RBACService,
+	logger *zap.Logger,
+	config SubscriptionWorkerConfig,
+) *SubscriptionWorker {
+	if config.Interval == 0 {
+		config.Interval = time.Minute
+	}
+
+	return &SubscriptionWorker{
+		auditLogService:     auditLogService,
+		notificationService: notificationService,
+		rbacService:         rbacService,
+		logger:              logger,
+		interval:            config.Interval,
+		stopCh:              make(chan struct{}),
+	}
+}
+
+// Start starts the subscription worker
+func (w *SubscriptionWorker) Start(ctx context.Context) error {
+	w.mu.Lock()
+	if w.running {
+		w.mu.Unlock()
+		return fmt.Errorf("audit subscription worker is already running")
+	}
+	w.running = true
+	w.mu.Unlock()
+
+	w.logger.Info("Starting audit subscription worker",
+		zap.Duration("interval", w.interval))
+
+	go w.evaluateLoop(ctx)
+
+	return nil
+}
+
+// Stop stops the subscription worker
+func (w *SubscriptionWorker) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.running {
+		return
+	}
+
+	w.running = false
+	close(w.stopCh)
+
+	w.logger.Info("Stopped audit subscription worker")
+}
+
+// evaluateLoop runs the main evaluation loop
+func (w *SubscriptionWorker) evaluateLoop(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.evaluate(ctx)
+		case <-w.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// evaluate checks every subscription once and sends a notification per subscription with new matches
+func (w *SubscriptionWorker) evaluate(ctx context.Context) {
+	matches, err := w.auditLogService.EvaluateSubscriptions()
+	if err != nil {
+		w.logger.Error("Failed to evaluate audit query subscriptions", zap.Error(err))
+		return
+	}
+
+	for _, match := range matches {
+		w.notify(ctx, match)
+	}
+}
+
+// notify sends a summary of new matches to the subscriber
+func (w *SubscriptionWorker) notify(ctx context.Context, match *domain.AuditSubscriptionMatch) {
+	if w.notificationService == nil {
+		return
+	}
+
+	subscription := match.Subscription
+	saved := subscription.SavedQuery
+
+	// Matches span every workspace, so only subscribers who are still admins receive them
+	isAdmin, err := w.rbacService.HasRole(subscription.UserID, domain.AdminRoleType)
+	if err != nil || !isAdmin {
+		w.logger.Warn("Skipping audit query subscription of a non-admin subscriber",
+			zap.String("subscription_id", subscription.ID.String()),
+			zap.String("user_id", subscription.UserID.String()),
+			zap.Error(err))
+		return
+	}
+
+	actions := make([]string, 0, len(match.Logs))
+	logIDs := make([]uuid.UUID, 0, len(match.Logs))
+	for _, log := range match.Logs {
+		actions = append(actions, log.Action)
+		logIDs = append(logIDs, log.ID)
+	}
+	summary := strings.Join(actions, ", ")
+	if match.Total > int64(len(match.Logs)) {
+		summary = fmt.Sprintf("%s ... and %d more", summary, match.Total-int64(len(match.Logs)))
+	}
+
+	data, _ := json.Marshal(map[string]interface{}{
+		"saved_query_id": saved.ID,
+		"query":          saved.Query,
+		"from":           match.From,
+		"to":             match.To,
+		"total":          match.Total,
+		"audit_log_ids":  logIDs,
+	})
+
+	notification := &domain.Notification{
+		ID:        uuid.New().String(),
+		UserID:    subscription.UserID.String(),
+		Type:      "info",
+		Title:     fmt.Sprintf("%d new audit log(s) match %q", match.Total, saved.Name),
+		Message:   fmt.Sprintf("New audit log entries match saved query %q (%s): %s", saved.Name, saved.Query, summary),
+		Category:  "security",
+		Priority:  subscription.Priority,
+		Data:      string(data),
+		CreatedAt: time.Now(),
+	}
+
+	if err := w.notificationService.SendNotification(ctx, subscription.UserID.String(), notification); err != nil {
+		w.logger.Warn("Failed to send audit query subscription notification",
+			zap.String("subscription_id", subscription.ID.String()),
+			zap.Error(err))
+	}
+}
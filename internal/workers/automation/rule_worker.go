@@ -0,0 +1,156 @@
+package automation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"skyclust/internal/domain"
+	"skyclust/internal/infrastructure/messaging"
+)
+
+// durableConsumer is the JetStream consumer that evaluates automation rules once across all replicas
+const durableConsumer = "automation"
+
+// RuleWorker evaluates workspace automation rules for every platform event
+// and purges execution history past the retention period
+type RuleWorker struct {
+	automationService domain.AutomationService
+	eventBus          messaging.Bus
+	logger            *zap.Logger
+
+	// Worker configuration
+	retention time.Duration
+	running   bool
+	mu        sync.RWMutex
+	stopCh    chan struct{}
+}
+
+// RuleWorkerConfig holds configuration for the rule worker
+type RuleWorkerConfig struct {
+	Retention time.Duration // how long execution history is kept
+}
+
+// NewRuleWorker creates a new automation rule worker
+func NewRuleWorker(
+	automationService domain.AutomationService,
+	eventBus messaging.Bus,
+	logger *zap.Logger,
+	config RuleWorkerConfig,
+) *RuleWorker {
+	if config.Retention == 0 {
+		config.Retention = 30 * 24 * time.Hour
+	}
+
+	return &RuleWorker{
+		automationService: automationService,
+		eventBus:          eventBus,
+		logger:            logger,
+		retention:         config.Retention,
+		stopCh:            make(chan struct{}),
+	}
+}
+
+// Start subscribes to all platform events and starts the purge loop
+func (w *RuleWorker) Start(ctx context.Context) error {
+	w.mu.Lock()
+	if w.running {
+		w.mu.Unlock()
+		return fmt.Errorf("automation rule worker is already running")
+	}
+	w.running = true
+	w.mu.Unlock()
+
+	// With JetStream each event is evaluated once across replicas and retried if evaluation fails
+	var err error
+	if durableBus, ok := w.eventBus.(messaging.DurableBus); ok {
+		err = durableBus.SubscribeDurable(durableConsumer, ">", w)
+	} else {
+		err = w.eventBus.Subscribe(">", w)
+	}
+	if err != nil {
+		w.mu.Lock()
+		w.running = false
+		w.mu.Unlock()
+		return fmt.Errorf("failed to subscribe automation rule worker to events: %w", err)
+	}
+
+	w.logger.Info("Starting automation rule worker",
+		zap.Duration("retention", w.retention))
+
+	go w.purgeLoop(ctx)
+
+	return nil
+}
+
+// Stop stops the rule worker
+func (w *RuleWorker) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.running {
+		return
+	}
+
+	w.running = false
+	close(w.stopCh)
+
+	w.logger.Info("Stopped automation rule worker")
+}
+
+// Handle evaluates the automation rules for an event (implements messaging.EventHandler)
+func (w *RuleWorker) Handle(ctx context.Context, event messaging.Event) error {
+	// LocalBus passes the publisher's request context, which is canceled once the response is written
+	started, err := w.automationService.HandleEvent(context.WithoutCancel(ctx), domain.AutomationEvent{
+		Type:        event.Type,
+		WorkspaceID: event.WorkspaceID,
+		UserID:      event.UserID,
+		Data:        event.Data,
+		Timestamp:   event.Timestamp,
+	})
+	if err != nil {
+		w.logger.Warn("Failed to evaluate automation rules",
+			zap.String("event_type", event.Type),
+			zap.Error(err))
+		return err
+	}
+
+	if started > 0 {
+		w.logger.Debug("Started automation rule executions",
+			zap.String("event_type", event.Type),
+			zap.Int("count", started))
+	}
+	return nil
+}
+
+// purgeLoop removes old execution history once an hour
+func (w *RuleWorker) purgeLoop(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.purge(ctx)
+		case <-w.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// purge removes executions older than the retention period
+func (w *RuleWorker) purge(ctx context.Context) {
+	deleted, err := w.automationService.PurgeExecutions(ctx, w.retention)
+	if err != nil {
+		w.logger.Warn("Failed to purge old automation executions", zap.Error(err))
+		return
+	}
+	if deleted > 0 {
+		w.logger.Info("Purged old automation executions", zap.Int64("deleted", deleted))
+	}
+}
@@ -0,0 +1,287 @@
+package credential
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/google/uuid"
+
+	"skyclust/internal/domain"
+)
+
+// HealthWorker periodically verifies credentials against provider APIs and notifies workspace credential managers about failures
+type HealthWorker struct {
+	credentialService    domain.CredentialService
+	credentialRepo       domain.CredentialRepository
+	workspaceRepo        domain.WorkspaceRepository
+	workspaceRBACService domain.WorkspaceRBACService
+	notificationService  domain.NotificationService
+	logger               *zap.Logger
+
+	// Worker configuration
+	checkInterval  time.Duration
+	verifyInterval time.Duration
+	batchSize      int
+	maxConcurrency int
+	running        bool
+	mu             sync.RWMutex
+	stopCh         chan struct{}
+}
+
+// HealthWorkerConfig holds configuration for the health worker
+type HealthWorkerConfig struct {
+	CheckInterval  time.Duration // how often the worker looks for credentials due for verification
+	VerifyInterval time.Duration // minimum time between two verifications of the same credential
+	BatchSize      int
+	MaxConcurrency int
+}
+
+// NewHealthWorker creates a new credential health worker
+func NewHealthWorker(
+	credentialService domain.CredentialService,
+	credentialRepo domain.CredentialRepository,
+	workspaceRepo domain.WorkspaceRepository,
+	workspaceRBACService domain.WorkspaceRBACService,
+	notificationService domain.NotificationService,
+	logger *zap.Logger,
+	config HealthWorkerConfig,
+) *HealthWorker {
+	if config.CheckInterval == 0 {
+		config.CheckInterval = 10 * time.Minute
+	}
+	if config.VerifyInterval == 0 {
+		config.VerifyInterval = 6 * time.Hour
+	}
+	if config.BatchSize == 0 {
+		config.BatchSize = 100
+	}
+	if config.MaxConcurrency == 0 {
+		config.MaxConcurrency = 5
+	}
+
+	return &HealthWorker{
+		credentialService:    credentialService,
+		credentialRepo:       credentialRepo,
+		workspaceRepo:        workspaceRepo,
+		workspaceRBACService: workspaceRBACService,
+		notificationService:  notificationService,
+		logger:               logger,
+		checkInterval:        config.CheckInterval,
+		verifyInterval:       config.VerifyInterval,
+		batchSize:            config.BatchSize,
+		maxConcurrency:       config.MaxConcurrency,
+		stopCh:               make(chan struct{}),
+	}
+}
+
+// Start starts the health worker
+func (w *HealthWorker) Start(ctx context.Context) error {
+	w.mu.Lock()
+	if w.running {
+		w.mu.Unlock()
+		return fmt.Errorf("credential health worker is already running")
+	}
+	w.running = true
+	w.mu.Unlock()
+
+	w.logger.Info("Starting credential health worker",
+		zap.Duration("check_interval", w.checkInterval),
+		zap.Duration("verify_interval", w.verifyInterval),
+		zap.Int("max_concurrency", w.maxConcurrency))
+
+	go w.checkLoop(ctx)
+
+	return nil
+}
+
+// Stop stops the health worker
+func (w *HealthWorker) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.running {
+		return
+	}
+
+	w.running = false
+	close(w.stopCh)
+
+	w.logger.Info("Stopped credential health worker")
+}
+
+// checkLoop runs the main verification loop
+func (w *HealthWorker) checkLoop(ctx context.Context) {
+	ticker := time.NewTicker(w.checkInterval)
+	defer ticker.Stop()
+
+	// Initial check
+	w.checkDueCredentials(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			w.checkDueCredentials(ctx)
+		case <-w.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// checkDueCredentials verifies one batch of credentials whose last verification is older than the verify interval
+func (w *HealthWorker) checkDueCredentials(ctx context.Context) {
+	credentials, err := w.credentialRepo.ListDueForVerification(time.Now().Add(-w.verifyInterval), w.batchSize)
+	if err != nil {
+		w.logger.Error("Failed to list credentials due for verification", zap.Error(err))
+		return
+	}
+	if len(credentials) == 0 {
+		return
+	}
+
+	w.logger.Debug("Verifying credentials", zap.Int("count", len(credentials)))
+
+	semaphore := make(chan struct{}, w.maxConcurrency)
+	var wg sync.WaitGroup
+	for _, credential := range credentials {
+		select {
+		case <-w.stopCh:
+			wg.Wait()
+			return
+		default:
+		}
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(credential *domain.Credential) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			w.checkCredential(ctx, credential)
+		}(credential)
+	}
+	wg.Wait()
+}
+
+// checkCredential verifies a single credential and sends notifications on state changes
+func (w *HealthWorker) checkCredential(ctx context.Context, credential *domain.Credential) {
+	health, err := w.credentialService.CheckCredentialHealth(ctx, credential)
+	if err != nil {
+		w.logger.Warn("Failed to check credential health",
+			zap.String("credential_id", credential.ID.String()),
+			zap.Error(err))
+		return
+	}
+
+	if health.Failed() {
+		w.logger.Warn("Credential verification failed",
+			zap.String("credential_id", credential.ID.String()),
+			zap.String("provider", credential.Provider),
+			zap.String("message", health.Message))
+		w.notifyCredentialManagers(ctx, credential, health, &domain.Notification{
+			Type:     "error",
+			Title:    fmt.Sprintf("Credential %q failed verification", credential.Name),
+			Message:  fmt.Sprintf("The %s credential %q can no longer authenticate: %s", credential.Provider, credential.Name, health.Message),
+			Category: "security",
+			Priority: "high",
+		})
+	}
+
+	if health.RotationBecameDue() {
+		ageDays := int(health.VerifiedAt.Sub(*health.KeyCreatedAt).Hours() / 24)
+		w.notifyCredentialManagers(ctx, credential, health, &domain.Notification{
+			Type:     "warning",
+			Title:    fmt.Sprintf("Credential %q needs key rotation", credential.Name),
+			Message:  fmt.Sprintf("The access key of %s credential %q is %d days old. Rotate it and update the credential.", credential.Provider, credential.Name, ageDays),
+			Category: "security",
+			Priority: "medium",
+		})
+	}
+}
+
+// notifyCredentialManagers sends a notification to the workspace members who can manage credentials
+func (w *HealthWorker) notifyCredentialManagers(ctx context.Context, credential *domain.Credential, health *domain.CredentialHealth, notification *domain.Notification) {
+	if w.notificationService == nil {
+		return
+	}
+
+	recipientIDs, err := w.credentialManagerIDs(ctx, credential.WorkspaceID)
+	if err != nil {
+		w.logger.Warn("Failed to resolve credential managers for credential notification",
+			zap.String("workspace_id", credential.WorkspaceID.String()),
+			zap.Error(err))
+		return
+	}
+	if len(recipientIDs) == 0 {
+		return
+	}
+
+	data, _ := json.Marshal(map[string]interface{}{
+		"credential_id":   credential.ID,
+		"workspace_id":    credential.WorkspaceID,
+		"provider":        credential.Provider,
+		"health_status":   health.Status,
+		"permission_gaps": health.PermissionGaps,
+		"key_created_at":  health.KeyCreatedAt,
+	})
+	notification.ID = uuid.New().String()
+	notification.WorkspaceID = credential.WorkspaceID.String()
+	notification.Data = string(data)
+	notification.CreatedAt = time.Now()
+
+	if err := w.notificationService.SendBulkNotification(ctx, recipientIDs, notification); err != nil {
+		w.logger.Warn("Failed to send credential notification",
+			zap.String("credential_id", credential.ID.String()),
+			zap.Error(err))
+	}
+}
+
+// credentialManagerIDs returns the IDs of the owner and members holding credential:write in a workspace.
+// Recipients are selected by effective permission so custom roles that manage credentials are included.
+func (w *HealthWorker) credentialManagerIDs(ctx context.Context, workspaceID uuid.UUID) ([]string, error) {
+	workspace, err := w.workspaceRepo.GetByID(ctx, workspaceID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var recipientIDs []string
+	if workspace != nil && workspace.OwnerID != "" {
+		seen[workspace.OwnerID] = true
+		recipientIDs = append(recipientIDs, workspace.OwnerID)
+	}
+
+	members, err := w.workspaceRepo.GetWorkspaceMembersWithRoles(ctx, workspaceID.String())
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if seen[member.UserID] {
+			continue
+		}
+		seen[member.UserID] = true
+
+		userID, err := uuid.Parse(member.UserID)
+		if err != nil {
+			continue
+		}
+		access, err := w.workspaceRBACService.GetWorkspaceAccess(ctx, workspaceID, userID)
+		if err != nil {
+			w.logger.Warn("Failed to resolve workspace access for credential notification",
+				zap.String("workspace_id", workspaceID.String()),
+				zap.String("user_id", member.UserID),
+				zap.Error(err))
+			continue
+		}
+		if access.Has(domain.CredentialWrite) {
+			recipientIDs = append(recipientIDs, member.UserID)
+		}
+	}
+
+	return recipientIDs, nil
+}
@@ -0,0 +1,176 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"skyclust/internal/domain"
+)
+
+// DeliveryWorker runs the scheduled part of the notification delivery pipeline:
+// it releases notifications held during quiet hours, escalates unacknowledged urgent
+// notifications and sends low priority digests. Rows are claimed with row locks, so
+// the worker is safe to run on every replica.
+type DeliveryWorker struct {
+	notificationService domain.NotificationService
+	logger              *zap.Logger
+
+	// Worker configuration
+	pollInterval   time.Duration
+	digestInterval time.Duration
+	batchSize      int
+	running        bool
+	mu             sync.RWMutex
+	stopCh         chan struct{}
+}
+
+// DeliveryWorkerConfig holds configuration for the delivery worker
+type DeliveryWorkerConfig struct {
+	PollInterval   time.Duration // how often deferred notifications and escalations are processed
+	DigestInterval time.Duration // how often users are checked for a due digest
+	BatchSize      int           // notifications claimed per round
+}
+
+// NewDeliveryWorker creates a new notification delivery worker
+func NewDeliveryWorker(
+	notificationService domain.NotificationService,
+	logger *zap.Logger,
+	config DeliveryWorkerConfig,
+) *DeliveryWorker {
+	if config.PollInterval == 0 {
+		config.PollInterval = time.Minute
+	}
+	if config.DigestInterval == 0 {
+		config.DigestInterval = 5 * time.Minute
+	}
+	if config.BatchSize == 0 {
+		config.BatchSize = 100
+	}
+
+	return &DeliveryWorker{
+		notificationService: notificationService,
+		logger:              logger,
+		pollInterval:        config.PollInterval,
+		digestInterval:      config.DigestInterval,
+		batchSize:           config.BatchSize,
+		stopCh:              make(chan struct{}),
+	}
+}
+
+// Start starts the delivery loop
+func (w *DeliveryWorker) Start(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.running {
+		return fmt.Errorf("notification delivery worker is already running")
+	}
+
+	w.running = true
+	w.logger.Info("Starting notification delivery worker",
+		zap.Duration("poll_interval", w.pollInterval),
+		zap.Duration("digest_interval", w.digestInterval),
+		zap.Int("batch_size", w.batchSize))
+
+	go w.deliveryLoop(ctx)
+
+	return nil
+}
+
+// Stop stops the delivery worker
+func (w *DeliveryWorker) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.running {
+		return
+	}
+
+	w.running = false
+	close(w.stopCh)
+
+	w.logger.Info("Stopped notification delivery worker")
+}
+
+// deliveryLoop runs the main delivery loop
+func (w *DeliveryWorker) deliveryLoop(ctx context.Context) {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	digestTicker := time.NewTicker(w.digestInterval)
+	defer digestTicker.Stop()
+
+	for {
+		w.release(ctx)
+		w.escalate(ctx)
+
+		select {
+		case <-ticker.C:
+		case <-digestTicker.C:
+			w.sendDigests(ctx)
+		case <-w.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// release delivers deferred notifications whose quiet hours have ended until none are left
+func (w *DeliveryWorker) release(ctx context.Context) {
+	for {
+		released, err := w.notificationService.ReleaseDeferredNotifications(ctx, w.batchSize)
+		if err != nil {
+			w.logger.Error("Failed to release deferred notifications", zap.Error(err))
+			return
+		}
+		if released > 0 {
+			w.logger.Debug("Released deferred notifications", zap.Int("count", released))
+		}
+		if released < w.batchSize || w.stopping(ctx) {
+			return
+		}
+	}
+}
+
+// escalate forwards unacknowledged urgent notifications until none are due
+func (w *DeliveryWorker) escalate(ctx context.Context) {
+	for {
+		escalated, err := w.notificationService.EscalateUnacknowledged(ctx, w.batchSize)
+		if err != nil {
+			w.logger.Error("Failed to escalate unacknowledged notifications", zap.Error(err))
+			return
+		}
+		if escalated < w.batchSize || w.stopping(ctx) {
+			return
+		}
+	}
+}
+
+// sendDigests emails due digests
+func (w *DeliveryWorker) sendDigests(ctx context.Context) {
+	sent, err := w.notificationService.SendDigests(ctx)
+	if err != nil {
+		w.logger.Error("Failed to send notification digests", zap.Error(err))
+		return
+	}
+	if sent > 0 {
+		w.logger.Info("Sent notification digests", zap.Int("count", sent))
+	}
+}
+
+// stopping reports whether the worker was stopped or the context canceled
+func (w *DeliveryWorker) stopping(ctx context.Context) bool {
+	select {
+	case <-w.stopCh:
+		return true
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,161 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"skyclust/internal/domain"
+)
+
+// Poller follows running cloud operations (EKS/GKE clusters and node groups, GCP VPC deletion,
+// VM start/stop) until they reach a terminal state and purges finished operations past the
+// retention period. Operations are claimed with SKIP LOCKED, so the poller is safe to run on
+// every replica.
+type Poller struct {
+	operationService domain.OperationService
+	logger           *zap.Logger
+
+	// Worker configuration
+	pollInterval time.Duration
+	batchSize    int
+	retention    time.Duration
+	running      bool
+	mu           sync.RWMutex
+	stopCh       chan struct{}
+}
+
+// PollerConfig holds configuration for the operation poller
+type PollerConfig struct {
+	PollInterval time.Duration // how often due operations are picked up
+	BatchSize    int           // operations claimed per round
+	Retention    time.Duration // how long finished operations are kept
+}
+
+// NewPoller creates a new operation poller
+func NewPoller(
+	operationService domain.OperationService,
+	logger *zap.Logger,
+	config PollerConfig,
+) *Poller {
+	if config.PollInterval == 0 {
+		config.PollInterval = 5 * time.Second
+	}
+	if config.BatchSize == 0 {
+		config.BatchSize = 50
+	}
+	if config.Retention == 0 {
+		config.Retention = 7 * 24 * time.Hour
+	}
+
+	return &Poller{
+		operationService: operationService,
+		logger:           logger,
+		pollInterval:     config.PollInterval,
+		batchSize:        config.BatchSize,
+		retention:        config.Retention,
+		stopCh:           make(chan struct{}),
+	}
+}
+
+// Start starts the polling loop
+func (w *Poller) Start(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.running {
+		return fmt.Errorf("operation poller is already running")
+	}
+
+	w.running = true
+	w.logger.Info("Starting operation poller",
+		zap.Duration("poll_interval", w.pollInterval),
+		zap.Int("batch_size", w.batchSize),
+		zap.Duration("retention", w.retention))
+
+	go w.pollLoop(ctx)
+
+	return nil
+}
+
+// Stop stops the operation poller
+func (w *Poller) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.running {
+		return
+	}
+
+	w.running = false
+	close(w.stopCh)
+
+	w.logger.Info("Stopped operation poller")
+}
+
+// pollLoop runs the main polling loop
+func (w *Poller) pollLoop(ctx context.Context) {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	purgeTicker := time.NewTicker(time.Hour)
+	defer purgeTicker.Stop()
+
+	for {
+		w.poll(ctx)
+
+		select {
+		case <-ticker.C:
+		case <-purgeTicker.C:
+			w.purge(ctx)
+		case <-w.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// poll checks due operations until fewer than a full batch are due
+func (w *Poller) poll(ctx context.Context) {
+	for {
+		polled, err := w.operationService.PollDue(ctx, w.batchSize)
+		if err != nil {
+			w.logger.Error("Failed to poll operations", zap.Error(err))
+			return
+		}
+		if polled > 0 {
+			w.logger.Debug("Polled operations", zap.Int("count", polled))
+		}
+		if polled < w.batchSize || w.stopping(ctx) {
+			return
+		}
+	}
+}
+
+// purge removes finished operations older than the retention period
+func (w *Poller) purge(ctx context.Context) {
+	deleted, err := w.operationService.PurgeOperations(ctx, w.retention)
+	if err != nil {
+		w.logger.Warn("Failed to purge old operations", zap.Error(err))
+		return
+	}
+	if deleted > 0 {
+		w.logger.Info("Purged old operations", zap.Int64("deleted", deleted))
+	}
+}
+
+// stopping reports whether the worker was stopped or the context canceled
+func (w *Poller) stopping(ctx context.Context) bool {
+	select {
+	case <-w.stopCh:
+		return true
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,202 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"skyclust/internal/domain"
+	"skyclust/internal/infrastructure/messaging"
+)
+
+// durableConsumer is the JetStream consumer that records webhook deliveries once across all replicas
+const durableConsumer = "webhooks"
+
+// DeliveryWorker records a webhook delivery for every platform event that matches a subscription
+// and sends due deliveries, retrying failed ones with backoff
+type DeliveryWorker struct {
+	webhookService domain.WebhookService
+	eventBus       messaging.Bus
+	logger         *zap.Logger
+
+	// Worker configuration
+	pollInterval time.Duration
+	batchSize    int
+	retention    time.Duration
+	running      bool
+	mu           sync.RWMutex
+	stopCh       chan struct{}
+	wakeCh       chan struct{}
+}
+
+// DeliveryWorkerConfig holds configuration for the delivery worker
+type DeliveryWorkerConfig struct {
+	PollInterval time.Duration // how often due deliveries and retries are picked up
+	BatchSize    int           // deliveries claimed per round
+	Retention    time.Duration // how long finished deliveries are kept
+}
+
+// NewDeliveryWorker creates a new webhook delivery worker
+func NewDeliveryWorker(
+	webhookService domain.WebhookService,
+	eventBus messaging.Bus,
+	logger *zap.Logger,
+	config DeliveryWorkerConfig,
+) *DeliveryWorker {
+	if config.PollInterval == 0 {
+		config.PollInterval = 5 * time.Second
+	}
+	if config.BatchSize == 0 {
+		config.BatchSize = 50
+	}
+	if config.Retention == 0 {
+		config.Retention = 30 * 24 * time.Hour
+	}
+
+	return &DeliveryWorker{
+		webhookService: webhookService,
+		eventBus:       eventBus,
+		logger:         logger,
+		pollInterval:   config.PollInterval,
+		batchSize:      config.BatchSize,
+		retention:      config.Retention,
+		stopCh:         make(chan struct{}),
+		wakeCh:         make(chan struct{}, 1),
+	}
+}
+
+// Start subscribes to all platform events and starts the delivery loop
+func (w *DeliveryWorker) Start(ctx context.Context) error {
+	w.mu.Lock()
+	if w.running {
+		w.mu.Unlock()
+		return fmt.Errorf("webhook delivery worker is already running")
+	}
+	w.running = true
+	w.mu.Unlock()
+
+	// With JetStream each event is recorded once across replicas and retried if recording fails
+	var err error
+	if durableBus, ok := w.eventBus.(messaging.DurableBus); ok {
+		err = durableBus.SubscribeDurable(durableConsumer, ">", w)
+	} else {
+		err = w.eventBus.Subscribe(">", w)
+	}
+	if err != nil {
+		w.mu.Lock()
+		w.running = false
+		w.mu.Unlock()
+		return fmt.Errorf("failed to subscribe webhook delivery worker to events: %w", err)
+	}
+
+	w.logger.Info("Starting webhook delivery worker",
+		zap.Duration("poll_interval", w.pollInterval),
+		zap.Int("batch_size", w.batchSize),
+		zap.Duration("retention", w.retention))
+
+	go w.deliveryLoop(ctx)
+
+	return nil
+}
+
+// Stop stops the delivery worker
+func (w *DeliveryWorker) Stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.running {
+		return
+	}
+
+	w.running = false
+	close(w.stopCh)
+
+	w.logger.Info("Stopped webhook delivery worker")
+}
+
+// Handle records deliveries for an event (implements messaging.EventHandler)
+func (w *DeliveryWorker) Handle(ctx context.Context, event messaging.Event) error {
+	// LocalBus passes the publisher's request context, which is canceled once the response is written
+	queued, err := w.webhookService.Enqueue(context.WithoutCancel(ctx), domain.WebhookEvent{
+		Type:        event.Type,
+		WorkspaceID: event.WorkspaceID,
+		Data:        event.Data,
+		Timestamp:   event.Timestamp,
+	})
+	if err != nil {
+		w.logger.Warn("Failed to queue webhook deliveries",
+			zap.String("event_type", event.Type),
+			zap.Error(err))
+		return err
+	}
+
+	if queued > 0 {
+		// Deliver right away instead of waiting for the next poll
+		select {
+		case w.wakeCh <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// deliveryLoop runs the main delivery loop
+func (w *DeliveryWorker) deliveryLoop(ctx context.Context) {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	purgeTicker := time.NewTicker(time.Hour)
+	defer purgeTicker.Stop()
+
+	for {
+		w.deliver(ctx)
+
+		select {
+		case <-ticker.C:
+		case <-w.wakeCh:
+		case <-purgeTicker.C:
+			w.purge(ctx)
+		case <-w.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// deliver sends due deliveries until none are left
+func (w *DeliveryWorker) deliver(ctx context.Context) {
+	for {
+		attempted, err := w.webhookService.DeliverDue(ctx, w.batchSize)
+		if err != nil {
+			w.logger.Error("Failed to deliver webhooks", zap.Error(err))
+			return
+		}
+		if attempted < w.batchSize {
+			return
+		}
+
+		select {
+		case <-w.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		default:
+		}
+	}
+}
+
+// purge removes finished deliveries older than the retention period
+func (w *DeliveryWorker) purge(ctx context.Context) {
+	deleted, err := w.webhookService.PurgeDeliveries(ctx, w.retention)
+	if err != nil {
+		w.logger.Warn("Failed to purge old webhook deliveries", zap.Error(err))
+		return
+	}
+	if deleted > 0 {
+		w.logger.Info("Purged old webhook deliveries", zap.Int64("deleted", deleted))
+	}
+}
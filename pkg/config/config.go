@@ -3,6 +3,7 @@ package config
 import (
 	"crypto/rand"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
@@ -41,6 +42,24 @@ type Config struct {
 
 	// Redis Configuration
 	Redis RedisConfig `json:"redis" yaml:"redis"`
+
+	// Secret Store Configuration (external credential material)
+	SecretStores SecretStoresConfig `json:"secret_stores" yaml:"secret_stores"`
+
+	// Audit Log Configuration
+	Audit AuditConfig `json:"audit" yaml:"audit"`
+
+	// Outgoing Webhook Configuration
+	Webhook WebhookConfig `json:"webhook" yaml:"webhook"`
+
+	// Notification Configuration (chat channels)
+	Notification NotificationConfig `json:"notification" yaml:"notification"`
+
+	// Automation Rules Configuration
+	Automation AutomationConfig `json:"automation" yaml:"automation"`
+
+	// Long-running Operation Tracking Configuration
+	Operation OperationConfig `json:"operation" yaml:"operation"`
 }
 
 // ServerConfig holds server configuration
@@ -71,6 +90,9 @@ type SecurityConfig struct {
 	JWTIssuer     string        `json:"jwt_issuer" yaml:"jwt_issuer"`
 	BCryptCost    int           `json:"bcrypt_cost" yaml:"bcrypt_cost"`
 	EncryptionKey string        `json:"encryption_key" yaml:"encryption_key"`
+	// EncryptionKeys lists versioned master keys as "id:secret,id:secret"; EncryptionKey is used as "v1" when empty
+	EncryptionKeys         string `json:"encryption_keys" yaml:"encryption_keys"`
+	EncryptionPrimaryKeyID string `json:"encryption_primary_key_id" yaml:"encryption_primary_key_id"`
 }
 
 // EncryptionConfig holds encryption configuration
@@ -114,6 +136,21 @@ type NATSConfig struct {
 	Password             string `json:"password"`
 	CompressionType      string `json:"compression_type"`      // "none", "gzip", "snappy"
 	CompressionThreshold int    `json:"compression_threshold"` // Minimum size in bytes to compress
+
+	// JetStream persists events and delivers them to durable consumers with explicit ack
+	JetStream NATSJetStreamConfig `json:"jetstream" yaml:"jetstream"`
+}
+
+// NATSJetStreamConfig holds JetStream stream, consumer and dead-letter configuration
+type NATSJetStreamConfig struct {
+	Enabled    bool            `json:"enabled" yaml:"enabled"`
+	MaxAge     time.Duration   `json:"max_age" yaml:"max_age"`         // Event stream retention
+	MaxDeliver int             `json:"max_deliver" yaml:"max_deliver"` // Deliveries before an event is dead-lettered
+	AckWait    time.Duration   `json:"ack_wait" yaml:"ack_wait"`
+	Backoff    []time.Duration `json:"backoff" yaml:"backoff"` // Redelivery delays per attempt
+
+	// DeadLetterMaxAge is how long dead-lettered events are kept for inspection and replay
+	DeadLetterMaxAge time.Duration `json:"dead_letter_max_age" yaml:"dead_letter_max_age"`
 }
 
 // RedisConfig holds Redis configuration
@@ -125,6 +162,126 @@ type RedisConfig struct {
 	PoolSize int    `json:"pool_size"`
 }
 
+// SecretStoresConfig holds external secret store configuration for credential material
+type SecretStoresConfig struct {
+	CacheTTL time.Duration `json:"cache_ttl" yaml:"cache_ttl"` // in-memory cache for resolved secrets
+	FileDir  string        `json:"file_dir" yaml:"file_dir"`   // root directory of the file-based store (disabled when empty)
+	Vault    VaultConfig   `json:"vault" yaml:"vault"`
+
+	// WorkspacePathPrefix limits secret references to paths under this prefix; {workspace_id} is replaced per workspace
+	WorkspacePathPrefix string `json:"workspace_path_prefix" yaml:"workspace_path_prefix"`
+}
+
+// VaultConfig holds HashiCorp Vault KV v2 configuration (disabled when Address is empty)
+type VaultConfig struct {
+	Address   string        `json:"address" yaml:"address"`
+	Token     string        `json:"token" yaml:"token"`
+	Mount     string        `json:"mount" yaml:"mount"`
+	Namespace string        `json:"namespace" yaml:"namespace"`
+	Timeout   time.Duration `json:"timeout" yaml:"timeout"`
+}
+
+// AuditConfig holds audit log integrity configuration
+type AuditConfig struct {
+	// SigningKey is a base64 encoded 32-byte Ed25519 seed for checkpoint signatures; derived from the encryption key when empty
+	SigningKey         string        `json:"signing_key" yaml:"signing_key"`
+	CheckpointInterval time.Duration `json:"checkpoint_interval" yaml:"checkpoint_interval"`
+
+	// Sinks forward every audit log entry to external SIEMs through the outbox
+	Sinks            []AuditSinkConfig `json:"sinks" yaml:"sinks"`
+	SinkPollInterval time.Duration     `json:"sink_poll_interval" yaml:"sink_poll_interval"`
+	SinkBatchSize    int               `json:"sink_batch_size" yaml:"sink_batch_size"`
+
+	// CloudIngest pulls CloudTrail and GCP Admin Activity logs of every active credential into the audit log
+	CloudIngestEnabled  bool          `json:"cloud_ingest_enabled" yaml:"cloud_ingest_enabled"`
+	CloudIngestInterval time.Duration `json:"cloud_ingest_interval" yaml:"cloud_ingest_interval"`
+
+	// SubscriptionInterval is how often subscribed saved queries are re-run for new matches
+	SubscriptionInterval time.Duration `json:"subscription_interval" yaml:"subscription_interval"`
+}
+
+// WebhookConfig holds outgoing webhook delivery configuration
+type WebhookConfig struct {
+	// PollInterval is how often due deliveries and retries are picked up
+	PollInterval time.Duration `json:"poll_interval" yaml:"poll_interval"`
+	// DeliveryRetention is how long finished deliveries stay in the delivery log
+	DeliveryRetention time.Duration `json:"delivery_retention" yaml:"delivery_retention"`
+}
+
+// NotificationConfig holds notification delivery configuration
+type NotificationConfig struct {
+	// PublicURL is the externally reachable base URL of the API (e.g. https://skyclust.example.com),
+	// used for acknowledge links in chat messages; links are omitted when empty
+	PublicURL string `json:"public_url" yaml:"public_url"`
+	// AckSigningKey signs acknowledge tokens; derived from the encryption key when empty
+	AckSigningKey string `json:"ack_signing_key" yaml:"ack_signing_key"`
+	// AckTTL is how long acknowledge links and buttons stay valid
+	AckTTL time.Duration `json:"ack_ttl" yaml:"ack_ttl"`
+
+	// DedupWindow is how long a repeated notification (same dedup key) is suppressed for a user;
+	// 10m when zero, negative disables deduplication
+	DedupWindow time.Duration `json:"dedup_window" yaml:"dedup_window"`
+	// DeliveryPollInterval is how often deferred notifications and escalations are processed
+	DeliveryPollInterval time.Duration `json:"delivery_poll_interval" yaml:"delivery_poll_interval"`
+	// DigestCheckInterval is how often users are checked for a due digest email
+	DigestCheckInterval time.Duration `json:"digest_check_interval" yaml:"digest_check_interval"`
+
+	// SMTP settings for digest and escalation emails; email delivery is disabled when SMTPHost is empty
+	SMTPHost      string `json:"smtp_host" yaml:"smtp_host"`
+	SMTPPort      string `json:"smtp_port" yaml:"smtp_port"`
+	SMTPUsername  string `json:"smtp_username" yaml:"smtp_username"`
+	SMTPPassword  string `json:"smtp_password" yaml:"smtp_password"`
+	EmailFrom     string `json:"email_from" yaml:"email_from"`
+	EmailFromName string `json:"email_from_name" yaml:"email_from_name"`
+}
+
+// AutomationConfig holds workspace automation rule configuration
+type AutomationConfig struct {
+	// ActionTimeout bounds how long the actions of a single rule execution may run
+	ActionTimeout time.Duration `json:"action_timeout" yaml:"action_timeout"`
+	// ExecutionRetention is how long rule execution history is kept
+	ExecutionRetention time.Duration `json:"execution_retention" yaml:"execution_retention"`
+}
+
+// OperationConfig holds long-running cloud operation tracking configuration
+type OperationConfig struct {
+	// PollInterval is how often the poller picks up operations whose next status check is due
+	PollInterval time.Duration `json:"poll_interval" yaml:"poll_interval"`
+	// Retention is how long finished operations are kept
+	Retention time.Duration `json:"retention" yaml:"retention"`
+}
+
+// AuditSinkConfig holds a single audit log sink (syslog, webhook or splunk_hec)
+type AuditSinkConfig struct {
+	Name     string `json:"name" yaml:"name"`
+	Type     string `json:"type" yaml:"type"`
+	Format   string `json:"format" yaml:"format"` // json (default) or cef
+	Disabled bool   `json:"disabled" yaml:"disabled"`
+
+	// Syslog (RFC 5424 over TCP, or TLS when TLS is set)
+	Address  string `json:"address" yaml:"address"`
+	TLS      bool   `json:"tls" yaml:"tls"`
+	AppName  string `json:"app_name" yaml:"app_name"`
+	Hostname string `json:"hostname" yaml:"hostname"`
+
+	// Webhook (HMAC-SHA256 signed with Secret) and Splunk HEC (Token)
+	URL        string `json:"url" yaml:"url"`
+	Secret     string `json:"secret" yaml:"secret"`
+	Token      string `json:"token" yaml:"token"`
+	Index      string `json:"index" yaml:"index"`
+	Source     string `json:"source" yaml:"source"`
+	SourceType string `json:"source_type" yaml:"source_type"`
+
+	CAFile             string        `json:"ca_file" yaml:"ca_file"`
+	InsecureSkipVerify bool          `json:"insecure_skip_verify" yaml:"insecure_skip_verify"`
+	Timeout            time.Duration `json:"timeout" yaml:"timeout"`
+
+	// Filters; patterns match exactly or by prefix with a trailing '*'
+	Actions        []string `json:"actions" yaml:"actions"`
+	ExcludeActions []string `json:"exclude_actions" yaml:"exclude_actions"`
+	Resources      []string `json:"resources" yaml:"resources"`
+}
+
 // EnvMapping defines environment variable mapping
 type EnvMapping struct {
 	EnvKey    string
@@ -168,6 +325,8 @@ var envMappings = []EnvMapping{
 	// Security configuration
 	{"JWT_SECRET", "Security.JWTSecret", "string", false},
 	{"ENCRYPTION_KEY", "Security.EncryptionKey", "string", false},
+	{"ENCRYPTION_KEYS", "Security.EncryptionKeys", "string", false},
+	{"ENCRYPTION_PRIMARY_KEY_ID", "Security.EncryptionPrimaryKeyID", "string", false},
 	{"JWT_ISSUER", "Security.JWTIssuer", "string", false},
 	{"BCRYPT_COST", "Security.BCryptCost", "int", false},
 
@@ -183,6 +342,12 @@ var envMappings = []EnvMapping{
 	{"NATS_CLUSTER", "NATS.Cluster", "string", false},
 	{"NATS_USERNAME", "NATS.Username", "string", false},
 	{"NATS_PASSWORD", "NATS.Password", "string", false},
+	{"NATS_JETSTREAM_ENABLED", "NATS.JetStream.Enabled", "bool", false},
+	{"NATS_JETSTREAM_MAX_AGE", "NATS.JetStream.MaxAge", "duration", false},
+	{"NATS_JETSTREAM_MAX_DELIVER", "NATS.JetStream.MaxDeliver", "int", false},
+	{"NATS_JETSTREAM_ACK_WAIT", "NATS.JetStream.AckWait", "duration", false},
+	{"NATS_JETSTREAM_BACKOFF", "NATS.JetStream.Backoff", "durations", false},
+	{"NATS_JETSTREAM_DLQ_MAX_AGE", "NATS.JetStream.DeadLetterMaxAge", "duration", false},
 
 	// Logging configuration
 	{"LOG_LEVEL", "Logging.Level", "string", false},
@@ -200,6 +365,50 @@ var envMappings = []EnvMapping{
 	{"METRICS_PORT", "Monitoring.MetricsPort", "int", false},
 	{"HEALTH_PORT", "Monitoring.HealthPort", "int", false},
 	{"TRACE_URL", "Monitoring.TraceURL", "string", false},
+
+	// Secret store configuration
+	{"SECRET_STORE_CACHE_TTL", "SecretStores.CacheTTL", "duration", false},
+	{"SECRET_STORE_FILE_DIR", "SecretStores.FileDir", "string", false},
+	{"SECRET_STORE_WORKSPACE_PREFIX", "SecretStores.WorkspacePathPrefix", "string", false},
+	{"VAULT_ADDR", "SecretStores.Vault.Address", "string", false},
+	{"VAULT_TOKEN", "SecretStores.Vault.Token", "string", false},
+	{"VAULT_KV_MOUNT", "SecretStores.Vault.Mount", "string", false},
+	{"VAULT_NAMESPACE", "SecretStores.Vault.Namespace", "string", false},
+
+	// Audit log configuration
+	{"AUDIT_SIGNING_KEY", "Audit.SigningKey", "string", false},
+	{"AUDIT_CHECKPOINT_INTERVAL", "Audit.CheckpointInterval", "duration", false},
+	{"AUDIT_SINKS", "Audit.Sinks", "json", false},
+	{"AUDIT_SINK_POLL_INTERVAL", "Audit.SinkPollInterval", "duration", false},
+	{"AUDIT_CLOUD_INGEST_ENABLED", "Audit.CloudIngestEnabled", "bool", false},
+	{"AUDIT_CLOUD_INGEST_INTERVAL", "Audit.CloudIngestInterval", "duration", false},
+	{"AUDIT_SUBSCRIPTION_INTERVAL", "Audit.SubscriptionInterval", "duration", false},
+
+	// Outgoing webhook configuration
+	{"WEBHOOK_POLL_INTERVAL", "Webhook.PollInterval", "duration", false},
+	{"WEBHOOK_DELIVERY_RETENTION", "Webhook.DeliveryRetention", "duration", false},
+
+	// Notification configuration
+	{"NOTIFICATION_PUBLIC_URL", "Notification.PublicURL", "string", false},
+	{"NOTIFICATION_ACK_SIGNING_KEY", "Notification.AckSigningKey", "string", false},
+	{"NOTIFICATION_ACK_TTL", "Notification.AckTTL", "duration", false},
+	{"NOTIFICATION_DEDUP_WINDOW", "Notification.DedupWindow", "duration", false},
+	{"NOTIFICATION_DELIVERY_POLL_INTERVAL", "Notification.DeliveryPollInterval", "duration", false},
+	{"NOTIFICATION_DIGEST_CHECK_INTERVAL", "Notification.DigestCheckInterval", "duration", false},
+	{"SMTP_HOST", "Notification.SMTPHost", "string", false},
+	{"SMTP_PORT", "Notification.SMTPPort", "string", false},
+	{"SMTP_USERNAME", "Notification.SMTPUsername", "string", false},
+	{"SMTP_PASSWORD", "Notification.SMTPPassword", "string", false},
+	{"NOTIFICATION_EMAIL_FROM", "Notification.EmailFrom", "string", false},
+	{"NOTIFICATION_EMAIL_FROM_NAME", "Notification.EmailFromName", "string", false},
+
+	// Automation configuration
+	{"AUTOMATION_ACTION_TIMEOUT", "Automation.ActionTimeout", "duration", false},
+	{"AUTOMATION_EXECUTION_RETENTION", "Automation.ExecutionRetention", "duration", false},
+
+	// Operation tracking configuration
+	{"OPERATION_POLL_INTERVAL", "Operation.PollInterval", "duration", false},
+	{"OPERATION_RETENTION", "Operation.Retention", "duration", false},
 }
 
 // NewEnvCache creates a new environment variable cache
@@ -320,6 +529,10 @@ func (c *ConfigOverrider) setFieldByPath(fieldPath, value, fieldType string) err
 		c.config.Security.JWTSecret = value
 	case "Security.EncryptionKey":
 		c.config.Security.EncryptionKey = value
+	case "Security.EncryptionKeys":
+		c.config.Security.EncryptionKeys = value
+	case "Security.EncryptionPrimaryKeyID":
+		c.config.Security.EncryptionPrimaryKeyID = value
 	case "Security.JWTIssuer":
 		c.config.Security.JWTIssuer = value
 	case "Security.BCryptCost":
@@ -370,6 +583,44 @@ func (c *ConfigOverrider) setFieldByPath(fieldPath, value, fieldType string) err
 		c.config.NATS.Username = value
 	case "NATS.Password":
 		c.config.NATS.Password = value
+	case "NATS.JetStream.Enabled":
+		c.config.NATS.JetStream.Enabled = parseBoolEnv(value, c.config.NATS.JetStream.Enabled)
+	case "NATS.JetStream.MaxAge":
+		if duration, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid jetstream max age value '%s': %w", value, err)
+		} else {
+			c.config.NATS.JetStream.MaxAge = duration
+		}
+	case "NATS.JetStream.MaxDeliver":
+		if intVal, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("invalid jetstream max deliver value '%s': %w", value, err)
+		} else if intVal <= 0 {
+			return fmt.Errorf("invalid jetstream max deliver value '%s': must be positive", value)
+		} else {
+			c.config.NATS.JetStream.MaxDeliver = intVal
+		}
+	case "NATS.JetStream.AckWait":
+		if duration, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid jetstream ack wait value '%s': %w", value, err)
+		} else {
+			c.config.NATS.JetStream.AckWait = duration
+		}
+	case "NATS.JetStream.Backoff":
+		var backoff []time.Duration
+		for _, part := range strings.Split(value, ",") {
+			duration, err := time.ParseDuration(strings.TrimSpace(part))
+			if err != nil {
+				return fmt.Errorf("invalid jetstream backoff value '%s': %w", value, err)
+			}
+			backoff = append(backoff, duration)
+		}
+		c.config.NATS.JetStream.Backoff = backoff
+	case "NATS.JetStream.DeadLetterMaxAge":
+		if duration, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid jetstream dead letter max age value '%s': %w", value, err)
+		} else {
+			c.config.NATS.JetStream.DeadLetterMaxAge = duration
+		}
 
 	// Logging configuration
 	case "Logging.Level":
@@ -417,6 +668,138 @@ func (c *ConfigOverrider) setFieldByPath(fieldPath, value, fieldType string) err
 	case "Monitoring.TraceURL":
 		c.config.Monitoring.TraceURL = value
 
+	// Secret store configuration
+	case "SecretStores.CacheTTL":
+		if duration, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid secret store cache ttl value '%s': %w", value, err)
+		} else {
+			c.config.SecretStores.CacheTTL = duration
+		}
+	case "SecretStores.FileDir":
+		c.config.SecretStores.FileDir = value
+	case "SecretStores.WorkspacePathPrefix":
+		c.config.SecretStores.WorkspacePathPrefix = value
+	case "SecretStores.Vault.Address":
+		c.config.SecretStores.Vault.Address = value
+	case "SecretStores.Vault.Token":
+		c.config.SecretStores.Vault.Token = value
+	case "SecretStores.Vault.Mount":
+		c.config.SecretStores.Vault.Mount = value
+	case "SecretStores.Vault.Namespace":
+		c.config.SecretStores.Vault.Namespace = value
+
+	// Audit log configuration
+	case "Audit.SigningKey":
+		c.config.Audit.SigningKey = value
+	case "Audit.CheckpointInterval":
+		if duration, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid audit checkpoint interval value '%s': %w", value, err)
+		} else {
+			c.config.Audit.CheckpointInterval = duration
+		}
+	case "Audit.Sinks":
+		var sinks []AuditSinkConfig
+		if err := json.Unmarshal([]byte(value), &sinks); err != nil {
+			return fmt.Errorf("invalid audit sinks value: %w", err)
+		}
+		c.config.Audit.Sinks = sinks
+	case "Audit.SinkPollInterval":
+		if duration, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid audit sink poll interval value '%s': %w", value, err)
+		} else {
+			c.config.Audit.SinkPollInterval = duration
+		}
+	case "Audit.CloudIngestEnabled":
+		c.config.Audit.CloudIngestEnabled = parseBoolEnv(value, c.config.Audit.CloudIngestEnabled)
+	case "Audit.CloudIngestInterval":
+		if duration, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid audit cloud ingest interval value '%s': %w", value, err)
+		} else {
+			c.config.Audit.CloudIngestInterval = duration
+		}
+	case "Audit.SubscriptionInterval":
+		if duration, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid audit subscription interval value '%s': %w", value, err)
+		} else {
+			c.config.Audit.SubscriptionInterval = duration
+		}
+	case "Webhook.PollInterval":
+		if duration, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid webhook poll interval value '%s': %w", value, err)
+		} else {
+			c.config.Webhook.PollInterval = duration
+		}
+	case "Webhook.DeliveryRetention":
+		if duration, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid webhook delivery retention value '%s': %w", value, err)
+		} else {
+			c.config.Webhook.DeliveryRetention = duration
+		}
+	case "Notification.PublicURL":
+		c.config.Notification.PublicURL = value
+	case "Notification.AckSigningKey":
+		c.config.Notification.AckSigningKey = value
+	case "Notification.AckTTL":
+		if duration, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid notification ack TTL value '%s': %w", value, err)
+		} else {
+			c.config.Notification.AckTTL = duration
+		}
+	case "Notification.DedupWindow":
+		if duration, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid notification dedup window value '%s': %w", value, err)
+		} else {
+			c.config.Notification.DedupWindow = duration
+		}
+	case "Notification.DeliveryPollInterval":
+		if duration, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid notification delivery poll interval value '%s': %w", value, err)
+		} else {
+			c.config.Notification.DeliveryPollInterval = duration
+		}
+	case "Notification.DigestCheckInterval":
+		if duration, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid notification digest check interval value '%s': %w", value, err)
+		} else {
+			c.config.Notification.DigestCheckInterval = duration
+		}
+	case "Notification.SMTPHost":
+		c.config.Notification.SMTPHost = value
+	case "Notification.SMTPPort":
+		c.config.Notification.SMTPPort = value
+	case "Notification.SMTPUsername":
+		c.config.Notification.SMTPUsername = value
+	case "Notification.SMTPPassword":
+		c.config.Notification.SMTPPassword = value
+	case "Notification.EmailFrom":
+		c.config.Notification.EmailFrom = value
+	case "Notification.EmailFromName":
+		c.config.Notification.EmailFromName = value
+	case "Automation.ActionTimeout":
+		if duration, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid automation action timeout value '%s': %w", value, err)
+		} else {
+			c.config.Automation.ActionTimeout = duration
+		}
+	case "Automation.ExecutionRetention":
+		if duration, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid automation execution retention value '%s': %w", value, err)
+		} else {
+			c.config.Automation.ExecutionRetention = duration
+		}
+	case "Operation.PollInterval":
+		if duration, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid operation poll interval value '%s': %w", value, err)
+		} else {
+			c.config.Operation.PollInterval = duration
+		}
+	case "Operation.Retention":
+		if duration, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid operation retention value '%s': %w", value, err)
+		} else {
+			c.config.Operation.Retention = duration
+		}
+
 	default:
 		return fmt.Errorf("unknown field path: %s", fieldPath)
 	}
@@ -101,3 +101,14 @@ func AuditManagementMiddleware(rbacService domain.RBACService) gin.HandlerFunc {
 		domain.AuditExport,
 	})
 }
+
+// WorkspaceRBACMiddleware injects the workspace authorizer into the request context
+// Handlers resolve the target workspace and check permissions through BaseHandler.RequireWorkspacePermission
+func WorkspaceRBACMiddleware(authorizer domain.WorkspaceAuthorizer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if authorizer != nil {
+			c.Set(domain.WorkspaceAuthorizerContextKey, authorizer)
+		}
+		c.Next()
+	}
+}
@@ -29,6 +29,7 @@ type Service interface {
 	BroadcastToWorkspace(workspaceID string, event *messaging.Event) error
 	BroadcastToUser(userID string, event *messaging.Event) error
 	BroadcastToConnection(connID string, eventType string, data interface{}) error
+	SendEventWithID(connID, eventID, eventType string, data interface{}) error
 	BroadcastToAll(eventType string, data interface{}) error
 
 	// Connection subscription management
@@ -59,6 +60,7 @@ type SSEConnection struct {
 	SubscribedEvents    map[string]bool
 	SubscribedResources map[string]map[string]bool // resourceType -> resourceID -> bool
 	mu                  sync.RWMutex
+	writeMu             sync.Mutex // serializes frames written by heartbeat and event senders
 }
 
 // NewService creates a new realtime service
@@ -215,6 +217,17 @@ func (s *service) BroadcastToConnection(connID string, eventType string, data in
 	return s.sendToConnection(conn, eventType, data)
 }
 
+// SendEventWithID sends an event with an SSE id field so clients can resume with Last-Event-ID
+// Last-Event-ID로 재연결할 수 있도록 id 필드를 포함해 이벤트 전송
+func (s *service) SendEventWithID(connID, eventID, eventType string, data interface{}) error {
+	conn, err := s.GetConnection(connID)
+	if err != nil {
+		return err
+	}
+
+	return s.writeEvent(conn, eventID, eventType, data)
+}
+
 // BroadcastToAll broadcasts an event to all connections
 // 모든 연결에 이벤트 브로드캐스팅
 func (s *service) BroadcastToAll(eventType string, data interface{}) error {
@@ -256,6 +269,12 @@ func (s *service) sendToConnection(conn *SSEConnection, eventType string, data i
 		return nil // 구독하지 않은 이벤트는 무시
 	}
 
+	return s.writeEvent(conn, "", eventType, data)
+}
+
+// writeEvent writes a single SSE frame (id is omitted when empty)
+// SSE 프레임 하나를 기록 (id가 비어 있으면 생략)
+func (s *service) writeEvent(conn *SSEConnection, eventID, eventType string, data interface{}) error {
 	// JSON 마샬링
 	jsonData, err := json.Marshal(data)
 	if err != nil {
@@ -263,9 +282,14 @@ func (s *service) sendToConnection(conn *SSEConnection, eventType string, data i
 	}
 
 	// SSE 형식으로 전송
+	conn.writeMu.Lock()
+	if eventID != "" {
+		fmt.Fprintf(conn.Writer, "id: %s\n", eventID)
+	}
 	fmt.Fprintf(conn.Writer, "event: %s\n", eventType)
 	fmt.Fprintf(conn.Writer, "data: %s\n\n", string(jsonData))
 	conn.Flusher.Flush()
+	conn.writeMu.Unlock()
 
 	// LastSeen 업데이트
 	conn.mu.Lock()
@@ -401,8 +425,10 @@ func (s *service) startHeartbeat(conn *SSEConnection) {
 		case <-conn.Context.Done():
 			return
 		case <-ticker.C:
+			conn.writeMu.Lock()
 			fmt.Fprintf(conn.Writer, ": heartbeat\n\n")
 			conn.Flusher.Flush()
+			conn.writeMu.Unlock()
 
 			conn.mu.Lock()
 			conn.LastSeen = time.Now()
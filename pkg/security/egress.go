@@ -0,0 +1,102 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrNonPublicAddress is returned when an outbound request targets a loopback, private, link-local or reserved address
+var ErrNonPublicAddress = errors.New("destination address is not a public address")
+
+// nonPublicPrefixes lists ranges that are not covered by the netip helpers but must never be reached from user-supplied URLs
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "this network"
+	netip.MustParsePrefix("100.64.0.0/10"),   // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("192.0.2.0/24"),    // TEST-NET-1
+	netip.MustParsePrefix("198.18.0.0/15"),   // benchmarking
+	netip.MustParsePrefix("198.51.100.0/24"), // TEST-NET-2
+	netip.MustParsePrefix("203.0.113.0/24"),  // TEST-NET-3
+	netip.MustParsePrefix("240.0.0.0/4"),     // reserved, including broadcast
+	netip.MustParsePrefix("64:ff9b::/96"),    // NAT64 can embed any IPv4 address
+	netip.MustParsePrefix("2001:db8::/32"),   // documentation
+}
+
+// IsPublicAddress reports whether an outbound request may connect to the address
+func IsPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() ||
+		addr.IsUnspecified() ||
+		addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckPublicHost resolves a host name (or parses an IP literal) and fails when any address is not public
+func CheckPublicHost(ctx context.Context, host string) error {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if !IsPublicAddress(addr) {
+			return fmt.Errorf("%w: %s", ErrNonPublicAddress, addr)
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s: %w", host, err)
+	}
+	if len(addrs) == 0 {
+		return fmt.Errorf("failed to resolve %s: no addresses", host)
+	}
+	for _, addr := range addrs {
+		if !IsPublicAddress(addr) {
+			return fmt.Errorf("%w: %s resolves to %s", ErrNonPublicAddress, host, addr.Unmap())
+		}
+	}
+	return nil
+}
+
+// NewPublicDialer returns a dialer that refuses to connect to non-public addresses
+// The check runs on the resolved address of every connection attempt, so DNS rebinding cannot bypass it
+func NewPublicDialer(timeout time.Duration) *net.Dialer {
+	return &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrNonPublicAddress, address)
+			}
+			if !IsPublicAddress(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", ErrNonPublicAddress, addrPort.Addr().Unmap())
+			}
+			return nil
+		},
+	}
+}
+
+// NewPublicTransport returns an HTTP transport for user-supplied URLs that only connects to public addresses
+// Proxies are disabled because the dial check would otherwise only see the proxy address
+func NewPublicTransport(dialTimeout time.Duration) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = NewPublicDialer(dialTimeout).DialContext
+	return transport
+}
@@ -0,0 +1,70 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestIsPublicAddress(t *testing.T) {
+	tests := []struct {
+		addr   string
+		public bool
+	}{
+		{"8.8.8.8", true},
+		{"2606:4700:4700::1111", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"0.0.0.0", false},
+		{"::", false},
+		{"100.64.0.1", false},
+		{"255.255.255.255", false},
+		{"224.0.0.1", false},
+		{"::ffff:127.0.0.1", false},
+		{"::ffff:169.254.169.254", false},
+		{"64:ff9b::a9fe:a9fe", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			if got := IsPublicAddress(netip.MustParseAddr(tt.addr)); got != tt.public {
+				t.Fatalf("IsPublicAddress(%s) = %v, want %v", tt.addr, got, tt.public)
+			}
+		})
+	}
+}
+
+func TestCheckPublicHostRejectsLiteralAndLocalhost(t *testing.T) {
+	ctx := context.Background()
+	for _, host := range []string{"127.0.0.1", "169.254.169.254", "::1", "localhost"} {
+		if err := CheckPublicHost(ctx, host); !errors.Is(err, ErrNonPublicAddress) {
+			t.Fatalf("CheckPublicHost(%s) = %v, want ErrNonPublicAddress", host, err)
+		}
+	}
+	if err := CheckPublicHost(ctx, "8.8.8.8"); err != nil {
+		t.Fatalf("CheckPublicHost(8.8.8.8) = %v, want nil", err)
+	}
+}
+
+func TestPublicTransportRefusesLoopback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not reach a loopback server")
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewPublicTransport(time.Second)}
+	_, err := client.Get(server.URL)
+	if !errors.Is(err, ErrNonPublicAddress) {
+		t.Fatalf("expected ErrNonPublicAddress, got %v", err)
+	}
+}
@@ -0,0 +1,275 @@
+package security
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const (
+	// LegacyKeyVersion identifies ciphertexts written before envelope encryption (no key prefix)
+	LegacyKeyVersion = "legacy"
+
+	envelopeFormatV1 byte = 1
+	dataKeySize           = chacha20poly1305.KeySize
+)
+
+// envelopeMagic prefixes every envelope ciphertext: magic | format | len(keyID) | keyID | len(wrappedKey) | wrappedKey | payload
+var envelopeMagic = []byte("SKE")
+
+var masterKeyIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,32}$`)
+
+// MasterKey is a key-encryption key identified by a version ID
+type MasterKey struct {
+	ID  string
+	Key []byte
+}
+
+// KeyVersioner reports which master key protects a ciphertext
+type KeyVersioner interface {
+	PrimaryKeyID() string
+	KeyIDs() []string
+	KeyVersion(data []byte) string
+}
+
+// EnvelopeEncryptor encrypts each message with a fresh data key that is wrapped by a versioned master key.
+// Ciphertexts carry the master key ID, so several master keys can be active while data is migrated.
+type EnvelopeEncryptor struct {
+	primaryID string
+	keyIDs    []string
+	masters   map[string]cipher.AEAD
+	legacy    []Encryptor
+}
+
+// ParseMasterKeys parses a "id:secret,id:secret" key list (order is preserved)
+func ParseMasterKeys(spec string) ([]MasterKey, error) {
+	var keys []MasterKey
+	for i, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, secret, ok := strings.Cut(entry, ":")
+		if !ok || secret == "" {
+			// 비밀 값이 로그에 남지 않도록 항목 위치만 보고
+			return nil, fmt.Errorf("invalid master key entry #%d: expected id:secret", i+1)
+		}
+		keys = append(keys, MasterKey{ID: strings.TrimSpace(id), Key: []byte(secret)})
+	}
+	return keys, nil
+}
+
+// NewEnvelopeEncryptor creates an envelope encryptor.
+// primaryID selects the key used for new ciphertexts (defaults to the last key);
+// legacyKeys are tried for ciphertexts written by the single-key encryptor.
+func NewEnvelopeEncryptor(keys []MasterKey, primaryID string, legacyKeys ...[]byte) (*EnvelopeEncryptor, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("at least one master key is required")
+	}
+
+	e := &EnvelopeEncryptor{masters: make(map[string]cipher.AEAD, len(keys))}
+	for _, key := range keys {
+		if !masterKeyIDPattern.MatchString(key.ID) || key.ID == LegacyKeyVersion {
+			return nil, fmt.Errorf("invalid master key ID %q", key.ID)
+		}
+		if _, exists := e.masters[key.ID]; exists {
+			return nil, fmt.Errorf("duplicate master key ID %q", key.ID)
+		}
+		if len(key.Key) == 0 {
+			return nil, fmt.Errorf("master key %q is empty", key.ID)
+		}
+
+		hash := sha256.Sum256(key.Key)
+		aead, err := chacha20poly1305.NewX(hash[:])
+		if err != nil {
+			return nil, fmt.Errorf("failed to create cipher for master key %q: %w", key.ID, err)
+		}
+		e.masters[key.ID] = aead
+		e.keyIDs = append(e.keyIDs, key.ID)
+		// 이전 단일 키 암호화 데이터는 동일한 키로 복호화될 수 있음
+		e.legacy = append(e.legacy, NewAESEncryptor(key.Key))
+	}
+
+	if primaryID == "" {
+		primaryID = keys[len(keys)-1].ID
+	}
+	if _, ok := e.masters[primaryID]; !ok {
+		return nil, fmt.Errorf("primary master key %q is not configured", primaryID)
+	}
+	e.primaryID = primaryID
+
+	for _, key := range legacyKeys {
+		if len(key) > 0 {
+			e.legacy = append(e.legacy, NewAESEncryptor(key))
+		}
+	}
+
+	return e, nil
+}
+
+// PrimaryKeyID returns the master key ID used for new ciphertexts
+func (e *EnvelopeEncryptor) PrimaryKeyID() string {
+	return e.primaryID
+}
+
+// KeyIDs returns the configured master key IDs in configuration order
+func (e *EnvelopeEncryptor) KeyIDs() []string {
+	return append([]string(nil), e.keyIDs...)
+}
+
+// KeyVersion returns the master key ID of a ciphertext, or LegacyKeyVersion when it has no envelope prefix
+func (e *EnvelopeEncryptor) KeyVersion(data []byte) string {
+	header, _, ok := parseEnvelopeHeader(data)
+	if !ok {
+		return LegacyKeyVersion
+	}
+	return header.keyID
+}
+
+// Encrypt encrypts data with a new data key wrapped by the primary master key
+func (e *EnvelopeEncryptor) Encrypt(data []byte) ([]byte, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	header := make([]byte, 0, len(envelopeMagic)+2+len(e.primaryID))
+	header = append(header, envelopeMagic...)
+	header = append(header, envelopeFormatV1, byte(len(e.primaryID)))
+	header = append(header, e.primaryID...)
+
+	wrappedKey, err := seal(e.masters[e.primaryID], dataKey, header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+
+	dataAEAD, err := chacha20poly1305.NewX(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create data cipher: %w", err)
+	}
+	payload, err := seal(dataAEAD, data, header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt payload: %w", err)
+	}
+
+	out := make([]byte, 0, len(header)+2+len(wrappedKey)+len(payload))
+	out = append(out, header...)
+	out = binary.BigEndian.AppendUint16(out, uint16(len(wrappedKey)))
+	out = append(out, wrappedKey...)
+	out = append(out, payload...)
+	return out, nil
+}
+
+// Decrypt decrypts an envelope ciphertext, falling back to the legacy single-key format
+func (e *EnvelopeEncryptor) Decrypt(data []byte) ([]byte, error) {
+	header, rest, ok := parseEnvelopeHeader(data)
+	if !ok {
+		return e.decryptLegacy(data)
+	}
+
+	plaintext, err := e.decryptEnvelope(header, rest)
+	if err != nil {
+		// A legacy ciphertext whose random nonce happens to look like an envelope prefix
+		if legacyPlaintext, legacyErr := e.decryptLegacy(data); legacyErr == nil {
+			return legacyPlaintext, nil
+		}
+		return nil, err
+	}
+	return plaintext, nil
+}
+
+// decryptEnvelope unwraps the data key with the referenced master key and decrypts the payload
+func (e *EnvelopeEncryptor) decryptEnvelope(header envelopeHeader, rest []byte) ([]byte, error) {
+	master, exists := e.masters[header.keyID]
+	if !exists {
+		return nil, fmt.Errorf("master key %q is not configured", header.keyID)
+	}
+
+	if len(rest) < 2 {
+		return nil, fmt.Errorf("envelope ciphertext too short")
+	}
+	wrappedLen := int(binary.BigEndian.Uint16(rest))
+	rest = rest[2:]
+	if len(rest) < wrappedLen {
+		return nil, fmt.Errorf("envelope ciphertext too short")
+	}
+	wrappedKey, payload := rest[:wrappedLen], rest[wrappedLen:]
+
+	dataKey, err := open(master, wrappedKey, header.raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key with master key %q: %w", header.keyID, err)
+	}
+	dataAEAD, err := chacha20poly1305.NewX(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create data cipher: %w", err)
+	}
+	plaintext, err := open(dataAEAD, payload, header.raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: %w", err)
+	}
+	return plaintext, nil
+}
+
+// decryptLegacy tries every legacy key for ciphertexts without an envelope prefix
+func (e *EnvelopeEncryptor) decryptLegacy(data []byte) ([]byte, error) {
+	var lastErr error
+	for _, legacy := range e.legacy {
+		plaintext, err := legacy.Decrypt(data)
+		if err == nil {
+			return plaintext, nil
+		}
+		lastErr = err
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no legacy key configured")
+	}
+	return nil, lastErr
+}
+
+// envelopeHeader is the authenticated prefix of an envelope ciphertext
+type envelopeHeader struct {
+	keyID string
+	raw   []byte
+}
+
+// parseEnvelopeHeader splits the envelope header from the rest of the ciphertext
+func parseEnvelopeHeader(data []byte) (envelopeHeader, []byte, bool) {
+	prefixLen := len(envelopeMagic) + 2
+	if len(data) < prefixLen || !bytes.Equal(data[:len(envelopeMagic)], envelopeMagic) || data[len(envelopeMagic)] != envelopeFormatV1 {
+		return envelopeHeader{}, nil, false
+	}
+	idLen := int(data[len(envelopeMagic)+1])
+	if idLen == 0 || len(data) < prefixLen+idLen {
+		return envelopeHeader{}, nil, false
+	}
+	keyID := string(data[prefixLen : prefixLen+idLen])
+	if !masterKeyIDPattern.MatchString(keyID) {
+		return envelopeHeader{}, nil, false
+	}
+	return envelopeHeader{keyID: keyID, raw: data[:prefixLen+idLen]}, data[prefixLen+idLen:], true
+}
+
+// seal encrypts plaintext with a random nonce prepended to the ciphertext
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open decrypts a ciphertext produced by seal
+func open(aead cipher.AEAD, data, additionalData []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
+}
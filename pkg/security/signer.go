@@ -0,0 +1,71 @@
+package security
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Ed25519Signer signs payloads with an Ed25519 key and exposes the public key for offline verification
+type Ed25519Signer struct {
+	privateKey ed25519.PrivateKey
+	publicKey  ed25519.PublicKey
+	keyID      string
+}
+
+// NewEd25519Signer creates a signer from a base64 encoded 32-byte seed
+func NewEd25519Signer(encodedSeed string) (*Ed25519Signer, error) {
+	seed, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedSeed))
+	if err != nil {
+		return nil, fmt.Errorf("signing key must be base64 encoded: %w", err)
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("signing key must be a %d-byte seed, got %d bytes", ed25519.SeedSize, len(seed))
+	}
+	return newEd25519Signer(seed), nil
+}
+
+// DeriveEd25519Signer derives a deterministic signer from a secret and a purpose label
+// Used when no dedicated signing key is configured so signatures stay stable across restarts
+func DeriveEd25519Signer(secret []byte, purpose string) *Ed25519Signer {
+	seed := sha256.Sum256(append([]byte(purpose+":"), secret...))
+	return newEd25519Signer(seed[:])
+}
+
+func newEd25519Signer(seed []byte) *Ed25519Signer {
+	privateKey := ed25519.NewKeyFromSeed(seed)
+	publicKey := privateKey.Public().(ed25519.PublicKey)
+	fingerprint := sha256.Sum256(publicKey)
+	return &Ed25519Signer{
+		privateKey: privateKey,
+		publicKey:  publicKey,
+		keyID:      hex.EncodeToString(fingerprint[:8]),
+	}
+}
+
+// KeyID returns a short fingerprint of the public key
+func (s *Ed25519Signer) KeyID() string {
+	return s.keyID
+}
+
+// PublicKey returns the base64 encoded public key
+func (s *Ed25519Signer) PublicKey() string {
+	return base64.StdEncoding.EncodeToString(s.publicKey)
+}
+
+// Sign returns the base64 encoded signature of the payload
+func (s *Ed25519Signer) Sign(payload []byte) string {
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(s.privateKey, payload))
+}
+
+// Verify reports whether the base64 encoded signature is valid for the payload
+func (s *Ed25519Signer) Verify(payload []byte, signature string) bool {
+	decoded, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	return ed25519.Verify(s.publicKey, payload, decoded)
+}